@@ -334,6 +334,30 @@ module.exports = {
                 },
               ],
             },
+            {
+              title: "Rate Limit Middleware",
+              directory: true,
+              path: "/middleware",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/middleware/rate-limit/overview.html",
+                },
+              ],
+            },
+            {
+              title: "Callbacks Middleware",
+              directory: true,
+              path: "/middleware",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/middleware/callbacks/overview.html",
+                },
+              ],
+            },
           ],
         },
         {
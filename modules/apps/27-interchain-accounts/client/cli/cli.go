@@ -20,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 	icaQueryCmd.AddCommand(
 		controllercli.GetQueryCmd(),
 		hostcli.GetQueryCmd(),
+		GetCmdDecodePacketData(),
 	)
 
 	return icaQueryCmd
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channelcli "github.com/cosmos/ibc-go/v6/modules/core/04-channel/client/cli"
+)
+
+// decodedPacketData defines the output of the decode-packet-data command. The messages are only
+// populated if their types are registered with the interface registry.
+type decodedPacketData struct {
+	InterchainAccountPacketData json.RawMessage   `json:"interchain_account_packet_data"`
+	Messages                    []json.RawMessage `json:"messages,omitempty"`
+}
+
+// GetCmdDecodePacketData defines the command to decode the ICS27 packet data of a packet from its hex encoded bytes.
+func GetCmdDecodePacketData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-packet-data [hex-bytes]",
+		Short: "Decode ICS27 packet data",
+		Long: `Decode the hex encoded bytes of a protobuf encoded packet or of raw packet data as ICS27 interchain account packet data and print its fields.
+The messages of the packet data are decoded as well if their types are registered with the interface registry of the client.
+The bytes are decoded locally and no connection to a node is required.`,
+		Example: fmt.Sprintf("%s query interchain-accounts decode-packet-data [hex-bytes]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := channelcli.ParseHexBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			decoded, err := decodePacketData(codec.NewProtoCodec(clientCtx.InterfaceRegistry), channelcli.ParsePacketData(bz))
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(decoded)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// decodePacketData decodes the provided packet data as InterchainAccountPacketData along with its messages.
+func decodePacketData(cdc *codec.ProtoCodec, data []byte) (*decodedPacketData, error) {
+	var icaData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(data, &icaData); err != nil || icaData.ValidateBasic() != nil {
+		return nil, fmt.Errorf("bytes are not valid ICS27 packet data")
+	}
+
+	decoded := decodedPacketData{InterchainAccountPacketData: icatypes.ModuleCdc.MustMarshalJSON(&icaData)}
+
+	// the messages can only be decoded if their types are registered with the interface registry
+	if msgs, err := icatypes.DeserializeCosmosTx(cdc, icaData.Data); err == nil {
+		for _, msg := range msgs {
+			msgJSON, err := cdc.MarshalInterfaceJSON(msg)
+			if err != nil {
+				return nil, err
+			}
+
+			decoded.Messages = append(decoded.Messages, msgJSON)
+		}
+	}
+
+	return &decoded, nil
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+)
+
+func TestDecodePacketData(t *testing.T) {
+	var (
+		bz                  []byte
+		registerInterfaceFn func(registry codectypes.InterfaceRegistry)
+	)
+
+	// icaPacketData returns the bytes of interchain account packet data executing a single bank send message
+	icaPacketData := func() []byte {
+		cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		msg := &banktypes.MsgSend{
+			FromAddress: "cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+			ToAddress:   "cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw",
+			Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		}
+
+		data, err := icatypes.SerializeCosmosTx(cdc, []proto.Message{msg})
+		require.NoError(t, err)
+
+		return icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}.GetBytes()
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expPass     bool
+		assertionFn func(t *testing.T, decoded *decodedPacketData)
+	}{
+		{
+			"success: interchain account packet data",
+			func() {
+				bz = icaPacketData()
+				registerInterfaceFn = banktypes.RegisterInterfaces
+			},
+			true,
+			func(t *testing.T, decoded *decodedPacketData) {
+				require.NotNil(t, decoded.InterchainAccountPacketData)
+				require.Len(t, decoded.Messages, 1)
+				require.Contains(t, string(decoded.Messages[0]), `"@type":"/cosmos.bank.v1beta1.MsgSend"`)
+			},
+		},
+		{
+			"success: interchain account packet data with unregistered message types",
+			func() {
+				bz = icaPacketData()
+			},
+			true,
+			func(t *testing.T, decoded *decodedPacketData) {
+				require.NotNil(t, decoded.InterchainAccountPacketData)
+				require.Empty(t, decoded.Messages)
+			},
+		},
+		{
+			"unknown packet data",
+			func() {
+				bz = []byte("unknown packet data")
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			registerInterfaceFn = nil
+
+			tc.malleate()
+
+			interfaceRegistry := codectypes.NewInterfaceRegistry()
+			if registerInterfaceFn != nil {
+				registerInterfaceFn(interfaceRegistry)
+			}
+
+			decoded, err := decodePacketData(codec.NewProtoCodec(interfaceRegistry), bz)
+			if tc.expPass {
+				require.NoError(t, err)
+				tc.assertionFn(t, decoded)
+			} else {
+				require.Error(t, err)
+				require.Nil(t, decoded)
+			}
+		})
+	}
+}
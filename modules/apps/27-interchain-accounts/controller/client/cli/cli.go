@@ -17,6 +17,7 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdQueryInterchainAccount(),
+		GetCmdQueryInterchainAccountsForOwner(),
 		GetCmdParams(),
 	)
 
@@ -45,6 +45,46 @@ func GetCmdQueryInterchainAccount() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryInterchainAccountsForOwner returns the command handler for querying all interchain accounts of a given owner.
+func GetCmdQueryInterchainAccountsForOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts [owner]",
+		Short:   "Query all interchain accounts registered by a given owner",
+		Long:    "Query the controller submodule for the interchain account addresses registered by a given owner across all connections",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller interchain-accounts cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryInterchainAccountsForOwnerRequest{
+				Owner:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.InterchainAccountsForOwner(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for the controller submodule parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
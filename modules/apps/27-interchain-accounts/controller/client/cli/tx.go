@@ -19,6 +19,7 @@ const (
 	// The controller chain channel version
 	flagVersion               = "version"
 	flagRelativePacketTimeout = "relative-packet-timeout"
+	flagRegister              = "register"
 )
 
 func newRegisterInterchainAccountCmd() *cobra.Command {
@@ -63,7 +64,10 @@ func newSendTxCmd() *cobra.Command {
 		Short: "Send an interchain account tx on the provided connection.",
 		Long: strings.TrimSpace(`Submits pre-built packet data containing messages to be executed on the host chain 
 and attempts to send the packet. Packet data is provided as json, file or string. An 
-appropriate relative timeoutTimestamp must be provided with flag {relative-packet-timeout}`),
+appropriate relative timeoutTimestamp must be provided with flag {relative-packet-timeout}.
+If the {register} flag is set and the interchain account has no open channel on the connection,
+the interchain account is registered with the {version} flag and the packet is sent once the
+channel is open.`),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -98,11 +102,23 @@ appropriate relative timeoutTimestamp must be provided with flag {relative-packe
 
 			msg := types.NewMsgSendTx(owner, connectionID, relativeTimeoutTimestamp, icaMsgData)
 
+			msg.Register, err = cmd.Flags().GetBool(flagRegister)
+			if err != nil {
+				return err
+			}
+
+			msg.Version, err = cmd.Flags().GetString(flagVersion)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().Uint64(flagRelativePacketTimeout, icatypes.DefaultRelativePacketTimeoutTimestamp, "Relative packet timeout in nanoseconds from now. Default is 10 minutes.")
+	cmd.Flags().Bool(flagRegister, false, "Register the interchain account if it has no open channel on the connection")
+	cmd.Flags().String(flagVersion, "", "Controller chain channel version used with the register flag")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
@@ -29,3 +30,28 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, ack expo
 		),
 	)
 }
+
+// EmitPendingTxEvent emits an event signalling that a transaction pending the registration of an interchain account
+// was sent with the provided sequence or dropped with the provided error.
+func EmitPendingTxEvent(ctx sdk.Context, pendingTx types.PendingTx, sequence uint64, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, pendingTx.PortId),
+		sdk.NewAttribute(icatypes.AttributeKeyConnectionID, pendingTx.ConnectionId),
+		sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, pendingTx.ChannelId),
+		sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyAckError, err.Error()))
+	} else {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeySequence, fmt.Sprintf("%d", sequence)))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypePendingTx,
+			attributes...,
+		),
+	)
+}
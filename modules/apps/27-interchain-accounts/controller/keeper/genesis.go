@@ -34,6 +34,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.ControllerGe
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, pendingTx := range state.PendingTxs {
+		keeper.SetPendingTx(ctx, pendingTx)
+
+		// a transaction whose channel is already open is sent at the end of the next block
+		if channelID, found := keeper.GetOpenActiveChannel(ctx, pendingTx.ConnectionId, pendingTx.PortId); found {
+			keeper.onPendingTxChannelOpen(ctx, pendingTx.ConnectionId, pendingTx.PortId, channelID)
+		}
+	}
+
 	keeper.SetParams(ctx, state.Params)
 }
 
@@ -44,5 +53,6 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.ControllerGenesi
 		keeper.GetAllInterchainAccounts(ctx),
 		keeper.GetAllPorts(ctx),
 		keeper.GetParams(ctx),
+		keeper.GetAllPendingTxs(ctx),
 	)
 }
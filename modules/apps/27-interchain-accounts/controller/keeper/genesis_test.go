@@ -35,6 +35,9 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Ports: []string{TestPortID},
+		PendingTxs: []types.PendingTx{
+			types.NewPendingTx("test-port-1", "connection-1", "channel-1", icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}, 1, 1),
+		},
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper, genesisState)
@@ -53,6 +56,10 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(interchainAccAddr.String(), accountAdrr)
 
+	pendingTx, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingTx(suite.chainA.GetContext(), "connection-1", "test-port-1")
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.PendingTxs[0], pendingTx)
+
 	expParams := types.NewParams(false)
 	params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -35,6 +38,40 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 	}, nil
 }
 
+// InterchainAccountsForOwner implements the Query/InterchainAccountsForOwner gRPC method
+func (k Keeper) InterchainAccountsForOwner(goCtx context.Context, req *types.QueryInterchainAccountsForOwnerRequest) (*types.QueryInterchainAccountsForOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// interchain account addresses are keyed by the controller portID followed by the connectionID
+	var interchainAccounts []types.OwnerInterchainAccount
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s/%s/", icatypes.OwnerKeyPrefix, portID)))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		interchainAccounts = append(interchainAccounts, types.OwnerInterchainAccount{
+			ConnectionId: string(key),
+			PortId:       portID,
+			Address:      string(value),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryInterchainAccountsForOwnerResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -2,8 +2,10 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 )
 
@@ -75,6 +77,103 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryInterchainAccountsForOwner() {
+	var (
+		req                   *types.QueryInterchainAccountsForOwnerRequest
+		expInterchainAccounts []types.OwnerInterchainAccount
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit:      2,
+					CountTotal: true,
+				}
+
+				expInterchainAccounts = expInterchainAccounts[:2]
+			},
+			true,
+		},
+		{
+			"success: owner without interchain accounts",
+			func() {
+				req.Owner = suite.chainA.SenderAccount.GetAddress().String()
+				expInterchainAccounts = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"empty owner address",
+			func() {
+				req.Owner = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, ibctesting.TestAccAddress)
+			suite.Require().NoError(err)
+
+			address, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			expInterchainAccounts = []types.OwnerInterchainAccount{
+				{ConnectionId: ibctesting.FirstConnectionID, PortId: path.EndpointA.ChannelConfig.PortID, Address: address},
+			}
+
+			// register additional interchain accounts for the owner on other connections
+			for _, connectionID := range []string{"connection-1", "connection-2"} {
+				addr := icatypes.GenerateAddress(suite.chainA.GetContext(), connectionID, path.EndpointA.ChannelConfig.PortID).String()
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), connectionID, path.EndpointA.ChannelConfig.PortID, addr)
+
+				expInterchainAccounts = append(expInterchainAccounts, types.OwnerInterchainAccount{
+					ConnectionId: connectionID, PortId: path.EndpointA.ChannelConfig.PortID, Address: addr,
+				})
+			}
+
+			req = &types.QueryInterchainAccountsForOwnerRequest{
+				Owner: ibctesting.TestAccAddress,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccountsForOwner(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expInterchainAccounts, res.InterchainAccounts)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 	expParams := types.DefaultParams()
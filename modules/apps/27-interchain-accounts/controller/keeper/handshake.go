@@ -121,17 +121,35 @@ func (k Keeper) OnChanOpenAck(
 		return sdkerrors.Wrap(icatypes.ErrInvalidAccountAddress, "interchain account address cannot be empty")
 	}
 
+	// the interchain account address remains set when the channel is closed, such that it is only
+	// registered by the handshake of the first channel
+	_, reopening := k.GetInterchainAccountAddress(ctx, metadata.ControllerConnectionId, portID)
+
 	k.SetActiveChannelID(ctx, metadata.ControllerConnectionId, portID, channelID)
 	k.SetInterchainAccountAddress(ctx, metadata.ControllerConnectionId, portID, metadata.Address)
+	k.onPendingTxChannelOpen(ctx, metadata.ControllerConnectionId, portID, channelID)
+
+	if !reopening && k.hooks != nil {
+		k.callHook(ctx, "OnInterchainAccountRegistered", portID, channelID, func(cacheCtx sdk.Context) error {
+			return k.hooks.OnInterchainAccountRegistered(cacheCtx, portID, channelID, metadata.Address)
+		})
+	}
 
 	return nil
 }
 
-// OnChanCloseConfirm removes the active channel stored in state
+// OnChanCloseConfirm drops the transaction pending the registration of the interchain account on the closed channel
 func (k Keeper) OnChanCloseConfirm(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 ) error {
+	connectionID, err := k.GetConnectionID(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	k.onPendingTxChannelClose(ctx, connectionID, portID, channelID)
+
 	return nil
 }
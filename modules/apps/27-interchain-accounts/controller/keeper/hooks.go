@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// callHook invokes the provided controller hook using a cached context. State changes made by the
+// hook are only written if it returns without an error. A panic or an error returned by the hook is
+// logged and does not affect the interchain account, its channel or its packets. Out of gas panics
+// are propagated, since the gas limit of the transaction has been exceeded.
+func (k Keeper) callHook(ctx sdk.Context, name, portID, channelID string, hook func(cacheCtx sdk.Context) error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				panic(r)
+			}
+
+			k.Logger(ctx).Error("interchain accounts controller hook panicked", "hook", name, "port-id", portID, "channel-id", channelID, "panic", fmt.Sprintf("%v", r))
+		}
+	}()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := hook(cacheCtx); err != nil {
+		k.Logger(ctx).Error("interchain accounts controller hook failed", "hook", name, "port-id", portID, "channel-id", channelID, "error", err.Error())
+		return
+	}
+
+	writeCache()
+}
@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+var _ types.ICAControllerHooks = &mockICAControllerHooks{}
+
+// mockICAControllerHooks records the invocations of the controller hooks and runs the
+// provided callback, which may be used to write state, return an error or panic.
+type mockICAControllerHooks struct {
+	calls    []string
+	callback func(ctx sdk.Context) error
+}
+
+func (h *mockICAControllerHooks) OnInterchainAccountRegistered(ctx sdk.Context, portID, channelID, address string) error {
+	h.calls = append(h.calls, fmt.Sprintf("registered:%s/%s/%s", portID, channelID, address))
+	return h.run(ctx)
+}
+
+func (h *mockICAControllerHooks) OnInterchainAccountTxAcknowledged(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	h.calls = append(h.calls, fmt.Sprintf("acknowledged:%s/%s/%d/%s", packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), acknowledgement))
+	return h.run(ctx)
+}
+
+func (h *mockICAControllerHooks) OnInterchainAccountTxTimedOut(ctx sdk.Context, packet channeltypes.Packet) error {
+	h.calls = append(h.calls, fmt.Sprintf("timedout:%s/%s/%d", packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	return h.run(ctx)
+}
+
+func (h *mockICAControllerHooks) run(ctx sdk.Context) error {
+	if h.callback != nil {
+		return h.callback(ctx)
+	}
+
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestControllerHooks() {
+	const (
+		hookConnectionID = "connection-hook"
+		hookPortID       = "port-hook"
+		hookChannelID    = "channel-hook"
+	)
+
+	var (
+		path      *ibctesting.Path
+		hooks     *mockICAControllerHooks
+		reopen    bool
+		expCalled bool
+		expWrite  bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success", func() {
+				expCalled = true
+				expWrite = true
+			},
+		},
+		{
+			"hook returns an error", func() {
+				hooks.callback = func(ctx sdk.Context) error {
+					suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(ctx, hookConnectionID, hookPortID, hookChannelID)
+					return fmt.Errorf("hook error")
+				}
+				expCalled = true
+			},
+		},
+		{
+			"hook panics", func() {
+				hooks.callback = func(ctx sdk.Context) error {
+					suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(ctx, hookConnectionID, hookPortID, hookChannelID)
+					panic("hook panic")
+				}
+				expCalled = true
+			},
+		},
+		{
+			"registered hook is not invoked when the interchain account address is already set", func() {
+				reopen = true
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			reopen = false
+			expCalled = false
+			expWrite = false
+
+			hooks = &mockICAControllerHooks{
+				callback: func(ctx sdk.Context) error {
+					suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(ctx, hookConnectionID, hookPortID, hookChannelID)
+					return nil
+				},
+			}
+			secondHooks := &mockICAControllerHooks{}
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ChanOpenTry()
+			suite.Require().NoError(err)
+
+			address, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			metadata := icatypes.NewMetadata(icatypes.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, address, icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+			versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+			suite.Require().NoError(err)
+
+			tc.malleate() // malleate mutates test data
+
+			controllerKeeper := &suite.chainA.GetSimApp().ICAControllerKeeper
+			if reopen {
+				controllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, address)
+			}
+
+			controllerKeeper.SetHooks(types.NewMultiICAControllerHooks(hooks, secondHooks))
+
+			err = controllerKeeper.OnChanOpenAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, string(versionBytes))
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(
+				[]byte("data"), 1,
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100), 0,
+			)
+
+			err = controllerKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, []byte("ack"))
+			suite.Require().NoError(err)
+
+			err = controllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+			suite.Require().NoError(err)
+
+			// the handshake is unaffected by the hooks
+			activeChannelID, found := controllerKeeper.GetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+			suite.Require().Equal(path.EndpointA.ChannelID, activeChannelID)
+
+			var expCalls []string
+			if !reopen {
+				expCalls = append(expCalls, fmt.Sprintf("registered:%s/%s/%s", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, address))
+			}
+			expCalls = append(expCalls,
+				fmt.Sprintf("acknowledged:%s/%s/1/ack", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID),
+				fmt.Sprintf("timedout:%s/%s/1", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID),
+			)
+			suite.Require().Equal(expCalls, hooks.calls)
+
+			if expCalled && !expWrite {
+				// the first hook failed, so the chained hooks are never invoked
+				suite.Require().Empty(secondHooks.calls)
+			} else {
+				// hooks are chained
+				suite.Require().Equal(expCalls, secondHooks.calls)
+			}
+
+			_, found = controllerKeeper.GetActiveChannelID(suite.chainA.GetContext(), hookConnectionID, hookPortID)
+			suite.Require().Equal(expWrite || reopen, found)
+		})
+	}
+}
+
+// TestControllerHooksOutOfGas tests that an out of gas panic of a hook is not recovered,
+// since the gas limit of the transaction has been exceeded.
+func (suite *KeeperTestSuite) TestControllerHooksOutOfGas() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	controllerKeeper := &suite.chainA.GetSimApp().ICAControllerKeeper
+	controllerKeeper.SetHooks(&mockICAControllerHooks{
+		callback: func(ctx sdk.Context) error {
+			panic(sdk.ErrorOutOfGas{Descriptor: "hook"})
+		},
+	})
+
+	packet := channeltypes.NewPacket(
+		[]byte("data"), 1,
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100), 0,
+	)
+
+	suite.Require().PanicsWithValue(sdk.ErrorOutOfGas{Descriptor: "hook"}, func() {
+		_ = controllerKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, []byte("ack"))
+	})
+
+	suite.Require().PanicsWithValue(sdk.ErrorOutOfGas{Descriptor: "hook"}, func() {
+		_ = controllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+	})
+}
@@ -35,6 +35,8 @@ type Keeper struct {
 	scopedKeeper exported.ScopedKeeper
 
 	msgRouter icatypes.MessageRouter
+
+	hooks types.ICAControllerHooks
 }
 
 // NewKeeper creates a new interchain accounts controller Keeper instance
@@ -60,6 +62,18 @@ func NewKeeper(
 	}
 }
 
+// SetHooks sets the interchain accounts controller hooks. It panics if the hooks have already been set.
+// Multiple hooks may be registered by combining them with types.NewMultiICAControllerHooks.
+func (k *Keeper) SetHooks(hooks types.ICAControllerHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set interchain accounts controller hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
 // Logger returns the application logger, scoped to the associated module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, icatypes.ModuleName))
@@ -35,6 +35,11 @@ func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.M
 		return nil, sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel is already active or a handshake is in flight")
 	}
 
+	// a transaction pending a previous registration is not sent on the channel opened by this registration
+	if pendingTx, found := s.GetPendingTx(ctx, msg.ConnectionId, portID); found {
+		s.dropPendingTx(ctx, pendingTx, errRegistrationReplaced)
+	}
+
 	s.SetMiddlewareDisabled(ctx, portID, msg.ConnectionId)
 
 	channelID, err := s.registerInterchainAccount(ctx, msg.ConnectionId, portID, msg.Version)
@@ -56,6 +61,16 @@ func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.M
 		return nil, err
 	}
 
+	// the transaction is sent once the channel opened by the registration is open
+	if _, found := s.GetOpenActiveChannel(ctx, msg.ConnectionId, portID); msg.Register && !found {
+		channelID, err := s.registerWithPendingTx(ctx, msg.ConnectionId, portID, msg.Version, msg.PacketData, msg.RelativeTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.MsgSendTxResponse{ChannelId: channelID}, nil
+	}
+
 	// the absolute timeout value is calculated using the controller chain block time + the relative timeout value
 	// this assumes time synchrony to a certain degree between the controller and counterparty host chain
 	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
 // IsControllerEnabled retrieves the controller enabled boolean from the paramstore.
@@ -19,6 +20,13 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(k.IsControllerEnabled(ctx))
 }
 
+// GetAppParams returns the total set of controller submodule parameters. It is used by the IBCParams query
+// of core IBC.
+func (k Keeper) GetAppParams(ctx sdk.Context) exported.AppParams {
+	params := k.GetParams(ctx)
+	return &params
+}
+
 // SetParams sets the total set of the controller submodule parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
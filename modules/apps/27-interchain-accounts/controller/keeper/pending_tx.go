@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+)
+
+// errRegistrationReplaced is the reason for dropping a pending transaction whose interchain account
+// registration is replaced by a new registration.
+var errRegistrationReplaced = errors.New("interchain account registration was replaced by a new registration")
+
+// errChannelClosed is the reason for dropping a pending transaction whose channel was closed before it opened.
+var errChannelClosed = errors.New("channel opened by the interchain account registration was closed")
+
+// errPendingTxExpired is the reason for dropping a pending transaction whose channel did not open before
+// its expiry timestamp.
+var errPendingTxExpired = errors.New("channel opened by the interchain account registration did not open before the pending transaction expired")
+
+// GetPendingTx returns the transaction pending the registration of the interchain account for the provided connectionID and portID
+func (k Keeper) GetPendingTx(ctx sdk.Context, connectionID, portID string) (types.PendingTx, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPendingTx(portID, connectionID))
+	if bz == nil {
+		return types.PendingTx{}, false
+	}
+
+	var pendingTx types.PendingTx
+	k.cdc.MustUnmarshal(bz, &pendingTx)
+	return pendingTx, true
+}
+
+// SetPendingTx stores the transaction pending the registration of an interchain account, keyed by its connectionID and portID,
+// and indexes it by its expiry timestamp
+func (k Keeper) SetPendingTx(ctx sdk.Context, pendingTx types.PendingTx) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pendingTx)
+	store.Set(types.KeyPendingTx(pendingTx.PortId, pendingTx.ConnectionId), bz)
+	store.Set(types.KeyExpiringPendingTx(pendingTx.ExpiryTimestamp, pendingTx.PortId, pendingTx.ConnectionId), types.KeyPendingTx(pendingTx.PortId, pendingTx.ConnectionId))
+}
+
+// IsPendingTxReady returns true if the active channel of the pending transaction for the provided connectionID and portID
+// opened and the transaction is sent at the end of the block
+func (k Keeper) IsPendingTxReady(ctx sdk.Context, connectionID, portID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyReadyPendingTx(portID, connectionID))
+}
+
+// setPendingTxReady marks the pending transaction for the provided connectionID and portID as ready to be sent
+func (k Keeper) setPendingTxReady(ctx sdk.Context, connectionID, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyReadyPendingTx(portID, connectionID), []byte{byte(1)})
+}
+
+// GetAllPendingTxs returns all transactions pending the registration of an interchain account
+func (k Keeper) GetAllPendingTxs(ctx sdk.Context) []types.PendingTx {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.PendingTxKeyPrefix))
+	defer iterator.Close()
+
+	var pendingTxs []types.PendingTx
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingTx types.PendingTx
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingTx)
+		pendingTxs = append(pendingTxs, pendingTx)
+	}
+
+	return pendingTxs
+}
+
+func (k Keeper) deletePendingTx(ctx sdk.Context, pendingTx types.PendingTx) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingTx(pendingTx.PortId, pendingTx.ConnectionId))
+	store.Delete(types.KeyReadyPendingTx(pendingTx.PortId, pendingTx.ConnectionId))
+	store.Delete(types.KeyExpiringPendingTx(pendingTx.ExpiryTimestamp, pendingTx.PortId, pendingTx.ConnectionId))
+}
+
+// dropPendingTx deletes the pending transaction and emits an event signalling to the owner that the
+// transaction was not sent.
+func (k Keeper) dropPendingTx(ctx sdk.Context, pendingTx types.PendingTx, err error) {
+	k.deletePendingTx(ctx, pendingTx)
+	EmitPendingTxEvent(ctx, pendingTx, 0, err)
+
+	k.Logger(ctx).Info("dropped pending interchain account transaction", "port-id", pendingTx.PortId, "connection-id", pendingTx.ConnectionId, "error", err.Error())
+}
+
+// registerWithPendingTx registers an interchain account and stores the provided packet data to be sent once the
+// channel opened by the registration is open. The channel id of the MsgChannelOpenInitResponse is returned.
+// A transaction pending a previous registration of the interchain account is replaced. The transaction expires
+// if the channel has not opened within its relative timeout.
+func (k Keeper) registerWithPendingTx(
+	ctx sdk.Context, connectionID, portID, version string,
+	icaPacketData icatypes.InterchainAccountPacketData, relativeTimeout uint64,
+) (string, error) {
+	if k.IsMiddlewareEnabled(ctx, portID, connectionID) && !k.IsActiveChannelClosed(ctx, connectionID, portID) {
+		return "", sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel is already active or a handshake is in flight")
+	}
+
+	// a stale transaction pending a previous registration is not sent on the channel opened by this registration
+	if pendingTx, found := k.GetPendingTx(ctx, connectionID, portID); found {
+		k.dropPendingTx(ctx, pendingTx, errRegistrationReplaced)
+	}
+
+	k.SetMiddlewareDisabled(ctx, portID, connectionID)
+
+	channelID, err := k.registerInterchainAccount(ctx, connectionID, portID, version)
+	if err != nil {
+		return "", err
+	}
+
+	expiryTimestamp := uint64(ctx.BlockTime().UnixNano()) + relativeTimeout
+	k.SetPendingTx(ctx, types.NewPendingTx(portID, connectionID, channelID, icaPacketData, relativeTimeout, expiryTimestamp))
+
+	return channelID, nil
+}
+
+// onPendingTxChannelOpen marks the transaction pending the registration of the interchain account as ready to be sent
+// if the provided channel is the channel opened by the registration.
+func (k Keeper) onPendingTxChannelOpen(ctx sdk.Context, connectionID, portID, channelID string) {
+	if pendingTx, found := k.GetPendingTx(ctx, connectionID, portID); found && pendingTx.ChannelId == channelID {
+		k.setPendingTxReady(ctx, connectionID, portID)
+	}
+}
+
+// onPendingTxChannelClose drops the transaction pending the registration of the interchain account if the provided
+// channel is the channel opened by the registration.
+func (k Keeper) onPendingTxChannelClose(ctx sdk.Context, connectionID, portID, channelID string) {
+	if pendingTx, found := k.GetPendingTx(ctx, connectionID, portID); found && pendingTx.ChannelId == channelID {
+		k.dropPendingTx(ctx, pendingTx, errChannelClosed)
+	}
+}
+
+// SendPendingTxs sends the pending transactions marked as ready when their channel opened. The absolute
+// timeout of a transaction is calculated from the current block time and its relative timeout. A transaction
+// which cannot be sent is dropped, as are the transactions whose channel did not open before their expiry
+// timestamp. SendPendingTxs is called at the end of every block, since a packet cannot be sent on a channel
+// before the channel handshake callbacks have completed.
+func (k Keeper) SendPendingTxs(ctx sdk.Context) {
+	for _, pendingTx := range k.getReadyPendingTxs(ctx) {
+		// state changes of a failed send are discarded
+		cacheCtx, writeFn := ctx.CacheContext()
+
+		absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + pendingTx.RelativeTimeout
+		sequence, err := k.sendTx(cacheCtx, pendingTx.ConnectionId, pendingTx.PortId, pendingTx.PacketData, absoluteTimeout)
+		if err != nil {
+			k.dropPendingTx(ctx, pendingTx, err)
+			continue
+		}
+
+		writeFn()
+
+		k.deletePendingTx(ctx, pendingTx)
+		EmitPendingTxEvent(ctx, pendingTx, sequence, nil)
+	}
+
+	for _, pendingTx := range k.getExpiredPendingTxs(ctx) {
+		k.dropPendingTx(ctx, pendingTx, errPendingTxExpired)
+	}
+}
+
+// getReadyPendingTxs returns the pending transactions marked as ready to be sent.
+func (k Keeper) getReadyPendingTxs(ctx sdk.Context) []types.PendingTx {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.ReadyPendingTxKeyPrefix+"/"))
+	defer iterator.Close()
+
+	var pendingTxs []types.PendingTx
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		pendingTx, found := k.GetPendingTx(ctx, keySplit[2], keySplit[1])
+		if !found {
+			panic(fmt.Sprintf("pending transaction marked as ready not found for port %s and connection %s", keySplit[1], keySplit[2]))
+		}
+
+		pendingTxs = append(pendingTxs, pendingTx)
+	}
+
+	return pendingTxs
+}
+
+// getExpiredPendingTxs returns the pending transactions whose expiry timestamp is not after the current block time.
+func (k Keeper) getExpiredPendingTxs(ctx sdk.Context) []types.PendingTx {
+	store := ctx.KVStore(k.storeKey)
+	end := append([]byte(types.ExpiringPendingTxKeyPrefix+"/"), sdk.Uint64ToBigEndian(uint64(ctx.BlockTime().UnixNano())+1)...)
+	iterator := store.Iterator([]byte(types.ExpiringPendingTxKeyPrefix+"/"), end)
+	defer iterator.Close()
+
+	var pendingTxs []types.PendingTx
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingTx types.PendingTx
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &pendingTx)
+		pendingTxs = append(pendingTxs, pendingTx)
+	}
+
+	return pendingTxs
+}
@@ -0,0 +1,289 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+func (suite *KeeperTestSuite) TestSendTxWithRegistration() {
+	var (
+		path          *ibctesting.Path
+		msg           *types.MsgSendTx
+		expPendingTx  bool
+		expPacketSent bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: transaction is sent once the channel is open", func() {},
+			true,
+		},
+		{
+			"success: transaction is sent immediately on an open active channel", func() {
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				expPendingTx = false
+			},
+			true,
+		},
+		{
+			"success: pending transaction is dropped if it cannot be sent", func() {
+				// the packet data exceeds the maximum packet data size once the channel is open
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+				channelKeeper.SetParams(suite.chainA.GetContext(), channeltypes.NewParams(channeltypes.DefaultMaxConnectionHops, 1, channeltypes.DefaultMaxInFlightPackets))
+
+				expPacketSent = false
+			},
+			true,
+		},
+		{
+			"failure: version is invalid", func() {
+				msg.Version = "invalid-version"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			portID, err := icatypes.NewControllerPortID(TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// the interchain account address is known before the registration
+			interchainAccountAddr := icatypes.GenerateAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+			icaMsg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr.String(),
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []proto.Message{icaMsg})
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			msg = types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute.Nanoseconds()), packetData)
+			msg.Register = true
+			msg.Version = TestVersion
+
+			expPendingTx, expPacketSent = true, true
+
+			tc.malleate() // malleate mutates test data
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.SendTx(suite.chainA.GetContext(), msg)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+			if !expPendingTx {
+				suite.Require().Equal(uint64(1), res.Sequence)
+				suite.Require().Empty(res.ChannelId)
+
+				_, found := controllerKeeper.GetPendingTx(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+				suite.Require().False(found)
+				return
+			}
+
+			suite.Require().Zero(res.Sequence)
+			suite.Require().Equal(ibctesting.FirstChannelID, res.ChannelId)
+
+			pendingTx, found := controllerKeeper.GetPendingTx(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+			suite.Require().True(found)
+			expiryTimestamp := uint64(suite.chainA.GetContext().BlockTime().UnixNano()) + msg.RelativeTimeout
+			suite.Require().Equal(types.NewPendingTx(portID, path.EndpointA.ConnectionID, res.ChannelId, packetData, msg.RelativeTimeout, expiryTimestamp), pendingTx)
+
+			// commit state changes for proof verification and complete the channel handshake
+			suite.chainA.NextBlock()
+			path.EndpointA.ChannelID = res.ChannelId
+			path.EndpointA.ChannelConfig.PortID = portID
+
+			suite.Require().NoError(path.EndpointB.ChanOpenTry())
+
+			// the pending transaction is not sent before the channel is open
+			controllerKeeper.SendPendingTxs(suite.chainA.GetContext())
+			_, found = controllerKeeper.GetPendingTx(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+			suite.Require().True(found)
+			suite.Require().False(controllerKeeper.IsPendingTxReady(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID))
+
+			// the pending transaction is sent at the end of the block in which the channel opened
+			suite.Require().NoError(path.EndpointA.ChanOpenAck())
+
+			_, found = controllerKeeper.GetPendingTx(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+			suite.Require().False(found)
+
+			hasCommitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), portID, res.ChannelId, 1)
+			suite.Require().Equal(expPacketSent, hasCommitment)
+		})
+	}
+}
+
+// TestRegisterInterchainAccountDropsPendingTx tests that a transaction pending the registration of an interchain
+// account is dropped if the interchain account is registered again.
+func (suite *KeeperTestSuite) TestRegisterInterchainAccountDropsPendingTx() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	msg := types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute.Nanoseconds()), packetData)
+	msg.Register = true
+	msg.Version = TestVersion
+
+	msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+	_, err := msgServer.SendTx(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
+	_, err = msgServer.RegisterInterchainAccount(suite.chainA.GetContext(), types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, TestVersion))
+	suite.Require().NoError(err)
+
+	_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingTx(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID)
+	suite.Require().False(found)
+}
+
+// TestSendTxWithRegistrationReplacesPendingTx tests that a stale transaction pending a previous registration of the
+// interchain account is replaced by a new registration.
+func (suite *KeeperTestSuite) TestSendTxWithRegistrationReplacesPendingTx() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	msg := types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute.Nanoseconds()), packetData)
+	msg.Register = true
+	msg.Version = TestVersion
+
+	msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+	_, err := msgServer.SendTx(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
+	res, err := msgServer.SendTx(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.FormatChannelIdentifier(1), res.ChannelId)
+
+	pendingTx, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingTx(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID)
+	suite.Require().True(found)
+	suite.Require().Equal(res.ChannelId, pendingTx.ChannelId)
+}
+
+// TestDropPendingTx tests that a transaction pending the registration of an interchain account is dropped if its
+// channel is closed or does not open before the transaction expires.
+func (suite *KeeperTestSuite) TestDropPendingTx() {
+	var (
+		ctx       sdk.Context
+		path      *ibctesting.Path
+		channelID string
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expReason string
+	}{
+		{
+			"channel is closed", func() {
+				ctx = suite.chainA.GetContext()
+				err := suite.chainA.GetSimApp().ICAControllerKeeper.OnChanCloseConfirm(ctx, TestPortID, channelID)
+				suite.Require().NoError(err)
+			},
+			"channel opened by the interchain account registration was closed",
+		},
+		{
+			"channel does not open before the transaction expires", func() {
+				suite.coordinator.IncrementTimeBy(time.Minute)
+				ctx = suite.chainA.GetContext()
+				suite.chainA.GetSimApp().ICAControllerKeeper.SendPendingTxs(ctx)
+			},
+			"channel opened by the interchain account registration did not open before the pending transaction expired",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: []byte("data"),
+			}
+
+			msg := types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute.Nanoseconds()), packetData)
+			msg.Register = true
+			msg.Version = TestVersion
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.SendTx(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+			channelID = res.ChannelId
+
+			// the pending transaction has not expired yet
+			suite.chainA.GetSimApp().ICAControllerKeeper.SendPendingTxs(suite.chainA.GetContext())
+			_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingTx(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID)
+			suite.Require().True(found)
+
+			tc.malleate()
+
+			_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingTx(ctx, path.EndpointA.ConnectionID, TestPortID)
+			suite.Require().False(found)
+
+			var dropped bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != icatypes.EventTypePendingTx {
+					continue
+				}
+
+				for _, attr := range event.Attributes {
+					if string(attr.Value) == tc.expReason {
+						dropped = true
+					}
+				}
+			}
+			suite.Require().True(dropped)
+		})
+	}
+}
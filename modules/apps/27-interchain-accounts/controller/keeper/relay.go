@@ -52,8 +52,25 @@ func (k Keeper) sendTx(ctx sdk.Context, connectionID, portID string, icaPacketDa
 	return sequence, nil
 }
 
-// OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
+// OnAcknowledgementPacket invokes the OnInterchainAccountTxAcknowledged hook if hooks are set.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	if k.hooks != nil {
+		k.callHook(ctx, "OnInterchainAccountTxAcknowledged", packet.GetSourcePort(), packet.GetSourceChannel(), func(cacheCtx sdk.Context) error {
+			return k.hooks.OnInterchainAccountTxAcknowledged(cacheCtx, packet, acknowledgement)
+		})
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket invokes the OnInterchainAccountTxTimedOut hook if hooks are set. The underlying
+// channel end is closed due to the semantics of ORDERED channels.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	if k.hooks != nil {
+		k.callHook(ctx, "OnInterchainAccountTxTimedOut", packet.GetSourcePort(), packet.GetSourceChannel(), func(cacheCtx sdk.Context) error {
+			return k.hooks.OnInterchainAccountTxTimedOut(cacheCtx, packet)
+		})
+	}
+
 	return nil
 }
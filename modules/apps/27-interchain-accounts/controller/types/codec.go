@@ -3,6 +3,8 @@ package types
 import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
 // RegisterInterfaces registers the interchain accounts controller message types using the provided InterfaceRegistry
@@ -12,4 +14,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterInterchainAccount{},
 		&MsgSendTx{},
 	)
+
+	registry.RegisterImplementations(
+		(*exported.AppParams)(nil),
+		&Params{},
+	)
 }
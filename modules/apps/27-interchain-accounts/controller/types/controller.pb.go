@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -70,8 +71,98 @@ func (m *Params) GetControllerEnabled() bool {
 	return false
 }
 
+// PendingTx defines the interchain account transaction of an owner which is sent once the channel
+// opened by the implicit registration of the interchain account on the connection is open.
+type PendingTx struct {
+	PortId       string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// the channel opened by the registration
+	ChannelId  string                            `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	PacketData types.InterchainAccountPacketData `protobuf:"bytes,4,opt,name=packet_data,json=packetData,proto3" json:"packet_data" yaml:"packet_data"`
+	// the relative timeout added to the block time at which the transaction is sent
+	RelativeTimeout uint64 `protobuf:"varint,5,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty" yaml:"relative_timeout"`
+	// the block time, in unix nanoseconds, after which the transaction is dropped if the channel has not opened
+	ExpiryTimestamp uint64 `protobuf:"varint,6,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty" yaml:"expiry_timestamp"`
+}
+
+func (m *PendingTx) Reset()         { *m = PendingTx{} }
+func (m *PendingTx) String() string { return proto.CompactTextString(m) }
+func (*PendingTx) ProtoMessage()    {}
+func (*PendingTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{1}
+}
+func (m *PendingTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTx.Merge(m, src)
+}
+func (m *PendingTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTx proto.InternalMessageInfo
+
+func (m *PendingTx) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PendingTx) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *PendingTx) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingTx) GetPacketData() types.InterchainAccountPacketData {
+	if m != nil {
+		return m.PacketData
+	}
+	return types.InterchainAccountPacketData{}
+}
+
+func (m *PendingTx) GetRelativeTimeout() uint64 {
+	if m != nil {
+		return m.RelativeTimeout
+	}
+	return 0
+}
+
+func (m *PendingTx) GetExpiryTimestamp() uint64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
+	proto.RegisterType((*PendingTx)(nil), "ibc.applications.interchain_accounts.controller.v1.PendingTx")
 }
 
 func init() {
@@ -79,23 +170,38 @@ func init() {
 }
 
 var fileDescriptor_177fd0fec5eb3400 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0xce, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0xca, 0xcf, 0xc9, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0x44, 0xe2,
-	0xe9, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x19, 0x65, 0x26, 0x25, 0xeb, 0x21, 0x1b, 0xa2, 0x87,
-	0xc5, 0x10, 0x3d, 0x24, 0x6d, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xed, 0xfa,
-	0x20, 0x16, 0xc4, 0x24, 0xa5, 0x30, 0x2e, 0xb6, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x1f,
-	0x2e, 0x21, 0x84, 0x86, 0xf8, 0xd4, 0xbc, 0xc4, 0xa4, 0x9c, 0xd4, 0x14, 0x09, 0x46, 0x05, 0x46,
-	0x0d, 0x0e, 0x27, 0xd9, 0x4f, 0xf7, 0xe4, 0x25, 0x2b, 0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0xd5,
-	0x28, 0x05, 0x09, 0x22, 0x04, 0x5d, 0x21, 0x62, 0x4e, 0x59, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0x15, 0x90, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
-	0x9f, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0xac, 0x9f, 0x99, 0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x5f, 0x66,
-	0xa6, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x5a, 0x0c, 0x0a, 0xa0, 0x62, 0x7d, 0x23, 0x73, 0x5d,
-	0x84, 0xb7, 0x74, 0xb1, 0x85, 0x4d, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x2b, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x97, 0x12, 0x1b, 0x1a, 0x5b, 0x01, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x1b, 0x77, 0xad, 0x76, 0x56, 0x5d, 0x77, 0x58, 0x31, 0x56, 0x4c, 0x4a, 0x4e, 0x05,
+	0x69, 0x86, 0xd6, 0x45, 0x41, 0xf0, 0x60, 0x5c, 0x85, 0x82, 0x87, 0x12, 0x8a, 0x07, 0x2f, 0x61,
+	0x32, 0x19, 0xd2, 0xd1, 0x64, 0x26, 0x24, 0xd3, 0xb0, 0x3d, 0xfa, 0x0d, 0xfc, 0x4a, 0xde, 0xf6,
+	0xb8, 0x47, 0x4f, 0x41, 0xda, 0x6f, 0x90, 0x4f, 0x20, 0xc9, 0x64, 0x9b, 0xd2, 0xdd, 0xc3, 0xde,
+	0xe6, 0x7d, 0xde, 0xe7, 0xf7, 0xcc, 0x9f, 0xbc, 0x01, 0x9f, 0x98, 0x4f, 0x10, 0x4e, 0x92, 0x88,
+	0x11, 0x2c, 0x99, 0xe0, 0x19, 0x62, 0x5c, 0xd2, 0x94, 0x2c, 0x30, 0xe3, 0x1e, 0x26, 0x44, 0x2c,
+	0xb9, 0xcc, 0x10, 0x11, 0x5c, 0xa6, 0x22, 0x8a, 0x68, 0x8a, 0xf2, 0xf1, 0x4e, 0x65, 0x27, 0xa9,
+	0x90, 0x02, 0x4e, 0x98, 0x4f, 0xec, 0xdd, 0x10, 0xfb, 0x96, 0x10, 0x7b, 0x07, 0xcb, 0xc7, 0xfd,
+	0xd3, 0x50, 0x84, 0xa2, 0xc6, 0x51, 0xb5, 0x52, 0x49, 0xfd, 0xb3, 0x3b, 0x1d, 0x27, 0x1f, 0xa3,
+	0x04, 0x93, 0x9f, 0x54, 0x2a, 0xca, 0xfa, 0x06, 0xba, 0x33, 0x9c, 0xe2, 0x38, 0x83, 0x5f, 0x01,
+	0x6c, 0xb7, 0xf1, 0x28, 0xc7, 0x7e, 0x44, 0x03, 0x5d, 0x1b, 0x68, 0xc3, 0x87, 0xce, 0xab, 0xb2,
+	0x30, 0x5f, 0xac, 0x70, 0x1c, 0xbd, 0xb7, 0x6e, 0x7a, 0x2c, 0xf7, 0xa4, 0x15, 0x3f, 0x37, 0xda,
+	0x9f, 0x03, 0xd0, 0x9b, 0x51, 0x1e, 0x30, 0x1e, 0xce, 0x2f, 0xe0, 0x6b, 0xf0, 0x20, 0x11, 0xa9,
+	0xf4, 0x98, 0x0a, 0xec, 0x39, 0xb0, 0x2c, 0xcc, 0x27, 0x2a, 0xb0, 0x69, 0x58, 0x6e, 0xb7, 0x5a,
+	0x4d, 0x03, 0xf8, 0x01, 0x3c, 0x26, 0x82, 0x73, 0x4a, 0xaa, 0x5b, 0x54, 0xc8, 0xbd, 0x1a, 0xd1,
+	0xcb, 0xc2, 0x3c, 0xdd, 0x9e, 0xa1, 0x6d, 0x5b, 0xee, 0xa3, 0xb6, 0x9e, 0x06, 0xf0, 0x0c, 0x00,
+	0xb2, 0xc0, 0x9c, 0xd3, 0xa8, 0x62, 0x0f, 0x6a, 0xf6, 0x59, 0x59, 0x98, 0x27, 0x0d, 0xbb, 0xed,
+	0x59, 0x6e, 0xaf, 0x29, 0xa6, 0x01, 0xfc, 0xa5, 0x81, 0x23, 0xf5, 0x30, 0x5e, 0x80, 0x25, 0xd6,
+	0x0f, 0x07, 0xda, 0xf0, 0x68, 0x72, 0x6e, 0xdf, 0xe9, 0xf3, 0xe4, 0x63, 0x7b, 0xba, 0x95, 0x3f,
+	0x2a, 0x75, 0x56, 0x87, 0x9d, 0x63, 0x89, 0x9d, 0xfe, 0x65, 0x61, 0x76, 0xca, 0xc2, 0x84, 0xcd,
+	0x85, 0xdb, 0x6d, 0x2c, 0x17, 0x24, 0x5b, 0x1f, 0xfc, 0x02, 0x9e, 0xa6, 0x34, 0xc2, 0x92, 0xe5,
+	0xd4, 0x93, 0x2c, 0xa6, 0x62, 0x29, 0xf5, 0xfb, 0x03, 0x6d, 0x78, 0xe8, 0xbc, 0x2c, 0x0b, 0xf3,
+	0xb9, 0xa2, 0xf7, 0x1d, 0x96, 0x7b, 0x7c, 0x2d, 0xcd, 0x95, 0x52, 0xe5, 0xd0, 0x8b, 0x84, 0xa5,
+	0xab, 0xda, 0x93, 0x49, 0x1c, 0x27, 0x7a, 0x77, 0x3f, 0x67, 0xdf, 0x61, 0xb9, 0xc7, 0x4a, 0x9a,
+	0x5f, 0x2b, 0xce, 0x8f, 0xcb, 0xb5, 0xa1, 0x5d, 0xad, 0x0d, 0xed, 0xdf, 0xda, 0xd0, 0x7e, 0x6f,
+	0x8c, 0xce, 0xd5, 0xc6, 0xe8, 0xfc, 0xdd, 0x18, 0x9d, 0xef, 0xb3, 0x90, 0xc9, 0xc5, 0xd2, 0xb7,
+	0x89, 0x88, 0x11, 0x11, 0x59, 0x2c, 0x32, 0xc4, 0x7c, 0x32, 0x0a, 0x05, 0xca, 0xdf, 0xa2, 0x58,
+	0x04, 0xcb, 0x88, 0x66, 0xd5, 0x2c, 0x66, 0x68, 0xf2, 0x6e, 0xd4, 0xbe, 0xd8, 0xe8, 0xb6, 0xbf,
+	0x42, 0xae, 0x12, 0x9a, 0xf9, 0xdd, 0x7a, 0x1c, 0xdf, 0xfc, 0x1f, 0x00, 0x30, 0xde, 0x46, 0x8c,
+	0x55, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -131,6 +237,70 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.PacketData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintController(dAtA []byte, offset int, v uint64) int {
 	offset -= sovController(v)
 	base := offset
@@ -154,6 +324,35 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *PendingTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = m.PacketData.Size()
+	n += 1 + l + sovController(uint64(l))
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovController(uint64(m.RelativeTimeout))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovController(uint64(m.ExpiryTimestamp))
+	}
+	return n
+}
+
 func sovController(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -230,6 +429,223 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipController(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
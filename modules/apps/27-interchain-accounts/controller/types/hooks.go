@@ -0,0 +1,68 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+// ICAControllerHooks defines the hooks which may be registered with the interchain accounts controller
+// keeper to be notified of interchain account events. The port and channel identifiers are those of
+// the controller chain end of the channel.
+type ICAControllerHooks interface {
+	// OnInterchainAccountRegistered is called once the channel handshake registering a new interchain
+	// account has completed on the controller chain. The address is the interchain account address
+	// on the host chain.
+	OnInterchainAccountRegistered(ctx sdk.Context, portID, channelID, address string) error
+	// OnInterchainAccountTxAcknowledged is called when the acknowledgement of a packet sent by an
+	// interchain account is received.
+	OnInterchainAccountTxAcknowledged(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error
+	// OnInterchainAccountTxTimedOut is called when a packet sent by an interchain account times out.
+	OnInterchainAccountTxTimedOut(ctx sdk.Context, packet channeltypes.Packet) error
+}
+
+var _ ICAControllerHooks = MultiICAControllerHooks{}
+
+// MultiICAControllerHooks combines multiple interchain accounts controller hooks, all hooks are run in order.
+type MultiICAControllerHooks []ICAControllerHooks
+
+// NewMultiICAControllerHooks returns a new MultiICAControllerHooks instance containing the provided hooks.
+func NewMultiICAControllerHooks(hooks ...ICAControllerHooks) MultiICAControllerHooks {
+	return hooks
+}
+
+// OnInterchainAccountRegistered implements ICAControllerHooks. The hooks are run in order
+// and the first error returned by a hook is returned.
+func (h MultiICAControllerHooks) OnInterchainAccountRegistered(ctx sdk.Context, portID, channelID, address string) error {
+	for i := range h {
+		if err := h[i].OnInterchainAccountRegistered(ctx, portID, channelID, address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OnInterchainAccountTxAcknowledged implements ICAControllerHooks. The hooks are run in order
+// and the first error returned by a hook is returned.
+func (h MultiICAControllerHooks) OnInterchainAccountTxAcknowledged(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	for i := range h {
+		if err := h[i].OnInterchainAccountTxAcknowledged(ctx, packet, acknowledgement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OnInterchainAccountTxTimedOut implements ICAControllerHooks. The hooks are run in order
+// and the first error returned by a hook is returned.
+func (h MultiICAControllerHooks) OnInterchainAccountTxTimedOut(ctx sdk.Context, packet channeltypes.Packet) error {
+	for i := range h {
+		if err := h[i].OnInterchainAccountTxTimedOut(ctx, packet); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
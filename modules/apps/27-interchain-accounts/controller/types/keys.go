@@ -1,9 +1,44 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// SubModuleName defines the interchain accounts controller module name
 	SubModuleName = "icacontroller"
 
 	// StoreKey is the store key string for the interchain accounts controller module
 	StoreKey = SubModuleName
+
+	// PendingTxKeyPrefix defines the key prefix used to store the transactions pending the registration
+	// of an interchain account
+	PendingTxKeyPrefix = "pendingTx"
+
+	// ReadyPendingTxKeyPrefix defines the key prefix used to mark the pending transactions whose channel
+	// is open and which are sent at the end of the block
+	ReadyPendingTxKeyPrefix = "readyPendingTx"
+
+	// ExpiringPendingTxKeyPrefix defines the key prefix used to index the pending transactions by their
+	// expiry timestamp
+	ExpiringPendingTxKeyPrefix = "expiringPendingTx"
 )
+
+// KeyPendingTx creates and returns a new key used for pending transaction store operations
+func KeyPendingTx(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", PendingTxKeyPrefix, portID, connectionID))
+}
+
+// KeyReadyPendingTx creates and returns a new key used to mark a pending transaction as ready to be sent
+func KeyReadyPendingTx(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", ReadyPendingTxKeyPrefix, portID, connectionID))
+}
+
+// KeyExpiringPendingTx creates and returns a new key used to index a pending transaction by its expiry
+// timestamp. The timestamp is big endian encoded so that the keys are ordered by expiry.
+func KeyExpiringPendingTx(expiryTimestamp uint64, portID, connectionID string) []byte {
+	key := append([]byte(ExpiringPendingTxKeyPrefix+"/"), sdk.Uint64ToBigEndian(expiryTimestamp)...)
+	return append(key, []byte(fmt.Sprintf("/%s/%s", portID, connectionID))...)
+}
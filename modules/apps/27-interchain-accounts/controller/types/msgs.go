@@ -72,6 +72,10 @@ func (msg MsgSendTx) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relative timeout cannot be zero")
 	}
 
+	if !msg.Register && msg.Version != "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "version can only be provided if the interchain account is registered")
+	}
+
 	return nil
 }
 
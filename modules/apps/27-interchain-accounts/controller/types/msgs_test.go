@@ -132,6 +132,21 @@ func TestMsgSendTxValidateBasic(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"success: version is provided with registration",
+			func() {
+				msg.Register = true
+				msg.Version = icatypes.Version
+			},
+			true,
+		},
+		{
+			"version is provided without registration",
+			func() {
+				msg.Version = icatypes.Version
+			},
+			false,
+		},
 	}
 
 	for i, tc := range testCases {
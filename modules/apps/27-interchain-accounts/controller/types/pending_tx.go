@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// NewPendingTx creates a new PendingTx instance.
+func NewPendingTx(portID, connectionID, channelID string, packetData icatypes.InterchainAccountPacketData, relativeTimeout, expiryTimestamp uint64) PendingTx {
+	return PendingTx{
+		PortId:          portID,
+		ConnectionId:    connectionID,
+		ChannelId:       channelID,
+		PacketData:      packetData,
+		RelativeTimeout: relativeTimeout,
+		ExpiryTimestamp: expiryTimestamp,
+	}
+}
+
+// Validate performs basic validation of the PendingTx.
+func (ptx PendingTx) Validate() error {
+	if err := host.PortIdentifierValidator(ptx.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ConnectionIdentifierValidator(ptx.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(ptx.ChannelId); err != nil {
+		return err
+	}
+
+	if err := ptx.PacketData.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if ptx.RelativeTimeout == 0 {
+		return fmt.Errorf("relative timeout of the pending transaction on port %s cannot be zero", ptx.PortId)
+	}
+
+	if ptx.ExpiryTimestamp == 0 {
+		return fmt.Errorf("expiry timestamp of the pending transaction on port %s cannot be zero", ptx.PortId)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -127,6 +128,180 @@ func (m *QueryInterchainAccountResponse) GetAddress() string {
 	return ""
 }
 
+// QueryInterchainAccountsForOwnerRequest is the request type for the Query/InterchainAccountsForOwner RPC method.
+type QueryInterchainAccountsForOwnerRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination request over the connections of the owner
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountsForOwnerRequest) Reset() {
+	*m = QueryInterchainAccountsForOwnerRequest{}
+}
+func (m *QueryInterchainAccountsForOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountsForOwnerRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountsForOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{2}
+}
+func (m *QueryInterchainAccountsForOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountsForOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountsForOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountsForOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountsForOwnerRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountsForOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountsForOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountsForOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountsForOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountsForOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryInterchainAccountsForOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInterchainAccountsForOwnerResponse the response type for the Query/InterchainAccountsForOwner RPC method.
+type QueryInterchainAccountsForOwnerResponse struct {
+	// list of interchain accounts registered by the owner
+	InterchainAccounts []OwnerInterchainAccount `protobuf:"bytes,1,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountsForOwnerResponse) Reset() {
+	*m = QueryInterchainAccountsForOwnerResponse{}
+}
+func (m *QueryInterchainAccountsForOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountsForOwnerResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountsForOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{3}
+}
+func (m *QueryInterchainAccountsForOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountsForOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountsForOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountsForOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountsForOwnerResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountsForOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountsForOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountsForOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountsForOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountsForOwnerResponse) GetInterchainAccounts() []OwnerInterchainAccount {
+	if m != nil {
+		return m.InterchainAccounts
+	}
+	return nil
+}
+
+func (m *QueryInterchainAccountsForOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// OwnerInterchainAccount defines an interchain account registered by an owner on a given connection
+type OwnerInterchainAccount struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *OwnerInterchainAccount) Reset()         { *m = OwnerInterchainAccount{} }
+func (m *OwnerInterchainAccount) String() string { return proto.CompactTextString(m) }
+func (*OwnerInterchainAccount) ProtoMessage()    {}
+func (*OwnerInterchainAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{4}
+}
+func (m *OwnerInterchainAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerInterchainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnerInterchainAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnerInterchainAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerInterchainAccount.Merge(m, src)
+}
+func (m *OwnerInterchainAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerInterchainAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerInterchainAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerInterchainAccount proto.InternalMessageInfo
+
+func (m *OwnerInterchainAccount) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *OwnerInterchainAccount) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *OwnerInterchainAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -135,7 +310,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df0d8b259d72854e, []int{2}
+	return fileDescriptor_df0d8b259d72854e, []int{5}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,7 +349,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df0d8b259d72854e, []int{3}
+	return fileDescriptor_df0d8b259d72854e, []int{6}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,6 +388,9 @@ func (m *QueryParamsResponse) GetParams() *Params {
 func init() {
 	proto.RegisterType((*QueryInterchainAccountRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountRequest")
 	proto.RegisterType((*QueryInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountResponse")
+	proto.RegisterType((*QueryInterchainAccountsForOwnerRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountsForOwnerRequest")
+	proto.RegisterType((*QueryInterchainAccountsForOwnerResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountsForOwnerResponse")
+	proto.RegisterType((*OwnerInterchainAccount)(nil), "ibc.applications.interchain_accounts.controller.v1.OwnerInterchainAccount")
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsResponse")
 }
@@ -222,37 +400,49 @@ func init() {
 }
 
 var fileDescriptor_df0d8b259d72854e = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x8b, 0x13, 0x31,
-	0x14, 0xc7, 0x3b, 0x95, 0xad, 0x18, 0xf5, 0x60, 0xec, 0xa1, 0x14, 0x1d, 0x65, 0x4e, 0x5e, 0x9a,
-	0xb0, 0xa3, 0x28, 0x14, 0x14, 0x5c, 0x41, 0xd9, 0xdb, 0xee, 0x1c, 0x44, 0x3c, 0xb8, 0xa4, 0x99,
-	0x30, 0x1b, 0x99, 0xc9, 0x9b, 0x4d, 0x32, 0x95, 0xb2, 0xec, 0xc5, 0x4f, 0x20, 0x78, 0xf3, 0x13,
-	0x79, 0x5c, 0x10, 0xc1, 0x93, 0x48, 0xeb, 0x27, 0xf0, 0xec, 0x41, 0x26, 0x13, 0xed, 0x0e, 0xae,
-	0x62, 0xeb, 0x9e, 0x26, 0xef, 0x3d, 0xde, 0xff, 0xf7, 0x5e, 0xfe, 0x19, 0xf4, 0x40, 0x4e, 0x38,
-	0x65, 0x65, 0x99, 0x4b, 0xce, 0xac, 0x04, 0x65, 0xa8, 0x54, 0x56, 0x68, 0xbe, 0xcf, 0xa4, 0xda,
-	0x63, 0x9c, 0x43, 0xa5, 0xac, 0xa1, 0x1c, 0x94, 0xd5, 0x90, 0xe7, 0x42, 0xd3, 0xe9, 0x26, 0x3d,
-	0xa8, 0x84, 0x9e, 0x91, 0x52, 0x83, 0x05, 0x1c, 0xcb, 0x09, 0x27, 0x27, 0xfb, 0xc9, 0x29, 0xfd,
-	0x64, 0xd9, 0x4f, 0xa6, 0x9b, 0xc3, 0x47, 0x6b, 0x30, 0x4f, 0x28, 0x38, 0xf0, 0xb0, 0x9f, 0x41,
-	0x06, 0xee, 0x48, 0xeb, 0x93, 0xcf, 0x5e, 0xcb, 0x00, 0xb2, 0x5c, 0x50, 0x56, 0x4a, 0xca, 0x94,
-	0x02, 0xeb, 0x87, 0x72, 0xd5, 0xc8, 0xa2, 0xeb, 0xbb, 0xf5, 0xec, 0xdb, 0xbf, 0x70, 0x0f, 0x1b,
-	0x5a, 0x22, 0x0e, 0x2a, 0x61, 0x2c, 0xee, 0xa3, 0x0d, 0x78, 0xa5, 0x84, 0x1e, 0x04, 0x37, 0x83,
-	0x5b, 0x17, 0x92, 0x26, 0xc0, 0xf7, 0xd1, 0x65, 0x0e, 0x4a, 0x09, 0x5e, 0x6b, 0xed, 0xc9, 0x74,
-	0xd0, 0xad, 0xab, 0x5b, 0x83, 0x6f, 0x9f, 0x6f, 0xf4, 0x67, 0xac, 0xc8, 0xc7, 0x51, 0xab, 0x1c,
-	0x25, 0x97, 0x96, 0xf1, 0x76, 0x1a, 0x8d, 0x51, 0xf8, 0x27, 0xaa, 0x29, 0x41, 0x19, 0x81, 0x07,
-	0xe8, 0x3c, 0x4b, 0x53, 0x2d, 0x8c, 0xf1, 0xe0, 0x9f, 0x61, 0xd4, 0x47, 0xd8, 0xf5, 0xee, 0x30,
-	0xcd, 0x0a, 0xe3, 0xc7, 0x8c, 0x24, 0xba, 0xda, 0xca, 0x7a, 0x99, 0x04, 0xf5, 0x4a, 0x97, 0x71,
-	0x2a, 0x17, 0xe3, 0x31, 0x59, 0xdd, 0x1c, 0xe2, 0x35, 0xbd, 0x52, 0xfc, 0xfd, 0x1c, 0xda, 0x70,
-	0x2c, 0xfc, 0xae, 0x8b, 0xae, 0xfc, 0xb6, 0x02, 0xde, 0x5d, 0x87, 0xf1, 0x57, 0x13, 0x86, 0xc9,
-	0x59, 0x4a, 0x36, 0x57, 0x13, 0xbd, 0x78, 0xfd, 0xe1, 0xeb, 0xdb, 0xee, 0x33, 0xfc, 0x94, 0xfa,
-	0xb7, 0xf7, 0x2f, 0x6f, 0xce, 0xb9, 0x6f, 0xe8, 0xa1, 0xfb, 0x1e, 0xd1, 0xa5, 0xa9, 0x86, 0x1e,
-	0xb6, 0x1c, 0x3f, 0xc2, 0x1f, 0x03, 0xd4, 0x6b, 0x6e, 0x0e, 0x3f, 0x5e, 0x7b, 0xfc, 0x96, 0xc9,
-	0xc3, 0x27, 0xff, 0xad, 0xe3, 0x77, 0x1f, 0xbb, 0xdd, 0xef, 0xe0, 0x78, 0x95, 0xdd, 0x1b, 0xfb,
-	0xb7, 0x5e, 0xbe, 0x9f, 0x87, 0xc1, 0xf1, 0x3c, 0x0c, 0xbe, 0xcc, 0xc3, 0xe0, 0xcd, 0x22, 0xec,
-	0x1c, 0x2f, 0xc2, 0xce, 0xa7, 0x45, 0xd8, 0x79, 0xbe, 0x93, 0x49, 0xbb, 0x5f, 0x4d, 0x08, 0x87,
-	0x82, 0x72, 0x30, 0x05, 0x98, 0x5a, 0x7e, 0x94, 0x01, 0x9d, 0xde, 0xa5, 0x05, 0xa4, 0x55, 0x2e,
-	0x4c, 0x03, 0x8b, 0xef, 0x8d, 0x96, 0xbc, 0xd1, 0x69, 0x3c, 0x3b, 0x2b, 0x85, 0x99, 0xf4, 0xdc,
-	0x4f, 0x7a, 0xfb, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7e, 0xc3, 0x22, 0xa9, 0x93, 0x04, 0x00,
-	0x00,
+	// 672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xce, 0xa6, 0x34, 0xe5, 0x37, 0xfd, 0x29, 0x38, 0x0d, 0x12, 0x16, 0xdd, 0x94, 0x3d, 0xb4,
+	0x45, 0xe9, 0x0e, 0x59, 0x45, 0x21, 0xa0, 0x60, 0x85, 0x96, 0x7a, 0xd0, 0x74, 0x0f, 0x45, 0x2a,
+	0x58, 0x66, 0x37, 0xc3, 0x76, 0x24, 0xd9, 0xd9, 0xee, 0x4c, 0x22, 0xa5, 0xf4, 0xe2, 0xc1, 0xb3,
+	0xa0, 0x27, 0x0f, 0xfa, 0x05, 0xf4, 0x7b, 0xf4, 0x58, 0x10, 0xc1, 0x53, 0x90, 0xd6, 0x4f, 0xd0,
+	0x4f, 0x20, 0x3b, 0x33, 0x9a, 0xac, 0xd9, 0xb6, 0x26, 0xad, 0xa7, 0x9d, 0x7f, 0xef, 0xfb, 0x3c,
+	0xef, 0xf3, 0xbc, 0x33, 0x0b, 0xee, 0x53, 0x3f, 0x40, 0x38, 0x8e, 0x5b, 0x34, 0xc0, 0x82, 0xb2,
+	0x88, 0x23, 0x1a, 0x09, 0x92, 0x04, 0x5b, 0x98, 0x46, 0x9b, 0x38, 0x08, 0x58, 0x27, 0x12, 0x1c,
+	0x05, 0x2c, 0x12, 0x09, 0x6b, 0xb5, 0x48, 0x82, 0xba, 0x35, 0xb4, 0xdd, 0x21, 0xc9, 0x8e, 0x13,
+	0x27, 0x4c, 0x30, 0xe8, 0x52, 0x3f, 0x70, 0x06, 0xe3, 0x9d, 0x9c, 0x78, 0xa7, 0x1f, 0xef, 0x74,
+	0x6b, 0xe6, 0xc3, 0x31, 0x30, 0x07, 0x32, 0x48, 0x60, 0xb3, 0x1c, 0xb2, 0x90, 0xc9, 0x21, 0x4a,
+	0x47, 0x7a, 0xf5, 0x5a, 0xc8, 0x58, 0xd8, 0x22, 0x08, 0xc7, 0x14, 0xe1, 0x28, 0x62, 0x42, 0x93,
+	0x52, 0xbb, 0x37, 0x02, 0xc6, 0xdb, 0x8c, 0x23, 0x1f, 0x73, 0xa2, 0xaa, 0x40, 0xdd, 0x9a, 0x4f,
+	0x04, 0xae, 0xa1, 0x18, 0x87, 0x34, 0x92, 0x87, 0xd5, 0x59, 0x5b, 0x80, 0xeb, 0x6b, 0xe9, 0x89,
+	0xd5, 0xdf, 0xd4, 0x1e, 0x28, 0x66, 0x1e, 0xd9, 0xee, 0x10, 0x2e, 0x60, 0x19, 0x4c, 0xb2, 0x97,
+	0x11, 0x49, 0x2a, 0xc6, 0xac, 0xb1, 0xf0, 0x9f, 0xa7, 0x26, 0xf0, 0x1e, 0xb8, 0x14, 0xb0, 0x28,
+	0x22, 0x41, 0x9a, 0x6a, 0x93, 0x36, 0x2b, 0xc5, 0x74, 0x77, 0xa9, 0x72, 0xdc, 0xab, 0x96, 0x77,
+	0x70, 0xbb, 0x55, 0xb7, 0x33, 0xdb, 0xb6, 0xf7, 0x7f, 0x7f, 0xbe, 0xda, 0xb4, 0xeb, 0xc0, 0x3a,
+	0x09, 0x95, 0xc7, 0x2c, 0xe2, 0x04, 0x56, 0xc0, 0x14, 0x6e, 0x36, 0x13, 0xc2, 0xb9, 0x06, 0xfe,
+	0x35, 0xb5, 0x5f, 0x1b, 0x60, 0x2e, 0x3f, 0x98, 0x2f, 0xb3, 0xe4, 0x49, 0x4a, 0xef, 0x74, 0xee,
+	0xcb, 0x00, 0xf4, 0x65, 0x90, 0xc4, 0xa7, 0xdd, 0x39, 0x47, 0x69, 0xe6, 0xa4, 0x9a, 0x39, 0xca,
+	0x79, 0xad, 0x99, 0xd3, 0xc0, 0x21, 0xd1, 0x19, 0xbd, 0x81, 0x48, 0xfb, 0x5d, 0x11, 0xcc, 0x9f,
+	0x49, 0x44, 0x97, 0xf3, 0xd1, 0x00, 0x33, 0x39, 0xee, 0x57, 0x8c, 0xd9, 0x89, 0x85, 0x69, 0xf7,
+	0x91, 0x33, 0x7a, 0x7b, 0x39, 0x12, 0x60, 0x08, 0x7a, 0xc9, 0xde, 0xef, 0x55, 0x0b, 0xc7, 0xbd,
+	0xaa, 0xa9, 0x6c, 0xc8, 0xc9, 0x63, 0x7b, 0x90, 0x0e, 0x31, 0x86, 0x2b, 0x39, 0xaa, 0xcc, 0x9f,
+	0xa9, 0x8a, 0x2a, 0x2f, 0x23, 0xcb, 0x07, 0x03, 0x5c, 0xcd, 0xe7, 0x36, 0xdc, 0x35, 0xc6, 0x28,
+	0x5d, 0x03, 0x6f, 0x82, 0xa9, 0x98, 0x25, 0xa2, 0xdf, 0x6e, 0xf0, 0xb8, 0x57, 0xbd, 0xac, 0x02,
+	0xf5, 0x86, 0xed, 0x95, 0xd2, 0xd1, 0x6a, 0x73, 0xb0, 0x81, 0x26, 0xb2, 0x0d, 0x54, 0x06, 0x50,
+	0xda, 0xd6, 0xc0, 0x09, 0x6e, 0x73, 0xed, 0xac, 0x4d, 0xc1, 0x4c, 0x66, 0x55, 0x1b, 0xe7, 0x81,
+	0x52, 0x2c, 0x57, 0x24, 0xd7, 0x69, 0xb7, 0x3e, 0x8e, 0x55, 0x3a, 0xa7, 0xce, 0xe4, 0x7e, 0x2a,
+	0x81, 0x49, 0x89, 0x05, 0xdf, 0x17, 0xc1, 0x95, 0x61, 0x99, 0xd6, 0xc6, 0xc1, 0x38, 0xf5, 0x16,
+	0x9b, 0xde, 0x45, 0xa6, 0x54, 0xd2, 0xd8, 0xcf, 0x5f, 0x7d, 0xf9, 0xf1, 0xb6, 0xf8, 0x14, 0xae,
+	0x23, 0xfd, 0xd0, 0xfd, 0xcd, 0x03, 0x27, 0xaf, 0x20, 0x47, 0xbb, 0xf2, 0xbb, 0x87, 0xfa, 0xfe,
+	0x72, 0xb4, 0x9b, 0x31, 0x7f, 0x0f, 0x7e, 0x2e, 0x02, 0xf3, 0xe4, 0xab, 0x05, 0x37, 0x2e, 0xae,
+	0xa4, 0x3f, 0x1f, 0x0e, 0xf3, 0xd9, 0x3f, 0xc9, 0xad, 0x75, 0x5b, 0x97, 0xba, 0x35, 0xe0, 0xe3,
+	0x73, 0xe8, 0x96, 0x13, 0x00, 0xbf, 0x1a, 0xa0, 0xa4, 0x3a, 0x0d, 0x2e, 0x8f, 0xcd, 0x3f, 0x73,
+	0x29, 0xcc, 0x95, 0x73, 0xe7, 0xd1, 0x35, 0xd7, 0x65, 0xcd, 0xb7, 0xa1, 0x3b, 0x4a, 0xcd, 0xea,
+	0xba, 0x2c, 0xbd, 0xd8, 0x3f, 0xb4, 0x8c, 0x83, 0x43, 0xcb, 0xf8, 0x7e, 0x68, 0x19, 0x6f, 0x8e,
+	0xac, 0xc2, 0xc1, 0x91, 0x55, 0xf8, 0x76, 0x64, 0x15, 0x36, 0x1a, 0x21, 0x15, 0x5b, 0x1d, 0xdf,
+	0x09, 0x58, 0x1b, 0xe9, 0x7f, 0x1e, 0xf5, 0x83, 0xc5, 0x90, 0xa1, 0xee, 0x1d, 0xd4, 0x66, 0xcd,
+	0x4e, 0x8b, 0x70, 0x05, 0xe6, 0xde, 0x5d, 0xec, 0xe3, 0x2d, 0xe6, 0xe1, 0x89, 0x9d, 0x98, 0x70,
+	0xbf, 0x24, 0xff, 0x8a, 0xb7, 0x7e, 0x0e, 0x00, 0xf4, 0x06, 0x37, 0x1a, 0x30, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -269,6 +459,9 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// InterchainAccount returns the interchain account address for a given owner address on a given connection
 	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
+	// InterchainAccountsForOwner returns all interchain accounts registered by a given owner address
+	// across all connections
+	InterchainAccountsForOwner(ctx context.Context, in *QueryInterchainAccountsForOwnerRequest, opts ...grpc.CallOption) (*QueryInterchainAccountsForOwnerResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -290,6 +483,15 @@ func (c *queryClient) InterchainAccount(ctx context.Context, in *QueryInterchain
 	return out, nil
 }
 
+func (c *queryClient) InterchainAccountsForOwner(ctx context.Context, in *QueryInterchainAccountsForOwnerRequest, opts ...grpc.CallOption) (*QueryInterchainAccountsForOwnerResponse, error) {
+	out := new(QueryInterchainAccountsForOwnerResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccountsForOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/Params", in, out, opts...)
@@ -303,6 +505,9 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 type QueryServer interface {
 	// InterchainAccount returns the interchain account address for a given owner address on a given connection
 	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
+	// InterchainAccountsForOwner returns all interchain accounts registered by a given owner address
+	// across all connections
+	InterchainAccountsForOwner(context.Context, *QueryInterchainAccountsForOwnerRequest) (*QueryInterchainAccountsForOwnerResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -314,6 +519,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) InterchainAccount(ctx context.Context, req *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccount not implemented")
 }
+func (*UnimplementedQueryServer) InterchainAccountsForOwner(ctx context.Context, req *QueryInterchainAccountsForOwnerRequest) (*QueryInterchainAccountsForOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountsForOwner not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -340,6 +548,24 @@ func _Query_InterchainAccount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InterchainAccountsForOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountsForOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccountsForOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccountsForOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccountsForOwner(ctx, req.(*QueryInterchainAccountsForOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -366,6 +592,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InterchainAccount",
 			Handler:    _Query_InterchainAccount_Handler,
 		},
+		{
+			MethodName: "InterchainAccountsForOwner",
+			Handler:    _Query_InterchainAccountsForOwner_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -442,6 +672,141 @@ func (m *QueryInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInterchainAccountsForOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountsForOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountsForOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountsForOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountsForOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountsForOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InterchainAccounts) > 0 {
+		for iNdEx := len(m.InterchainAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterchainAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerInterchainAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerInterchainAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -541,36 +906,93 @@ func (m *QueryInterchainAccountResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryInterchainAccountsForOwnerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryInterchainAccountsForOwnerResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
+	if len(m.InterchainAccounts) > 0 {
+		for _, e := range m.InterchainAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (m *OwnerInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -765,6 +1187,390 @@ func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInterchainAccountsForOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountsForOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountsForOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountsForOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountsForOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountsForOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainAccounts = append(m.InterchainAccounts, OwnerInterchainAccount{})
+			if err := m.InterchainAccounts[len(m.InterchainAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerInterchainAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerInterchainAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
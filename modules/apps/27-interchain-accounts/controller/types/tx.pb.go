@@ -122,6 +122,11 @@ type MsgSendTx struct {
 	// Relative timeout timestamp provided will be added to the current block time during transaction execution.
 	// The timeout timestamp must be non-zero.
 	RelativeTimeout uint64 `protobuf:"varint,4,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty" yaml:"relative_timeout"`
+	// If register is true and the interchain account has no open active channel on the connection, the
+	// interchain account is registered and the transaction is sent once the channel is open.
+	Register bool `protobuf:"varint,5,opt,name=register,proto3" json:"register,omitempty"`
+	// The version used to register the interchain account, only valid if register is true.
+	Version string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *MsgSendTx) Reset()         { *m = MsgSendTx{} }
@@ -159,7 +164,10 @@ var xxx_messageInfo_MsgSendTx proto.InternalMessageInfo
 
 // MsgSendTxResponse defines the response for MsgSendTx
 type MsgSendTxResponse struct {
+	// the sequence of the sent packet, zero if the transaction is pending the registration
 	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the channel opened by the registration if the transaction is pending the registration
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
 }
 
 func (m *MsgSendTxResponse) Reset()         { *m = MsgSendTxResponse{} }
@@ -202,6 +210,13 @@ func (m *MsgSendTxResponse) GetSequence() uint64 {
 	return 0
 }
 
+func (m *MsgSendTxResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgRegisterInterchainAccount)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccount")
 	proto.RegisterType((*MsgRegisterInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccountResponse")
@@ -214,42 +229,43 @@ func init() {
 }
 
 var fileDescriptor_7def041328c84a30 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xed, 0x34, 0x94, 0xe6, 0x0a, 0x82, 0x5a, 0x41, 0x18, 0x83, 0xec, 0xca, 0x62, 0xe8,
-	0x92, 0x3b, 0x25, 0x54, 0x20, 0x15, 0x75, 0x20, 0x2a, 0x48, 0x19, 0x22, 0x45, 0xa6, 0x03, 0x42,
-	0x48, 0xd1, 0xe5, 0x7c, 0x72, 0x0e, 0x9c, 0x3b, 0xe3, 0xbb, 0x98, 0x76, 0x64, 0x63, 0x42, 0x6c,
-	0xac, 0xfd, 0x2b, 0xf8, 0x17, 0xe8, 0xd8, 0x91, 0x29, 0xaa, 0x92, 0x85, 0x39, 0x7f, 0x01, 0xb2,
-	0x9d, 0x38, 0x01, 0x4a, 0x55, 0x7e, 0x6d, 0x7e, 0x77, 0xf7, 0x79, 0xef, 0xfb, 0x7e, 0xf8, 0x81,
-	0x87, 0xac, 0x47, 0x10, 0x8e, 0xa2, 0x90, 0x11, 0xac, 0x98, 0xe0, 0x12, 0x31, 0xae, 0x68, 0x4c,
-	0xfa, 0x98, 0xf1, 0x2e, 0x26, 0x44, 0x0c, 0xb9, 0x92, 0x88, 0x08, 0xae, 0x62, 0x11, 0x86, 0x34,
-	0x46, 0x49, 0x1d, 0xa9, 0x03, 0x18, 0xc5, 0x42, 0x09, 0xa3, 0xc1, 0x7a, 0x04, 0x2e, 0xc3, 0xf0,
-	0x0c, 0x18, 0x2e, 0x60, 0x98, 0xd4, 0xad, 0x6a, 0x20, 0x02, 0x91, 0xe1, 0x28, 0xfd, 0xca, 0x3d,
-	0x59, 0xdb, 0x17, 0x92, 0x91, 0xd4, 0x51, 0x84, 0xc9, 0x2b, 0xaa, 0x72, 0xca, 0xfd, 0xa8, 0x83,
-	0x3b, 0x6d, 0x19, 0x78, 0x34, 0x60, 0x52, 0xd1, 0xb8, 0x55, 0x20, 0x8f, 0x72, 0xc2, 0xa8, 0x82,
-	0x4b, 0xe2, 0x0d, 0xa7, 0xb1, 0xa9, 0x6f, 0xea, 0x5b, 0x15, 0x2f, 0x37, 0x8c, 0x5d, 0x70, 0x95,
-	0x08, 0xce, 0x29, 0x49, 0x23, 0x75, 0x99, 0x6f, 0x96, 0xd2, 0xdb, 0xa6, 0x39, 0x1d, 0x39, 0xd5,
-	0x43, 0x3c, 0x08, 0x77, 0xdc, 0xef, 0xae, 0x5d, 0xef, 0xca, 0xc2, 0x6e, 0xf9, 0x86, 0x09, 0x2e,
-	0x27, 0x34, 0x96, 0x4c, 0x70, 0x73, 0x25, 0x73, 0x3b, 0x37, 0x77, 0xd6, 0xde, 0x1d, 0x39, 0xda,
-	0xd7, 0x23, 0x47, 0x73, 0x5f, 0x80, 0xbb, 0xe7, 0x09, 0xf3, 0xa8, 0x8c, 0x04, 0x97, 0xd4, 0xd8,
-	0x06, 0x80, 0xf4, 0x31, 0xe7, 0x34, 0x4c, 0x75, 0x64, 0x2a, 0x9b, 0x37, 0xa6, 0x23, 0x67, 0x63,
-	0xa6, 0xa3, 0xb8, 0x73, 0xbd, 0xca, 0xcc, 0x68, 0xf9, 0xee, 0xa7, 0x12, 0xa8, 0xb4, 0x65, 0xf0,
-	0x94, 0x72, 0x7f, 0xff, 0xe0, 0xff, 0x24, 0xf9, 0x56, 0x07, 0xeb, 0x79, 0xad, 0xbb, 0x3e, 0x56,
-	0x38, 0xcb, 0x74, 0xbd, 0xb1, 0x07, 0x2f, 0xd4, 0xf1, 0xa4, 0x0e, 0x7f, 0x4a, 0xb9, 0x93, 0x39,
-	0xdb, 0xc3, 0x0a, 0x37, 0xad, 0xe3, 0x91, 0xa3, 0x4d, 0x47, 0x8e, 0x91, 0xeb, 0x58, 0x0a, 0xe3,
-	0x7a, 0x20, 0x2a, 0xde, 0x19, 0x4f, 0xc0, 0xf5, 0x98, 0x86, 0x58, 0xb1, 0x84, 0x76, 0x15, 0x1b,
-	0x50, 0x31, 0x54, 0x66, 0x79, 0x53, 0xdf, 0x2a, 0x37, 0x6f, 0x4f, 0x47, 0xce, 0xcd, 0x9c, 0xfe,
-	0xf1, 0x85, 0xeb, 0x5d, 0x9b, 0x1f, 0xed, 0xe7, 0x27, 0x4b, 0x6d, 0x41, 0x60, 0xa3, 0xa8, 0x5b,
-	0xd1, 0x03, 0x0b, 0xac, 0x49, 0xfa, 0x7a, 0x48, 0x39, 0xa1, 0x59, 0x09, 0xcb, 0x5e, 0x61, 0x37,
-	0x4e, 0x4b, 0x60, 0xa5, 0x2d, 0x03, 0xe3, 0xb3, 0x0e, 0x6e, 0xfd, 0x7a, 0xcc, 0x3a, 0xf0, 0xf7,
-	0x7f, 0x04, 0x78, 0xde, 0x7c, 0x58, 0xcf, 0xfe, 0xb5, 0xc7, 0x22, 0xdb, 0xf7, 0x3a, 0x58, 0x9d,
-	0x0d, 0xce, 0xee, 0x1f, 0x06, 0xc9, 0x71, 0xeb, 0xf1, 0x5f, 0xe1, 0x73, 0x41, 0xcd, 0x97, 0xc7,
-	0x63, 0x5b, 0x3f, 0x19, 0xdb, 0xfa, 0xe9, 0xd8, 0xd6, 0x3f, 0x4c, 0x6c, 0xed, 0x64, 0x62, 0x6b,
-	0x5f, 0x26, 0xb6, 0xf6, 0xbc, 0x13, 0x30, 0xd5, 0x1f, 0xf6, 0x20, 0x11, 0x03, 0x44, 0x84, 0x1c,
-	0x08, 0x89, 0x58, 0x8f, 0xd4, 0x02, 0x81, 0x92, 0xfb, 0x68, 0x20, 0xfc, 0x61, 0x48, 0x65, 0xba,
-	0x34, 0x24, 0x6a, 0x3c, 0xa8, 0x2d, 0x42, 0xd7, 0xce, 0x5a, 0x5b, 0xea, 0x30, 0xa2, 0xb2, 0xb7,
-	0x9a, 0xed, 0x8d, 0x7b, 0xdf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x67, 0xf2, 0x97, 0x46, 0xf6, 0x04,
-	0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x14, 0xb7, 0xd3, 0x34, 0xdf, 0xe4, 0xfa, 0x45, 0x50, 0x2b, 0x08, 0x63, 0x90, 0x1d, 0x59, 0x0c,
+	0x59, 0xe2, 0x53, 0x42, 0x05, 0x52, 0x51, 0x07, 0xa2, 0x82, 0x94, 0x21, 0x52, 0x64, 0x3a, 0x20,
+	0x84, 0x14, 0x5d, 0xce, 0x27, 0xe7, 0xc0, 0xb9, 0x33, 0xbe, 0x8b, 0x69, 0x47, 0x36, 0x26, 0xc4,
+	0xc6, 0xda, 0xff, 0x86, 0x8e, 0x1d, 0x99, 0xa2, 0x2a, 0x59, 0x18, 0x98, 0xf2, 0x17, 0xa0, 0xd8,
+	0x89, 0x13, 0x4a, 0x5b, 0x85, 0x5f, 0x9b, 0x3f, 0x77, 0xf7, 0x79, 0xef, 0xf3, 0xde, 0xe7, 0xf9,
+	0x81, 0x47, 0xb4, 0x87, 0x21, 0x0a, 0xc3, 0x80, 0x62, 0x24, 0x29, 0x67, 0x02, 0x52, 0x26, 0x49,
+	0x84, 0xfb, 0x88, 0xb2, 0x2e, 0xc2, 0x98, 0x0f, 0x99, 0x14, 0x10, 0x73, 0x26, 0x23, 0x1e, 0x04,
+	0x24, 0x82, 0x71, 0x1d, 0xca, 0x43, 0x27, 0x8c, 0xb8, 0xe4, 0x5a, 0x83, 0xf6, 0xb0, 0xb3, 0x4a,
+	0x76, 0x2e, 0x20, 0x3b, 0x4b, 0xb2, 0x13, 0xd7, 0x8d, 0xb2, 0xcf, 0x7d, 0x9e, 0xd0, 0xe1, 0xec,
+	0x2b, 0x8d, 0x64, 0xec, 0xac, 0x25, 0x23, 0xae, 0xc3, 0x10, 0xe1, 0xd7, 0x44, 0xa6, 0x2c, 0xfb,
+	0x93, 0x0a, 0xee, 0xb6, 0x85, 0xef, 0x12, 0x9f, 0x0a, 0x49, 0xa2, 0x56, 0x46, 0x79, 0x9c, 0x32,
+	0xb4, 0x32, 0xd8, 0xe4, 0x6f, 0x19, 0x89, 0x74, 0xb5, 0xa2, 0x56, 0x4b, 0x6e, 0x0a, 0xb4, 0x3d,
+	0x70, 0x0d, 0x73, 0xc6, 0x08, 0x9e, 0x65, 0xea, 0x52, 0x4f, 0xcf, 0xcd, 0x6e, 0x9b, 0xfa, 0x74,
+	0x64, 0x95, 0x8f, 0xd0, 0x20, 0xd8, 0xb5, 0x7f, 0xb8, 0xb6, 0xdd, 0xff, 0x97, 0xb8, 0xe5, 0x69,
+	0x3a, 0xf8, 0x2f, 0x26, 0x91, 0xa0, 0x9c, 0xe9, 0x1b, 0x49, 0xd8, 0x05, 0xdc, 0x2d, 0xbe, 0x3f,
+	0xb6, 0x94, 0xaf, 0xc7, 0x96, 0x62, 0xbf, 0x04, 0xf7, 0xae, 0x12, 0xe6, 0x12, 0x11, 0x72, 0x26,
+	0x88, 0xb6, 0x03, 0x00, 0xee, 0x23, 0xc6, 0x48, 0x30, 0xd3, 0x91, 0xa8, 0x6c, 0xde, 0x9c, 0x8e,
+	0xac, 0xed, 0xb9, 0x8e, 0xec, 0xce, 0x76, 0x4b, 0x73, 0xd0, 0xf2, 0xec, 0x6f, 0x39, 0x50, 0x6a,
+	0x0b, 0xff, 0x19, 0x61, 0xde, 0xc1, 0xe1, 0xbf, 0x29, 0xf2, 0x9d, 0x0a, 0xb6, 0xd2, 0x5e, 0x77,
+	0x3d, 0x24, 0x51, 0x52, 0xe9, 0x56, 0x63, 0xdf, 0x59, 0xcb, 0xf1, 0xb8, 0xee, 0xfc, 0x54, 0x72,
+	0x27, 0x09, 0xb6, 0x8f, 0x24, 0x6a, 0x1a, 0x27, 0x23, 0x4b, 0x99, 0x8e, 0x2c, 0x2d, 0xd5, 0xb1,
+	0x92, 0xc6, 0x76, 0x41, 0x98, 0xbd, 0xd3, 0x9e, 0x82, 0x1b, 0x11, 0x09, 0x90, 0xa4, 0x31, 0xe9,
+	0x4a, 0x3a, 0x20, 0x7c, 0x28, 0xf5, 0x7c, 0x45, 0xad, 0xe6, 0x9b, 0x77, 0xa6, 0x23, 0xeb, 0x56,
+	0xca, 0x3e, 0xff, 0xc2, 0x76, 0xaf, 0x2f, 0x8e, 0x0e, 0xd2, 0x13, 0xcd, 0x00, 0xc5, 0x68, 0xee,
+	0x84, 0xbe, 0x59, 0x51, 0xab, 0x45, 0x37, 0xc3, 0xab, 0x66, 0x16, 0x2e, 0x33, 0x93, 0x80, 0xed,
+	0xac, 0xdb, 0x99, 0x73, 0x06, 0x28, 0x0a, 0xf2, 0x66, 0x48, 0x18, 0x26, 0x49, 0xe3, 0xf3, 0x6e,
+	0x86, 0xcf, 0xb9, 0x9a, 0x5b, 0xcf, 0xd5, 0xc6, 0x59, 0x0e, 0x6c, 0xb4, 0x85, 0xaf, 0x7d, 0x56,
+	0xc1, 0xed, 0xcb, 0x47, 0xba, 0xe3, 0xfc, 0xfa, 0x4f, 0xe7, 0x5c, 0x35, 0x8b, 0xc6, 0xf3, 0xbf,
+	0x1d, 0x31, 0xeb, 0xd1, 0x07, 0x15, 0x14, 0xe6, 0x43, 0xba, 0xf7, 0x9b, 0x49, 0x52, 0xba, 0xf1,
+	0xe4, 0x8f, 0xe8, 0x0b, 0x41, 0xcd, 0x57, 0x27, 0x63, 0x53, 0x3d, 0x1d, 0x9b, 0xea, 0xd9, 0xd8,
+	0x54, 0x3f, 0x4e, 0x4c, 0xe5, 0x74, 0x62, 0x2a, 0x5f, 0x26, 0xa6, 0xf2, 0xa2, 0xe3, 0x53, 0xd9,
+	0x1f, 0xf6, 0x1c, 0xcc, 0x07, 0x10, 0x73, 0x31, 0xe0, 0x02, 0xd2, 0x1e, 0xae, 0xf9, 0x1c, 0xc6,
+	0x0f, 0xe0, 0x80, 0x7b, 0xc3, 0x80, 0x88, 0xd9, 0x82, 0x12, 0xb0, 0xf1, 0xb0, 0xb6, 0x4c, 0x5d,
+	0xbb, 0x68, 0x45, 0xca, 0xa3, 0x90, 0x88, 0x5e, 0x21, 0xd9, 0x51, 0xf7, 0xbf, 0x0f, 0x00, 0xd2,
+	0xe1, 0x22, 0x77, 0x62, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -466,6 +482,23 @@ func (m *MsgSendTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Register {
+		i--
+		if m.Register {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.RelativeTimeout != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.RelativeTimeout))
 		i--
@@ -518,6 +551,13 @@ func (m *MsgSendTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
 		i--
@@ -590,6 +630,13 @@ func (m *MsgSendTx) Size() (n int) {
 	if m.RelativeTimeout != 0 {
 		n += 1 + sovTx(uint64(m.RelativeTimeout))
 	}
+	if m.Register {
+		n += 2
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -602,6 +649,10 @@ func (m *MsgSendTxResponse) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovTx(uint64(m.Sequence))
 	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -984,6 +1035,58 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Register", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Register = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1053,6 +1156,38 @@ func (m *MsgSendTxResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -44,12 +44,16 @@ func DefaultControllerGenesis() ControllerGenesisState {
 }
 
 // NewControllerGenesisState creates a returns a new ControllerGenesisState instance
-func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params) ControllerGenesisState {
+func NewControllerGenesisState(
+	channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params,
+	pendingTxs []controllertypes.PendingTx,
+) ControllerGenesisState {
 	return ControllerGenesisState{
 		ActiveChannels:     channels,
 		InterchainAccounts: accounts,
 		Ports:              ports,
 		Params:             controllerParams,
+		PendingTxs:         pendingTxs,
 	}
 }
 
@@ -85,6 +89,12 @@ func (gs ControllerGenesisState) Validate() error {
 		return err
 	}
 
+	for _, ptx := range gs.PendingTxs {
+		if err := ptx.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -97,12 +107,16 @@ func DefaultHostGenesis() HostGenesisState {
 }
 
 // NewHostGenesisState creates a returns a new HostGenesisState instance
-func NewHostGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params) HostGenesisState {
+func NewHostGenesisState(
+	channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params,
+	connectionAllowlists []hosttypes.ConnectionAllowlist,
+) HostGenesisState {
 	return HostGenesisState{
-		ActiveChannels:     channels,
-		InterchainAccounts: accounts,
-		Port:               port,
-		Params:             hostParams,
+		ActiveChannels:       channels,
+		InterchainAccounts:   accounts,
+		Port:                 port,
+		Params:               hostParams,
+		ConnectionAllowlists: connectionAllowlists,
 	}
 }
 
@@ -136,5 +150,11 @@ func (gs HostGenesisState) Validate() error {
 		return err
 	}
 
+	for _, allowlist := range gs.ConnectionAllowlists {
+		if err := allowlist.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -84,6 +84,8 @@ type ControllerGenesisState struct {
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
 	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
 	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// pending_txs contains the transactions pending the implicit registration of interchain accounts.
+	PendingTxs []types.PendingTx `protobuf:"bytes,5,rep,name=pending_txs,json=pendingTxs,proto3" json:"pending_txs" yaml:"pending_txs"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
@@ -147,12 +149,21 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 	return types.Params{}
 }
 
+func (m *ControllerGenesisState) GetPendingTxs() []types.PendingTx {
+	if m != nil {
+		return m.PendingTxs
+	}
+	return nil
+}
+
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
 	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
 	Port               string                        `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
 	Params             types1.Params                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// connection_allowlists contains the allowlists overriding the allow_messages parameter for a connection.
+	ConnectionAllowlists []types1.ConnectionAllowlist `protobuf:"bytes,5,rep,name=connection_allowlists,json=connectionAllowlists,proto3" json:"connection_allowlists" yaml:"connection_allowlists"`
 }
 
 func (m *HostGenesisState) Reset()         { *m = HostGenesisState{} }
@@ -216,6 +227,13 @@ func (m *HostGenesisState) GetParams() types1.Params {
 	return types1.Params{}
 }
 
+func (m *HostGenesisState) GetConnectionAllowlists() []types1.ConnectionAllowlist {
+	if m != nil {
+		return m.ConnectionAllowlists
+	}
+	return nil
+}
+
 // ActiveChannel contains a connection ID, port ID and associated active channel ID, as well as a boolean flag to
 // indicate if the channel is middleware enabled
 type ActiveChannel struct {
@@ -360,51 +378,55 @@ func init() {
 }
 
 var fileDescriptor_d4aa48c8e29a1947 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0xdd, 0x6a, 0xdb, 0x3c,
-	0x18, 0xc7, 0xe3, 0xa4, 0x6f, 0xdf, 0x37, 0xea, 0xc7, 0xdb, 0xa9, 0x1f, 0x78, 0xd9, 0xb0, 0x33,
-	0x9f, 0x2c, 0x30, 0x6a, 0xd3, 0xae, 0xac, 0x50, 0xe8, 0x20, 0x0e, 0xa3, 0x0b, 0xac, 0x30, 0xbc,
-	0x1d, 0x8c, 0x9d, 0x18, 0x45, 0x16, 0x8e, 0xc0, 0xb1, 0x82, 0xa5, 0x66, 0xf4, 0x0a, 0x7a, 0x3a,
-	0x76, 0x07, 0x3b, 0x1b, 0xbb, 0x80, 0x5d, 0x43, 0x8f, 0x46, 0x0f, 0x77, 0x14, 0x46, 0x7b, 0x07,
-	0xb9, 0x82, 0x21, 0x59, 0x4d, 0xd2, 0x34, 0x1d, 0xc9, 0xc9, 0x8e, 0x76, 0x64, 0x7d, 0x3c, 0xff,
-	0xff, 0xf3, 0x93, 0x1e, 0x59, 0x02, 0x87, 0xb4, 0x85, 0x3d, 0xd4, 0xed, 0x26, 0x14, 0x23, 0x41,
-	0x59, 0xca, 0x3d, 0x9a, 0x0a, 0x92, 0xe1, 0x36, 0xa2, 0x69, 0x88, 0x30, 0x66, 0x27, 0xa9, 0xe0,
-	0x5e, 0x4c, 0x52, 0xc2, 0x29, 0xf7, 0x7a, 0x3b, 0xd7, 0x4d, 0xb7, 0x9b, 0x31, 0xc1, 0xa0, 0x47,
-	0x5b, 0xd8, 0x1d, 0x97, 0xbb, 0x53, 0xe4, 0xee, 0xb5, 0xa6, 0xb7, 0x53, 0xd9, 0x88, 0x59, 0xcc,
-	0x94, 0xd6, 0x93, 0xad, 0xdc, 0xa6, 0xd2, 0x98, 0x89, 0x02, 0xb3, 0x54, 0x64, 0x2c, 0x49, 0x48,
-	0x26, 0x41, 0x46, 0x3d, 0x6d, 0xb2, 0x3f, 0x93, 0x49, 0x9b, 0x71, 0x21, 0xe5, 0xf2, 0x9b, 0x0b,
-	0x9d, 0x8b, 0x22, 0x58, 0x3e, 0xca, 0x11, 0xdf, 0x08, 0x24, 0x08, 0xfc, 0x6a, 0x00, 0x73, 0x64,
-	0x1f, 0x6a, 0xfc, 0x90, 0xcb, 0x49, 0xd3, 0xa8, 0x1a, 0xb5, 0xa5, 0xdd, 0x23, 0x77, 0xce, 0x95,
-	0xbb, 0x8d, 0xa1, 0xe1, 0x78, 0x2e, 0xff, 0xf1, 0x79, 0xdf, 0x2e, 0x0c, 0xfa, 0xb6, 0x7d, 0x8a,
-	0x3a, 0xc9, 0x81, 0x73, 0x57, 0x5a, 0x27, 0xd8, 0xc2, 0x53, 0x0d, 0xe0, 0x27, 0x03, 0x40, 0xb9,
-	0x98, 0x09, 0xcc, 0xa2, 0xc2, 0xac, 0xcf, 0x8d, 0xf9, 0x92, 0x71, 0x71, 0x03, 0xf0, 0x91, 0x06,
-	0xbc, 0x9f, 0x03, 0xde, 0x4e, 0xe5, 0x04, 0x6b, 0xed, 0x09, 0x91, 0xf3, 0xad, 0x04, 0xb6, 0xa6,
-	0x2f, 0x18, 0x9e, 0x19, 0xe0, 0x7f, 0x84, 0x05, 0xed, 0x91, 0x10, 0xb7, 0x51, 0x9a, 0x92, 0x84,
-	0x9b, 0x46, 0xb5, 0x54, 0x5b, 0xda, 0x7d, 0x3e, 0x37, 0x6c, 0x5d, 0xf9, 0x34, 0x72, 0x1b, 0xdf,
-	0xd2, 0xa4, 0x5b, 0x39, 0xe9, 0x44, 0x12, 0x27, 0x58, 0x45, 0xe3, 0xe1, 0x1c, 0x7e, 0x36, 0xc0,
-	0xfa, 0x94, 0x04, 0x66, 0x51, 0xd1, 0xbc, 0x9a, 0x9b, 0x26, 0x20, 0x31, 0xe5, 0x82, 0x64, 0x24,
-	0x6a, 0x0e, 0x03, 0xeb, 0x79, 0x9c, 0xef, 0x68, 0xb6, 0x4a, 0xce, 0x36, 0xc5, 0xc9, 0x09, 0x20,
-	0x9d, 0x94, 0x71, 0xb8, 0x01, 0xfe, 0xe9, 0xb2, 0x4c, 0x70, 0xb3, 0x54, 0x2d, 0xd5, 0xca, 0x41,
-	0xde, 0x81, 0xef, 0xc0, 0x62, 0x17, 0x65, 0xa8, 0xc3, 0xcd, 0x05, 0x55, 0xe6, 0x83, 0xd9, 0x58,
-	0xc7, 0x7e, 0x99, 0xde, 0x8e, 0xfb, 0x5a, 0x39, 0xf8, 0x0b, 0x92, 0x2c, 0xd0, 0x7e, 0xce, 0x97,
-	0x12, 0x58, 0x9b, 0x3c, 0x02, 0x7f, 0x4b, 0x36, 0x57, 0xc9, 0x20, 0x58, 0x90, 0x55, 0x32, 0x4b,
-	0x55, 0xa3, 0x56, 0x0e, 0x54, 0x1b, 0x06, 0x13, 0x05, 0xdb, 0x9b, 0x8d, 0x54, 0x5d, 0x52, 0x77,
-	0x95, 0xea, 0xac, 0x08, 0x56, 0x6e, 0xec, 0x26, 0x3c, 0x04, 0x2b, 0x98, 0xa5, 0x29, 0xc1, 0xd2,
-	0x31, 0xa4, 0x91, 0xba, 0xab, 0xca, 0xbe, 0x39, 0xe8, 0xdb, 0x1b, 0xc3, 0xeb, 0x65, 0x34, 0xed,
-	0x04, 0xcb, 0xa3, 0x7e, 0x33, 0x82, 0x4f, 0xc0, 0xbf, 0x12, 0x56, 0x0a, 0x8b, 0x4a, 0x08, 0x07,
-	0x7d, 0x7b, 0x35, 0x17, 0xea, 0x09, 0x27, 0x58, 0x94, 0xad, 0x66, 0x04, 0xf7, 0x00, 0xd0, 0x65,
-	0x92, 0xf1, 0x6a, 0xad, 0xfe, 0xe6, 0xa0, 0x6f, 0xdf, 0xd3, 0x89, 0x86, 0x73, 0x4e, 0x50, 0xd6,
-	0x9d, 0x66, 0x04, 0xdf, 0x82, 0x4d, 0xca, 0xc3, 0x0e, 0x8d, 0xa2, 0x84, 0x7c, 0x40, 0x19, 0x09,
-	0x49, 0x8a, 0x5a, 0x09, 0x89, 0xd4, 0xb6, 0xfc, 0xe7, 0x57, 0x07, 0x7d, 0xfb, 0xa1, 0xde, 0xee,
-	0x69, 0x61, 0x4e, 0xb0, 0x4e, 0xf9, 0xf1, 0x70, 0xf8, 0x85, 0x1e, 0xfd, 0x6e, 0x80, 0x07, 0xbf,
-	0xa9, 0xe4, 0x1f, 0xdd, 0x97, 0x86, 0xfc, 0x55, 0x54, 0xda, 0x10, 0x45, 0x51, 0x46, 0x38, 0xd7,
-	0x9b, 0x53, 0x19, 0x3f, 0xe6, 0x37, 0x02, 0xd4, 0x31, 0x57, 0x23, 0xf5, 0x7c, 0xc0, 0x8f, 0xcf,
-	0x2f, 0x2d, 0xe3, 0xe2, 0xd2, 0x32, 0x7e, 0x5e, 0x5a, 0xc6, 0xc7, 0x2b, 0xab, 0x70, 0x71, 0x65,
-	0x15, 0x7e, 0x5c, 0x59, 0x85, 0xf7, 0xc7, 0x31, 0x15, 0xed, 0x93, 0x96, 0x8b, 0x59, 0xc7, 0xc3,
-	0x8c, 0x77, 0x18, 0x97, 0x4f, 0xf0, 0x76, 0xcc, 0xbc, 0xde, 0x33, 0xaf, 0xc3, 0xa2, 0x93, 0x84,
-	0x70, 0xf9, 0x08, 0x72, 0x6f, 0x77, 0x7f, 0x7b, 0x74, 0xa4, 0xb6, 0x6f, 0x3d, 0xe5, 0xe2, 0xb4,
-	0x4b, 0x78, 0x6b, 0x51, 0xbd, 0x80, 0x4f, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x7a, 0xae,
-	0xa9, 0x07, 0x08, 0x00, 0x00,
+	// 768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4d, 0x6b, 0xdb, 0x48,
+	0x18, 0xb6, 0x6c, 0x27, 0xbb, 0x9e, 0x7c, 0x6c, 0x76, 0xe2, 0x04, 0xad, 0x77, 0xb1, 0xbd, 0xc3,
+	0xc2, 0x1a, 0x96, 0x48, 0x24, 0x1b, 0x36, 0x10, 0xc8, 0x82, 0x65, 0x4a, 0x6a, 0x68, 0xa0, 0xa8,
+	0x39, 0x94, 0x5e, 0x84, 0x3c, 0x1a, 0xe4, 0x01, 0x59, 0x23, 0x34, 0x13, 0x27, 0xe9, 0x1f, 0xc8,
+	0xb5, 0xf4, 0xdc, 0x4b, 0xaf, 0x3d, 0xf6, 0x4f, 0x34, 0xa7, 0x92, 0x63, 0x4f, 0xa6, 0x24, 0xff,
+	0xc0, 0xbf, 0xa0, 0xcc, 0x48, 0xfe, 0x88, 0xed, 0x14, 0xbb, 0x87, 0x9e, 0x7a, 0xd2, 0xcc, 0xe8,
+	0x7d, 0x9e, 0xf7, 0x79, 0xdf, 0x79, 0x66, 0x18, 0x70, 0x44, 0x5b, 0xd8, 0x74, 0xa3, 0x28, 0xa0,
+	0xd8, 0x15, 0x94, 0x85, 0xdc, 0xa4, 0xa1, 0x20, 0x31, 0x6e, 0xbb, 0x34, 0x74, 0x5c, 0x8c, 0xd9,
+	0x59, 0x28, 0xb8, 0xe9, 0x93, 0x90, 0x70, 0xca, 0xcd, 0xee, 0xee, 0x60, 0x68, 0x44, 0x31, 0x13,
+	0x0c, 0x9a, 0xb4, 0x85, 0x8d, 0x71, 0xb8, 0x31, 0x03, 0x6e, 0x0c, 0x30, 0xdd, 0xdd, 0x52, 0xd1,
+	0x67, 0x3e, 0x53, 0x58, 0x53, 0x8e, 0x12, 0x9a, 0x52, 0x63, 0x2e, 0x15, 0x98, 0x85, 0x22, 0x66,
+	0x41, 0x40, 0x62, 0x29, 0x64, 0x34, 0x4b, 0x49, 0x0e, 0xe6, 0x22, 0x69, 0x33, 0x2e, 0x24, 0x5c,
+	0x7e, 0x13, 0x20, 0xba, 0xc9, 0x82, 0xd5, 0xe3, 0x44, 0xe2, 0x33, 0xe1, 0x0a, 0x02, 0xdf, 0x69,
+	0x40, 0x1f, 0xd1, 0x3b, 0xa9, 0x7c, 0x87, 0xcb, 0x9f, 0xba, 0x56, 0xd5, 0x6a, 0x2b, 0x7b, 0xc7,
+	0xc6, 0x82, 0x95, 0x1b, 0x8d, 0x21, 0xe1, 0x78, 0x2e, 0xeb, 0xef, 0xeb, 0x5e, 0x25, 0xd3, 0xef,
+	0x55, 0x2a, 0x97, 0x6e, 0x27, 0x38, 0x44, 0x0f, 0xa5, 0x45, 0xf6, 0x36, 0x9e, 0x49, 0x00, 0x5f,
+	0x6b, 0x00, 0xca, 0x62, 0x26, 0x64, 0x66, 0x95, 0xcc, 0xfa, 0xc2, 0x32, 0x1f, 0x33, 0x2e, 0xee,
+	0x09, 0xfc, 0x33, 0x15, 0xf8, 0x5b, 0x22, 0x70, 0x3a, 0x15, 0xb2, 0x37, 0xda, 0x13, 0x20, 0xf4,
+	0x3e, 0x0f, 0xb6, 0x67, 0x17, 0x0c, 0xaf, 0x34, 0xf0, 0x8b, 0x8b, 0x05, 0xed, 0x12, 0x07, 0xb7,
+	0xdd, 0x30, 0x24, 0x01, 0xd7, 0xb5, 0x6a, 0xae, 0xb6, 0xb2, 0xf7, 0xff, 0xc2, 0x62, 0xeb, 0x8a,
+	0xa7, 0x91, 0xd0, 0x58, 0xe5, 0x54, 0xe9, 0x76, 0xa2, 0x74, 0x22, 0x09, 0xb2, 0xd7, 0xdd, 0xf1,
+	0x70, 0x0e, 0xdf, 0x6a, 0x60, 0x73, 0x46, 0x02, 0x3d, 0xab, 0xd4, 0x3c, 0x59, 0x58, 0x8d, 0x4d,
+	0x7c, 0xca, 0x05, 0x89, 0x89, 0xd7, 0x1c, 0x06, 0xd6, 0x93, 0x38, 0x0b, 0xa5, 0xda, 0x4a, 0x89,
+	0xb6, 0x19, 0x4c, 0xc8, 0x86, 0x74, 0x12, 0xc6, 0x61, 0x11, 0x2c, 0x45, 0x2c, 0x16, 0x5c, 0xcf,
+	0x55, 0x73, 0xb5, 0x82, 0x9d, 0x4c, 0xe0, 0x73, 0xb0, 0x1c, 0xb9, 0xb1, 0xdb, 0xe1, 0x7a, 0x5e,
+	0x6d, 0xf3, 0xe1, 0x7c, 0x5a, 0xc7, 0x8e, 0x4c, 0x77, 0xd7, 0x78, 0xaa, 0x18, 0xac, 0xbc, 0x54,
+	0x66, 0xa7, 0x7c, 0xf0, 0x25, 0x58, 0x89, 0x48, 0xe8, 0xd1, 0xd0, 0x77, 0xc4, 0x05, 0xd7, 0x97,
+	0x54, 0x2b, 0x8e, 0xbe, 0x89, 0x3e, 0xa1, 0x39, 0xbd, 0xb0, 0x4a, 0x69, 0xed, 0x30, 0xa9, 0x7d,
+	0x8c, 0x1f, 0xd9, 0x20, 0x1a, 0x84, 0x71, 0xf4, 0x21, 0x0f, 0x36, 0x26, 0xed, 0xf7, 0xc3, 0x2e,
+	0x0b, 0xd9, 0x05, 0x82, 0xbc, 0x74, 0x88, 0x9e, 0xab, 0x6a, 0xb5, 0x82, 0xad, 0xc6, 0xd0, 0x9e,
+	0x30, 0xcb, 0xfe, 0x7c, 0x4a, 0xd5, 0x05, 0xf9, 0x90, 0x4d, 0xde, 0x68, 0x60, 0x0b, 0xb3, 0x30,
+	0x24, 0x58, 0x12, 0x38, 0x6e, 0x10, 0xb0, 0xf3, 0x80, 0x72, 0x31, 0x70, 0x4c, 0x7d, 0xb1, 0x1c,
+	0x8d, 0x21, 0x55, 0x7d, 0xc0, 0x64, 0xfd, 0x95, 0xb6, 0xe0, 0x8f, 0xe1, 0xc5, 0x38, 0x9d, 0x0d,
+	0xd9, 0x45, 0x3c, 0x0d, 0xe5, 0xe8, 0x2a, 0x0b, 0xd6, 0xee, 0x6d, 0x36, 0x3c, 0x02, 0x6b, 0x63,
+	0x0c, 0xd4, 0x53, 0xd7, 0x78, 0xc1, 0xd2, 0xfb, 0xbd, 0x4a, 0x71, 0x2a, 0x01, 0xf5, 0x90, 0xbd,
+	0x3a, 0x9a, 0x37, 0x3d, 0xf8, 0x0f, 0xf8, 0x49, 0xf6, 0x52, 0x02, 0xb3, 0x0a, 0x08, 0xfb, 0xbd,
+	0xca, 0x7a, 0xea, 0xe7, 0xe4, 0x07, 0xb2, 0x97, 0xe5, 0xa8, 0xe9, 0xc1, 0x7d, 0x00, 0x52, 0x17,
+	0xc9, 0x78, 0xb5, 0x15, 0xd6, 0x56, 0xbf, 0x57, 0xf9, 0x35, 0x4d, 0x34, 0xfc, 0x87, 0xec, 0x42,
+	0x3a, 0x69, 0x7a, 0xf0, 0x14, 0x6c, 0x51, 0xee, 0x74, 0xa8, 0xe7, 0x05, 0xe4, 0xdc, 0x8d, 0x89,
+	0x43, 0x42, 0xb7, 0x15, 0x10, 0x4f, 0xed, 0xda, 0xcf, 0x56, 0x75, 0xd4, 0x8a, 0x99, 0x61, 0xc8,
+	0xde, 0xa4, 0xfc, 0x64, 0xb8, 0xfc, 0x28, 0x5d, 0xfd, 0xa8, 0x81, 0xdf, 0xbf, 0x62, 0xb4, 0xef,
+	0xda, 0x97, 0x86, 0x3c, 0xc9, 0x2a, 0xad, 0xe3, 0x7a, 0x5e, 0x4c, 0x38, 0x4f, 0x9b, 0x53, 0x1a,
+	0x3f, 0x85, 0xf7, 0x02, 0xd4, 0x29, 0x54, 0x2b, 0xf5, 0x64, 0xc1, 0xf2, 0xaf, 0x6f, 0xcb, 0xda,
+	0xcd, 0x6d, 0x59, 0xfb, 0x7c, 0x5b, 0xd6, 0x5e, 0xdd, 0x95, 0x33, 0x37, 0x77, 0xe5, 0xcc, 0xa7,
+	0xbb, 0x72, 0xe6, 0xc5, 0x89, 0x4f, 0x45, 0xfb, 0xac, 0x65, 0x60, 0xd6, 0x31, 0x31, 0xe3, 0x1d,
+	0xc6, 0xe5, 0xeb, 0x64, 0xc7, 0x67, 0x66, 0xf7, 0x3f, 0xb3, 0xc3, 0xbc, 0xb3, 0x80, 0x70, 0xf9,
+	0x3e, 0xe0, 0xe6, 0xde, 0xc1, 0xce, 0xc8, 0x8d, 0x3b, 0x53, 0xaf, 0x1c, 0x71, 0x19, 0x11, 0xde,
+	0x5a, 0x56, 0x8f, 0x83, 0x7f, 0xbf, 0x0c, 0x00, 0xda, 0xab, 0x7a, 0xb6, 0x22, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -470,6 +492,20 @@ func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingTxs) > 0 {
+		for iNdEx := len(m.PendingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -540,6 +576,20 @@ func (m *HostGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConnectionAllowlists) > 0 {
+		for iNdEx := len(m.ConnectionAllowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConnectionAllowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -736,6 +786,12 @@ func (m *ControllerGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingTxs) > 0 {
+		for _, e := range m.PendingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -763,6 +819,12 @@ func (m *HostGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ConnectionAllowlists) > 0 {
+		for _, e := range m.ConnectionAllowlists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1095,6 +1157,40 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTxs = append(m.PendingTxs, types.PendingTx{})
+			if err := m.PendingTxs[len(m.PendingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1278,6 +1374,40 @@ func (m *HostGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionAllowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionAllowlists = append(m.ConnectionAllowlists, types1.ConnectionAllowlist{})
+			if err := m.ConnectionAllowlists[len(m.ConnectionAllowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
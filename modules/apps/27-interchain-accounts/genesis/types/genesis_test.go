@@ -98,7 +98,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -112,7 +112,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -133,7 +133,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -154,7 +154,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -175,7 +175,39 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams(), nil)
+			},
+			false,
+		},
+		{
+			"failed to validate pending transactions - zero relative timeout",
+			func() {
+				packetData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+				}
+
+				pendingTxs := []controllertypes.PendingTx{
+					controllertypes.NewPendingTx(TestPortID, ibctesting.FirstConnectionID, ibctesting.FirstChannelID, packetData, 0, 1),
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), pendingTxs)
+			},
+			false,
+		},
+		{
+			"failed to validate pending transactions - zero expiry timestamp",
+			func() {
+				packetData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+				}
+
+				pendingTxs := []controllertypes.PendingTx{
+					controllertypes.NewPendingTx(TestPortID, ibctesting.FirstConnectionID, ibctesting.FirstChannelID, packetData, 1, 0),
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), pendingTxs)
 			},
 			false,
 		},
@@ -221,7 +253,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -235,7 +267,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -256,7 +288,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -277,7 +309,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -298,7 +330,40 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams(), nil)
+			},
+			false,
+		},
+		{
+			"success: with connection allowlists",
+			func() {
+				allowlists := []hosttypes.ConnectionAllowlist{
+					hosttypes.NewConnectionAllowlist(ibctesting.FirstConnectionID, []string{"/cosmos.bank.v1beta1.MsgSend"}),
+				}
+
+				genesisState = genesistypes.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.DefaultParams(), allowlists)
+			},
+			true,
+		},
+		{
+			"success: with an empty connection allowlist",
+			func() {
+				allowlists := []hosttypes.ConnectionAllowlist{
+					hosttypes.NewConnectionAllowlist(ibctesting.FirstConnectionID, nil),
+				}
+
+				genesisState = genesistypes.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.DefaultParams(), allowlists)
+			},
+			true,
+		},
+		{
+			"failed to validate connection allowlists - invalid connection identifier",
+			func() {
+				allowlists := []hosttypes.ConnectionAllowlist{
+					hosttypes.NewConnectionAllowlist("", []string{"/cosmos.bank.v1beta1.MsgSend"}),
+				}
+
+				genesisState = genesistypes.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.DefaultParams(), allowlists)
 			},
 			false,
 		},
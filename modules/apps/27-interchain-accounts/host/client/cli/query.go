@@ -47,6 +47,75 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdChannelSummary returns the command handler for the host active channels querying.
+func GetCmdChannelSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-summary",
+		Short:   "Query the active channels and bound ports of the interchain-accounts host submodule",
+		Long:    "Query the active channels of the interchain-accounts host submodule along with their channel states, the number of open and closed active channels of the requested page and the ports the host submodule is bound to",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host channel-summary", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ChannelSummary(cmd.Context(), &types.QueryChannelSummaryRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel summary")
+
+	return cmd
+}
+
+// GetCmdQueryInterchainAccount returns the command handler for the host interchain account querying.
+func GetCmdQueryInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-account [owner] [connection-id]",
+		Short:   "Query the interchain account address for a given controller owner on a particular connection",
+		Long:    "Query the host submodule for the interchain account address and active channel of a given controller owner on a particular host connection",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-account cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InterchainAccount(cmd.Context(), &types.QueryInterchainAccountRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdPacketEvents returns the command handler for the host packet events querying.
 func GetCmdPacketEvents() *cobra.Command {
 	cmd := &cobra.Command{
@@ -15,7 +15,8 @@ import (
 )
 
 const (
-	memoFlag string = "memo"
+	memoFlag         string = "memo"
+	msgGasLimitsFlag string = "msg-gas-limits"
 )
 
 func generatePacketDataCmd() *cobra.Command {
@@ -36,7 +37,7 @@ which submits pre-built packet data containing messages to be executed on the ho
             "amount": "1000"
         }
     ]
-}' --memo memo
+}' --memo memo --msg-gas-limits 200000
 
 
 %s tx interchain-accounts host generate-packet-data '[{
@@ -73,7 +74,17 @@ which submits pre-built packet data containing messages to be executed on the ho
 				return err
 			}
 
-			packetDataBytes, err := generatePacketData(cdc, []byte(args[0]), memo)
+			gasLimits, err := cmd.Flags().GetUintSlice(msgGasLimitsFlag)
+			if err != nil {
+				return err
+			}
+
+			var msgGasLimits []uint64
+			for _, gasLimit := range gasLimits {
+				msgGasLimits = append(msgGasLimits, uint64(gasLimit))
+			}
+
+			packetDataBytes, err := generatePacketData(cdc, []byte(args[0]), memo, msgGasLimits)
 			if err != nil {
 				return err
 			}
@@ -85,18 +96,23 @@ which submits pre-built packet data containing messages to be executed on the ho
 	}
 
 	cmd.Flags().String(memoFlag, "", "an optional memo to be included in the interchain account packet data")
+	cmd.Flags().UintSlice(msgGasLimitsFlag, nil, "optional comma separated gas limits of the messages, in the order of the messages, executing each message with its own gas meter")
 	return cmd
 }
 
-// generatePacketData takes in message bytes and a memo and serializes the message into an
-// instance of InterchainAccountPacketData which is returned as bytes.
-func generatePacketData(cdc *codec.ProtoCodec, msgBytes []byte, memo string) ([]byte, error) {
+// generatePacketData takes in message bytes, a memo and optional message gas limits and serializes the message
+// into an instance of InterchainAccountPacketData which is returned as bytes.
+func generatePacketData(cdc *codec.ProtoCodec, msgBytes []byte, memo string, msgGasLimits []uint64) ([]byte, error) {
 	protoMessages, err := convertBytesIntoProtoMessages(cdc, msgBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	return generateIcaPacketDataFromProtoMessages(cdc, protoMessages, memo)
+	if len(msgGasLimits) != 0 && len(msgGasLimits) != len(protoMessages) {
+		return nil, fmt.Errorf("expected %d message gas limits, got %d", len(protoMessages), len(msgGasLimits))
+	}
+
+	return generateIcaPacketDataFromProtoMessages(cdc, protoMessages, memo, msgGasLimits)
 }
 
 // convertBytesIntoProtoMessages returns a list of proto messages from bytes. The bytes can be in the form of a single
@@ -127,17 +143,19 @@ func convertBytesIntoProtoMessages(cdc *codec.ProtoCodec, msgBytes []byte) ([]pr
 	return sdkMessages, nil
 }
 
-// generateIcaPacketDataFromProtoMessages generates ica packet data as bytes from a given set of proto encoded sdk messages and a memo.
-func generateIcaPacketDataFromProtoMessages(cdc *codec.ProtoCodec, sdkMessages []proto.Message, memo string) ([]byte, error) {
+// generateIcaPacketDataFromProtoMessages generates ica packet data as bytes from a given set of proto encoded sdk messages,
+// a memo and optional message gas limits.
+func generateIcaPacketDataFromProtoMessages(cdc *codec.ProtoCodec, sdkMessages []proto.Message, memo string, msgGasLimits []uint64) ([]byte, error) {
 	icaPacketDataBytes, err := icatypes.SerializeCosmosTx(cdc, sdkMessages)
 	if err != nil {
 		return nil, err
 	}
 
 	icaPacketData := icatypes.InterchainAccountPacketData{
-		Type: icatypes.EXECUTE_TX,
-		Data: icaPacketDataBytes,
-		Memo: memo,
+		Type:         icatypes.EXECUTE_TX,
+		Data:         icaPacketDataBytes,
+		Memo:         memo,
+		MsgGasLimits: msgGasLimits,
 	}
 
 	if err := icaPacketData.ValidateBasic(); err != nil {
@@ -42,6 +42,7 @@ func TestGeneratePacketData(t *testing.T) {
 	tests := []struct {
 		name                string
 		memo                string
+		msgGasLimits        []uint64
 		expectedPass        bool
 		message             string
 		registerInterfaceFn func(registry codectypes.InterfaceRegistry)
@@ -89,6 +90,31 @@ func TestGeneratePacketData(t *testing.T) {
 			registerInterfaceFn: stakingtypes.RegisterInterfaces,
 			assertionFn:         nil,
 		},
+		{
+			name:         "packet data generation succeeds with message gas limits",
+			msgGasLimits: []uint64{200000, 100000},
+			expectedPass: true,
+			message:      multiMsg,
+			registerInterfaceFn: func(registry codectypes.InterfaceRegistry) {
+				stakingtypes.RegisterInterfaces(registry)
+				banktypes.RegisterInterfaces(registry)
+			},
+			assertionFn: nil,
+		},
+		{
+			name:                "message gas limits do not match the messages",
+			msgGasLimits:        []uint64{200000, 100000},
+			expectedPass:        false,
+			message:             msgDelegateMessage,
+			registerInterfaceFn: stakingtypes.RegisterInterfaces,
+		},
+		{
+			name:                "message gas limit is zero",
+			msgGasLimits:        []uint64{0},
+			expectedPass:        false,
+			message:             msgDelegateMessage,
+			registerInterfaceFn: stakingtypes.RegisterInterfaces,
+		},
 		{
 			name:         "invalid message string",
 			expectedPass: false,
@@ -106,7 +132,7 @@ func TestGeneratePacketData(t *testing.T) {
 		cdc := codec.NewProtoCodec(ir)
 
 		t.Run(tc.name, func(t *testing.T) {
-			bz, err := generatePacketData(cdc, []byte(tc.message), tc.memo)
+			bz, err := generatePacketData(cdc, []byte(tc.message), tc.memo, tc.msgGasLimits)
 
 			if tc.expectedPass {
 				require.NoError(t, err)
@@ -118,6 +144,7 @@ func TestGeneratePacketData(t *testing.T) {
 
 				require.Equal(t, icatypes.EXECUTE_TX, packetData.Type)
 				require.Equal(t, tc.memo, packetData.Memo)
+				require.ElementsMatch(t, tc.msgGasLimits, packetData.MsgGasLimits)
 
 				data := packetData.Data
 				messages, err := icatypes.DeserializeCosmosTx(cdc, data)
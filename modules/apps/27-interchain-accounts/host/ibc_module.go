@@ -9,9 +9,15 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
+var (
+	_ porttypes.IBCModule             = IBCModule{}
+	_ porttypes.PacketDataUnmarshaler = IBCModule{}
+)
+
 // IBCModule implements the ICS26 interface for interchain accounts host chains
 type IBCModule struct {
 	keeper keeper.Keeper
@@ -106,13 +112,18 @@ func (im IBCModule) OnRecvPacket(
 	_ sdk.AccAddress,
 ) ibcexported.Acknowledgement {
 	if !im.keeper.IsHostEnabled(ctx) {
-		return channeltypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
+		return channeltypes.NewErrorAcknowledgementWithCode(types.ErrHostSubModuleDisabled)
 	}
 
 	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
+	if err == nil && im.keeper.HasPendingPacket(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()) {
+		// NOTE: acknowledgement will be written asynchronously by the module executing the messages.
+		return nil
+	}
+
 	ack := channeltypes.NewResultAcknowledgement(txResponse)
 	if err != nil {
-		ack = channeltypes.NewErrorAcknowledgement(err)
+		ack = channeltypes.NewErrorAcknowledgementWithCode(err)
 	}
 
 	// Emit an event indicating a successful or failed acknowledgement.
@@ -140,3 +151,14 @@ func (im IBCModule) OnTimeoutPacket(
 ) error {
 	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "cannot cause a packet timeout on a host channel end, a host chain does not send a packet over the channel")
 }
+
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes
+// into an *InterchainAccountPacketData.
+func (im IBCModule) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (interface{}, error) {
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data: %s", err.Error())
+	}
+
+	return &packetData, nil
+}
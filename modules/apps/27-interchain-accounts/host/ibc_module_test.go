@@ -3,6 +3,7 @@ package host_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,8 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 
+	icahost "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host"
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 	feetypes "github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
@@ -710,3 +713,125 @@ func (suite *InterchainAccountsTestSuite) assertBalance(addr sdk.AccAddress, exp
 	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), addr, sdk.DefaultBondDenom)
 	suite.Require().Equal(expBalance[0], balance)
 }
+
+// asyncMessageRouter wraps a message router and signals that bank send messages are acknowledged asynchronously,
+// recording the identifiers of the packets executing them
+type asyncMessageRouter struct {
+	icatypes.MessageRouter
+
+	packetIDs *[]channeltypes.PacketId
+}
+
+func (r asyncMessageRouter) IsAsync(ctx sdk.Context, packetID channeltypes.PacketId, msg sdk.Msg) bool {
+	if sdk.MsgTypeURL(msg) != sdk.MsgTypeURL(&banktypes.MsgSend{}) {
+		return false
+	}
+
+	*r.packetIDs = append(*r.packetIDs, packetID)
+
+	return true
+}
+
+// TestAsyncAcknowledgement tests that no acknowledgement is written upon receipt of a packet executing a message
+// acknowledged asynchronously, that the controller chain cannot time out the packet while awaiting the
+// acknowledgement and that it accepts the acknowledgement once it is written.
+func (suite *InterchainAccountsTestSuite) TestAsyncAcknowledgement() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	var asyncPacketIDs []channeltypes.PacketId
+
+	app := suite.chainB.GetSimApp()
+	hostKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.ScopedICAHostKeeper, asyncMessageRouter{app.MsgServiceRouter(), &asyncPacketIDs},
+		app.ICAHostKeeper.GetAuthority(),
+	)
+	hostModule := icahost.NewIBCModule(hostKeeper)
+
+	startingBal := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)))
+	tokenAmt := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)))
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, startingBal)
+
+	interchainAccountAddr, found := hostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      tokenAmt,
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg})
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())
+	sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, timeoutTimestamp)
+	suite.Require().NoError(err)
+
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+
+	packet := channeltypes.NewPacket(icaPacketData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+
+	// receive the packet on the host chain using the host module with the async message router
+	proof, proofHeight := path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	err = app.IBCKeeper.ChannelKeeper.RecvPacket(suite.chainB.GetContext(), suite.chainB.GetChannelCapability(packet.GetDestPort(), packet.GetDestChannel()), packet, proof, proofHeight)
+	suite.Require().NoError(err)
+
+	ack := hostModule.OnRecvPacket(suite.chainB.GetContext(), packet, nil)
+	suite.Require().Nil(ack)
+
+	// the router is passed the identifiers of the pending packet
+	packetID := channeltypes.NewPacketID(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().Equal([]channeltypes.PacketId{packetID}, asyncPacketIDs)
+
+	suite.Require().True(hostKeeper.HasPendingPacket(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	_, found = app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// the messages are executed upon receipt
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+	suite.assertBalance(icaAddr, startingBal.Sub(tokenAmt...))
+
+	// the controller chain cannot time out the packet while the acknowledgement is pending
+	suite.coordinator.IncrementTimeBy(2 * time.Hour)
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	proof, proofHeight = path.EndpointB.QueryProof(host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel()))
+	nextSeqRecv, found := app.IBCKeeper.ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	suite.Require().True(found)
+
+	err = suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.TimeoutPacket(suite.chainA.GetContext(), packet, proof, proofHeight, nextSeqRecv)
+	suite.Require().ErrorIs(err, channeltypes.ErrPacketReceived)
+
+	// the module executing the messages writes the acknowledgement
+	asyncAck := channeltypes.NewResultAcknowledgement([]byte("async result"))
+	err = hostKeeper.WriteAcknowledgement(suite.chainB.GetContext(), asyncPacketIDs[0], asyncAck)
+	suite.Require().NoError(err)
+	suite.Require().False(hostKeeper.HasPendingPacket(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+	err = hostKeeper.WriteAcknowledgement(suite.chainB.GetContext(), asyncPacketIDs[0], asyncAck)
+	suite.Require().ErrorIs(err, types.ErrPendingPacketNotFound)
+
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	// the controller chain accepts the delayed acknowledgement
+	err = path.EndpointA.AcknowledgePacket(packet, asyncAck.Acknowledgement())
+	suite.Require().NoError(err)
+
+	commitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().Nil(commitment)
+}
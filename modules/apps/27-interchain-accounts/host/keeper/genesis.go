@@ -27,6 +27,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.HostGenesisS
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, allowlist := range state.ConnectionAllowlists {
+		keeper.SetConnectionAllowlist(ctx, allowlist)
+	}
+
 	keeper.SetParams(ctx, state.Params)
 }
 
@@ -37,5 +41,6 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.HostGenesisState
 		keeper.GetAllInterchainAccounts(ctx),
 		icatypes.HostPortID,
 		keeper.GetParams(ctx),
+		keeper.GetAllConnectionAllowlists(ctx),
 	)
 }
@@ -29,6 +29,9 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Port: icatypes.HostPortID,
+		ConnectionAllowlists: []types.ConnectionAllowlist{
+			types.NewConnectionAllowlist(ibctesting.FirstConnectionID, []string{"/cosmos.bank.v1beta1.MsgSend"}),
+		},
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper, genesisState)
@@ -44,6 +47,10 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	expParams := types.NewParams(false, nil)
 	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
+
+	allowlist, found := suite.chainA.GetSimApp().ICAHostKeeper.GetConnectionAllowlist(suite.chainA.GetContext(), ibctesting.FirstConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.ConnectionAllowlists[0], allowlist)
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -71,3 +78,28 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
 }
+
+// TestGenesisRoundTrip tests that the registered interchain accounts and connection allowlists survive a genesis export and import.
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	allowlist := types.NewConnectionAllowlist(path.EndpointB.ConnectionID, []string{"/cosmos.bank.v1beta1.MsgSend"})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetConnectionAllowlist(suite.chainB.GetContext(), allowlist)
+
+	genesisState := keeper.ExportGenesis(suite.chainB.GetContext(), suite.chainB.GetSimApp().ICAHostKeeper)
+	suite.Require().Len(genesisState.InterchainAccounts, 1)
+	suite.Require().Equal([]types.ConnectionAllowlist{allowlist}, genesisState.ConnectionAllowlists)
+
+	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper, genesisState)
+
+	exportedGenesisState := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper)
+	suite.Require().Equal(genesisState.InterchainAccounts, exportedGenesisState.InterchainAccounts)
+	suite.Require().Equal(genesisState.ActiveChannels, exportedGenesisState.ActiveChannels)
+	suite.Require().Equal(genesisState.ConnectionAllowlists, exportedGenesisState.ConnectionAllowlists)
+}
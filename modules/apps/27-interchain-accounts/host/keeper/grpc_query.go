@@ -2,10 +2,18 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -19,3 +27,100 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// ChannelSummary implements the Query/ChannelSummary gRPC method. The active channels are paginated, while
+// the numbers of open and closed channels are counted over all active channels.
+func (q Keeper) ChannelSummary(c context.Context, req *types.QueryChannelSummaryRequest) (*types.QueryChannelSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryChannelSummaryResponse{
+		Ports: q.GetAllPorts(ctx),
+	}
+
+	// active channels are keyed by the controller portID followed by the connectionID
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(fmt.Sprintf("%s/", icatypes.ActiveChannelKeyPrefix)))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		activeChannel, err := q.hostActiveChannel(ctx, key, value)
+		if err != nil {
+			return err
+		}
+
+		res.ActiveChannels = append(res.ActiveChannels, activeChannel)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		activeChannel, err := q.hostActiveChannel(ctx, iterator.Key(), iterator.Value())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		switch activeChannel.State {
+		case channeltypes.OPEN:
+			res.OpenChannels++
+		case channeltypes.CLOSED:
+			res.ClosedChannels++
+		}
+	}
+
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+// hostActiveChannel returns the active channel stored under the provided key of the active channel prefix
+// store along with the state of its channel. The state is unspecified if the channel does not exist.
+func (q Keeper) hostActiveChannel(ctx sdk.Context, key, value []byte) (types.HostActiveChannel, error) {
+	keySplit := strings.Split(string(key), "/")
+	if len(keySplit) != 2 {
+		return types.HostActiveChannel{}, fmt.Errorf("invalid active channel key: %s", key)
+	}
+
+	activeChannel := types.HostActiveChannel{
+		ConnectionId: keySplit[1],
+		PortId:       keySplit[0],
+		ChannelId:    string(value),
+	}
+
+	// the channel of an interchain account on the host chain is bound to the host port
+	if channel, found := q.channelKeeper.GetChannel(ctx, icatypes.HostPortID, activeChannel.ChannelId); found {
+		activeChannel.State = channel.State
+	}
+
+	return activeChannel, nil
+}
+
+// InterchainAccount implements the Query/InterchainAccount gRPC method
+func (q Keeper) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, found := q.GetInterchainAccountAddress(ctx, req.ConnectionId, portID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve account address for %s on connection %s", portID, req.ConnectionId)
+	}
+
+	channelID, _ := q.GetActiveChannelID(ctx, req.ConnectionId, portID)
+
+	return &types.QueryInterchainAccountResponse{
+		Address:   addr,
+		ChannelId: channelID,
+	}, nil
+}
@@ -1,9 +1,15 @@
 package keeper_test
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -12,3 +18,183 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryChannelSummary() {
+	var (
+		path   *ibctesting.Path
+		req    *types.QueryChannelSummaryRequest
+		expRes *types.QueryChannelSummaryResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: channel is closed", func() {
+				suite.Require().NoError(path.EndpointB.SetChannelClosed())
+
+				expRes.ActiveChannels[0].State = channeltypes.CLOSED
+				expRes.OpenChannels = 0
+				expRes.ClosedChannels = 1
+			}, true,
+		},
+		{
+			"success: with pagination", func() {
+				// active channel whose channel does not exist on the host port
+				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), ibctesting.FirstConnectionID, TestPortID+"2", ibctesting.InvalidID)
+
+				req.Pagination = &query.PageRequest{
+					Limit:      1,
+					CountTotal: true,
+				}
+
+				expRes.Pagination = &query.PageResponse{
+					NextKey: []byte(fmt.Sprintf("%s2/%s", TestPortID, ibctesting.FirstConnectionID)),
+					Total:   2,
+				}
+			}, true,
+		},
+		{
+			"success: channel counts cover all pages", func() {
+				// second active channel on the same open channel, which is not part of the requested page
+				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), ibctesting.FirstConnectionID, TestPortID+"2", path.EndpointB.ChannelID)
+
+				req.Pagination = &query.PageRequest{
+					Limit:      1,
+					CountTotal: true,
+				}
+
+				expRes.OpenChannels = 2
+				expRes.Pagination = &query.PageResponse{
+					NextKey: []byte(fmt.Sprintf("%s2/%s", TestPortID, ibctesting.FirstConnectionID)),
+					Total:   2,
+				}
+			}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryChannelSummaryRequest{}
+			expRes = &types.QueryChannelSummaryResponse{
+				ActiveChannels: []types.HostActiveChannel{
+					{
+						ConnectionId: path.EndpointB.ConnectionID,
+						PortId:       path.EndpointA.ChannelConfig.PortID,
+						ChannelId:    path.EndpointB.ChannelID,
+						State:        channeltypes.OPEN,
+					},
+				},
+				OpenChannels: 1,
+				Ports:        []string{icatypes.HostPortID},
+				Pagination:   &query.PageResponse{Total: 1},
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.ChannelSummary(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	var (
+		path *ibctesting.Path
+		req  *types.QueryInterchainAccountRequest
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty request", func() {
+				req = nil
+			}, false,
+		},
+		{
+			"empty owner address", func() {
+				req.Owner = ""
+			}, false,
+		},
+		{
+			"account address not found for owner", func() {
+				req.Owner = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			}, false,
+		},
+		{
+			"account address not found on connection", func() {
+				req.ConnectionId = "invalid-connection-id"
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountRequest{
+				Owner:        TestOwnerAddress,
+				ConnectionId: path.EndpointB.ConnectionID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccount(ctx, req)
+
+			if tc.expPass {
+				expAddress, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				suite.Require().NoError(err)
+				suite.Require().Equal(&types.QueryInterchainAccountResponse{
+					Address:   expAddress,
+					ChannelId: path.EndpointB.ChannelID,
+				}, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
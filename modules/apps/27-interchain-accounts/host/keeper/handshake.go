@@ -110,12 +110,25 @@ func (k Keeper) OnChanOpenConfirm(
 		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
 	}
 
+	// an active channel is only set once the handshake of a newly registered interchain account completes,
+	// it remains set when the channel is closed such that reopening the channel does not recreate the account
+	_, reopening := k.GetActiveChannelID(ctx, channel.ConnectionHops[0], channel.Counterparty.PortId)
+
 	// It is assumed the controller chain will not allow multiple active channels to be created for the same connectionID/portID
 	// If the controller chain does allow multiple active channels to be created for the same connectionID/portID,
 	// disallowing overwriting the current active channel guarantees the channel can no longer be used as the controller
 	// and host will disagree on what the currently active channel is
 	k.SetActiveChannelID(ctx, channel.ConnectionHops[0], channel.Counterparty.PortId, channelID)
 
+	if !reopening && k.hooks != nil {
+		address, found := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], channel.Counterparty.PortId)
+		if !found {
+			return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on connection %s for port %s", channel.ConnectionHops[0], channel.Counterparty.PortId)
+		}
+
+		k.onInterchainAccountCreated(ctx, portID, channelID, address)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// onInterchainAccountCreated invokes the OnInterchainAccountCreated hook using a cached context.
+// State changes made by the hook are only written if it returns without an error. A panic or an
+// error returned by the hook is logged and does not affect the interchain account or its channel.
+// Out of gas panics are propagated, since the gas limit of the transaction has been exceeded.
+func (k Keeper) onInterchainAccountCreated(ctx sdk.Context, portID, channelID, address string) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				panic(r)
+			}
+
+			k.Logger(ctx).Error("interchain account created hook panicked", "port-id", portID, "channel-id", channelID, "address", address, "panic", fmt.Sprintf("%v", r))
+		}
+	}()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.hooks.OnInterchainAccountCreated(cacheCtx, portID, channelID, address); err != nil {
+		k.Logger(ctx).Error("interchain account created hook failed", "port-id", portID, "channel-id", channelID, "address", address, "error", err.Error())
+		return
+	}
+
+	writeCache()
+}
@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+var _ types.ICAHostHooks = &mockICAHostHooks{}
+
+// mockICAHostHooks records the invocations of OnInterchainAccountCreated and runs the
+// provided callback, which may be used to write state, return an error or panic.
+type mockICAHostHooks struct {
+	calls    []string
+	callback func(ctx sdk.Context) error
+}
+
+func (h *mockICAHostHooks) OnInterchainAccountCreated(ctx sdk.Context, portID, channelID, address string) error {
+	h.calls = append(h.calls, fmt.Sprintf("%s/%s/%s", portID, channelID, address))
+
+	if h.callback != nil {
+		return h.callback(ctx)
+	}
+
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestOnInterchainAccountCreatedHooks() {
+	const (
+		hookConnectionID = "connection-hook"
+		hookPortID       = "port-hook"
+		hookChannelID    = "channel-hook"
+	)
+
+	var (
+		path      *ibctesting.Path
+		hooks     *mockICAHostHooks
+		reopen    bool
+		expCalled bool
+		expWrite  bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success", func() {
+				expCalled = true
+				expWrite = true
+			},
+		},
+		{
+			"hook returns an error", func() {
+				hooks.callback = func(ctx sdk.Context) error {
+					suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(ctx, hookConnectionID, hookPortID, hookChannelID)
+					return fmt.Errorf("hook error")
+				}
+				expCalled = true
+			},
+		},
+		{
+			"hook panics", func() {
+				hooks.callback = func(ctx sdk.Context) error {
+					suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(ctx, hookConnectionID, hookPortID, hookChannelID)
+					panic("hook panic")
+				}
+				expCalled = true
+			},
+		},
+		{
+			"hook is not invoked when the active channel is already set", func() {
+				reopen = true
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			reopen = false
+			expCalled = false
+			expWrite = false
+
+			hooks = &mockICAHostHooks{
+				callback: func(ctx sdk.Context) error {
+					suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(ctx, hookConnectionID, hookPortID, hookChannelID)
+					return nil
+				},
+			}
+			secondHooks := &mockICAHostHooks{}
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ChanOpenTry()
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.ChanOpenAck()
+			suite.Require().NoError(err)
+
+			tc.malleate() // malleate mutates test data
+
+			hostKeeper := &suite.chainB.GetSimApp().ICAHostKeeper
+			if reopen {
+				err = hostKeeper.OnChanOpenConfirm(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				suite.Require().NoError(err)
+			}
+
+			hostKeeper.SetHooks(types.NewMultiICAHostHooks(hooks, secondHooks))
+
+			err = hostKeeper.OnChanOpenConfirm(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().NoError(err)
+
+			// the handshake is unaffected by the hooks
+			activeChannelID, found := hostKeeper.GetActiveChannelID(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+			suite.Require().Equal(path.EndpointB.ChannelID, activeChannelID)
+
+			if expCalled {
+				address, found := hostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				expCall := fmt.Sprintf("%s/%s/%s", path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, address)
+				suite.Require().Equal([]string{expCall}, hooks.calls)
+
+				if expWrite {
+					// hooks are chained
+					suite.Require().Equal([]string{expCall}, secondHooks.calls)
+				} else {
+					suite.Require().Empty(secondHooks.calls)
+				}
+			} else {
+				suite.Require().Empty(hooks.calls)
+				suite.Require().Empty(secondHooks.calls)
+			}
+
+			_, found = hostKeeper.GetActiveChannelID(suite.chainB.GetContext(), hookConnectionID, hookPortID)
+			suite.Require().Equal(expWrite, found)
+		})
+	}
+}
+
+// TestOnInterchainAccountCreatedHooksOutOfGas tests that an out of gas panic of the hook is not
+// recovered, since the gas limit of the transaction has been exceeded.
+func (suite *KeeperTestSuite) TestOnInterchainAccountCreatedHooksOutOfGas() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.ChanOpenTry()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.ChanOpenAck()
+	suite.Require().NoError(err)
+
+	hostKeeper := &suite.chainB.GetSimApp().ICAHostKeeper
+	hostKeeper.SetHooks(&mockICAHostHooks{
+		callback: func(ctx sdk.Context) error {
+			panic(sdk.ErrorOutOfGas{Descriptor: "hook"})
+		},
+	})
+
+	suite.Require().PanicsWithValue(sdk.ErrorOutOfGas{Descriptor: "hook"}, func() {
+		_ = hostKeeper.OnChanOpenConfirm(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	})
+}
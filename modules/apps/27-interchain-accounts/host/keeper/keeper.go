@@ -34,6 +34,11 @@ type Keeper struct {
 	scopedKeeper exported.ScopedKeeper
 
 	msgRouter icatypes.MessageRouter
+
+	hooks types.ICAHostHooks
+
+	// the address capable of executing a MsgSetConnectionAllowlist message. Typically, this should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new interchain accounts host Keeper instance
@@ -41,6 +46,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
 	accountKeeper icatypes.AccountKeeper, scopedKeeper exported.ScopedKeeper, msgRouter icatypes.MessageRouter,
+	authority string,
 ) Keeper {
 	// ensure ibc interchain accounts module account is set
 	if addr := accountKeeper.GetModuleAddress(icatypes.ModuleName); addr == nil {
@@ -62,7 +68,25 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		scopedKeeper:  scopedKeeper,
 		msgRouter:     msgRouter,
+		authority:     authority,
+	}
+}
+
+// SetHooks sets the interchain accounts host hooks. It panics if the hooks have already been set.
+// Multiple hooks may be registered by combining them with types.NewMultiICAHostHooks.
+func (k *Keeper) SetHooks(hooks types.ICAHostHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set interchain accounts host hooks twice")
 	}
+
+	k.hooks = hooks
+
+	return k
+}
+
+// GetAuthority returns the address capable of executing a MsgSetConnectionAllowlist message
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
 // Logger returns the application logger, scoped to the associated module
@@ -70,6 +94,22 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, icatypes.ModuleName))
 }
 
+// GetAllPorts returns all ports to which the interchain accounts host module is bound
+func (k Keeper) GetAllPorts(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.PortKeyPrefix))
+	defer iterator.Close()
+
+	var ports []string
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		ports = append(ports, keySplit[1])
+	}
+
+	return ports
+}
+
 // BindPort stores the provided portID and binds to it, returning the associated capability
 func (k Keeper) BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability {
 	store := ctx.KVStore(k.storeKey)
@@ -199,3 +239,92 @@ func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portI
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyOwnerAccount(portID, connectionID), []byte(address))
 }
+
+// GetPendingPacket retrieves the packet awaiting an asynchronous acknowledgement from the store, keyed by the
+// provided host portID, channelID and packet sequence
+func (k Keeper) GetPendingPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPendingPacket(portID, channelID, sequence))
+	if bz == nil {
+		return channeltypes.Packet{}, false
+	}
+
+	var packet channeltypes.Packet
+	k.cdc.MustUnmarshal(bz, &packet)
+
+	return packet, true
+}
+
+// HasPendingPacket returns true if a packet awaiting an asynchronous acknowledgement exists for the provided
+// host portID, channelID and packet sequence
+func (k Keeper) HasPendingPacket(ctx sdk.Context, portID, channelID string, sequence uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyPendingPacket(portID, channelID, sequence))
+}
+
+// SetPendingPacket stores a packet awaiting an asynchronous acknowledgement, keyed by its destination portID,
+// channelID and sequence
+func (k Keeper) SetPendingPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPendingPacket(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()), k.cdc.MustMarshal(&packet))
+}
+
+// DeletePendingPacket removes the packet awaiting an asynchronous acknowledgement from the store
+func (k Keeper) DeletePendingPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingPacket(portID, channelID, sequence))
+}
+
+// GetConnectionAllowlist retrieves the allowlist overriding the host allowlist for the provided connectionID
+func (k Keeper) GetConnectionAllowlist(ctx sdk.Context, connectionID string) (types.ConnectionAllowlist, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyConnectionAllowlist(connectionID))
+	if bz == nil {
+		return types.ConnectionAllowlist{}, false
+	}
+
+	var allowlist types.ConnectionAllowlist
+	k.cdc.MustUnmarshal(bz, &allowlist)
+
+	return allowlist, true
+}
+
+// GetAllConnectionAllowlists returns the allowlists of all connections overriding the host allowlist
+func (k Keeper) GetAllConnectionAllowlists(ctx sdk.Context) []types.ConnectionAllowlist {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.ConnectionAllowlistKeyPrefix))
+	defer iterator.Close()
+
+	var allowlists []types.ConnectionAllowlist
+	for ; iterator.Valid(); iterator.Next() {
+		var allowlist types.ConnectionAllowlist
+		k.cdc.MustUnmarshal(iterator.Value(), &allowlist)
+
+		allowlists = append(allowlists, allowlist)
+	}
+
+	return allowlists
+}
+
+// SetConnectionAllowlist stores the allowlist overriding the host allowlist, keyed by its connectionID
+func (k Keeper) SetConnectionAllowlist(ctx sdk.Context, allowlist types.ConnectionAllowlist) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyConnectionAllowlist(allowlist.ConnectionId), k.cdc.MustMarshal(&allowlist))
+}
+
+// DeleteConnectionAllowlist removes the allowlist of the provided connectionID, reverting to the host allowlist
+func (k Keeper) DeleteConnectionAllowlist(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyConnectionAllowlist(connectionID))
+}
+
+// GetAllowMessagesForConnection returns the sdk message typeURLs allowed to be executed by interchain accounts
+// registered over the provided connectionID. The allowlist of the connection is returned if one is set,
+// otherwise the host allowlist applies.
+func (k Keeper) GetAllowMessagesForConnection(ctx sdk.Context, connectionID string) []string {
+	if allowlist, found := k.GetConnectionAllowlist(ctx, connectionID); found {
+		return allowlist.AllowMessages
+	}
+
+	return k.GetAllowMessages(ctx)
+}
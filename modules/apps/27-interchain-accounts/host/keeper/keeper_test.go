@@ -6,7 +6,9 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	genesistypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/genesis/types"
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 )
@@ -235,3 +237,47 @@ func (suite *KeeperTestSuite) TestSetInterchainAccountAddress() {
 	suite.Require().True(found)
 	suite.Require().Equal(expectedAccAddr, retrievedAddr)
 }
+
+func (suite *KeeperTestSuite) TestPendingPacket() {
+	packet := channeltypes.NewPacket([]byte("data"), 1, TestPortID, ibctesting.FirstChannelID, icatypes.HostPortID, ibctesting.FirstChannelID, clienttypes.ZeroHeight(), 1)
+
+	suite.Require().False(suite.chainB.GetSimApp().ICAHostKeeper.HasPendingPacket(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+	suite.chainB.GetSimApp().ICAHostKeeper.SetPendingPacket(suite.chainB.GetContext(), packet)
+
+	retrievedPacket, found := suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacket(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(packet, retrievedPacket)
+
+	suite.chainB.GetSimApp().ICAHostKeeper.DeletePendingPacket(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+	_, found = suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacket(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestConnectionAllowlist() {
+	ctx := suite.chainB.GetContext()
+	hostKeeper := suite.chainB.GetSimApp().ICAHostKeeper
+
+	_, found := hostKeeper.GetConnectionAllowlist(ctx, ibctesting.FirstConnectionID)
+	suite.Require().False(found)
+	suite.Require().Equal(hostKeeper.GetAllowMessages(ctx), hostKeeper.GetAllowMessagesForConnection(ctx, ibctesting.FirstConnectionID))
+
+	allowlist := types.NewConnectionAllowlist(ibctesting.FirstConnectionID, []string{"/cosmos.gov.v1beta1.MsgSubmitProposal"})
+	hostKeeper.SetConnectionAllowlist(ctx, allowlist)
+
+	retrievedAllowlist, found := hostKeeper.GetConnectionAllowlist(ctx, ibctesting.FirstConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(allowlist, retrievedAllowlist)
+	suite.Require().Equal(allowlist.AllowMessages, hostKeeper.GetAllowMessagesForConnection(ctx, ibctesting.FirstConnectionID))
+	suite.Require().Equal([]types.ConnectionAllowlist{allowlist}, hostKeeper.GetAllConnectionAllowlists(ctx))
+
+	// the allowlist of other connections is unaffected
+	suite.Require().Equal(hostKeeper.GetAllowMessages(ctx), hostKeeper.GetAllowMessagesForConnection(ctx, "connection-1"))
+
+	hostKeeper.DeleteConnectionAllowlist(ctx, ibctesting.FirstConnectionID)
+
+	_, found = hostKeeper.GetConnectionAllowlist(ctx, ibctesting.FirstConnectionID)
+	suite.Require().False(found)
+	suite.Require().Empty(hostKeeper.GetAllConnectionAllowlists(ctx))
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ICS27 host MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// SetConnectionAllowlist defines a rpc handler for MsgSetConnectionAllowlist. It sets the allowlist overriding
+// the host allowlist for the connection, or removes it if Remove is true. An empty allowlist denies all messages.
+func (s msgServer) SetConnectionAllowlist(goCtx context.Context, msg *types.MsgSetConnectionAllowlist) (*types.MsgSetConnectionAllowlistResponse, error) {
+	if s.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", s.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Remove {
+		s.DeleteConnectionAllowlist(ctx, msg.ConnectionId)
+	} else {
+		s.Keeper.SetConnectionAllowlist(ctx, types.NewConnectionAllowlist(msg.ConnectionId, msg.AllowMessages))
+	}
+
+	s.Logger(ctx).Info("interchain accounts host connection allowlist updated", "connection-id", msg.ConnectionId, "allow-messages", msg.AllowMessages, "remove", msg.Remove)
+
+	return &types.MsgSetConnectionAllowlistResponse{}, nil
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+func (suite *KeeperTestSuite) TestSetConnectionAllowlist() {
+	var (
+		msg          *types.MsgSetConnectionAllowlist
+		expAllowlist bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: connection allowlist is set",
+			func() {},
+			true,
+		},
+		{
+			"success: connection allowlist is removed",
+			func() {
+				allowlist := types.NewConnectionAllowlist(ibctesting.FirstConnectionID, []string{"/cosmos.staking.v1beta1.MsgDelegate"})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetConnectionAllowlist(suite.chainB.GetContext(), allowlist)
+
+				msg.AllowMessages = nil
+				msg.Remove = true
+				expAllowlist = false
+			},
+			true,
+		},
+		{
+			"success: empty connection allowlist is set",
+			func() {
+				msg.AllowMessages = nil
+			},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = ibctesting.TestAccAddress
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			expAllowlist = true
+
+			msg = types.NewMsgSetConnectionAllowlist(
+				suite.chainB.GetSimApp().ICAHostKeeper.GetAuthority(),
+				ibctesting.FirstConnectionID,
+				[]string{"/cosmos.gov.v1beta1.MsgSubmitProposal"},
+				false,
+			)
+
+			tc.malleate()
+
+			hostKeeper := suite.chainB.GetSimApp().ICAHostKeeper
+			res, err := keeper.NewMsgServerImpl(&hostKeeper).SetConnectionAllowlist(sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				allowlist, found := suite.chainB.GetSimApp().ICAHostKeeper.GetConnectionAllowlist(suite.chainB.GetContext(), ibctesting.FirstConnectionID)
+				suite.Require().Equal(expAllowlist, found)
+				if expAllowlist {
+					suite.Require().Equal(msg.AllowMessages, allowlist.AllowMessages)
+				}
+			} else {
+				suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
 // IsHostEnabled retrieves the host enabled boolean from the paramstore.
@@ -21,9 +22,27 @@ func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
 	return res
 }
 
+// GetMsgFieldRules retrieves the host message field rules from the paramstore.
+// No rules are returned if the parameter has not been set.
+func (k Keeper) GetMsgFieldRules(ctx sdk.Context) []types.MsgFieldRule {
+	var res []types.MsgFieldRule
+	k.paramSpace.GetIfExists(ctx, types.KeyMsgFieldRules, &res)
+	return res
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	params := types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	params.MsgFieldRules = k.GetMsgFieldRules(ctx)
+
+	return params
+}
+
+// GetAppParams returns the total set of host submodule parameters. It is used by the IBCParams query
+// of core IBC.
+func (k Keeper) GetAppParams(ctx sdk.Context) exported.AppParams {
+	params := k.GetParams(ctx)
+	return &params
 }
 
 // SetParams sets the total set of the host submodule parameters.
@@ -9,10 +9,14 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
 // If the transaction is successfully executed, the transaction response bytes will be returned.
+// If the message router signals that any of the executed messages is acknowledged asynchronously,
+// the packet is stored as pending until its acknowledgement is written using WriteAcknowledgement.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
 	var data icatypes.InterchainAccountPacketData
 
@@ -28,11 +32,15 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 			return nil, err
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		txResponse, async, err := k.executeTx(ctx, packet, msgs, data.MsgGasLimits)
 		if err != nil {
 			return nil, err
 		}
 
+		if async {
+			k.SetPendingPacket(ctx, packet)
+		}
+
 		return txResponse, nil
 	default:
 		return nil, icatypes.ErrUnknownDataType
@@ -43,14 +51,22 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
 // into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
 // execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
-	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+// If message gas limits are provided, each message is executed with its own gas meter limited to its gas limit
+// and the results of the messages are returned as a CosmosTxResult instead of a TxMsgData.
+// The returned boolean indicates whether the acknowledgement is written asynchronously.
+func (k Keeper) executeTx(ctx sdk.Context, packet channeltypes.Packet, msgs []sdk.Msg, msgGasLimits []uint64) ([]byte, bool, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
 	if !found {
-		return nil, channeltypes.ErrChannelNotFound
+		return nil, false, channeltypes.ErrChannelNotFound
 	}
 
-	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
-		return nil, err
+	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], packet.GetSourcePort()); err != nil {
+		return nil, false, err
+	}
+
+	packetID := channeltypes.NewPacketID(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	if len(msgGasLimits) != 0 {
+		return k.executeTxWithGasLimits(ctx, packetID, msgs, msgGasLimits)
 	}
 
 	txMsgData := &sdk.TxMsgData{
@@ -59,44 +75,173 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 
 	// CacheContext returns a new context with the multi-store branched into a cached storage object
 	// writeCache is called only if all msgs succeed, performing state transitions atomically
+	var async bool
 	cacheCtx, writeCache := ctx.CacheContext()
 	for i, msg := range msgs {
 		if err := msg.ValidateBasic(); err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		any, err := k.executeMsg(cacheCtx, msg)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		txMsgData.MsgResponses[i] = any
+
+		if router, ok := k.msgRouter.(types.AsyncMessageRouter); ok && router.IsAsync(cacheCtx, packetID, msg) {
+			async = true
+		}
 	}
 
 	writeCache()
 
 	txResponse, err := proto.Marshal(txMsgData)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
+		return nil, false, sdkerrors.Wrap(err, "failed to marshal tx data")
 	}
 
-	return txResponse, nil
+	return txResponse, async, nil
+}
+
+// executeTxWithGasLimits executes the provided authenticated messages, each in its own branch of the cached
+// context with a gas meter limited to the gas limit of the message, or to the gas remaining to the transaction
+// if lower. A message exceeding its gas limit fails without consuming the gas of the messages following it. The
+// gas consumed by each message is charged to the gas meter of the provided context. A message running out of the
+// gas remaining to the transaction rather than of its own gas limit panics with an out of gas error, failing the
+// transaction instead of the packet. The state changes will only be committed if all messages succeed.
+func (k Keeper) executeTxWithGasLimits(ctx sdk.Context, packetID channeltypes.PacketId, msgs []sdk.Msg, msgGasLimits []uint64) ([]byte, bool, error) {
+	if len(msgGasLimits) != len(msgs) {
+		return nil, false, sdkerrors.Wrapf(icatypes.ErrInvalidMsgGasLimits, "expected %d message gas limits, got %d", len(msgs), len(msgGasLimits))
+	}
+
+	txResult := &icatypes.CosmosTxResult{
+		MsgResults: make([]*icatypes.MsgResult, len(msgs)),
+	}
+
+	var async bool
+	cacheCtx, writeCache := ctx.CacheContext()
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, false, err
+		}
+
+		if msgGasLimits[i] == 0 {
+			return nil, false, sdkerrors.Wrapf(icatypes.ErrInvalidMsgGasLimits, "gas limit of message %d cannot be zero", i)
+		}
+
+		msgCtx, writeMsgCache := cacheCtx.CacheContext()
+
+		gasLimit := msgGasLimits[i]
+		txGasLimited := ctx.GasMeter().GasRemaining() < gasLimit
+		if txGasLimited {
+			gasLimit = ctx.GasMeter().GasRemaining()
+		}
+
+		msgCtx = msgCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+		any, err := k.executeMsgWithGasMeter(msgCtx, msg)
+		if txGasLimited && msgCtx.GasMeter().IsPastLimit() {
+			panic(sdk.ErrorOutOfGas{Descriptor: "interchain account message"})
+		}
+
+		// the gas consumed by the message is charged even if the message fails
+		ctx.GasMeter().ConsumeGas(msgCtx.GasMeter().GasConsumedToLimit(), "interchain account message")
+
+		if err != nil {
+			return nil, false, sdkerrors.Wrapf(err, "message %d failed", i)
+		}
+
+		writeMsgCache()
+
+		txResult.MsgResults[i] = &icatypes.MsgResult{
+			MsgResponse: any,
+			GasUsed:     msgCtx.GasMeter().GasConsumed(),
+		}
+
+		if router, ok := k.msgRouter.(types.AsyncMessageRouter); ok && router.IsAsync(cacheCtx, packetID, msg) {
+			async = true
+		}
+	}
+
+	writeCache()
+
+	txResponse, err := proto.Marshal(txResult)
+	if err != nil {
+		return nil, false, sdkerrors.Wrap(err, "failed to marshal tx result")
+	}
+
+	return txResponse, async, nil
+}
+
+// executeMsgWithGasMeter executes the provided message and recovers from the message running out of the gas
+// of the gas meter of the provided context, returning an out of gas error instead.
+func (k Keeper) executeMsgWithGasMeter(ctx sdk.Context, msg sdk.Msg) (any *codectypes.Any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s; gas limit: %d", outOfGas.Descriptor, ctx.GasMeter().Limit())
+		}
+	}()
+
+	return k.executeMsg(ctx, msg)
+}
+
+// WriteAcknowledgement writes the acknowledgement of a pending packet whose executed messages are acknowledged
+// asynchronously, as signalled by an AsyncMessageRouter. It is expected to be called by the module executing
+// the messages with the packet identifiers passed to AsyncMessageRouter.IsAsync, which hold the destination
+// port, channel and sequence of the packet. An error is returned if no pending packet exists for the provided
+// packet identifiers.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, packetID channeltypes.PacketId, ack exported.Acknowledgement) error {
+	portID, channelID, sequence := packetID.PortId, packetID.ChannelId, packetID.Sequence
+
+	packet, found := k.GetPendingPacket(ctx, portID, channelID, sequence)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrPendingPacketNotFound, "port ID (%s) channel ID (%s) sequence (%d)", portID, channelID, sequence)
+	}
+
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack); err != nil {
+		return err
+	}
+
+	k.DeletePendingPacket(ctx, portID, channelID, sequence)
+
+	EmitAcknowledgementEvent(ctx, packet, ack, nil)
+
+	return nil
 }
 
 // authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
-// from state using the provided controller port identifier
+// from state using the provided controller port identifier, and that the msgs are allowed to be executed
+// according to the connection or host allowlist and the message field rules
 func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
 	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
 	if !found {
 		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
 	}
 
-	allowMsgs := k.GetAllowMessages(ctx)
+	allowMsgs := k.GetAllowMessagesForConnection(ctx, connectionID)
+	msgFieldRules := k.GetMsgFieldRules(ctx)
 	for _, msg := range msgs {
 		if !types.ContainsMsgType(allowMsgs, msg) {
 			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
 		}
 
+		if rule, found := types.GetMsgFieldRule(msgFieldRules, msg); found {
+			if err := rule.ValidateMsg(msg); err != nil {
+				return err
+			}
+		}
+
 		for _, signer := range msg.GetSigners() {
 			if interchainAccountAddr != signer.String() {
 				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", interchainAccountAddr, signer.String())
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -401,6 +402,163 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			false,
 		},
+		{
+			"interchain account successfully executes banktypes.MsgSend satisfying the message field rules",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg})
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params.MsgFieldRules = []types.MsgFieldRule{
+					types.NewMsgFieldRule(
+						sdk.MsgTypeURL(msg),
+						types.NewAllowedValuesConstraint("to_address", msg.ToAddress),
+						types.NewMaxAmountConstraint("amount", sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))),
+					),
+				}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"unauthorised: message does not satisfy the message field rules",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg})
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{types.AllowAllHostMsgs})
+				params.MsgFieldRules = []types.MsgFieldRule{
+					types.NewMsgFieldRule(
+						sdk.MsgTypeURL(msg),
+						types.NewMaxAmountConstraint("amount", sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(99)))),
+					),
+				}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"interchain account successfully executes banktypes.MsgSend allowed by the connection allowlist",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg})
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				allowlist := types.NewConnectionAllowlist(path.EndpointB.ConnectionID, []string{sdk.MsgTypeURL(msg)})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetConnectionAllowlist(suite.chainB.GetContext(), allowlist)
+			},
+			true,
+		},
+		{
+			"unauthorised: message type allowed by the host allowlist but not by the connection allowlist",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg})
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{types.AllowAllHostMsgs})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				allowlist := types.NewConnectionAllowlist(path.EndpointB.ConnectionID, []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetConnectionAllowlist(suite.chainB.GetContext(), allowlist)
+			},
+			false,
+		},
+		{
+			"unauthorised: empty connection allowlist denies all message types",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg})
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{types.AllowAllHostMsgs})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				allowlist := types.NewConnectionAllowlist(path.EndpointB.ConnectionID, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetConnectionAllowlist(suite.chainB.GetContext(), allowlist)
+			},
+			false,
+		},
 		{
 			"unauthorised: message type not allowed", // NOTE: do not update params to explicitly force the error
 			func() {
@@ -502,6 +660,162 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+// TestOnRecvPacketMsgGasLimits tests the execution of interchain account packets with per message gas limits.
+func (suite *KeeperTestSuite) TestOnRecvPacketMsgGasLimits() {
+	var (
+		path         *ibctesting.Path
+		msgs         []proto.Message
+		msgGasLimits []uint64
+		txGasLimit   uint64
+		expErr       error
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: message gas limits exceed the gas remaining to the transaction", func() {
+				txGasLimit = 60000
+			}, true,
+		},
+		{
+			"message exceeds its gas limit", func() {
+				msgGasLimits[1] = 1
+				expErr = sdkerrors.ErrOutOfGas
+			}, false,
+		},
+		{
+			"message runs out of the gas remaining to the transaction", func() {
+				txGasLimit = 30000
+			}, false,
+		},
+		{
+			"message fails", func() {
+				msgs[1].(*banktypes.MsgSend).Amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))
+				expErr = sdkerrors.ErrInsufficientFunds
+			}, false,
+		},
+		{
+			"message gas limits do not match the messages", func() {
+				msgGasLimits = msgGasLimits[:1]
+				expErr = icatypes.ErrInvalidMsgGasLimits
+			}, false,
+		},
+		{
+			"message gas limit is zero", func() {
+				msgGasLimits[0] = 0
+				expErr = icatypes.ErrInvalidMsgGasLimits
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			txGasLimit = 0
+			expErr = nil
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			msgs = []proto.Message{
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				},
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200))),
+				},
+			}
+			msgGasLimits = []uint64{100000, 100000}
+
+			tc.malleate() // malleate mutates test data
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type:         icatypes.EXECUTE_TX,
+				Data:         data,
+				MsgGasLimits: msgGasLimits,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(1, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			if txGasLimit != 0 {
+				ctx = ctx.WithGasMeter(sdk.NewGasMeter(txGasLimit))
+			}
+
+			// running out of the gas remaining to the transaction fails the transaction instead of the packet
+			if expErr == nil && !tc.expPass {
+				suite.Require().PanicsWithValue(sdk.ErrorOutOfGas{Descriptor: "interchain account message"}, func() {
+					_, _ = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+				})
+				return
+			}
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.DefaultBondDenom)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewInt(9700), balance.Amount)
+
+				var txResult icatypes.CosmosTxResult
+				suite.Require().NoError(proto.Unmarshal(txResponse, &txResult))
+				suite.Require().Len(txResult.MsgResults, len(msgs))
+
+				var gasUsed uint64
+				for _, msgResult := range txResult.MsgResults {
+					suite.Require().Equal("/"+proto.MessageName(&banktypes.MsgSendResponse{}), msgResult.MsgResponse.TypeUrl)
+					suite.Require().NotZero(msgResult.GasUsed)
+					gasUsed += msgResult.GasUsed
+				}
+
+				// the gas consumed by the messages is charged to the gas meter of the packet
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), gasUsed)
+			} else {
+				suite.Require().ErrorIs(err, expErr)
+				suite.Require().Nil(txResponse)
+
+				// the state changes of all messages are reverted
+				suite.Require().Equal(sdk.NewInt(10000), balance.Amount)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
 	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
 	suite.Require().True(found)
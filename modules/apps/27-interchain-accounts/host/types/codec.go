@@ -0,0 +1,24 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+// RegisterInterfaces registers the interchain accounts host message types using the provided InterfaceRegistry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSetConnectionAllowlist{},
+	)
+
+	registry.RegisterImplementations(
+		(*exported.AppParams)(nil),
+		&Params{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
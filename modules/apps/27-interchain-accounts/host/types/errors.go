@@ -7,4 +7,5 @@ import (
 // ICA Host sentinel errors
 var (
 	ErrHostSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrPendingPacketNotFound = sdkerrors.Register(SubModuleName, 3, "pending packet not found")
 )
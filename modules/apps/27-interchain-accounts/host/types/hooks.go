@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ICAHostHooks defines the hooks which may be registered with the interchain accounts host keeper
+// to be notified of interchain account events.
+type ICAHostHooks interface {
+	// OnInterchainAccountCreated is called once the channel handshake of a newly registered
+	// interchain account has completed. The port and channel identifiers are those of the host
+	// chain end of the channel.
+	OnInterchainAccountCreated(ctx sdk.Context, portID, channelID, address string) error
+}
+
+var _ ICAHostHooks = MultiICAHostHooks{}
+
+// MultiICAHostHooks combines multiple interchain accounts host hooks, all hooks are run in order.
+type MultiICAHostHooks []ICAHostHooks
+
+// NewMultiICAHostHooks returns a new MultiICAHostHooks instance containing the provided hooks.
+func NewMultiICAHostHooks(hooks ...ICAHostHooks) MultiICAHostHooks {
+	return hooks
+}
+
+// OnInterchainAccountCreated implements ICAHostHooks. The hooks are run in order
+// and the first error returned by a hook is returned.
+func (h MultiICAHostHooks) OnInterchainAccountCreated(ctx sdk.Context, portID, channelID, address string) error {
+	for i := range h {
+		if err := h[i].OnInterchainAccountCreated(ctx, portID, channelID, address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
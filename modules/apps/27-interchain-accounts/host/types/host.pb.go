@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -30,6 +32,9 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// msg_field_rules defines an optional list of rules restricting the field values of allowed sdk messages.
+	// Messages of a type without a rule are only subject to the allow_messages list.
+	MsgFieldRules []MsgFieldRule `protobuf:"bytes,3,rep,name=msg_field_rules,json=msgFieldRules,proto3" json:"msg_field_rules" yaml:"msg_field_rules"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,8 +84,198 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetMsgFieldRules() []MsgFieldRule {
+	if m != nil {
+		return m.MsgFieldRules
+	}
+	return nil
+}
+
+// MsgFieldRule defines the constraints which must be satisfied by the fields of an sdk message
+// executed on a host chain.
+type MsgFieldRule struct {
+	// type_url is the sdk message typeURL the rule applies to.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+	// constraints defines the constraints which must all be satisfied by the message.
+	Constraints []FieldConstraint `protobuf:"bytes,2,rep,name=constraints,proto3" json:"constraints"`
+}
+
+func (m *MsgFieldRule) Reset()         { *m = MsgFieldRule{} }
+func (m *MsgFieldRule) String() string { return proto.CompactTextString(m) }
+func (*MsgFieldRule) ProtoMessage()    {}
+func (*MsgFieldRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *MsgFieldRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFieldRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFieldRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFieldRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFieldRule.Merge(m, src)
+}
+func (m *MsgFieldRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFieldRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFieldRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFieldRule proto.InternalMessageInfo
+
+func (m *MsgFieldRule) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MsgFieldRule) GetConstraints() []FieldConstraint {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+// FieldConstraint restricts the value of a single sdk message field. Exactly one of allowed_values
+// or max_amount must be set.
+type FieldConstraint struct {
+	// field is the dot separated path of the field using the proto field names, e.g. "validator_address"
+	// or "amount".
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// allowed_values restricts a scalar field, or each element of a list field, to one of the provided values.
+	AllowedValues []string `protobuf:"bytes,2,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty" yaml:"allowed_values"`
+	// max_amount restricts a coin or list of coins field to not exceed the provided amounts. Denominations
+	// without a maximum amount are not allowed.
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_amount,json=maxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_amount" yaml:"max_amount"`
+}
+
+func (m *FieldConstraint) Reset()         { *m = FieldConstraint{} }
+func (m *FieldConstraint) String() string { return proto.CompactTextString(m) }
+func (*FieldConstraint) ProtoMessage()    {}
+func (*FieldConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{2}
+}
+func (m *FieldConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldConstraint.Merge(m, src)
+}
+func (m *FieldConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldConstraint proto.InternalMessageInfo
+
+func (m *FieldConstraint) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FieldConstraint) GetAllowedValues() []string {
+	if m != nil {
+		return m.AllowedValues
+	}
+	return nil
+}
+
+func (m *FieldConstraint) GetMaxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return nil
+}
+
+// ConnectionAllowlist defines the sdk message typeURLs allowed to be executed on the host chain by interchain
+// accounts registered over a connection. It overrides the allow_messages parameter for the connection. An empty
+// allowlist denies the execution of all messages over the connection.
+type ConnectionAllowlist struct {
+	// connection_id is the identifier of the connection the allowlist applies to.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// allow_messages defines a list of sdk message typeURLs allowed to be executed over the connection.
+	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+}
+
+func (m *ConnectionAllowlist) Reset()         { *m = ConnectionAllowlist{} }
+func (m *ConnectionAllowlist) String() string { return proto.CompactTextString(m) }
+func (*ConnectionAllowlist) ProtoMessage()    {}
+func (*ConnectionAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{3}
+}
+func (m *ConnectionAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionAllowlist.Merge(m, src)
+}
+func (m *ConnectionAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionAllowlist proto.InternalMessageInfo
+
+func (m *ConnectionAllowlist) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ConnectionAllowlist) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*MsgFieldRule)(nil), "ibc.applications.interchain_accounts.host.v1.MsgFieldRule")
+	proto.RegisterType((*FieldConstraint)(nil), "ibc.applications.interchain_accounts.host.v1.FieldConstraint")
+	proto.RegisterType((*ConnectionAllowlist)(nil), "ibc.applications.interchain_accounts.host.v1.ConnectionAllowlist")
 }
 
 func init() {
@@ -88,26 +283,43 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcf, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0x04, 0xd3, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x3a, 0x99, 0x49, 0xc9, 0x7a, 0xc8, 0x1a, 0xf5, 0xb0, 0x68, 0xd4, 0x03, 0x6b, 0x28, 0x33,
-	0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x6b, 0xd4, 0x07, 0xb1, 0x20, 0x66, 0x28, 0xb5, 0x31,
-	0x72, 0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x59, 0x71, 0xf1, 0x80, 0xd4, 0xc6, 0xa7,
-	0xe6, 0x25, 0x26, 0xe5, 0xa4, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x38, 0x89, 0x7f, 0xba,
-	0x27, 0x2f, 0x5c, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x2c, 0xab, 0x14, 0xc4, 0x0d, 0xe2, 0xba,
-	0x42, 0x78, 0x42, 0x0e, 0x5c, 0x7c, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0xb9, 0xa9, 0xc5, 0xc5,
-	0x89, 0xe9, 0xa9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x92, 0x9f, 0xee, 0xc9, 0x8b,
-	0x42, 0x74, 0xa3, 0xca, 0x2b, 0x05, 0xf1, 0x82, 0x05, 0x7c, 0xa1, 0x7c, 0xa7, 0x94, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xf2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x4c, 0x4a, 0xd6, 0x4d,
-	0xcf, 0xd7, 0x2f, 0x33, 0xd3, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x85, 0x5f, 0xb1,
-	0xbe, 0x91, 0xb9, 0x2e, 0x22, 0x04, 0x74, 0x51, 0x83, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x6b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x95, 0xc4, 0x0a, 0x74, 0x01,
-	0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x5b, 0x28, 0xed, 0xa5, 0xa5, 0xc2, 0x09, 0x90, 0x76, 0xb0, 0x23, 0x4f, 0x19, 0xc8,
+	0x9d, 0x12, 0x24, 0x2a, 0x45, 0xaa, 0x44, 0x1d, 0x15, 0x09, 0xa4, 0x4a, 0xc8, 0x12, 0x0c, 0x2c,
+	0xd6, 0xf9, 0x7c, 0x38, 0x27, 0xce, 0xbe, 0xc8, 0x67, 0x87, 0x76, 0x42, 0x62, 0x65, 0x61, 0xe2,
+	0x1f, 0xb0, 0xf0, 0x4b, 0x3a, 0x76, 0x64, 0x32, 0x28, 0xd9, 0x18, 0xf3, 0x0b, 0xd0, 0xf9, 0xdc,
+	0x34, 0x29, 0x30, 0x54, 0x62, 0xf2, 0xbd, 0x7b, 0xf7, 0x7d, 0xf7, 0xde, 0xf7, 0x3e, 0x1f, 0x38,
+	0x60, 0x01, 0x41, 0x78, 0x3c, 0xe6, 0x8c, 0xe0, 0x8c, 0x89, 0x44, 0x22, 0x96, 0x64, 0x34, 0x25,
+	0x23, 0xcc, 0x12, 0x1f, 0x13, 0x22, 0xf2, 0x24, 0x93, 0x68, 0x24, 0x64, 0x86, 0x26, 0xbd, 0xf2,
+	0x0b, 0xc7, 0xa9, 0xc8, 0x84, 0xf9, 0x88, 0x05, 0x04, 0x2e, 0x03, 0xe1, 0x5f, 0x80, 0xb0, 0x04,
+	0x4c, 0x7a, 0xfb, 0xcd, 0x48, 0x44, 0xa2, 0x04, 0x22, 0xb5, 0xd2, 0x1c, 0xfb, 0x16, 0x11, 0x32,
+	0x16, 0x12, 0x05, 0x58, 0x52, 0x34, 0xe9, 0x05, 0x34, 0xc3, 0x3d, 0x44, 0x04, 0x4b, 0x74, 0xde,
+	0xf9, 0xb4, 0x06, 0x36, 0x5e, 0xe2, 0x14, 0xc7, 0xd2, 0x1c, 0x80, 0x6d, 0xc5, 0xe5, 0xd3, 0x04,
+	0x07, 0x9c, 0x86, 0x2d, 0xa3, 0x6d, 0x74, 0x36, 0xdd, 0x87, 0xf3, 0xc2, 0x6e, 0x9c, 0xe1, 0x98,
+	0x0f, 0x9c, 0xe5, 0xac, 0xe3, 0xd5, 0x55, 0x78, 0xac, 0x23, 0xf3, 0x29, 0xb8, 0x8b, 0x39, 0x17,
+	0xef, 0xfd, 0x98, 0x4a, 0x89, 0x23, 0x2a, 0x5b, 0x6b, 0xed, 0xf5, 0xce, 0x96, 0xbb, 0x37, 0x2f,
+	0xec, 0xfb, 0x1a, 0xbd, 0x9a, 0x77, 0xbc, 0x9d, 0x72, 0xe3, 0xa4, 0x8a, 0xcd, 0x8f, 0x06, 0xd8,
+	0x8d, 0x65, 0xe4, 0xbf, 0x65, 0x94, 0x87, 0x7e, 0x9a, 0x73, 0x2a, 0x5b, 0xeb, 0xed, 0xf5, 0x4e,
+	0xbd, 0x3f, 0x80, 0x37, 0xd1, 0x01, 0x9e, 0xc8, 0xe8, 0x99, 0xe2, 0xf0, 0x72, 0x4e, 0x5d, 0xeb,
+	0xbc, 0xb0, 0x6b, 0xf3, 0xc2, 0x7e, 0xa0, 0x6b, 0xb8, 0x76, 0x81, 0xe3, 0xed, 0xc4, 0x4b, 0xa7,
+	0xa5, 0xf3, 0xd5, 0x00, 0xdb, 0xcb, 0x78, 0x13, 0x82, 0xcd, 0xec, 0x6c, 0x4c, 0xfd, 0x3c, 0xe5,
+	0xa5, 0x1e, 0x5b, 0x6e, 0x63, 0x5e, 0xd8, 0xbb, 0x9a, 0xed, 0x32, 0xe3, 0x78, 0x77, 0xd4, 0xf2,
+	0x55, 0xca, 0x4d, 0x0a, 0xea, 0x44, 0x24, 0x32, 0x4b, 0x31, 0x4b, 0x32, 0x2d, 0x42, 0xbd, 0x7f,
+	0x78, 0xb3, 0x06, 0xca, 0xdb, 0x87, 0x0b, 0x16, 0xf7, 0x96, 0xea, 0xc1, 0x5b, 0xe6, 0x75, 0x7e,
+	0x19, 0x60, 0xf7, 0xda, 0x31, 0xb3, 0x09, 0x6e, 0x97, 0xad, 0xe9, 0x3a, 0x3d, 0x1d, 0x2c, 0x06,
+	0x43, 0x43, 0x7f, 0x82, 0x79, 0xfe, 0xcf, 0xc1, 0x2c, 0xf2, 0x97, 0x83, 0xa1, 0xe1, 0xeb, 0x32,
+	0x36, 0x3f, 0x00, 0x10, 0xe3, 0x53, 0x1f, 0xc7, 0xaa, 0xca, 0x6a, 0x24, 0x7b, 0x50, 0xdb, 0x0a,
+	0x2a, 0x5b, 0xc1, 0xca, 0x56, 0x70, 0x28, 0x58, 0xe2, 0x1e, 0x57, 0x8a, 0xdf, 0xab, 0x14, 0x5f,
+	0x40, 0x9d, 0x6f, 0x3f, 0xec, 0x4e, 0xc4, 0xb2, 0x51, 0x1e, 0x40, 0x22, 0x62, 0x54, 0x19, 0x53,
+	0x7f, 0xba, 0x32, 0x7c, 0x87, 0x94, 0x88, 0xb2, 0x64, 0x91, 0xde, 0x56, 0x8c, 0x4f, 0x8f, 0x34,
+	0xee, 0x8b, 0x01, 0x1a, 0x43, 0x91, 0x24, 0x94, 0x28, 0xed, 0x8e, 0x54, 0x71, 0x9c, 0xc9, 0xcc,
+	0x3c, 0x04, 0x3b, 0x64, 0xb1, 0xed, 0xb3, 0xaa, 0x71, 0xb7, 0x35, 0x2f, 0xec, 0xa6, 0xbe, 0x7c,
+	0x25, 0xed, 0x78, 0xdb, 0x57, 0xf1, 0xf3, 0xff, 0x60, 0x59, 0x37, 0x3c, 0x9f, 0x5a, 0xc6, 0xc5,
+	0xd4, 0x32, 0x7e, 0x4e, 0x2d, 0xe3, 0xf3, 0xcc, 0xaa, 0x5d, 0xcc, 0xac, 0xda, 0xf7, 0x99, 0x55,
+	0x7b, 0xf3, 0xe2, 0xcf, 0x3e, 0x59, 0x40, 0xba, 0x91, 0x40, 0x93, 0x27, 0x28, 0x16, 0xa1, 0x32,
+	0x9b, 0x7a, 0x12, 0x24, 0xea, 0x1f, 0x74, 0xaf, 0xbc, 0xd0, 0x5d, 0x7d, 0x0d, 0x4a, 0x3d, 0x82,
+	0x8d, 0xf2, 0x47, 0x7d, 0xfc, 0x7b, 0x00, 0xcb, 0x54, 0x1e, 0xc9, 0x47, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -130,6 +342,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgFieldRules) > 0 {
+		for iNdEx := len(m.MsgFieldRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFieldRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -152,6 +378,142 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFieldRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFieldRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFieldRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Constraints) > 0 {
+		for iNdEx := len(m.Constraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Constraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxAmount) > 0 {
+		for iNdEx := len(m.MaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedValues) > 0 {
+		for iNdEx := len(m.AllowedValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValues[iNdEx])
+			copy(dAtA[i:], m.AllowedValues[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowedValues[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectionAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHost(v)
 	base := offset
@@ -178,6 +540,75 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if len(m.MsgFieldRules) > 0 {
+		for _, e := range m.MsgFieldRules {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFieldRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.Constraints) > 0 {
+		for _, e := range m.Constraints {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.MaxAmount) > 0 {
+		for _, e := range m.MaxAmount {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConnectionAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -268,6 +699,418 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFieldRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFieldRules = append(m.MsgFieldRules, MsgFieldRule{})
+			if err := m.MsgFieldRules[len(m.MsgFieldRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFieldRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFieldRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFieldRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constraints = append(m.Constraints, FieldConstraint{})
+			if err := m.Constraints[len(m.Constraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldConstraint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldConstraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValues = append(m.AllowedValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = append(m.MaxAmount, types.Coin{})
+			if err := m.MaxAmount[len(m.MaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,8 +15,24 @@ const (
 
 	// AllowAllHostMsgs holds the string key that allows all message types on interchain accounts host module
 	AllowAllHostMsgs = "*"
+
+	// PendingPacketKeyPrefix defines the key prefix used to store packets awaiting an asynchronous acknowledgement
+	PendingPacketKeyPrefix = "pendingPacket"
+
+	// ConnectionAllowlistKeyPrefix defines the key prefix used to store the allowlists overriding the host allowlist for a connection
+	ConnectionAllowlistKeyPrefix = "connectionAllowlist"
 )
 
+// KeyPendingPacket creates and returns a new key used for pending packets store operations
+func KeyPendingPacket(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", PendingPacketKeyPrefix, portID, channelID, sequence))
+}
+
+// KeyConnectionAllowlist creates and returns a new key used for connection allowlist store operations
+func KeyConnectionAllowlist(connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", ConnectionAllowlistKeyPrefix, connectionID))
+}
+
 // ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 	// check that wildcard * option for allowing all message types is the only string in the array, if so, return true
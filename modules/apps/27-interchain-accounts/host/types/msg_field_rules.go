@@ -0,0 +1,205 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewMsgFieldRule creates a new MsgFieldRule instance
+func NewMsgFieldRule(typeURL string, constraints ...FieldConstraint) MsgFieldRule {
+	return MsgFieldRule{
+		TypeUrl:     typeURL,
+		Constraints: constraints,
+	}
+}
+
+// NewAllowedValuesConstraint creates a new FieldConstraint restricting the provided field to the allowed values
+func NewAllowedValuesConstraint(field string, allowedValues ...string) FieldConstraint {
+	return FieldConstraint{
+		Field:         field,
+		AllowedValues: allowedValues,
+	}
+}
+
+// NewMaxAmountConstraint creates a new FieldConstraint restricting the provided coin field to the maximum amount
+func NewMaxAmountConstraint(field string, maxAmount sdk.Coins) FieldConstraint {
+	return FieldConstraint{
+		Field:     field,
+		MaxAmount: maxAmount,
+	}
+}
+
+// GetMsgFieldRule returns the rule of the provided rules which applies to the sdk.Msg TypeURL, if any
+func GetMsgFieldRule(rules []MsgFieldRule, msg sdk.Msg) (MsgFieldRule, bool) {
+	for _, rule := range rules {
+		if rule.TypeUrl == sdk.MsgTypeURL(msg) {
+			return rule, true
+		}
+	}
+
+	return MsgFieldRule{}, false
+}
+
+// ValidateBasic performs a basic validation of the MsgFieldRule fields
+func (r MsgFieldRule) ValidateBasic() error {
+	if strings.TrimSpace(r.TypeUrl) == "" {
+		return fmt.Errorf("message field rule type URL cannot be empty")
+	}
+
+	if r.TypeUrl == AllowAllHostMsgs {
+		return fmt.Errorf("message field rule cannot apply to all message types")
+	}
+
+	if len(r.Constraints) == 0 {
+		return fmt.Errorf("message field rule for type %s must contain at least one constraint", r.TypeUrl)
+	}
+
+	for _, constraint := range r.Constraints {
+		if err := constraint.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid constraint for type %s: %w", r.TypeUrl, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateMsg returns an error if the provided msg does not satisfy all constraints of the rule.
+// The msg fields are resolved using the proto JSON encoding of the msg.
+func (r MsgFieldRule) ValidateMsg(msg sdk.Msg) error {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot evaluate message field rules for type %s: %s", r.TypeUrl, err)
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot evaluate message field rules for type %s: %s", r.TypeUrl, err)
+	}
+
+	for _, constraint := range r.Constraints {
+		if err := constraint.validateFields(fields); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message of type %s does not satisfy constraint on field %s: %s", r.TypeUrl, constraint.Field, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs a basic validation of the FieldConstraint fields
+func (c FieldConstraint) ValidateBasic() error {
+	if strings.TrimSpace(c.Field) == "" {
+		return fmt.Errorf("field cannot be empty")
+	}
+
+	for _, name := range strings.Split(c.Field, ".") {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("field %s cannot contain empty path elements", c.Field)
+		}
+	}
+
+	if (len(c.AllowedValues) == 0) == (len(c.MaxAmount) == 0) {
+		return fmt.Errorf("exactly one of allowed values or max amount must be set for field %s", c.Field)
+	}
+
+	if len(c.MaxAmount) != 0 && !c.MaxAmount.IsValid() {
+		return fmt.Errorf("invalid max amount %s for field %s", c.MaxAmount, c.Field)
+	}
+
+	return nil
+}
+
+// validateFields returns an error if the field of the constraint is not present in the provided
+// JSON decoded msg fields or its value does not satisfy the constraint.
+func (c FieldConstraint) validateFields(fields map[string]interface{}) error {
+	var value interface{} = fields
+	for _, name := range strings.Split(c.Field, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("field not found")
+		}
+
+		if value, ok = object[name]; !ok {
+			return fmt.Errorf("field not found")
+		}
+	}
+
+	if len(c.AllowedValues) != 0 {
+		return c.validateAllowedValues(value)
+	}
+
+	return c.validateMaxAmount(value)
+}
+
+// validateAllowedValues returns an error if the provided scalar value, or any element of the provided list,
+// is not contained in the allowed values of the constraint.
+func (c FieldConstraint) validateAllowedValues(value interface{}) error {
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	for _, v := range values {
+		var s string
+		switch v := v.(type) {
+		case string:
+			s = v
+		case bool, float64:
+			s = fmt.Sprint(v)
+		default:
+			return fmt.Errorf("value is not a scalar")
+		}
+
+		if !contains(c.AllowedValues, s) {
+			return fmt.Errorf("value %s is not allowed", s)
+		}
+	}
+
+	return nil
+}
+
+// validateMaxAmount returns an error if the provided coin or list of coins exceeds the max amount of the constraint.
+func (c FieldConstraint) validateMaxAmount(value interface{}) error {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	var coins sdk.Coins
+	if _, ok := value.([]interface{}); ok {
+		if err := json.Unmarshal(bz, &coins); err != nil {
+			return fmt.Errorf("value is not a list of coins")
+		}
+	} else {
+		var coin sdk.Coin
+		if err := json.Unmarshal(bz, &coin); err != nil {
+			return fmt.Errorf("value is not a coin")
+		}
+
+		coins = sdk.Coins{coin}
+	}
+
+	if err := coins.Validate(); err != nil {
+		return err
+	}
+
+	if !coins.IsAllLTE(c.MaxAmount) {
+		return fmt.Errorf("amount %s exceeds max amount %s", coins, c.MaxAmount)
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+)
+
+var (
+	validatorAddress = sdk.ValAddress("validator").String()
+	delegatorAddress = sdk.AccAddress("delegator").String()
+	maxAmount        = sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 100))
+)
+
+func TestMsgFieldRuleValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rule    types.MsgFieldRule
+		expPass bool
+	}{
+		{"success: allowed values", types.NewMsgFieldRule("/cosmos.staking.v1beta1.MsgDelegate", types.NewAllowedValuesConstraint("validator_address", validatorAddress)), true},
+		{"success: max amount", types.NewMsgFieldRule("/cosmos.bank.v1beta1.MsgSend", types.NewMaxAmountConstraint("amount", maxAmount)), true},
+		{"success: nested field", types.NewMsgFieldRule("/cosmos.staking.v1beta1.MsgDelegate", types.NewAllowedValuesConstraint("amount.denom", "stake")), true},
+		{"empty type URL", types.NewMsgFieldRule(" ", types.NewAllowedValuesConstraint("validator_address", validatorAddress)), false},
+		{"type URL allows all messages", types.NewMsgFieldRule(types.AllowAllHostMsgs, types.NewAllowedValuesConstraint("validator_address", validatorAddress)), false},
+		{"no constraints", types.NewMsgFieldRule("/cosmos.bank.v1beta1.MsgSend"), false},
+		{"empty field", types.NewMsgFieldRule("/cosmos.bank.v1beta1.MsgSend", types.NewMaxAmountConstraint("", maxAmount)), false},
+		{"empty field path element", types.NewMsgFieldRule("/cosmos.bank.v1beta1.MsgSend", types.NewAllowedValuesConstraint("amount..denom", "stake")), false},
+		{"neither allowed values nor max amount", types.NewMsgFieldRule("/cosmos.bank.v1beta1.MsgSend", types.FieldConstraint{Field: "amount"}), false},
+		{"both allowed values and max amount", types.NewMsgFieldRule("/cosmos.bank.v1beta1.MsgSend", types.FieldConstraint{Field: "amount", AllowedValues: []string{"stake"}, MaxAmount: maxAmount}), false},
+		{"invalid max amount", types.NewMsgFieldRule("/cosmos.bank.v1beta1.MsgSend", types.NewMaxAmountConstraint("amount", sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}})), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.rule.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgFieldRuleValidateMsg(t *testing.T) {
+	delegateRule := types.NewMsgFieldRule(
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		types.NewAllowedValuesConstraint("validator_address", validatorAddress),
+		types.NewMaxAmountConstraint("amount", maxAmount),
+	)
+
+	sendRule := types.NewMsgFieldRule(
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		types.NewMaxAmountConstraint("amount", maxAmount),
+	)
+
+	testCases := []struct {
+		name    string
+		rule    types.MsgFieldRule
+		msg     sdk.Msg
+		expPass bool
+	}{
+		{"success: delegation to allowed validator", delegateRule, stakingtypes.NewMsgDelegate(sdk.AccAddress("delegator"), sdk.ValAddress("validator"), sdk.NewInt64Coin("stake", 100)), true},
+		{"success: send within max amount", sendRule, banktypes.NewMsgSend(sdk.AccAddress("sender"), sdk.AccAddress("receiver"), sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 1))), true},
+		{"success: nested field", types.NewMsgFieldRule(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}), types.NewAllowedValuesConstraint("amount.denom", "stake")), stakingtypes.NewMsgDelegate(sdk.AccAddress("delegator"), sdk.ValAddress("validator"), sdk.NewInt64Coin("stake", 100)), true},
+		{"field path does not traverse lists", types.NewMsgFieldRule(sdk.MsgTypeURL(&banktypes.MsgSend{}), types.NewAllowedValuesConstraint("amount.denom", "stake")), banktypes.NewMsgSend(sdk.AccAddress("sender"), sdk.AccAddress("receiver"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1))), false},
+		{"delegation to validator not allowed", delegateRule, stakingtypes.NewMsgDelegate(sdk.AccAddress("delegator"), sdk.ValAddress("other"), sdk.NewInt64Coin("stake", 100)), false},
+		{"delegation exceeds max amount", delegateRule, stakingtypes.NewMsgDelegate(sdk.AccAddress("delegator"), sdk.ValAddress("validator"), sdk.NewInt64Coin("stake", 101)), false},
+		{"send exceeds max amount", sendRule, banktypes.NewMsgSend(sdk.AccAddress("sender"), sdk.AccAddress("receiver"), sdk.NewCoins(sdk.NewInt64Coin("atom", 51))), false},
+		{"send denomination without max amount", sendRule, banktypes.NewMsgSend(sdk.AccAddress("sender"), sdk.AccAddress("receiver"), sdk.NewCoins(sdk.NewInt64Coin("osmo", 1))), false},
+		{"field not found", types.NewMsgFieldRule(sdk.MsgTypeURL(&banktypes.MsgSend{}), types.NewAllowedValuesConstraint("validator_address", validatorAddress)), banktypes.NewMsgSend(sdk.AccAddress("sender"), sdk.AccAddress("receiver"), maxAmount), false},
+		{"allowed values on non scalar field", types.NewMsgFieldRule(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}), types.NewAllowedValuesConstraint("amount", "stake")), stakingtypes.NewMsgDelegate(sdk.AccAddress("delegator"), sdk.ValAddress("validator"), sdk.NewInt64Coin("stake", 100)), false},
+		{"max amount on non coin field", types.NewMsgFieldRule(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}), types.NewMaxAmountConstraint("validator_address", maxAmount)), stakingtypes.NewMsgDelegate(sdk.AccAddress("delegator"), sdk.ValAddress("validator"), sdk.NewInt64Coin("stake", 100)), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.rule.ValidateMsg(tc.msg)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+var _ sdk.Msg = &MsgSetConnectionAllowlist{}
+
+// NewMsgSetConnectionAllowlist creates a new instance of MsgSetConnectionAllowlist
+//
+//nolint:interfacer
+func NewMsgSetConnectionAllowlist(authority, connectionID string, allowMsgs []string, remove bool) *MsgSetConnectionAllowlist {
+	return &MsgSetConnectionAllowlist{
+		Authority:     authority,
+		ConnectionId:  connectionID,
+		AllowMessages: allowMsgs,
+		Remove:        remove,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetConnectionAllowlist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if msg.Remove && len(msg.AllowMessages) != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "allow messages must be empty when the connection allowlist is removed")
+	}
+
+	if err := validateAllowlist(msg.AllowMessages); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSetConnectionAllowlist) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+func TestMsgSetConnectionAllowlistValidateBasic(t *testing.T) {
+	var msg *types.MsgSetConnectionAllowlist
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: empty allowlist denies all messages",
+			func() {
+				msg.AllowMessages = nil
+			},
+			true,
+		},
+		{
+			"success: connection allowlist is removed",
+			func() {
+				msg.AllowMessages = nil
+				msg.Remove = true
+			},
+			true,
+		},
+		{
+			"allow messages are provided when the connection allowlist is removed",
+			func() {
+				msg.Remove = true
+			},
+			false,
+		},
+		{
+			"authority address is invalid",
+			func() {
+				msg.Authority = "invalid-address"
+			},
+			false,
+		},
+		{
+			"connection id is invalid",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"allowlist contains an empty message type",
+			func() {
+				msg.AllowMessages = []string{"/cosmos.bank.v1beta1.MsgSend", " "}
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgSetConnectionAllowlist(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, []string{"/cosmos.bank.v1beta1.MsgSend"}, false)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
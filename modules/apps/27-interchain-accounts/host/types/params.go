@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 )
 
 const (
@@ -17,6 +19,8 @@ var (
 	KeyHostEnabled = []byte("HostEnabled")
 	// KeyAllowMessages is the store key for the AllowMessages Params
 	KeyAllowMessages = []byte("AllowMessages")
+	// KeyMsgFieldRules is the store key for the MsgFieldRules Params
+	KeyMsgFieldRules = []byte("MsgFieldRules")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -47,6 +51,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMsgFieldRules(p.MsgFieldRules); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -55,9 +63,28 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyHostEnabled, p.HostEnabled, validateEnabledType),
 		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyMsgFieldRules, p.MsgFieldRules, validateMsgFieldRules),
 	}
 }
 
+// NewConnectionAllowlist creates a new ConnectionAllowlist instance
+func NewConnectionAllowlist(connectionID string, allowMsgs []string) ConnectionAllowlist {
+	return ConnectionAllowlist{
+		ConnectionId:  connectionID,
+		AllowMessages: allowMsgs,
+	}
+}
+
+// Validate performs a basic validation of the ConnectionAllowlist fields. An empty allowlist is valid
+// and denies the execution of all messages over the connection.
+func (ca ConnectionAllowlist) Validate() error {
+	if err := host.ConnectionIdentifierValidator(ca.ConnectionId); err != nil {
+		return err
+	}
+
+	return validateAllowlist(ca.AllowMessages)
+}
+
 func validateEnabledType(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -81,3 +108,25 @@ func validateAllowlist(i interface{}) error {
 
 	return nil
 }
+
+func validateMsgFieldRules(i interface{}) error {
+	rules, ok := i.([]MsgFieldRule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	typeURLs := make(map[string]bool)
+	for _, rule := range rules {
+		if err := rule.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if typeURLs[rule.TypeUrl] {
+			return fmt.Errorf("duplicate message field rule for type %s", rule.TypeUrl)
+		}
+
+		typeURLs[rule.TypeUrl] = true
+	}
+
+	return nil
+}
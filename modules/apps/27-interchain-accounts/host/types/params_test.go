@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
@@ -11,4 +12,22 @@ import (
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
 	require.NoError(t, types.NewParams(false, []string{}).Validate())
+
+	params := types.DefaultParams()
+	params.MsgFieldRules = []types.MsgFieldRule{
+		types.NewMsgFieldRule("/cosmos.bank.v1beta1.MsgSend", types.NewMaxAmountConstraint("amount", sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))),
+		types.NewMsgFieldRule("/cosmos.staking.v1beta1.MsgDelegate", types.NewAllowedValuesConstraint("validator_address", "cosmosvaloper1")),
+	}
+	require.NoError(t, params.Validate())
+
+	params.MsgFieldRules = append(params.MsgFieldRules, types.NewMsgFieldRule("/cosmos.bank.v1beta1.MsgSend", types.NewAllowedValuesConstraint("to_address", "cosmos1")))
+	require.Error(t, params.Validate(), "duplicate message field rule")
+}
+
+func TestValidateConnectionAllowlist(t *testing.T) {
+	require.NoError(t, types.NewConnectionAllowlist("connection-0", []string{"/cosmos.bank.v1beta1.MsgSend"}).Validate())
+	require.NoError(t, types.NewConnectionAllowlist("connection-0", []string{types.AllowAllHostMsgs}).Validate())
+	require.Error(t, types.NewConnectionAllowlist("", []string{"/cosmos.bank.v1beta1.MsgSend"}).Validate(), "invalid connection identifier")
+	require.NoError(t, types.NewConnectionAllowlist("connection-0", nil).Validate(), "empty allowlist")
+	require.Error(t, types.NewConnectionAllowlist("connection-0", []string{""}).Validate(), "empty message type")
 }
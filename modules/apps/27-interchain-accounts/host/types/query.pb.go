@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -111,9 +114,322 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryChannelSummaryRequest is the request type for the Query/ChannelSummary RPC method.
+type QueryChannelSummaryRequest struct {
+	// pagination request over the active channels
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChannelSummaryRequest) Reset()         { *m = QueryChannelSummaryRequest{} }
+func (m *QueryChannelSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelSummaryRequest) ProtoMessage()    {}
+func (*QueryChannelSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{2}
+}
+func (m *QueryChannelSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelSummaryRequest.Merge(m, src)
+}
+func (m *QueryChannelSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelSummaryRequest proto.InternalMessageInfo
+
+func (m *QueryChannelSummaryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryChannelSummaryResponse is the response type for the Query/ChannelSummary RPC method.
+type QueryChannelSummaryResponse struct {
+	// list of active channels of the requested page
+	ActiveChannels []HostActiveChannel `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
+	// total number of active channels in state OPEN, across all pages
+	OpenChannels uint64 `protobuf:"varint,2,opt,name=open_channels,json=openChannels,proto3" json:"open_channels,omitempty" yaml:"open_channels"`
+	// total number of active channels in state CLOSED, across all pages
+	ClosedChannels uint64 `protobuf:"varint,3,opt,name=closed_channels,json=closedChannels,proto3" json:"closed_channels,omitempty" yaml:"closed_channels"`
+	// list of ports the ICA host submodule is bound to
+	Ports []string `protobuf:"bytes,4,rep,name=ports,proto3" json:"ports,omitempty"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChannelSummaryResponse) Reset()         { *m = QueryChannelSummaryResponse{} }
+func (m *QueryChannelSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelSummaryResponse) ProtoMessage()    {}
+func (*QueryChannelSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{3}
+}
+func (m *QueryChannelSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelSummaryResponse.Merge(m, src)
+}
+func (m *QueryChannelSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryChannelSummaryResponse) GetActiveChannels() []HostActiveChannel {
+	if m != nil {
+		return m.ActiveChannels
+	}
+	return nil
+}
+
+func (m *QueryChannelSummaryResponse) GetOpenChannels() uint64 {
+	if m != nil {
+		return m.OpenChannels
+	}
+	return 0
+}
+
+func (m *QueryChannelSummaryResponse) GetClosedChannels() uint64 {
+	if m != nil {
+		return m.ClosedChannels
+	}
+	return 0
+}
+
+func (m *QueryChannelSummaryResponse) GetPorts() []string {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *QueryChannelSummaryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// HostActiveChannel defines the active channel of an interchain account on the host chain along with its state
+type HostActiveChannel struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// the controller port of the interchain account
+	PortId    string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the state of the channel on the host port
+	State types.State `protobuf:"varint,4,opt,name=state,proto3,enum=ibc.core.channel.v1.State" json:"state,omitempty"`
+}
+
+func (m *HostActiveChannel) Reset()         { *m = HostActiveChannel{} }
+func (m *HostActiveChannel) String() string { return proto.CompactTextString(m) }
+func (*HostActiveChannel) ProtoMessage()    {}
+func (*HostActiveChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{4}
+}
+func (m *HostActiveChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostActiveChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostActiveChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostActiveChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostActiveChannel.Merge(m, src)
+}
+func (m *HostActiveChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostActiveChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostActiveChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostActiveChannel proto.InternalMessageInfo
+
+func (m *HostActiveChannel) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *HostActiveChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *HostActiveChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *HostActiveChannel) GetState() types.State {
+	if m != nil {
+		return m.State
+	}
+	return types.UNINITIALIZED
+}
+
+// QueryInterchainAccountRequest is the request type for the Query/InterchainAccount RPC method.
+type QueryInterchainAccountRequest struct {
+	// the owner address of the interchain account on the controller chain
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the connection identifier on the host chain
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+}
+
+func (m *QueryInterchainAccountRequest) Reset()         { *m = QueryInterchainAccountRequest{} }
+func (m *QueryInterchainAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{5}
+}
+func (m *QueryInterchainAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryInterchainAccountRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryInterchainAccountResponse is the response type for the Query/InterchainAccount RPC method.
+type QueryInterchainAccountResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the active channel of the interchain account on the host port
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *QueryInterchainAccountResponse) Reset()         { *m = QueryInterchainAccountResponse{} }
+func (m *QueryInterchainAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{6}
+}
+func (m *QueryInterchainAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryInterchainAccountResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryChannelSummaryRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryChannelSummaryRequest")
+	proto.RegisterType((*QueryChannelSummaryResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryChannelSummaryResponse")
+	proto.RegisterType((*HostActiveChannel)(nil), "ibc.applications.interchain_accounts.host.v1.HostActiveChannel")
+	proto.RegisterType((*QueryInterchainAccountRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountRequest")
+	proto.RegisterType((*QueryInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountResponse")
 }
 
 func init() {
@@ -121,27 +437,58 @@ func init() {
 }
 
 var fileDescriptor_e6b7e23fc90c353a = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x31, 0x4b, 0x33, 0x31,
-	0x18, 0xc7, 0x9b, 0x17, 0xde, 0x0e, 0x71, 0x8b, 0x0e, 0x52, 0x24, 0x48, 0x27, 0x87, 0x36, 0xa1,
-	0xb5, 0x58, 0x47, 0x75, 0x14, 0x07, 0x75, 0x74, 0x91, 0x5c, 0x1a, 0xae, 0x81, 0x5e, 0x9e, 0xf4,
-	0x92, 0x3b, 0xe8, 0xea, 0x27, 0x10, 0xfc, 0x48, 0x2e, 0x2e, 0x42, 0xc1, 0xc5, 0x51, 0xee, 0xfc,
-	0x20, 0x72, 0xb9, 0x03, 0x2d, 0x8a, 0x70, 0xb8, 0x3e, 0xe1, 0xf7, 0xfb, 0x3f, 0xff, 0x27, 0xf8,
-	0x58, 0x47, 0x92, 0x0b, 0x6b, 0x17, 0x5a, 0x0a, 0xaf, 0xc1, 0x38, 0xae, 0x8d, 0x57, 0xa9, 0x9c,
-	0x0b, 0x6d, 0x6e, 0x85, 0x94, 0x90, 0x19, 0xef, 0xf8, 0x1c, 0x9c, 0xe7, 0xf9, 0x88, 0x2f, 0x33,
-	0x95, 0xae, 0x98, 0x4d, 0xc1, 0x03, 0x19, 0xe8, 0x48, 0xb2, 0xaf, 0x24, 0xfb, 0x81, 0x64, 0x15,
-	0xc9, 0xf2, 0x51, 0x6f, 0x2f, 0x06, 0x88, 0x17, 0x8a, 0x0b, 0xab, 0xb9, 0x30, 0x06, 0x7c, 0xc3,
-	0x04, 0x57, 0x6f, 0xda, 0x6a, 0x8b, 0xe0, 0x0c, 0x60, 0x7f, 0x07, 0x93, 0xab, 0x6a, 0xa7, 0x4b,
-	0x91, 0x8a, 0xc4, 0x5d, 0xab, 0x65, 0xa6, 0x9c, 0xef, 0x4b, 0xbc, 0xbd, 0x31, 0x75, 0x16, 0x8c,
-	0x53, 0xe4, 0x02, 0x77, 0x6d, 0x98, 0xec, 0xa2, 0x7d, 0x74, 0xb0, 0x35, 0x9e, 0xb0, 0x36, 0x15,
-	0x58, 0x63, 0x6b, 0x1c, 0xe3, 0x67, 0x84, 0xff, 0x87, 0x14, 0xf2, 0x88, 0x70, 0xb7, 0x7e, 0x24,
-	0x27, 0xed, 0x94, 0xdf, 0x77, 0xef, 0x9d, 0xfe, 0xc1, 0x50, 0xf7, 0xec, 0x4f, 0xee, 0x5e, 0xde,
-	0x1f, 0xfe, 0x31, 0x32, 0xe0, 0xcd, 0x59, 0x7f, 0x3f, 0x67, 0xdd, 0xe7, 0x6c, 0xf6, 0x54, 0x50,
-	0xb4, 0x2e, 0x28, 0x7a, 0x2b, 0x28, 0xba, 0x2f, 0x69, 0x67, 0x5d, 0xd2, 0xce, 0x6b, 0x49, 0x3b,
-	0x37, 0xe7, 0xb1, 0xf6, 0xf3, 0x2c, 0x62, 0x12, 0x12, 0x2e, 0xc1, 0x25, 0xe0, 0x2a, 0xf1, 0x30,
-	0x06, 0x9e, 0x1f, 0xf1, 0x04, 0x66, 0xd9, 0x42, 0xb9, 0x3a, 0x66, 0x3c, 0x1d, 0x7e, 0x26, 0x0d,
-	0x37, 0x93, 0xfc, 0xca, 0x2a, 0x17, 0x75, 0xc3, 0xbf, 0x1d, 0x7e, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xf6, 0xa9, 0x51, 0xdd, 0x78, 0x02, 0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3b, 0x6f, 0x2b, 0x45,
+	0x14, 0xf6, 0xda, 0xb1, 0xaf, 0x32, 0xf7, 0xe2, 0xab, 0x0c, 0x06, 0x59, 0x0b, 0xac, 0xcd, 0x16,
+	0x60, 0xc1, 0xcd, 0x0e, 0x36, 0x81, 0x20, 0xa4, 0x2b, 0x48, 0xae, 0x04, 0x31, 0xa4, 0x08, 0x9b,
+	0x06, 0xd1, 0x44, 0xe3, 0xd9, 0xd1, 0x7a, 0x25, 0x7b, 0x67, 0xb3, 0x33, 0x36, 0xb2, 0xa2, 0x34,
+	0x54, 0x91, 0x68, 0x90, 0xf8, 0x41, 0x14, 0x34, 0x29, 0x23, 0xd1, 0x50, 0x59, 0x28, 0x89, 0x44,
+	0xef, 0x5f, 0x80, 0xe6, 0xe1, 0xc7, 0xc6, 0x09, 0xc4, 0x21, 0x95, 0x77, 0x66, 0xce, 0xf9, 0xce,
+	0xf9, 0xbe, 0xf3, 0x30, 0xf8, 0x2c, 0xea, 0x10, 0x84, 0x93, 0xa4, 0x17, 0x11, 0x2c, 0x22, 0x16,
+	0x73, 0x14, 0xc5, 0x82, 0xa6, 0xa4, 0x8b, 0xa3, 0xf8, 0x08, 0x13, 0xc2, 0x06, 0xb1, 0xe0, 0xa8,
+	0xcb, 0xb8, 0x40, 0xc3, 0x26, 0x3a, 0x1e, 0xd0, 0x74, 0xe4, 0x25, 0x29, 0x13, 0x0c, 0xbe, 0x88,
+	0x3a, 0xc4, 0x5b, 0xf4, 0xf4, 0x6e, 0xf1, 0xf4, 0xa4, 0xa7, 0x37, 0x6c, 0xda, 0x95, 0x90, 0x85,
+	0x4c, 0x39, 0x22, 0xf9, 0xa5, 0x31, 0xec, 0xb7, 0x43, 0xc6, 0xc2, 0x1e, 0x45, 0x38, 0x89, 0x10,
+	0x8e, 0x63, 0x26, 0x0c, 0x92, 0x7e, 0xfd, 0x80, 0x30, 0xde, 0x67, 0x1c, 0x75, 0x30, 0xa7, 0x3a,
+	0x34, 0x1a, 0x36, 0x3b, 0x54, 0xe0, 0x26, 0x4a, 0x70, 0x18, 0xc5, 0xca, 0xd8, 0xd8, 0x6e, 0xaf,
+	0xc4, 0x43, 0x65, 0xa5, 0x1d, 0xdf, 0x95, 0x8e, 0x84, 0xa5, 0x14, 0x91, 0x2e, 0x8e, 0x63, 0xda,
+	0x93, 0xef, 0xe6, 0x53, 0x9b, 0xb8, 0x15, 0x00, 0xbf, 0x93, 0xd1, 0x0f, 0x70, 0x8a, 0xfb, 0xdc,
+	0xa7, 0xc7, 0x03, 0xca, 0x85, 0x4b, 0xc0, 0xeb, 0x99, 0x5b, 0x9e, 0xb0, 0x98, 0x53, 0xb8, 0x0f,
+	0x4a, 0x89, 0xba, 0xa9, 0x5a, 0x75, 0xab, 0xf1, 0xb4, 0xb5, 0xe5, 0xad, 0xa2, 0x93, 0x67, 0xd0,
+	0x0c, 0x86, 0x1b, 0x00, 0x5b, 0x05, 0x79, 0xa5, 0x13, 0x3a, 0x1c, 0xf4, 0xfb, 0x38, 0x1d, 0x99,
+	0x14, 0xe0, 0x57, 0x00, 0xcc, 0x85, 0x30, 0xf1, 0xde, 0xf3, 0xb4, 0x6a, 0x9e, 0x54, 0xcd, 0xd3,
+	0x05, 0x33, 0xaa, 0x79, 0x07, 0x38, 0xa4, 0xc6, 0xd7, 0x5f, 0xf0, 0x74, 0x7f, 0x2e, 0x80, 0xb7,
+	0x6e, 0x0d, 0x63, 0x38, 0x9d, 0x59, 0xe0, 0x39, 0x26, 0x22, 0x1a, 0xd2, 0x23, 0xa3, 0x8c, 0x64,
+	0x57, 0x68, 0x3c, 0x6d, 0x7d, 0xb1, 0x1a, 0xbb, 0x3d, 0xc6, 0xc5, 0x8e, 0x02, 0x32, 0x91, 0x76,
+	0x9d, 0xf3, 0x71, 0x2d, 0x37, 0x19, 0xd7, 0xde, 0x1c, 0xe1, 0x7e, 0xef, 0x73, 0xf7, 0x46, 0x14,
+	0xd7, 0x2f, 0xe3, 0x45, 0x73, 0x0e, 0x5f, 0x82, 0xd7, 0x58, 0x42, 0xe3, 0x79, 0x1e, 0xf9, 0xba,
+	0xd5, 0x58, 0xdb, 0xad, 0x4e, 0xc6, 0xb5, 0x8a, 0x86, 0xc8, 0x3c, 0xbb, 0xfe, 0x33, 0x79, 0x9e,
+	0xb9, 0xbf, 0x02, 0xcf, 0x49, 0x8f, 0x71, 0x1a, 0xcc, 0x01, 0x0a, 0x0a, 0xc0, 0x9e, 0xe7, 0x70,
+	0xc3, 0xc0, 0xf5, 0xcb, 0xfa, 0x66, 0x06, 0x52, 0x01, 0xc5, 0x84, 0xa5, 0x82, 0x57, 0xd7, 0xea,
+	0x85, 0xc6, 0xba, 0xaf, 0x0f, 0xf0, 0xeb, 0x4c, 0x31, 0x8a, 0xaa, 0x18, 0xef, 0xff, 0x67, 0x31,
+	0xb4, 0xc2, 0x99, 0x6a, 0xfc, 0x6d, 0x81, 0x8d, 0x25, 0xa1, 0x24, 0x71, 0xc2, 0xe2, 0x98, 0x12,
+	0x69, 0x73, 0x14, 0x05, 0xaa, 0xdc, 0xeb, 0x8b, 0xc4, 0x33, 0xcf, 0xae, 0xff, 0x6c, 0x7e, 0x6e,
+	0x07, 0xf0, 0x43, 0xf0, 0x44, 0xa6, 0x29, 0x1d, 0xf3, 0xca, 0x11, 0x4e, 0xc6, 0xb5, 0xb2, 0x76,
+	0x34, 0x0f, 0xae, 0x5f, 0x92, 0x5f, 0xed, 0x00, 0x6e, 0x01, 0x60, 0xd8, 0x4b, 0xfb, 0x82, 0xb2,
+	0x7f, 0x63, 0x32, 0xae, 0x6d, 0x98, 0x40, 0xb3, 0x37, 0xd7, 0x5f, 0x37, 0x87, 0x76, 0x00, 0x3f,
+	0x02, 0x45, 0x2e, 0xb0, 0xa0, 0xd5, 0xb5, 0xba, 0xd5, 0x28, 0xb7, 0x6c, 0xd5, 0x1a, 0x72, 0xb2,
+	0xbc, 0xe9, 0x38, 0x0d, 0x9b, 0xde, 0xa1, 0xb4, 0xf0, 0xb5, 0xa1, 0x2b, 0xc0, 0x3b, 0xaa, 0xed,
+	0xda, 0xb3, 0x96, 0xd9, 0xd1, 0x1d, 0x33, 0x6d, 0xf0, 0x0a, 0x28, 0xb2, 0x1f, 0x63, 0x9a, 0x6a,
+	0xb2, 0xbe, 0x3e, 0x2c, 0x4b, 0x91, 0x5f, 0x45, 0x0a, 0x37, 0x01, 0xce, 0x5d, 0x51, 0x4d, 0xbf,
+	0x57, 0xc1, 0x13, 0x1c, 0x04, 0x29, 0xe5, 0xdc, 0x04, 0x9e, 0x1e, 0x6f, 0x28, 0x93, 0xbf, 0x9f,
+	0x32, 0xad, 0xdf, 0x8a, 0xa0, 0xa8, 0x42, 0xc2, 0xdf, 0x2d, 0x50, 0xd2, 0x23, 0x0e, 0xbf, 0x5c,
+	0x6d, 0x74, 0x96, 0x37, 0x90, 0xbd, 0xf3, 0x3f, 0x10, 0x34, 0x53, 0x77, 0xeb, 0xa7, 0x3f, 0xae,
+	0x7f, 0xcd, 0x7b, 0xf0, 0x05, 0x32, 0xfb, 0xf3, 0xdf, 0xf7, 0xa6, 0xde, 0x4a, 0xf0, 0xda, 0x02,
+	0xe5, 0xec, 0xaa, 0x80, 0x7b, 0x0f, 0xc8, 0xe5, 0xd6, 0xa5, 0x66, 0xb7, 0x1f, 0x01, 0xc9, 0xb0,
+	0x7b, 0xa9, 0xd8, 0x6d, 0xc3, 0x4f, 0xee, 0xc7, 0x6e, 0x5a, 0x3d, 0x6e, 0x38, 0x9d, 0xe5, 0xc1,
+	0xc6, 0x52, 0x93, 0xc0, 0x6f, 0x1f, 0x90, 0xdf, 0x5d, 0x0d, 0x6e, 0xef, 0x3f, 0x0e, 0x98, 0xe1,
+	0xfb, 0xbd, 0xe2, 0xeb, 0xc3, 0x83, 0xfb, 0xf1, 0x55, 0xd3, 0xc4, 0xd1, 0x89, 0xfa, 0x3d, 0x45,
+	0xf3, 0x21, 0xe1, 0xe8, 0x24, 0x33, 0x41, 0xa7, 0xbb, 0xc1, 0xf9, 0xa5, 0x63, 0x5d, 0x5c, 0x3a,
+	0xd6, 0x5f, 0x97, 0x8e, 0xf5, 0xcb, 0x95, 0x93, 0xbb, 0xb8, 0x72, 0x72, 0x7f, 0x5e, 0x39, 0xb9,
+	0x1f, 0xbe, 0x09, 0x23, 0xd1, 0x1d, 0x74, 0x3c, 0xc2, 0xfa, 0xc8, 0xfc, 0x5f, 0x47, 0x1d, 0xb2,
+	0x19, 0x32, 0x34, 0xfc, 0x14, 0xf5, 0x59, 0x30, 0xe8, 0x51, 0xae, 0x53, 0x69, 0x6d, 0x6f, 0xce,
+	0xb3, 0xd9, 0xcc, 0x66, 0x23, 0x46, 0x09, 0xe5, 0x9d, 0x92, 0xfa, 0xbf, 0xfd, 0xf8, 0x9f, 0x01,
+	0x00, 0x57, 0xac, 0x57, 0x1e, 0x95, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +505,13 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ChannelSummary queries the active channels of the ICA host submodule along with their channel states and
+	// the ports the ICA host submodule is bound to. The active channels are paginated, while the numbers of open
+	// and closed channels are counted over all active channels.
+	ChannelSummary(ctx context.Context, in *QueryChannelSummaryRequest, opts ...grpc.CallOption) (*QueryChannelSummaryResponse, error)
+	// InterchainAccount returns the interchain account address and active channel of a given controller owner
+	// address on a particular host connection
+	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
 }
 
 type queryClient struct {
@@ -177,10 +531,35 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ChannelSummary(ctx context.Context, in *QueryChannelSummaryRequest, opts ...grpc.CallOption) (*QueryChannelSummaryResponse, error) {
+	out := new(QueryChannelSummaryResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/ChannelSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error) {
+	out := new(QueryInterchainAccountResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ChannelSummary queries the active channels of the ICA host submodule along with their channel states and
+	// the ports the ICA host submodule is bound to. The active channels are paginated, while the numbers of open
+	// and closed channels are counted over all active channels.
+	ChannelSummary(context.Context, *QueryChannelSummaryRequest) (*QueryChannelSummaryResponse, error)
+	// InterchainAccount returns the interchain account address and active channel of a given controller owner
+	// address on a particular host connection
+	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -190,6 +569,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ChannelSummary(ctx context.Context, req *QueryChannelSummaryRequest) (*QueryChannelSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelSummary not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccount(ctx context.Context, req *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccount not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -213,6 +598,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ChannelSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/ChannelSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelSummary(ctx, req.(*QueryChannelSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccount(ctx, req.(*QueryInterchainAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.host.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -221,6 +642,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ChannelSummary",
+			Handler:    _Query_ChannelSummary_Handler,
+		},
+		{
+			MethodName: "InterchainAccount",
+			Handler:    _Query_InterchainAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/host/v1/query.proto",
@@ -284,41 +713,369 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryChannelSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryChannelSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryChannelSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryChannelSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Ports) > 0 {
+		for iNdEx := len(m.Ports) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ports[iNdEx])
+			copy(dAtA[i:], m.Ports[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Ports[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ClosedChannels != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClosedChannels))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OpenChannels != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OpenChannels))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ActiveChannels) > 0 {
+		for iNdEx := len(m.ActiveChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostActiveChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostActiveChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostActiveChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActiveChannels) > 0 {
+		for _, e := range m.ActiveChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.OpenChannels != 0 {
+		n += 1 + sovQuery(uint64(m.OpenChannels))
+	}
+	if m.ClosedChannels != 0 {
+		n += 1 + sovQuery(uint64(m.ClosedChannels))
+	}
+	if len(m.Ports) > 0 {
+		for _, s := range m.Ports {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *HostActiveChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -459,6 +1216,675 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryChannelSummaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelSummaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelSummaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelSummaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveChannels = append(m.ActiveChannels, HostActiveChannel{})
+			if err := m.ActiveChannels[len(m.ActiveChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenChannels", wireType)
+			}
+			m.OpenChannels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenChannels |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedChannels", wireType)
+			}
+			m.ClosedChannels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedChannels |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ports = append(m.Ports, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostActiveChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostActiveChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostActiveChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= types.State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+// AsyncMessageRouter defines a message router which may signal that the acknowledgement of a packet
+// is written asynchronously. If the host keeper is constructed with a message router implementing this
+// interface and IsAsync returns true for any message of an executed transaction, no acknowledgement is
+// returned upon receipt of the packet. Instead the packet is stored as pending and the module executing
+// the message is responsible for writing the acknowledgement using the host keeper WriteAcknowledgement
+// function, addressing the pending packet by the packet identifiers passed to IsAsync.
+type AsyncMessageRouter interface {
+	icatypes.MessageRouter
+
+	// IsAsync returns true if the acknowledgement of a packet executing the provided message is
+	// written asynchronously. The packetID holds the destination port, channel and sequence of the
+	// packet, which the executing module should record in order to write the acknowledgement. The
+	// provided context is the one the message was executed with, so state written to it is discarded
+	// if the transaction fails.
+	IsAsync(ctx sdk.Context, packetID channeltypes.PacketId, msg sdk.Msg) bool
+}
@@ -0,0 +1,666 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_accounts/host/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetConnectionAllowlist defines the governance gated msg to set or remove the allowlist of a connection,
+// which overrides the allow_messages parameter for interchain accounts registered over the connection.
+type MsgSetConnectionAllowlist struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// connection_id is the identifier of the connection the allowlist applies to.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// allow_messages defines a list of sdk message typeURLs allowed to be executed over the connection.
+	// An empty list denies the execution of all messages over the connection.
+	AllowMessages []string `protobuf:"bytes,3,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// remove deletes the allowlist of the connection if true, such that the allow_messages parameter applies
+	// to it again. The allow_messages field must be empty.
+	Remove bool `protobuf:"varint,4,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *MsgSetConnectionAllowlist) Reset()         { *m = MsgSetConnectionAllowlist{} }
+func (m *MsgSetConnectionAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConnectionAllowlist) ProtoMessage()    {}
+func (*MsgSetConnectionAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{0}
+}
+func (m *MsgSetConnectionAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConnectionAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConnectionAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConnectionAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConnectionAllowlist.Merge(m, src)
+}
+func (m *MsgSetConnectionAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConnectionAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConnectionAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConnectionAllowlist proto.InternalMessageInfo
+
+// MsgSetConnectionAllowlistResponse defines the Msg/SetConnectionAllowlist response type.
+type MsgSetConnectionAllowlistResponse struct {
+}
+
+func (m *MsgSetConnectionAllowlistResponse) Reset()         { *m = MsgSetConnectionAllowlistResponse{} }
+func (m *MsgSetConnectionAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConnectionAllowlistResponse) ProtoMessage()    {}
+func (*MsgSetConnectionAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{1}
+}
+func (m *MsgSetConnectionAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConnectionAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConnectionAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConnectionAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConnectionAllowlistResponse.Merge(m, src)
+}
+func (m *MsgSetConnectionAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConnectionAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConnectionAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConnectionAllowlistResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetConnectionAllowlist)(nil), "ibc.applications.interchain_accounts.host.v1.MsgSetConnectionAllowlist")
+	proto.RegisterType((*MsgSetConnectionAllowlistResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgSetConnectionAllowlistResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/host/v1/tx.proto", fileDescriptor_fa437afde7f1e7ae)
+}
+
+var fileDescriptor_fa437afde7f1e7ae = []byte{
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0xaa, 0xd3, 0x40,
+	0x14, 0x86, 0x33, 0x56, 0x2e, 0xb7, 0x83, 0xd7, 0x45, 0xb8, 0x5e, 0x72, 0x2f, 0x92, 0xd4, 0xb8,
+	0xe9, 0xc2, 0xce, 0xd0, 0x8a, 0x0a, 0x05, 0x41, 0xeb, 0x42, 0x14, 0x8a, 0x10, 0x77, 0x6e, 0xca,
+	0x64, 0x32, 0x24, 0x03, 0x49, 0x4e, 0xc8, 0x99, 0x44, 0xfb, 0x06, 0x2e, 0x7d, 0x84, 0x3e, 0x83,
+	0x4b, 0x9f, 0xc0, 0x65, 0x97, 0x2e, 0xa4, 0x48, 0xbb, 0x71, 0xdd, 0x27, 0x90, 0xb4, 0xd5, 0x5a,
+	0xb0, 0x0b, 0xe1, 0xee, 0xf2, 0x9f, 0x93, 0xef, 0x3f, 0x67, 0x0e, 0x3f, 0x7d, 0xa4, 0x43, 0xc9,
+	0x45, 0x51, 0xa4, 0x5a, 0x0a, 0xa3, 0x21, 0x47, 0xae, 0x73, 0xa3, 0x4a, 0x99, 0x08, 0x9d, 0x4f,
+	0x84, 0x94, 0x50, 0xe5, 0x06, 0x79, 0x02, 0x68, 0x78, 0xdd, 0xe7, 0xe6, 0x03, 0x2b, 0x4a, 0x30,
+	0x60, 0x3f, 0xd0, 0xa1, 0x64, 0x7f, 0x63, 0xec, 0x1f, 0x18, 0x6b, 0x30, 0x56, 0xf7, 0xaf, 0xce,
+	0x63, 0x88, 0x61, 0x03, 0xf2, 0xe6, 0x6b, 0xeb, 0xe1, 0x7f, 0x27, 0xf4, 0x72, 0x8c, 0xf1, 0x5b,
+	0x65, 0x5e, 0x40, 0x9e, 0x2b, 0xd9, 0xf8, 0x3c, 0x4f, 0x53, 0x78, 0x9f, 0x6a, 0x34, 0xf6, 0x5d,
+	0xda, 0x16, 0x95, 0x49, 0xa0, 0xd4, 0x66, 0xea, 0x90, 0x0e, 0xe9, 0xb6, 0x83, 0x7d, 0xc1, 0x7e,
+	0x4a, 0xcf, 0xe4, 0x1f, 0x68, 0xa2, 0x23, 0xe7, 0x46, 0xf3, 0xc7, 0xc8, 0x59, 0x2f, 0xbc, 0xf3,
+	0xa9, 0xc8, 0xd2, 0xa1, 0x7f, 0xd0, 0xf6, 0x83, 0x5b, 0x7b, 0xfd, 0x2a, 0xb2, 0x9f, 0xd1, 0xdb,
+	0xa2, 0x99, 0x34, 0xc9, 0x14, 0xa2, 0x88, 0x15, 0x3a, 0xad, 0x4e, 0xab, 0xdb, 0x1e, 0x5d, 0xae,
+	0x17, 0xde, 0x9d, 0x2d, 0x7f, 0xd8, 0xf7, 0x83, 0xb3, 0x4d, 0x61, 0xbc, 0xd3, 0xf6, 0x05, 0x3d,
+	0x29, 0x55, 0x06, 0xb5, 0x72, 0x6e, 0x76, 0x48, 0xf7, 0x34, 0xd8, 0xa9, 0xe1, 0xe9, 0xc7, 0x99,
+	0x67, 0xfd, 0x9c, 0x79, 0x96, 0x7f, 0x9f, 0xde, 0x3b, 0xfa, 0xba, 0x40, 0x61, 0x01, 0x39, 0xaa,
+	0xc1, 0x17, 0x42, 0x5b, 0x63, 0x8c, 0xed, 0xcf, 0x84, 0x5e, 0x1c, 0x39, 0xc4, 0x4b, 0xf6, 0x3f,
+	0xb7, 0x66, 0x47, 0x67, 0x5e, 0xbd, 0xb9, 0x26, 0xa3, 0xdf, 0xcb, 0x8f, 0xa2, 0xaf, 0x4b, 0x97,
+	0xcc, 0x97, 0x2e, 0xf9, 0xb1, 0x74, 0xc9, 0xa7, 0x95, 0x6b, 0xcd, 0x57, 0xae, 0xf5, 0x6d, 0xe5,
+	0x5a, 0xef, 0x5e, 0xc7, 0xda, 0x24, 0x55, 0xc8, 0x24, 0x64, 0x5c, 0x02, 0x66, 0x80, 0x5c, 0x87,
+	0xb2, 0x17, 0x03, 0xaf, 0x1f, 0xf3, 0x0c, 0xa2, 0x2a, 0x55, 0xd8, 0xa4, 0x0e, 0xf9, 0xe0, 0x49,
+	0x6f, 0xbf, 0x44, 0xef, 0x30, 0x70, 0x66, 0x5a, 0x28, 0x0c, 0x4f, 0x36, 0x69, 0x79, 0xf8, 0x6b,
+	0x00, 0x24, 0xea, 0x81, 0xff, 0xaa, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetConnectionAllowlist defines a rpc handler for MsgSetConnectionAllowlist.
+	SetConnectionAllowlist(ctx context.Context, in *MsgSetConnectionAllowlist, opts ...grpc.CallOption) (*MsgSetConnectionAllowlistResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetConnectionAllowlist(ctx context.Context, in *MsgSetConnectionAllowlist, opts ...grpc.CallOption) (*MsgSetConnectionAllowlistResponse, error) {
+	out := new(MsgSetConnectionAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/SetConnectionAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetConnectionAllowlist defines a rpc handler for MsgSetConnectionAllowlist.
+	SetConnectionAllowlist(context.Context, *MsgSetConnectionAllowlist) (*MsgSetConnectionAllowlistResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetConnectionAllowlist(ctx context.Context, req *MsgSetConnectionAllowlist) (*MsgSetConnectionAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConnectionAllowlist not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetConnectionAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetConnectionAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetConnectionAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/SetConnectionAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetConnectionAllowlist(ctx, req.(*MsgSetConnectionAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.interchain_accounts.host.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetConnectionAllowlist",
+			Handler:    _Msg_SetConnectionAllowlist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/interchain_accounts/host/v1/tx.proto",
+}
+
+func (m *MsgSetConnectionAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetConnectionAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetConnectionAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetConnectionAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetConnectionAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetConnectionAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetConnectionAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetConnectionAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetConnectionAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetConnectionAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetConnectionAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetConnectionAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetConnectionAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetConnectionAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -51,6 +51,7 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
 // RegisterInterfaces registers module concrete types into protobuf Any
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	controllertypes.RegisterInterfaces(registry)
+	hosttypes.RegisterInterfaces(registry)
 	types.RegisterInterfaces(registry)
 }
 
@@ -157,6 +158,7 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	}
 
 	if am.hostKeeper != nil {
+		hosttypes.RegisterMsgServer(cfg.MsgServer(), hostkeeper.NewMsgServerImpl(am.hostKeeper))
 		hosttypes.RegisterQueryServer(cfg.QueryServer(), am.hostKeeper)
 	}
 
@@ -210,8 +212,13 @@ func (AppModule) ConsensusVersion() uint64 { return 2 }
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 }
 
-// EndBlock implements the AppModule interface
+// EndBlock implements the AppModule interface. It sends the controller transactions pending the
+// registration of an interchain account whose channel opened during the block.
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if am.controllerKeeper != nil {
+		am.controllerKeeper.SendPendingTxs(ctx)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -23,4 +23,5 @@ var (
 	ErrInvalidTimeoutTimestamp     = sdkerrors.Register(ModuleName, 17, "timeout timestamp must be in the future")
 	ErrInvalidCodec                = sdkerrors.Register(ModuleName, 18, "codec is not supported")
 	ErrInvalidAccountReopening     = sdkerrors.Register(ModuleName, 19, "invalid account reopening")
+	ErrInvalidMsgGasLimits         = sdkerrors.Register(ModuleName, 20, "invalid message gas limits")
 )
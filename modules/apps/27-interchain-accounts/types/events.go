@@ -2,10 +2,14 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket    = "ics27_packet"
+	EventTypePendingTx = "ics27_pending_tx"
 
 	AttributeKeyAckError            = "error"
 	AttributeKeyHostChannelID       = "host_channel_id"
 	AttributeKeyControllerChannelID = "controller_channel_id"
+	AttributeKeyControllerPortID    = "controller_port_id"
+	AttributeKeyConnectionID        = "connection_id"
+	AttributeKeySequence            = "sequence"
 	AttributeKeyAckSuccess          = "success"
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -25,7 +26,7 @@ var (
 )
 
 // ValidateBasic performs basic validation of the interchain account packet data.
-// The memo may be empty.
+// The memo and the message gas limits may be empty.
 func (iapd InterchainAccountPacketData) ValidateBasic() error {
 	if iapd.Type == UNSPECIFIED {
 		return sdkerrors.Wrap(ErrInvalidOutgoingData, "packet data type cannot be unspecified")
@@ -39,6 +40,12 @@ func (iapd InterchainAccountPacketData) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
 	}
 
+	for i, gasLimit := range iapd.MsgGasLimits {
+		if gasLimit == 0 {
+			return sdkerrors.Wrapf(ErrInvalidMsgGasLimits, "gas limit of message %d cannot be zero", i)
+		}
+	}
+
 	return nil
 }
 
@@ -47,6 +54,16 @@ func (iapd InterchainAccountPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iapd))
 }
 
+// GetPacketSender returns the owner of the interchain account controller port over which the
+// packet is sent. An empty string is returned if the source port is not a controller port.
+func (iapd InterchainAccountPacketData) GetPacketSender(sourcePortID string) string {
+	if !strings.HasPrefix(sourcePortID, ControllerPortPrefix) {
+		return ""
+	}
+
+	return strings.TrimPrefix(sourcePortID, ControllerPortPrefix)
+}
+
 // GetBytes returns the JSON marshalled interchain account CosmosTx.
 func (ct CosmosTx) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ct))
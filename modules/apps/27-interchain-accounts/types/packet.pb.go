@@ -53,11 +53,15 @@ func (Type) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_89a080d7401cd393, []int{0}
 }
 
-// InterchainAccountPacketData is comprised of a raw transaction, type of transaction and optional memo field.
+// InterchainAccountPacketData is comprised of a raw transaction, type of transaction, optional memo field and
+// optional per message gas limits.
 type InterchainAccountPacketData struct {
 	Type Type   `protobuf:"varint,1,opt,name=type,proto3,enum=ibc.applications.interchain_accounts.v1.Type" json:"type,omitempty"`
 	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	// the gas limit of each message of the transaction, in the order of the messages. If set, each message is
+	// executed with its own gas meter and the acknowledgement result is a CosmosTxResult
+	MsgGasLimits []uint64 `protobuf:"varint,4,rep,packed,name=msg_gas_limits,json=msgGasLimits,proto3" json:"msg_gas_limits,omitempty"`
 }
 
 func (m *InterchainAccountPacketData) Reset()         { *m = InterchainAccountPacketData{} }
@@ -114,6 +118,13 @@ func (m *InterchainAccountPacketData) GetMemo() string {
 	return ""
 }
 
+func (m *InterchainAccountPacketData) GetMsgGasLimits() []uint64 {
+	if m != nil {
+		return m.MsgGasLimits
+	}
+	return nil
+}
+
 // CosmosTx contains a list of sdk.Msg's. It should be used when sending transactions to an SDK host chain.
 type CosmosTx struct {
 	Messages []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
@@ -159,10 +170,113 @@ func (m *CosmosTx) GetMessages() []*types.Any {
 	return nil
 }
 
+// MsgResult contains the result of a single message executed on an SDK host chain.
+type MsgResult struct {
+	// the response of the message
+	MsgResponse *types.Any `protobuf:"bytes,1,opt,name=msg_response,json=msgResponse,proto3" json:"msg_response,omitempty"`
+	// the gas consumed by the message
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *MsgResult) Reset()         { *m = MsgResult{} }
+func (m *MsgResult) String() string { return proto.CompactTextString(m) }
+func (*MsgResult) ProtoMessage()    {}
+func (*MsgResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{2}
+}
+func (m *MsgResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResult.Merge(m, src)
+}
+func (m *MsgResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResult proto.InternalMessageInfo
+
+func (m *MsgResult) GetMsgResponse() *types.Any {
+	if m != nil {
+		return m.MsgResponse
+	}
+	return nil
+}
+
+func (m *MsgResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+// CosmosTxResult contains the results of the messages of a CosmosTx executed with per message gas limits. It
+// is the acknowledgement result of interchain account packets which set the message gas limits.
+type CosmosTxResult struct {
+	MsgResults []*MsgResult `protobuf:"bytes,1,rep,name=msg_results,json=msgResults,proto3" json:"msg_results,omitempty"`
+}
+
+func (m *CosmosTxResult) Reset()         { *m = CosmosTxResult{} }
+func (m *CosmosTxResult) String() string { return proto.CompactTextString(m) }
+func (*CosmosTxResult) ProtoMessage()    {}
+func (*CosmosTxResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{3}
+}
+func (m *CosmosTxResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosTxResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosTxResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosTxResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosTxResult.Merge(m, src)
+}
+func (m *CosmosTxResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosTxResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosTxResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosTxResult proto.InternalMessageInfo
+
+func (m *CosmosTxResult) GetMsgResults() []*MsgResult {
+	if m != nil {
+		return m.MsgResults
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ibc.applications.interchain_accounts.v1.Type", Type_name, Type_value)
 	proto.RegisterType((*InterchainAccountPacketData)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccountPacketData")
 	proto.RegisterType((*CosmosTx)(nil), "ibc.applications.interchain_accounts.v1.CosmosTx")
+	proto.RegisterType((*MsgResult)(nil), "ibc.applications.interchain_accounts.v1.MsgResult")
+	proto.RegisterType((*CosmosTxResult)(nil), "ibc.applications.interchain_accounts.v1.CosmosTxResult")
 }
 
 func init() {
@@ -170,32 +284,38 @@ func init() {
 }
 
 var fileDescriptor_89a080d7401cd393 = []byte{
-	// 392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0xaa, 0xd3, 0x40,
-	0x14, 0xcd, 0xf8, 0x82, 0x3c, 0xe7, 0xc9, 0x7b, 0x25, 0xbc, 0x45, 0x8c, 0x10, 0xc2, 0x13, 0x31,
-	0x08, 0x99, 0xb1, 0x55, 0x74, 0xe3, 0xa6, 0xb6, 0x11, 0xba, 0x91, 0x12, 0x53, 0xa8, 0x6e, 0xc2,
-	0x64, 0x3a, 0xa6, 0x83, 0x4d, 0x26, 0x74, 0x26, 0xc5, 0xfc, 0x41, 0xe9, 0xca, 0x1f, 0xe8, 0xca,
-	0x9f, 0x71, 0xd9, 0xa5, 0x4b, 0x69, 0x7f, 0x44, 0x32, 0xc1, 0xb6, 0x0b, 0x17, 0xee, 0x0e, 0x87,
-	0x7b, 0xce, 0xbd, 0xe7, 0x1e, 0xf8, 0x8a, 0xa7, 0x14, 0x93, 0xb2, 0x5c, 0x70, 0x4a, 0x14, 0x17,
-	0x85, 0xc4, 0xbc, 0x50, 0x6c, 0x49, 0xe7, 0x84, 0x17, 0x09, 0xa1, 0x54, 0x54, 0x85, 0x92, 0x78,
-	0xd5, 0xc5, 0x25, 0xa1, 0x5f, 0x99, 0x42, 0xe5, 0x52, 0x28, 0x61, 0x3d, 0xe3, 0x29, 0x45, 0xe7,
-	0x2a, 0xf4, 0x0f, 0x15, 0x5a, 0x75, 0x9d, 0x47, 0x99, 0x10, 0xd9, 0x82, 0x61, 0x2d, 0x4b, 0xab,
-	0x2f, 0x98, 0x14, 0x75, 0xeb, 0xe1, 0xdc, 0x66, 0x22, 0x13, 0x1a, 0xe2, 0x06, 0xb5, 0xec, 0xdd,
-	0x1a, 0xc0, 0xc7, 0xa3, 0xa3, 0x57, 0xbf, 0xb5, 0x1a, 0xeb, 0xdd, 0x43, 0xa2, 0x88, 0xd5, 0x87,
-	0xa6, 0xaa, 0x4b, 0x66, 0x03, 0x0f, 0xf8, 0xd7, 0xbd, 0x00, 0xfd, 0xe7, 0x21, 0x28, 0xae, 0x4b,
-	0x16, 0x69, 0xa9, 0x65, 0x41, 0x73, 0x46, 0x14, 0xb1, 0xef, 0x79, 0xc0, 0x7f, 0x18, 0x69, 0xdc,
-	0x70, 0x39, 0xcb, 0x85, 0x7d, 0xe1, 0x01, 0xff, 0x41, 0xa4, 0xf1, 0xdd, 0x5b, 0x78, 0x39, 0x10,
-	0x32, 0x17, 0x32, 0xfe, 0x66, 0xbd, 0x80, 0x97, 0x39, 0x93, 0x92, 0x64, 0x4c, 0xda, 0xc0, 0xbb,
-	0xf0, 0xaf, 0x7a, 0xb7, 0xa8, 0x8d, 0x86, 0xfe, 0x46, 0x43, 0xfd, 0xa2, 0x8e, 0x8e, 0x53, 0xcf,
-	0xa7, 0xd0, 0x6c, 0x76, 0x5a, 0x4f, 0x61, 0x27, 0xfe, 0x34, 0x0e, 0x93, 0xc9, 0x87, 0x8f, 0xe3,
-	0x70, 0x30, 0x7a, 0x3f, 0x0a, 0x87, 0x1d, 0xc3, 0xb9, 0xd9, 0x6c, 0xbd, 0xab, 0x33, 0xca, 0x7a,
-	0x02, 0x6f, 0xf4, 0x58, 0x38, 0x0d, 0x07, 0x93, 0x38, 0x4c, 0xe2, 0x69, 0x07, 0x38, 0xd7, 0x9b,
-	0xad, 0x07, 0x4f, 0x8c, 0x63, 0xae, 0x7f, 0xb8, 0xc6, 0xbb, 0xe4, 0xe7, 0xde, 0x05, 0xbb, 0xbd,
-	0x0b, 0x7e, 0xef, 0x5d, 0xf0, 0xfd, 0xe0, 0x1a, 0xbb, 0x83, 0x6b, 0xfc, 0x3a, 0xb8, 0xc6, 0xe7,
-	0x30, 0xe3, 0x6a, 0x5e, 0xa5, 0x88, 0x8a, 0x1c, 0x53, 0x7d, 0x3a, 0xe6, 0x29, 0x0d, 0x32, 0x81,
-	0x57, 0xaf, 0x71, 0x2e, 0x66, 0xd5, 0x82, 0xc9, 0xa6, 0x6c, 0x89, 0x7b, 0x6f, 0x82, 0xd3, 0xa3,
-	0x82, 0x63, 0xcf, 0xcd, 0x7f, 0x64, 0x7a, 0x5f, 0x47, 0x7a, 0xf9, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0x80, 0x14, 0x19, 0x92, 0x1c, 0x02, 0x00, 0x00,
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6f, 0xd3, 0x4c,
+	0x14, 0x8c, 0xbf, 0x58, 0x1f, 0xe9, 0xa6, 0x4a, 0x23, 0xab, 0x87, 0x34, 0x48, 0x96, 0x15, 0x40,
+	0x44, 0x48, 0xf1, 0xd2, 0x80, 0xe8, 0x85, 0x4b, 0x48, 0x0d, 0x8a, 0x04, 0x28, 0x72, 0x13, 0x29,
+	0x70, 0xb1, 0xd6, 0x9b, 0x65, 0xbb, 0xc2, 0xeb, 0xb5, 0xf2, 0xd6, 0x11, 0xf9, 0x07, 0xa8, 0x27,
+	0xfe, 0x40, 0x4f, 0xdc, 0xf9, 0x1d, 0x1c, 0x7b, 0xe4, 0x88, 0x92, 0x3f, 0x82, 0xbc, 0x6e, 0xd2,
+	0x1e, 0x40, 0xea, 0x6d, 0x34, 0x7a, 0x33, 0x6f, 0xde, 0xe8, 0xa1, 0xe7, 0x22, 0xa6, 0x98, 0x64,
+	0x59, 0x22, 0x28, 0xd1, 0x42, 0xa5, 0x80, 0x45, 0xaa, 0xd9, 0x82, 0x9e, 0x13, 0x91, 0x46, 0x84,
+	0x52, 0x95, 0xa7, 0x1a, 0xf0, 0xf2, 0x18, 0x67, 0x84, 0x7e, 0x66, 0xda, 0xcf, 0x16, 0x4a, 0x2b,
+	0xe7, 0xb1, 0x88, 0xa9, 0x7f, 0x5b, 0xe5, 0xff, 0x45, 0xe5, 0x2f, 0x8f, 0xdb, 0x47, 0x5c, 0x29,
+	0x9e, 0x30, 0x6c, 0x64, 0x71, 0xfe, 0x09, 0x93, 0x74, 0x55, 0x7a, 0xb4, 0x0f, 0xb9, 0xe2, 0xca,
+	0x40, 0x5c, 0xa0, 0x92, 0xed, 0xfc, 0xb0, 0xd0, 0xfd, 0xd1, 0xce, 0x6b, 0x50, 0x5a, 0x8d, 0xcd,
+	0xee, 0x53, 0xa2, 0x89, 0x33, 0x40, 0xb6, 0x5e, 0x65, 0xac, 0x65, 0x79, 0x56, 0xb7, 0xd1, 0xef,
+	0xf9, 0x77, 0x0c, 0xe2, 0x4f, 0x56, 0x19, 0x0b, 0x8d, 0xd4, 0x71, 0x90, 0x3d, 0x27, 0x9a, 0xb4,
+	0xfe, 0xf3, 0xac, 0xee, 0x7e, 0x68, 0x70, 0xc1, 0x49, 0x26, 0x55, 0xab, 0xea, 0x59, 0xdd, 0xbd,
+	0xd0, 0x60, 0xe7, 0x21, 0x6a, 0x48, 0xe0, 0x11, 0x27, 0x10, 0x25, 0x42, 0x0a, 0x0d, 0x2d, 0xdb,
+	0xab, 0x76, 0xed, 0x70, 0x5f, 0x02, 0x7f, 0x43, 0xe0, 0xad, 0xe1, 0x3a, 0x2f, 0x51, 0x6d, 0xa8,
+	0x40, 0x2a, 0x98, 0x7c, 0x71, 0x9e, 0xa2, 0x9a, 0x64, 0x00, 0x84, 0x33, 0x68, 0x59, 0x5e, 0xb5,
+	0x5b, 0xef, 0x1f, 0xfa, 0x65, 0x01, 0xfe, 0xb6, 0x00, 0x7f, 0x90, 0xae, 0xc2, 0xdd, 0x54, 0x27,
+	0x42, 0x7b, 0xef, 0x80, 0x87, 0x0c, 0xf2, 0x44, 0x3b, 0x27, 0xa8, 0xb0, 0x8e, 0x16, 0x0c, 0x32,
+	0x95, 0x42, 0x79, 0xe3, 0xbf, 0x2c, 0xea, 0xd2, 0xc8, 0xcc, 0xa0, 0x73, 0x84, 0x6a, 0x45, 0xca,
+	0x1c, 0xd8, 0xdc, 0x5c, 0x65, 0x87, 0xf7, 0x38, 0x81, 0x29, 0xb0, 0x79, 0x87, 0xa1, 0xc6, 0x36,
+	0xde, 0xf5, 0x96, 0x33, 0x54, 0xbf, 0xde, 0x92, 0x27, 0x7a, 0x9b, 0xb3, 0x7f, 0xe7, 0x22, 0x77,
+	0x71, 0x43, 0x24, 0xb7, 0x10, 0x9e, 0xcc, 0x90, 0x5d, 0x34, 0xec, 0x3c, 0x42, 0xcd, 0xc9, 0x87,
+	0x71, 0x10, 0x4d, 0xdf, 0x9f, 0x8d, 0x83, 0xe1, 0xe8, 0xf5, 0x28, 0x38, 0x6d, 0x56, 0xda, 0x07,
+	0x17, 0x97, 0x5e, 0xfd, 0x16, 0xe5, 0x3c, 0x40, 0x07, 0x66, 0x2c, 0x98, 0x05, 0xc3, 0xe9, 0x24,
+	0x88, 0x26, 0xb3, 0xa6, 0xd5, 0x6e, 0x5c, 0x5c, 0x7a, 0xe8, 0x86, 0x69, 0xdb, 0x5f, 0xbf, 0xbb,
+	0x95, 0x57, 0xd1, 0xcf, 0xb5, 0x6b, 0x5d, 0xad, 0x5d, 0xeb, 0xf7, 0xda, 0xb5, 0xbe, 0x6d, 0xdc,
+	0xca, 0xd5, 0xc6, 0xad, 0xfc, 0xda, 0xb8, 0x95, 0x8f, 0x01, 0x17, 0xfa, 0x3c, 0x8f, 0x7d, 0xaa,
+	0x24, 0xa6, 0xe6, 0x46, 0x2c, 0x62, 0xda, 0xe3, 0x0a, 0x2f, 0x5f, 0x60, 0xa9, 0xe6, 0x79, 0xc2,
+	0xa0, 0x78, 0x6d, 0xc0, 0xfd, 0x93, 0xde, 0xcd, 0x35, 0xbd, 0xdd, 0x57, 0x17, 0xdf, 0x00, 0xf1,
+	0xff, 0xa6, 0xd7, 0x67, 0x7f, 0x06, 0x00, 0xf9, 0xda, 0xc7, 0x5b, 0x0a, 0x03, 0x00, 0x00,
 }
 
 func (m *InterchainAccountPacketData) Marshal() (dAtA []byte, err error) {
@@ -218,6 +338,24 @@ func (m *InterchainAccountPacketData) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgGasLimits) > 0 {
+		dAtA2 := make([]byte, len(m.MsgGasLimits)*10)
+		var j1 int
+		for _, num := range m.MsgGasLimits {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPacket(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -277,6 +415,83 @@ func (m *CosmosTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MsgResponse != nil {
+		{
+			size, err := m.MsgResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosmosTxResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosTxResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosTxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -305,6 +520,13 @@ func (m *InterchainAccountPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if len(m.MsgGasLimits) > 0 {
+		l = 0
+		for _, e := range m.MsgGasLimits {
+			l += sovPacket(uint64(e))
+		}
+		n += 1 + sovPacket(uint64(l)) + l
+	}
 	return n
 }
 
@@ -323,6 +545,37 @@ func (m *CosmosTx) Size() (n int) {
 	return n
 }
 
+func (m *MsgResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgResponse != nil {
+		l = m.MsgResponse.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovPacket(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *CosmosTxResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovPacket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -443,6 +696,82 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPacket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MsgGasLimits = append(m.MsgGasLimits, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPacket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPacket
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPacket
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MsgGasLimits) == 0 {
+					m.MsgGasLimits = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPacket
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MsgGasLimits = append(m.MsgGasLimits, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasLimits", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -548,6 +877,195 @@ func (m *CosmosTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MsgResponse == nil {
+				m.MsgResponse = &types.Any{}
+			}
+			if err := m.MsgResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosTxResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosTxResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosTxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, &MsgResult{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPacket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -65,6 +65,24 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			},
 			false,
 		},
+		{
+			"success, message gas limits",
+			types.InterchainAccountPacketData{
+				Type:         types.EXECUTE_TX,
+				Data:         []byte("data"),
+				MsgGasLimits: []uint64{100000, 200000},
+			},
+			true,
+		},
+		{
+			"message gas limit is zero",
+			types.InterchainAccountPacketData{
+				Type:         types.EXECUTE_TX,
+				Data:         []byte("data"),
+				MsgGasLimits: []uint64{100000, 0},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -82,3 +100,13 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestGetPacketSender() {
+	packetData := types.InterchainAccountPacketData{
+		Type: types.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	suite.Require().Equal(TestOwnerAddress, packetData.GetPacketSender(types.ControllerPortPrefix+TestOwnerAddress))
+	suite.Require().Empty(packetData.GetPacketSender(types.HostPortID))
+}
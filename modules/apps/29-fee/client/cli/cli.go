@@ -44,6 +44,7 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewRegisterPayeeCmd(),
 		NewRegisterCounterpartyPayeeCmd(),
+		NewRegisterCounterpartyPayeeBatchCmd(),
 		NewPayPacketFeeAsyncTxCmd(),
 	)
 
@@ -72,6 +72,41 @@ func NewRegisterCounterpartyPayeeCmd() *cobra.Command {
 	return cmd
 }
 
+// NewRegisterCounterpartyPayeeBatchCmd returns the command to create a MsgRegisterCounterpartyPayeeBatch
+func NewRegisterCounterpartyPayeeBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-counterparty-payee-batch [relayer] [port-id:channel-id:counterparty-payee]...",
+		Short:   "Register counterparty payee addresses on multiple channels.",
+		Long:    strings.TrimSpace(`Register counterparty payee addresses on multiple channels. No counterparty payee is registered if any of the registrations is invalid.`),
+		Example: fmt.Sprintf("%s tx ibc-fee register-counterparty-payee-batch cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh transfer:channel-0:osmo1v5y0tz01llxzf4c2afml8s3awue0ymju22wxx2 transfer:channel-1:juno1v5y0tz01llxzf4c2afml8s3awue0ymju22wxx2", version.AppName),
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var registrations []types.CounterpartyPayeeRegistration
+			for _, arg := range args[1:] {
+				fields := strings.SplitN(arg, ":", 3)
+				if len(fields) != 3 {
+					return fmt.Errorf("invalid counterparty payee registration %s, expected format port-id:channel-id:counterparty-payee", arg)
+				}
+
+				registrations = append(registrations, types.NewCounterpartyPayeeRegistration(fields[0], fields[1], fields[2]))
+			}
+
+			msg := types.NewMsgRegisterCounterpartyPayeeBatch(args[0], registrations)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewPayPacketFeeAsyncTxCmd returns the command to create a MsgPayPacketFeeAsync
 func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
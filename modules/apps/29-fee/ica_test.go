@@ -1,11 +1,14 @@
 package fee_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/gogo/protobuf/proto"
 
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
@@ -182,6 +185,117 @@ func (suite *FeeTestSuite) TestFeeInterchainAccounts() {
 	suite.Require().Equal(preEscrowBalance.SubAmount(defaultRecvFee.AmountOf(sdk.DefaultBondDenom)), postDistBalance)
 }
 
+// TestFeeInterchainAccountsEndToEnd registers a fee enabled interchain account with MsgRegisterInterchainAccount,
+// executes a tx through it with MsgSendTx and ensures the relayers are paid once the packet is acknowledged.
+func (suite *FeeTestSuite) TestFeeInterchainAccountsEndToEnd() {
+	path := NewIncentivizedICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	suite.Require().NoError(err)
+
+	msgRegisterInterchainAccount := icacontrollertypes.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, owner, path.EndpointA.ChannelConfig.Version)
+	res, err := suite.chainA.SendMsgs(msgRegisterInterchainAccount)
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelID, err = ibctesting.ParseChannelIDFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	path.EndpointA.ChannelConfig.PortID = portID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+	suite.Require().True(found)
+
+	// assert the channel version wraps the interchain accounts metadata in the fee version on both ends
+	channelA := path.EndpointA.GetChannel()
+	channelB := path.EndpointB.GetChannel()
+	suite.Require().Equal(channelA.Version, channelB.Version)
+
+	var versionMetadata types.Metadata
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON([]byte(channelA.Version), &versionMetadata))
+	suite.Require().Equal(types.Version, versionMetadata.FeeVersion)
+
+	var icaMetadata icatypes.Metadata
+	suite.Require().NoError(icatypes.ModuleCdc.UnmarshalJSON([]byte(versionMetadata.AppVersion), &icaMetadata))
+	suite.Require().Equal(interchainAccountAddr, icaMetadata.Address)
+
+	// the interchain accounts keepers are provided the unwrapped interchain accounts metadata
+	appVersion, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetAppVersion(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(versionMetadata.AppVersion, appVersion)
+
+	appVersion, found = suite.chainB.GetSimApp().ICAHostKeeper.GetAppVersion(suite.chainB.GetContext(), icatypes.HostPortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(versionMetadata.AppVersion, appVersion)
+
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID))
+	suite.Require().True(suite.chainB.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), icatypes.HostPortID, path.EndpointB.ChannelID))
+
+	// fund the interchain account on chainB and allow it to execute bank sends
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))
+	_, err = suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(interchainAccountAddr), coins))
+	suite.Require().NoError(err)
+
+	msgSend := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(interchainAccountAddr), suite.chainB.SenderAccounts[1].SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000))))
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(msgSend)}))
+
+	// register the payees of the relayers: the recv fee is paid to recvPayee and the ack fee to ackPayee
+	recvPayee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	ackPayee := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+	_, err = suite.chainB.SendMsgs(types.NewMsgRegisterCounterpartyPayee(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.SenderAccount.GetAddress().String(), recvPayee.String()))
+	suite.Require().NoError(err)
+
+	_, err = suite.chainA.SendMsgs(types.NewMsgRegisterPayee(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), ackPayee.String()))
+	suite.Require().NoError(err)
+
+	// escrow the packet fee and send the interchain accounts tx in the same tx
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msgSend})
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	msgs := []sdk.Msg{
+		types.NewMsgPayPacketFee(fee, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, owner, nil),
+		icacontrollertypes.NewMsgSendTx(owner, path.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()), icaPacketData),
+	}
+
+	res, err = suite.chainA.SendMsgs(msgs...)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	packetID := channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().True(found)
+
+	payerBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress())
+	recvPayeeBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), recvPayee)
+	ackPayeeBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), ackPayee)
+
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	// the interchain accounts tx has been executed on chainB
+	suite.Require().Equal(coins.Sub(msgSend.Amount...), suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), sdk.MustAccAddressFromBech32(interchainAccountAddr)))
+
+	// the escrowed fees are distributed: the relayer payees are paid and the timeout fee is refunded
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().False(found)
+
+	suite.Require().Equal(recvPayeeBalance.Add(fee.RecvFee...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), recvPayee))
+	suite.Require().Equal(ackPayeeBalance.Add(fee.AckFee...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), ackPayee))
+	suite.Require().Equal(payerBalance.Add(fee.TimeoutFee...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress()))
+}
+
 func buildInterchainAccountsPacket(path *ibctesting.Path, data []byte, seq uint64) channeltypes.Packet {
 	packet := channeltypes.NewPacket(
 		data,
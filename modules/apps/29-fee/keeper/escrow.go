@@ -100,7 +100,9 @@ func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr
 	k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.TimeoutFee)
 }
 
-// DistributePacketsFeesOnTimeout pays all the timeout fees for a given packetID while refunding the acknowledgement & receive fees to the refund account.
+// DistributePacketFeesOnTimeout pays all the timeout fees for a given packetID to the timeout relayer while refunding the
+// acknowledgement & receive fees to the refund address of each packet fee. The timeout relayer is expected to be the payee
+// address registered by the relayer submitting the MsgTimeout, if any.
 func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
 	// cache context before trying to distribute fees
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
@@ -194,6 +194,22 @@ func (suite *KeeperTestSuite) TestQueryIncentivizedPacketsForChannel() {
 			},
 			true,
 		},
+		{
+			"success: paginated",
+			func() {
+				expIdentifiedPacketFees = expIdentifiedPacketFees[:2]
+				req = &types.QueryIncentivizedPacketsForChannelRequest{
+					Pagination: &query.PageRequest{
+						Limit:      2,
+						CountTotal: true,
+					},
+					PortId:      ibctesting.MockFeePort,
+					ChannelId:   ibctesting.FirstChannelID,
+					QueryHeight: 0,
+				}
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -225,6 +241,7 @@ func (suite *KeeperTestSuite) TestQueryIncentivizedPacketsForChannel() {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
 				suite.Require().Equal(expIdentifiedPacketFees, res.IncentivizedPackets)
+				suite.Require().NotNil(res.Pagination)
 			} else {
 				suite.Require().Error(err)
 			}
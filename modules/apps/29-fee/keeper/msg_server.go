@@ -55,22 +55,56 @@ func (k Keeper) RegisterPayee(goCtx context.Context, msg *types.MsgRegisterPayee
 func (k Keeper) RegisterCounterpartyPayee(goCtx context.Context, msg *types.MsgRegisterCounterpartyPayee) (*types.MsgRegisterCounterpartyPayeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// only register counterparty payee if the channel exists and is fee enabled
-	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
-		return nil, channeltypes.ErrChannelNotFound
+	if err := k.validateCounterpartyPayeeChannel(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, err
 	}
 
-	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
-		return nil, types.ErrFeeNotEnabled
+	k.registerCounterpartyPayee(ctx, msg.Relayer, msg.CounterpartyPayee, msg.ChannelId)
+
+	return &types.MsgRegisterCounterpartyPayeeResponse{}, nil
+}
+
+// RegisterCounterpartyPayeeBatch defines a rpc handler method for MsgRegisterCounterpartyPayeeBatch
+// RegisterCounterpartyPayeeBatch registers the counterparty payee addresses of a relayer on multiple channels as
+// done by RegisterCounterpartyPayee. All channels are validated before any counterparty payee is registered, such
+// that no registration takes place if any of the channels does not exist or is not fee enabled.
+func (k Keeper) RegisterCounterpartyPayeeBatch(goCtx context.Context, msg *types.MsgRegisterCounterpartyPayeeBatch) (*types.MsgRegisterCounterpartyPayeeBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, registration := range msg.Registrations {
+		if err := k.validateCounterpartyPayeeChannel(ctx, registration.PortId, registration.ChannelId); err != nil {
+			return nil, sdkerrors.Wrapf(err, "port ID (%s) channel ID (%s)", registration.PortId, registration.ChannelId)
+		}
 	}
 
-	k.SetCounterpartyPayeeAddress(ctx, msg.Relayer, msg.CounterpartyPayee, msg.ChannelId)
+	for _, registration := range msg.Registrations {
+		k.registerCounterpartyPayee(ctx, msg.Relayer, registration.CounterpartyPayee, registration.ChannelId)
+	}
 
-	k.Logger(ctx).Info("registering counterparty payee for relayer", "relayer", msg.Relayer, "counterparty payee", msg.CounterpartyPayee, "channel", msg.ChannelId)
+	return &types.MsgRegisterCounterpartyPayeeBatchResponse{}, nil
+}
 
-	EmitRegisterCounterpartyPayeeEvent(ctx, msg.Relayer, msg.CounterpartyPayee, msg.ChannelId)
+// validateCounterpartyPayeeChannel returns an error if the channel does not exist or is not fee enabled,
+// since a counterparty payee may only be registered on fee enabled channels
+func (k Keeper) validateCounterpartyPayeeChannel(ctx sdk.Context, portID, channelID string) error {
+	if _, found := k.channelKeeper.GetChannel(ctx, portID, channelID); !found {
+		return channeltypes.ErrChannelNotFound
+	}
 
-	return &types.MsgRegisterCounterpartyPayeeResponse{}, nil
+	if !k.IsFeeEnabled(ctx, portID, channelID) {
+		return types.ErrFeeNotEnabled
+	}
+
+	return nil
+}
+
+// registerCounterpartyPayee stores the counterparty payee address of the relayer on the given channel and emits an event
+func (k Keeper) registerCounterpartyPayee(ctx sdk.Context, relayer, counterpartyPayee, channelID string) {
+	k.SetCounterpartyPayeeAddress(ctx, relayer, counterpartyPayee, channelID)
+
+	k.Logger(ctx).Info("registering counterparty payee for relayer", "relayer", relayer, "counterparty payee", counterpartyPayee, "channel", channelID)
+
+	EmitRegisterCounterpartyPayeeEvent(ctx, relayer, counterpartyPayee, channelID)
 }
 
 // PayPacketFee defines a rpc handler method for MsgPayPacketFee
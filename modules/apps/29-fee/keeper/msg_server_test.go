@@ -161,6 +161,75 @@ func (suite *KeeperTestSuite) TestRegisterCounterpartyPayee() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRegisterCounterpartyPayeeBatch() {
+	var msg *types.MsgRegisterCounterpartyPayeeBatch
+
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"channel does not exist",
+			false,
+			func() {
+				msg.Registrations[1].ChannelId = "channel-100"
+			},
+		},
+		{
+			"channel is not fee enabled",
+			false,
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.pathAToC.EndpointA.ChannelConfig.PortID, suite.pathAToC.EndpointA.ChannelID)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.coordinator.Setup(suite.path)     // setup channel
+		suite.coordinator.Setup(suite.pathAToC) // setup channel
+
+		relayer := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+		counterpartyPayee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+		msg = types.NewMsgRegisterCounterpartyPayeeBatch(
+			relayer,
+			[]types.CounterpartyPayeeRegistration{
+				types.NewCounterpartyPayeeRegistration(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, counterpartyPayee),
+				types.NewCounterpartyPayeeRegistration(suite.pathAToC.EndpointA.ChannelConfig.PortID, suite.pathAToC.EndpointA.ChannelID, counterpartyPayee),
+			},
+		)
+
+		tc.malleate()
+
+		res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterCounterpartyPayeeBatch(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+		for _, channelID := range []string{suite.path.EndpointA.ChannelID, suite.pathAToC.EndpointA.ChannelID} {
+			registeredPayee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), relayer, channelID)
+
+			if tc.expPass {
+				suite.Require().True(found)
+				suite.Require().Equal(counterpartyPayee, registeredPayee)
+			} else {
+				// no counterparty payee is registered if any registration of the batch is invalid
+				suite.Require().False(found)
+			}
+		}
+
+		if tc.expPass {
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+		} else {
+			suite.Require().Error(err)
+		}
+	}
+}
+
 func (suite *KeeperTestSuite) TestPayPacketFee() {
 	var (
 		expEscrowBalance sdk.Coins
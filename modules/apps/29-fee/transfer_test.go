@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 )
 
@@ -68,3 +69,73 @@ func (suite *FeeTestSuite) TestFeeTransfer() {
 		fee.AckFee.Add(fee.TimeoutFee...), // ack fee paid, timeout fee refunded
 		sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), ibctesting.TestCoin.Denom)).Sub(originalChainASenderAccountBalance[0]))
 }
+
+// Integration test to ensure the timeout fee is paid to the timeout relayer's payee and the
+// receive and acknowledgement fees are refunded to the payer when an ics20 packet times out
+func (suite *FeeTestSuite) TestFeeTransferTimeout() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	feeTransferVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: transfertypes.Version}))
+	path.EndpointA.ChannelConfig.Version = feeTransferVersion
+	path.EndpointB.ChannelConfig.Version = feeTransferVersion
+	path.EndpointA.ChannelConfig.PortID = transfertypes.PortID
+	path.EndpointB.ChannelConfig.PortID = transfertypes.PortID
+
+	suite.coordinator.Setup(path)
+
+	// the timeout relayer is chainA.SenderAccount, which registers a payee address distinct from
+	// the payer to differentiate the timeout fee from the refunded fees
+	payer := suite.chainA.SenderAccount.GetAddress()
+	payee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	msgRegister := types.NewMsgRegisterPayee(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, payer.String(), payee.String())
+	_, err := suite.chainA.SendMsgs(msgRegister)
+	suite.Require().NoError(err) // message committed
+
+	// set up coin & ics20 packet which times out at the next block height of chainB
+	coin := ibctesting.TestCoin
+	fee := types.Fee{
+		RecvFee:    defaultRecvFee,
+		AckFee:     defaultAckFee,
+		TimeoutFee: defaultTimeoutFee,
+	}
+	timeoutHeight := clienttypes.NewHeight(clienttypes.ParseChainID(suite.chainB.ChainID), uint64(suite.chainB.GetContext().BlockHeight())+1)
+
+	msgs := []sdk.Msg{
+		types.NewMsgPayPacketFee(fee, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, payer.String(), nil),
+		transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, payer.String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, ""),
+	}
+	res, err := suite.chainA.SendMsgs(msgs...)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	packetID := channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+
+	// after incentivizing and sending the packet
+	payerBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payer, sdk.DefaultBondDenom)
+	payeeBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
+
+	// time out the packet
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+
+	// the payee of the timeout relayer is paid only the timeout fee
+	suite.Require().Equal(
+		payeeBalance.Add(fee.TimeoutFee[0]),
+		suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom),
+	)
+
+	// the payer is refunded the transferred coin as well as the receive and acknowledgement fees
+	suite.Require().Equal(
+		payerBalance.Add(coin).Add(fee.RecvFee[0]).Add(fee.AckFee[0]),
+		suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payer, sdk.DefaultBondDenom),
+	)
+
+	// the fee module escrow account holds no remaining fees
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom).IsZero())
+}
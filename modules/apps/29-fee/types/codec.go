@@ -14,6 +14,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPayPacketFeeAsync{}, "cosmos-sdk/MsgPayPacketFeeAsync", nil)
 	cdc.RegisterConcrete(&MsgRegisterPayee{}, "cosmos-sdk/MsgRegisterPayee", nil)
 	cdc.RegisterConcrete(&MsgRegisterCounterpartyPayee{}, "cosmos-sdk/MsgRegisterCounterpartyPayee", nil)
+	cdc.RegisterConcrete(&MsgRegisterCounterpartyPayeeBatch{}, "cosmos-sdk/MsgRegisterCounterpartyPayeeBatch", nil)
 }
 
 // RegisterInterfaces register the 29-fee module interfaces to protobuf
@@ -25,6 +26,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgPayPacketFeeAsync{},
 		&MsgRegisterPayee{},
 		&MsgRegisterCounterpartyPayee{},
+		&MsgRegisterCounterpartyPayeeBatch{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -99,6 +99,57 @@ func (msg MsgRegisterCounterpartyPayee) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+// NewCounterpartyPayeeRegistration creates a new instance of CounterpartyPayeeRegistration
+func NewCounterpartyPayeeRegistration(portID, channelID, counterpartyPayeeAddr string) CounterpartyPayeeRegistration {
+	return CounterpartyPayeeRegistration{
+		PortId:            portID,
+		ChannelId:         channelID,
+		CounterpartyPayee: counterpartyPayeeAddr,
+	}
+}
+
+// NewMsgRegisterCounterpartyPayeeBatch creates a new instance of MsgRegisterCounterpartyPayeeBatch
+func NewMsgRegisterCounterpartyPayeeBatch(relayerAddr string, registrations []CounterpartyPayeeRegistration) *MsgRegisterCounterpartyPayeeBatch {
+	return &MsgRegisterCounterpartyPayeeBatch{
+		Relayer:       relayerAddr,
+		Registrations: registrations,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterCounterpartyPayeeBatch fields. Each registration
+// is validated as a MsgRegisterCounterpartyPayee and a channel may only be registered once per batch.
+func (msg MsgRegisterCounterpartyPayeeBatch) ValidateBasic() error {
+	if len(msg.Registrations) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "counterparty payee registrations cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(msg.Registrations))
+	for i, registration := range msg.Registrations {
+		if err := NewMsgRegisterCounterpartyPayee(registration.PortId, registration.ChannelId, msg.Relayer, registration.CounterpartyPayee).ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid counterparty payee registration at index %d", i)
+		}
+
+		// counterparty payees are stored by relayer and channel identifier
+		if seen[registration.ChannelId] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate counterparty payee registration for channel %s", registration.ChannelId)
+		}
+
+		seen[registration.ChannelId] = true
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterCounterpartyPayeeBatch) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
 // NewMsgPayPacketFee creates a new instance of MsgPayPacketFee
 func NewMsgPayPacketFee(fee Fee, sourcePortID, sourceChannelID, signer string, relayers []string) *MsgPayPacketFee {
 	return &MsgPayPacketFee{
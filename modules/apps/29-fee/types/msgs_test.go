@@ -158,6 +158,89 @@ func TestRegisterCountepartyAddressGetSigners(t *testing.T) {
 	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(accAddress)}, msg.GetSigners())
 }
 
+func TestMsgRegisterCounterpartyPayeeBatchValidation(t *testing.T) {
+	var msg *types.MsgRegisterCounterpartyPayeeBatch
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty registrations",
+			func() {
+				msg.Registrations = nil
+			},
+			false,
+		},
+		{
+			"invalid relayer address",
+			func() {
+				msg.Relayer = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.Registrations[1].PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.Registrations[1].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid counterparty payee address: whitespaced empty string",
+			func() {
+				msg.Registrations[1].CounterpartyPayee = "  "
+			},
+			false,
+		},
+		{
+			"duplicate channel registration",
+			func() {
+				msg.Registrations[1].ChannelId = ibctesting.FirstChannelID
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgRegisterCounterpartyPayeeBatch(defaultAccAddress, []types.CounterpartyPayeeRegistration{
+			types.NewCounterpartyPayeeRegistration(ibctesting.MockPort, ibctesting.FirstChannelID, defaultAccAddress),
+			types.NewCounterpartyPayeeRegistration(ibctesting.MockPort, "channel-1", defaultAccAddress),
+		})
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestRegisterCounterpartyPayeeBatchGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgRegisterCounterpartyPayeeBatch(accAddress.String(), []types.CounterpartyPayeeRegistration{
+		types.NewCounterpartyPayeeRegistration(ibctesting.MockPort, ibctesting.FirstChannelID, defaultAccAddress),
+	})
+	require.Equal(t, []sdk.AccAddress{accAddress}, msg.GetSigners())
+}
+
 func TestMsgPayPacketFeeValidation(t *testing.T) {
 	var msg *types.MsgPayPacketFee
 
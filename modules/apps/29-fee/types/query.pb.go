@@ -92,6 +92,8 @@ func (m *QueryIncentivizedPacketsRequest) GetQueryHeight() uint64 {
 type QueryIncentivizedPacketsResponse struct {
 	// list of identified fees for incentivized packets
 	IncentivizedPackets []IdentifiedPacketFees `protobuf:"bytes,1,rep,name=incentivized_packets,json=incentivizedPackets,proto3" json:"incentivized_packets"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryIncentivizedPacketsResponse) Reset()         { *m = QueryIncentivizedPacketsResponse{} }
@@ -134,6 +136,13 @@ func (m *QueryIncentivizedPacketsResponse) GetIncentivizedPackets() []Identified
 	return nil
 }
 
+func (m *QueryIncentivizedPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryIncentivizedPacketRequest defines the request type for the IncentivizedPacket rpc
 type QueryIncentivizedPacketRequest struct {
 	// unique packet identifier comprised of channel ID, port ID and sequence
@@ -315,6 +324,8 @@ func (m *QueryIncentivizedPacketsForChannelRequest) GetQueryHeight() uint64 {
 type QueryIncentivizedPacketsForChannelResponse struct {
 	// Map of all incentivized_packets
 	IncentivizedPackets []*IdentifiedPacketFees `protobuf:"bytes,1,rep,name=incentivized_packets,json=incentivizedPackets,proto3" json:"incentivized_packets,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryIncentivizedPacketsForChannelResponse) Reset() {
@@ -361,6 +372,13 @@ func (m *QueryIncentivizedPacketsForChannelResponse) GetIncentivizedPackets() []
 	return nil
 }
 
+func (m *QueryIncentivizedPacketsForChannelResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryTotalRecvFeesRequest defines the request type for the TotalRecvFees rpc
 type QueryTotalRecvFeesRequest struct {
 	// the packet identifier for the associated fees
@@ -1069,91 +1087,92 @@ func init() {
 }
 
 var fileDescriptor_0638a8a78ca2503c = []byte{
-	// 1340 bytes of a gzipped FileDescriptorProto
+	// 1351 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5f, 0x6f, 0xdb, 0xd4,
-	0x1b, 0xee, 0xe9, 0xba, 0xb5, 0x3d, 0xed, 0x7e, 0xbf, 0xe5, 0xb4, 0xb0, 0x34, 0xb4, 0x49, 0xe7,
-	0x31, 0x28, 0x9d, 0x6a, 0xab, 0x19, 0x5b, 0x37, 0x24, 0x04, 0x4d, 0x47, 0x47, 0x61, 0x40, 0xc9,
-	0x7a, 0x03, 0x02, 0x65, 0x8e, 0x73, 0x92, 0x5a, 0x4d, 0x7d, 0x3c, 0xdb, 0x89, 0xc8, 0xba, 0x02,
-	0x9b, 0xa8, 0x40, 0x80, 0x00, 0x09, 0x89, 0x0b, 0xee, 0x11, 0x02, 0x89, 0x0f, 0xc0, 0x37, 0xd8,
-	0x15, 0xaa, 0xc4, 0x0d, 0xe2, 0x22, 0xa0, 0x16, 0xf1, 0x01, 0x72, 0xc5, 0x05, 0x48, 0xc8, 0xe7,
-	0xbc, 0x4e, 0x9c, 0xd9, 0x6e, 0x93, 0x52, 0xca, 0x55, 0x6d, 0x9f, 0xf7, 0xcf, 0xf3, 0x3c, 0xe7,
-	0xb5, 0xcf, 0x93, 0xe2, 0xb3, 0x7a, 0x5e, 0x53, 0x54, 0xd3, 0x2c, 0xeb, 0x9a, 0xea, 0xe8, 0xcc,
-	0xb0, 0x95, 0x22, 0xa5, 0x4a, 0x75, 0x56, 0xb9, 0x55, 0xa1, 0x56, 0x4d, 0x36, 0x2d, 0xe6, 0x30,
-	0x72, 0x5a, 0xcf, 0x6b, 0xb2, 0x3f, 0x48, 0x2e, 0x52, 0x2a, 0x57, 0x67, 0x13, 0xa3, 0x25, 0x56,
-	0x62, 0x3c, 0x46, 0x71, 0xaf, 0x44, 0x78, 0x62, 0xbc, 0xc4, 0x58, 0xa9, 0x4c, 0x15, 0xd5, 0xd4,
-	0x15, 0xd5, 0x30, 0x98, 0x03, 0x49, 0x62, 0x35, 0xa9, 0x31, 0x7b, 0x9d, 0xd9, 0x4a, 0x5e, 0xb5,
-	0xdd, 0x46, 0x79, 0xea, 0xa8, 0xb3, 0x8a, 0xc6, 0x74, 0x03, 0xd6, 0xa7, 0xfd, 0xeb, 0x1c, 0x45,
-	0x33, 0xca, 0x54, 0x4b, 0xba, 0xc1, 0x8b, 0x41, 0xec, 0x99, 0x28, 0xf4, 0x2e, 0x3e, 0x11, 0x72,
-	0x2e, 0x2a, 0xa4, 0x44, 0x0d, 0x6a, 0xeb, 0xb6, 0xbf, 0x92, 0xc6, 0x2c, 0xaa, 0x68, 0xab, 0xaa,
-	0x61, 0xd0, 0xb2, 0x1b, 0x02, 0x97, 0x22, 0x44, 0xfa, 0x18, 0xe1, 0xd4, 0xab, 0x2e, 0x9e, 0x25,
-	0x43, 0xa3, 0x86, 0xa3, 0x57, 0xf5, 0xdb, 0xb4, 0xb0, 0xac, 0x6a, 0x6b, 0xd4, 0xb1, 0xb3, 0xf4,
-	0x56, 0x85, 0xda, 0x0e, 0x59, 0xc4, 0xb8, 0x05, 0x32, 0x8e, 0x26, 0xd1, 0xd4, 0x50, 0xfa, 0x31,
-	0x59, 0x30, 0x92, 0x5d, 0x46, 0xb2, 0xd0, 0x15, 0x18, 0xc9, 0xcb, 0x6a, 0x89, 0x42, 0x6e, 0xd6,
-	0x97, 0x49, 0xce, 0xe0, 0x61, 0x1e, 0x98, 0x5b, 0xa5, 0x7a, 0x69, 0xd5, 0x89, 0xf7, 0x4e, 0xa2,
-	0xa9, 0xbe, 0xec, 0x10, 0x7f, 0xf6, 0x3c, 0x7f, 0x24, 0x7d, 0x88, 0xf0, 0x64, 0x34, 0x1c, 0xdb,
-	0x64, 0x86, 0x4d, 0x49, 0x11, 0x8f, 0xea, 0xbe, 0xe5, 0x9c, 0x29, 0xd6, 0xe3, 0x68, 0xf2, 0xd8,
-	0xd4, 0x50, 0x7a, 0x46, 0x8e, 0xd8, 0x58, 0x79, 0xa9, 0xe0, 0xe6, 0x14, 0x75, 0xaf, 0xe2, 0x22,
-	0xa5, 0x76, 0xa6, 0xef, 0x7e, 0x3d, 0xd5, 0x93, 0x1d, 0xd1, 0x83, 0xfd, 0xa4, 0x2d, 0x84, 0x93,
-	0x11, 0x60, 0x3c, 0x69, 0x9e, 0xc5, 0x83, 0xa2, 0x7b, 0x4e, 0x2f, 0x80, 0x32, 0x13, 0xbc, 0xbf,
-	0xab, 0xba, 0xec, 0x49, 0x5d, 0x75, 0x35, 0x71, 0xa3, 0x96, 0x0a, 0xd0, 0x6f, 0xc0, 0x84, 0xfb,
-	0x4e, 0x44, 0x79, 0x3f, 0x7a, 0x8f, 0x9a, 0x9a, 0x14, 0xf0, 0x48, 0x88, 0x26, 0x00, 0xe9, 0x40,
-	0x92, 0x90, 0xa0, 0x24, 0xd2, 0x0f, 0x08, 0x3f, 0x11, 0xb5, 0x3d, 0x8b, 0xcc, 0x5a, 0x10, 0x7c,
-	0x0f, 0x7b, 0x6e, 0x4e, 0xe3, 0x7e, 0x93, 0x59, 0x5c, 0x62, 0x57, 0x9d, 0xc1, 0xec, 0x09, 0xf7,
-	0x76, 0xa9, 0x40, 0x26, 0x30, 0x06, 0x89, 0xdd, 0xb5, 0x63, 0x7c, 0x6d, 0x10, 0x9e, 0x84, 0x48,
-	0xdb, 0x17, 0x94, 0xf6, 0x13, 0x84, 0xa7, 0x3b, 0x21, 0x04, 0x2a, 0xdf, 0x3c, 0xc4, 0xc9, 0x0b,
-	0x9f, 0xb9, 0x37, 0xf1, 0x18, 0xc7, 0xb3, 0xc2, 0x1c, 0xb5, 0x9c, 0xa5, 0x5a, 0x95, 0x87, 0x1e,
-	0xd6, 0xb4, 0x49, 0x5f, 0x22, 0x9c, 0x08, 0xab, 0x0f, 0xfc, 0xee, 0xe0, 0x41, 0x8b, 0x6a, 0xd5,
-	0x5c, 0x91, 0x52, 0x8f, 0xd4, 0x58, 0xdb, 0x86, 0x79, 0x5b, 0xb5, 0xc0, 0x74, 0x23, 0x73, 0xd5,
-	0x2d, 0xde, 0xa8, 0xa7, 0x4e, 0xd5, 0xd4, 0xf5, 0xf2, 0x53, 0x52, 0x33, 0x53, 0xfa, 0xf6, 0x97,
-	0xd4, 0x54, 0x49, 0x77, 0x56, 0x2b, 0x79, 0x59, 0x63, 0xeb, 0x0a, 0x7c, 0xfb, 0xc4, 0x9f, 0x19,
-	0xbb, 0xb0, 0xa6, 0x38, 0x35, 0x93, 0xda, 0xbc, 0x88, 0x9d, 0x1d, 0xb0, 0x00, 0x85, 0xf4, 0x06,
-	0x8e, 0xb7, 0xb0, 0xcd, 0x6b, 0x6b, 0x87, 0x4b, 0xfd, 0x0b, 0xe4, 0x97, 0xb6, 0x59, 0x1e, 0x98,
-	0xd7, 0xf0, 0x80, 0xaa, 0xad, 0x75, 0x48, 0x7c, 0x01, 0x88, 0xff, 0x5f, 0x10, 0xf7, 0x12, 0xbb,
-	0xe3, 0xdd, 0xaf, 0x0a, 0x08, 0xd2, 0x4d, 0x3c, 0xde, 0xc2, 0xb5, 0xa2, 0xaf, 0x53, 0x56, 0x71,
-	0x0e, 0x97, 0xfa, 0xd7, 0x08, 0x4f, 0x44, 0xb4, 0x00, 0xfa, 0x5b, 0x08, 0x0f, 0x3b, 0xe2, 0x79,
-	0x87, 0x1a, 0x5c, 0x03, 0x0d, 0x46, 0x84, 0x06, 0xfe, 0xe4, 0xee, 0x74, 0x18, 0x72, 0x5a, 0x78,
-	0x24, 0x0d, 0xc7, 0x38, 0xd0, 0x65, 0xb5, 0x46, 0xbd, 0x6f, 0x01, 0x79, 0xb2, 0xed, 0x35, 0x77,
-	0x15, 0x18, 0xcc, 0x3c, 0xd4, 0xa8, 0xa7, 0x62, 0xa2, 0x75, 0x6b, 0x4d, 0xf2, 0xbf, 0xfd, 0x71,
-	0xdc, 0x6f, 0xd1, 0xb2, 0x5a, 0xa3, 0x16, 0x7c, 0x35, 0xbc, 0x5b, 0xe9, 0x06, 0x26, 0xfe, 0x26,
-	0x20, 0xc1, 0xd3, 0xf8, 0xa4, 0xe9, 0x3e, 0xc8, 0xa9, 0x85, 0x82, 0x45, 0x6d, 0x1b, 0x1a, 0xc5,
-	0x1b, 0xf5, 0xd4, 0xa8, 0x68, 0xd4, 0xb6, 0x2c, 0x65, 0x87, 0xf9, 0xfd, 0x3c, 0xdc, 0x32, 0x90,
-	0x78, 0x81, 0x55, 0x0c, 0x87, 0x5a, 0xa6, 0x6a, 0x39, 0xff, 0x2e, 0x0b, 0x03, 0x0e, 0xa7, 0x90,
-	0x86, 0xc0, 0xe8, 0x3a, 0x26, 0x9a, 0x6f, 0x31, 0xc7, 0xf1, 0x42, 0xe7, 0x89, 0x46, 0x3d, 0x35,
-	0x06, 0x9d, 0x03, 0x31, 0x52, 0x36, 0xa6, 0x3d, 0x58, 0x55, 0xfa, 0xc8, 0x3b, 0x0d, 0x17, 0x29,
-	0x7d, 0xce, 0x50, 0xf3, 0x65, 0x5a, 0x80, 0xcf, 0xe3, 0x7f, 0x61, 0x14, 0xbe, 0xf2, 0xce, 0xc4,
-	0x30, 0x34, 0xc0, 0xff, 0x2e, 0xc2, 0xa3, 0x45, 0x4a, 0x73, 0x54, 0xac, 0xe7, 0x40, 0x55, 0x6f,
-	0xb8, 0xa7, 0x23, 0x3f, 0xd7, 0x81, 0x9a, 0x99, 0xb3, 0x30, 0xed, 0x8f, 0x08, 0xc9, 0xc2, 0xaa,
-	0x4a, 0x59, 0x52, 0x0c, 0x60, 0x91, 0xee, 0x79, 0xaf, 0x5e, 0xa0, 0xa6, 0x27, 0xda, 0xf9, 0xd6,
-	0xe9, 0x26, 0xb6, 0x86, 0x34, 0xea, 0xa9, 0xff, 0xc1, 0xc4, 0x89, 0x05, 0xa9, 0x79, 0xe2, 0xb5,
-	0x0f, 0x51, 0x6f, 0x67, 0x43, 0x24, 0xbd, 0x16, 0xb5, 0x73, 0x4d, 0xa9, 0xe6, 0xf0, 0x90, 0x8f,
-	0x13, 0x07, 0x32, 0x90, 0x79, 0xb8, 0x51, 0x4f, 0x91, 0x00, 0x61, 0x29, 0x8b, 0x5b, 0x3c, 0xd3,
-	0xbf, 0xc7, 0xf0, 0x71, 0x5e, 0x9b, 0x7c, 0x8f, 0xf0, 0x48, 0xc8, 0x29, 0x4a, 0x2e, 0x47, 0xca,
-	0xbc, 0x8f, 0xef, 0x4c, 0x5c, 0x39, 0x40, 0xa6, 0xe0, 0x23, 0xcd, 0xdc, 0xfb, 0xf1, 0xb7, 0xcf,
-	0x7b, 0x1f, 0x27, 0xe7, 0x14, 0x70, 0xca, 0x4d, 0x87, 0x1c, 0x76, 0x7e, 0x93, 0x4f, 0x7b, 0x31,
-	0x09, 0x96, 0x23, 0x73, 0xdd, 0x02, 0xf0, 0x90, 0x5f, 0xee, 0x3e, 0x11, 0x80, 0x6f, 0x21, 0x8e,
-	0xfc, 0x1d, 0xb2, 0x19, 0x40, 0xee, 0x0d, 0x9a, 0xb2, 0xd1, 0x3c, 0x0e, 0xe4, 0xd6, 0x86, 0x6f,
-	0x2a, 0xee, 0x88, 0xb4, 0x2d, 0xc2, 0xf4, 0x6c, 0x2a, 0xb6, 0x0b, 0xcb, 0xd0, 0x68, 0xdb, 0xaa,
-	0xf7, 0x70, 0x33, 0x4c, 0x12, 0xf2, 0x17, 0xc2, 0x13, 0x7b, 0x7a, 0x22, 0x92, 0xe9, 0x7a, 0x77,
-	0x02, 0x0e, 0x31, 0xb1, 0xf0, 0x8f, 0x6a, 0x80, 0x64, 0x37, 0xb8, 0x62, 0x2f, 0x91, 0x17, 0xf7,
-	0x50, 0x2c, 0x4c, 0x27, 0x4f, 0x9d, 0xd0, 0x89, 0xf8, 0x13, 0xe1, 0x93, 0x6d, 0x1e, 0x89, 0xa4,
-	0xf7, 0xc6, 0x1a, 0x66, 0xd8, 0x12, 0x17, 0xba, 0xca, 0x01, 0x3e, 0x77, 0xc5, 0x08, 0x6c, 0x90,
-	0xda, 0xd1, 0x8d, 0x80, 0xe3, 0x22, 0xc9, 0x35, 0x1d, 0x1c, 0xf9, 0x03, 0xe1, 0x61, 0xbf, 0x4f,
-	0x22, 0xb3, 0x1d, 0x30, 0x69, 0xb7, 0x6c, 0x89, 0x74, 0x37, 0x29, 0xc0, 0xfd, 0x5d, 0xc1, 0xfd,
-	0x36, 0x79, 0xeb, 0xa8, 0xb9, 0x7b, 0x26, 0x8e, 0x7c, 0xd0, 0x8b, 0x4f, 0x3d, 0xe8, 0x93, 0xc8,
-	0xc5, 0x0e, 0xb8, 0x04, 0xad, 0x5b, 0xe2, 0x52, 0xb7, 0x69, 0x20, 0xc3, 0x7b, 0x42, 0x86, 0xb7,
-	0xc9, 0x9d, 0xa3, 0x96, 0xc1, 0xef, 0xe3, 0xc8, 0x37, 0x08, 0x1f, 0xe7, 0x87, 0x3f, 0x99, 0xde,
-	0x9b, 0x88, 0xdf, 0xe8, 0x24, 0xce, 0x77, 0x14, 0x0b, 0x4c, 0xaf, 0x71, 0xa2, 0xf3, 0xe4, 0x99,
-	0x0e, 0x5f, 0x5e, 0x70, 0x3f, 0xb6, 0xb2, 0x01, 0x57, 0x9b, 0x0a, 0xb7, 0x2c, 0xe4, 0x67, 0x84,
-	0x63, 0x01, 0x2b, 0x44, 0xf6, 0xd9, 0x80, 0x28, 0xb3, 0x96, 0x98, 0xeb, 0x3a, 0x0f, 0xf8, 0xac,
-	0x70, 0x3e, 0x2f, 0x93, 0xeb, 0x07, 0xe7, 0x13, 0xf4, 0x63, 0xe4, 0x3b, 0x84, 0x49, 0xd0, 0xe8,
-	0xec, 0x77, 0x3e, 0x45, 0x1a, 0xb5, 0xfd, 0xce, 0xa7, 0x68, 0x4f, 0x25, 0x3d, 0xca, 0xf9, 0x25,
-	0xc9, 0x78, 0x80, 0x9f, 0xcf, 0x22, 0x90, 0x6d, 0x84, 0x63, 0x81, 0x22, 0xfb, 0x6d, 0x46, 0x94,
-	0x43, 0x4a, 0xcc, 0x75, 0x9d, 0x07, 0x60, 0x5f, 0xe0, 0x60, 0xaf, 0x92, 0xcc, 0x01, 0x4f, 0x06,
-	0x1f, 0xa5, 0xcc, 0x2b, 0xf7, 0x77, 0x92, 0x68, 0x7b, 0x27, 0x89, 0x7e, 0xdd, 0x49, 0xa2, 0xcf,
-	0x76, 0x93, 0x3d, 0xdb, 0xbb, 0xc9, 0x9e, 0x9f, 0x76, 0x93, 0x3d, 0xaf, 0x5f, 0x0c, 0xfe, 0xd4,
-	0xd1, 0xf3, 0xda, 0x4c, 0x89, 0x29, 0xd5, 0x4b, 0xca, 0x3a, 0x2b, 0x54, 0xca, 0xd4, 0x16, 0xcd,
-	0xd3, 0x57, 0x66, 0xdc, 0xfe, 0xfc, 0xd7, 0x4f, 0xfe, 0x04, 0xff, 0x07, 0xdc, 0x85, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x7f, 0xbf, 0x2c, 0xb1, 0xad, 0x14, 0x00, 0x00,
+	0x1b, 0xee, 0xc9, 0xfe, 0xb4, 0x3d, 0xed, 0x7e, 0xbf, 0xe5, 0xb4, 0xb0, 0xd4, 0xb4, 0x49, 0xe7,
+	0x31, 0x56, 0x3a, 0xd5, 0x56, 0x33, 0xb6, 0x76, 0x48, 0x08, 0x9a, 0x8e, 0x96, 0xc2, 0x80, 0x92,
+	0xf5, 0x06, 0x04, 0xca, 0x1c, 0xe7, 0x24, 0xb5, 0x9a, 0xda, 0x9e, 0xed, 0x44, 0x64, 0x5d, 0x81,
+	0x4d, 0x54, 0x20, 0x81, 0x04, 0x12, 0x12, 0x17, 0xdc, 0x23, 0x04, 0x12, 0x1f, 0x80, 0x6f, 0xb0,
+	0x2b, 0x54, 0x69, 0x17, 0x20, 0x2e, 0x02, 0x6a, 0x11, 0x1f, 0x20, 0x57, 0x5c, 0x80, 0x84, 0x7c,
+	0xce, 0xeb, 0xc4, 0x99, 0xed, 0x36, 0x29, 0x5d, 0xb9, 0x6a, 0xec, 0xf7, 0xbc, 0xef, 0xfb, 0x3c,
+	0xcf, 0x79, 0x73, 0xce, 0x93, 0xe2, 0x73, 0x5a, 0x5e, 0x95, 0x15, 0xd3, 0x2c, 0x6b, 0xaa, 0xe2,
+	0x68, 0x86, 0x6e, 0xcb, 0x45, 0x4a, 0xe5, 0xea, 0xb4, 0x7c, 0xab, 0x42, 0xad, 0x9a, 0x64, 0x5a,
+	0x86, 0x63, 0x90, 0x33, 0x5a, 0x5e, 0x95, 0xfc, 0x8b, 0xa4, 0x22, 0xa5, 0x52, 0x75, 0x5a, 0x18,
+	0x2e, 0x19, 0x25, 0x83, 0xad, 0x91, 0xdd, 0x4f, 0x7c, 0xb9, 0x30, 0x5a, 0x32, 0x8c, 0x52, 0x99,
+	0xca, 0x8a, 0xa9, 0xc9, 0x8a, 0xae, 0x1b, 0x0e, 0x24, 0xf1, 0x68, 0x52, 0x35, 0xec, 0x75, 0xc3,
+	0x96, 0xf3, 0x8a, 0xed, 0x36, 0xca, 0x53, 0x47, 0x99, 0x96, 0x55, 0x43, 0xd3, 0x21, 0x3e, 0xe9,
+	0x8f, 0x33, 0x14, 0xcd, 0x55, 0xa6, 0x52, 0xd2, 0x74, 0x56, 0x0c, 0xd6, 0x9e, 0x8d, 0x42, 0xef,
+	0xe2, 0xe3, 0x4b, 0xce, 0x47, 0x2d, 0x29, 0x51, 0x9d, 0xda, 0x9a, 0xed, 0xaf, 0xa4, 0x1a, 0x16,
+	0x95, 0xd5, 0x55, 0x45, 0xd7, 0x69, 0xd9, 0x5d, 0x02, 0x1f, 0xf9, 0x12, 0xf1, 0x53, 0x84, 0x53,
+	0x6f, 0xb8, 0x78, 0x96, 0x74, 0x95, 0xea, 0x8e, 0x56, 0xd5, 0x6e, 0xd3, 0xc2, 0xb2, 0xa2, 0xae,
+	0x51, 0xc7, 0xce, 0xd2, 0x5b, 0x15, 0x6a, 0x3b, 0x64, 0x01, 0xe3, 0x16, 0xc8, 0x04, 0x1a, 0x47,
+	0x13, 0x03, 0xe9, 0xa7, 0x24, 0xce, 0x48, 0x72, 0x19, 0x49, 0x5c, 0x57, 0x60, 0x24, 0x2d, 0x2b,
+	0x25, 0x0a, 0xb9, 0x59, 0x5f, 0x26, 0x39, 0x8b, 0x07, 0xd9, 0xc2, 0xdc, 0x2a, 0xd5, 0x4a, 0xab,
+	0x4e, 0x22, 0x36, 0x8e, 0x26, 0x8e, 0x67, 0x07, 0xd8, 0xbb, 0x97, 0xd8, 0x2b, 0xf1, 0x01, 0xc2,
+	0xe3, 0xd1, 0x70, 0x6c, 0xd3, 0xd0, 0x6d, 0x4a, 0x8a, 0x78, 0x58, 0xf3, 0x85, 0x73, 0x26, 0x8f,
+	0x27, 0xd0, 0xf8, 0xb1, 0x89, 0x81, 0xf4, 0x94, 0x14, 0xb1, 0xb1, 0xd2, 0x52, 0xc1, 0xcd, 0x29,
+	0x6a, 0x5e, 0xc5, 0x05, 0x4a, 0xed, 0xcc, 0xf1, 0xfb, 0xf5, 0x54, 0x4f, 0x76, 0x48, 0x0b, 0xf6,
+	0x23, 0x8b, 0x6d, 0xbc, 0x63, 0x8c, 0xf7, 0x85, 0x7d, 0x79, 0x73, 0x90, 0x7e, 0xe2, 0xe2, 0x16,
+	0xc2, 0xc9, 0x08, 0x56, 0x9e, 0xc6, 0x2f, 0xe0, 0x7e, 0x4e, 0x23, 0xa7, 0x15, 0x40, 0xe2, 0x31,
+	0x46, 0xc4, 0xdd, 0x3e, 0xc9, 0xdb, 0xb3, 0xaa, 0xdb, 0xc4, 0x5d, 0xb5, 0x54, 0x00, 0xe0, 0x7d,
+	0x26, 0x3c, 0x77, 0xa2, 0xee, 0x47, 0xd1, 0x9b, 0xdd, 0x14, 0xb7, 0x80, 0x87, 0x42, 0xc4, 0x05,
+	0x48, 0x07, 0xd2, 0x96, 0x04, 0xb5, 0x15, 0x7f, 0x44, 0xf8, 0xe9, 0xa8, 0x7d, 0x5e, 0x30, 0xac,
+	0x79, 0xce, 0xf7, 0xb0, 0x07, 0xf0, 0x0c, 0xee, 0x35, 0x0d, 0x8b, 0x49, 0xec, 0xaa, 0xd3, 0x9f,
+	0x3d, 0xe9, 0x3e, 0x2e, 0x15, 0xc8, 0x18, 0xc6, 0x20, 0xb1, 0x1b, 0x3b, 0xc6, 0x62, 0xfd, 0xf0,
+	0x26, 0x44, 0xda, 0xe3, 0x41, 0x69, 0x7f, 0x42, 0x78, 0xb2, 0x13, 0x42, 0xa0, 0xf2, 0xcd, 0x43,
+	0x1c, 0xe1, 0x47, 0x3c, 0xbc, 0xef, 0xe0, 0x11, 0x46, 0x6c, 0xc5, 0x70, 0x94, 0x72, 0x96, 0xaa,
+	0x55, 0xd6, 0xf3, 0xb0, 0xc6, 0x56, 0xfc, 0x0a, 0x61, 0x21, 0xac, 0x3e, 0x08, 0x75, 0x07, 0xf7,
+	0x5b, 0x54, 0xad, 0xe6, 0x8a, 0x94, 0x7a, 0xea, 0x8c, 0xb4, 0xb1, 0xf0, 0xf0, 0xcf, 0x1b, 0x9a,
+	0x9e, 0xb9, 0xe6, 0x16, 0x6f, 0xd4, 0x53, 0xa7, 0x6b, 0xca, 0x7a, 0xf9, 0x59, 0xb1, 0x99, 0x29,
+	0x7e, 0xf7, 0x6b, 0x6a, 0xa2, 0xa4, 0x39, 0xab, 0x95, 0xbc, 0xa4, 0x1a, 0xeb, 0x32, 0x9c, 0xc6,
+	0xfc, 0xcf, 0x94, 0x5d, 0x58, 0x93, 0x9d, 0x9a, 0x49, 0x6d, 0x56, 0xc4, 0xce, 0xf6, 0x59, 0x80,
+	0x42, 0x7c, 0x1b, 0x27, 0x5a, 0xd8, 0xe6, 0xd4, 0xb5, 0xc3, 0xa5, 0xfe, 0x25, 0xc2, 0x23, 0x21,
+	0xe5, 0x81, 0x79, 0x0d, 0xf7, 0x29, 0xea, 0x5a, 0x87, 0xc4, 0xe7, 0x81, 0xf8, 0xff, 0x39, 0x71,
+	0x2f, 0xb1, 0x3b, 0xde, 0xbd, 0x0a, 0x87, 0x20, 0xde, 0xc4, 0xa3, 0x2d, 0x5c, 0x2b, 0xda, 0x3a,
+	0x35, 0x2a, 0xce, 0xe1, 0x52, 0xff, 0x06, 0xe1, 0xb1, 0x88, 0x16, 0x40, 0x7f, 0x0b, 0xe1, 0x41,
+	0x87, 0xbf, 0xef, 0x50, 0x83, 0x45, 0xd0, 0x60, 0x88, 0x6b, 0xe0, 0x4f, 0xee, 0x4e, 0x87, 0x01,
+	0xa7, 0x85, 0x47, 0x54, 0x71, 0x9c, 0x01, 0x5d, 0x56, 0x6a, 0xd4, 0x3b, 0x54, 0xc8, 0x33, 0x6d,
+	0xe7, 0x85, 0xab, 0x40, 0x7f, 0xe6, 0xb1, 0x46, 0x3d, 0x15, 0xe7, 0xad, 0x5b, 0x31, 0xd1, 0x7f,
+	0x8c, 0x24, 0x70, 0xaf, 0x45, 0xcb, 0x4a, 0x8d, 0x5a, 0x70, 0xfc, 0x78, 0x8f, 0xe2, 0x0d, 0x4c,
+	0xfc, 0x4d, 0x40, 0x82, 0xe7, 0xf0, 0x29, 0xd3, 0x7d, 0x91, 0x53, 0x0a, 0x05, 0x8b, 0xda, 0x36,
+	0x34, 0x4a, 0x34, 0xea, 0xa9, 0x61, 0xde, 0xa8, 0x2d, 0x2c, 0x66, 0x07, 0xd9, 0xf3, 0x1c, 0x3c,
+	0x1a, 0x20, 0xf1, 0xbc, 0x51, 0xd1, 0x1d, 0x6a, 0x99, 0x8a, 0xe5, 0x3c, 0x5a, 0x16, 0x3a, 0x4e,
+	0x46, 0x35, 0x04, 0x46, 0xd7, 0x31, 0x51, 0x7d, 0xc1, 0x1c, 0xc3, 0x0b, 0x9d, 0xc7, 0x1a, 0xf5,
+	0xd4, 0x08, 0x74, 0x0e, 0xac, 0x11, 0xb3, 0x71, 0xf5, 0xe1, 0xaa, 0xe2, 0x27, 0xde, 0xb5, 0xba,
+	0x40, 0xe9, 0x8b, 0xba, 0x92, 0x2f, 0xd3, 0x02, 0x9c, 0xb3, 0xff, 0x85, 0x75, 0xf9, 0xda, 0xbb,
+	0x5c, 0xc3, 0xd0, 0x00, 0xff, 0xbb, 0x08, 0x0f, 0x17, 0x29, 0xcd, 0x51, 0x1e, 0xcf, 0x81, 0xaa,
+	0xde, 0x70, 0x4f, 0x46, 0x9e, 0xfb, 0x81, 0x9a, 0x99, 0x73, 0x30, 0xed, 0x4f, 0x70, 0xc9, 0xc2,
+	0xaa, 0x8a, 0x59, 0x52, 0x0c, 0x60, 0x11, 0xef, 0x79, 0x5f, 0xbd, 0x40, 0x4d, 0x4f, 0xb4, 0x8b,
+	0xad, 0x6b, 0x92, 0x6f, 0x0d, 0x69, 0xd4, 0x53, 0xff, 0x83, 0x89, 0xe3, 0x01, 0xb1, 0x79, 0x75,
+	0xb6, 0x0f, 0x51, 0xac, 0xb3, 0x21, 0x12, 0xdf, 0x8c, 0xda, 0xb9, 0xa6, 0x54, 0x33, 0x78, 0xc0,
+	0xc7, 0x89, 0x01, 0xe9, 0xcb, 0x3c, 0xde, 0xa8, 0xa7, 0x48, 0x80, 0xb0, 0x98, 0xc5, 0x2d, 0x9e,
+	0xe9, 0x3f, 0xe2, 0xf8, 0x04, 0xab, 0x4d, 0x7e, 0x40, 0x78, 0x28, 0xe4, 0x3a, 0x26, 0xb3, 0x91,
+	0x32, 0xef, 0xe3, 0x84, 0x85, 0xab, 0x07, 0xc8, 0xe4, 0x7c, 0xc4, 0xa9, 0x7b, 0x0f, 0x7e, 0xff,
+	0x22, 0x76, 0x81, 0x9c, 0x97, 0xc1, 0xbb, 0x37, 0x3d, 0x7b, 0x98, 0x11, 0x20, 0x9f, 0xc5, 0x30,
+	0x09, 0x96, 0x23, 0x33, 0xdd, 0x02, 0xf0, 0x90, 0xcf, 0x76, 0x9f, 0x08, 0xc0, 0xb7, 0x10, 0x43,
+	0xfe, 0x3e, 0xd9, 0x0c, 0x20, 0xf7, 0x06, 0x4d, 0xde, 0x68, 0x5e, 0x07, 0x52, 0x6b, 0xc3, 0x37,
+	0x65, 0x77, 0x44, 0xda, 0x82, 0x30, 0x3d, 0x9b, 0xb2, 0xed, 0xc2, 0xd2, 0x55, 0xda, 0x16, 0xf5,
+	0x5e, 0x6e, 0x86, 0x49, 0x42, 0xfe, 0x46, 0x78, 0x6c, 0x4f, 0x73, 0x45, 0x32, 0x5d, 0xef, 0x4e,
+	0xc0, 0x6a, 0x0a, 0xf3, 0xff, 0xaa, 0x06, 0x48, 0x76, 0x83, 0x29, 0xf6, 0x2a, 0x79, 0x65, 0x0f,
+	0xc5, 0xc2, 0x74, 0xf2, 0xd4, 0x09, 0x9d, 0x88, 0xbf, 0x10, 0x3e, 0xd5, 0xe6, 0x91, 0x48, 0x7a,
+	0x6f, 0xac, 0x61, 0x86, 0x4d, 0xb8, 0xd4, 0x55, 0x0e, 0xf0, 0xb9, 0xcb, 0x47, 0x60, 0x83, 0xd4,
+	0x8e, 0x6e, 0x04, 0x1c, 0x17, 0x49, 0xae, 0xe9, 0xe0, 0xc8, 0x9f, 0x08, 0x0f, 0xfa, 0x7d, 0x12,
+	0x99, 0xee, 0x80, 0x49, 0xbb, 0x65, 0x13, 0xd2, 0xdd, 0xa4, 0x00, 0xf7, 0x0f, 0x38, 0xf7, 0xdb,
+	0xe4, 0xdd, 0xa3, 0xe6, 0xee, 0x99, 0x38, 0xf2, 0x71, 0x0c, 0x9f, 0x7e, 0xd8, 0x27, 0x91, 0xcb,
+	0x1d, 0x70, 0x09, 0x5a, 0x37, 0xe1, 0x4a, 0xb7, 0x69, 0x20, 0xc3, 0x87, 0x5c, 0x86, 0xf7, 0xc8,
+	0x9d, 0xa3, 0x96, 0xc1, 0xef, 0xe3, 0xc8, 0xb7, 0x08, 0x9f, 0x60, 0x97, 0x3f, 0x99, 0xdc, 0x9b,
+	0x88, 0xdf, 0xe8, 0x08, 0x17, 0x3b, 0x5a, 0x0b, 0x4c, 0x17, 0x19, 0xd1, 0x39, 0xf2, 0x7c, 0x87,
+	0x5f, 0x5e, 0x70, 0x3f, 0xb6, 0xbc, 0x01, 0x9f, 0x36, 0x65, 0x66, 0x59, 0xc8, 0x2f, 0x08, 0xc7,
+	0x03, 0x56, 0x88, 0xec, 0xb3, 0x01, 0x51, 0x66, 0x4d, 0x98, 0xe9, 0x3a, 0x0f, 0xf8, 0xac, 0x30,
+	0x3e, 0xaf, 0x91, 0xeb, 0x07, 0xe7, 0x13, 0xf4, 0x63, 0xe4, 0x7b, 0x84, 0x49, 0xd0, 0xe8, 0xec,
+	0x77, 0x3f, 0x45, 0x1a, 0x35, 0x61, 0xb6, 0xfb, 0x44, 0xe0, 0xf7, 0x24, 0xe3, 0x97, 0x24, 0xa3,
+	0x01, 0x7e, 0x3e, 0x8b, 0x40, 0xb6, 0x11, 0x8e, 0x07, 0x8a, 0xec, 0xb7, 0x19, 0x51, 0x0e, 0x49,
+	0x98, 0xe9, 0x3a, 0x0f, 0xc0, 0xbe, 0xcc, 0xc0, 0x5e, 0x23, 0x99, 0x03, 0xde, 0x0c, 0x3e, 0x4a,
+	0x99, 0xd7, 0xef, 0xef, 0x24, 0xd1, 0xf6, 0x4e, 0x12, 0xfd, 0xb6, 0x93, 0x44, 0x9f, 0xef, 0x26,
+	0x7b, 0xb6, 0x77, 0x93, 0x3d, 0x3f, 0xef, 0x26, 0x7b, 0xde, 0xba, 0x1c, 0xfc, 0xa9, 0xa3, 0xe5,
+	0xd5, 0xa9, 0x92, 0x21, 0x57, 0xaf, 0xc8, 0xeb, 0x46, 0xa1, 0x52, 0xa6, 0x36, 0x6f, 0x9e, 0xbe,
+	0x3a, 0xe5, 0xf6, 0x67, 0xbf, 0x7e, 0xf2, 0x27, 0xd9, 0xbf, 0x04, 0x2f, 0xfd, 0x33, 0x00, 0x0b,
+	0x47, 0x0d, 0x64, 0x3f, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1640,6 +1659,18 @@ func (m *QueryIncentivizedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.IncentivizedPackets) > 0 {
 		for iNdEx := len(m.IncentivizedPackets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1802,6 +1833,18 @@ func (m *QueryIncentivizedPacketsForChannelResponse) MarshalToSizedBuffer(dAtA [
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.IncentivizedPackets) > 0 {
 		for iNdEx := len(m.IncentivizedPackets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2349,6 +2392,10 @@ func (m *QueryIncentivizedPacketsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2413,6 +2460,10 @@ func (m *QueryIncentivizedPacketsForChannelResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2788,6 +2839,42 @@ func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3226,6 +3313,42 @@ func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) erro
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -193,6 +193,152 @@ func (m *MsgRegisterCounterpartyPayeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterCounterpartyPayeeResponse proto.InternalMessageInfo
 
+// CounterpartyPayeeRegistration defines the counterparty payee address to be registered on a given channel
+type CounterpartyPayeeRegistration struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the counterparty payee address
+	CounterpartyPayee string `protobuf:"bytes,3,opt,name=counterparty_payee,json=counterpartyPayee,proto3" json:"counterparty_payee,omitempty" yaml:"counterparty_payee"`
+}
+
+func (m *CounterpartyPayeeRegistration) Reset()         { *m = CounterpartyPayeeRegistration{} }
+func (m *CounterpartyPayeeRegistration) String() string { return proto.CompactTextString(m) }
+func (*CounterpartyPayeeRegistration) ProtoMessage()    {}
+func (*CounterpartyPayeeRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{4}
+}
+func (m *CounterpartyPayeeRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CounterpartyPayeeRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CounterpartyPayeeRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CounterpartyPayeeRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CounterpartyPayeeRegistration.Merge(m, src)
+}
+func (m *CounterpartyPayeeRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *CounterpartyPayeeRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_CounterpartyPayeeRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CounterpartyPayeeRegistration proto.InternalMessageInfo
+
+func (m *CounterpartyPayeeRegistration) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *CounterpartyPayeeRegistration) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *CounterpartyPayeeRegistration) GetCounterpartyPayee() string {
+	if m != nil {
+		return m.CounterpartyPayee
+	}
+	return ""
+}
+
+// MsgRegisterCounterpartyPayeeBatch defines the request type for the RegisterCounterpartyPayeeBatch rpc
+type MsgRegisterCounterpartyPayeeBatch struct {
+	// the relayer address
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// the counterparty payee addresses to be registered for the relayer
+	Registrations []CounterpartyPayeeRegistration `protobuf:"bytes,2,rep,name=registrations,proto3" json:"registrations"`
+}
+
+func (m *MsgRegisterCounterpartyPayeeBatch) Reset()         { *m = MsgRegisterCounterpartyPayeeBatch{} }
+func (m *MsgRegisterCounterpartyPayeeBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCounterpartyPayeeBatch) ProtoMessage()    {}
+func (*MsgRegisterCounterpartyPayeeBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{5}
+}
+func (m *MsgRegisterCounterpartyPayeeBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCounterpartyPayeeBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCounterpartyPayeeBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCounterpartyPayeeBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCounterpartyPayeeBatch.Merge(m, src)
+}
+func (m *MsgRegisterCounterpartyPayeeBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCounterpartyPayeeBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCounterpartyPayeeBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCounterpartyPayeeBatch proto.InternalMessageInfo
+
+// MsgRegisterCounterpartyPayeeBatchResponse defines the response type for the RegisterCounterpartyPayeeBatch rpc
+type MsgRegisterCounterpartyPayeeBatchResponse struct {
+}
+
+func (m *MsgRegisterCounterpartyPayeeBatchResponse) Reset() {
+	*m = MsgRegisterCounterpartyPayeeBatchResponse{}
+}
+func (m *MsgRegisterCounterpartyPayeeBatchResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgRegisterCounterpartyPayeeBatchResponse) ProtoMessage() {}
+func (*MsgRegisterCounterpartyPayeeBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{6}
+}
+func (m *MsgRegisterCounterpartyPayeeBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCounterpartyPayeeBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCounterpartyPayeeBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCounterpartyPayeeBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCounterpartyPayeeBatchResponse.Merge(m, src)
+}
+func (m *MsgRegisterCounterpartyPayeeBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCounterpartyPayeeBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCounterpartyPayeeBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCounterpartyPayeeBatchResponse proto.InternalMessageInfo
+
 // MsgPayPacketFee defines the request type for the PayPacketFee rpc
 // This Msg can be used to pay for a packet at the next sequence send & should be combined with the Msg that will be
 // paid for
@@ -213,7 +359,7 @@ func (m *MsgPayPacketFee) Reset()         { *m = MsgPayPacketFee{} }
 func (m *MsgPayPacketFee) String() string { return proto.CompactTextString(m) }
 func (*MsgPayPacketFee) ProtoMessage()    {}
 func (*MsgPayPacketFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05c93128649f1b96, []int{4}
+	return fileDescriptor_05c93128649f1b96, []int{7}
 }
 func (m *MsgPayPacketFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,7 +396,7 @@ func (m *MsgPayPacketFeeResponse) Reset()         { *m = MsgPayPacketFeeResponse
 func (m *MsgPayPacketFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPayPacketFeeResponse) ProtoMessage()    {}
 func (*MsgPayPacketFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05c93128649f1b96, []int{5}
+	return fileDescriptor_05c93128649f1b96, []int{8}
 }
 func (m *MsgPayPacketFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,7 +438,7 @@ func (m *MsgPayPacketFeeAsync) Reset()         { *m = MsgPayPacketFeeAsync{} }
 func (m *MsgPayPacketFeeAsync) String() string { return proto.CompactTextString(m) }
 func (*MsgPayPacketFeeAsync) ProtoMessage()    {}
 func (*MsgPayPacketFeeAsync) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05c93128649f1b96, []int{6}
+	return fileDescriptor_05c93128649f1b96, []int{9}
 }
 func (m *MsgPayPacketFeeAsync) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,7 +475,7 @@ func (m *MsgPayPacketFeeAsyncResponse) Reset()         { *m = MsgPayPacketFeeAsy
 func (m *MsgPayPacketFeeAsyncResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPayPacketFeeAsyncResponse) ProtoMessage()    {}
 func (*MsgPayPacketFeeAsyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05c93128649f1b96, []int{7}
+	return fileDescriptor_05c93128649f1b96, []int{10}
 }
 func (m *MsgPayPacketFeeAsyncResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,6 +509,9 @@ func init() {
 	proto.RegisterType((*MsgRegisterPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterPayeeResponse")
 	proto.RegisterType((*MsgRegisterCounterpartyPayee)(nil), "ibc.applications.fee.v1.MsgRegisterCounterpartyPayee")
 	proto.RegisterType((*MsgRegisterCounterpartyPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterCounterpartyPayeeResponse")
+	proto.RegisterType((*CounterpartyPayeeRegistration)(nil), "ibc.applications.fee.v1.CounterpartyPayeeRegistration")
+	proto.RegisterType((*MsgRegisterCounterpartyPayeeBatch)(nil), "ibc.applications.fee.v1.MsgRegisterCounterpartyPayeeBatch")
+	proto.RegisterType((*MsgRegisterCounterpartyPayeeBatchResponse)(nil), "ibc.applications.fee.v1.MsgRegisterCounterpartyPayeeBatchResponse")
 	proto.RegisterType((*MsgPayPacketFee)(nil), "ibc.applications.fee.v1.MsgPayPacketFee")
 	proto.RegisterType((*MsgPayPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeResponse")
 	proto.RegisterType((*MsgPayPacketFeeAsync)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsync")
@@ -372,51 +521,56 @@ func init() {
 func init() { proto.RegisterFile("ibc/applications/fee/v1/tx.proto", fileDescriptor_05c93128649f1b96) }
 
 var fileDescriptor_05c93128649f1b96 = []byte{
-	// 699 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x6b, 0xdb, 0x4a,
-	0x10, 0xb7, 0xe2, 0xfc, 0xf3, 0x24, 0x2f, 0x89, 0x97, 0xe4, 0x45, 0x16, 0x89, 0x95, 0x27, 0x1e,
-	0x8f, 0x3c, 0x4a, 0xa4, 0xda, 0x4d, 0x0a, 0x0d, 0x94, 0x52, 0x05, 0x42, 0x03, 0x0d, 0x35, 0xa2,
-	0xa7, 0x52, 0x08, 0xb2, 0xbc, 0x56, 0xd4, 0xda, 0x5a, 0xa1, 0x95, 0x4d, 0xf5, 0x0d, 0x7a, 0x4c,
-	0xbf, 0x41, 0xbe, 0x41, 0xbf, 0x46, 0x8e, 0x39, 0xf4, 0xd0, 0x93, 0x28, 0xc9, 0xa5, 0xb7, 0x82,
-	0xfb, 0x05, 0xca, 0x4a, 0x2b, 0x55, 0xb6, 0x71, 0x70, 0x7b, 0xea, 0x6d, 0x67, 0xe6, 0x37, 0xbf,
-	0x9d, 0xf9, 0x69, 0x46, 0x0b, 0x3b, 0x4e, 0xd3, 0xd2, 0x4c, 0xcf, 0xeb, 0x38, 0x96, 0x19, 0x38,
-	0xc4, 0xa5, 0x5a, 0x1b, 0x63, 0xad, 0x5f, 0xd3, 0x82, 0x77, 0xaa, 0xe7, 0x93, 0x80, 0xa0, 0x4d,
-	0xa7, 0x69, 0xa9, 0x79, 0x84, 0xda, 0xc6, 0x58, 0xed, 0xd7, 0xa4, 0x75, 0x9b, 0xd8, 0x24, 0xc6,
-	0x68, 0xec, 0x94, 0xc0, 0xa5, 0x7f, 0x26, 0x11, 0xb2, 0xac, 0x1c, 0xc4, 0x22, 0x3e, 0xd6, 0xac,
-	0x73, 0xd3, 0x75, 0x71, 0x87, 0x85, 0xf9, 0x31, 0x81, 0x28, 0x1f, 0x05, 0x58, 0x3b, 0xa5, 0xb6,
-	0x81, 0x6d, 0x87, 0x06, 0xd8, 0x6f, 0x98, 0x21, 0xc6, 0xe8, 0x1e, 0x2c, 0x78, 0xc4, 0x0f, 0xce,
-	0x9c, 0x96, 0x28, 0xec, 0x08, 0xbb, 0x25, 0x1d, 0x0d, 0x22, 0x79, 0x25, 0x34, 0xbb, 0x9d, 0x43,
-	0x85, 0x07, 0x14, 0x63, 0x9e, 0x9d, 0x4e, 0x5a, 0x68, 0x1f, 0x80, 0x53, 0x32, 0xfc, 0x4c, 0x8c,
-	0xdf, 0x18, 0x44, 0x72, 0x39, 0xc1, 0xff, 0x8c, 0x29, 0x46, 0x89, 0x1b, 0x27, 0x2d, 0x24, 0xc2,
-	0x82, 0x8f, 0x3b, 0x66, 0x88, 0x7d, 0xb1, 0xc8, 0x52, 0x8c, 0xd4, 0x44, 0xeb, 0x30, 0xe7, 0xb1,
-	0x2a, 0xc4, 0xd9, 0xd8, 0x9f, 0x18, 0x87, 0x8b, 0xef, 0x2f, 0xe5, 0xc2, 0xd7, 0x4b, 0xb9, 0xa0,
-	0x48, 0x20, 0x8e, 0x16, 0x6c, 0x60, 0xea, 0x11, 0x97, 0x62, 0xe5, 0xbb, 0x00, 0x5b, 0xb9, 0xe0,
-	0x11, 0xe9, 0xb9, 0x01, 0xf6, 0x3d, 0xd3, 0x0f, 0xc2, 0x3f, 0xa0, 0xb3, 0xe7, 0x80, 0xac, 0x5c,
-	0x45, 0x67, 0xb9, 0x36, 0xf5, 0xed, 0x41, 0x24, 0x57, 0x38, 0xef, 0x18, 0x46, 0x31, 0xca, 0xd6,
-	0x68, 0x2b, 0x39, 0x45, 0xfe, 0x83, 0x7f, 0xef, 0x6a, 0x3a, 0x53, 0xe7, 0x62, 0x06, 0x56, 0x4f,
-	0xa9, 0xdd, 0x30, 0xc3, 0x86, 0x69, 0xbd, 0xc5, 0xc1, 0x31, 0xc6, 0x68, 0x1f, 0x8a, 0x6d, 0x8c,
-	0x63, 0x31, 0x96, 0xea, 0x5b, 0xea, 0x84, 0x11, 0x54, 0x8f, 0x31, 0xd6, 0x67, 0xaf, 0x22, 0xb9,
-	0x60, 0x30, 0x38, 0x7a, 0x02, 0x2b, 0x94, 0xf4, 0x7c, 0x0b, 0x9f, 0xa5, 0x6a, 0x26, 0xea, 0x54,
-	0x06, 0x91, 0xbc, 0x91, 0x74, 0x31, 0x1c, 0x57, 0x8c, 0xe5, 0xc4, 0xd1, 0x48, 0xa4, 0x7d, 0x06,
-	0x65, 0x0e, 0xc8, 0x29, 0x1c, 0xcb, 0xa5, 0x6f, 0x0d, 0x22, 0x59, 0x1c, 0xe2, 0xc8, 0x0b, 0xbd,
-	0x9a, 0xf8, 0x8e, 0x32, 0xb9, 0xff, 0x86, 0x79, 0xea, 0xd8, 0x2e, 0xf6, 0xf9, 0xbc, 0x70, 0x0b,
-	0x49, 0xb0, 0xc8, 0x75, 0xa7, 0xe2, 0xdc, 0x4e, 0x71, 0xb7, 0x64, 0x64, 0x76, 0x4e, 0xba, 0x0a,
-	0x6c, 0x8e, 0x28, 0x92, 0xa9, 0xf5, 0x49, 0x80, 0xf5, 0x91, 0xd8, 0x53, 0x1a, 0xba, 0x16, 0x7a,
-	0x09, 0x25, 0x2f, 0xf6, 0xa4, 0x53, 0xb4, 0x54, 0xdf, 0x8e, 0x85, 0x63, 0x9b, 0xa6, 0xa6, 0xeb,
-	0xd5, 0xaf, 0xa9, 0x49, 0xde, 0x49, 0x4b, 0x17, 0x99, 0x72, 0x83, 0x48, 0x5e, 0xe3, 0x83, 0x96,
-	0x66, 0x2b, 0xc6, 0xa2, 0xc7, 0x31, 0xe8, 0x35, 0x00, 0xf7, 0xb3, 0xef, 0x31, 0x13, 0xd3, 0x2a,
-	0x13, 0xbf, 0x47, 0x56, 0x92, 0x5e, 0xe1, 0xdc, 0xe5, 0x21, 0xee, 0x36, 0x1b, 0x1a, 0x5e, 0xe6,
-	0xf1, 0xd0, 0xb0, 0x54, 0xe3, 0x0d, 0x19, 0xeb, 0x2a, 0x6d, 0xbb, 0xfe, 0xad, 0x08, 0xc5, 0x53,
-	0x6a, 0xa3, 0x2e, 0xfc, 0x35, 0xfc, 0x53, 0xf8, 0x7f, 0x62, 0x31, 0xa3, 0xeb, 0x28, 0xd5, 0xa6,
-	0x86, 0xa6, 0xd7, 0xa2, 0x0f, 0x02, 0x54, 0x26, 0xaf, 0xed, 0xc1, 0x34, 0x84, 0x63, 0x69, 0xd2,
-	0xe3, 0xdf, 0x4a, 0xcb, 0x6a, 0x7a, 0x03, 0xcb, 0x43, 0xbb, 0xb2, 0x7b, 0x17, 0x5d, 0x1e, 0x29,
-	0xdd, 0x9f, 0x16, 0x99, 0xdd, 0x15, 0x42, 0x79, 0x7c, 0xd2, 0xf6, 0xa6, 0xa5, 0x89, 0xe1, 0xd2,
-	0xc1, 0x2f, 0xc1, 0xd3, 0xab, 0xf5, 0x17, 0x57, 0x37, 0x55, 0xe1, 0xfa, 0xa6, 0x2a, 0x7c, 0xb9,
-	0xa9, 0x0a, 0x17, 0xb7, 0xd5, 0xc2, 0xf5, 0x6d, 0xb5, 0xf0, 0xf9, 0xb6, 0x5a, 0x78, 0x75, 0x60,
-	0x3b, 0xc1, 0x79, 0xaf, 0xa9, 0x5a, 0xa4, 0xab, 0x59, 0x84, 0x76, 0x09, 0xd5, 0x9c, 0xa6, 0xb5,
-	0x67, 0x13, 0xad, 0xff, 0x50, 0xeb, 0x92, 0x56, 0xaf, 0x83, 0x29, 0x7b, 0x82, 0xa8, 0x56, 0x7f,
-	0xb4, 0xc7, 0x5e, 0x9f, 0x20, 0xf4, 0x30, 0x6d, 0xce, 0xc7, 0x4f, 0xcb, 0x83, 0x1f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x33, 0xbd, 0x77, 0xfb, 0xf3, 0x06, 0x00, 0x00,
+	// 781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6a, 0xdb, 0x4c,
+	0x14, 0xb5, 0xec, 0xfc, 0xf9, 0xe6, 0xd7, 0x22, 0xf9, 0x22, 0x0b, 0x47, 0x72, 0xc4, 0x47, 0x71,
+	0x08, 0x91, 0x6a, 0x37, 0x09, 0x34, 0x50, 0x4a, 0x15, 0x08, 0x0d, 0x34, 0xd4, 0x88, 0xae, 0x4a,
+	0x21, 0xc8, 0xf2, 0x58, 0x51, 0x6b, 0x5b, 0x42, 0x23, 0x9b, 0xea, 0x0d, 0xba, 0x4c, 0xfb, 0x04,
+	0xd9, 0x77, 0xd1, 0xd7, 0xc8, 0x32, 0x8b, 0x2e, 0xba, 0x32, 0x25, 0xd9, 0x74, 0xed, 0xbe, 0x40,
+	0x91, 0x34, 0x52, 0x25, 0x27, 0x76, 0x5c, 0x2f, 0x4a, 0x77, 0x9a, 0xb9, 0xe7, 0xde, 0x39, 0xf7,
+	0x9c, 0x99, 0x8b, 0xa0, 0x68, 0xd4, 0x34, 0x49, 0xb5, 0xac, 0xa6, 0xa1, 0xa9, 0x8e, 0x61, 0xb6,
+	0xb1, 0xd4, 0x40, 0x48, 0xea, 0x96, 0x25, 0xe7, 0xbd, 0x68, 0xd9, 0xa6, 0x63, 0xd2, 0xeb, 0x46,
+	0x4d, 0x13, 0xe3, 0x08, 0xb1, 0x81, 0x90, 0xd8, 0x2d, 0xb3, 0xab, 0xba, 0xa9, 0x9b, 0x3e, 0x46,
+	0xf2, 0xbe, 0x02, 0x38, 0xbb, 0x39, 0xac, 0xa0, 0x97, 0x15, 0x83, 0x68, 0xa6, 0x8d, 0x24, 0xed,
+	0x4c, 0x6d, 0xb7, 0x51, 0xd3, 0x0b, 0x93, 0xcf, 0x00, 0x22, 0x7c, 0xa1, 0x60, 0xe5, 0x04, 0xeb,
+	0x0a, 0xd2, 0x0d, 0xec, 0x20, 0xbb, 0xaa, 0xba, 0x08, 0xd1, 0xdb, 0x30, 0x6b, 0x99, 0xb6, 0x73,
+	0x6a, 0xd4, 0x19, 0xaa, 0x48, 0x95, 0xb2, 0x32, 0xdd, 0xef, 0xf1, 0x4b, 0xae, 0xda, 0x6a, 0x1e,
+	0x08, 0x24, 0x20, 0x28, 0x33, 0xde, 0xd7, 0x71, 0x9d, 0xde, 0x05, 0x20, 0x25, 0x3d, 0x7c, 0xda,
+	0xc7, 0xaf, 0xf5, 0x7b, 0x7c, 0x2e, 0xc0, 0xff, 0x8e, 0x09, 0x4a, 0x96, 0x2c, 0x8e, 0xeb, 0x34,
+	0x03, 0xb3, 0x36, 0x6a, 0xaa, 0x2e, 0xb2, 0x99, 0x8c, 0x97, 0xa2, 0x84, 0x4b, 0x7a, 0x15, 0xa6,
+	0x2d, 0x8f, 0x05, 0x33, 0xe5, 0xef, 0x07, 0x8b, 0x83, 0xb9, 0x0f, 0x17, 0x7c, 0xea, 0xc7, 0x05,
+	0x9f, 0x12, 0x58, 0x60, 0x06, 0x09, 0x2b, 0x08, 0x5b, 0x66, 0x1b, 0x23, 0xe1, 0x27, 0x05, 0x85,
+	0x58, 0xf0, 0xd0, 0xec, 0xb4, 0x1d, 0x64, 0x5b, 0xaa, 0xed, 0xb8, 0xff, 0x40, 0x67, 0x2f, 0x80,
+	0xd6, 0x62, 0x8c, 0x4e, 0x63, 0x6d, 0xca, 0x1b, 0xfd, 0x1e, 0x9f, 0x27, 0x75, 0x6f, 0x61, 0x04,
+	0x25, 0xa7, 0x0d, 0xb6, 0x12, 0x53, 0xe4, 0x01, 0xfc, 0x3f, 0xaa, 0xe9, 0x48, 0x9d, 0x2b, 0x0a,
+	0x36, 0xee, 0x88, 0x7a, 0x69, 0xb6, 0x7f, 0x85, 0xfe, 0x86, 0x3c, 0x77, 0x8b, 0x90, 0x99, 0x4c,
+	0x04, 0xe1, 0x33, 0x05, 0x9b, 0xa3, 0x7a, 0x97, 0x55, 0x47, 0x3b, 0x8b, 0x5b, 0x42, 0x25, 0x2d,
+	0xa9, 0xc1, 0xa2, 0x1d, 0x13, 0x00, 0x33, 0xe9, 0x62, 0xa6, 0x34, 0x5f, 0xd9, 0x17, 0x87, 0xbc,
+	0x45, 0x71, 0xa4, 0x7e, 0xf2, 0xd4, 0x65, 0x8f, 0x4f, 0x29, 0xc9, 0x92, 0x31, 0xa3, 0xb6, 0x61,
+	0xeb, 0x5e, 0xb2, 0x91, 0x5b, 0xe7, 0x69, 0x58, 0x3e, 0xc1, 0x7a, 0x55, 0x75, 0xab, 0xaa, 0xf6,
+	0x0e, 0x39, 0x47, 0x08, 0xd1, 0xbb, 0x90, 0x69, 0x20, 0xe4, 0x37, 0x31, 0x5f, 0x29, 0x0c, 0x25,
+	0x79, 0x84, 0x10, 0xa1, 0xe2, 0xc1, 0xe9, 0xa7, 0xb0, 0x84, 0xcd, 0x8e, 0xad, 0xa1, 0xd3, 0xd0,
+	0xdc, 0xc0, 0xac, 0x7c, 0xbf, 0xc7, 0xaf, 0x05, 0x72, 0x27, 0xe3, 0x82, 0xb2, 0x10, 0x6c, 0x54,
+	0x03, 0xa7, 0x9f, 0x43, 0x8e, 0x00, 0x62, 0x86, 0x07, 0x96, 0x15, 0xfa, 0x3d, 0x9e, 0x49, 0xd4,
+	0x88, 0xfb, 0xbe, 0x1c, 0xec, 0x1d, 0x46, 0xee, 0xff, 0x07, 0x33, 0xd8, 0xd0, 0xdb, 0xc8, 0x26,
+	0xaf, 0x9b, 0xac, 0x68, 0x16, 0xe6, 0x88, 0x25, 0x98, 0x99, 0x2e, 0x66, 0x4a, 0x59, 0x25, 0x5a,
+	0xc7, 0xf4, 0xcb, 0xc3, 0xfa, 0x80, 0x22, 0x91, 0x5a, 0x5f, 0x29, 0x58, 0x1d, 0x88, 0x3d, 0xc3,
+	0x6e, 0x5b, 0xa3, 0x5f, 0x41, 0xd6, 0xf2, 0x77, 0xc2, 0x4b, 0x3d, 0x5f, 0xd9, 0xf0, 0x85, 0xf3,
+	0xe6, 0xa2, 0x18, 0x0e, 0xc3, 0x6e, 0x59, 0x0c, 0xf2, 0x8e, 0xeb, 0x32, 0xe3, 0x29, 0xd7, 0xef,
+	0xf1, 0x2b, 0xe4, 0xde, 0x87, 0xd9, 0x82, 0x32, 0x67, 0x11, 0x0c, 0xfd, 0x06, 0x80, 0xec, 0x7b,
+	0x7e, 0xa4, 0xfd, 0xb2, 0xc2, 0x50, 0x3f, 0x22, 0x4a, 0x72, 0x9e, 0xd4, 0xce, 0x25, 0x6a, 0x37,
+	0xbc, 0xdb, 0x4d, 0x68, 0x1e, 0x25, 0x9e, 0x36, 0x07, 0x85, 0xbb, 0xba, 0x0a, 0xdb, 0xae, 0x7c,
+	0x9a, 0x86, 0xcc, 0x09, 0xd6, 0xe9, 0x16, 0x2c, 0x26, 0x47, 0xf8, 0xd6, 0x50, 0x32, 0x83, 0xc3,
+	0x93, 0x2d, 0x8f, 0x0d, 0x0d, 0x8f, 0xa5, 0x3f, 0x52, 0x90, 0x1f, 0x3e, 0x64, 0xf7, 0xc6, 0x29,
+	0x78, 0x2b, 0x8d, 0x7d, 0x32, 0x51, 0x5a, 0xc4, 0xe9, 0x82, 0x02, 0xee, 0x9e, 0x39, 0x70, 0x30,
+	0xd1, 0x09, 0x7e, 0x2e, 0x2b, 0x4f, 0x9e, 0x1b, 0x51, 0x7c, 0x0b, 0x0b, 0x89, 0xe7, 0x5c, 0x1a,
+	0x55, 0x33, 0x8e, 0x64, 0x1f, 0x8e, 0x8b, 0x8c, 0xce, 0x72, 0x21, 0x77, 0xfb, 0x31, 0xec, 0x8c,
+	0x5b, 0xc6, 0x87, 0xb3, 0x7b, 0x7f, 0x04, 0x0f, 0x8f, 0x96, 0x5f, 0x5e, 0x5e, 0x73, 0xd4, 0xd5,
+	0x35, 0x47, 0x7d, 0xbf, 0xe6, 0xa8, 0xf3, 0x1b, 0x2e, 0x75, 0x75, 0xc3, 0xa5, 0xbe, 0xdd, 0x70,
+	0xa9, 0xd7, 0x7b, 0xba, 0xe1, 0x9c, 0x75, 0x6a, 0xa2, 0x66, 0xb6, 0x24, 0xcd, 0xc4, 0x2d, 0x13,
+	0x4b, 0x46, 0x4d, 0xdb, 0xd1, 0x4d, 0xa9, 0xbb, 0x2f, 0xb5, 0xcc, 0x7a, 0xa7, 0x89, 0xb0, 0xf7,
+	0x4f, 0x83, 0xa5, 0xca, 0xe3, 0x1d, 0xef, 0x77, 0xc6, 0x71, 0x2d, 0x84, 0x6b, 0x33, 0xfe, 0xbf,
+	0xca, 0xa3, 0x5f, 0x03, 0x00, 0x34, 0xae, 0x50, 0x7b, 0x44, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -443,6 +597,10 @@ type MsgClient interface {
 	// the destination chain must include the registered counterparty payee address in the acknowledgement. This function
 	// may be called more than once by a relayer, in which case, the latest counterparty payee address is always used.
 	RegisterCounterpartyPayee(ctx context.Context, in *MsgRegisterCounterpartyPayee, opts ...grpc.CallOption) (*MsgRegisterCounterpartyPayeeResponse, error)
+	// RegisterCounterpartyPayeeBatch defines a rpc handler method for MsgRegisterCounterpartyPayeeBatch
+	// RegisterCounterpartyPayeeBatch allows a relayer to register counterparty payee addresses on multiple channels
+	// in a single message. The batch is rejected as a whole if any of its registrations is invalid.
+	RegisterCounterpartyPayeeBatch(ctx context.Context, in *MsgRegisterCounterpartyPayeeBatch, opts ...grpc.CallOption) (*MsgRegisterCounterpartyPayeeBatchResponse, error)
 	// PayPacketFee defines a rpc handler method for MsgPayPacketFee
 	// PayPacketFee is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of the packet at the next sequence
@@ -481,6 +639,15 @@ func (c *msgClient) RegisterCounterpartyPayee(ctx context.Context, in *MsgRegist
 	return out, nil
 }
 
+func (c *msgClient) RegisterCounterpartyPayeeBatch(ctx context.Context, in *MsgRegisterCounterpartyPayeeBatch, opts ...grpc.CallOption) (*MsgRegisterCounterpartyPayeeBatchResponse, error) {
+	out := new(MsgRegisterCounterpartyPayeeBatchResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/RegisterCounterpartyPayeeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) PayPacketFee(ctx context.Context, in *MsgPayPacketFee, opts ...grpc.CallOption) (*MsgPayPacketFeeResponse, error) {
 	out := new(MsgPayPacketFeeResponse)
 	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/PayPacketFee", in, out, opts...)
@@ -513,6 +680,10 @@ type MsgServer interface {
 	// the destination chain must include the registered counterparty payee address in the acknowledgement. This function
 	// may be called more than once by a relayer, in which case, the latest counterparty payee address is always used.
 	RegisterCounterpartyPayee(context.Context, *MsgRegisterCounterpartyPayee) (*MsgRegisterCounterpartyPayeeResponse, error)
+	// RegisterCounterpartyPayeeBatch defines a rpc handler method for MsgRegisterCounterpartyPayeeBatch
+	// RegisterCounterpartyPayeeBatch allows a relayer to register counterparty payee addresses on multiple channels
+	// in a single message. The batch is rejected as a whole if any of its registrations is invalid.
+	RegisterCounterpartyPayeeBatch(context.Context, *MsgRegisterCounterpartyPayeeBatch) (*MsgRegisterCounterpartyPayeeBatchResponse, error)
 	// PayPacketFee defines a rpc handler method for MsgPayPacketFee
 	// PayPacketFee is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of the packet at the next sequence
@@ -535,6 +706,9 @@ func (*UnimplementedMsgServer) RegisterPayee(ctx context.Context, req *MsgRegist
 func (*UnimplementedMsgServer) RegisterCounterpartyPayee(ctx context.Context, req *MsgRegisterCounterpartyPayee) (*MsgRegisterCounterpartyPayeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterCounterpartyPayee not implemented")
 }
+func (*UnimplementedMsgServer) RegisterCounterpartyPayeeBatch(ctx context.Context, req *MsgRegisterCounterpartyPayeeBatch) (*MsgRegisterCounterpartyPayeeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCounterpartyPayeeBatch not implemented")
+}
 func (*UnimplementedMsgServer) PayPacketFee(ctx context.Context, req *MsgPayPacketFee) (*MsgPayPacketFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFee not implemented")
 }
@@ -582,6 +756,24 @@ func _Msg_RegisterCounterpartyPayee_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterCounterpartyPayeeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCounterpartyPayeeBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCounterpartyPayeeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/RegisterCounterpartyPayeeBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCounterpartyPayeeBatch(ctx, req.(*MsgRegisterCounterpartyPayeeBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_PayPacketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgPayPacketFee)
 	if err := dec(in); err != nil {
@@ -630,6 +822,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterCounterpartyPayee",
 			Handler:    _Msg_RegisterCounterpartyPayee_Handler,
 		},
+		{
+			MethodName: "RegisterCounterpartyPayeeBatch",
+			Handler:    _Msg_RegisterCounterpartyPayeeBatch_Handler,
+		},
 		{
 			MethodName: "PayPacketFee",
 			Handler:    _Msg_PayPacketFee_Handler,
@@ -791,6 +987,117 @@ func (m *MsgRegisterCounterpartyPayeeResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *CounterpartyPayeeRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CounterpartyPayeeRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CounterpartyPayeeRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CounterpartyPayee) > 0 {
+		i -= len(m.CounterpartyPayee)
+		copy(dAtA[i:], m.CounterpartyPayee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyPayee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCounterpartyPayeeBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCounterpartyPayeeBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCounterpartyPayeeBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Registrations) > 0 {
+		for iNdEx := len(m.Registrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Registrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCounterpartyPayeeBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCounterpartyPayeeBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCounterpartyPayeeBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgPayPacketFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1022,6 +1329,55 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Size() (n int) {
 	return n
 }
 
+func (m *CounterpartyPayeeRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyPayee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCounterpartyPayeeBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Registrations) > 0 {
+		for _, e := range m.Registrations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterCounterpartyPayeeBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgPayPacketFee) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1544,6 +1900,318 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CounterpartyPayeeRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CounterpartyPayeeRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CounterpartyPayeeRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayeeBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrations = append(m.Registrations, CounterpartyPayeeRegistration{})
+			if err := m.Registrations[len(m.Registrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayeeBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,337 @@
+package ibccallbacks
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+var _ porttypes.Middleware = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the callbacks middleware given the contract
+// keeper and the underlying application. Callbacks are executed for packets whose memo contains
+// a src_callback or dest_callback entry addressing a contract recognized by the contract keeper.
+type IBCMiddleware struct {
+	app            types.CallbacksCompatibleModule
+	ics4Wrapper    porttypes.ICS4Wrapper
+	contractKeeper types.ContractKeeper
+
+	// maxCallbackGas is the maximum amount of gas a single callback may consume
+	maxCallbackGas uint64
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the underlying application, the ICS4 wrapper,
+// the contract keeper and the maximum amount of gas a callback may consume. It panics if the
+// underlying application does not implement the PacketDataUnmarshaler interface.
+func NewIBCMiddleware(
+	app porttypes.IBCModule,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	contractKeeper types.ContractKeeper,
+	maxCallbackGas uint64,
+) IBCMiddleware {
+	callbacksApp, ok := app.(types.CallbacksCompatibleModule)
+	if !ok {
+		panic(fmt.Errorf("underlying application does not implement %T", (*types.CallbacksCompatibleModule)(nil)))
+	}
+
+	if ics4Wrapper == nil {
+		panic(fmt.Errorf("ICS4Wrapper cannot be nil"))
+	}
+
+	if contractKeeper == nil {
+		panic(fmt.Errorf("contract keeper cannot be nil"))
+	}
+
+	if maxCallbackGas == 0 {
+		panic(fmt.Errorf("maximum callback gas must be positive"))
+	}
+
+	return IBCMiddleware{
+		app:            callbacksApp,
+		ics4Wrapper:    ics4Wrapper,
+		contractKeeper: contractKeeper,
+		maxCallbackGas: maxCallbackGas,
+	}
+}
+
+// OnChanOpenInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCMiddleware interface.
+// The destination callback is executed once the underlying application has successfully received
+// the packet. The callback of a packet acknowledged asynchronously is executed in WriteAcknowledgement.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+
+	// the state changes of a packet which cannot be received are reverted, so
+	// there is no need to execute a callback on the receiving chain
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	im.processDestCallback(ctx, packet, ack)
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCMiddleware interface.
+// The source callback is executed once the underlying application has processed the acknowledgement.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	callbackData, found := im.getSourceCallbackData(ctx, packet)
+	if !found {
+		return nil
+	}
+
+	im.processCallback(ctx, packet, types.CallbackTypeAcknowledgementPacket, callbackData, func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCOnAcknowledgementPacket(
+			cachedCtx, packet, acknowledgement, relayer, callbackData.ContractAddress, callbackData.SenderAddress,
+		)
+	})
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCMiddleware interface.
+// The source callback is executed once the underlying application has processed the timeout.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	callbackData, found := im.getSourceCallbackData(ctx, packet)
+	if !found {
+		return nil
+	}
+
+	im.processCallback(ctx, packet, types.CallbackTypeTimeoutPacket, callbackData, func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCOnTimeoutPacket(
+			cachedCtx, packet, relayer, callbackData.ContractAddress, callbackData.SenderAddress,
+		)
+	})
+
+	return nil
+}
+
+// SendPacket implements the ICS4 Wrapper interface.
+// Packets requesting a malformed source callback are rejected, since the callback could never be executed.
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	if packetData, err := im.app.UnmarshalPacketData(ctx, sourcePort, sourceChannel, data); err == nil {
+		if _, _, err := types.GetSourceCallbackData(packetData, sourcePort, im.maxCallbackGas); err != nil {
+			return 0, err
+		}
+	}
+
+	return im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface.
+// The destination callback of a packet acknowledged asynchronously is executed once the
+// successful acknowledgement is written.
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	if err := im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack); err != nil {
+		return err
+	}
+
+	if ack.Success() {
+		im.processDestCallback(ctx, packet, ack)
+	}
+
+	return nil
+}
+
+// GetAppVersion returns the application version of the underlying application
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// processDestCallback executes the destination callback requested by the provided packet, if any.
+func (im IBCMiddleware) processDestCallback(ctx sdk.Context, packet exported.PacketI, ack exported.Acknowledgement) {
+	packetData, err := im.app.UnmarshalPacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+	if err != nil {
+		return
+	}
+
+	callbackData, found, err := types.GetDestCallbackData(packetData, im.maxCallbackGas)
+	if err != nil || !found || !im.contractKeeper.IsCallbackContract(ctx, callbackData.ContractAddress) {
+		return
+	}
+
+	im.processCallback(ctx, packet, types.CallbackTypeReceivePacket, callbackData, func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCReceivePacket(cachedCtx, packet, ack, callbackData.ContractAddress)
+	})
+}
+
+// getSourceCallbackData returns the source callback data requested by the provided packet. False is
+// returned if the packet does not request a well formed source callback addressing a contract.
+func (im IBCMiddleware) getSourceCallbackData(ctx sdk.Context, packet exported.PacketI) (types.CallbackData, bool) {
+	packetData, err := im.app.UnmarshalPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return types.CallbackData{}, false
+	}
+
+	callbackData, found, err := types.GetSourceCallbackData(packetData, packet.GetSourcePort(), im.maxCallbackGas)
+	if err != nil || !found || !im.contractKeeper.IsCallbackContract(ctx, callbackData.ContractAddress) {
+		return types.CallbackData{}, false
+	}
+
+	return callbackData, true
+}
+
+// processCallback executes the provided callback in a cached context metered by a gas meter limited
+// to the gas limit of the callback, or to the gas remaining to the transaction if lower. The state
+// changes of the callback are only written if the callback succeeds. A failing, panicking or out of gas
+// callback does not affect the packet handling of the underlying application. The gas consumed by the
+// callback, up to its gas limit, is charged to the transaction executing the packet handling. A callback
+// running out of the gas remaining to the transaction rather than of its own gas limit panics with an
+// out of gas error, failing the transaction so that the packet may be relayed again with enough gas.
+func (im IBCMiddleware) processCallback(
+	ctx sdk.Context,
+	packet exported.PacketI,
+	callbackType types.CallbackType,
+	callbackData types.CallbackData,
+	callbackFn func(cachedCtx sdk.Context) error,
+) {
+	cachedCtx, writeFn := ctx.CacheContext()
+
+	gasLimit := callbackData.GasLimit
+	txGasLimited := ctx.GasMeter().GasRemaining() < gasLimit
+	if txGasLimited {
+		gasLimit = ctx.GasMeter().GasRemaining()
+	}
+
+	cachedCtx = cachedCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	err := executeCallback(cachedCtx, callbackFn)
+	if txGasLimited && cachedCtx.GasMeter().IsPastLimit() {
+		panic(sdk.ErrorOutOfGas{Descriptor: fmt.Sprintf("ibc %s callback", callbackType)})
+	}
+	ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumedToLimit(), fmt.Sprintf("ibc %s callback", callbackType))
+
+	if err == nil {
+		writeFn()
+	} else {
+		ctx.Logger().With("module", "x/"+types.ModuleName).Error(
+			"callback failed", "callback-type", callbackType, "contract-address", callbackData.ContractAddress, "error", err.Error(),
+		)
+	}
+
+	types.EmitCallbackEvent(ctx, packet, callbackType, callbackData, err)
+}
+
+// executeCallback executes the provided callback, converting a panic raised by the callback,
+// including running out of gas, into an error.
+func executeCallback(ctx sdk.Context, callbackFn func(cachedCtx sdk.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "callback out of gas in location: %s", outOfGas.Descriptor)
+				return
+			}
+
+			err = sdkerrors.Wrapf(types.ErrCallbackPanic, "%v", r)
+		}
+	}()
+
+	return callbackFn(ctx)
+}
@@ -0,0 +1,591 @@
+package ibccallbacks_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	ibccallbacks "github.com/cosmos/ibc-go/v6/modules/apps/callbacks"
+	"github.com/cosmos/ibc-go/v6/modules/apps/callbacks/types"
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	ibcmock "github.com/cosmos/ibc-go/v6/testing/mock"
+)
+
+const (
+	contractAddress = "contract"
+	maxCallbackGas  = uint64(1_000_000)
+	callbackGasCost = uint64(10_000)
+)
+
+// mockContractKeeper is a ContractKeeper which records the executed callbacks in the store of
+// the provided store key before returning the configured result.
+type mockContractKeeper struct {
+	storeKey storetypes.StoreKey
+
+	err     error
+	panics  bool
+	gasCost uint64
+}
+
+func (k *mockContractKeeper) IsCallbackContract(ctx sdk.Context, address string) bool {
+	return address == contractAddress
+}
+
+func (k *mockContractKeeper) IBCOnAcknowledgementPacket(ctx sdk.Context, packet exported.PacketI, acknowledgement []byte, relayer sdk.AccAddress, contractAddress, packetSenderAddress string) error {
+	return k.execute(ctx, types.CallbackTypeAcknowledgementPacket)
+}
+
+func (k *mockContractKeeper) IBCOnTimeoutPacket(ctx sdk.Context, packet exported.PacketI, relayer sdk.AccAddress, contractAddress, packetSenderAddress string) error {
+	return k.execute(ctx, types.CallbackTypeTimeoutPacket)
+}
+
+func (k *mockContractKeeper) IBCReceivePacket(ctx sdk.Context, packet exported.PacketI, ack exported.Acknowledgement, contractAddress string) error {
+	return k.execute(ctx, types.CallbackTypeReceivePacket)
+}
+
+func (k *mockContractKeeper) execute(ctx sdk.Context, callbackType types.CallbackType) error {
+	gasCost := callbackGasCost
+	if k.gasCost != 0 {
+		gasCost = k.gasCost
+	}
+
+	ctx.GasMeter().ConsumeGas(gasCost, "mock callback")
+	ctx.KVStore(k.storeKey).Set(callbackKey(callbackType), []byte{1})
+
+	if k.panics {
+		panic("mock callback panic")
+	}
+
+	return k.err
+}
+
+func callbackKey(callbackType types.CallbackType) []byte {
+	return []byte(fmt.Sprintf("callback/%s", callbackType))
+}
+
+type CallbacksTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *CallbacksTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+func TestCallbacksTestSuite(t *testing.T) {
+	suite.Run(t, new(CallbacksTestSuite))
+}
+
+// newMiddleware returns the callbacks middleware wrapping the transfer application of the provided chain.
+func newMiddleware(chain *ibctesting.TestChain, contractKeeper types.ContractKeeper) ibccallbacks.IBCMiddleware {
+	app := chain.GetSimApp()
+	return ibccallbacks.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), app.IBCKeeper.ChannelKeeper, contractKeeper, maxCallbackGas)
+}
+
+// sendTransfer sends a transfer with the provided memo from chainA to chainB and returns the sent packet.
+func (suite *CallbacksTestSuite) sendTransfer(memo string) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, memo,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// requireCallbackEvent asserts whether a callback event with the expected result was emitted.
+func (suite *CallbacksTestSuite) requireCallbackEvent(events sdk.Events, eventType string, expCallback, expSuccess bool, expGasLimit uint64) {
+	var found bool
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		found = true
+		attributes := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+
+		suite.Require().Equal(contractAddress, attributes[types.AttributeKeyContractAddress])
+		suite.Require().Equal(fmt.Sprintf("%d", expGasLimit), attributes[types.AttributeKeyGasLimit])
+		if expSuccess {
+			suite.Require().Equal(types.AttributeValueCallbackSuccess, attributes[types.AttributeKeyResult])
+		} else {
+			suite.Require().Equal(types.AttributeValueCallbackFailure, attributes[types.AttributeKeyResult])
+			suite.Require().NotEmpty(attributes[types.AttributeKeyError])
+		}
+	}
+
+	suite.Require().Equal(expCallback, found)
+}
+
+func (suite *CallbacksTestSuite) TestNewIBCMiddleware() {
+	contractKeeper := &mockContractKeeper{}
+	app := suite.chainA.GetSimApp()
+
+	suite.Require().NotPanics(func() {
+		ibccallbacks.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), app.IBCKeeper.ChannelKeeper, contractKeeper, maxCallbackGas)
+	})
+
+	suite.Require().Panics(func() {
+		ibccallbacks.NewIBCMiddleware(ibcmock.NewIBCModule(&ibcmock.AppModule{}, ibcmock.NewIBCApp(ibctesting.MockPort, app.ScopedIBCMockKeeper)), app.IBCKeeper.ChannelKeeper, contractKeeper, maxCallbackGas)
+	}, "underlying application does not unmarshal packet data")
+
+	suite.Require().Panics(func() {
+		ibccallbacks.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), nil, contractKeeper, maxCallbackGas)
+	}, "nil ICS4Wrapper")
+
+	suite.Require().Panics(func() {
+		ibccallbacks.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), app.IBCKeeper.ChannelKeeper, nil, maxCallbackGas)
+	}, "nil contract keeper")
+
+	suite.Require().Panics(func() {
+		ibccallbacks.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), app.IBCKeeper.ChannelKeeper, contractKeeper, 0)
+	}, "zero maximum callback gas")
+}
+
+func (suite *CallbacksTestSuite) TestOnAcknowledgementPacket() {
+	var (
+		memo           string
+		contractKeeper *mockContractKeeper
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expCallback bool
+		expSuccess  bool
+		expGasLimit uint64
+	}{
+		{
+			"success",
+			func() {},
+			true, true, maxCallbackGas,
+		},
+		{
+			"success: gas limit requested by the memo",
+			func() {
+				memo = fmt.Sprintf(`{"src_callback":{"address":"%s","gas_limit":"50000"}}`, contractAddress)
+			},
+			true, true, 50_000,
+		},
+		{
+			"success: gas limit capped at the maximum callback gas",
+			func() {
+				memo = fmt.Sprintf(`{"src_callback":{"address":"%s","gas_limit":"%d"}}`, contractAddress, maxCallbackGas+1)
+			},
+			true, true, maxCallbackGas,
+		},
+		{
+			"no callback requested",
+			func() {
+				memo = "memo"
+			},
+			false, false, 0,
+		},
+		{
+			"only destination callback requested",
+			func() {
+				memo = fmt.Sprintf(`{"dest_callback":{"address":"%s"}}`, contractAddress)
+			},
+			false, false, 0,
+		},
+		{
+			"address is not a contract",
+			func() {
+				memo = `{"src_callback":{"address":"account"}}`
+			},
+			false, false, 0,
+		},
+		{
+			"malformed callback",
+			func() {
+				memo = fmt.Sprintf(`{"src_callback":{"address":"%s","gas_limit":"invalid"}}`, contractAddress)
+			},
+			false, false, 0,
+		},
+		{
+			"failure: callback returns an error",
+			func() {
+				contractKeeper.err = errors.New("mock callback error")
+			},
+			true, false, maxCallbackGas,
+		},
+		{
+			"failure: callback panics",
+			func() {
+				contractKeeper.panics = true
+			},
+			true, false, maxCallbackGas,
+		},
+		{
+			"failure: callback runs out of gas",
+			func() {
+				memo = fmt.Sprintf(`{"src_callback":{"address":"%s","gas_limit":"%d"}}`, contractAddress, callbackGasCost-1)
+			},
+			true, false, callbackGasCost - 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			memo = fmt.Sprintf(`{"src_callback":{"address":"%s"}}`, contractAddress)
+			contractKeeper = &mockContractKeeper{storeKey: suite.chainA.GetSimApp().GetKey(transfertypes.StoreKey)}
+
+			tc.malleate()
+
+			// the packet is constructed rather than sent, since sending a packet requesting a malformed
+			// source callback is rejected by the transfer stack of simapp
+			packetData := transfertypes.NewFungibleTokenPacketData(
+				sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), memo,
+			)
+			packet := channeltypes.NewPacket(
+				packetData.GetBytes(), 1, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0,
+			)
+			ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+			ctx := suite.chainA.GetContext()
+			middleware := newMiddleware(suite.chainA, contractKeeper)
+			err := middleware.OnAcknowledgementPacket(ctx, packet, ack, suite.chainA.SenderAccount.GetAddress())
+
+			// the packet handling of the underlying application never fails because of the callback
+			suite.Require().NoError(err)
+			suite.requireCallbackEvent(ctx.EventManager().Events(), types.EventTypeSourceCallback, tc.expCallback, tc.expSuccess, tc.expGasLimit)
+
+			// the state changes of the callback are only written if the callback succeeds
+			stored := ctx.KVStore(contractKeeper.storeKey).Has(callbackKey(types.CallbackTypeAcknowledgementPacket))
+			suite.Require().Equal(tc.expCallback && tc.expSuccess, stored)
+		})
+	}
+}
+
+// TestCallbackGasCappedByTransactionGas tests that the gas meter of a callback is limited to the gas
+// remaining to the transaction executing the packet handling.
+func (suite *CallbacksTestSuite) TestCallbackGasCappedByTransactionGas() {
+	var contractKeeper *mockContractKeeper
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPanic bool
+	}{
+		{
+			"success: callback gas limit exceeds the gas remaining to the transaction",
+			func() {},
+			false,
+		},
+		{
+			"callback runs out of the gas remaining to the transaction",
+			func() {
+				contractKeeper.gasCost = maxCallbackGas
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			contractKeeper = &mockContractKeeper{storeKey: suite.chainA.GetSimApp().GetKey(transfertypes.StoreKey)}
+
+			tc.malleate()
+
+			packet := suite.sendTransfer(fmt.Sprintf(`{"src_callback":{"address":"%s"}}`, contractAddress))
+			ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+			// the transaction has less gas remaining than the maximum callback gas
+			ctx := suite.chainA.GetContext().WithGasMeter(sdk.NewGasMeter(maxCallbackGas / 2))
+			middleware := newMiddleware(suite.chainA, contractKeeper)
+
+			if tc.expPanic {
+				// running out of the gas remaining to the transaction fails the transaction instead of the callback
+				suite.Require().PanicsWithValue(sdk.ErrorOutOfGas{Descriptor: fmt.Sprintf("ibc %s callback", types.CallbackTypeAcknowledgementPacket)}, func() {
+					_ = middleware.OnAcknowledgementPacket(ctx, packet, ack, suite.chainA.SenderAccount.GetAddress())
+				})
+				return
+			}
+
+			err := middleware.OnAcknowledgementPacket(ctx, packet, ack, suite.chainA.SenderAccount.GetAddress())
+			suite.Require().NoError(err)
+			suite.requireCallbackEvent(ctx.EventManager().Events(), types.EventTypeSourceCallback, true, true, maxCallbackGas)
+			suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), callbackGasCost)
+		})
+	}
+}
+
+func (suite *CallbacksTestSuite) TestOnTimeoutPacket() {
+	var contractKeeper *mockContractKeeper
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expSuccess bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"failure: callback returns an error",
+			func() {
+				contractKeeper.err = errors.New("mock callback error")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			contractKeeper = &mockContractKeeper{storeKey: suite.chainA.GetSimApp().GetKey(transfertypes.StoreKey)}
+
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			packet := suite.sendTransfer(fmt.Sprintf(`{"src_callback":{"address":"%s"}}`, contractAddress))
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			ctx := suite.chainA.GetContext()
+			middleware := newMiddleware(suite.chainA, contractKeeper)
+			err := middleware.OnTimeoutPacket(ctx, packet, sender)
+			suite.Require().NoError(err)
+
+			// the tokens are refunded regardless of the callback result
+			balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+			suite.Require().Equal(balanceBefore.AddAmount(sdk.NewInt(100)), balanceAfter)
+
+			suite.requireCallbackEvent(ctx.EventManager().Events(), types.EventTypeSourceCallback, true, tc.expSuccess, maxCallbackGas)
+			stored := ctx.KVStore(contractKeeper.storeKey).Has(callbackKey(types.CallbackTypeTimeoutPacket))
+			suite.Require().Equal(tc.expSuccess, stored)
+		})
+	}
+}
+
+func (suite *CallbacksTestSuite) TestOnRecvPacket() {
+	var (
+		packetData     transfertypes.FungibleTokenPacketData
+		contractKeeper *mockContractKeeper
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expAck      bool
+		expCallback bool
+		expSuccess  bool
+	}{
+		{
+			"success",
+			func() {},
+			true, true, true,
+		},
+		{
+			"only source callback requested",
+			func() {
+				packetData.Memo = fmt.Sprintf(`{"src_callback":{"address":"%s"}}`, contractAddress)
+			},
+			true, false, false,
+		},
+		{
+			"packet cannot be received",
+			func() {
+				packetData.Receiver = "invalid address"
+			},
+			false, false, false,
+		},
+		{
+			"failure: callback returns an error",
+			func() {
+				contractKeeper.err = errors.New("mock callback error")
+			},
+			true, true, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packetData = transfertypes.NewFungibleTokenPacketData(
+				sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				fmt.Sprintf(`{"dest_callback":{"address":"%s"}}`, contractAddress),
+			)
+			contractKeeper = &mockContractKeeper{storeKey: suite.chainB.GetSimApp().GetKey(transfertypes.StoreKey)}
+
+			tc.malleate()
+
+			packet := channeltypes.NewPacket(
+				packetData.GetBytes(), 1, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			middleware := newMiddleware(suite.chainB, contractKeeper)
+			ack := middleware.OnRecvPacket(ctx, packet, suite.chainB.SenderAccount.GetAddress())
+
+			// the acknowledgement of the underlying application is returned regardless of the callback result
+			suite.Require().Equal(tc.expAck, ack.Success())
+			suite.requireCallbackEvent(ctx.EventManager().Events(), types.EventTypeDestinationCallback, tc.expCallback, tc.expSuccess, maxCallbackGas)
+
+			stored := ctx.KVStore(contractKeeper.storeKey).Has(callbackKey(types.CallbackTypeReceivePacket))
+			suite.Require().Equal(tc.expCallback && tc.expSuccess, stored)
+		})
+	}
+}
+
+func (suite *CallbacksTestSuite) TestSendPacket() {
+	testCases := []struct {
+		name    string
+		memo    string
+		expPass bool
+	}{
+		{"success: no callback", "", true},
+		{"success: source callback", fmt.Sprintf(`{"src_callback":{"address":"%s"}}`, contractAddress), true},
+		{"malformed source callback", `{"src_callback":{"address":""}}`, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packetData := transfertypes.NewFungibleTokenPacketData(
+				sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), tc.memo,
+			)
+			chanCap := suite.chainA.GetChannelCapability(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+
+			middleware := newMiddleware(suite.chainA, &mockContractKeeper{})
+			_, err := middleware.SendPacket(
+				suite.chainA.GetContext(), chanCap, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				suite.chainB.GetTimeoutHeight(), 0, packetData.GetBytes(),
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidCallbackData)
+			}
+		})
+	}
+}
+
+// TestSimAppCallbacks tests the callbacks middleware wired into the transfer stack of simapp end to end,
+// executing the callbacks of transfers relayed between the test chains.
+func (suite *CallbacksTestSuite) TestSimAppCallbacks() {
+	var executedA, executedB []types.CallbackType
+
+	// setContractKeeper configures the mock contract keeper of the provided chain to record the executed callbacks
+	setContractKeeper := func(chain *ibctesting.TestChain, executed *[]types.CallbackType) {
+		contractKeeper := chain.GetSimApp().MockContractKeeper
+		contractKeeper.IsCallbackContractFn = func(ctx sdk.Context, address string) bool {
+			return address == contractAddress
+		}
+		contractKeeper.IBCOnAcknowledgementPacketFn = func(ctx sdk.Context, packet exported.PacketI, acknowledgement []byte, relayer sdk.AccAddress, contractAddress, packetSenderAddress string) error {
+			suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), packetSenderAddress)
+			*executed = append(*executed, types.CallbackTypeAcknowledgementPacket)
+			return nil
+		}
+		contractKeeper.IBCOnTimeoutPacketFn = func(ctx sdk.Context, packet exported.PacketI, relayer sdk.AccAddress, contractAddress, packetSenderAddress string) error {
+			suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), packetSenderAddress)
+			*executed = append(*executed, types.CallbackTypeTimeoutPacket)
+			return nil
+		}
+		contractKeeper.IBCReceivePacketFn = func(ctx sdk.Context, packet exported.PacketI, ack exported.Acknowledgement, contractAddress string) error {
+			suite.Require().True(ack.Success())
+			*executed = append(*executed, types.CallbackTypeReceivePacket)
+			return nil
+		}
+	}
+
+	setContractKeeper(suite.chainA, &executedA)
+	setContractKeeper(suite.chainB, &executedB)
+
+	memo := fmt.Sprintf(`{"src_callback":{"address":"%s"},"dest_callback":{"address":"%s"}}`, contractAddress, contractAddress)
+
+	// the destination callback is executed on receipt and the source callback on acknowledgement
+	packet := suite.sendTransfer(memo)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	suite.Require().Equal([]types.CallbackType{types.CallbackTypeAcknowledgementPacket}, executedA)
+	suite.Require().Equal([]types.CallbackType{types.CallbackTypeReceivePacket}, executedB)
+
+	// the source callback is executed on timeout
+	executedA, executedB = nil, nil
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext()).Increment().(clienttypes.Height)
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, memo,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().Equal([]types.CallbackType{types.CallbackTypeTimeoutPacket}, executedA)
+	suite.Require().Empty(executedB)
+
+	// a transfer requesting a malformed source callback is rejected when sent
+	msg = transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, `{"src_callback":{"address":""}}`,
+	)
+
+	_, err = suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidCallbackData)
+}
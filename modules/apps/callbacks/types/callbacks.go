@@ -0,0 +1,124 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+)
+
+// CallbacksCompatibleModule defines the interface which must be implemented by the application
+// wrapped by the callbacks middleware, allowing the middleware to decode the packet data.
+type CallbacksCompatibleModule interface {
+	porttypes.IBCModule
+	porttypes.PacketDataUnmarshaler
+}
+
+// CallbackPacketData defines the interface which must be implemented by the packet data of the
+// underlying application for callbacks to be executed.
+type CallbackPacketData interface {
+	// GetMemo returns the packet memo, which may contain the callback entries.
+	GetMemo() string
+	// GetPacketSender returns the sender of the packet sent over the provided source port.
+	// An empty string is returned if the sender cannot be determined.
+	GetPacketSender(sourcePortID string) string
+}
+
+// callbackMetadata defines the structure of a callback entry of the packet memo.
+//
+// Example:
+//
+//	{"src_callback":{"address":"cosmos1...","gas_limit":"100000"}}
+type callbackMetadata struct {
+	Address  string `json:"address"`
+	GasLimit string `json:"gas_limit,omitempty"`
+}
+
+// CallbackData defines the data required to execute a callback.
+type CallbackData struct {
+	// ContractAddress is the address of the contract receiving the callback.
+	ContractAddress string
+	// SenderAddress is the sender of the packet. It is only set for source callbacks.
+	SenderAddress string
+	// GasLimit is the maximum amount of gas the callback may consume.
+	GasLimit uint64
+}
+
+// GetSourceCallbackData returns the source callback data contained in the memo of the provided
+// packet data. The gas limit requested by the memo is capped at the provided maximum callback gas.
+// False is returned if the packet data does not request a source callback.
+func GetSourceCallbackData(packetData interface{}, sourcePortID string, maxCallbackGas uint64) (CallbackData, bool, error) {
+	callbackData, found, err := getCallbackData(packetData, SourceCallbackKey, maxCallbackGas)
+	if err != nil || !found {
+		return CallbackData{}, found, err
+	}
+
+	callbackData.SenderAddress = packetData.(CallbackPacketData).GetPacketSender(sourcePortID)
+	return callbackData, true, nil
+}
+
+// GetDestCallbackData returns the destination callback data contained in the memo of the provided
+// packet data. The gas limit requested by the memo is capped at the provided maximum callback gas.
+// False is returned if the packet data does not request a destination callback.
+func GetDestCallbackData(packetData interface{}, maxCallbackGas uint64) (CallbackData, bool, error) {
+	return getCallbackData(packetData, DestinationCallbackKey, maxCallbackGas)
+}
+
+// getCallbackData parses the callback entry stored under the provided key of the packet memo.
+// The memo may be used for arbitrary purposes, so false is returned if the memo is not a JSON
+// object or does not contain the callback entry. An error is returned if the callback entry is
+// present but malformed.
+func getCallbackData(packetData interface{}, callbackKey string, maxCallbackGas uint64) (CallbackData, bool, error) {
+	callbackPacketData, ok := packetData.(CallbackPacketData)
+	if !ok {
+		return CallbackData{}, false, nil
+	}
+
+	memo := callbackPacketData.GetMemo()
+	if !strings.Contains(memo, `"`+callbackKey+`"`) {
+		return CallbackData{}, false, nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &raw); err != nil {
+		return CallbackData{}, false, nil
+	}
+
+	entry, ok := raw[callbackKey]
+	if !ok {
+		return CallbackData{}, false, nil
+	}
+
+	var metadata callbackMetadata
+	if err := json.Unmarshal(entry, &metadata); err != nil {
+		return CallbackData{}, false, sdkerrors.Wrapf(ErrInvalidCallbackData, "cannot unmarshal %s metadata: %s", callbackKey, err.Error())
+	}
+
+	if strings.TrimSpace(metadata.Address) == "" {
+		return CallbackData{}, false, sdkerrors.Wrapf(ErrInvalidCallbackData, "%s address cannot be empty", callbackKey)
+	}
+
+	gasLimit := maxCallbackGas
+	if metadata.GasLimit != "" {
+		userGasLimit, err := strconv.ParseUint(metadata.GasLimit, 10, 64)
+		if err != nil {
+			return CallbackData{}, false, sdkerrors.Wrapf(ErrInvalidCallbackData, "unable to parse %s gas limit (%s): %s", callbackKey, metadata.GasLimit, err.Error())
+		}
+
+		if userGasLimit == 0 {
+			return CallbackData{}, false, sdkerrors.Wrapf(ErrInvalidCallbackData, "%s gas limit must be positive", callbackKey)
+		}
+
+		if userGasLimit < gasLimit {
+			gasLimit = userGasLimit
+		}
+	}
+
+	return CallbackData{
+		ContractAddress: metadata.Address,
+		GasLimit:        gasLimit,
+	}, true, nil
+}
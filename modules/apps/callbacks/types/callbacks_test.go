@@ -0,0 +1,134 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+const (
+	sender         = "sender"
+	receiver       = "receiver"
+	contract       = "contract"
+	maxCallbackGas = uint64(1_000_000)
+)
+
+func TestGetSourceCallbackData(t *testing.T) {
+	testCases := []struct {
+		name            string
+		packetData      interface{}
+		expCallbackData types.CallbackData
+		expFound        bool
+		expPass         bool
+	}{
+		{
+			"success",
+			newPacketData(`{"src_callback":{"address":"contract"}}`),
+			types.CallbackData{ContractAddress: contract, SenderAddress: sender, GasLimit: maxCallbackGas},
+			true, true,
+		},
+		{
+			"success: gas limit requested by the memo",
+			newPacketData(`{"src_callback":{"address":"contract","gas_limit":"50000"}}`),
+			types.CallbackData{ContractAddress: contract, SenderAddress: sender, GasLimit: 50_000},
+			true, true,
+		},
+		{
+			"success: gas limit capped at the maximum callback gas",
+			newPacketData(`{"src_callback":{"address":"contract","gas_limit":"2000000"}}`),
+			types.CallbackData{ContractAddress: contract, SenderAddress: sender, GasLimit: maxCallbackGas},
+			true, true,
+		},
+		{
+			"success: source callback combined with other memo entries",
+			newPacketData(`{"src_callback":{"address":"contract"},"dest_callback":{"address":"other"},"key":"value"}`),
+			types.CallbackData{ContractAddress: contract, SenderAddress: sender, GasLimit: maxCallbackGas},
+			true, true,
+		},
+		{
+			"empty memo",
+			newPacketData(""),
+			types.CallbackData{},
+			false, true,
+		},
+		{
+			"memo is not a JSON object",
+			newPacketData(`"src_callback"`),
+			types.CallbackData{},
+			false, true,
+		},
+		{
+			"only destination callback",
+			newPacketData(`{"dest_callback":{"address":"contract"}}`),
+			types.CallbackData{},
+			false, true,
+		},
+		{
+			"packet data does not support callbacks",
+			[]byte(`{"src_callback":{"address":"contract"}}`),
+			types.CallbackData{},
+			false, true,
+		},
+		{
+			"callback is not a JSON object",
+			newPacketData(`{"src_callback":"contract"}`),
+			types.CallbackData{},
+			false, false,
+		},
+		{
+			"empty address",
+			newPacketData(`{"src_callback":{"address":" "}}`),
+			types.CallbackData{},
+			false, false,
+		},
+		{
+			"invalid gas limit",
+			newPacketData(`{"src_callback":{"address":"contract","gas_limit":"-1"}}`),
+			types.CallbackData{},
+			false, false,
+		},
+		{
+			"zero gas limit",
+			newPacketData(`{"src_callback":{"address":"contract","gas_limit":"0"}}`),
+			types.CallbackData{},
+			false, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			callbackData, found, err := types.GetSourceCallbackData(tc.packetData, transfertypes.PortID, maxCallbackGas)
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidCallbackData)
+			}
+			require.Equal(t, tc.expFound, found)
+			require.Equal(t, tc.expCallbackData, callbackData)
+		})
+	}
+}
+
+func TestGetDestCallbackData(t *testing.T) {
+	callbackData, found, err := types.GetDestCallbackData(newPacketData(`{"dest_callback":{"address":"contract","gas_limit":"50000"}}`), maxCallbackGas)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// the sender address is only set for source callbacks
+	require.Equal(t, types.CallbackData{ContractAddress: contract, GasLimit: 50_000}, callbackData)
+
+	_, found, err = types.GetDestCallbackData(newPacketData(`{"src_callback":{"address":"contract"}}`), maxCallbackGas)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func newPacketData(memo string) *transfertypes.FungibleTokenPacketData {
+	packetData := transfertypes.NewFungibleTokenPacketData("denom", "100", sender, receiver, memo)
+	return &packetData
+}
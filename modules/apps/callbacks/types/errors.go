@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// callbacks middleware sentinel errors
+var (
+	ErrInvalidCallbackData = sdkerrors.Register(ModuleName, 2, "invalid callback data")
+	ErrCallbackPanic       = sdkerrors.Register(ModuleName, 3, "callback panicked")
+)
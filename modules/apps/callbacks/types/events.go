@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+// CallbackType defines the packet lifecycle event a callback is executed upon
+type CallbackType string
+
+// callbacks middleware callback types
+const (
+	CallbackTypeAcknowledgementPacket CallbackType = "acknowledgement_packet"
+	CallbackTypeTimeoutPacket         CallbackType = "timeout_packet"
+	CallbackTypeReceivePacket         CallbackType = "receive_packet"
+)
+
+// callbacks middleware events
+const (
+	EventTypeSourceCallback      = "ibc_src_callback"
+	EventTypeDestinationCallback = "ibc_dest_callback"
+
+	AttributeKeyCallbackType      = "callback_type"
+	AttributeKeyContractAddress   = "callback_address"
+	AttributeKeyGasLimit          = "callback_exec_gas_limit"
+	AttributeKeyResult            = "callback_result"
+	AttributeKeyError             = "callback_error"
+	AttributeKeyPacketSequence    = "packet_sequence"
+	AttributeKeySourcePort        = "packet_src_port"
+	AttributeKeySourceChannel     = "packet_src_channel"
+	AttributeKeyDestPort          = "packet_dest_port"
+	AttributeKeyDestChannel       = "packet_dest_channel"
+	AttributeValueCallbackSuccess = "success"
+	AttributeValueCallbackFailure = "failure"
+)
+
+// EmitCallbackEvent emits an event for the execution of a callback. The destination callback event
+// is emitted for receive packet callbacks and the source callback event otherwise.
+func EmitCallbackEvent(ctx sdk.Context, packet exported.PacketI, callbackType CallbackType, callbackData CallbackData, err error) {
+	eventType := EventTypeSourceCallback
+	if callbackType == CallbackTypeReceivePacket {
+		eventType = EventTypeDestinationCallback
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyCallbackType, string(callbackType)),
+		sdk.NewAttribute(AttributeKeyContractAddress, callbackData.ContractAddress),
+		sdk.NewAttribute(AttributeKeyGasLimit, fmt.Sprintf("%d", callbackData.GasLimit)),
+		sdk.NewAttribute(AttributeKeyPacketSequence, fmt.Sprintf("%d", packet.GetSequence())),
+		sdk.NewAttribute(AttributeKeySourcePort, packet.GetSourcePort()),
+		sdk.NewAttribute(AttributeKeySourceChannel, packet.GetSourceChannel()),
+		sdk.NewAttribute(AttributeKeyDestPort, packet.GetDestPort()),
+		sdk.NewAttribute(AttributeKeyDestChannel, packet.GetDestChannel()),
+	}
+
+	if err == nil {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyResult, AttributeValueCallbackSuccess))
+	} else {
+		attributes = append(attributes,
+			sdk.NewAttribute(AttributeKeyResult, AttributeValueCallbackFailure),
+			sdk.NewAttribute(AttributeKeyError, err.Error()),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			attributes...,
+		),
+	)
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+// ContractKeeper defines the expected keeper of the contracts receiving packet callbacks, such as
+// a CosmWasm keeper. Errors returned by the callbacks revert the state changes made by the callback
+// but do not affect the packet lifecycle of the underlying application.
+type ContractKeeper interface {
+	// IsCallbackContract returns true if the provided address is a contract which may receive callbacks.
+	IsCallbackContract(ctx sdk.Context, contractAddress string) bool
+	// IBCOnAcknowledgementPacket is executed on the source chain once the packet has been acknowledged
+	// by the underlying application. The packet sender address is provided so that the contract may
+	// verify it is allowed to receive callbacks for packets of the sender.
+	IBCOnAcknowledgementPacket(
+		ctx sdk.Context,
+		packet exported.PacketI,
+		acknowledgement []byte,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+	// IBCOnTimeoutPacket is executed on the source chain once the packet has been timed out by the
+	// underlying application.
+	IBCOnTimeoutPacket(
+		ctx sdk.Context,
+		packet exported.PacketI,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+	// IBCReceivePacket is executed on the destination chain once the packet has been successfully
+	// received by the underlying application and the acknowledgement has been written.
+	IBCReceivePacket(
+		ctx sdk.Context,
+		packet exported.PacketI,
+		ack exported.Acknowledgement,
+		contractAddress string,
+	) error
+}
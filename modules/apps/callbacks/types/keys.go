@@ -0,0 +1,14 @@
+package types
+
+const (
+	// ModuleName defines the callbacks middleware name
+	ModuleName = "ibccallbacks"
+
+	// SourceCallbackKey is the packet memo key of the callback executed on the source chain
+	// upon the acknowledgement or timeout of the packet
+	SourceCallbackKey = "src_callback"
+
+	// DestinationCallbackKey is the packet memo key of the callback executed on the destination
+	// chain upon the receipt of the packet
+	DestinationCallbackKey = "dest_callback"
+)
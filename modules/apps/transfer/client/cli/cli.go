@@ -17,9 +17,18 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryDenomTrace(),
 		GetCmdQueryDenomTraces(),
+		GetCmdQueryDenomTracesByBaseDenom(),
 		GetCmdParams(),
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
+		GetCmdQueryDenomHashToTrace(),
+		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQuerySimulateTransfer(),
+		GetCmdQueryReceiveOnlyChannel(),
+		GetCmdQueryIsSendEnabled(),
+		GetCmdQueryIsReceiveEnabled(),
+		GetCmdQueryEscrowDenomMigration(),
+		GetCmdDecodePacketData(),
 	)
 
 	return queryCmd
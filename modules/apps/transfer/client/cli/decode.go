@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channelcli "github.com/cosmos/ibc-go/v6/modules/core/04-channel/client/cli"
+)
+
+// decodedPacketData defines the output of the decode-packet-data command. Only the version of the
+// packet data which could be decoded from the provided bytes is populated.
+type decodedPacketData struct {
+	FungibleTokenPacketData   json.RawMessage `json:"fungible_token_packet_data,omitempty"`
+	FungibleTokenPacketDataV2 json.RawMessage `json:"fungible_token_packet_data_v2,omitempty"`
+}
+
+// GetCmdDecodePacketData defines the command to decode the ICS20 packet data of a packet from its hex encoded bytes.
+func GetCmdDecodePacketData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-packet-data [hex-bytes]",
+		Short: "Decode ICS20 packet data",
+		Long: `Decode the hex encoded bytes of a protobuf encoded packet or of raw packet data as ICS20 fungible token packet data and print its fields.
+Compressed packet data is decompressed before it is decoded. The bytes are decoded locally and no connection to a node is required.`,
+		Example: fmt.Sprintf("%s query ibc-transfer decode-packet-data [hex-bytes]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := channelcli.ParseHexBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			decoded, err := decodePacketData(channelcli.ParsePacketData(bz))
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(decoded)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// decodePacketData decodes the provided packet data as FungibleTokenPacketData or FungibleTokenPacketDataV2,
+// decompressing it first if it is compressed.
+func decodePacketData(data []byte) (*decodedPacketData, error) {
+	if decompressed, err := types.DecompressPacketData(sdk.NewInfiniteGasMeter(), data); err == nil {
+		data = decompressed
+	}
+
+	var transferData types.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(data, &transferData); err == nil && transferData.ValidateBasic() == nil {
+		return &decodedPacketData{FungibleTokenPacketData: types.ModuleCdc.MustMarshalJSON(&transferData)}, nil
+	}
+
+	var transferDataV2 types.FungibleTokenPacketDataV2
+	if err := types.ModuleCdc.UnmarshalJSON(data, &transferDataV2); err == nil && transferDataV2.ValidateBasic() == nil {
+		return &decodedPacketData{FungibleTokenPacketDataV2: types.ModuleCdc.MustMarshalJSON(&transferDataV2)}, nil
+	}
+
+	return nil, fmt.Errorf("bytes are not valid ICS20 packet data")
+}
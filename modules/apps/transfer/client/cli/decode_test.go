@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+const (
+	sender   = "cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz"
+	receiver = "cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw"
+)
+
+func TestDecodePacketData(t *testing.T) {
+	var bz []byte
+
+	transferData := types.NewFungibleTokenPacketData("transfer/channel-0/uatom", "100", sender, receiver, "memo")
+	transferDataV2 := types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: "uatom", Amount: "100"}}, sender, receiver, "")
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expPass     bool
+		assertionFn func(t *testing.T, decoded *decodedPacketData)
+	}{
+		{
+			"success: transfer packet data",
+			func() {
+				bz = transferData.GetBytes()
+			},
+			true,
+			func(t *testing.T, decoded *decodedPacketData) {
+				require.Equal(t, types.ModuleCdc.MustMarshalJSON(&transferData), []byte(decoded.FungibleTokenPacketData))
+				require.Nil(t, decoded.FungibleTokenPacketDataV2)
+			},
+		},
+		{
+			"success: compressed transfer packet data",
+			func() {
+				bz = types.CompressPacketData(transferData.GetBytes())
+			},
+			true,
+			func(t *testing.T, decoded *decodedPacketData) {
+				require.Equal(t, types.ModuleCdc.MustMarshalJSON(&transferData), []byte(decoded.FungibleTokenPacketData))
+			},
+		},
+		{
+			"success: multi token transfer packet data",
+			func() {
+				bz = transferDataV2.GetBytes()
+			},
+			true,
+			func(t *testing.T, decoded *decodedPacketData) {
+				require.Nil(t, decoded.FungibleTokenPacketData)
+				require.Equal(t, types.ModuleCdc.MustMarshalJSON(&transferDataV2), []byte(decoded.FungibleTokenPacketDataV2))
+			},
+		},
+		{
+			"unknown packet data",
+			func() {
+				bz = []byte("unknown packet data")
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tc.malleate()
+
+			decoded, err := decodePacketData(bz)
+			if tc.expPass {
+				require.NoError(t, err)
+				tc.assertionFn(t, decoded)
+			} else {
+				require.Error(t, err)
+				require.Nil(t, decoded)
+			}
+		})
+	}
+}
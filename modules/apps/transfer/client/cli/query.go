@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 )
 
+const flagPrefix = "prefix"
+
 // GetCmdQueryDenomTrace defines the command to query a a denomination trace from a given trace hash or ibc denom.
 func GetCmdQueryDenomTrace() *cobra.Command {
 	cmd := &cobra.Command{
@@ -82,6 +84,55 @@ func GetCmdQueryDenomTraces() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryDenomTracesByBaseDenom defines the command to query all the denomination trace infos
+// with a given base denomination.
+func GetCmdQueryDenomTracesByBaseDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-traces-by-base-denom [base-denom]",
+		Short: "Query the trace info for all token denominations with a base denomination",
+		Long: `Query the trace info for all token denominations with a base denomination.
+If the --prefix flag is set, all denominations with a base denomination starting with the provided base denomination are returned.`,
+		Example: fmt.Sprintf("%s query ibc-transfer denom-traces-by-base-denom uatom", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			isPrefix, err := cmd.Flags().GetBool(flagPrefix)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTracesByBaseDenomRequest{
+				BaseDenom:  args[0],
+				Prefix:     isPrefix,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTracesByBaseDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagPrefix, false, "Match all base denominations starting with the provided base denomination")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations trace")
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for ibc-transfer parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -116,7 +167,7 @@ func GetCmdQueryEscrowAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "escrow-address",
 		Short:   "Get the escrow address for a channel",
-		Long:    "Get the escrow address for a channel",
+		Long:    "Get the escrow address for a channel. The address is queried from the chain, since chains may override the default escrow address derivation.",
 		Args:    cobra.ExactArgs(2),
 		Example: fmt.Sprintf("%s query ibc-transfer escrow-address [port] [channel-id]", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -124,10 +175,19 @@ func GetCmdQueryEscrowAddress() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			port := args[0]
-			channel := args[1]
-			addr := types.GetEscrowAddress(port, channel)
-			return clientCtx.PrintString(fmt.Sprintf("%s\n", addr.String()))
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEscrowAddressRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.EscrowAddress(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.EscrowAddress))
 		},
 	}
 
@@ -167,3 +227,235 @@ func GetCmdQueryDenomHash() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryDenomHashToTrace defines the command to query the denomination trace from a given ibc denom hash.
+func GetCmdQueryDenomHashToTrace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-hash-to-trace [hash/denom]",
+		Short:   "Query the denom trace info from a given ibc denom hash, with or without the ibc/ prefix",
+		Long:    "Query the denom trace info from a given ibc denom hash, with or without the ibc/ prefix",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-hash-to-trace ibc/27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomHashToTraceRequest{
+				Hash: args[0],
+			}
+
+			res, err := queryClient.DenomHashToTrace(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTotalEscrowForDenom defines the command to query the total amount of tokens in escrow for a denomination.
+func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "total-escrow [denom]",
+		Short:   "Query the total amount of tokens in escrow for a denom",
+		Long:    "Query the total amount of tokens in escrow for a denom",
+		Example: fmt.Sprintf("%s query ibc-transfer total-escrow uosmo", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTotalEscrowForDenomRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.TotalEscrowForDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySimulateTransfer defines the command to query the denomination received on the destination
+// chain when transferring a denomination over a channel.
+func GetCmdQuerySimulateTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-transfer [src-port] [src-channel] [denom]",
+		Short:   "Query the denom trace and escrow address resulting from transferring a denom over a channel",
+		Long:    "Query the denom trace received on the destination chain and the escrow address holding the tokens when transferring a denom over a channel",
+		Example: fmt.Sprintf("%s query ibc-transfer simulate-transfer transfer channel-0 uatom", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySimulateTransferRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Denom:     args[2],
+			}
+
+			res, err := queryClient.SimulateTransfer(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryReceiveOnlyChannel defines the command to query whether outbound transfers are disabled for a channel.
+func GetCmdQueryReceiveOnlyChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "receive-only-channel [port-id] [channel-id]",
+		Short:   "Query whether a channel is receive only",
+		Long:    "Query whether outbound transfers over a channel are disabled. Transfers are still received over a receive only channel.",
+		Example: fmt.Sprintf("%s query ibc-transfer receive-only-channel transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryReceiveOnlyChannelRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ReceiveOnlyChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryIsSendEnabled defines the command to query whether transfers may be sent, optionally for a denomination.
+func GetCmdQueryIsSendEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "send-enabled [denom]",
+		Short:   "Query whether transfers may be sent",
+		Long:    "Query whether transfers may be sent from this chain. If a denomination is provided, its send enabled override is taken into account.",
+		Example: fmt.Sprintf("%s query ibc-transfer send-enabled uatom", version.AppName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryIsSendEnabledRequest{}
+			if len(args) == 1 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.IsSendEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryIsReceiveEnabled defines the command to query whether transfers may be received, optionally for a denomination.
+func GetCmdQueryIsReceiveEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "receive-enabled [denom]",
+		Short:   "Query whether transfers may be received",
+		Long:    "Query whether transfers may be received by this chain. If the denomination of the received tokens on this chain is provided, its receive enabled override is taken into account.",
+		Example: fmt.Sprintf("%s query ibc-transfer receive-enabled transfer/channel-0/uatom", version.AppName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryIsReceiveEnabledRequest{}
+			if len(args) == 1 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.IsReceiveEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEscrowDenomMigration defines the command to perform a dry run of the migration of the escrowed
+// tokens of a renamed base denomination.
+func GetCmdQueryEscrowDenomMigration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-denom-migration [old-denom] [new-denom]",
+		Short:   "Query the escrowed tokens migrated when renaming a base denomination",
+		Long:    "Perform a dry run of the migration of the tokens of the old base denomination held in escrow to the new base denomination and query the affected escrow accounts and amounts.",
+		Example: fmt.Sprintf("%s query ibc-transfer escrow-denom-migration uatom unewatom", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEscrowDenomMigrationRequest{
+				OldDenom: args[0],
+				NewDenom: args[1],
+			}
+
+			res, err := queryClient.EscrowDenomMigration(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
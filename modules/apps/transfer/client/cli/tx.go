@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -22,6 +23,7 @@ const (
 	flagPacketTimeoutHeight    = "packet-timeout-height"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagTimeoutDuration        = "timeout-relative-duration"
 	flagMemo                   = "memo"
 )
 
@@ -35,7 +37,10 @@ as absolute or relative using the "absolute-timeouts" flag. Timeout height can b
 in the form {revision}-{height} using the "packet-timeout-height" flag. Relative timeout height is added to the block
 height queried from the latest consensus state corresponding to the counterparty channel. Relative timeout timestamp 
 is added to the greater value of the local clock time and the block timestamp queried from the latest consensus state 
-corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
+corresponding to the counterparty channel. Alternatively, the timeout timestamp may be set by passing a duration
+using the "timeout-relative-duration" flag, which is added to the latest block time of the local chain. Any timeout set
+to 0 is disabled. Multiple tokens may be transferred
+in a single packet over channels using the multi token transfer version by passing a comma separated list of coins.`),
 		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [amount]", version.AppName),
 		Args:    cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -48,14 +53,20 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 			srcChannel := args[1]
 			receiver := args[2]
 
-			coin, err := sdk.ParseCoinNormalized(args[3])
+			coins, err := sdk.ParseCoinsNormalized(args[3])
 			if err != nil {
 				return err
 			}
 
-			if !strings.HasPrefix(coin.Denom, "ibc/") {
-				denomTrace := types.ParseDenomTrace(coin.Denom)
-				coin.Denom = denomTrace.IBCDenom()
+			if len(coins) == 0 {
+				return fmt.Errorf("amount cannot be empty")
+			}
+
+			for i, coin := range coins {
+				if !strings.HasPrefix(coin.Denom, "ibc/") {
+					denomTrace := types.ParseDenomTrace(coin.Denom)
+					coins[i].Denom = denomTrace.IBCDenom()
+				}
 			}
 
 			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
@@ -77,6 +88,19 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				return err
 			}
 
+			timeoutDuration, err := cmd.Flags().GetDuration(flagTimeoutDuration)
+			if err != nil {
+				return err
+			}
+
+			// the timeout timestamp is computed from the local block time if a timeout duration is provided
+			if timeoutDuration != 0 {
+				if cmd.Flags().Changed(flagPacketTimeoutTimestamp) {
+					return fmt.Errorf("flags %s and %s cannot be used together", flagPacketTimeoutTimestamp, flagTimeoutDuration)
+				}
+				timeoutTimestamp = 0
+			}
+
 			memo, err := cmd.Flags().GetString(flagMemo)
 			if err != nil {
 				return err
@@ -116,9 +140,26 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				}
 			}
 
+			if timeoutDuration != 0 {
+				blockTime, err := queryLatestBlockTime(clientCtx)
+				if err != nil {
+					return err
+				}
+
+				timeoutTimestamp, err = types.TimeoutTimestampFromDuration(blockTime, timeoutDuration)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+				srcPort, srcChannel, coins[0], sender, receiver, timeoutHeight, timeoutTimestamp, memo,
 			)
+			if len(coins) > 1 {
+				msg = types.NewMsgMultiTokenTransfer(
+					srcPort, srcChannel, coins, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+				)
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -126,8 +167,24 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().Duration(flagTimeoutDuration, 0, "Packet timeout duration added to the latest block time of the local chain, e.g. 10m. Cannot be combined with the packet-timeout-timestamp flag.")
 	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// queryLatestBlockTime returns the time of the latest block of the chain the client is connected to.
+func queryLatestBlockTime(clientCtx client.Context) (time.Time, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	status, err := node.Status(context.Background())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return status.SyncInfo.LatestBlockTime, nil
+}
@@ -17,6 +17,11 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
+var (
+	_ porttypes.IBCModule             = IBCModule{}
+	_ porttypes.PacketDataUnmarshaler = IBCModule{}
+)
+
 // IBCModule implements the ICS26 interface for transfer given the transfer keeper.
 type IBCModule struct {
 	keeper keeper.Keeper
@@ -31,7 +36,7 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 
 // ValidateTransferChannelParams does validation of a newly created transfer channel. A transfer
 // channel must be UNORDERED, use the correct port (by default 'transfer'), and use the current
-// supported version. Only 2^32 channels are allowed to be created.
+// supported versions. Only 2^32 channels are allowed to be created.
 func ValidateTransferChannelParams(
 	ctx sdk.Context,
 	keeper keeper.Keeper,
@@ -61,6 +66,13 @@ func ValidateTransferChannelParams(
 	return nil
 }
 
+// isSupportedVersion returns true if the provided version is either the single token or the
+// multi token transfer version, optionally negotiating the compression of the packet data.
+func isSupportedVersion(version string) bool {
+	baseVersion, _ := types.ParseVersion(version)
+	return baseVersion == types.Version || baseVersion == types.V2
+}
+
 // OnChanOpenInit implements the IBCModule interface
 func (im IBCModule) OnChanOpenInit(
 	ctx sdk.Context,
@@ -80,8 +92,8 @@ func (im IBCModule) OnChanOpenInit(
 		version = types.Version
 	}
 
-	if version != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	if !isSupportedVersion(version) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s or %s, optionally suffixed with %s", version, types.Version, types.V2, types.CompressionVersionSuffix)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -107,8 +119,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	if !isSupportedVersion(counterpartyVersion) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s or %s, optionally suffixed with %s", counterpartyVersion, types.Version, types.V2, types.CompressionVersionSuffix)
 	}
 
 	// OpenTry must claim the channelCapability that IBC passes into the callback
@@ -116,7 +128,7 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	return types.Version, nil
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -127,8 +139,8 @@ func (im IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	if !isSupportedVersion(counterpartyVersion) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s or %s, optionally suffixed with %s", counterpartyVersion, types.Version, types.V2, types.CompressionVersionSuffix)
 	}
 	return nil
 }
@@ -169,21 +181,45 @@ func (im IBCModule) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
+	packetData, err := im.keeper.DecompressPacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+	if err == nil {
+		// reject packets carrying oversized memos before the packet data is fully decoded
+		err = im.keeper.ValidatePacketMemoLength(ctx, packetData)
+	}
+
+	if err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePacket,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, "false"),
+				sdk.NewAttribute(types.AttributeKeyAckError, err.Error()),
+			),
+		)
+
+		return channeltypes.NewErrorAcknowledgementWithCode(err)
+	}
+
+	if im.isV2Channel(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		return im.onRecvPacketV2(ctx, packet, packetData, relayer)
+	}
+
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
 	var data types.FungibleTokenPacketData
 	var ackErr error
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	if err := types.ModuleCdc.UnmarshalJSON(packetData, &data); err != nil {
 		ackErr = sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
-		ack = channeltypes.NewErrorAcknowledgement(ackErr)
+		ack = channeltypes.NewErrorAcknowledgementWithCode(ackErr)
 	}
 
 	// only attempt the application logic if the packet data
 	// was successfully decoded
 	if ack.Success() {
-		err := im.keeper.OnRecvPacket(ctx, packet, data)
+		err := im.keeper.OnRecvPacket(ctx, packet, data, relayer)
 		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err)
+			ack = channeltypes.NewErrorAcknowledgementWithCode(err)
 			ackErr = err
 		}
 	}
@@ -195,6 +231,7 @@ func (im IBCModule) OnRecvPacket(
 		sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
 		sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
 		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
 		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
 	}
 
@@ -209,6 +246,12 @@ func (im IBCModule) OnRecvPacket(
 		),
 	)
 
+	// NOTE: the acknowledgement of a packet whose tokens are forwarded to another chain is written
+	// asynchronously once the forwarded packet is acknowledged or timed out.
+	if forward, _ := types.ParseForwardMetadata(data.Memo); forward != nil && ack.Success() {
+		return nil
+	}
+
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
 	return ack
 }
@@ -224,8 +267,18 @@ func (im IBCModule) OnAcknowledgementPacket(
 	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 	}
+
+	packetData, err := im.keeper.DecompressPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	if im.isV2Channel(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		return im.onAcknowledgementPacketV2(ctx, packet, packetData, ack)
+	}
+
 	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	if err := types.ModuleCdc.UnmarshalJSON(packetData, &data); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 
@@ -272,8 +325,17 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
+	packetData, err := im.keeper.DecompressPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	if im.isV2Channel(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		return im.onTimeoutPacketV2(ctx, packet, packetData)
+	}
+
 	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	if err := types.ModuleCdc.UnmarshalJSON(packetData, &data); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 	// refund tokens
@@ -294,3 +356,30 @@ func (im IBCModule) OnTimeoutPacket(
 
 	return nil
 }
+
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes into the packet data
+// type of the version negotiated for the provided channel. A *FungibleTokenPacketDataV2 is returned
+// for multi token transfer channels and a *FungibleTokenPacketData otherwise. Compressed packet data
+// of channels negotiating compression is decompressed before it is unmarshaled.
+func (im IBCModule) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (interface{}, error) {
+	bz, err := im.keeper.DecompressPacketData(ctx, portID, channelID, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	if im.isV2Channel(ctx, portID, channelID) {
+		var packetData types.FungibleTokenPacketDataV2
+		if err := types.ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal ICS-20 multi token transfer packet data: %s", err.Error())
+		}
+
+		return &packetData, nil
+	}
+
+	var packetData types.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	return &packetData, nil
+}
@@ -2,7 +2,10 @@ package transfer_test
 
 import (
 	"math"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer"
@@ -33,6 +36,16 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				channel.Version = ""
 			}, true,
 		},
+		{
+			"success: multi token version", func() {
+				channel.Version = types.V2
+			}, true,
+		},
+		{
+			"success: compressed multi token version", func() {
+				channel.Version = types.CompressedVersion(types.V2)
+			}, true,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -91,8 +104,13 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 			)
 
 			if tc.expPass {
+				expVersion := channel.GetVersion()
+				if expVersion == "" {
+					expVersion = types.Version
+				}
+
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal(version, "")
@@ -118,6 +136,16 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success: multi token counterparty version", func() {
+				counterpartyVersion = types.V2
+			}, true,
+		},
+		{
+			"success: compressed counterparty version", func() {
+				counterpartyVersion = types.CompressedVersion(types.Version)
+			}, true,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -183,7 +211,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(counterpartyVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", version)
@@ -203,6 +231,16 @@ func (suite *TransferTestSuite) TestOnChanOpenAck() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success: multi token counterparty version", func() {
+				counterpartyVersion = types.V2
+			}, true,
+		},
+		{
+			"success: compressed counterparty version", func() {
+				counterpartyVersion = types.CompressedVersion(types.Version)
+			}, true,
+		},
 		{
 			"invalid counterparty version", func() {
 				counterpartyVersion = "version"
@@ -239,3 +277,238 @@ func (suite *TransferTestSuite) TestOnChanOpenAck() {
 		})
 	}
 }
+
+func (suite *TransferTestSuite) TestOnRecvPacketMemoLength() {
+	var (
+		path     *ibctesting.Path
+		memo     string
+		compress bool
+	)
+
+	// setCompressedVersion negotiates the compression of the packet data for the receiving channel
+	setCompressedVersion := func() {
+		channel := path.EndpointB.GetChannel()
+		channel.Version = types.CompressedVersion(types.Version)
+		path.EndpointB.SetChannel(channel)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: memo within the maximum memo length", func() {}, true,
+		},
+		{
+			"memo exceeds the maximum memo length", func() {
+				memo = strings.Repeat("a", types.DefaultMaxMemoLength+1)
+			}, false,
+		},
+		{
+			"multi-byte characters are counted by their UTF-8 byte length", func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, 5, nil))
+				memo = "€€"
+			}, false,
+		},
+		{
+			"success: maximum memo length of zero disables the limit", func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, 0, nil))
+				memo = strings.Repeat("a", types.DefaultMaxMemoLength+1)
+			}, true,
+		},
+		{
+			"success: compressed packet data", func() {
+				setCompressedVersion()
+				compress = true
+			}, true,
+		},
+		{
+			"success: uncompressed packet data on a compressed channel", func() {
+				setCompressedVersion()
+			}, true,
+		},
+		{
+			"memo of compressed packet data exceeds the maximum memo length", func() {
+				setCompressedVersion()
+				memo = strings.Repeat("a", types.DefaultMaxMemoLength+1)
+				compress = true
+			}, false,
+		},
+		{
+			"compressed packet data on a channel not negotiating compression", func() {
+				compress = true
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			memo = "memo"
+			compress = false
+
+			tc.malleate()
+
+			packetData := types.NewFungibleTokenPacketData(
+				sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), memo,
+			)
+			packetBytes := packetData.GetBytes()
+			if compress {
+				packetBytes = types.CompressPacketData(packetBytes)
+			}
+
+			packet := channeltypes.NewPacket(packetBytes, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), ibctesting.TransferPort)
+			suite.Require().NoError(err)
+
+			cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+			suite.Require().True(ok)
+
+			ack := cbs.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress())
+			suite.Require().Equal(tc.expPass, ack.Success())
+		})
+	}
+}
+
+// TestOnRecvPacketErrorAcknowledgement tests that the error acknowledgements written by the transfer
+// application include the codespace and ABCI code of the error.
+func (suite *TransferTestSuite) TestOnRecvPacketErrorAcknowledgement() {
+	var (
+		path     *ibctesting.Path
+		memo     string
+		expError *sdkerrors.Error
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"receive disabled", func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false, types.DefaultMaxMemoLength, nil))
+				expError = types.ErrReceiveDisabled
+			},
+		},
+		{
+			"memo exceeds the maximum memo length", func() {
+				memo = strings.Repeat("a", types.DefaultMaxMemoLength+1)
+				expError = types.ErrInvalidMemo
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			memo = ""
+
+			tc.malleate()
+
+			packetData := types.NewFungibleTokenPacketData(
+				sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), memo,
+			)
+			packet := channeltypes.NewPacket(packetData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), ibctesting.TransferPort)
+			suite.Require().NoError(err)
+
+			cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+			suite.Require().True(ok)
+
+			ack, ok := cbs.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress()).(channeltypes.Acknowledgement)
+			suite.Require().True(ok)
+			suite.Require().Equal(channeltypes.NewErrorAcknowledgementWithCode(expError), ack)
+
+			codespace, code, ok := ack.ErrorCode()
+			suite.Require().True(ok)
+			suite.Require().Equal(expError.Codespace(), codespace)
+			suite.Require().Equal(expError.ABCICode(), code)
+		})
+	}
+}
+
+// TestOnRecvPacketRelayerAttribute tests that the fungible token packet event emitted when receiving
+// a packet includes the address of the relayer, for both successful and error acknowledgements.
+func (suite *TransferTestSuite) TestOnRecvPacketRelayerAttribute() {
+	var (
+		path *ibctesting.Path
+		memo string
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expSuccess bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"receive disabled", func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false, types.DefaultMaxMemoLength, nil))
+			}, false,
+		},
+		{
+			"memo exceeds the maximum memo length", func() {
+				memo = strings.Repeat("a", types.DefaultMaxMemoLength+1)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			memo = ""
+
+			tc.malleate()
+
+			packetData := types.NewFungibleTokenPacketData(
+				sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), memo,
+			)
+			packet := channeltypes.NewPacket(packetData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), ibctesting.TransferPort)
+			suite.Require().NoError(err)
+
+			cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+			suite.Require().True(ok)
+
+			relayer := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+			ctx := suite.chainB.GetContext()
+			ack := cbs.OnRecvPacket(ctx, packet, relayer)
+			suite.Require().Equal(tc.expSuccess, ack.Success())
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypePacket {
+					continue
+				}
+
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == types.AttributeKeyRelayer {
+						suite.Require().Equal(relayer.String(), string(attr.Value))
+						found = true
+					}
+				}
+			}
+			suite.Require().True(found)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package transfer
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+// isV2Channel returns true if the multi token transfer version was negotiated for the provided channel.
+func (im IBCModule) isV2Channel(ctx sdk.Context, portID, channelID string) bool {
+	version, found := im.keeper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return false
+	}
+
+	baseVersion, _ := types.ParseVersion(version)
+	return baseVersion == types.V2
+}
+
+// onRecvPacketV2 handles the receipt of a packet sent over a multi token transfer channel.
+// A successful acknowledgement is returned if the packet data is successfully decoded and
+// all tokens of the packet are received.
+func (im IBCModule) onRecvPacketV2(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	packetData []byte,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	var data types.FungibleTokenPacketDataV2
+	var ackErr error
+	if err := types.ModuleCdc.UnmarshalJSON(packetData, &data); err != nil {
+		ackErr = sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal ICS-20 multi token transfer packet data")
+		ack = channeltypes.NewErrorAcknowledgementWithCode(ackErr)
+	}
+
+	// only attempt the application logic if the packet data
+	// was successfully decoded
+	if ack.Success() {
+		if err := im.keeper.OnRecvPacketV2(ctx, packet, data); err != nil {
+			ack = channeltypes.NewErrorAcknowledgementWithCode(err)
+			ackErr = err
+		}
+	}
+
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+		sdk.NewAttribute(types.AttributeKeyTokens, data.TokensString()),
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+	}
+
+	if ackErr != nil {
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAckError, ackErr.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacket,
+			eventAttributes...,
+		),
+	)
+
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	return ack
+}
+
+// onAcknowledgementPacketV2 handles the acknowledgement of a packet sent over a multi token
+// transfer channel.
+func (im IBCModule) onAcknowledgementPacketV2(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	packetData []byte,
+	ack channeltypes.Acknowledgement,
+) error {
+	var data types.FungibleTokenPacketDataV2
+	if err := types.ModuleCdc.UnmarshalJSON(packetData, &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 multi token transfer packet data: %s", err.Error())
+	}
+
+	if err := im.keeper.OnAcknowledgementPacketV2(ctx, packet, data, ack); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+			sdk.NewAttribute(types.AttributeKeyTokens, data.TokensString()),
+			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+		),
+	)
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePacket,
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, string(resp.Result)),
+			),
+		)
+	case *channeltypes.Acknowledgement_Error:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePacket,
+				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
+			),
+		)
+	}
+
+	return nil
+}
+
+// onTimeoutPacketV2 handles the timeout of a packet sent over a multi token transfer channel.
+func (im IBCModule) onTimeoutPacketV2(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	packetData []byte,
+) error {
+	var data types.FungibleTokenPacketDataV2
+	if err := types.ModuleCdc.UnmarshalJSON(packetData, &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 multi token transfer packet data: %s", err.Error())
+	}
+
+	// refund tokens
+	if err := im.keeper.OnTimeoutPacketV2(ctx, packet, data); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyRefundTokens, data.TokensString()),
+			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		),
+	)
+
+	return nil
+}
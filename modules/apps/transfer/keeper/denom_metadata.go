@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+// registerDenomMetadata registers the bank metadata returned by the denomination metadata provider
+// for the voucher with the provided denomination trace. It is called when the voucher is received for
+// the first time. Errors of the provider and invalid metadata are logged rather than returned, such
+// that the metadata registration cannot fail the receipt of the voucher.
+func (k Keeper) registerDenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace, memo string) {
+	if k.denomMetadataProvider == nil {
+		return
+	}
+
+	voucherDenom := denomTrace.IBCDenom()
+	if k.bankKeeper.HasDenomMetaData(ctx, voucherDenom) {
+		return
+	}
+
+	// state changes of a failing provider are discarded
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	metadata, found, err := k.denomMetadata(cacheCtx, denomTrace, memo)
+	if err != nil {
+		k.Logger(ctx).Error("failed to get denomination metadata", "denom", voucherDenom, "error", err.Error())
+		return
+	}
+
+	if !found {
+		return
+	}
+
+	if metadata.Base != voucherDenom {
+		k.Logger(ctx).Error("invalid denomination metadata", "denom", voucherDenom, "error", fmt.Sprintf("expected base %s, got %s", voucherDenom, metadata.Base))
+		return
+	}
+
+	if err := metadata.Validate(); err != nil {
+		k.Logger(ctx).Error("invalid denomination metadata", "denom", voucherDenom, "error", err.Error())
+		return
+	}
+
+	k.bankKeeper.SetDenomMetaData(cacheCtx, metadata)
+
+	cacheCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, voucherDenom),
+			sdk.NewAttribute(types.AttributeKeyDisplay, metadata.Display),
+			sdk.NewAttribute(types.AttributeKeySymbol, metadata.Symbol),
+		),
+	)
+
+	writeFn()
+}
+
+// denomMetadata returns the metadata of the denomination metadata provider, converting a panic of the
+// provider into an error. Out of gas panics are propagated, since the gas limit of the transaction
+// has been exceeded.
+func (k Keeper) denomMetadata(ctx sdk.Context, denomTrace types.DenomTrace, memo string) (metadata banktypes.Metadata, found bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				panic(r)
+			}
+
+			err = fmt.Errorf("denomination metadata provider panicked: %v", r)
+		}
+	}()
+
+	return k.denomMetadataProvider.DenomMetadata(ctx, denomTrace, memo)
+}
@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"errors"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+var _ types.DenomMetadataProvider = (*mockDenomMetadataProvider)(nil)
+
+// mockDenomMetadataProvider returns the result of its metadata function and counts its invocations.
+type mockDenomMetadataProvider struct {
+	calls      int
+	metadataFn func(ctx sdk.Context, denomTrace types.DenomTrace, memo string) (banktypes.Metadata, bool, error)
+}
+
+func (p *mockDenomMetadataProvider) DenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace, memo string) (banktypes.Metadata, bool, error) {
+	p.calls++
+	return p.metadataFn(ctx, denomTrace, memo)
+}
+
+func voucherMetadata(denomTrace types.DenomTrace) banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: "IBC voucher of " + denomTrace.GetFullDenomPath(),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denomTrace.IBCDenom(), Exponent: 0},
+			{Denom: strings.ToUpper(denomTrace.BaseDenom), Exponent: 6},
+		},
+		Base:    denomTrace.IBCDenom(),
+		Display: strings.ToUpper(denomTrace.BaseDenom),
+		Name:    denomTrace.BaseDenom,
+		Symbol:  strings.ToUpper(denomTrace.BaseDenom),
+	}
+}
+
+// TestDenomMetadataProvider tests that the metadata of the denomination metadata provider is
+// registered the first time a voucher is received and that a failing provider never fails the
+// receipt of the voucher.
+func (suite *KeeperTestSuite) TestDenomMetadataProvider() {
+	var (
+		provider    *mockDenomMetadataProvider
+		memo        string
+		expMetadata bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success",
+			func() {},
+		},
+		{
+			"success: memo is passed to the provider",
+			func() {
+				memo = `{"metadata":{"symbol":"ATOM"}}`
+				provider.metadataFn = func(_ sdk.Context, denomTrace types.DenomTrace, memo string) (banktypes.Metadata, bool, error) {
+					suite.Require().Equal(`{"metadata":{"symbol":"ATOM"}}`, memo)
+					return voucherMetadata(denomTrace), true, nil
+				}
+			},
+		},
+		{
+			"metadata not found",
+			func() {
+				provider.metadataFn = func(sdk.Context, types.DenomTrace, string) (banktypes.Metadata, bool, error) {
+					return banktypes.Metadata{}, false, nil
+				}
+				expMetadata = false
+			},
+		},
+		{
+			"provider returns an error",
+			func() {
+				provider.metadataFn = func(sdk.Context, types.DenomTrace, string) (banktypes.Metadata, bool, error) {
+					return banktypes.Metadata{}, false, errors.New("provider failed")
+				}
+				expMetadata = false
+			},
+		},
+		{
+			"provider panics",
+			func() {
+				provider.metadataFn = func(sdk.Context, types.DenomTrace, string) (banktypes.Metadata, bool, error) {
+					panic("provider panicked")
+				}
+				expMetadata = false
+			},
+		},
+		{
+			"state changes of a failing provider are discarded",
+			func() {
+				provider.metadataFn = func(ctx sdk.Context, denomTrace types.DenomTrace, _ string) (banktypes.Metadata, bool, error) {
+					suite.chainB.GetSimApp().BankKeeper.SetDenomMetaData(ctx, voucherMetadata(denomTrace))
+					return banktypes.Metadata{}, false, errors.New("provider failed")
+				}
+				expMetadata = false
+			},
+		},
+		{
+			"base of the metadata is not the voucher denomination",
+			func() {
+				provider.metadataFn = func(_ sdk.Context, denomTrace types.DenomTrace, _ string) (banktypes.Metadata, bool, error) {
+					metadata := voucherMetadata(denomTrace)
+					metadata.Base = denomTrace.BaseDenom
+					metadata.DenomUnits[0].Denom = denomTrace.BaseDenom
+					return metadata, true, nil
+				}
+				expMetadata = false
+			},
+		},
+		{
+			"invalid metadata",
+			func() {
+				provider.metadataFn = func(_ sdk.Context, denomTrace types.DenomTrace, _ string) (banktypes.Metadata, bool, error) {
+					metadata := voucherMetadata(denomTrace)
+					metadata.Display = "unknown"
+					return metadata, true, nil
+				}
+				expMetadata = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			provider = &mockDenomMetadataProvider{
+				metadataFn: func(_ sdk.Context, denomTrace types.DenomTrace, _ string) (banktypes.Metadata, bool, error) {
+					return voucherMetadata(denomTrace), true, nil
+				},
+			}
+			memo = ""
+			expMetadata = true
+
+			tc.malleate()
+
+			app := suite.chainB.GetSimApp()
+			transferKeeper := keeper.NewKeeper(
+				app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+				app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+				app.AccountKeeper, app.BankKeeper, app.ScopedTransferKeeper,
+				app.TransferKeeper.GetAuthority(),
+				keeper.WithDenomMetadataProvider(provider),
+			)
+
+			denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+			receiver := suite.chainB.SenderAccount.GetAddress()
+
+			// the voucher is received twice, the provider is only invoked for the first receipt
+			for seq := uint64(1); seq <= 2; seq++ {
+				data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), receiver.String(), memo)
+				packet := channeltypes.NewPacket(data.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+				err := transferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data, suite.chainB.SenderAccounts[1].SenderAccount.GetAddress())
+				suite.Require().NoError(err)
+			}
+
+			suite.Require().Equal(1, provider.calls)
+			suite.Require().Equal(sdk.NewInt(200), app.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, denomTrace.IBCDenom()).Amount)
+
+			metadata, found := app.BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denomTrace.IBCDenom())
+			suite.Require().Equal(expMetadata, found)
+			if expMetadata {
+				suite.Require().Equal(voucherMetadata(denomTrace), metadata)
+			}
+		})
+	}
+}
+
+// TestDenomMetadataProviderExistingMetadata tests that the denomination metadata provider is not
+// invoked if metadata is already registered for the voucher denomination.
+func (suite *KeeperTestSuite) TestDenomMetadataProviderExistingMetadata() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	provider := &mockDenomMetadataProvider{
+		metadataFn: func(_ sdk.Context, denomTrace types.DenomTrace, _ string) (banktypes.Metadata, bool, error) {
+			return voucherMetadata(denomTrace), true, nil
+		},
+	}
+
+	app := suite.chainB.GetSimApp()
+	transferKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.BankKeeper, app.ScopedTransferKeeper,
+		app.TransferKeeper.GetAuthority(),
+		keeper.WithDenomMetadataProvider(provider),
+	)
+
+	denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+	existingMetadata := voucherMetadata(denomTrace)
+	existingMetadata.Description = "existing metadata"
+	app.BankKeeper.SetDenomMetaData(suite.chainB.GetContext(), existingMetadata)
+
+	data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+	err := transferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data, suite.chainB.SenderAccounts[1].SenderAccount.GetAddress())
+	suite.Require().NoError(err)
+
+	suite.Require().Zero(provider.calls)
+
+	metadata, found := app.BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denomTrace.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(existingMetadata, metadata)
+}
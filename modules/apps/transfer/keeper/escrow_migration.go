@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+// MigrateEscrowDenom migrates the tokens of the old base denomination held by the escrow accounts of
+// every transfer channel to the new base denomination, e.g. after the native token of the chain was
+// renamed by a bank migration. The tokens of the old denomination are burned and the same amount of
+// the new denomination is minted to each escrow account. The total amount in escrow of the old
+// denomination is added to the total amount in escrow of the new denomination.
+//
+// Tokens of the old denomination which return to this chain or are refunded after the migration are
+// unescrowed in the new denomination. The stored transfers of timed out packets are rewritten to the
+// new denomination so that they may still be retried. Tokens of the old denomination can no longer be
+// sent. The vouchers on the counterparty chains are not modified.
+//
+// The affected escrow accounts and their balances of the old denomination are returned. If dryRun is
+// true, they are returned without modifying state.
+func (k Keeper) MigrateEscrowDenom(ctx sdk.Context, oldDenom, newDenom string, dryRun bool) ([]types.EscrowDenomMigration, error) {
+	if err := types.ValidateEscrowDenomMigration(oldDenom, newDenom); err != nil {
+		return nil, err
+	}
+
+	if migratedDenom, found := k.GetMigratedDenom(ctx, oldDenom); found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDenomMigration, "escrowed tokens of denomination %s were already migrated to %s", oldDenom, migratedDenom)
+	}
+
+	// the new denomination may only have been migrated before if that migration is reverted
+	if migratedDenom, found := k.GetMigratedDenom(ctx, newDenom); found && migratedDenom != oldDenom {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDenomMigration, "escrowed tokens of denomination %s were already migrated to %s", newDenom, migratedDenom)
+	}
+
+	var migrations []types.EscrowDenomMigration
+	for _, escrowAddress := range k.GetAllEscrowAddresses(ctx) {
+		balance := k.bankKeeper.GetBalance(ctx, escrowAddress, oldDenom)
+		if !balance.IsPositive() {
+			continue
+		}
+
+		migrations = append(migrations, types.EscrowDenomMigration{
+			EscrowAddress: escrowAddress.String(),
+			Amount:        balance,
+		})
+	}
+
+	if dryRun {
+		return migrations, nil
+	}
+
+	for _, migration := range migrations {
+		escrowAddress, err := sdk.AccAddressFromBech32(migration.EscrowAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		oldTokens := sdk.NewCoins(migration.Amount)
+		newTokens := sdk.NewCoins(sdk.NewCoin(newDenom, migration.Amount.Amount))
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, escrowAddress, types.ModuleName, oldTokens); err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, oldTokens); err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, newTokens); err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, escrowAddress, newTokens); err != nil {
+			panic(fmt.Sprintf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
+		}
+	}
+
+	// track the total amount in escrow keyed by denomination to allow for efficient iteration
+	totalEscrow := k.GetTotalEscrowForDenom(ctx, oldDenom)
+	k.escrowToken(ctx, sdk.NewCoin(newDenom, totalEscrow.Amount))
+	k.SetTotalEscrowForDenom(ctx, sdk.NewCoin(oldDenom, sdk.ZeroInt()))
+
+	// denominations previously migrated to the old denomination are now held in the new denomination,
+	// a denomination migrated back to its former name is no longer migrated
+	for _, migratedDenom := range k.GetAllMigratedDenoms(ctx) {
+		if migratedDenom.NewDenom != oldDenom {
+			continue
+		}
+
+		if migratedDenom.OldDenom == newDenom {
+			k.DeleteMigratedDenom(ctx, migratedDenom.OldDenom)
+			continue
+		}
+
+		migratedDenom.NewDenom = newDenom
+		k.SetMigratedDenom(ctx, migratedDenom)
+	}
+
+	k.SetMigratedDenom(ctx, types.MigratedDenom{OldDenom: oldDenom, NewDenom: newDenom})
+
+	k.migrateTimedOutTransfers(ctx, oldDenom, newDenom)
+
+	return migrations, nil
+}
+
+// migrateTimedOutTransfers rewrites the tokens of the old denomination of the stored transfers of
+// timed out packets to the new denomination. The transfers are collected before they are rewritten
+// as the store may not be written to while it is iterated over.
+func (k Keeper) migrateTimedOutTransfers(ctx sdk.Context, oldDenom, newDenom string) {
+	var transfers []types.IdentifiedTimedOutTransfer
+	k.IterateTimedOutTransfers(ctx, func(transfer types.IdentifiedTimedOutTransfer) bool {
+		if transfer.Transfer.Tokens.AmountOf(oldDenom).IsPositive() {
+			transfers = append(transfers, transfer)
+		}
+
+		return false
+	})
+
+	for _, transfer := range transfers {
+		var tokens sdk.Coins
+		for _, token := range transfer.Transfer.Tokens {
+			if token.Denom == oldDenom {
+				token.Denom = newDenom
+			}
+
+			tokens = tokens.Add(token)
+		}
+
+		transfer.Transfer.Tokens = tokens
+		k.SetTimedOutTransfer(ctx, transfer.PortId, transfer.ChannelId, transfer.Sequence, transfer.Transfer)
+	}
+}
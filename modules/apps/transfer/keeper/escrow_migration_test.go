@@ -0,0 +1,357 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+const migratedBondDenom = "unewstake"
+
+func (suite *KeeperTestSuite) TestMigrateEscrowDenom() {
+	var (
+		oldDenom, newDenom string
+		dryRun             bool
+		expMigrated        bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: dry run",
+			func() {
+				dryRun = true
+				expMigrated = false
+			},
+			true,
+		},
+		{
+			"success: no escrowed tokens of the old denomination",
+			func() {
+				oldDenom = "uatom"
+			},
+			true,
+		},
+		{
+			"success: migration of a migrated denomination is reverted",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetMigratedDenom(suite.chainA.GetContext(), types.MigratedDenom{OldDenom: migratedBondDenom, NewDenom: sdk.DefaultBondDenom})
+			},
+			true,
+		},
+		{
+			"invalid old denomination",
+			func() {
+				oldDenom = "transfer/channel-0/stake"
+			},
+			false,
+		},
+		{
+			"identical denominations",
+			func() {
+				newDenom = oldDenom
+			},
+			false,
+		},
+		{
+			"old denomination already migrated",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetMigratedDenom(suite.chainA.GetContext(), types.MigratedDenom{OldDenom: sdk.DefaultBondDenom, NewDenom: "uatom"})
+			},
+			false,
+		},
+		{
+			"new denomination already migrated",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetMigratedDenom(suite.chainA.GetContext(), types.MigratedDenom{OldDenom: migratedBondDenom, NewDenom: "uatom"})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			oldDenom, newDenom = sdk.DefaultBondDenom, migratedBondDenom
+			dryRun = false
+			expMigrated = true
+
+			pathAtoB := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(pathAtoB)
+
+			pathAtoC := NewTransferPath(suite.chainA, suite.chainC)
+			suite.coordinator.Setup(pathAtoC)
+
+			escrowAddressB := types.GetEscrowAddress(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID)
+			escrowAddressC := types.GetEscrowAddress(pathAtoC.EndpointA.ChannelConfig.PortID, pathAtoC.EndpointA.ChannelID)
+
+			coinToB := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			coinToC := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))
+			for _, transfer := range []struct {
+				path *ibctesting.Path
+				coin sdk.Coin
+			}{{pathAtoB, coinToB}, {pathAtoC, coinToC}} {
+				msg := types.NewMsgTransfer(
+					transfer.path.EndpointA.ChannelConfig.PortID, transfer.path.EndpointA.ChannelID, transfer.coin,
+					suite.chainA.SenderAccount.GetAddress().String(), transfer.path.EndpointB.Chain.SenderAccount.GetAddress().String(),
+					suite.chainB.GetTimeoutHeight(), 0, "",
+				)
+				_, err := suite.chainA.SendMsgs(msg)
+				suite.Require().NoError(err)
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+			migrations, err := transferKeeper.MigrateEscrowDenom(ctx, oldDenom, newDenom, dryRun)
+
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, types.ErrInvalidDenomMigration)
+				suite.Require().Nil(migrations)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			if oldDenom != sdk.DefaultBondDenom {
+				suite.Require().Empty(migrations)
+				suite.Require().True(transferKeeper.GetTotalEscrowForDenom(ctx, newDenom).IsZero())
+				return
+			}
+
+			suite.Require().ElementsMatch([]types.EscrowDenomMigration{
+				{EscrowAddress: escrowAddressB.String(), Amount: coinToB},
+				{EscrowAddress: escrowAddressC.String(), Amount: coinToC},
+			}, migrations)
+
+			if !expMigrated {
+				suite.Require().Equal(coinToB, bankKeeper.GetBalance(ctx, escrowAddressB, sdk.DefaultBondDenom))
+				suite.Require().Equal(coinToC, bankKeeper.GetBalance(ctx, escrowAddressC, sdk.DefaultBondDenom))
+				suite.Require().Equal(coinToB.Add(coinToC), transferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom))
+				suite.Require().True(transferKeeper.GetTotalEscrowForDenom(ctx, newDenom).IsZero())
+
+				_, found := transferKeeper.GetMigratedDenom(ctx, sdk.DefaultBondDenom)
+				suite.Require().False(found)
+				return
+			}
+
+			suite.Require().True(bankKeeper.GetBalance(ctx, escrowAddressB, sdk.DefaultBondDenom).IsZero())
+			suite.Require().True(bankKeeper.GetBalance(ctx, escrowAddressC, sdk.DefaultBondDenom).IsZero())
+			suite.Require().Equal(coinToB.Amount, bankKeeper.GetBalance(ctx, escrowAddressB, newDenom).Amount)
+			suite.Require().Equal(coinToC.Amount, bankKeeper.GetBalance(ctx, escrowAddressC, newDenom).Amount)
+
+			suite.Require().True(transferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom).IsZero())
+			suite.Require().Equal(sdk.NewCoin(newDenom, coinToB.Amount.Add(coinToC.Amount)), transferKeeper.GetTotalEscrowForDenom(ctx, newDenom))
+
+			migratedDenom, found := transferKeeper.GetMigratedDenom(ctx, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(newDenom, migratedDenom)
+
+			// a reverted migration is removed
+			_, found = transferKeeper.GetMigratedDenom(ctx, newDenom)
+			suite.Require().False(found)
+		})
+	}
+}
+
+// TestMigrateEscrowDenomChained tests that the base denominations migrated to a denomination which is
+// migrated again are unescrowed in the latest denomination.
+func (suite *KeeperTestSuite) TestMigrateEscrowDenomChained() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+	_, err := transferKeeper.MigrateEscrowDenom(ctx, sdk.DefaultBondDenom, "ustakea", false)
+	suite.Require().NoError(err)
+
+	_, err = transferKeeper.MigrateEscrowDenom(ctx, "ustakea", "ustakeb", false)
+	suite.Require().NoError(err)
+
+	suite.Require().ElementsMatch([]types.MigratedDenom{
+		{OldDenom: sdk.DefaultBondDenom, NewDenom: "ustakeb"},
+		{OldDenom: "ustakea", NewDenom: "ustakeb"},
+	}, transferKeeper.GetAllMigratedDenoms(ctx))
+
+	// revert the migration to the original denomination
+	_, err = transferKeeper.MigrateEscrowDenom(ctx, "ustakeb", sdk.DefaultBondDenom, false)
+	suite.Require().NoError(err)
+
+	suite.Require().ElementsMatch([]types.MigratedDenom{
+		{OldDenom: "ustakea", NewDenom: sdk.DefaultBondDenom},
+		{OldDenom: "ustakeb", NewDenom: sdk.DefaultBondDenom},
+	}, transferKeeper.GetAllMigratedDenoms(ctx))
+}
+
+// TestMigrateEscrowDenomRoundTrip tests that the vouchers of a migrated denomination sent back to
+// chainA are unescrowed in the new denomination.
+func (suite *KeeperTestSuite) TestMigrateEscrowDenomRoundTrip() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	_, err = suite.chainA.GetSimApp().TransferKeeper.MigrateEscrowDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom, migratedBondDenom, false)
+	suite.Require().NoError(err)
+
+	// the vouchers on chainB are not modified by the migration
+	voucher := types.GetTransferCoin(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom, coin.Amount)
+	suite.Require().Equal(voucher, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucher.Denom))
+
+	receiver := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	msg = types.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucher,
+		suite.chainB.SenderAccount.GetAddress().String(), receiver.String(),
+		suite.chainA.GetTimeoutHeight(), 0, "",
+	)
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	suite.Require().Equal(sdk.NewCoin(migratedBondDenom, coin.Amount), suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, migratedBondDenom))
+	suite.Require().True(suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), migratedBondDenom).IsZero())
+}
+
+// TestMigrateEscrowDenomRefund tests that the tokens of a packet sent before the migration are refunded
+// in the new denomination.
+func (suite *KeeperTestSuite) TestMigrateEscrowDenomRefund() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+		sender.String(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.GetSelfHeight(suite.chainB.GetContext()), 0, "",
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	_, err = suite.chainA.GetSimApp().TransferKeeper.MigrateEscrowDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom, migratedBondDenom, false)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().Equal(sdk.NewCoin(migratedBondDenom, coin.Amount), suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, migratedBondDenom))
+	suite.Require().True(suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), migratedBondDenom).IsZero())
+}
+
+// TestMigrateEscrowDenomSendOldDenom tests that tokens of the old denomination can no longer be sent
+// after the migration, while tokens of the new denomination can.
+func (suite *KeeperTestSuite) TestMigrateEscrowDenomSendOldDenom() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	_, err := suite.chainA.GetSimApp().TransferKeeper.MigrateEscrowDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom, migratedBondDenom, false)
+	suite.Require().NoError(err)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	newTokens := sdk.NewCoins(sdk.NewCoin(migratedBondDenom, sdk.NewInt(100)))
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), types.ModuleName, newTokens))
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, sender, newTokens))
+
+	for _, tc := range []struct {
+		denom  string
+		expErr error
+	}{
+		{sdk.DefaultBondDenom, types.ErrInvalidDenomForTransfer},
+		{migratedBondDenom, nil},
+	} {
+		msg := types.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(tc.denom, sdk.NewInt(100)),
+			sender.String(), suite.chainB.SenderAccount.GetAddress().String(),
+			suite.chainB.GetTimeoutHeight(), 0, "",
+		)
+		_, err = suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+		suite.Require().ErrorIs(err, tc.expErr, tc.denom)
+	}
+
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom).IsZero())
+	suite.Require().Equal(newTokens[0], suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, migratedBondDenom))
+}
+
+// TestMigrateEscrowDenomTimedOutTransfers tests that the transfers of packets timed out before the
+// migration are rewritten to the new denomination and may still be retried.
+func (suite *KeeperTestSuite) TestMigrateEscrowDenomTimedOutTransfers() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+		sender.String(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.GetSelfHeight(suite.chainB.GetContext()), 0, "",
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	timedOutTransfer, found := transferKeeper.GetTimedOutTransfer(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(coin), timedOutTransfer.Tokens)
+
+	// the stored transfers are not modified by a dry run
+	_, err = transferKeeper.MigrateEscrowDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom, migratedBondDenom, true)
+	suite.Require().NoError(err)
+
+	transfer, found := transferKeeper.GetTimedOutTransfer(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(timedOutTransfer, transfer)
+
+	_, err = transferKeeper.MigrateEscrowDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom, migratedBondDenom, false)
+	suite.Require().NoError(err)
+
+	timedOutTransfer.Tokens = sdk.NewCoins(sdk.NewCoin(migratedBondDenom, coin.Amount))
+	transfer, found = transferKeeper.GetTimedOutTransfer(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(timedOutTransfer, transfer)
+
+	// the rewritten transfer replaces the original one
+	suite.Require().Equal([]types.IdentifiedTimedOutTransfer{{
+		PortId:    packet.GetSourcePort(),
+		ChannelId: packet.GetSourceChannel(),
+		Sequence:  packet.GetSequence(),
+		Transfer:  timedOutTransfer,
+	}}, transferKeeper.GetAllTimedOutTransfers(suite.chainA.GetContext()))
+}
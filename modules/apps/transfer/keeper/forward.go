@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// forwardPacket sends the tokens received by the forward address in the provided inbound packet
+// to the next hop specified by the forward metadata. The inbound packet is stored so that its
+// acknowledgement may be written asynchronously once the forwarded packet is acknowledged or
+// timed out.
+func (k Keeper) forwardPacket(ctx sdk.Context, packet channeltypes.Packet, forwardAddress sdk.AccAddress, token sdk.Coin, forward *types.ForwardMetadata) error {
+	if !k.bankKeeper.IsSendEnabledCoin(ctx, token) {
+		return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", token.Denom)
+	}
+
+	// the acknowledgement of the inbound packet is only resolved for single token packets
+	appVersion, _ := k.GetAppVersion(ctx, forward.Port, forward.Channel)
+	if baseVersion, _ := types.ParseVersion(appVersion); baseVersion == types.V2 {
+		return sdkerrors.Wrapf(types.ErrInvalidForwardMetadata, "packets cannot be forwarded over %s channels", types.V2)
+	}
+
+	memo, err := forward.NextMemo()
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidForwardMetadata, "cannot marshal forward metadata of the next hop: %s", err.Error())
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + forward.GetTimeout()
+
+	sequence, err := k.sendTransfer(
+		ctx, forward.Port, forward.Channel, sdk.Coins{token}, forwardAddress, forward.Receiver,
+		clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+	)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to forward packet")
+	}
+
+	k.SetForwardedPacket(ctx, forward.Port, forward.Channel, sequence, packet)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacketForward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyReceiver, forward.Receiver),
+			sdk.NewAttribute(types.AttributeKeyDenom, token.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, token.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, forward.Port),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, forward.Channel),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+
+	return nil
+}
+
+// resolveForwardedPacket writes the acknowledgement of the inbound packet forwarded by the provided
+// outbound packet. If the outbound packet failed, the tokens refunded to the forward address are
+// returned to the escrow account or burned, reverting the receipt of the inbound packet, so that
+// the error acknowledgement refunds the original sender on the counterparty chain. It is a no-op
+// if the outbound packet was not sent by packet forwarding.
+func (k Keeper) resolveForwardedPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	inboundPacket, found := k.GetForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	k.DeleteForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if !ack.Success() {
+		if err := k.revertForwardedTokens(ctx, inboundPacket, data); err != nil {
+			return err
+		}
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(inboundPacket.GetDestPort(), inboundPacket.GetDestChannel()))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, channelCap, inboundPacket, ack)
+}
+
+// revertForwardedTokens moves the tokens refunded to the forward address back to the state prior to
+// the receipt of the inbound packet. Tokens that were unescrowed upon receipt are escrowed again, while
+// vouchers minted upon receipt are burned.
+func (k Keeper) revertForwardedTokens(ctx sdk.Context, inboundPacket channeltypes.Packet, data types.FungibleTokenPacketData) error {
+	inboundPacketData, err := k.DecompressPacketData(ctx, inboundPacket.GetDestPort(), inboundPacket.GetDestChannel(), inboundPacket.GetData())
+	if err != nil {
+		return err
+	}
+
+	var inboundData types.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(inboundPacketData, &inboundData); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	// parse the transfer amount
+	transferAmount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
+	}
+	token := sdk.NewCoin(types.ParseDenomTrace(data.Denom).IBCDenom(), transferAmount)
+
+	forwardAddress := types.GetForwardAddress(inboundPacket.GetDestPort(), inboundPacket.GetDestChannel())
+
+	if types.ReceiverChainIsSource(inboundPacket.GetSourcePort(), inboundPacket.GetSourceChannel(), inboundData.Denom) {
+		// the tokens were unescrowed upon receipt, escrow them again
+		escrowAddress := k.GetEscrowAddress(inboundPacket.GetDestPort(), inboundPacket.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(ctx, forwardAddress, escrowAddress, sdk.NewCoins(token)); err != nil {
+			return err
+		}
+
+		// track the total amount in escrow keyed by denomination to allow for efficient iteration
+		k.escrowToken(ctx, token)
+
+		return nil
+	}
+
+	// the vouchers were minted upon receipt, burn them
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, forwardAddress, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		// NOTE: should not happen as the module account was
+		// retrieved on the step above and it has enough balace
+		// to burn.
+		panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
+	}
+
+	return nil
+}
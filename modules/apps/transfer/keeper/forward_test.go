@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+// TestPacketForwarding tests sending tokens from chainA to chainC by forwarding them
+// through chainB. The acknowledgement of the packet received on chainB is written
+// once the forwarded packet sent from chainB to chainC is acknowledged or timed out.
+func (suite *KeeperTestSuite) TestPacketForwarding() {
+	var (
+		pathAtoB *ibctesting.Path
+		pathBtoC *ibctesting.Path
+		forward  types.ForwardMetadata
+	)
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expForward bool // the packet is forwarded by chainB
+		expTimeout bool // the forwarded packet times out
+		expPass    bool // the tokens arrive on chainC
+	}{
+		{
+			"success",
+			func() {},
+			true, false, true,
+		},
+		{
+			"forwarded packet receives error acknowledgement",
+			func() {
+				forward.Receiver = "invalid address"
+			},
+			true, false, false,
+		},
+		{
+			"forwarded packet times out",
+			func() {
+				forward.Timeout = uint64(time.Nanosecond)
+			},
+			true, true, false,
+		},
+		{
+			"forward channel does not exist",
+			func() {
+				forward.Channel = ibctesting.InvalidID
+			},
+			false, false, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			pathAtoB = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(pathAtoB)
+			pathBtoC = NewTransferPath(suite.chainB, suite.chainC)
+			suite.coordinator.Setup(pathBtoC)
+
+			forward = types.ForwardMetadata{
+				Receiver: suite.chainC.SenderAccount.GetAddress().String(),
+				Port:     pathBtoC.EndpointA.ChannelConfig.PortID,
+				Channel:  pathBtoC.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+
+			memo, err := types.ForwardMetadata{Next: &forward}.NextMemo()
+			suite.Require().NoError(err)
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			sender := suite.chainA.SenderAccount.GetAddress()
+			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			msg := types.NewMsgTransfer(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, coin, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, memo)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err) // message committed
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			// receive the packet on chainB
+			suite.Require().NoError(pathAtoB.EndpointB.UpdateClient())
+			res, err = pathAtoB.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			var ack []byte
+			if tc.expForward {
+				// the acknowledgement of the inbound packet is written asynchronously
+				_, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+				suite.Require().Error(err)
+
+				forwardedPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+
+				_, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), forwardedPacket.GetSourcePort(), forwardedPacket.GetSourceChannel(), forwardedPacket.GetSequence())
+				suite.Require().True(found)
+
+				if tc.expTimeout {
+					// advance chainC past the timeout of the forwarded packet and time it out on chainB
+					suite.coordinator.CommitBlock(suite.chainC)
+					suite.Require().NoError(pathBtoC.EndpointA.UpdateClient())
+
+					// the timeout is sent directly rather than with the endpoint helper, since the channel
+					// identifiers of chainB and chainC differ
+					proof, proofHeight := pathBtoC.EndpointB.QueryProof(host.PacketReceiptKey(forwardedPacket.GetDestPort(), forwardedPacket.GetDestChannel(), forwardedPacket.GetSequence()))
+					nextSeqRecv, found := suite.chainC.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainC.GetContext(), forwardedPacket.GetDestPort(), forwardedPacket.GetDestChannel())
+					suite.Require().True(found)
+
+					timeoutMsg := channeltypes.NewMsgTimeout(forwardedPacket, nextSeqRecv, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+					_, err = suite.chainB.SendMsgs(timeoutMsg)
+					suite.Require().NoError(err)
+
+					ack = channeltypes.NewErrorAcknowledgementWithCode(sdkerrors.Wrap(channeltypes.ErrPacketTimeout, "forwarded packet timed out")).Acknowledgement()
+				} else {
+					// relay the forwarded packet to chainC and its acknowledgement back to chainB
+					suite.Require().NoError(pathBtoC.EndpointB.UpdateClient())
+					res, err = pathBtoC.EndpointB.RecvPacketWithResult(forwardedPacket)
+					suite.Require().NoError(err)
+
+					ack, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+					suite.Require().NoError(err)
+
+					suite.Require().NoError(pathBtoC.EndpointA.AcknowledgePacket(forwardedPacket, ack))
+				}
+
+				_, found = suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), forwardedPacket.GetSourcePort(), forwardedPacket.GetSourceChannel(), forwardedPacket.GetSequence())
+				suite.Require().False(found)
+			} else {
+				ack, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+			}
+
+			// relay the acknowledgement of the inbound packet back to chainA
+			suite.Require().NoError(pathAtoB.EndpointA.UpdateClient())
+			suite.Require().NoError(pathAtoB.EndpointA.AcknowledgePacket(packet, ack))
+
+			// the forward address never retains any tokens
+			forwardAddress := types.GetForwardAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), forwardAddress).IsZero())
+
+			postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			voucherDenomOnB := types.ParseDenomTrace(types.GetPrefixedDenom(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, sdk.DefaultBondDenom))
+			voucherDenomOnC := types.ParseDenomTrace(types.GetPrefixedDenom(pathBtoC.EndpointB.ChannelConfig.PortID, pathBtoC.EndpointB.ChannelID, voucherDenomOnB.GetFullDenomPath()))
+			voucherOnC := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), voucherDenomOnC.IBCDenom())
+
+			if tc.expPass {
+				suite.Require().Equal(preCoin.Sub(coin), postCoin)
+				suite.Require().Equal(coin.Amount, voucherOnC.Amount)
+
+				// the vouchers minted on chainB are held in escrow for chainC
+				totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), voucherDenomOnB.IBCDenom())
+				suite.Require().Equal(coin.Amount, totalEscrow.Amount)
+			} else {
+				// the original sender is refunded
+				suite.Require().Equal(preCoin, postCoin)
+				suite.Require().True(voucherOnC.IsZero())
+
+				// the vouchers minted on chainB have been burned
+				supply := suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenomOnB.IBCDenom())
+				suite.Require().True(supply.IsZero())
+			}
+		})
+	}
+}
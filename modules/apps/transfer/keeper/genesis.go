@@ -28,13 +28,42 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	}
 
 	k.SetParams(ctx, state.Params)
+
+	// Every denom will have only one total escrow amount, since any
+	// duplicate entry will fail validation in Validate of GenesisState
+	for _, denomEscrow := range state.TotalEscrowed {
+		k.SetTotalEscrowForDenom(ctx, denomEscrow)
+	}
+
+	for _, channel := range state.ReceiveOnlyChannels {
+		k.SetChannelReceiveOnly(ctx, channel.PortId, channel.ChannelId)
+	}
+
+	for _, override := range state.DenomTransferEnabled {
+		k.SetDenomTransferEnabledOverride(ctx, override)
+	}
+
+	for _, migratedDenom := range state.MigratedDenoms {
+		k.SetMigratedDenom(ctx, migratedDenom)
+	}
+
+	for _, transfer := range state.TimedOutTransfers {
+		k.SetTimedOutTransfer(ctx, transfer.PortId, transfer.ChannelId, transfer.Sequence, transfer.Transfer)
+	}
 }
 
-// ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
+// ExportGenesis exports ibc-transfer module's portID, denom trace info, total escrow amounts, receive
+// only channels, denomination transfer enabled overrides, migrated denominations and the transfers of
+// timed out packets into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:      k.GetPort(ctx),
-		DenomTraces: k.GetAllDenomTraces(ctx),
-		Params:      k.GetParams(ctx),
+		PortId:               k.GetPort(ctx),
+		DenomTraces:          k.GetAllDenomTraces(ctx),
+		Params:               k.GetParams(ctx),
+		TotalEscrowed:        k.GetAllTotalEscrowed(ctx),
+		ReceiveOnlyChannels:  k.GetAllReceiveOnlyChannels(ctx),
+		DenomTransferEnabled: k.GetAllDenomTransferEnabledOverrides(ctx),
+		MigratedDenoms:       k.GetAllMigratedDenoms(ctx),
+		TimedOutTransfers:    k.GetAllTimedOutTransfers(ctx),
 	}
 }
@@ -3,13 +3,16 @@ package keeper_test
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 )
 
 func (suite *KeeperTestSuite) TestGenesis() {
 	var (
-		path   string
-		traces types.Traces
+		path          string
+		traces        types.Traces
+		totalEscrowed sdk.Coins
 	)
 
 	for i := 0; i < 5; i++ {
@@ -26,14 +29,67 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		}
 		traces = append(types.Traces{denomTrace}, traces...)
 		suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+
+		escrow := sdk.NewCoin(fmt.Sprintf("denom%d", i), sdk.NewInt(int64(i+1)))
+		totalEscrowed = totalEscrowed.Add(escrow)
+		suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), escrow)
+	}
+
+	receiveOnlyChannels := []types.ReceiveOnlyChannel{
+		{PortId: types.PortID, ChannelId: "channel-0"},
+		{PortId: types.PortID, ChannelId: "channel-1"},
+	}
+	for _, channel := range receiveOnlyChannels {
+		suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiveOnly(suite.chainA.GetContext(), channel.PortId, channel.ChannelId)
+	}
+
+	overrides := []types.DenomTransferEnabled{
+		{Denom: "transfer/channel-0/uatom", SendEnabled: types.NewTransferEnabledFlag(true)},
+		{Denom: "uatom", ReceiveEnabled: types.NewTransferEnabledFlag(true)},
+	}
+	for _, override := range overrides {
+		suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), override)
+	}
+
+	migratedDenoms := []types.MigratedDenom{
+		{OldDenom: "denom0", NewDenom: "denom1"},
+		{OldDenom: "uatom", NewDenom: "denom1"},
+	}
+	for _, migratedDenom := range migratedDenoms {
+		suite.chainA.GetSimApp().TransferKeeper.SetMigratedDenom(suite.chainA.GetContext(), migratedDenom)
+	}
+
+	timedOutTransfers := []types.IdentifiedTimedOutTransfer{
+		{PortId: types.PortID, ChannelId: "channel-0", Sequence: 1, Transfer: types.TimedOutTransfer{Sender: "sender", Receiver: "receiver", Tokens: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1))), ExpiryTimestamp: 1}},
+		{PortId: types.PortID, ChannelId: "channel-1", Sequence: 2, Transfer: types.TimedOutTransfer{Sender: "sender", Receiver: "receiver", Tokens: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(2))), ExpiryTimestamp: 2}},
+	}
+	for _, transfer := range timedOutTransfers {
+		suite.chainA.GetSimApp().TransferKeeper.SetTimedOutTransfer(suite.chainA.GetContext(), transfer.PortId, transfer.ChannelId, transfer.Sequence, transfer.Transfer)
 	}
 
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(traces.Sort(), genesis.DenomTraces)
+	suite.Require().Equal(totalEscrowed, genesis.TotalEscrowed)
+	suite.Require().Equal(receiveOnlyChannels, genesis.ReceiveOnlyChannels)
+	suite.Require().Equal(overrides, genesis.DenomTransferEnabled)
+	suite.Require().Equal(migratedDenoms, genesis.MigratedDenoms)
+	suite.Require().Equal(timedOutTransfers, genesis.TimedOutTransfers)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
 	})
+
+	for _, escrow := range totalEscrowed {
+		suite.Require().Equal(escrow, suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), escrow.Denom))
+	}
+
+	for _, channel := range receiveOnlyChannels {
+		suite.Require().True(suite.chainA.GetSimApp().TransferKeeper.IsChannelReceiveOnly(suite.chainA.GetContext(), channel.PortId, channel.ChannelId))
+	}
+
+	suite.Require().Equal(overrides, suite.chainA.GetSimApp().TransferKeeper.GetAllDenomTransferEnabledOverrides(suite.chainA.GetContext()))
+	suite.Require().Equal(migratedDenoms, suite.chainA.GetSimApp().TransferKeeper.GetAllMigratedDenoms(suite.chainA.GetContext()))
+	suite.Require().Equal(timedOutTransfers, suite.chainA.GetSimApp().TransferKeeper.GetAllTimedOutTransfers(suite.chainA.GetContext()))
 }
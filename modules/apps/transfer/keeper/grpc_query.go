@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -72,6 +73,49 @@ func (q Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesReques
 	}, nil
 }
 
+// DenomTracesByBaseDenom implements the Query/DenomTracesByBaseDenom gRPC method. The denomination
+// traces are filtered while iterating over the store, so that only matching traces are paginated
+// and returned.
+func (q Keeper) DenomTracesByBaseDenom(c context.Context, req *types.QueryDenomTracesByBaseDenomRequest) (*types.QueryDenomTracesByBaseDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.BaseDenom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "base denomination cannot be blank")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces := types.Traces{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DenomTraceKey)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		result, err := q.UnmarshalDenomTrace(value)
+		if err != nil {
+			return false, err
+		}
+
+		if result.BaseDenom != req.BaseDenom && !(req.Prefix && strings.HasPrefix(result.BaseDenom, req.BaseDenom)) {
+			return false, nil
+		}
+
+		if accumulate {
+			traces = append(traces, result)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTracesByBaseDenomResponse{
+		DenomTraces: traces.Sort(),
+		Pagination:  pageRes,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -109,15 +153,212 @@ func (q Keeper) DenomHash(c context.Context, req *types.QueryDenomHashRequest) (
 	}, nil
 }
 
+// DenomHashToTrace implements the Query/DenomHashToTrace gRPC method
+func (q Keeper) DenomHashToTrace(c context.Context, req *types.QueryDenomHashToTraceRequest) (*types.QueryDenomHashToTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// accept both the ibc denom (ibc/{hash}) and the bare hash
+	hashStr := strings.TrimPrefix(strings.TrimSpace(req.Hash), fmt.Sprintf("%s/", types.DenomPrefix))
+	if hashStr == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom trace hash cannot be empty")
+	}
+
+	hash, err := types.ParseHexHash(hashStr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash: %s, error: %s", hashStr, err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denomTrace, found := q.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrTraceNotFound, hash.String()).Error(),
+		)
+	}
+
+	return &types.QueryDenomHashToTraceResponse{
+		DenomTrace: &denomTrace,
+	}, nil
+}
+
 // EscrowAddress implements the EscrowAddress gRPC method
 func (q Keeper) EscrowAddress(c context.Context, req *types.QueryEscrowAddressRequest) (*types.QueryEscrowAddressResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	addr := types.GetEscrowAddress(req.PortId, req.ChannelId)
+	addr := q.GetEscrowAddress(req.PortId, req.ChannelId)
 
 	return &types.QueryEscrowAddressResponse{
 		EscrowAddress: addr.String(),
 	}, nil
 }
+
+// TotalEscrowForDenom implements the TotalEscrowForDenom gRPC method.
+func (q Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscrowForDenomRequest) (*types.QueryTotalEscrowForDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateIBCDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	amount := q.GetTotalEscrowForDenom(ctx, req.Denom)
+
+	return &types.QueryTotalEscrowForDenomResponse{
+		Amount: amount,
+	}, nil
+}
+
+// SimulateTransfer implements the Query/SimulateTransfer gRPC method.
+func (q Keeper) SimulateTransfer(c context.Context, req *types.QuerySimulateTransferRequest) (*types.QuerySimulateTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := types.ValidateIBCDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denomTrace, receiverChainIsSource, escrowAddress, err := q.ReceivedDenomTrace(ctx, req.PortId, req.ChannelId, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QuerySimulateTransferResponse{
+		DenomTrace:            &denomTrace,
+		Denom:                 denomTrace.IBCDenom(),
+		ReceiverChainIsSource: receiverChainIsSource,
+		EscrowAddress:         escrowAddress.String(),
+	}, nil
+}
+
+// ReceiveOnlyChannel implements the Query/ReceiveOnlyChannel gRPC method.
+func (q Keeper) ReceiveOnlyChannel(c context.Context, req *types.QueryReceiveOnlyChannelRequest) (*types.QueryReceiveOnlyChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryReceiveOnlyChannelResponse{
+		ReceiveOnly: q.IsChannelReceiveOnly(ctx, req.PortId, req.ChannelId),
+	}, nil
+}
+
+// IsSendEnabled implements the Query/IsSendEnabled gRPC method.
+func (q Keeper) IsSendEnabled(c context.Context, req *types.QueryIsSendEnabledRequest) (*types.QueryIsSendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sendEnabled := q.GetSendEnabled(ctx)
+	if req.Denom != "" {
+		fullDenomPath, err := q.queriedDenomPath(ctx, req.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		sendEnabled = q.IsSendEnabledDenom(ctx, fullDenomPath)
+	}
+
+	return &types.QueryIsSendEnabledResponse{
+		Denom:       req.Denom,
+		SendEnabled: sendEnabled,
+	}, nil
+}
+
+// IsReceiveEnabled implements the Query/IsReceiveEnabled gRPC method.
+func (q Keeper) IsReceiveEnabled(c context.Context, req *types.QueryIsReceiveEnabledRequest) (*types.QueryIsReceiveEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	receiveEnabled := q.GetReceiveEnabled(ctx)
+	if req.Denom != "" {
+		fullDenomPath, err := q.queriedDenomPath(ctx, req.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		receiveEnabled = q.IsReceiveEnabledDenom(ctx, fullDenomPath)
+	}
+
+	return &types.QueryIsReceiveEnabledResponse{
+		Denom:          req.Denom,
+		ReceiveEnabled: receiveEnabled,
+	}, nil
+}
+
+// EscrowDenomMigration implements the Query/EscrowDenomMigration gRPC method.
+func (q Keeper) EscrowDenomMigration(c context.Context, req *types.QueryEscrowDenomMigrationRequest) (*types.QueryEscrowDenomMigrationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	migrations, err := q.MigrateEscrowDenom(ctx, req.OldDenom, req.NewDenom, true)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	total := sdk.NewCoin(req.OldDenom, sdk.ZeroInt())
+	for _, migration := range migrations {
+		total = total.Add(migration.Amount)
+	}
+
+	return &types.QueryEscrowDenomMigrationResponse{
+		Migrations: migrations,
+		Total:      total,
+	}, nil
+}
+
+// queriedDenomPath returns the full denomination path of the provided denomination, resolving ibc
+// denominations (ibc/{hash}) to the full denomination path of their denomination trace.
+func (q Keeper) queriedDenomPath(ctx sdk.Context, denom string) (string, error) {
+	if !strings.HasPrefix(denom, types.DenomPrefix+"/") {
+		if err := types.ValidatePrefixedDenom(denom); err != nil {
+			return "", status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return denom, nil
+	}
+
+	fullDenomPath, err := q.DenomPathFromHash(ctx, denom)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrTraceNotFound) {
+			return "", status.Error(codes.NotFound, err.Error())
+		}
+
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return fullDenomPath, nil
+}
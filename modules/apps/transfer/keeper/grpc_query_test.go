@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"fmt"
+	"strings"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -149,6 +151,116 @@ func (suite *KeeperTestSuite) TestQueryDenomTraces() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDenomTracesByBaseDenom() {
+	var (
+		req       *types.QueryDenomTracesByBaseDenomRequest
+		expTraces types.Traces
+	)
+
+	traces := types.Traces{
+		{Path: "", BaseDenom: "uatom"},
+		{Path: "transfer/channelToB", BaseDenom: "uatom"},
+		{Path: "transfer/channelToA/transfer/channelToB", BaseDenom: "uatom"},
+		{Path: "transfer/channelToB", BaseDenom: "uatomic"},
+		{Path: "transfer/channelToB", BaseDenom: "uosmo"},
+		{Path: "transfer/channelToB", BaseDenom: "gamm/pool/1"},
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: base denom",
+			func() {
+				expTraces = traces[:3]
+				req = &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: "uatom"}
+			},
+			true,
+		},
+		{
+			"success: base denom prefix",
+			func() {
+				expTraces = traces[:4]
+				req = &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: "uatom", Prefix: true}
+			},
+			true,
+		},
+		{
+			"success: base denom with slashes",
+			func() {
+				expTraces = traces[5:]
+				req = &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: "gamm/pool/1"}
+			},
+			true,
+		},
+		{
+			"success: pagination only counts matching traces",
+			func() {
+				expTraces = traces[:3]
+				req = &types.QueryDenomTracesByBaseDenomRequest{
+					BaseDenom: "uatom",
+					Pagination: &query.PageRequest{
+						Limit:      3,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success: no matching traces",
+			func() {
+				expTraces = types.Traces{}
+				req = &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: "stake"}
+			},
+			true,
+		},
+		{
+			"blank base denom",
+			func() {
+				req = &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: " "}
+			},
+			false,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			for _, trace := range traces {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.DenomTracesByBaseDenom(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expTraces, res.DenomTraces)
+
+				if req.Pagination != nil && req.Pagination.CountTotal {
+					suite.Require().Equal(uint64(len(expTraces)), res.Pagination.Total)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 	expParams := types.DefaultParams()
@@ -222,6 +334,109 @@ func (suite *KeeperTestSuite) TestQueryDenomHash() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDenomHashToTrace() {
+	var (
+		req      *types.QueryDenomHashToTraceRequest
+		expTrace types.DenomTrace
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: ibc denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), expTrace)
+
+				req = &types.QueryDenomHashToTraceRequest{
+					Hash: expTrace.IBCDenom(),
+				}
+			},
+			true,
+		},
+		{
+			"success: hex hash without prefix",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), expTrace)
+
+				req = &types.QueryDenomHashToTraceRequest{
+					Hash: expTrace.Hash().String(),
+				}
+			},
+			true,
+		},
+		{
+			"success: lowercase hex hash",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), expTrace)
+
+				req = &types.QueryDenomHashToTraceRequest{
+					Hash: strings.ToLower(expTrace.Hash().String()),
+				}
+			},
+			true,
+		},
+		{
+			"failure: empty hash",
+			func() {
+				req = &types.QueryDenomHashToTraceRequest{
+					Hash: "ibc/",
+				}
+			},
+			false,
+		},
+		{
+			"failure: invalid hash",
+			func() {
+				req = &types.QueryDenomHashToTraceRequest{
+					Hash: "ibc/!@#!@#!",
+				}
+			},
+			false,
+		},
+		{
+			"failure: not found denom trace",
+			func() {
+				req = &types.QueryDenomHashToTraceRequest{
+					Hash: expTrace.IBCDenom(),
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			expTrace = types.DenomTrace{
+				Path:      "transfer/channelToA/transfer/channelToB",
+				BaseDenom: "uatom",
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.DenomHashToTrace(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(&expTrace, res.DenomTrace)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+	// the parsed hash is returned in the not found error
+	suite.SetupTest() // reset
+	hash := expTrace.Hash().String()
+	_, err := suite.queryClient.DenomHashToTrace(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryDenomHashToTraceRequest{Hash: "ibc/" + strings.ToLower(hash)})
+	suite.Require().ErrorContains(err, hash)
+}
+
 func (suite *KeeperTestSuite) TestEscrowAddress() {
 	var req *types.QueryEscrowAddressRequest
 
@@ -261,3 +476,553 @@ func (suite *KeeperTestSuite) TestEscrowAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryReceiveOnlyChannel() {
+	var (
+		req            *types.QueryReceiveOnlyChannelRequest
+		expReceiveOnly bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: receive only channel",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiveOnly(suite.chainA.GetContext(), ibctesting.TransferPort, ibctesting.FirstChannelID)
+				expReceiveOnly = true
+			},
+			true,
+		},
+		{
+			"success: channel is not receive only",
+			func() {},
+			true,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryReceiveOnlyChannelRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+			expReceiveOnly = false
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.ReceiveOnlyChannel(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expReceiveOnly, res.ReceiveOnly)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryIsSendEnabled() {
+	var (
+		req            *types.QueryIsSendEnabledRequest
+		expSendEnabled bool
+	)
+
+	denomTrace := types.DenomTrace{
+		Path:      fmt.Sprintf("%s/%s", ibctesting.TransferPort, ibctesting.FirstChannelID),
+		BaseDenom: "uatom",
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: send enabled param",
+			func() {},
+			true,
+		},
+		{
+			"success: send disabled param",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true, types.DefaultMaxMemoLength, nil))
+				expSendEnabled = false
+			},
+			true,
+		},
+		{
+			"success: denom without override",
+			func() {
+				req.Denom = sdk.DefaultBondDenom
+			},
+			true,
+		},
+		{
+			"success: denom with override",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, SendEnabled: types.NewTransferEnabledFlag(false), ReceiveEnabled: types.NewTransferEnabledFlag(true)})
+
+				req.Denom = sdk.DefaultBondDenom
+				expSendEnabled = false
+			},
+			true,
+		},
+		{
+			"success: send disabled param, but enabled for denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true, types.DefaultMaxMemoLength, nil))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, SendEnabled: types.NewTransferEnabledFlag(true)})
+
+				req.Denom = sdk.DefaultBondDenom
+			},
+			true,
+		},
+		{
+			"success: ibc denom with override of its base denomination",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: denomTrace.BaseDenom, SendEnabled: types.NewTransferEnabledFlag(false), ReceiveEnabled: types.NewTransferEnabledFlag(true)})
+
+				req.Denom = denomTrace.IBCDenom()
+				expSendEnabled = false
+			},
+			true,
+		},
+		{
+			"denom trace not found",
+			func() {
+				req.Denom = denomTrace.IBCDenom()
+			},
+			false,
+		},
+		{
+			"invalid denom",
+			func() {
+				req.Denom = fmt.Sprintf("%s/%s/", ibctesting.TransferPort, ibctesting.FirstChannelID)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryIsSendEnabledRequest{}
+			expSendEnabled = true
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.IsSendEnabled(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(req.Denom, res.Denom)
+				suite.Require().Equal(expSendEnabled, res.SendEnabled)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryIsReceiveEnabled() {
+	var (
+		req               *types.QueryIsReceiveEnabledRequest
+		expReceiveEnabled bool
+	)
+
+	denomTrace := types.DenomTrace{
+		Path:      fmt.Sprintf("%s/%s", ibctesting.TransferPort, ibctesting.FirstChannelID),
+		BaseDenom: "uatom",
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: receive enabled param",
+			func() {},
+			true,
+		},
+		{
+			"success: receive disabled param",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, false, types.DefaultMaxMemoLength, nil))
+				expReceiveEnabled = false
+			},
+			true,
+		},
+		{
+			"success: full denom path with override",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: denomTrace.GetFullDenomPath(), SendEnabled: types.NewTransferEnabledFlag(true), ReceiveEnabled: types.NewTransferEnabledFlag(false)})
+
+				req.Denom = denomTrace.GetFullDenomPath()
+				expReceiveEnabled = false
+			},
+			true,
+		},
+		{
+			"success: override of the full denom path takes precedence over the override of the base denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: denomTrace.BaseDenom, SendEnabled: types.NewTransferEnabledFlag(true), ReceiveEnabled: types.NewTransferEnabledFlag(false)})
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: denomTrace.GetFullDenomPath(), SendEnabled: types.NewTransferEnabledFlag(true), ReceiveEnabled: types.NewTransferEnabledFlag(true)})
+
+				req.Denom = denomTrace.GetFullDenomPath()
+			},
+			true,
+		},
+		{
+			"success: ibc denom with override",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: denomTrace.GetFullDenomPath(), SendEnabled: types.NewTransferEnabledFlag(true), ReceiveEnabled: types.NewTransferEnabledFlag(false)})
+
+				req.Denom = denomTrace.IBCDenom()
+				expReceiveEnabled = false
+			},
+			true,
+		},
+		{
+			"invalid ibc denom hash",
+			func() {
+				req.Denom = types.DenomPrefix + "/invalid"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryIsReceiveEnabledRequest{}
+			expReceiveEnabled = true
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.IsReceiveEnabled(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(req.Denom, res.Denom)
+				suite.Require().Equal(expReceiveEnabled, res.ReceiveEnabled)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
+	var (
+		req             *types.QueryTotalEscrowForDenomRequest
+		expEscrowAmount math.Int
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid native denom with escrow amount < 2^63",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: sdk.DefaultBondDenom,
+				}
+
+				expEscrowAmount = math.NewInt(100)
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, expEscrowAmount))
+			},
+			true,
+		},
+		{
+			"valid ibc denom with escrow amount > 2^63",
+			func() {
+				denomTrace := types.DenomTrace{
+					Path:      "transfer/channel-0",
+					BaseDenom: sdk.DefaultBondDenom,
+				}
+
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+				var ok bool
+				expEscrowAmount, ok = math.NewIntFromString("100000000000000000000")
+				suite.Require().True(ok)
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(denomTrace.IBCDenom(), expEscrowAmount))
+
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: denomTrace.IBCDenom(),
+				}
+			},
+			true,
+		},
+		{
+			"valid denom with no escrow amount",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: "uatom",
+				}
+
+				expEscrowAmount = math.ZeroInt()
+			},
+			true,
+		},
+		{
+			"invalid ibc denom",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: "ibc/123",
+				}
+			},
+			false,
+		},
+		{
+			"invalid denom",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: "??𓃠🐾??",
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			expEscrowAmount = math.ZeroInt()
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.TotalEscrowForDenom(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expEscrowAmount, res.Amount.Amount)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateTransfer() {
+	var (
+		path                     *ibctesting.Path
+		req                      *types.QuerySimulateTransferRequest
+		expTrace                 types.DenomTrace
+		expReceiverChainIsSource bool
+		expEscrowAddress         sdk.AccAddress
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: native denom is prefixed",
+			func() {
+				expTrace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+				expEscrowAddress = types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			},
+			true,
+		},
+		{
+			"success: voucher returning to its origin is unwound",
+			func() {
+				denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+				req.Denom = denomTrace.IBCDenom()
+
+				expTrace = types.ParseDenomTrace(sdk.DefaultBondDenom)
+				expReceiverChainIsSource = true
+				expEscrowAddress = types.GetEscrowAddress(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			true,
+		},
+		{
+			"success: voucher from another chain is prefixed",
+			func() {
+				denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, "channel-5", sdk.DefaultBondDenom))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+				req.Denom = denomTrace.IBCDenom()
+
+				expTrace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, denomTrace.GetFullDenomPath()))
+				expEscrowAddress = types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			},
+			true,
+		},
+		{
+			"failure: invalid denom",
+			func() {
+				req.Denom = "ibc/!@#!@#!"
+			},
+			false,
+		},
+		{
+			"failure: invalid channel identifier",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				req.ChannelId = "channel-100"
+			},
+			false,
+		},
+		{
+			"failure: denom trace not found",
+			func() {
+				req.Denom = types.ParseDenomTrace("transfer/channel-5/uatom").IBCDenom()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expReceiverChainIsSource = false
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			req = &types.QuerySimulateTransferRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+				Denom:     sdk.DefaultBondDenom,
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.SimulateTransfer(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&expTrace, res.DenomTrace)
+				suite.Require().Equal(expTrace.IBCDenom(), res.Denom)
+				suite.Require().Equal(expReceiverChainIsSource, res.ReceiverChainIsSource)
+				suite.Require().Equal(expEscrowAddress.String(), res.EscrowAddress)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryEscrowDenomMigration() {
+	var (
+		req           *types.QueryEscrowDenomMigrationRequest
+		expMigrations []types.EscrowDenomMigration
+	)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: no escrowed tokens of the old denomination",
+			func() {
+				req.OldDenom = "uatom"
+				expMigrations = nil
+			},
+			true,
+		},
+		{
+			"invalid new denomination",
+			func() {
+				req.NewDenom = "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+			_, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			req = &types.QueryEscrowDenomMigrationRequest{
+				OldDenom: sdk.DefaultBondDenom,
+				NewDenom: "unewstake",
+			}
+			expMigrations = []types.EscrowDenomMigration{{
+				EscrowAddress: types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID).String(),
+				Amount:        coin,
+			}}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.EscrowDenomMigration(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(expMigrations, res.Migrations)
+
+				expTotal := sdk.NewCoin(req.OldDenom, sdk.ZeroInt())
+				for _, migration := range expMigrations {
+					expTotal = expTotal.Add(migration.Amount)
+				}
+				suite.Require().Equal(expTotal, res.Total)
+
+				// the escrowed tokens are not migrated
+				_, found := suite.chainA.GetSimApp().TransferKeeper.GetMigratedDenom(suite.chainA.GetContext(), req.OldDenom)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+// RegisterInvariants registers all transfer invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-escrow-per-denom", TotalEscrowPerDenomInvariant(k))
+}
+
+// TotalEscrowPerDenomInvariant checks that the balances of the escrow accounts cover the total
+// amount in escrow tracked for each denomination. The escrow accounts may hold more than the
+// tracked amount, since tokens can be sent to them directly, but holding less indicates an
+// accounting bug.
+func TotalEscrowPerDenomInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		escrowBalances := k.GetAllEscrowBalances(ctx)
+		for _, totalEscrow := range k.GetAllTotalEscrowed(ctx) {
+			escrowBalance := escrowBalances.AmountOf(totalEscrow.Denom)
+			if escrowBalance.LT(totalEscrow.Amount) {
+				broken = true
+				msg += fmt.Sprintf("\tdenom %s: escrow account balances (%s) are less than the total escrow (%s)\n", totalEscrow.Denom, escrowBalance, totalEscrow.Amount)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "total escrow per denom", msg), broken
+	}
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transferkeeper "github.com/cosmos/ibc-go/v6/modules/apps/transfer/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/cosmos/ibc-go/v6/testing/simapp"
+)
+
+func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
+	var path *ibctesting.Path
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no tokens in escrow",
+			func() {},
+			true,
+		},
+		{
+			"success: escrow balance equals total escrow",
+			func() {
+				escrowAddress := suite.chainA.GetSimApp().TransferKeeper.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress, sdk.NewCoins(coin)))
+
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+			},
+			true,
+		},
+		{
+			"success: escrow balance exceeds total escrow",
+			func() {
+				escrowAddress := suite.chainA.GetSimApp().TransferKeeper.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress, sdk.NewCoins(coin.Add(coin))))
+
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+			},
+			true,
+		},
+		{
+			"success: total escrow is covered by the escrow balances of two channels",
+			func() {
+				extraPath := NewTransferPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(extraPath)
+
+				escrowAddress1 := suite.chainA.GetSimApp().TransferKeeper.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				escrowAddress2 := suite.chainA.GetSimApp().TransferKeeper.GetEscrowAddress(extraPath.EndpointA.ChannelConfig.PortID, extraPath.EndpointA.ChannelID)
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress1, sdk.NewCoins(coin)))
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress2, sdk.NewCoins(coin)))
+
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin.Add(coin))
+			},
+			true,
+		},
+		{
+			"failure: total escrow exceeds escrow balance",
+			func() {
+				escrowAddress := suite.chainA.GetSimApp().TransferKeeper.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress, sdk.NewCoins(coin)))
+
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin.Add(coin))
+			},
+			false,
+		},
+		{
+			"failure: total escrow is set without escrow balance",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			invariant := transferkeeper.TotalEscrowPerDenomInvariant(&suite.chainA.GetSimApp().TransferKeeper)
+			msg, broken := invariant(suite.chainA.GetContext())
+
+			if tc.expPass {
+				suite.Require().False(broken, msg)
+			} else {
+				suite.Require().True(broken)
+				suite.Require().Contains(msg, fmt.Sprintf("denom %s", coin.Denom))
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -11,6 +13,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
@@ -28,6 +31,38 @@ type Keeper struct {
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	scopedKeeper  exported.ScopedKeeper
+
+	// the address capable of executing a MsgSetReceiveOnlyChannel message. Typically, this should be the x/gov module account.
+	authority string
+
+	// escrowAddressFn derives the escrow address of a channel, defaults to types.GetEscrowAddress
+	escrowAddressFn types.EscrowAddressFn
+
+	// denomMetadataProvider provides the bank metadata of vouchers received for the first time, optional
+	denomMetadataProvider types.DenomMetadataProvider
+}
+
+// Option configures optional behaviour of the transfer Keeper
+type Option func(*Keeper)
+
+// WithEscrowAddressFn overrides the derivation of the escrow address of each channel. The provided
+// function must be deterministic and must return a distinct address for every port and channel.
+//
+// NOTE: changing the escrow address derivation on a live chain orphans the tokens held by the
+// previously derived escrow accounts, making them impossible to unescrow. It should only be set by
+// new chains at genesis.
+func WithEscrowAddressFn(escrowAddressFn types.EscrowAddressFn) Option {
+	return func(k *Keeper) {
+		k.escrowAddressFn = escrowAddressFn
+	}
+}
+
+// WithDenomMetadataProvider registers the provider of the bank denomination metadata of vouchers. The
+// metadata returned by the provider is registered when a voucher is received for the first time.
+func WithDenomMetadataProvider(provider types.DenomMetadataProvider) Option {
+	return func(k *Keeper) {
+		k.denomMetadataProvider = provider
+	}
 }
 
 // NewKeeper creates a new IBC transfer Keeper instance
@@ -35,6 +70,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, scopedKeeper exported.ScopedKeeper,
+	authority string, opts ...Option,
 ) Keeper {
 	// ensure ibc transfer module account is set
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -46,17 +82,32 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return Keeper{
-		cdc:           cdc,
-		storeKey:      key,
-		paramSpace:    paramSpace,
-		ics4Wrapper:   ics4Wrapper,
-		channelKeeper: channelKeeper,
-		portKeeper:    portKeeper,
-		authKeeper:    authKeeper,
-		bankKeeper:    bankKeeper,
-		scopedKeeper:  scopedKeeper,
+	keeper := Keeper{
+		cdc:             cdc,
+		storeKey:        key,
+		paramSpace:      paramSpace,
+		ics4Wrapper:     ics4Wrapper,
+		channelKeeper:   channelKeeper,
+		portKeeper:      portKeeper,
+		authKeeper:      authKeeper,
+		bankKeeper:      bankKeeper,
+		scopedKeeper:    scopedKeeper,
+		authority:       authority,
+		escrowAddressFn: types.GetEscrowAddress,
+	}
+
+	for _, opt := range opts {
+		opt(&keeper)
 	}
+
+	return keeper
+}
+
+// SetICS4Wrapper sets the ICS4Wrapper used to send packets and write acknowledgements. It allows a
+// middleware wrapping the transfer application, which requires the transfer keeper to be constructed,
+// to be set as the ICS4Wrapper of the keeper.
+func (k *Keeper) SetICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = ics4Wrapper
 }
 
 // Logger returns a module-specific logger.
@@ -64,6 +115,17 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
 }
 
+// GetAuthority returns the address capable of executing a MsgSetReceiveOnlyChannel message
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetEscrowAddress returns the escrow address for the specified channel, derived with the escrow
+// address function configured for the keeper.
+func (k Keeper) GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	return k.escrowAddressFn(portID, channelID)
+}
+
 // IsBound checks if the transfer module is already bound to the desired port
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
@@ -89,6 +151,23 @@ func (k Keeper) SetPort(ctx sdk.Context, portID string) {
 	store.Set(types.PortKey, []byte(portID))
 }
 
+// GetAppVersion returns the transfer application version negotiated for the provided channel.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// DecompressPacketData returns the decompressed packet data of a packet sent or received on the provided
+// channel. Packet data is only decompressed on channels negotiating the compression of the packet data,
+// the packet data of packets on other channels is returned unchanged.
+func (k Keeper) DecompressPacketData(ctx sdk.Context, portID, channelID string, bz []byte) ([]byte, error) {
+	appVersion, _ := k.GetAppVersion(ctx, portID, channelID)
+	if _, compressed := types.ParseVersion(appVersion); !compressed {
+		return bz, nil
+	}
+
+	return types.DecompressPacketData(ctx.GasMeter(), bz)
+}
+
 // GetDenomTrace retreives the full identifiers trace and base denomination from the store.
 func (k Keeper) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (types.DenomTrace, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
@@ -141,6 +220,425 @@ func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.Den
 	}
 }
 
+// GetTotalEscrowForDenom gets the total amount of source chain tokens that
+// are in escrow, keyed by the denomination.
+//
+// NOTE: if there is no value stored in state for the provided denom then a new Coin is
+// returned for the denom with an initial value of zero. This allows callers to simply
+// call `Add()` on the returned Coin.
+func (k Keeper) GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalEscrowForDenomKey)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+
+	return sdk.NewCoin(denom, amount.Int)
+}
+
+// SetTotalEscrowForDenom stores the total amount of source chain tokens that are in escrow.
+// The amount is removed from state if it is zero. The function will panic if the amount
+// is negative.
+func (k Keeper) SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin) {
+	if coin.Amount.IsNegative() {
+		panic(fmt.Sprintf("amount cannot be negative: %s", coin.Amount))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalEscrowForDenomKey)
+	if coin.Amount.IsZero() {
+		store.Delete([]byte(coin.Denom))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: coin.Amount})
+	store.Set([]byte(coin.Denom), bz)
+}
+
+// GetAllTotalEscrowed returns the total amount of tokens in escrow for all denominations.
+func (k Keeper) GetAllTotalEscrowed(ctx sdk.Context) sdk.Coins {
+	escrows := sdk.Coins{}
+	k.IterateTokensInEscrow(ctx, func(denomEscrow sdk.Coin) bool {
+		escrows = escrows.Add(denomEscrow)
+		return false
+	})
+
+	return escrows
+}
+
+// GetAllEscrowAddresses returns the escrow addresses of every channel bound to the transfer port.
+// Closed channels are included since their escrow accounts may still hold tokens.
+func (k Keeper) GetAllEscrowAddresses(ctx sdk.Context) []sdk.AccAddress {
+	var escrowAddresses []sdk.AccAddress
+	portID := k.GetPort(ctx)
+
+	transferChannels := k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID)
+	for _, channel := range transferChannels {
+		if channel.PortId != portID {
+			continue
+		}
+
+		escrowAddresses = append(escrowAddresses, k.GetEscrowAddress(channel.PortId, channel.ChannelId))
+	}
+
+	return escrowAddresses
+}
+
+// GetAllEscrowBalances returns the sum of the bank balances of the escrow accounts of every
+// channel bound to the transfer port.
+func (k Keeper) GetAllEscrowBalances(ctx sdk.Context) sdk.Coins {
+	var escrowBalances sdk.Coins
+	for _, escrowAddress := range k.GetAllEscrowAddresses(ctx) {
+		escrowBalances = escrowBalances.Add(k.bankKeeper.GetAllBalances(ctx, escrowAddress)...)
+	}
+
+	return escrowBalances
+}
+
+// IterateTokensInEscrow iterates over the total escrow amounts in the store
+// and performs a callback function.
+func (k Keeper) IterateTokensInEscrow(ctx sdk.Context, cb func(denomEscrow sdk.Coin) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalEscrowForDenomKey)
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+
+		if cb(sdk.NewCoin(string(iterator.Key()), amount.Int)) {
+			break
+		}
+	}
+}
+
+// escrowToken adds the provided token to the total amount in escrow for its denomination.
+func (k Keeper) escrowToken(ctx sdk.Context, token sdk.Coin) {
+	currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+	k.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Add(token))
+}
+
+// unescrowToken subtracts the provided token from the total amount in escrow for its denomination.
+func (k Keeper) unescrowToken(ctx sdk.Context, token sdk.Coin) {
+	currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+	k.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Sub(token))
+}
+
+// GetMigratedDenom returns the new denomination of the provided base denomination if its escrowed
+// tokens were migrated.
+func (k Keeper) GetMigratedDenom(ctx sdk.Context, oldDenom string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyMigratedDenom(oldDenom))
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	var migratedDenom types.MigratedDenom
+	k.cdc.MustUnmarshal(bz, &migratedDenom)
+
+	return migratedDenom.NewDenom, true
+}
+
+// SetMigratedDenom stores the new denomination of a base denomination whose escrowed tokens were migrated.
+func (k Keeper) SetMigratedDenom(ctx sdk.Context, migratedDenom types.MigratedDenom) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&migratedDenom)
+	store.Set(types.KeyMigratedDenom(migratedDenom.OldDenom), bz)
+}
+
+// DeleteMigratedDenom removes the new denomination stored for the provided base denomination.
+func (k Keeper) DeleteMigratedDenom(ctx sdk.Context, oldDenom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyMigratedDenom(oldDenom))
+}
+
+// GetAllMigratedDenoms returns the base denominations whose escrowed tokens were migrated along with
+// their new denominations.
+func (k Keeper) GetAllMigratedDenoms(ctx sdk.Context) []types.MigratedDenom {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MigratedDenomKey)
+	defer iterator.Close()
+
+	var migratedDenoms []types.MigratedDenom
+	for ; iterator.Valid(); iterator.Next() {
+		var migratedDenom types.MigratedDenom
+		k.cdc.MustUnmarshal(iterator.Value(), &migratedDenom)
+
+		migratedDenoms = append(migratedDenoms, migratedDenom)
+	}
+
+	return migratedDenoms
+}
+
+// escrowedDenom returns the denomination in which the tokens of the provided base denomination are
+// held in escrow, which is its new denomination if the escrowed tokens were migrated.
+func (k Keeper) escrowedDenom(ctx sdk.Context, denom string) string {
+	if newDenom, found := k.GetMigratedDenom(ctx, denom); found {
+		return newDenom
+	}
+
+	return denom
+}
+
+// GetForwardedPacket returns the inbound packet which was forwarded by the outbound packet
+// sent on the provided port and channel with the given sequence.
+func (k Keeper) GetForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForwardedPacket(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return channeltypes.Packet{}, false
+	}
+
+	var packet channeltypes.Packet
+	k.cdc.MustUnmarshal(bz, &packet)
+
+	return packet, true
+}
+
+// SetForwardedPacket stores the inbound packet which is awaiting the acknowledgement of the outbound
+// packet sent on the provided port and channel with the given sequence.
+func (k Keeper) SetForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64, packet channeltypes.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packet)
+	store.Set(types.KeyForwardedPacket(portID, channelID, sequence), bz)
+}
+
+// DeleteForwardedPacket removes the inbound packet stored for the outbound packet sent on the
+// provided port and channel with the given sequence.
+func (k Keeper) DeleteForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForwardedPacket(portID, channelID, sequence))
+}
+
+// GetTimedOutTransfer returns the transfer of the timed out packet sent on the provided port and
+// channel with the given sequence.
+func (k Keeper) GetTimedOutTransfer(ctx sdk.Context, portID, channelID string, sequence uint64) (types.TimedOutTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyTimedOutTransfer(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.TimedOutTransfer{}, false
+	}
+
+	var transfer types.TimedOutTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+
+	return transfer, true
+}
+
+// SetTimedOutTransfer stores the transfer of the timed out packet sent on the provided port and
+// channel with the given sequence, so that it may be retried. The transfer is indexed by its expiry
+// timestamp so that it is pruned once it expired.
+func (k Keeper) SetTimedOutTransfer(ctx sdk.Context, portID, channelID string, sequence uint64, transfer types.TimedOutTransfer) {
+	k.DeleteTimedOutTransfer(ctx, portID, channelID, sequence)
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyTimedOutTransfer(portID, channelID, sequence)
+	bz := k.cdc.MustMarshal(&transfer)
+	store.Set(key, bz)
+	store.Set(types.KeyTimedOutTransferExpiry(transfer.ExpiryTimestamp, portID, channelID, sequence), key)
+}
+
+// DeleteTimedOutTransfer removes the transfer stored for the timed out packet sent on the provided
+// port and channel with the given sequence, along with its expiry index.
+func (k Keeper) DeleteTimedOutTransfer(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	transfer, found := k.GetTimedOutTransfer(ctx, portID, channelID, sequence)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyTimedOutTransfer(portID, channelID, sequence))
+	store.Delete(types.KeyTimedOutTransferExpiry(transfer.ExpiryTimestamp, portID, channelID, sequence))
+}
+
+// IterateTimedOutTransfers iterates over the stored transfers of timed out packets and performs a
+// callback function. The iteration stops if the callback returns true.
+func (k Keeper) IterateTimedOutTransfers(ctx sdk.Context, cb func(transfer types.IdentifiedTimedOutTransfer) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TimedOutTransferKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, sequence, err := types.ParseKeyTimedOutTransfer(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		var transfer types.TimedOutTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+
+		if cb(types.IdentifiedTimedOutTransfer{PortId: portID, ChannelId: channelID, Sequence: sequence, Transfer: transfer}) {
+			break
+		}
+	}
+}
+
+// GetAllTimedOutTransfers returns the stored transfers of all timed out packets.
+func (k Keeper) GetAllTimedOutTransfers(ctx sdk.Context) []types.IdentifiedTimedOutTransfer {
+	transfers := []types.IdentifiedTimedOutTransfer{}
+	k.IterateTimedOutTransfers(ctx, func(transfer types.IdentifiedTimedOutTransfer) bool {
+		transfers = append(transfers, transfer)
+		return false
+	})
+
+	return transfers
+}
+
+// PruneExpiredTimedOutTransfers deletes the transfers of timed out packets whose expiry timestamp
+// is reached at the current block time. Only the expired transfers are iterated, as the expiry
+// index is ordered by expiry timestamp.
+func (k Keeper) PruneExpiredTimedOutTransfers(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	end := append(types.TimedOutTransferExpiryKey, sdk.Uint64ToBigEndian(uint64(ctx.BlockTime().UnixNano())+1)...)
+	iterator := store.Iterator(types.TimedOutTransferExpiryKey, end)
+
+	// collect the keys before deletion, the store must not be written to while iterating
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		portID, channelID, sequence, err := types.ParseKeyTimedOutTransfer(key)
+		if err != nil {
+			panic(err)
+		}
+
+		k.DeleteTimedOutTransfer(ctx, portID, channelID, sequence)
+	}
+}
+
+// SetChannelReceiveOnly sets the flag disabling outbound transfers over the channel identified by
+// the provided port and channel identifiers.
+func (k Keeper) SetChannelReceiveOnly(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyReceiveOnlyChannel(portID, channelID), []byte{1})
+}
+
+// DeleteChannelReceiveOnly deletes the receive only flag for the provided port and channel identifiers.
+func (k Keeper) DeleteChannelReceiveOnly(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyReceiveOnlyChannel(portID, channelID))
+}
+
+// IsChannelReceiveOnly returns true if outbound transfers are disabled for the channel identified by
+// the provided port and channel identifiers.
+func (k Keeper) IsChannelReceiveOnly(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyReceiveOnlyChannel(portID, channelID))
+}
+
+// GetAllReceiveOnlyChannels returns the port and channel identifiers of all receive only channels.
+func (k Keeper) GetAllReceiveOnlyChannels(ctx sdk.Context) []types.ReceiveOnlyChannel {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ReceiveOnlyChannelKey)
+	defer iterator.Close()
+
+	var channels []types.ReceiveOnlyChannel
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, err := types.ParseKeyReceiveOnlyChannel(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		channels = append(channels, types.ReceiveOnlyChannel{
+			PortId:    portID,
+			ChannelId: channelID,
+		})
+	}
+
+	return channels
+}
+
+// GetDenomTransferEnabledOverride returns the send and receive enabled override of the provided denomination.
+func (k Keeper) GetDenomTransferEnabledOverride(ctx sdk.Context, denom string) (types.DenomTransferEnabled, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyDenomTransferEnabled(denom))
+	if bz == nil {
+		return types.DenomTransferEnabled{}, false
+	}
+
+	var override types.DenomTransferEnabled
+	k.cdc.MustUnmarshal(bz, &override)
+
+	return override, true
+}
+
+// SetDenomTransferEnabledOverride sets the send and receive enabled override of a denomination.
+func (k Keeper) SetDenomTransferEnabledOverride(ctx sdk.Context, override types.DenomTransferEnabled) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyDenomTransferEnabled(override.Denom), k.cdc.MustMarshal(&override))
+}
+
+// DeleteDenomTransferEnabledOverride deletes the send and receive enabled override of the provided denomination.
+func (k Keeper) DeleteDenomTransferEnabledOverride(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyDenomTransferEnabled(denom))
+}
+
+// GetAllDenomTransferEnabledOverrides returns the send and receive enabled overrides of all denominations.
+func (k Keeper) GetAllDenomTransferEnabledOverrides(ctx sdk.Context) []types.DenomTransferEnabled {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomTransferEnabledKey)
+	defer iterator.Close()
+
+	var overrides []types.DenomTransferEnabled
+	for ; iterator.Valid(); iterator.Next() {
+		var override types.DenomTransferEnabled
+		k.cdc.MustUnmarshal(iterator.Value(), &override)
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides
+}
+
+// IsSendEnabledDenom returns true if tokens with the provided full denomination path may be sent
+// from this chain. The send enabled flag of the override of the full denomination path takes
+// precedence over the flag of the override of its base denomination, and the SendEnabled param
+// applies if neither is set.
+func (k Keeper) IsSendEnabledDenom(ctx sdk.Context, fullDenomPath string) bool {
+	return k.isTransferEnabledDenom(ctx, fullDenomPath, k.GetSendEnabled(ctx), func(override types.DenomTransferEnabled) *types.TransferEnabledFlag {
+		return override.SendEnabled
+	})
+}
+
+// IsReceiveEnabledDenom returns true if tokens with the provided full denomination path, i.e. the
+// resulting denomination path on this chain, may be received. The receive enabled flag of the
+// override of the full denomination path takes precedence over the flag of the override of its
+// base denomination, and the ReceiveEnabled param applies if neither is set.
+func (k Keeper) IsReceiveEnabledDenom(ctx sdk.Context, fullDenomPath string) bool {
+	return k.isTransferEnabledDenom(ctx, fullDenomPath, k.GetReceiveEnabled(ctx), func(override types.DenomTransferEnabled) *types.TransferEnabledFlag {
+		return override.ReceiveEnabled
+	})
+}
+
+// isTransferEnabledDenom returns the value of the flag selected by getFlag of the override of the full
+// denomination path if it is set, of the override of its base denomination if it is set, and the
+// provided param value otherwise.
+func (k Keeper) isTransferEnabledDenom(ctx sdk.Context, fullDenomPath string, paramEnabled bool, getFlag func(types.DenomTransferEnabled) *types.TransferEnabledFlag) bool {
+	denoms := []string{fullDenomPath}
+	if baseDenom := types.ParseDenomTrace(fullDenomPath).BaseDenom; baseDenom != fullDenomPath {
+		denoms = append(denoms, baseDenom)
+	}
+
+	for _, denom := range denoms {
+		override, found := k.GetDenomTransferEnabledOverride(ctx, denom)
+		if !found {
+			continue
+		}
+
+		if flag := getFlag(override); flag != nil {
+			return flag.Enabled
+		}
+	}
+
+	return paramEnabled
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
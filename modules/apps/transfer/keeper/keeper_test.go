@@ -1,11 +1,16 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/keeper"
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 )
@@ -44,6 +49,116 @@ func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 	return path
 }
 
+// TestWithEscrowAddressFn tests that the escrow address derivation may be overridden and that
+// tokens are escrowed to the overridden escrow address.
+func (suite *KeeperTestSuite) TestWithEscrowAddressFn() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	escrowAddressFn := func(portID, channelID string) sdk.AccAddress {
+		return authtypes.NewModuleAddress(fmt.Sprintf("%s/escrow/%s/%s", types.ModuleName, portID, channelID))
+	}
+
+	app := suite.chainA.GetSimApp()
+	transferKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.BankKeeper, app.ScopedTransferKeeper,
+		app.TransferKeeper.GetAuthority(),
+		keeper.WithEscrowAddressFn(escrowAddressFn),
+	)
+
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	escrowAddress := escrowAddressFn(portID, channelID)
+	suite.Require().Equal(escrowAddress, transferKeeper.GetEscrowAddress(portID, channelID))
+	suite.Require().Equal(types.GetEscrowAddress(portID, channelID), app.TransferKeeper.GetEscrowAddress(portID, channelID))
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := types.NewMsgTransfer(
+		portID, channelID, coin,
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+
+	_, err := transferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(coin, app.BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom))
+	suite.Require().True(app.BankKeeper.GetBalance(suite.chainA.GetContext(), types.GetEscrowAddress(portID, channelID), sdk.DefaultBondDenom).IsZero())
+
+	_, _, simulatedEscrowAddress, err := transferKeeper.ReceivedDenomTrace(suite.chainA.GetContext(), portID, channelID, sdk.DefaultBondDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(escrowAddress, simulatedEscrowAddress)
+
+	// the escrow address function only applies to this chain, the tokens of a voucher returning to its
+	// origin are unescrowed from the escrow address of the counterparty derived with the default derivation
+	denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(portID, channelID, sdk.DefaultBondDenom))
+	transferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+
+	_, receiverChainIsSource, simulatedEscrowAddress, err := transferKeeper.ReceivedDenomTrace(suite.chainA.GetContext(), portID, channelID, denomTrace.IBCDenom())
+	suite.Require().NoError(err)
+	suite.Require().True(receiverChainIsSource)
+	suite.Require().Equal(types.GetEscrowAddress(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID), simulatedEscrowAddress)
+}
+
+// TestGetAllEscrowAddresses tests that the escrow addresses of all transfer channels are returned,
+// including closed channels, and that channels bound to other ports are skipped.
+func (suite *KeeperTestSuite) TestGetAllEscrowAddresses() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	closedPath := NewTransferPath(suite.chainA, suite.chainC)
+	suite.coordinator.Setup(closedPath)
+	suite.Require().NoError(closedPath.EndpointA.SetChannelClosed())
+
+	// channels bound to other ports do not have escrow accounts
+	mockPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(mockPath)
+
+	escrowAddresses := suite.chainA.GetSimApp().TransferKeeper.GetAllEscrowAddresses(suite.chainA.GetContext())
+	suite.Require().ElementsMatch([]sdk.AccAddress{
+		types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID),
+		types.GetEscrowAddress(closedPath.EndpointA.ChannelConfig.PortID, closedPath.EndpointA.ChannelID),
+	}, escrowAddresses)
+}
+
+// TestPruneExpiredTimedOutTransfers tests that only the transfers of timed out packets whose expiry
+// timestamp is reached are pruned, along with their expiry index.
+func (suite *KeeperTestSuite) TestPruneExpiredTimedOutTransfers() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	now := uint64(ctx.BlockTime().UnixNano())
+
+	transfer := types.TimedOutTransfer{
+		Sender:   suite.chainA.SenderAccount.GetAddress().String(),
+		Receiver: suite.chainB.SenderAccount.GetAddress().String(),
+		Tokens:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+	for sequence, expiryTimestamp := range []uint64{now - 1, now, now + 1} {
+		transfer.ExpiryTimestamp = expiryTimestamp
+		transferKeeper.SetTimedOutTransfer(ctx, types.PortID, ibctesting.FirstChannelID, uint64(sequence+1), transfer)
+	}
+
+	// overwriting a transfer replaces its expiry index
+	transferKeeper.SetTimedOutTransfer(ctx, types.PortID, ibctesting.FirstChannelID, 3, transfer)
+
+	transferKeeper.PruneExpiredTimedOutTransfers(ctx)
+
+	transfers := transferKeeper.GetAllTimedOutTransfers(ctx)
+	suite.Require().Equal([]types.IdentifiedTimedOutTransfer{
+		{PortId: types.PortID, ChannelId: ibctesting.FirstChannelID, Sequence: 3, Transfer: transfer},
+	}, transfers)
+
+	// the transfer is pruned once its expiry timestamp is reached
+	ctx = ctx.WithBlockTime(time.Unix(0, int64(now+1)))
+	transferKeeper.PruneExpiredTimedOutTransfers(ctx)
+	suite.Require().Empty(transferKeeper.GetAllTimedOutTransfers(ctx))
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey)), types.TimedOutTransferExpiryKey)
+	defer iterator.Close()
+	suite.Require().False(iterator.Valid())
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
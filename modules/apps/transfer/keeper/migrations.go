@@ -53,6 +53,38 @@ func (m Migrator) MigrateTraces(ctx sdk.Context) error {
 	return nil
 }
 
+// MigrateTotalEscrowForDenom migrates the total amount of source chain tokens in escrow.
+// The total escrow amounts are reconstructed from the balances of the escrow accounts
+// of every channel bound to the transfer port.
+func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
+	totalEscrowed := m.keeper.GetAllEscrowBalances(ctx)
+	for _, totalEscrow := range totalEscrowed {
+		m.keeper.SetTotalEscrowForDenom(ctx, totalEscrow)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully set total escrow", "number of denominations", totalEscrowed.Len())
+
+	return nil
+}
+
+// MigrateMaxMemoLength sets the maximum packet memo length parameter to its default value.
+func (m Migrator) MigrateMaxMemoLength(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxMemoLength, uint64(types.DefaultMaxMemoLength))
+
+	m.keeper.Logger(ctx).Info("successfully set max memo length", "max memo length", types.DefaultMaxMemoLength)
+
+	return nil
+}
+
+// MigrateReceiveDenomBlocklist sets the receive denomination blocklist parameter to an empty list.
+func (m Migrator) MigrateReceiveDenomBlocklist(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyReceiveDenomBlocklist, []string(nil))
+
+	m.keeper.Logger(ctx).Info("successfully set receive denom blocklist")
+
+	return nil
+}
+
 func equalTraces(dtA, dtB types.DenomTrace) bool {
 	return dtA.BaseDenom == dtB.BaseDenom && dtA.Path == dtB.Path
 }
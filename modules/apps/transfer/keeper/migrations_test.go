@@ -3,8 +3,13 @@ package keeper_test
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	transferkeeper "github.com/cosmos/ibc-go/v6/modules/apps/transfer/keeper"
 	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/cosmos/ibc-go/v6/testing/simapp"
 )
 
 func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
@@ -119,3 +124,92 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTracesCorruptionDetection() {
 		migrator.MigrateTraces(suite.chainA.GetContext())
 	})
 }
+
+func (suite *KeeperTestSuite) TestMigrateTotalEscrowForDenom() {
+	var (
+		path              *ibctesting.Path
+		expectedEscrowAmt math.Int
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: one native denom escrowed in one channel",
+			func() {
+				escrowAddress := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				escrowedCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				// funds the escrow account to have balance
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress, sdk.NewCoins(escrowedCoin)))
+
+				expectedEscrowAmt = escrowedCoin.Amount
+			},
+		},
+		{
+			"success: one native denom escrowed in two channels",
+			func() {
+				extraPath := NewTransferPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(extraPath)
+
+				escrowAddress1 := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				escrowAddress2 := transfertypes.GetEscrowAddress(extraPath.EndpointA.ChannelConfig.PortID, extraPath.EndpointA.ChannelID)
+				escrowedCoin1 := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				escrowedCoin2 := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				// funds the escrow accounts to have balance
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress1, sdk.NewCoins(escrowedCoin1)))
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress2, sdk.NewCoins(escrowedCoin2)))
+
+				expectedEscrowAmt = escrowedCoin1.Amount.Add(escrowedCoin2.Amount)
+			},
+		},
+		{
+			"success: no escrowed funds",
+			func() {
+				expectedEscrowAmt = sdk.ZeroInt()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate() // explicitly fund escrow account
+
+			migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+			suite.Require().NoError(migrator.MigrateTotalEscrowForDenom(suite.chainA.GetContext()))
+
+			// check that the migration set the expected amount for the native denom
+			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+			suite.Require().Equal(expectedEscrowAmt, totalEscrow.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateMaxMemoLength() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	transferKeeper.SetParams(ctx, transfertypes.NewParams(true, true, 1, nil))
+
+	migrator := transferkeeper.NewMigrator(transferKeeper)
+	suite.Require().NoError(migrator.MigrateMaxMemoLength(ctx))
+
+	suite.Require().Equal(uint64(transfertypes.DefaultMaxMemoLength), transferKeeper.GetMaxMemoLength(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMigrateReceiveDenomBlocklist() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	transferKeeper.SetParams(ctx, transfertypes.NewParams(true, true, transfertypes.DefaultMaxMemoLength, []string{"transfer/channel-0/uatom"}))
+
+	migrator := transferkeeper.NewMigrator(transferKeeper)
+	suite.Require().NoError(migrator.MigrateReceiveDenomBlocklist(ctx))
+
+	suite.Require().Empty(transferKeeper.GetReceiveDenomBlocklist(ctx))
+}
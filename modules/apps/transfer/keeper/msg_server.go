@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 )
 
 var _ types.MsgServer = Keeper{}
@@ -15,17 +18,16 @@ var _ types.MsgServer = Keeper{}
 func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !k.GetSendEnabled(ctx) {
-		return nil, types.ErrSendDisabled
-	}
-
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	if !k.bankKeeper.IsSendEnabledCoin(ctx, msg.Token) {
-		return nil, sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", msg.Token.Denom)
+	tokens := msg.GetCoins()
+	for _, token := range tokens {
+		if !k.bankKeeper.IsSendEnabledCoin(ctx, token) {
+			return nil, sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", token.Denom)
+		}
 	}
 
 	if k.bankKeeper.BlockedAddr(sender) {
@@ -33,13 +35,13 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	}
 
 	sequence, err := k.sendTransfer(
-		ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+		ctx, msg.SourcePort, msg.SourceChannel, tokens, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
 		msg.Memo)
 	if err != nil {
 		return nil, err
 	}
 
-	k.Logger(ctx).Info("IBC fungible token transfer", "token", msg.Token.Denom, "amount", msg.Token.Amount.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+	k.Logger(ctx).Info("IBC fungible token transfer", "tokens", tokens.String(), "sender", msg.Sender, "receiver", msg.Receiver)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -55,3 +57,190 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 
 	return &types.MsgTransferResponse{Sequence: sequence}, nil
 }
+
+// MultiTransfer defines a rpc handler method for MsgMultiTransfer. Each entry is sent as a MsgTransfer
+// in its own packet. The transfers are sent using a cached context which is only written if all of
+// them succeed, so that a single failing transfer discards the state changes of the entire message.
+func (k Keeper) MultiTransfer(goCtx context.Context, msg *types.MsgMultiTransfer) (*types.MsgMultiTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	transfers := msg.GetTransfers()
+	sequences := make([]uint64, len(transfers))
+	for i, transfer := range transfers {
+		res, err := k.Transfer(sdk.WrapSDKContext(cacheCtx), transfer)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to send entry %d", i)
+		}
+
+		sequences[i] = res.Sequence
+	}
+
+	writeFn()
+
+	return &types.MsgMultiTransferResponse{Sequences: sequences}, nil
+}
+
+// RetryTransfer defines a rpc handler method for MsgRetryTransfer. The transfer of the timed out
+// packet is sent again as a MsgTransfer with the timeouts of the message, in a new packet. The
+// stored transfer is removed so that it can only be retried once. Expired transfers cannot be retried.
+func (k Keeper) RetryTransfer(goCtx context.Context, msg *types.MsgRetryTransfer) (*types.MsgRetryTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	transfer, found := k.GetTimedOutTransfer(ctx, msg.SourcePort, msg.SourceChannel, msg.Sequence)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTimedOutTransferNotFound, "port ID (%s) channel ID (%s) sequence (%d)", msg.SourcePort, msg.SourceChannel, msg.Sequence)
+	}
+
+	if transfer.ExpiryTimestamp <= uint64(ctx.BlockTime().UnixNano()) {
+		return nil, sdkerrors.Wrapf(types.ErrTimedOutTransferNotFound, "transfer expired at %d", transfer.ExpiryTimestamp)
+	}
+
+	if transfer.Sender != msg.Sender {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected sender %s, got %s", transfer.Sender, msg.Sender)
+	}
+
+	k.DeleteTimedOutTransfer(ctx, msg.SourcePort, msg.SourceChannel, msg.Sequence)
+
+	msgTransfer := types.NewMsgMultiTokenTransfer(
+		msg.SourcePort, msg.SourceChannel, transfer.Tokens, transfer.Sender, transfer.Receiver,
+		msg.TimeoutHeight, msg.TimeoutTimestamp, transfer.Memo,
+	)
+	if len(transfer.Tokens) == 1 {
+		msgTransfer = types.NewMsgTransfer(
+			msg.SourcePort, msg.SourceChannel, transfer.Tokens[0], transfer.Sender, transfer.Receiver,
+			msg.TimeoutHeight, msg.TimeoutTimestamp, transfer.Memo,
+		)
+	}
+
+	res, err := k.Transfer(goCtx, msgTransfer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to retry transfer")
+	}
+
+	return &types.MsgRetryTransferResponse{Sequence: res.Sequence}, nil
+}
+
+// SetReceiveOnlyChannel defines a rpc handler method for MsgSetReceiveOnlyChannel. It enables or disables
+// the receive only mode of the channel, in which outbound transfers over the channel are rejected.
+func (k Keeper) SetReceiveOnlyChannel(goCtx context.Context, msg *types.MsgSetReceiveOnlyChannel) (*types.MsgSetReceiveOnlyChannelResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	if msg.ReceiveOnly {
+		k.SetChannelReceiveOnly(ctx, msg.PortId, msg.ChannelId)
+	} else {
+		k.DeleteChannelReceiveOnly(ctx, msg.PortId, msg.ChannelId)
+	}
+
+	k.Logger(ctx).Info("receive only mode of transfer channel updated", "port-id", msg.PortId, "channel-id", msg.ChannelId, "receive-only", msg.ReceiveOnly)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetReceiveOnlyChannel,
+			sdk.NewAttribute(types.AttributeKeyPortID, msg.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyReceiveOnly, strconv.FormatBool(msg.ReceiveOnly)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgSetReceiveOnlyChannelResponse{}, nil
+}
+
+// SetDenomTransferEnabled defines a rpc handler method for MsgSetDenomTransferEnabled. It sets or removes
+// the override of the send and receive enabled params for a single denomination. A flag which is not set
+// in the message keeps the flag stored for the denomination.
+func (k Keeper) SetDenomTransferEnabled(goCtx context.Context, msg *types.MsgSetDenomTransferEnabled) (*types.MsgSetDenomTransferEnabledResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Remove {
+		k.DeleteDenomTransferEnabledOverride(ctx, msg.Denom)
+	} else {
+		override, found := k.GetDenomTransferEnabledOverride(ctx, msg.Denom)
+		if !found {
+			override = types.DenomTransferEnabled{Denom: msg.Denom}
+		}
+
+		if msg.SendEnabled != nil {
+			override.SendEnabled = msg.SendEnabled
+		}
+
+		if msg.ReceiveEnabled != nil {
+			override.ReceiveEnabled = msg.ReceiveEnabled
+		}
+
+		k.SetDenomTransferEnabledOverride(ctx, override)
+	}
+
+	// the values applying to the denomination once the override is updated
+	sendEnabled := k.IsSendEnabledDenom(ctx, msg.Denom)
+	receiveEnabled := k.IsReceiveEnabledDenom(ctx, msg.Denom)
+
+	k.Logger(ctx).Info("transfer enabled override of denomination updated", "denom", msg.Denom, "send-enabled", sendEnabled, "receive-enabled", receiveEnabled, "remove", msg.Remove)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetDenomTransferEnabled,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeySendEnabled, strconv.FormatBool(sendEnabled)),
+			sdk.NewAttribute(types.AttributeKeyReceiveEnabled, strconv.FormatBool(receiveEnabled)),
+			sdk.NewAttribute(types.AttributeKeyRemove, strconv.FormatBool(msg.Remove)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgSetDenomTransferEnabledResponse{}, nil
+}
+
+// RenameEscrowDenom defines a rpc handler method for MsgRenameEscrowDenom. It migrates the tokens of
+// the old denomination held in escrow to the new denomination.
+func (k Keeper) RenameEscrowDenom(goCtx context.Context, msg *types.MsgRenameEscrowDenom) (*types.MsgRenameEscrowDenomResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	migrations, err := k.MigrateEscrowDenom(ctx, msg.OldDenom, msg.NewDenom, false)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("escrowed tokens migrated to new denomination", "old-denom", msg.OldDenom, "new-denom", msg.NewDenom, "escrow-accounts", len(migrations))
+
+	events := make(sdk.Events, 0, len(migrations)+1)
+	for _, migration := range migrations {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeMigrateEscrowDenom,
+			sdk.NewAttribute(types.AttributeKeyOldDenom, msg.OldDenom),
+			sdk.NewAttribute(types.AttributeKeyNewDenom, msg.NewDenom),
+			sdk.NewAttribute(types.AttributeKeyEscrowAddress, migration.EscrowAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, migration.Amount.Amount.String()),
+		))
+	}
+
+	ctx.EventManager().EmitEvents(append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+	)))
+
+	return &types.MsgRenameEscrowDenomResponse{Migrations: migrations}, nil
+}
@@ -1,10 +1,16 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 )
 
 func (suite *KeeperTestSuite) TestMsgTransfer() {
@@ -74,6 +80,43 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			},
 			false,
 		},
+		{
+			"channel is receive only",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiveOnly(suite.chainA.GetContext(), msg.SourcePort, msg.SourceChannel)
+			},
+			false,
+		},
+		{
+			"send transfers disabled for denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, SendEnabled: types.NewTransferEnabledFlag(false)})
+			},
+			false,
+		},
+		{
+			"send flag not set for denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, ReceiveEnabled: types.NewTransferEnabledFlag(false)})
+			},
+			true,
+		},
+		{
+			"send transfers disabled, but enabled for denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true, types.DefaultMaxMemoLength, nil))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, SendEnabled: types.NewTransferEnabledFlag(true)})
+			},
+			true,
+		},
+		{
+			"send transfers disabled and send flag not set for denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true, types.DefaultMaxMemoLength, nil))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, ReceiveEnabled: types.NewTransferEnabledFlag(true)})
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -107,3 +150,433 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 		})
 	}
 }
+
+// TestMsgMultiTransfer tests that each entry of a MsgMultiTransfer is sent in its own packet and that
+// no packets are sent if any of the entries fails.
+func (suite *KeeperTestSuite) TestMsgMultiTransfer() {
+	var msg *types.MsgMultiTransfer
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"send transfers disabled",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(),
+					types.Params{
+						SendEnabled: false,
+					},
+				)
+			},
+			false,
+		},
+		{
+			"channel does not exist",
+			func() {
+				msg.SourceChannel = "channel-100"
+			},
+			false,
+		},
+		{
+			"insufficient funds for the last entry",
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				msg.Entries[1].Token = balance
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			msg = types.NewMsgMultiTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				suite.chainA.SenderAccount.GetAddress().String(),
+				[]types.MultiTransferEntry{
+					types.NewMultiTransferEntry(suite.chainB.SenderAccount.GetAddress().String(), coin, suite.chainB.GetTimeoutHeight(), 0, "memo"),
+					types.NewMultiTransferEntry(suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String(), coin, suite.chainB.GetTimeoutHeight(), 0, ""),
+				},
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.MultiTransfer(sdk.WrapSDKContext(ctx), msg)
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			hasCommitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]uint64{1, 2}, res.Sequences)
+				suite.Require().True(hasCommitment)
+				suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2))
+				suite.Require().Equal(balanceBefore.Sub(coin.Add(coin)), balance)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+				suite.Require().False(hasCommitment)
+				suite.Require().Equal(balanceBefore, balance)
+			}
+		})
+	}
+}
+
+// TestMsgRetryTransfer tests that the transfer of a timed out packet is sent again in a new packet
+// by its sender, and only once.
+func (suite *KeeperTestSuite) TestMsgRetryTransfer() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgRetryTransfer
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"timed out transfer not found",
+			func() {
+				msg.Sequence++
+			},
+			false,
+		},
+		{
+			"sender is not the sender of the timed out transfer",
+			func() {
+				msg.Sender = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"transfer already retried",
+			func() {
+				_, err := suite.chainA.GetSimApp().TransferKeeper.RetryTransfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"channel is receive only",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiveOnly(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			},
+			false,
+		},
+		{
+			"timed out transfer expired",
+			func() {
+				ctx := suite.chainA.GetContext()
+				transfer, found := suite.chainA.GetSimApp().TransferKeeper.GetTimedOutTransfer(ctx, msg.SourcePort, msg.SourceChannel, msg.Sequence)
+				suite.Require().True(found)
+
+				transfer.ExpiryTimestamp = uint64(ctx.BlockTime().UnixNano())
+				suite.chainA.GetSimApp().TransferKeeper.SetTimedOutTransfer(ctx, msg.SourcePort, msg.SourceChannel, msg.Sequence, transfer)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver := suite.chainB.SenderAccount.GetAddress().String()
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			msgTransfer := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), receiver, timeoutHeight, 0, "memo")
+
+			res, err := suite.chainA.SendMsgs(msgTransfer)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			// timeout the packet, refunding the sender
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+			transfer, found := suite.chainA.GetSimApp().TransferKeeper.GetTimedOutTransfer(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence())
+			suite.Require().True(found)
+			suite.Require().Equal(types.TimedOutTransfer{Sender: sender.String(), Receiver: receiver, Tokens: sdk.NewCoins(coin), Memo: "memo", ExpiryTimestamp: transfer.ExpiryTimestamp}, transfer)
+			suite.Require().Greater(transfer.ExpiryTimestamp, uint64(suite.chainA.GetContext().BlockTime().Add(types.TimedOutTransferRetryPeriod-time.Minute).UnixNano()))
+
+			msg = types.NewMsgRetryTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence(), sender.String(), suite.chainB.GetTimeoutHeight(), 0)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+
+			retryRes, err := suite.chainA.GetSimApp().TransferKeeper.RetryTransfer(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(packet.GetSequence()+1, retryRes.Sequence)
+				suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, retryRes.Sequence))
+				suite.Require().Equal(balanceBefore.Sub(coin), suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+
+				_, found := suite.chainA.GetSimApp().TransferKeeper.GetTimedOutTransfer(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence())
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(retryRes)
+				suite.Require().Equal(balanceBefore, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetReceiveOnlyChannel() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgSetReceiveOnlyChannel
+	)
+
+	testCases := []struct {
+		name           string
+		malleate       func()
+		expErr         error
+		expReceiveOnly bool
+	}{
+		{
+			"success: enable receive only",
+			func() {},
+			nil,
+			true,
+		},
+		{
+			"success: disable receive only",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiveOnly(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				msg.ReceiveOnly = false
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			govtypes.ErrInvalidSigner,
+			false,
+		},
+		{
+			"failure: channel does not exist",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			channeltypes.ErrChannelNotFound,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			msg = types.NewMsgSetReceiveOnlyChannel(
+				suite.chainA.GetSimApp().TransferKeeper.GetAuthority(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				true,
+			)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.SetReceiveOnlyChannel(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+
+			receiveOnly := suite.chainA.GetSimApp().TransferKeeper.IsChannelReceiveOnly(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().Equal(tc.expReceiveOnly, receiveOnly)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetDenomTransferEnabled() {
+	var (
+		msg              *types.MsgSetDenomTransferEnabled
+		expectedOverride types.DenomTransferEnabled
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expErr      error
+		expOverride bool
+	}{
+		{
+			"success: set override",
+			func() {},
+			nil,
+			true,
+		},
+		{
+			"success: unset flag is not stored",
+			func() {
+				msg.SendEnabled = nil
+				expectedOverride.SendEnabled = nil
+			},
+			nil,
+			true,
+		},
+		{
+			"success: unset flag keeps the value of the existing override",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: msg.Denom, SendEnabled: types.NewTransferEnabledFlag(true), ReceiveEnabled: types.NewTransferEnabledFlag(false)})
+				msg.ReceiveEnabled = nil
+				expectedOverride = types.DenomTransferEnabled{Denom: msg.Denom, SendEnabled: types.NewTransferEnabledFlag(false), ReceiveEnabled: types.NewTransferEnabledFlag(false)}
+			},
+			nil,
+			true,
+		},
+		{
+			"success: remove override",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainA.GetContext(), types.DenomTransferEnabled{Denom: msg.Denom})
+				msg.Remove = true
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			govtypes.ErrInvalidSigner,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgSetDenomTransferEnabled(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), sdk.DefaultBondDenom, types.NewTransferEnabledFlag(false), types.NewTransferEnabledFlag(true), false)
+			expectedOverride = types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, SendEnabled: types.NewTransferEnabledFlag(false), ReceiveEnabled: types.NewTransferEnabledFlag(true)}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferEnabled(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+
+			override, found := suite.chainA.GetSimApp().TransferKeeper.GetDenomTransferEnabledOverride(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+			suite.Require().Equal(tc.expOverride, found)
+			if tc.expOverride {
+				suite.Require().Equal(expectedOverride, override)
+			}
+		})
+	}
+}
+
+// TestRenameEscrowDenom tests the migration of the escrowed tokens of a renamed base denomination by
+// the governance authority.
+func (suite *KeeperTestSuite) TestRenameEscrowDenom() {
+	var msg *types.MsgRenameEscrowDenom
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			govtypes.ErrInvalidSigner,
+		},
+		{
+			"failure: old denomination already migrated",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetMigratedDenom(suite.chainA.GetContext(), types.MigratedDenom{OldDenom: msg.OldDenom, NewDenom: "uatom"})
+			},
+			types.ErrInvalidDenomMigration,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			transferMsg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+			_, err := suite.chainA.SendMsgs(transferMsg)
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgRenameEscrowDenom(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), sdk.DefaultBondDenom, "unewstake")
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.RenameEscrowDenom(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]types.EscrowDenomMigration{{EscrowAddress: escrowAddress.String(), Amount: coin}}, res.Migrations)
+				suite.Require().Equal(coin.Amount, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, msg.NewDenom).Amount)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().Equal(coin, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
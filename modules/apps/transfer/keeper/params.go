@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
 // GetSendEnabled retrieves the send enabled boolean from the paramstore
@@ -20,9 +21,30 @@ func (k Keeper) GetReceiveEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// GetMaxMemoLength retrieves the maximum packet memo length in bytes from the paramstore
+func (k Keeper) GetMaxMemoLength(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxMemoLength, &res)
+	return res
+}
+
+// GetReceiveDenomBlocklist retrieves the full denomination paths which cannot be received from the paramstore
+func (k Keeper) GetReceiveDenomBlocklist(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeyReceiveDenomBlocklist, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-transfer parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx))
+	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx), k.GetMaxMemoLength(ctx), k.GetReceiveDenomBlocklist(ctx))
+}
+
+// GetAppParams returns the total set of ibc-transfer parameters. It is used by the IBCParams query
+// of core IBC.
+func (k Keeper) GetAppParams(ctx sdk.Context) exported.AppParams {
+	params := k.GetParams(ctx)
+	return &params
 }
 
 // SetParams sets the total set of ibc-transfer parameters.
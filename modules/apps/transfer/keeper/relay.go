@@ -52,13 +52,21 @@ func (k Keeper) sendTransfer(
 	ctx sdk.Context,
 	sourcePort,
 	sourceChannel string,
-	token sdk.Coin,
+	tokens sdk.Coins,
 	sender sdk.AccAddress,
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 	memo string,
 ) (uint64, error) {
+	if k.IsChannelReceiveOnly(ctx, sourcePort, sourceChannel) {
+		return 0, sdkerrors.Wrapf(types.ErrReceiveOnlyChannel, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	if err := k.validateMemoLength(ctx, memo); err != nil {
+		return 0, err
+	}
+
 	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
 		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
@@ -74,6 +82,143 @@ func (k Keeper) sendTransfer(
 		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
+	appVersion, found := k.GetAppVersion(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	baseVersion, compressed := types.ParseVersion(appVersion)
+
+	// only channels negotiated with the multi token version may transfer several tokens at once
+	if baseVersion != types.V2 && len(tokens) != 1 {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidVersion, "channel version %s only supports the transfer of a single token, got %d tokens", appVersion, len(tokens))
+	}
+
+	// NOTE: SendTransfer simply sends the denomination as it exists on its own
+	// chain inside the packet data. The receiving chain will perform denom
+	// prefixing as necessary.
+	packetTokens := make([]types.Token, len(tokens))
+	sourceLabels := make([]string, len(tokens))
+	for i, token := range tokens {
+		fullDenomPath, source, err := k.sendToken(ctx, sourcePort, sourceChannel, token, sender)
+		if err != nil {
+			return 0, err
+		}
+
+		packetTokens[i] = types.Token{Denom: fullDenomPath, Amount: token.Amount.String()}
+		sourceLabels[i] = fmt.Sprintf("%t", source)
+	}
+
+	var packetBytes []byte
+	if baseVersion == types.V2 {
+		packetData := types.NewFungibleTokenPacketDataV2(packetTokens, sender.String(), receiver, memo)
+		packetBytes = packetData.GetBytes()
+	} else {
+		packetData := types.NewFungibleTokenPacketData(
+			packetTokens[0].Denom, packetTokens[0].Amount, sender.String(), receiver, memo,
+		)
+		packetBytes = packetData.GetBytes()
+	}
+
+	// the packet data is only compressed if the counterparty negotiated the compressed version,
+	// packets sent over channels using the legacy versions remain uncompressed
+	if compressed {
+		packetBytes = types.CompressPacketData(packetBytes)
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		for i, token := range tokens {
+			if token.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "ibc", "transfer"},
+					float32(token.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, packetTokens[i].Denom)},
+				)
+			}
+
+			telemetry.IncrCounterWithLabels(
+				[]string{"ibc", types.ModuleName, "send"},
+				1,
+				[]metrics.Label{
+					telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
+					telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+					telemetry.NewLabel(coretypes.LabelSource, sourceLabels[i]),
+				},
+			)
+		}
+	}()
+
+	return sequence, nil
+}
+
+// ValidatePacketMemoLength ensures the memo of the provided ICS-20 packet data does not exceed the
+// maximum memo length. Only the memo field is decoded, and only if the packet data itself exceeds
+// the maximum memo length, so that packets carrying oversized memos may be rejected before the
+// packet data is fully decoded and processed. A maximum memo length of zero disables the limit.
+func (k Keeper) ValidatePacketMemoLength(ctx sdk.Context, packetData []byte) error {
+	if maxMemoLength := k.GetMaxMemoLength(ctx); maxMemoLength == 0 || uint64(len(packetData)) <= maxMemoLength {
+		return nil
+	}
+
+	memo, err := types.DecodePacketMemo(packetData)
+	if err != nil {
+		return err
+	}
+
+	return k.validateMemoLength(ctx, memo)
+}
+
+// validateMemoLength returns an error if the UTF-8 byte length of the provided memo exceeds the
+// maximum memo length. A maximum memo length of zero disables the limit.
+func (k Keeper) validateMemoLength(ctx sdk.Context, memo string) error {
+	if maxMemoLength := k.GetMaxMemoLength(ctx); maxMemoLength != 0 && uint64(len(memo)) > maxMemoLength {
+		return sdkerrors.Wrapf(types.ErrInvalidMemo, "memo length (%d bytes) exceeds the maximum memo length (%d bytes)", len(memo), maxMemoLength)
+	}
+
+	return nil
+}
+
+// validateReceiveDenom returns an error if the full denomination path of the tokens credited on
+// this chain upon receiving the provided packet denomination is on the receive denom blocklist.
+// The blocklist is matched on the full denomination path rather than the base denomination, so
+// a token may be blocked only when it is received over a specific channel.
+func (k Keeper) validateReceiveDenom(ctx sdk.Context, packet channeltypes.Packet, denom string) error {
+	blocklist := k.GetReceiveDenomBlocklist(ctx)
+	if len(blocklist) == 0 {
+		return nil
+	}
+
+	fullDenomPath := receivedDenomPath(packet, denom)
+	for _, blockedDenom := range blocklist {
+		if blockedDenom == fullDenomPath {
+			return sdkerrors.Wrapf(types.ErrReceiveDenomBlocked, "denomination %s is on the receive denom blocklist", fullDenomPath)
+		}
+	}
+
+	return nil
+}
+
+// receivedDenomPath returns the full denomination path of the tokens credited on this chain upon
+// receiving the provided packet denomination, i.e. the unprefixed denomination for tokens
+// returning to this chain or the denomination prefixed with the destination port and channel.
+func receivedDenomPath(packet channeltypes.Packet, denom string) string {
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return denom[len(voucherPrefix):]
+	}
+
+	return types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+}
+
+// sendToken escrows the provided token if the sender chain is the source of the token and burns
+// it otherwise. The full denomination path of the token and whether the sender chain is its
+// source are returned.
+func (k Keeper) sendToken(ctx sdk.Context, sourcePort, sourceChannel string, token sdk.Coin, sender sdk.AccAddress) (string, bool, error) {
 	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
 	fullDenomPath := token.Denom
 
@@ -84,106 +229,111 @@ func (k Keeper) sendTransfer(
 	if strings.HasPrefix(token.Denom, "ibc/") {
 		fullDenomPath, err = k.DenomPathFromHash(ctx, token.Denom)
 		if err != nil {
-			return 0, err
+			return "", false, err
 		}
 	}
 
-	labels := []metrics.Label{
-		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
-		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+	if !k.IsSendEnabledDenom(ctx, fullDenomPath) {
+		return "", false, sdkerrors.Wrapf(types.ErrSendDisabled, "transfers of %s are disabled", fullDenomPath)
 	}
 
-	// NOTE: SendTransfer simply sends the denomination as it exists on its own
-	// chain inside the packet data. The receiving chain will perform denom
-	// prefixing as necessary.
-
 	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
+		// tokens of a migrated denomination are unescrowed in the new denomination, so escrowing tokens
+		// of the old denomination would drain the escrow of the new denomination once they return
+		if migratedDenom, found := k.GetMigratedDenom(ctx, token.Denom); found {
+			return "", false, sdkerrors.Wrapf(types.ErrInvalidDenomForTransfer, "escrowed tokens of denomination %s were migrated to %s", token.Denom, migratedDenom)
+		}
 
 		// create the escrow address for the tokens
-		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+		escrowAddress := k.GetEscrowAddress(sourcePort, sourceChannel)
 
 		// escrow source tokens. It fails if balance insufficient.
 		if err := k.bankKeeper.SendCoins(
 			ctx, sender, escrowAddress, sdk.NewCoins(token),
 		); err != nil {
-			return 0, err
+			return "", false, err
 		}
-	} else {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
 
-		// transfer the coins to the module account and burn them
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(
-			ctx, sender, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			return 0, err
-		}
+		// track the total amount in escrow keyed by denomination to allow for efficient iteration
+		k.escrowToken(ctx, token)
 
-		if err := k.bankKeeper.BurnCoins(
-			ctx, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			// NOTE: should not happen as the module account was
-			// retrieved on the step above and it has enough balace
-			// to burn.
-			panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
-		}
+		return fullDenomPath, true, nil
 	}
 
-	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver, memo,
-	)
-
-	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
-	if err != nil {
-		return 0, err
+	// transfer the coins to the module account and burn them
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, sender, types.ModuleName, sdk.NewCoins(token),
+	); err != nil {
+		return "", false, err
 	}
 
-	defer func() {
-		if token.Amount.IsInt64() {
-			telemetry.SetGaugeWithLabels(
-				[]string{"tx", "msg", "ibc", "transfer"},
-				float32(token.Amount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, fullDenomPath)},
-			)
-		}
-
-		telemetry.IncrCounterWithLabels(
-			[]string{"ibc", types.ModuleName, "send"},
-			1,
-			labels,
-		)
-	}()
+	if err := k.bankKeeper.BurnCoins(
+		ctx, types.ModuleName, sdk.NewCoins(token),
+	); err != nil {
+		// NOTE: should not happen as the module account was
+		// retrieved on the step above and it has enough balace
+		// to burn.
+		panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
+	}
 
-	return sequence, nil
+	return fullDenomPath, false, nil
 }
 
 // OnRecvPacket processes a cross chain fungible token transfer. If the
 // sender chain is the source of minted tokens then vouchers will be minted
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
-// unescrowed and sent to the receiving address.
-func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+// unescrowed and sent to the receiving address. If the packet memo contains
+// relayer fee metadata, the fee is deducted from the received tokens and sent
+// to the relayer delivering the packet.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, relayer sdk.AccAddress) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return err
 	}
 
-	if !k.GetReceiveEnabled(ctx) {
-		return types.ErrReceiveDisabled
+	if fullDenomPath := receivedDenomPath(packet, data.Denom); !k.IsReceiveEnabledDenom(ctx, fullDenomPath) {
+		return sdkerrors.Wrapf(types.ErrReceiveDisabled, "transfers of %s are disabled", fullDenomPath)
+	}
+
+	if err := k.validateReceiveDenom(ctx, packet, data.Denom); err != nil {
+		return err
 	}
 
-	// decode the receiver address
-	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	forward, err := types.ParseForwardMetadata(data.Memo)
 	if err != nil {
 		return err
 	}
 
+	var receiver sdk.AccAddress
+	if forward != nil {
+		// tokens to be forwarded are held by the forward address of the receiving channel
+		receiver = types.GetForwardAddress(packet.GetDestPort(), packet.GetDestChannel())
+	} else {
+		// decode the receiver address
+		receiver, err = sdk.AccAddressFromBech32(data.Receiver)
+		if err != nil {
+			return err
+		}
+	}
+
 	// parse the transfer amount
 	transferAmount, ok := sdk.NewIntFromString(data.Amount)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
 	}
 
+	relayerFee, err := types.ParseRelayerFeeMetadata(data.Memo)
+	if err != nil {
+		return err
+	}
+
+	if relayerFee != nil {
+		if err := k.validateRelayerFee(relayerFee, forward, transferAmount, relayer); err != nil {
+			return err
+		}
+	}
+
 	labels := []metrics.Label{
 		telemetry.NewLabel(coretypes.LabelSourcePort, packet.GetSourcePort()),
 		telemetry.NewLabel(coretypes.LabelSourceChannel, packet.GetSourceChannel()),
@@ -212,16 +362,19 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		denomTrace := types.ParseDenomTrace(unprefixedDenom)
 		if denomTrace.Path != "" {
 			denom = denomTrace.IBCDenom()
+		} else {
+			denom = k.escrowedDenom(ctx, denom)
 		}
 		token := sdk.NewCoin(denom, transferAmount)
+		receiverToken, feeToken := splitRelayerFee(token, relayerFee)
 
 		if k.bankKeeper.BlockedAddr(receiver) {
 			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
 		}
 
 		// unescrow tokens
-		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token)); err != nil {
+		escrowAddress := k.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(receiverToken)); err != nil {
 			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
 			// counterparty module. The bug may occur in bank or any part of the code that allows
 			// the escrow address to be drained. A malicious counterparty module could drain the
@@ -229,6 +382,23 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
 		}
 
+		if feeToken.IsPositive() {
+			if err := k.bankKeeper.SendCoins(ctx, escrowAddress, relayer, sdk.NewCoins(feeToken)); err != nil {
+				return sdkerrors.Wrap(err, "unable to unescrow relayer fee, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+			}
+
+			emitRelayerFeeEvent(ctx, relayer, feeToken)
+		}
+
+		// track the total amount in escrow keyed by denomination to allow for efficient iteration
+		k.unescrowToken(ctx, token)
+
+		if forward != nil {
+			if err := k.forwardPacket(ctx, packet, receiver, token, forward); err != nil {
+				return err
+			}
+		}
+
 		defer func() {
 			if transferAmount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
@@ -263,6 +433,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	traceHash := denomTrace.Hash()
 	if !k.HasDenomTrace(ctx, traceHash) {
 		k.SetDenomTrace(ctx, denomTrace)
+		k.registerDenomMetadata(ctx, denomTrace, data.Memo)
 	}
 
 	voucherDenom := denomTrace.IBCDenom()
@@ -274,6 +445,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		),
 	)
 	voucher := sdk.NewCoin(voucherDenom, transferAmount)
+	receiverVoucher, feeVoucher := splitRelayerFee(voucher, relayerFee)
 
 	// mint new tokens if the source of the transfer is the same chain
 	if err := k.bankKeeper.MintCoins(
@@ -284,11 +456,28 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 
 	// send to receiver
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx, types.ModuleName, receiver, sdk.NewCoins(voucher),
+		ctx, types.ModuleName, receiver, sdk.NewCoins(receiverVoucher),
 	); err != nil {
 		return err
 	}
 
+	// send the relayer fee to the relayer
+	if feeVoucher.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, relayer, sdk.NewCoins(feeVoucher),
+		); err != nil {
+			return err
+		}
+
+		emitRelayerFeeEvent(ctx, relayer, feeVoucher)
+	}
+
+	if forward != nil {
+		if err := k.forwardPacket(ctx, packet, receiver, voucher, forward); err != nil {
+			return err
+		}
+	}
+
 	defer func() {
 		if transferAmount.IsInt64() {
 			telemetry.SetGaugeWithLabels(
@@ -314,21 +503,38 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 // acknowledgement written on the receiving chain. If the acknowledgement
 // was a success then nothing occurs. If the acknowledgement failed, then
 // the sender is refunded their tokens using the refundPacketToken function.
+// If the packet was sent by packet forwarding, the acknowledgement is also
+// written for the inbound packet which was forwarded.
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if err := k.refundPacketToken(ctx, packet, data); err != nil {
+			return err
+		}
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing
 		// needs to be executed and no error needs to be returned
-		return nil
 	}
+
+	return k.resolveForwardedPacket(ctx, packet, data, ack)
 }
 
 // OnTimeoutPacket refunds the sender since the original packet sent was
-// never received and has been timed out.
+// never received and has been timed out. If the packet was sent by packet
+// forwarding, an error acknowledgement is written for the inbound packet
+// which was forwarded. Otherwise the transfer is stored so that the sender
+// may retry it.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	if err := k.refundPacketToken(ctx, packet, data); err != nil {
+		return err
+	}
+
+	if _, found := k.GetForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()); !found {
+		k.setTimedOutTransfer(ctx, packet, data.Sender, data.Receiver, []types.Token{{Denom: data.Denom, Amount: data.Amount}}, data.Memo)
+	}
+
+	ack := channeltypes.NewErrorAcknowledgementWithCode(sdkerrors.Wrap(channeltypes.ErrPacketTimeout, "forwarded packet timed out"))
+	return k.resolveForwardedPacket(ctx, packet, data, ack)
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
@@ -338,25 +544,35 @@ func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, dat
 func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
 	// NOTE: packet data type already checked in handler.go
 
+	// decode the sender address
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	return k.refundToken(ctx, packet, data.Denom, data.Amount, sender)
+}
+
+// refundToken refunds the provided amount of the token with the given full denomination path to
+// the sender of the packet.
+func (k Keeper) refundToken(ctx sdk.Context, packet channeltypes.Packet, fullDenomPath, amount string, sender sdk.AccAddress) error {
 	// parse the denomination from the full denom path
-	trace := types.ParseDenomTrace(data.Denom)
+	trace := types.ParseDenomTrace(fullDenomPath)
 
 	// parse the transfer amount
-	transferAmount, ok := sdk.NewIntFromString(data.Amount)
+	transferAmount, ok := sdk.NewIntFromString(amount)
 	if !ok {
-		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
+		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", amount)
 	}
 	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
 
-	// decode the sender address
-	sender, err := sdk.AccAddressFromBech32(data.Sender)
-	if err != nil {
-		return err
-	}
+	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), fullDenomPath) {
+		if trace.Path == "" {
+			token.Denom = k.escrowedDenom(ctx, token.Denom)
+		}
 
-	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
 		// unescrow tokens back to sender
-		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+		escrowAddress := k.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
 		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, sender, sdk.NewCoins(token)); err != nil {
 			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
 			// counterparty module. The bug may occur in bank or any part of the code that allows
@@ -365,6 +581,9 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
 		}
 
+		// track the total amount in escrow keyed by denomination to allow for efficient iteration
+		k.unescrowToken(ctx, token)
+
 		return nil
 	}
 
@@ -401,3 +620,67 @@ func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error)
 	fullDenomPath := denomTrace.GetFullDenomPath()
 	return fullDenomPath, nil
 }
+
+// ReceivedDenomTrace computes the outcome of transferring the provided denomination over the
+// provided source port and channel without modifying state. It returns the denomination trace of
+// the tokens received on the destination chain, whether the receiving chain is the source of the
+// tokens, in which case the denomination is unwound rather than prefixed, and the escrow address
+// holding the tokens. The escrow address of the receiving chain is derived with types.GetEscrowAddress.
+func (k Keeper) ReceivedDenomTrace(ctx sdk.Context, sourcePort, sourceChannel, denom string) (types.DenomTrace, bool, sdk.AccAddress, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return types.DenomTrace{}, false, nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	fullDenomPath := denom
+	if strings.HasPrefix(denom, "ibc/") {
+		var err error
+		fullDenomPath, err = k.DenomPathFromHash(ctx, denom)
+		if err != nil {
+			return types.DenomTrace{}, false, nil, err
+		}
+	}
+
+	destinationPort := channel.GetCounterparty().GetPortID()
+	destinationChannel := channel.GetCounterparty().GetChannelID()
+
+	if types.ReceiverChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
+		// remove prefix added by sender chain, the tokens are unescrowed on the receiving chain
+		voucherPrefix := types.GetDenomPrefix(sourcePort, sourceChannel)
+		denomTrace := types.ParseDenomTrace(fullDenomPath[len(voucherPrefix):])
+
+		// the escrow address function of the keeper only applies to the channels of this chain
+		return denomTrace, true, types.GetEscrowAddress(destinationPort, destinationChannel), nil
+	}
+
+	// the tokens are escrowed on the sending chain and vouchers are minted on the receiving chain
+	denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(destinationPort, destinationChannel, fullDenomPath))
+
+	return denomTrace, false, k.GetEscrowAddress(sourcePort, sourceChannel), nil
+}
+
+// setTimedOutTransfer stores the transfer of the provided timed out packet. The tokens are stored in
+// their denomination on this chain, as refunded to the sender. The transfer may be retried until the
+// retry period elapsed.
+func (k Keeper) setTimedOutTransfer(ctx sdk.Context, packet channeltypes.Packet, sender, receiver string, tokens []types.Token, memo string) {
+	coins := make(sdk.Coins, len(tokens))
+	for i, token := range tokens {
+		// the amount was already parsed when the tokens were refunded
+		amount, _ := sdk.NewIntFromString(token.Amount)
+
+		trace := types.ParseDenomTrace(token.Denom)
+		denom := trace.IBCDenom()
+		if trace.Path == "" {
+			denom = k.escrowedDenom(ctx, denom)
+		}
+		coins[i] = sdk.NewCoin(denom, amount)
+	}
+
+	k.SetTimedOutTransfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), types.TimedOutTransfer{
+		Sender:          sender,
+		Receiver:        receiver,
+		Tokens:          coins,
+		Memo:            memo,
+		ExpiryTimestamp: uint64(ctx.BlockTime().Add(types.TimedOutTransferRetryPeriod).UnixNano()),
+	})
+}
@@ -101,6 +101,20 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				timeoutHeight = clienttypes.ZeroHeight()
 			}, false,
 		},
+		{
+			"memo exceeds the maximum memo length",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, 4, nil))
+				memo = "memos"
+			}, false,
+		},
+		{
+			"success: maximum memo length of zero disables the limit",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, 0, nil))
+				memo = "memos"
+			}, true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -136,11 +150,22 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				memo,
 			)
 
+			totalEscrowBefore := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), coin.GetDenom())
+
 			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
+
+				totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), coin.GetDenom())
+
+				// the total escrow only increases when chainA is the source of the sent token
+				if coin.GetDenom() == sdk.DefaultBondDenom {
+					suite.Require().Equal(totalEscrowBefore.Add(coin), totalEscrow)
+				} else {
+					suite.Require().Equal(totalEscrowBefore, totalEscrow)
+				}
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Nil(res)
@@ -159,6 +184,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		amount   math.Int
 		receiver string
 		memo     string
+		relayer  sdk.AccAddress
 	)
 
 	testCases := []struct {
@@ -175,6 +201,12 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{"success receive with coin from another chain as source with memo", func() {
 			memo = "memo"
 		}, false, true},
+		{"success receive on source chain with relayer fee", func() {
+			memo = `{"relayer_fee":{"amount":"10"}}`
+		}, true, true},
+		{"success receive with coin from another chain as source with relayer fee", func() {
+			memo = `{"relayer_fee":{"amount":"10"}}`
+		}, false, true},
 		{"empty coin", func() {
 			trace = types.DenomTrace{}
 			amount = sdk.ZeroInt()
@@ -203,6 +235,28 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{"failure: receive on module account on source chain", func() {
 			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
 		}, true, false},
+
+		// - relayer fee
+		{"failure: invalid relayer fee", func() {
+			memo = `{"relayer_fee":{"amount":"0"}}`
+		}, false, false},
+		{"failure: relayer fee equals transfer amount", func() {
+			memo = `{"relayer_fee":{"amount":"100"}}`
+		}, false, false},
+		{"failure: relayer fee exceeds transfer amount on source chain", func() {
+			memo = `{"relayer_fee":{"amount":"101"}}`
+		}, true, false},
+		{"failure: relayer fee combined with forward metadata", func() {
+			memo = fmt.Sprintf(`{"relayer_fee":{"amount":"10"},"forward":{"receiver":"%s","port":"transfer","channel":"channel-0"}}`, receiver)
+		}, false, false},
+		{"failure: empty relayer address", func() {
+			memo = `{"relayer_fee":{"amount":"10"}}`
+			relayer = sdk.AccAddress{}
+		}, false, false},
+		{"failure: relayer is a module account", func() {
+			memo = `{"relayer_fee":{"amount":"10"}}`
+			relayer = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+		}, true, false},
 	}
 
 	for _, tc := range testCases {
@@ -214,6 +268,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			path := NewTransferPath(suite.chainA, suite.chainB)
 			suite.coordinator.Setup(path)
 			receiver = suite.chainB.SenderAccount.GetAddress().String() // must be explicitly changed in malleate
+			relayer = suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
 
 			memo = ""                // can be explicitly changed in malleate
 			amount = sdk.NewInt(100) // must be explicitly changed in malleate
@@ -250,10 +305,14 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, memo)
 			packet := channeltypes.NewPacket(data.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 
-			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data, relayer)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				// the tokens sent from chainB to chainA have been returned, so nothing remains in escrow on chainB
+				totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom)
+				suite.Require().True(totalEscrow.IsZero())
 			} else {
 				suite.Require().Error(err)
 			}
@@ -261,6 +320,295 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+// TestOnRecvPacketReceiveDenomBlocklist tests that tokens whose full denomination path on the
+// receiving chain is on the receive denom blocklist are rejected, and that disabled receives are
+// rejected before the blocklist is checked.
+func (suite *KeeperTestSuite) TestOnRecvPacketReceiveDenomBlocklist() {
+	var (
+		path      *ibctesting.Path
+		blocklist []string
+	)
+
+	testCases := []struct {
+		msg            string
+		malleate       func()
+		receiveEnabled bool
+		expErr         error
+	}{
+		{
+			"success: empty blocklist",
+			func() {},
+			true, nil,
+		},
+		{
+			"success: denom blocklisted over another channel",
+			func() {
+				blocklist = []string{types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, "channel-100", sdk.DefaultBondDenom)}
+			},
+			true, nil,
+		},
+		{
+			"success: base denom blocklisted",
+			func() {
+				blocklist = []string{sdk.DefaultBondDenom}
+			},
+			true, nil,
+		},
+		{
+			"failure: denom blocklisted over the receiving channel",
+			func() {
+				blocklist = []string{"gamm/pool/1", types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)}
+			},
+			true, types.ErrReceiveDenomBlocked,
+		},
+		{
+			"failure: receive disabled takes precedence over the blocklist",
+			func() {
+				blocklist = []string{types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)}
+			},
+			false, types.ErrReceiveDisabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			blocklist = nil // can be explicitly changed in malleate
+
+			tc.malleate()
+
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, tc.receiveEnabled, types.DefaultMaxMemoLength, blocklist))
+
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data, suite.chainB.SenderAccounts[1].SenderAccount.GetAddress())
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestOnRecvPacketReceiveOnlyChannel tests that tokens continue to be received over a receive only
+// channel while outbound transfers over the channel are rejected.
+func (suite *KeeperTestSuite) TestOnRecvPacketReceiveOnlyChannel() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	suite.chainB.GetSimApp().TransferKeeper.SetChannelReceiveOnly(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+	data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+	err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data, suite.chainB.SenderAccounts[1].SenderAccount.GetAddress())
+	suite.Require().NoError(err)
+
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	msg := types.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		sdk.NewCoin(voucherDenom, sdk.NewInt(100)), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainA.GetTimeoutHeight(), 0, "",
+	)
+
+	res, err := suite.chainB.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+	suite.Require().ErrorIs(err, types.ErrReceiveOnlyChannel)
+	suite.Require().Nil(res)
+}
+
+// TestOnRecvPacketDenomTransferEnabled tests that the receive flag of the resulting denomination path,
+// falling back to the flag of its base denomination and then to the ReceiveEnabled param, determines
+// whether the tokens are received.
+func (suite *KeeperTestSuite) TestOnRecvPacketDenomTransferEnabled() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no override",
+			func() {},
+			nil,
+		},
+		{
+			"success: receive disabled by the params, but enabled for the denomination path",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false, types.DefaultMaxMemoLength, nil))
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainB.GetContext(), types.DenomTransferEnabled{
+					Denom:          types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+					ReceiveEnabled: types.NewTransferEnabledFlag(true),
+				})
+			},
+			nil,
+		},
+		{
+			"success: receive disabled by the params, but enabled for the base denomination",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false, types.DefaultMaxMemoLength, nil))
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainB.GetContext(), types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, ReceiveEnabled: types.NewTransferEnabledFlag(true)})
+			},
+			nil,
+		},
+		{
+			"failure: receive disabled by the params and receive flag not set for the denomination",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false, types.DefaultMaxMemoLength, nil))
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainB.GetContext(), types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, SendEnabled: types.NewTransferEnabledFlag(true)})
+			},
+			types.ErrReceiveDisabled,
+		},
+		{
+			"success: receive flag not set for the base denomination",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainB.GetContext(), types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, SendEnabled: types.NewTransferEnabledFlag(false)})
+			},
+			nil,
+		},
+		{
+			"failure: receive disabled for the base denomination",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainB.GetContext(), types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, ReceiveEnabled: types.NewTransferEnabledFlag(false)})
+			},
+			types.ErrReceiveDisabled,
+		},
+		{
+			"failure: receive flag not set for the denomination path falls through to the base denomination",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainB.GetContext(), types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, ReceiveEnabled: types.NewTransferEnabledFlag(false)})
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainB.GetContext(), types.DenomTransferEnabled{
+					Denom:       types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+					SendEnabled: types.NewTransferEnabledFlag(true),
+				})
+			},
+			types.ErrReceiveDisabled,
+		},
+		{
+			"failure: receive enabled for the base denomination, but disabled for the denomination path",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainB.GetContext(), types.DenomTransferEnabled{Denom: sdk.DefaultBondDenom, ReceiveEnabled: types.NewTransferEnabledFlag(true)})
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTransferEnabledOverride(suite.chainB.GetContext(), types.DenomTransferEnabled{
+					Denom:          types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+					ReceiveEnabled: types.NewTransferEnabledFlag(false),
+				})
+			},
+			types.ErrReceiveDisabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data, suite.chainB.SenderAccounts[1].SenderAccount.GetAddress())
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestOnRecvPacketRelayerFee tests that the relayer fee requested by the packet memo is deducted
+// from the tokens sent to the receiver and sent to the relayer, both when vouchers are minted and
+// when tokens are unescrowed.
+func (suite *KeeperTestSuite) TestOnRecvPacketRelayerFee() {
+	testCases := []struct {
+		msg          string
+		recvIsSource bool
+	}{
+		{"mint vouchers", false},
+		{"unescrow tokens", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			receiver := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+			relayer := suite.chainB.SenderAccounts[2].SenderAccount.GetAddress()
+			amount := sdk.NewInt(100)
+			seq := uint64(1)
+
+			var trace, recvTrace types.DenomTrace
+			if tc.recvIsSource {
+				// escrow the tokens on chainB which are sent back by chainA
+				coinFromBToA := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 110), 0, "")
+				res, err := suite.chainB.SendMsgs(transferMsg)
+				suite.Require().NoError(err)
+
+				packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+
+				err = path.RelayPacket(packet)
+				suite.Require().NoError(err)
+
+				seq++
+
+				trace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+				recvTrace = types.ParseDenomTrace(sdk.DefaultBondDenom)
+			} else {
+				trace = types.ParseDenomTrace(sdk.DefaultBondDenom)
+				recvTrace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+			}
+
+			bankKeeper := suite.chainB.GetSimApp().BankKeeper
+			receiverBalance := bankKeeper.GetBalance(suite.chainB.GetContext(), receiver, recvTrace.IBCDenom())
+			relayerBalance := bankKeeper.GetBalance(suite.chainB.GetContext(), relayer, recvTrace.IBCDenom())
+
+			memo := `{"relayer_fee":{"amount":"10"}}`
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver.String(), memo)
+			packet := channeltypes.NewPacket(data.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			ctx := suite.chainB.GetContext()
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(ctx, packet, data, relayer)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(receiverBalance.AddAmount(sdk.NewInt(90)), bankKeeper.GetBalance(ctx, receiver, recvTrace.IBCDenom()))
+			suite.Require().Equal(relayerBalance.AddAmount(sdk.NewInt(10)), bankKeeper.GetBalance(ctx, relayer, recvTrace.IBCDenom()))
+
+			// the full amount is unescrowed
+			totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom)
+			suite.Require().True(totalEscrow.IsZero())
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeRelayerFee {
+					found = true
+				}
+			}
+			suite.Require().True(found)
+		})
+	}
+}
+
 // TestOnAcknowledgementPacket tests that successful acknowledgement is a no-op
 // and failure acknowledment leads to refund when attempting to send from chainA
 // to chainB. If sender is source than the denomination being refunded has no
@@ -290,6 +638,8 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
 
 			suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			// set escrow amount that would have been stored after successful execution of MsgTransfer
+			suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 		}, false, true},
 		{
 			"unsuccessful refund from source", failedAck,
@@ -337,6 +687,10 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 					suite.Require().Equal(amount, deltaAmount, "failed ack did not trigger refund")
 				}
 
+				// check total amount in escrow of sent token denom has been released on refund
+				totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom())
+				suite.Require().True(totalEscrow.IsZero())
+
 			} else {
 				suite.Require().Error(err)
 			}
@@ -369,6 +723,8 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 				coin := sdk.NewCoin(trace.IBCDenom(), amount)
 
 				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+				// set escrow amount that would have been stored after successful execution of MsgTransfer
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 			}, true,
 		},
 		{
@@ -429,9 +785,67 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().Equal(amount.Int64(), deltaAmount.Int64(), "successful timeout did not trigger refund")
+
+				// check total amount in escrow of sent token denom has been released on refund
+				totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom())
+				suite.Require().True(totalEscrow.IsZero())
 			} else {
 				suite.Require().Error(err)
 			}
 		})
 	}
 }
+
+// TestCompressedTransfer tests that the packet data is only compressed over channels negotiating the
+// compression of the packet data and that compressed packets are received and acknowledged.
+func (suite *KeeperTestSuite) TestCompressedTransfer() {
+	testCases := []struct {
+		msg           string
+		version       string
+		expCompressed bool
+	}{
+		{"single token version", types.Version, false},
+		{"compressed single token version", types.CompressedVersion(types.Version), true},
+		{"compressed multi token version", types.CompressedVersion(types.V2), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = tc.version
+			path.EndpointB.ChannelConfig.Version = tc.version
+			suite.coordinator.Setup(path)
+
+			amount := sdk.NewInt(100)
+			memo := fmt.Sprintf("%0256d", 0)
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, memo)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expCompressed, types.IsCompressedPacketData(packet.GetData()))
+
+			packetData, err := types.DecompressPacketData(sdk.NewInfiniteGasMeter(), packet.GetData())
+			suite.Require().NoError(err)
+
+			packetMemo, err := types.DecodePacketMemo(packetData)
+			suite.Require().NoError(err)
+			suite.Require().Equal(memo, packetMemo)
+
+			if tc.expCompressed {
+				suite.Require().Less(len(packet.GetData()), len(packetData))
+			}
+
+			suite.Require().NoError(path.RelayPacket(packet))
+
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			voucher := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom)
+			suite.Require().Equal(amount, voucher.Amount)
+		})
+	}
+}
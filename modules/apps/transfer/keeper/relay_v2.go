@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	coretypes "github.com/cosmos/ibc-go/v6/modules/core/types"
+)
+
+// OnRecvPacketV2 processes a cross chain multi token transfer. Each token is received as in
+// OnRecvPacket: vouchers are minted for tokens whose source is the sender chain and all other
+// tokens are unescrowed. If any token cannot be received an error is returned, in which case the
+// state changes made for the other tokens are discarded by the core IBC handler and the error
+// acknowledgement refunds all tokens to the sender. Packet forwarding and relayer fees are not
+// supported for multi token transfers.
+func (k Keeper) OnRecvPacketV2(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, token := range data.Tokens {
+		if fullDenomPath := receivedDenomPath(packet, token.Denom); !k.IsReceiveEnabledDenom(ctx, fullDenomPath) {
+			return sdkerrors.Wrapf(types.ErrReceiveDisabled, "transfers of %s are disabled", fullDenomPath)
+		}
+
+		if err := k.validateReceiveDenom(ctx, packet, token.Denom); err != nil {
+			return err
+		}
+	}
+
+	if forward, err := types.ParseForwardMetadata(data.Memo); err != nil || forward != nil {
+		return sdkerrors.Wrap(types.ErrInvalidForwardMetadata, "packet forwarding is not supported for multi token transfers")
+	}
+
+	if relayerFee, err := types.ParseRelayerFeeMetadata(data.Memo); err != nil || relayerFee != nil {
+		return sdkerrors.Wrap(types.ErrInvalidRelayerFee, "relayer fees are not supported for multi token transfers")
+	}
+
+	// decode the receiver address
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+	}
+
+	for _, token := range data.Tokens {
+		if err := k.receiveToken(ctx, packet, token, receiver, data.Memo); err != nil {
+			return sdkerrors.Wrapf(err, "failed to receive token %s", token.Denom)
+		}
+	}
+
+	return nil
+}
+
+// receiveToken unescrows the provided token to the receiver if the receiving chain is the source
+// of the token and mints vouchers to the receiver otherwise. The memo of the packet is passed to
+// the denomination metadata provider.
+func (k Keeper) receiveToken(ctx sdk.Context, packet channeltypes.Packet, token types.Token, receiver sdk.AccAddress, memo string) error {
+	// parse the transfer amount
+	transferAmount, ok := sdk.NewIntFromString(token.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+	}
+
+	labels := []metrics.Label{
+		telemetry.NewLabel(coretypes.LabelSourcePort, packet.GetSourcePort()),
+		telemetry.NewLabel(coretypes.LabelSourceChannel, packet.GetSourceChannel()),
+	}
+
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
+		// sender chain is not the source, unescrow tokens
+
+		// remove prefix added by sender chain
+		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := token.Denom[len(voucherPrefix):]
+
+		// coin denomination used in sending from the escrow address
+		denom := unprefixedDenom
+
+		// The denomination used to send the coins is either the native denom or the hash of the path
+		// if the denomination is not native.
+		denomTrace := types.ParseDenomTrace(unprefixedDenom)
+		if denomTrace.Path != "" {
+			denom = denomTrace.IBCDenom()
+		} else {
+			denom = k.escrowedDenom(ctx, denom)
+		}
+		coin := sdk.NewCoin(denom, transferAmount)
+
+		// unescrow tokens
+		escrowAddress := k.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(coin)); err != nil {
+			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
+			// counterparty module. The bug may occur in bank or any part of the code that allows
+			// the escrow address to be drained. A malicious counterparty module could drain the
+			// escrow address by allowing more tokens to be sent back then were escrowed.
+			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+		}
+
+		// track the total amount in escrow keyed by denomination to allow for efficient iteration
+		k.unescrowToken(ctx, coin)
+
+		defer func() {
+			if transferAmount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"ibc", types.ModuleName, "packet", "receive"},
+					float32(transferAmount.Int64()),
+					[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, unprefixedDenom)},
+				)
+			}
+
+			telemetry.IncrCounterWithLabels(
+				[]string{"ibc", types.ModuleName, "receive"},
+				1,
+				append(
+					labels, telemetry.NewLabel(coretypes.LabelSource, "true"),
+				),
+			)
+		}()
+
+		return nil
+	}
+
+	// sender chain is the source, mint vouchers
+
+	// since SendPacket did not prefix the denomination, we must prefix denomination here
+	sourcePrefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	// NOTE: sourcePrefix contains the trailing "/"
+	prefixedDenom := sourcePrefix + token.Denom
+
+	// construct the denomination trace from the full raw denomination
+	denomTrace := types.ParseDenomTrace(prefixedDenom)
+
+	traceHash := denomTrace.Hash()
+	if !k.HasDenomTrace(ctx, traceHash) {
+		k.SetDenomTrace(ctx, denomTrace)
+		k.registerDenomMetadata(ctx, denomTrace, memo)
+	}
+
+	voucherDenom := denomTrace.IBCDenom()
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDenomTrace,
+			sdk.NewAttribute(types.AttributeKeyTraceHash, traceHash.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, voucherDenom),
+		),
+	)
+	voucher := sdk.NewCoin(voucherDenom, transferAmount)
+
+	// mint new tokens if the source of the transfer is the same chain
+	if err := k.bankKeeper.MintCoins(
+		ctx, types.ModuleName, sdk.NewCoins(voucher),
+	); err != nil {
+		return err
+	}
+
+	// send to receiver
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.ModuleName, receiver, sdk.NewCoins(voucher),
+	); err != nil {
+		return err
+	}
+
+	defer func() {
+		if transferAmount.IsInt64() {
+			telemetry.SetGaugeWithLabels(
+				[]string{"ibc", types.ModuleName, "packet", "receive"},
+				float32(transferAmount.Int64()),
+				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, token.Denom)},
+			)
+		}
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", types.ModuleName, "receive"},
+			1,
+			append(
+				labels, telemetry.NewLabel(coretypes.LabelSource, "false"),
+			),
+		)
+	}()
+
+	return nil
+}
+
+// OnAcknowledgementPacketV2 responds to the the success or failure of a multi token packet
+// acknowledgement written on the receiving chain. If the acknowledgement failed, all tokens
+// of the packet are refunded to the sender.
+func (k Keeper) OnAcknowledgementPacketV2(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacketTokens(ctx, packet, data)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be executed and no error needs to be returned
+		return nil
+	}
+}
+
+// OnTimeoutPacketV2 refunds all tokens of a multi token packet to the sender since the packet
+// was never received and has been timed out. The transfer is stored so that the sender may
+// retry it.
+func (k Keeper) OnTimeoutPacketV2(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+	if err := k.refundPacketTokens(ctx, packet, data); err != nil {
+		return err
+	}
+
+	k.setTimedOutTransfer(ctx, packet, data.Sender, data.Receiver, data.Tokens, data.Memo)
+
+	return nil
+}
+
+// refundPacketTokens refunds each token of the multi token packet to the sender using the
+// refundToken function.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+	// decode the sender address
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	for _, token := range data.Tokens {
+		if err := k.refundToken(ctx, packet, token.Denom, token.Amount, sender); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
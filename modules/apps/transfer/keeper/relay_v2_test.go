@@ -0,0 +1,296 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+// NewTransferPathV2 returns a transfer path negotiating the multi token transfer version.
+func NewTransferPathV2(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := NewTransferPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.Version = types.V2
+	path.EndpointB.ChannelConfig.Version = types.V2
+
+	return path
+}
+
+// TestMultiTokenTransfer tests sending multiple tokens from chainA to chainB in a single packet.
+// The tokens consist of the native denomination of chainA, which is escrowed on chainA and minted
+// on chainB, and vouchers of the native denomination of chainB, which are burned on chainA and
+// unescrowed on chainB. If any token cannot be received on chainB, none of them are and all tokens
+// are refunded to the sender.
+func (suite *KeeperTestSuite) TestMultiTokenTransfer() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expTimeout bool
+		expPass    bool
+	}{
+		{
+			"success",
+			func() {},
+			false, true,
+		},
+		{
+			"failure: tokens cannot be unescrowed on chainB",
+			func() {
+				// drain the escrow account of chainB holding the tokens sent to chainA
+				escrowAddress := types.GetEscrowAddress(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				balances := suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), escrowAddress)
+				err := suite.chainB.GetSimApp().BankKeeper.SendCoins(suite.chainB.GetContext(), escrowAddress, suite.chainB.SenderAccounts[1].SenderAccount.GetAddress(), balances)
+				suite.Require().NoError(err)
+			},
+			false, false,
+		},
+		{
+			"failure: receive disabled on chainB",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false, types.DefaultMaxMemoLength, nil))
+			},
+			false, false,
+		},
+		{
+			"failure: packet times out",
+			func() {},
+			true, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPathV2(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			amount := sdk.NewInt(100)
+			senderA := suite.chainA.SenderAccount.GetAddress()
+			senderB := suite.chainB.SenderAccount.GetAddress()
+
+			// send the native denomination of chainB to chainA as a single token packet
+			msg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount), senderB.String(), senderA.String(), suite.chainA.GetTimeoutHeight(), 0, "")
+			res, err := suite.chainB.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			var packetData types.FungibleTokenPacketDataV2
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+			suite.Require().Equal([]types.Token{{Denom: sdk.DefaultBondDenom, Amount: amount.String()}}, packetData.Tokens)
+
+			suite.Require().NoError(path.RelayPacket(packet))
+
+			voucherDenomOnA := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			voucherDenomOnB := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+			tokens := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, amount), sdk.NewCoin(voucherDenomOnA, amount)}
+			preBalances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), senderA)
+			preBalanceB := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), senderB, sdk.DefaultBondDenom)
+
+			tc.malleate()
+
+			timeoutHeight := suite.chainB.GetTimeoutHeight()
+			if tc.expTimeout {
+				timeoutHeight = clienttypes.NewHeight(clienttypes.ParseChainID(suite.chainB.ChainID), uint64(suite.chainB.GetContext().BlockHeight())+1)
+			}
+
+			// send both tokens from chainA to chainB in a single packet
+			msg = types.NewMsgMultiTokenTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, tokens, senderA.String(), senderB.String(), timeoutHeight, 0, "")
+			res, err = suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err) // message committed
+
+			packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			// the vouchers are burned and the native tokens are escrowed on chainA
+			suite.Require().Equal(preBalances.Sub(tokens...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), senderA))
+
+			if tc.expTimeout {
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+			} else {
+				suite.Require().NoError(path.EndpointB.UpdateClient())
+				res, err = path.EndpointB.RecvPacketWithResult(packet)
+				suite.Require().NoError(err)
+
+				ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ack))
+			}
+
+			postBalances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), senderA)
+			postBalanceB := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), senderB, sdk.DefaultBondDenom)
+			voucherOnB := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), senderB, voucherDenomOnB)
+
+			if tc.expPass {
+				suite.Require().Equal(preBalances.Sub(tokens...), postBalances)
+				suite.Require().Equal(preBalanceB.Add(sdk.NewCoin(sdk.DefaultBondDenom, amount)), postBalanceB)
+				suite.Require().Equal(amount, voucherOnB.Amount)
+
+				totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+				suite.Require().Equal(amount, totalEscrow.Amount)
+			} else {
+				// all tokens are refunded to the sender and none are received on chainB
+				suite.Require().Equal(preBalances, postBalances)
+				suite.Require().Equal(preBalanceB, postBalanceB)
+				suite.Require().True(voucherOnB.IsZero())
+
+				supply := suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenomOnB)
+				suite.Require().True(supply.IsZero())
+			}
+		})
+	}
+}
+
+// TestMultiTokenTransferVersion tests that multiple tokens can only be sent over channels
+// negotiating the multi token transfer version.
+func (suite *KeeperTestSuite) TestMultiTokenTransferVersion() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: multi token version",
+			func() {
+				path = NewTransferPathV2(suite.chainA, suite.chainB)
+			},
+			true,
+		},
+		{
+			"single token version",
+			func() {
+				path = NewTransferPath(suite.chainA, suite.chainB)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			suite.coordinator.Setup(path)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			secondCoin := sdk.NewCoin("atom", sdk.NewInt(100))
+
+			err := suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), types.ModuleName, sdk.NewCoins(secondCoin))
+			suite.Require().NoError(err)
+			err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, sender, sdk.NewCoins(secondCoin))
+			suite.Require().NoError(err)
+
+			msg := types.NewMsgMultiTokenTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoins(coin, secondCoin), sender.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+			_, err = suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidVersion)
+			}
+		})
+	}
+}
+
+// TestOnRecvPacketV2 tests the validation of multi token packets upon receipt.
+func (suite *KeeperTestSuite) TestOnRecvPacketV2() {
+	var (
+		path       *ibctesting.Path
+		packetData types.FungibleTokenPacketDataV2
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty tokens",
+			func() {
+				packetData.Tokens = nil
+			},
+			false,
+		},
+		{
+			"invalid receiver address",
+			func() {
+				packetData.Receiver = "invalid address"
+			},
+			false,
+		},
+		{
+			"forward metadata not supported",
+			func() {
+				packetData.Memo = `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-1"}}`
+			},
+			false,
+		},
+		{
+			"relayer fee not supported",
+			func() {
+				packetData.Memo = `{"relayer_fee":{"amount":"1"}}`
+			},
+			false,
+		},
+		{
+			"denom blocklisted",
+			func() {
+				blocklist := []string{types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, "atom")}
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, types.DefaultMaxMemoLength, blocklist))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPathV2(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packetData = types.NewFungibleTokenPacketDataV2(
+				[]types.Token{{Denom: sdk.DefaultBondDenom, Amount: "100"}, {Denom: "atom", Amount: "50"}},
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "",
+			)
+
+			tc.malleate()
+
+			packet := channeltypes.NewPacket(packetData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacketV2(suite.chainB.GetContext(), packet, packetData)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+// validateRelayerFee ensures the relayer fee requested by the provided packet memo may be paid to
+// the relayer delivering the packet. The relayer fee must be less than the transferred amount and
+// may not be combined with forward metadata, since a refund of the forwarded packet would leave
+// the vouchers paid to the relayer unbacked.
+func (k Keeper) validateRelayerFee(relayerFee *types.RelayerFeeMetadata, forward *types.ForwardMetadata, transferAmount sdk.Int, relayer sdk.AccAddress) error {
+	if forward != nil {
+		return sdkerrors.Wrap(types.ErrInvalidRelayerFee, "relayer fee cannot be combined with forward metadata")
+	}
+
+	if relayer.Empty() {
+		return sdkerrors.Wrap(types.ErrInvalidRelayerFee, "relayer address cannot be empty")
+	}
+
+	if k.bankKeeper.BlockedAddr(relayer) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", relayer)
+	}
+
+	if relayerFee.GetAmount().GTE(transferAmount) {
+		return sdkerrors.Wrapf(types.ErrInvalidRelayerFee, "relayer fee (%s) must be less than the transfer amount (%s)", relayerFee.Amount, transferAmount)
+	}
+
+	return nil
+}
+
+// splitRelayerFee returns the tokens to be sent to the receiver and to the relayer respectively.
+// The relayer fee is zero if no relayer fee metadata is provided.
+func splitRelayerFee(token sdk.Coin, relayerFee *types.RelayerFeeMetadata) (sdk.Coin, sdk.Coin) {
+	if relayerFee == nil {
+		return token, sdk.NewCoin(token.Denom, sdk.ZeroInt())
+	}
+
+	fee := sdk.NewCoin(token.Denom, relayerFee.GetAmount())
+	return token.Sub(fee), fee
+}
+
+// emitRelayerFeeEvent emits an event for the relayer fee paid to the relayer delivering the packet.
+func emitRelayerFeeEvent(ctx sdk.Context, relayer sdk.AccAddress, fee sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRelayerFee,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, fee.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, fee.Amount.String()),
+		),
+	)
+}
@@ -0,0 +1,22 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for the ICS20 rate limit middleware
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "ratelimit",
+		Short:                      "IBC fungible token transfer rate limit query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdRateLimit(),
+		GetCmdRateLimits(),
+	)
+
+	return queryCmd
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+)
+
+// GetCmdRateLimit returns the command handler for the rate limit of a denomination over a channel
+func GetCmdRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit [channel-id] [denom]",
+		Short:   "Query the rate limit of a denomination over a channel",
+		Long:    "Query the quota and the current flow of the rate limit of a denomination over a channel.",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ratelimit rate-limit channel-0 stake", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitRequest{
+				ChannelId: args[0],
+				Denom:     args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimit(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRateLimits returns the command handler for all the rate limits
+func GetCmdRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits",
+		Short:   "Query all the rate limits",
+		Long:    "Query the quota and the current flow of all the rate limits.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ratelimit rate-limits", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsRequest{
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate limits")
+
+	return cmd
+}
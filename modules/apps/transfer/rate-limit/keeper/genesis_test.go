@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+func (suite *KeeperTestSuite) TestInitExportGenesis() {
+	blockTime := suite.chainA.GetContext().BlockTime()
+
+	rateLimit := types.NewRateLimit(
+		ibctesting.FirstChannelID, sdk.DefaultBondDenom, types.NewQuota(10, 20, time.Hour),
+		types.NewFlow(sdk.NewInt(1000), blockTime),
+	)
+	rateLimit.Flow.Outflow = sdk.NewInt(100)
+
+	genesisState := types.NewGenesisState(
+		[]types.RateLimit{rateLimit},
+		[]types.PendingSendPacket{
+			{
+				ChannelId:   ibctesting.FirstChannelID,
+				Sequence:    1,
+				WindowStart: blockTime,
+			},
+		},
+	)
+
+	suite.chainA.GetSimApp().RateLimitKeeper.InitGenesis(suite.chainA.GetContext(), *genesisState)
+
+	exportedGenesis := suite.chainA.GetSimApp().RateLimitKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal(genesisState, exportedGenesis)
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (k Keeper) RateLimit(goCtx context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	denom := strings.TrimSpace(req.Denom)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rateLimit, found := k.GetRateLimit(ctx, req.ChannelId, denom)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", denom, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit: rateLimit,
+	}, nil
+}
+
+// RateLimits implements the Query/RateLimits gRPC method
+func (k Keeper) RateLimits(goCtx context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var rateLimits []types.RateLimit
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RateLimitKeyPrefix))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var (
+		req          *types.QueryRateLimitRequest
+		expRateLimit types.RateLimit
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: ibc denom",
+			func() {
+				denom := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+				expRateLimit.Denom = denom
+				suite.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(suite.chainA.GetContext(), expRateLimit)
+
+				req.Denom = denom
+			},
+			true,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid denom",
+			func() {
+				req.Denom = "!@#!@$#"
+			},
+			false,
+		},
+		{
+			"rate limit not found",
+			func() {
+				req.ChannelId = "channel-1"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			expRateLimit = types.NewRateLimit(
+				ibctesting.FirstChannelID, sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour),
+				types.NewFlow(sdk.NewInt(1000), suite.chainA.GetContext().BlockTime()),
+			)
+			suite.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(suite.chainA.GetContext(), expRateLimit)
+
+			req = &types.QueryRateLimitRequest{
+				ChannelId: expRateLimit.ChannelId,
+				Denom:     expRateLimit.Denom,
+			}
+
+			tc.malleate()
+
+			res, err := suite.queryClient.RateLimit(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRateLimit, res.RateLimit)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	var (
+		req           *types.QueryRateLimitsRequest
+		expRateLimits []types.RateLimit
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit:      2,
+					CountTotal: false,
+				}
+
+				expRateLimits = expRateLimits[:2]
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			expRateLimits = nil
+			for _, channelID := range []string{"channel-0", "channel-1", "channel-2"} {
+				rateLimit := types.NewRateLimit(
+					channelID, sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour),
+					types.NewFlow(sdk.NewInt(1000), suite.chainA.GetContext().BlockTime()),
+				)
+
+				suite.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+				expRateLimits = append(expRateLimits, rateLimit)
+			}
+
+			req = &types.QueryRateLimitsRequest{}
+
+			tc.malleate()
+
+			res, err := suite.queryClient.RateLimits(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRateLimits, res.RateLimits)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
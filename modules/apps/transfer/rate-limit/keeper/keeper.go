@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// Middleware must implement the ICS4Wrapper interface so that it can wrap the
+// IBC channel logic of the underlying transfer application.
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// Keeper defines the ICS20 rate limit keeper
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper porttypes.ICS4Wrapper
+	bankKeeper  types.BankKeeper
+
+	// the address capable of executing a MsgSetRateLimit message. Typically, this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new ICS20 rate limit Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey,
+	ics4Wrapper porttypes.ICS4Wrapper, bankKeeper types.BankKeeper, authority string,
+) Keeper {
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    key,
+		ics4Wrapper: ics4Wrapper,
+		bankKeeper:  bankKeeper,
+		authority:   authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetAuthority returns the address capable of executing a MsgSetRateLimit message
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetRateLimit retrieves the rate limit of the given denomination over the given channel
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRateLimit(channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// SetRateLimit stores the rate limit keyed by its channel identifier and denomination
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.KeyRateLimit(rateLimit.ChannelId, rateLimit.Denom), bz)
+}
+
+// DeleteRateLimit removes the rate limit of the given denomination over the given channel
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRateLimit(channelID, denom))
+}
+
+// GetAllRateLimits returns all the rate limits stored in state
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.RateLimitKeyPrefix))
+	defer iterator.Close()
+
+	var rateLimits []types.RateLimit
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// GetPendingSendPacket retrieves the rate limited packet sent over the given channel with the given sequence
+// which is awaiting an acknowledgement or timeout
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPendingSendPacket(channelID, sequence))
+	if bz == nil {
+		return types.PendingSendPacket{}, false
+	}
+
+	var pendingPacket types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &pendingPacket)
+
+	return pendingPacket, true
+}
+
+// SetPendingSendPacket stores the pending send packet keyed by its channel identifier and sequence
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, pendingPacket types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pendingPacket)
+	store.Set(types.KeyPendingSendPacket(pendingPacket.ChannelId, pendingPacket.Sequence), bz)
+}
+
+// DeletePendingSendPacket removes the pending send packet sent over the given channel with the given sequence
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingSendPacket(channelID, sequence))
+}
+
+// GetAllPendingSendPackets returns all the pending send packets stored in state
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.PendingSendPacketKeyPrefix))
+	defer iterator.Close()
+
+	var pendingPackets []types.PendingSendPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingPacket types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingPacket)
+
+		pendingPackets = append(pendingPackets, pendingPacket)
+	}
+
+	return pendingPackets
+}
+
+// getActiveRateLimit retrieves the rate limit of the given denomination over the given channel. If the time
+// window of the rate limit has elapsed, the flow is reset and the channel value is set to the current supply
+// of the denomination. The previous channel value is kept if the supply is zero, as all transfers would
+// otherwise exceed the quota.
+func (k Keeper) getActiveRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return types.RateLimit{}, false
+	}
+
+	if rateLimit.IsWindowExpired(ctx.BlockTime()) {
+		channelValue := k.bankKeeper.GetSupply(ctx, denom).Amount
+		if channelValue.IsZero() {
+			channelValue = rateLimit.Flow.ChannelValue
+		}
+
+		rateLimit.Flow = types.NewFlow(channelValue, ctx.BlockTime())
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	return rateLimit, true
+}
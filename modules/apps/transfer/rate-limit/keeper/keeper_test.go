@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.path)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().RateLimitKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestGetAllRateLimits() {
+	var expRateLimits []types.RateLimit
+
+	ctx := suite.chainA.GetContext()
+	for _, channelID := range []string{"channel-0", "channel-1", "channel-2"} {
+		rateLimit := types.NewRateLimit(
+			channelID, sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour),
+			types.NewFlow(sdk.NewInt(1000), ctx.BlockTime()),
+		)
+
+		suite.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(ctx, rateLimit)
+		expRateLimits = append(expRateLimits, rateLimit)
+	}
+
+	rateLimits := suite.chainA.GetSimApp().RateLimitKeeper.GetAllRateLimits(ctx)
+	suite.Require().Len(rateLimits, len(expRateLimits))
+	suite.Require().Equal(expRateLimits, rateLimits)
+
+	suite.chainA.GetSimApp().RateLimitKeeper.DeleteRateLimit(ctx, "channel-1", sdk.DefaultBondDenom)
+
+	_, found := suite.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(ctx, "channel-1", sdk.DefaultBondDenom)
+	suite.Require().False(found)
+	suite.Require().Len(suite.chainA.GetSimApp().RateLimitKeeper.GetAllRateLimits(ctx), len(expRateLimits)-1)
+}
+
+func (suite *KeeperTestSuite) TestGetAllPendingSendPackets() {
+	var expPendingPackets []types.PendingSendPacket
+
+	ctx := suite.chainA.GetContext()
+	for seq := uint64(1); seq <= 3; seq++ {
+		pendingPacket := types.PendingSendPacket{
+			ChannelId:   ibctesting.FirstChannelID,
+			Sequence:    seq,
+			WindowStart: ctx.BlockTime(),
+		}
+
+		suite.chainA.GetSimApp().RateLimitKeeper.SetPendingSendPacket(ctx, pendingPacket)
+		expPendingPackets = append(expPendingPackets, pendingPacket)
+	}
+
+	pendingPackets := suite.chainA.GetSimApp().RateLimitKeeper.GetAllPendingSendPackets(ctx)
+	suite.Require().Len(pendingPackets, len(expPendingPackets))
+	suite.Require().Equal(expPendingPackets, pendingPackets)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ICS20 rate limit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// SetRateLimit defines a rpc handler method for MsgSetRateLimit. It sets the quota of the rate limit
+// of the denomination over the channel, starting a new time window. An empty quota removes the rate limit.
+func (s msgServer) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if s.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Quota.IsEmpty() {
+		if _, found := s.GetRateLimit(ctx, msg.ChannelId, msg.Denom); !found {
+			return nil, sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", msg.Denom, msg.ChannelId)
+		}
+
+		s.DeleteRateLimit(ctx, msg.ChannelId, msg.Denom)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRemoveRateLimit,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+				sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			),
+		)
+
+		return &types.MsgSetRateLimitResponse{}, nil
+	}
+
+	channelValue := s.bankKeeper.GetSupply(ctx, msg.Denom).Amount
+	if channelValue.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidQuota, "supply of denom %s is zero", msg.Denom)
+	}
+
+	rateLimit := types.NewRateLimit(msg.ChannelId, msg.Denom, msg.Quota, types.NewFlow(channelValue, ctx.BlockTime()))
+	s.Keeper.SetRateLimit(ctx, rateLimit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRateLimit,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentSend, strconv.FormatUint(msg.Quota.MaxPercentSend, 10)),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentRecv, strconv.FormatUint(msg.Quota.MaxPercentRecv, 10)),
+			sdk.NewAttribute(types.AttributeKeyDuration, msg.Quota.Duration.String()),
+			sdk.NewAttribute(types.AttributeKeyChannelValue, channelValue.String()),
+		),
+	)
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/keeper"
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+)
+
+func (suite *KeeperTestSuite) TestSetRateLimit() {
+	var msg *types.MsgSetRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+		expFound bool
+	}{
+		{
+			"success",
+			func() {},
+			true, true,
+		},
+		{
+			"success: existing rate limit is replaced",
+			func() {
+				rateLimit := types.NewRateLimit(msg.ChannelId, msg.Denom, types.NewQuota(50, 50, time.Minute), types.NewFlow(sdk.NewInt(1), suite.chainA.GetContext().BlockTime()))
+				rateLimit.Flow.Outflow = sdk.NewInt(100)
+				suite.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+			},
+			true, true,
+		},
+		{
+			"success: empty quota removes rate limit",
+			func() {
+				rateLimit := types.NewRateLimit(msg.ChannelId, msg.Denom, msg.Quota, types.NewFlow(sdk.NewInt(1), suite.chainA.GetContext().BlockTime()))
+				suite.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+
+				msg.Quota = types.Quota{}
+			},
+			true, false,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false, false,
+		},
+		{
+			"empty quota for rate limit not found",
+			func() {
+				msg.Quota = types.Quota{}
+			},
+			false, false,
+		},
+		{
+			"supply of denom is zero",
+			func() {
+				msg.Denom = "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"
+			},
+			false, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			msg = types.NewMsgSetRateLimit(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, types.NewQuota(10, 20, time.Hour),
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().RateLimitKeeper)
+			res, err := msgServer.SetRateLimit(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			rateLimit, found := suite.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(ctx, msg.ChannelId, msg.Denom)
+			suite.Require().Equal(tc.expFound, found)
+
+			if tc.expFound {
+				supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, msg.Denom)
+
+				suite.Require().Equal(msg.Quota, rateLimit.Quota)
+				suite.Require().Equal(types.NewFlow(supply.Amount, ctx.BlockTime()), rateLimit.Flow)
+			}
+		})
+	}
+}
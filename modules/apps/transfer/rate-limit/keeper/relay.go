@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+// SendPacket wraps the ICS4Wrapper SendPacket function. If any denomination sent is rate limited
+// over the source channel, the packet is rejected if the net outflow exceeds the send quota.
+// Otherwise, the outflow is increased and the packet is tracked until it is acknowledged or
+// timed out.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	tokens, ok := k.unmarshalPacketTokens(ctx, sourcePort, sourceChannel, data)
+	if !ok {
+		// not an ICS20 packet, rate limits do not apply
+		return k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	rateLimits, amounts, err := k.getTokenRateLimits(ctx, sourceChannel, tokens, func(denom string) string {
+		return transfertypes.ParseDenomTrace(denom).IBCDenom()
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(rateLimits) == 0 {
+		return k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	// all quotas are checked before any flow is updated
+	for i, rateLimit := range rateLimits {
+		if err := rateLimit.CheckSend(amounts[i]); err != nil {
+			return 0, err
+		}
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	var windowStart time.Time
+	for i, rateLimit := range rateLimits {
+		rateLimit.Flow.Outflow = rateLimit.Flow.Outflow.Add(amounts[i])
+		k.SetRateLimit(ctx, rateLimit)
+
+		if rateLimit.Flow.WindowStart.After(windowStart) {
+			windowStart = rateLimit.Flow.WindowStart
+		}
+	}
+
+	k.SetPendingSendPacket(ctx, types.PendingSendPacket{
+		ChannelId:   sourceChannel,
+		Sequence:    sequence,
+		WindowStart: windowStart,
+	})
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement wraps IBC ChannelKeeper's WriteAcknowledgement function
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	// ics4Wrapper may be core IBC or higher-level middleware
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// GetAppVersion returns the underlying application version.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// UpdateRecvFlow increases the inflow of each denomination received in the provided packet which is
+// rate limited over the destination channel. An error is returned if the net inflow of any denomination
+// exceeds the receive quota. Packets which cannot be decoded as ICS20 packet data are ignored.
+func (k Keeper) UpdateRecvFlow(ctx sdk.Context, packet channeltypes.Packet) error {
+	tokens, ok := k.unmarshalPacketTokens(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+	if !ok {
+		return nil
+	}
+
+	rateLimits, amounts, err := k.getTokenRateLimits(ctx, packet.GetDestChannel(), tokens, func(denom string) string {
+		return getReceivedDenom(packet, denom)
+	})
+	if err != nil {
+		return err
+	}
+
+	// all quotas are checked before any flow is updated
+	for i, rateLimit := range rateLimits {
+		if err := rateLimit.CheckRecv(amounts[i]); err != nil {
+			return err
+		}
+	}
+
+	for i, rateLimit := range rateLimits {
+		rateLimit.Flow.Inflow = rateLimit.Flow.Inflow.Add(amounts[i])
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	return nil
+}
+
+// UndoSendFlow decreases the outflow of each denomination sent in the provided packet when the tokens
+// are refunded to the sender, i.e. upon an error acknowledgement or a timeout. The outflow of a rate
+// limit is only restored if the packet was sent within its current time window.
+func (k Keeper) UndoSendFlow(ctx sdk.Context, packet channeltypes.Packet) error {
+	pendingPacket, found := k.GetPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	tokens, ok := k.unmarshalPacketTokens(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data")
+	}
+
+	rateLimits, amounts, err := k.getTokenRateLimits(ctx, packet.GetSourceChannel(), tokens, func(denom string) string {
+		return transfertypes.ParseDenomTrace(denom).IBCDenom()
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, rateLimit := range rateLimits {
+		// the window of the rate limit was reset after the packet was sent
+		if pendingPacket.WindowStart.Before(rateLimit.Flow.WindowStart) {
+			continue
+		}
+
+		rateLimit.Flow.Outflow = sdk.MaxInt(rateLimit.Flow.Outflow.Sub(amounts[i]), sdk.ZeroInt())
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	return nil
+}
+
+// getTokenRateLimits returns the active rate limits over the given channel of the provided packet
+// tokens together with the total amount transferred of each rate limited denomination. The
+// denomination tracked by the rate limits is derived from the packet denomination using the
+// provided function.
+func (k Keeper) getTokenRateLimits(ctx sdk.Context, channelID string, tokens []transfertypes.Token, getDenom func(string) string) ([]types.RateLimit, []sdk.Int, error) {
+	var (
+		rateLimits []types.RateLimit
+		amounts    []sdk.Int
+		indices    = make(map[string]int)
+	)
+
+	for _, token := range tokens {
+		denom := getDenom(token.Denom)
+		i, ok := indices[denom]
+		if !ok {
+			rateLimit, found := k.getActiveRateLimit(ctx, channelID, denom)
+			if !found {
+				continue
+			}
+
+			i = len(rateLimits)
+			indices[denom] = i
+			rateLimits = append(rateLimits, rateLimit)
+			amounts = append(amounts, sdk.ZeroInt())
+		}
+
+		amount, err := parseAmount(token.Amount)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		amounts[i] = amounts[i].Add(amount)
+	}
+
+	return rateLimits, amounts, nil
+}
+
+// unmarshalPacketTokens decodes the tokens transferred by the provided ICS20 packet data of either
+// the single token or the multi token packet format. False is returned if the data cannot be decoded
+// as ICS20 packet data. Compressed packet data of channels negotiating compression is decompressed
+// before it is decoded.
+func (k Keeper) unmarshalPacketTokens(ctx sdk.Context, portID, channelID string, data []byte) ([]transfertypes.Token, bool) {
+	appVersion, _ := k.GetAppVersion(ctx, portID, channelID)
+	if _, compressed := transfertypes.ParseVersion(appVersion); compressed {
+		decompressed, err := transfertypes.DecompressPacketData(ctx.GasMeter(), data)
+		if err != nil {
+			return nil, false
+		}
+
+		data = decompressed
+	}
+
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err == nil {
+		return []transfertypes.Token{{Denom: packetData.Denom, Amount: packetData.Amount}}, true
+	}
+
+	var packetDataV2 transfertypes.FungibleTokenPacketDataV2
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetDataV2); err == nil {
+		return packetDataV2.Tokens, true
+	}
+
+	return nil, false
+}
+
+// getReceivedDenom returns the denomination of the tokens received on this chain for the packet
+// denomination, i.e. the unprefixed denomination for returning tokens or the voucher denomination.
+func getReceivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+// parseAmount parses the amount of the ICS20 packet data
+func parseAmount(amount string) (sdk.Int, error) {
+	transferAmount, ok := sdk.NewIntFromString(amount)
+	if !ok {
+		return sdk.Int{}, sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", amount)
+	}
+
+	return transferAmount, nil
+}
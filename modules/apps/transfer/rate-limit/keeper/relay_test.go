@@ -0,0 +1,428 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+func (suite *KeeperTestSuite) TestSendPacket() {
+	var (
+		rateLimit *types.RateLimit
+		amount    sdk.Int
+	)
+
+	channelValue := sdk.NewInt(1000)
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expPass    bool
+		expOutflow sdk.Int
+	}{
+		{
+			"success: no rate limit",
+			func() {
+				rateLimit = nil
+			},
+			true, sdk.Int{},
+		},
+		{
+			"success: within send quota",
+			func() {},
+			true, sdk.NewInt(100),
+		},
+		{
+			"success: inflow offsets outflow",
+			func() {
+				rateLimit.Flow.Inflow = sdk.NewInt(50)
+				amount = sdk.NewInt(150)
+			},
+			true, sdk.NewInt(150),
+		},
+		{
+			"success: send threshold disabled",
+			func() {
+				rateLimit.Quota.MaxPercentSend = 0
+				amount = sdk.NewInt(500)
+			},
+			true, sdk.NewInt(500),
+		},
+		{
+			"success: time window expired resets flow",
+			func() {
+				rateLimit.Flow.Outflow = sdk.NewInt(100)
+				rateLimit.Flow.WindowStart = suite.chainA.GetContext().BlockTime().Add(-rateLimit.Quota.Duration)
+			},
+			true, sdk.NewInt(100),
+		},
+		{
+			"rate limit of another channel does not apply",
+			func() {
+				rateLimit.ChannelId = "channel-1"
+				amount = sdk.NewInt(500)
+			},
+			true, sdk.ZeroInt(),
+		},
+		{
+			"send quota exceeded",
+			func() {
+				amount = sdk.NewInt(101)
+			},
+			false, sdk.ZeroInt(),
+		},
+		{
+			"send quota exceeded by accumulated outflow",
+			func() {
+				rateLimit.Flow.Outflow = sdk.NewInt(100)
+				amount = sdk.OneInt()
+			},
+			false, sdk.NewInt(100),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			amount = sdk.NewInt(100)
+			rateLimit = &types.RateLimit{
+				ChannelId: suite.path.EndpointA.ChannelID,
+				Denom:     sdk.DefaultBondDenom,
+				Quota:     types.NewQuota(10, 10, time.Hour),
+				Flow:      types.NewFlow(channelValue, suite.chainA.GetContext().BlockTime()),
+			}
+
+			tc.malleate()
+
+			if rateLimit != nil {
+				suite.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(suite.chainA.GetContext(), *rateLimit)
+			}
+
+			msg := transfertypes.NewMsgTransfer(
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+
+			if !tc.expPass {
+				_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+			} else {
+				res, err := suite.chainA.SendMsgs(msg)
+				suite.Require().NoError(err)
+
+				packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+
+				_, found := suite.chainA.GetSimApp().RateLimitKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().Equal(rateLimit != nil && rateLimit.ChannelId == packet.GetSourceChannel(), found)
+			}
+
+			if rateLimit != nil {
+				storedRateLimit, found := suite.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), rateLimit.ChannelId, rateLimit.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.expOutflow, storedRateLimit.Flow.Outflow)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendPacketMultiToken() {
+	var secondAmount sdk.Int
+
+	amount := sdk.NewInt(100)
+	secondDenom := "atom"
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expPass    bool
+		expOutflow sdk.Int
+	}{
+		{
+			"success: within send quota of all denominations",
+			func() {},
+			true, amount,
+		},
+		{
+			"send quota of one denomination exceeded",
+			func() {
+				secondAmount = sdk.NewInt(101)
+			},
+			false, sdk.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			suite.path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.path.EndpointA.ChannelConfig.Version = transfertypes.V2
+			suite.path.EndpointB.ChannelConfig.Version = transfertypes.V2
+			suite.coordinator.Setup(suite.path)
+
+			secondAmount = sdk.NewInt(100)
+
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			secondCoin := sdk.NewCoin(secondDenom, secondAmount)
+			suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), transfertypes.ModuleName, sdk.NewCoins(secondCoin)))
+			suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), transfertypes.ModuleName, sender, sdk.NewCoins(secondCoin)))
+
+			for _, denom := range []string{sdk.DefaultBondDenom, secondDenom} {
+				rateLimit := types.NewRateLimit(
+					suite.path.EndpointA.ChannelID, denom, types.NewQuota(10, 10, time.Hour),
+					types.NewFlow(sdk.NewInt(1000), suite.chainA.GetContext().BlockTime()),
+				)
+				suite.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+			}
+
+			msg := transfertypes.NewMsgMultiTokenTransfer(
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount), secondCoin), sender.String(),
+				suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+
+			if !tc.expPass {
+				_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+			} else {
+				res, err := suite.chainA.SendMsgs(msg)
+				suite.Require().NoError(err)
+
+				packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+
+				_, found := suite.chainA.GetSimApp().RateLimitKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().True(found)
+			}
+
+			// the outflow of the denomination within its quota is only updated if the packet is sent
+			storedRateLimit, found := suite.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expOutflow, storedRateLimit.Flow.Outflow)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		rateLimit types.RateLimit
+		amount    sdk.Int
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		expInflow sdk.Int
+	}{
+		{
+			"success: within receive quota",
+			func() {},
+			true, sdk.NewInt(100),
+		},
+		{
+			"success: receive threshold disabled",
+			func() {
+				rateLimit.Quota.MaxPercentRecv = 0
+				amount = sdk.NewInt(500)
+			},
+			true, sdk.NewInt(500),
+		},
+		{
+			"success: time window expired resets flow",
+			func() {
+				rateLimit.Flow.Inflow = sdk.NewInt(100)
+				rateLimit.Flow.WindowStart = suite.chainB.GetContext().BlockTime().Add(-rateLimit.Quota.Duration)
+			},
+			true, sdk.NewInt(100),
+		},
+		{
+			"receive quota exceeded",
+			func() {
+				amount = sdk.NewInt(101)
+			},
+			false, sdk.ZeroInt(),
+		},
+		{
+			"receive quota exceeded by accumulated inflow",
+			func() {
+				rateLimit.Flow.Inflow = sdk.NewInt(100)
+				amount = sdk.OneInt()
+			},
+			false, sdk.NewInt(100),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			amount = sdk.NewInt(100)
+
+			voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			rateLimit = types.NewRateLimit(
+				suite.path.EndpointB.ChannelID, voucherDenom, types.NewQuota(10, 10, time.Hour),
+				types.NewFlow(sdk.NewInt(1000), suite.chainB.GetContext().BlockTime()),
+			)
+
+			tc.malleate()
+
+			suite.chainB.GetSimApp().RateLimitKeeper.SetRateLimit(suite.chainB.GetContext(), rateLimit)
+
+			msg := transfertypes.NewMsgTransfer(
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err) // message committed
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+			res, err = suite.path.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			if tc.expPass {
+				suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+			} else {
+				suite.Require().NotEqual(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+			}
+
+			storedRateLimit, found := suite.chainB.GetSimApp().RateLimitKeeper.GetRateLimit(suite.chainB.GetContext(), rateLimit.ChannelId, rateLimit.Denom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expInflow, storedRateLimit.Flow.Inflow)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUndoSendFlow() {
+	var (
+		receiver string
+		timeout  bool
+	)
+
+	amount := sdk.NewInt(100)
+
+	testCases := []struct {
+		msg           string
+		malleate      func()
+		expOutflow    sdk.Int
+		expWindowLost bool // the time window of the rate limit expires before the refund
+	}{
+		{
+			"success acknowledgement does not restore outflow",
+			func() {},
+			amount, false,
+		},
+		{
+			"error acknowledgement restores outflow",
+			func() {
+				receiver = "invalid address"
+			},
+			sdk.ZeroInt(), false,
+		},
+		{
+			"timeout restores outflow",
+			func() {
+				timeout = true
+			},
+			sdk.ZeroInt(), false,
+		},
+		{
+			"refund in a new time window does not restore outflow",
+			func() {
+				timeout = true
+			},
+			amount, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			receiver = suite.chainB.SenderAccount.GetAddress().String()
+			timeout = false
+
+			tc.malleate()
+
+			rateLimit := types.NewRateLimit(
+				suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour),
+				types.NewFlow(sdk.NewInt(1000), suite.chainA.GetContext().BlockTime()),
+			)
+			suite.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+
+			timeoutHeight := suite.chainB.GetTimeoutHeight()
+			if timeout {
+				timeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			}
+
+			msg := transfertypes.NewMsgTransfer(
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainA.SenderAccount.GetAddress().String(),
+				receiver, timeoutHeight, 0, "",
+			)
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err) // message committed
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			if tc.expWindowLost {
+				// start a new time window whose outflow does not include the sent tokens
+				rateLimit.Flow.Outflow = amount
+				rateLimit.Flow.WindowStart = rateLimit.Flow.WindowStart.Add(time.Second)
+				suite.chainA.GetSimApp().RateLimitKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+			}
+
+			if timeout {
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+				suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+			} else {
+				suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+				res, err = suite.path.EndpointB.RecvPacketWithResult(packet)
+				suite.Require().NoError(err)
+
+				ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, ack))
+			}
+
+			_, found := suite.chainA.GetSimApp().RateLimitKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().False(found)
+
+			storedRateLimit, found := suite.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), rateLimit.ChannelId, rateLimit.Denom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expOutflow, storedRateLimit.Flow.Outflow)
+		})
+	}
+}
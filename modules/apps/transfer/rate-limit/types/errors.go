@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ICS20 rate limit sentinel errors
+var (
+	ErrInvalidQuota      = sdkerrors.Register(ModuleName, 2, "invalid rate limit quota")
+	ErrQuotaExceeded     = sdkerrors.Register(ModuleName, 3, "rate limit quota exceeded")
+	ErrRateLimitNotFound = sdkerrors.Register(ModuleName, 4, "rate limit not found")
+	ErrInvalidAuthority  = sdkerrors.Register(ModuleName, 5, "invalid authority")
+)
@@ -0,0 +1,14 @@
+package types
+
+// ICS20 rate limit events
+const (
+	EventTypeSetRateLimit    = "set_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyMaxPercentSend = "max_percent_send"
+	AttributeKeyMaxPercentRecv = "max_percent_recv"
+	AttributeKeyDuration       = "duration"
+	AttributeKeyChannelValue   = "channel_value"
+)
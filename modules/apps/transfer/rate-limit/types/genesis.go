@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// NewGenesisState creates a new rate limit GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns a default instance of the rate limit GenesisState.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := string(KeyRateLimit(rateLimit.ChannelId, rateLimit.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicate rate limit for denom %s on channel %s", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	for _, pendingPacket := range gs.PendingSendPackets {
+		if err := host.ChannelIdentifierValidator(pendingPacket.ChannelId); err != nil {
+			return err
+		}
+
+		if pendingPacket.Sequence == 0 {
+			return fmt.Errorf("pending send packet sequence cannot be 0")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success - default genesis",
+			func() {
+				genState = types.DefaultGenesisState()
+			},
+			true,
+		},
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid rate limit channel ID",
+			func() {
+				genState.RateLimits[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid rate limit quota",
+			func() {
+				genState.RateLimits[0].Quota = types.Quota{}
+			},
+			false,
+		},
+		{
+			"invalid rate limit flow",
+			func() {
+				genState.RateLimits[0].Flow.Outflow = sdk.NewInt(-1)
+			},
+			false,
+		},
+		{
+			"duplicate rate limit",
+			func() {
+				genState.RateLimits = append(genState.RateLimits, genState.RateLimits[0])
+			},
+			false,
+		},
+		{
+			"invalid pending send packet channel ID",
+			func() {
+				genState.PendingSendPackets[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid pending send packet sequence",
+			func() {
+				genState.PendingSendPackets[0].Sequence = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		now := time.Now()
+		genState = types.NewGenesisState(
+			[]types.RateLimit{
+				types.NewRateLimit(ibctesting.FirstChannelID, sdk.DefaultBondDenom, types.NewQuota(10, 20, time.Hour), types.NewFlow(sdk.NewInt(1000), now)),
+			},
+			[]types.PendingSendPacket{
+				{ChannelId: ibctesting.FirstChannelID, Sequence: 1, WindowStart: now},
+			},
+		)
+
+		tc.malleate()
+
+		err := genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
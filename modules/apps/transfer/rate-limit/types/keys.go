@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the ICS20 rate limit middleware name
+	ModuleName = "ratelimit"
+
+	// StoreKey is the store key string for the ICS20 rate limit middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the ICS20 rate limit middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the ICS20 rate limit middleware
+	QuerierRoute = ModuleName
+
+	// RateLimitKeyPrefix is the key prefix for the rate limits stored in state
+	RateLimitKeyPrefix = "rateLimit"
+
+	// PendingSendPacketKeyPrefix is the key prefix for the rate limited packets awaiting an acknowledgement or timeout
+	PendingSendPacketKeyPrefix = "pendingSendPacket"
+)
+
+// KeyRateLimit returns the key under which the rate limit of the given denomination over the given channel is stored
+func KeyRateLimit(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", RateLimitKeyPrefix, channelID, denom))
+}
+
+// KeyPendingSendPacket returns the key under which the pending send packet with the given channel and sequence is stored
+func KeyPendingSendPacket(channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", PendingSendPacketKeyPrefix, channelID, sequence))
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+var _ sdk.Msg = &MsgSetRateLimit{}
+
+// NewMsgSetRateLimit creates a new instance of MsgSetRateLimit
+func NewMsgSetRateLimit(authority, channelID, denom string, quota Quota) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		Authority: authority,
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgSetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from authority address")
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	// an empty quota removes the rate limit
+	if msg.Quota.IsEmpty() {
+		return nil
+	}
+
+	return msg.Quota.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSetRateLimit) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+var defaultAccAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+func TestMsgSetRateLimitValidation(t *testing.T) {
+	var msg *types.MsgSetRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: empty quota",
+			func() {
+				msg.Quota = types.Quota{}
+			},
+			true,
+		},
+		{
+			"invalid authority address",
+			func() {
+				msg.Authority = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid denom",
+			func() {
+				msg.Denom = "!@#!@$#"
+			},
+			false,
+		},
+		{
+			"invalid quota",
+			func() {
+				msg.Quota.MaxPercentSend = 101
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg = types.NewMsgSetRateLimit(defaultAccAddress, ibctesting.FirstChannelID, sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour))
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgSetRateLimitGetSigners(t *testing.T) {
+	msg := types.NewMsgSetRateLimit(defaultAccAddress, ibctesting.FirstChannelID, sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour))
+	require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(defaultAccAddress)}, msg.GetSigners())
+}
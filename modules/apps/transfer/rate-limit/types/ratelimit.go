@@ -0,0 +1,134 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// MaxPercent is the maximum percentage of the channel value which may be used as a quota threshold
+const MaxPercent = 100
+
+// NewQuota creates a new Quota instance.
+func NewQuota(maxPercentSend, maxPercentRecv uint64, duration time.Duration) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		Duration:       duration,
+	}
+}
+
+// IsEmpty returns true if no thresholds and no duration are set on the quota.
+func (q Quota) IsEmpty() bool {
+	return q.MaxPercentSend == 0 && q.MaxPercentRecv == 0 && q.Duration == 0
+}
+
+// ValidateBasic performs a basic validation of the quota. A threshold of zero disables
+// the rate limit in that direction, but at least one threshold must be set.
+func (q Quota) ValidateBasic() error {
+	if q.MaxPercentSend > MaxPercent || q.MaxPercentRecv > MaxPercent {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "thresholds cannot exceed %d percent: send (%d), recv (%d)", MaxPercent, q.MaxPercentSend, q.MaxPercentRecv)
+	}
+
+	if q.MaxPercentSend == 0 && q.MaxPercentRecv == 0 {
+		return sdkerrors.Wrap(ErrInvalidQuota, "at least one threshold must be set")
+	}
+
+	if q.Duration <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "duration must be positive: %s", q.Duration)
+	}
+
+	return nil
+}
+
+// NewFlow creates a new Flow instance with no inflow or outflow.
+func NewFlow(channelValue sdk.Int, windowStart time.Time) Flow {
+	return Flow{
+		Inflow:       sdk.ZeroInt(),
+		Outflow:      sdk.ZeroInt(),
+		ChannelValue: channelValue,
+		WindowStart:  windowStart,
+	}
+}
+
+// ValidateBasic performs a basic validation of the flow amounts.
+func (f Flow) ValidateBasic() error {
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "inflow must be non-negative: %s", f.Inflow)
+	}
+
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "outflow must be non-negative: %s", f.Outflow)
+	}
+
+	if f.ChannelValue.IsNil() || f.ChannelValue.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "channel value must be non-negative: %s", f.ChannelValue)
+	}
+
+	return nil
+}
+
+// NewRateLimit creates a new RateLimit instance.
+func NewRateLimit(channelID, denom string, quota Quota, flow Flow) RateLimit {
+	return RateLimit{
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+		Flow:      flow,
+	}
+}
+
+// IsWindowExpired returns true if the time window of the rate limit has elapsed at the provided block time.
+func (rl RateLimit) IsWindowExpired(blockTime time.Time) bool {
+	return !blockTime.Before(rl.Flow.WindowStart.Add(rl.Quota.Duration))
+}
+
+// CheckSend returns an error if sending the provided amount exceeds the send threshold of the quota.
+func (rl RateLimit) CheckSend(amount sdk.Int) error {
+	if rl.Quota.MaxPercentSend == 0 {
+		return nil
+	}
+
+	netOutflow := rl.Flow.Outflow.Add(amount).Sub(rl.Flow.Inflow)
+	threshold := rl.Flow.ChannelValue.MulRaw(int64(rl.Quota.MaxPercentSend)).QuoRaw(MaxPercent)
+	if netOutflow.GT(threshold) {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "net outflow (%s) exceeds threshold (%s) for denom %s on channel %s", netOutflow, threshold, rl.Denom, rl.ChannelId)
+	}
+
+	return nil
+}
+
+// CheckRecv returns an error if receiving the provided amount exceeds the receive threshold of the quota.
+func (rl RateLimit) CheckRecv(amount sdk.Int) error {
+	if rl.Quota.MaxPercentRecv == 0 {
+		return nil
+	}
+
+	netInflow := rl.Flow.Inflow.Add(amount).Sub(rl.Flow.Outflow)
+	threshold := rl.Flow.ChannelValue.MulRaw(int64(rl.Quota.MaxPercentRecv)).QuoRaw(MaxPercent)
+	if netInflow.GT(threshold) {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "net inflow (%s) exceeds threshold (%s) for denom %s on channel %s", netInflow, threshold, rl.Denom, rl.ChannelId)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs a basic validation of the rate limit.
+func (rl RateLimit) ValidateBasic() error {
+	if err := host.ChannelIdentifierValidator(rl.ChannelId); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(rl.Denom); err != nil {
+		return err
+	}
+
+	if err := rl.Quota.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return rl.Flow.ValidateBasic()
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/rate-limit/types"
+)
+
+func TestQuotaValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		quota   types.Quota
+		expPass bool
+	}{
+		{"valid quota", types.NewQuota(10, 20, time.Hour), true},
+		{"valid quota with send threshold disabled", types.NewQuota(0, 20, time.Hour), true},
+		{"valid quota with maximum thresholds", types.NewQuota(types.MaxPercent, types.MaxPercent, time.Hour), true},
+		{"send threshold exceeds maximum", types.NewQuota(types.MaxPercent+1, 20, time.Hour), false},
+		{"recv threshold exceeds maximum", types.NewQuota(10, types.MaxPercent+1, time.Hour), false},
+		{"both thresholds disabled", types.NewQuota(0, 0, time.Hour), false},
+		{"zero duration", types.NewQuota(10, 20, 0), false},
+		{"negative duration", types.NewQuota(10, 20, -time.Hour), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.quota.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestRateLimitCheckFlow(t *testing.T) {
+	now := time.Now()
+
+	rateLimit := types.NewRateLimit("channel-0", sdk.DefaultBondDenom, types.NewQuota(10, 20, time.Hour), types.NewFlow(sdk.NewInt(1000), now))
+	rateLimit.Flow.Inflow = sdk.NewInt(50)
+	rateLimit.Flow.Outflow = sdk.NewInt(100)
+
+	// send threshold is 100, net outflow is 50
+	require.NoError(t, rateLimit.CheckSend(sdk.NewInt(50)))
+	require.ErrorIs(t, rateLimit.CheckSend(sdk.NewInt(51)), types.ErrQuotaExceeded)
+
+	// recv threshold is 200, net inflow is -50
+	require.NoError(t, rateLimit.CheckRecv(sdk.NewInt(250)))
+	require.ErrorIs(t, rateLimit.CheckRecv(sdk.NewInt(251)), types.ErrQuotaExceeded)
+
+	require.False(t, rateLimit.IsWindowExpired(now.Add(time.Hour-1)))
+	require.True(t, rateLimit.IsWindowExpired(now.Add(time.Hour)))
+}
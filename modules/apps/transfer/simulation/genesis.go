@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
@@ -41,9 +42,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 	)
 
 	transferGenesis := types.GenesisState{
-		PortId:      portID,
-		DenomTraces: types.Traces{},
-		Params:      types.NewParams(sendEnabled, receiveEnabled),
+		PortId:        portID,
+		DenomTraces:   types.Traces{},
+		Params:        types.NewParams(sendEnabled, receiveEnabled, types.DefaultMaxMemoLength, nil),
+		TotalEscrowed: sdk.Coins{},
 	}
 
 	bz, err := json.MarshalIndent(&transferGenesis, "", " ")
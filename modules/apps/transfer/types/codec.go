@@ -9,18 +9,35 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/ibc transfer interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgTransfer{}, "cosmos-sdk/MsgTransfer", nil)
+	cdc.RegisterConcrete(&MsgMultiTransfer{}, "cosmos-sdk/MsgMultiTransfer", nil)
+	cdc.RegisterConcrete(&MsgRetryTransfer{}, "cosmos-sdk/MsgRetryTransfer", nil)
 }
 
 // RegisterInterfaces register the ibc transfer module interfaces to protobuf
 // Any.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransfer{})
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgTransfer{},
+		&MsgMultiTransfer{},
+		&MsgRetryTransfer{},
+		&MsgSetReceiveOnlyChannel{},
+		&MsgSetDenomTransferEnabled{},
+		&MsgRenameEscrowDenom{},
+	)
+
+	registry.RegisterImplementations(
+		(*exported.AppParams)(nil),
+		&Params{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
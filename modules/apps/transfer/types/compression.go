@@ -0,0 +1,83 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// CompressionVersionSuffix is appended to the transfer version of channels which negotiate the
+	// gzip compression of the packet data, e.g. ics20-1+gzip
+	CompressionVersionSuffix = "+gzip"
+
+	// MaxDecompressedPacketDataSize is the maximum size in bytes of decompressed packet data. It
+	// guards against compressed packet data expanding to an excessive size (zip bombs).
+	MaxDecompressedPacketDataSize = 1 << 20
+
+	// DecompressionGasCostPerByte is the gas consumed for every byte of decompressed packet data.
+	DecompressionGasCostPerByte = 3
+)
+
+// gzipMagic is the header every gzip stream starts with. JSON encoded packet data always starts
+// with '{', so compressed packet data can be told apart from uncompressed packet data.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// CompressedVersion returns the version negotiating the compression of the packet data for the
+// provided transfer version.
+func CompressedVersion(version string) string {
+	return version + CompressionVersionSuffix
+}
+
+// ParseVersion returns the transfer version with the compression suffix removed and whether the
+// provided version negotiates the compression of the packet data.
+func ParseVersion(version string) (string, bool) {
+	baseVersion := strings.TrimSuffix(version, CompressionVersionSuffix)
+	return baseVersion, baseVersion != version
+}
+
+// IsCompressedPacketData returns true if the provided packet data is gzip compressed.
+func IsCompressedPacketData(bz []byte) bool {
+	return bytes.HasPrefix(bz, gzipMagic)
+}
+
+// CompressPacketData gzip compresses the provided packet data. The compressed bytes are part of the
+// packet commitment, so they are produced by the deterministic encoder of this package rather than by
+// compress/gzip, whose output may differ between Go versions. The transfer application compresses the
+// packet data it sends over channels negotiating compression.
+func CompressPacketData(bz []byte) []byte {
+	return deflate(bz)
+}
+
+// DecompressPacketData returns the decompressed packet data if the provided packet data is gzip
+// compressed and the packet data unchanged otherwise. DecompressionGasCostPerByte gas is consumed on
+// the provided gas meter for every decompressed byte. An error is returned if the compressed packet
+// data is malformed or decompresses to more than MaxDecompressedPacketDataSize bytes.
+func DecompressPacketData(gasMeter sdk.GasMeter, bz []byte) ([]byte, error) {
+	if !IsCompressedPacketData(bz) {
+		return bz, nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(bz))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidPacketData, "cannot decompress packet data: %s", err.Error())
+	}
+	defer r.Close()
+
+	// read a single byte past the limit to detect packet data exceeding it
+	data, err := io.ReadAll(io.LimitReader(r, MaxDecompressedPacketDataSize+1))
+	gasMeter.ConsumeGas(uint64(len(data))*DecompressionGasCostPerByte, "decompress packet data")
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidPacketData, "cannot decompress packet data: %s", err.Error())
+	}
+
+	if len(data) > MaxDecompressedPacketDataSize {
+		return nil, sdkerrors.Wrapf(ErrInvalidPacketData, "decompressed packet data exceeds the maximum size of %d bytes", MaxDecompressedPacketDataSize)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,178 @@
+package types_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+func TestParseVersion(t *testing.T) {
+	testCases := []struct {
+		name          string
+		version       string
+		expVersion    string
+		expCompressed bool
+	}{
+		{"single token version", types.Version, types.Version, false},
+		{"multi token version", types.V2, types.V2, false},
+		{"compressed single token version", types.CompressedVersion(types.Version), types.Version, true},
+		{"compressed multi token version", types.CompressedVersion(types.V2), types.V2, true},
+		{"suffix not at the end", types.CompressionVersionSuffix + types.Version, types.CompressionVersionSuffix + types.Version, false},
+	}
+
+	for _, tc := range testCases {
+		version, compressed := types.ParseVersion(tc.version)
+		require.Equal(t, tc.expVersion, version, tc.name)
+		require.Equal(t, tc.expCompressed, compressed, tc.name)
+	}
+}
+
+func TestCompressPacketData(t *testing.T) {
+	random := make([]byte, 100000)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	// repeats the random bytes at a distance exceeding the match window
+	distant := append(append(append([]byte{}, random[:1000]...), random[1000:50000]...), random[:1000]...)
+
+	testCases := []struct {
+		name string
+		bz   []byte
+	}{
+		{"empty", []byte{}},
+		{"single byte", []byte{'a'}},
+		{"packet data", types.NewFungibleTokenPacketData("transfer/channel-0/uatom", "100", "sender", "receiver", "memo memo memo").GetBytes()},
+		{"repeated byte exceeding the maximum match length", bytes.Repeat([]byte{'a'}, 1000)},
+		{"repeated sequence", bytes.Repeat([]byte("0123456789abcdef"), 5000)},
+		{"random bytes", random},
+		{"sequence repeated beyond the match window", distant},
+	}
+
+	for _, tc := range testCases {
+		compressed := types.CompressPacketData(tc.bz)
+
+		r, err := gzip.NewReader(bytes.NewReader(compressed))
+		require.NoError(t, err, tc.name)
+
+		data, err := io.ReadAll(r)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.bz, data, tc.name)
+	}
+
+}
+
+// TestCompressPacketDataOutput pins the output of the encoder. The compressed bytes are part of the
+// packet commitment and must never change, large outputs are pinned by their sha256 hash.
+func TestCompressPacketDataOutput(t *testing.T) {
+	random := make([]byte, 100000)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	testCases := []struct {
+		name      string
+		bz        []byte
+		expOutput string
+		expHash   string
+	}{
+		{"empty", []byte{}, "1f8b08000000000000ff03000000000000000000", ""},
+		{"single byte", []byte{'a'}, "1f8b08000000000000ff4b040043beb7e801000000", ""},
+		{"repeated byte exceeding the maximum match length", bytes.Repeat([]byte{'a'}, 1000), "1f8b08000000000000ff4b1c05a360140c7b000003da389ae8030000", ""},
+		{"overlapping match", []byte("abcabcabcabcabcabcabcabcabcabc"), "1f8b08000000000000ff4b4c4ac6830081fcb1481e000000", ""},
+		{
+			"packet data",
+			types.NewFungibleTokenPacketData("transfer/channel-0/uatom", "100", "cosmos1sender", "cosmos1receiver", "memo memo memo").GetBytes(),
+			"1f8b08000000000000ffab564acccd2fcd2b51b25232343050d2514a49cdcbcf05f24a8a12f38ad3528bf4933312f3f25273740df44b134b80523a4ab9a9b9f94015204a014e00c58b52935333cb528b8072c9f9c5b9f9c58670111da5e2d4bc1420032e05e5d70200a556692a81000000",
+			"",
+		},
+		{"repeated sequence", bytes.Repeat([]byte("0123456789abcdef"), 5000), "", "db2f57fb6209b584ca796f12471dff9763535ae8726af7dd8c410d6199327be0"},
+		{"random bytes", random, "", "8d0156e0a1b73ab007d4c463f98cb937add7440d6c75393e402db3029ef5907a"},
+	}
+
+	for _, tc := range testCases {
+		compressed := types.CompressPacketData(tc.bz)
+
+		if tc.expOutput != "" {
+			require.Equal(t, tc.expOutput, hex.EncodeToString(compressed), tc.name)
+		} else {
+			hash := sha256.Sum256(compressed)
+			require.Equal(t, tc.expHash, hex.EncodeToString(hash[:]), tc.name)
+		}
+	}
+}
+
+// FuzzCompressPacketData tests that the compressed packet data is decoded by compress/gzip to the
+// original packet data.
+func FuzzCompressPacketData(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte("abcabcabcabcabcabcabcabcabcabc"))
+	f.Add(bytes.Repeat([]byte{'a'}, 1000))
+	f.Add(types.NewFungibleTokenPacketData("transfer/channel-0/uatom", "100", "sender", "receiver", "memo").GetBytes())
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		compressed := types.CompressPacketData(bz)
+		require.True(t, types.IsCompressedPacketData(compressed))
+
+		r, err := gzip.NewReader(bytes.NewReader(compressed))
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.True(t, bytes.Equal(bz, data))
+
+		// the compressed bytes only depend on the packet data
+		require.Equal(t, compressed, types.CompressPacketData(bz))
+	})
+}
+
+func TestDecompressPacketData(t *testing.T) {
+	packetData := types.NewFungibleTokenPacketData("atom", "100", "sender", "receiver", "memo").GetBytes()
+
+	compressed := types.CompressPacketData(packetData)
+	require.True(t, types.IsCompressedPacketData(compressed))
+	require.False(t, types.IsCompressedPacketData(packetData))
+
+	// the compressed bytes only depend on the packet data
+	require.Equal(t, compressed, types.CompressPacketData(packetData))
+
+	zipBomb := types.CompressPacketData(bytes.Repeat([]byte{'a'}, types.MaxDecompressedPacketDataSize+1))
+	maxSize := types.CompressPacketData(bytes.Repeat([]byte{'a'}, types.MaxDecompressedPacketDataSize))
+
+	testCases := []struct {
+		name    string
+		bz      []byte
+		expData []byte
+		expPass bool
+	}{
+		{"compressed packet data", compressed, packetData, true},
+		{"uncompressed packet data", packetData, packetData, true},
+		{"maximum decompressed size", maxSize, bytes.Repeat([]byte{'a'}, types.MaxDecompressedPacketDataSize), true},
+		{"decompressed size exceeds maximum", zipBomb, nil, false},
+		{"truncated compressed packet data", compressed[:len(compressed)/2], nil, false},
+		{"only the gzip header", compressed[:2], nil, false},
+	}
+
+	for _, tc := range testCases {
+		gasMeter := sdk.NewInfiniteGasMeter()
+		data, err := types.DecompressPacketData(gasMeter, tc.bz)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expData, data, tc.name)
+
+			// gas is only consumed for decompressed packet data
+			expGas := uint64(0)
+			if types.IsCompressedPacketData(tc.bz) {
+				expGas = uint64(len(tc.expData)) * types.DecompressionGasCostPerByte
+			}
+			require.Equal(t, expGas, gasMeter.GasConsumed(), tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidPacketData, tc.name)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package types
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+)
+
+// The packet data of compressed channels is part of the packet commitment, so the compressed bytes
+// must be identical on every validator. The output of compress/gzip is not guaranteed to be stable
+// across Go versions, therefore packet data is compressed with the encoder below, which emits a
+// single DEFLATE block (RFC 1951) using the fixed Huffman codes and a greedy LZ77 match search with
+// fixed parameters. Its output is only defined by this file and any change to it requires a new
+// compressed channel version. The output is a standard gzip stream (RFC 1952) decoded with
+// compress/gzip.
+
+const (
+	// deflateWindowSize is the maximum distance of a match
+	deflateWindowSize = 1 << 15
+	// deflateMinMatch is the minimum length of a match
+	deflateMinMatch = 3
+	// deflateMaxMatch is the maximum length of a match
+	deflateMaxMatch = 258
+	// deflateHashBits is the number of bits of the hash of the bytes starting a match
+	deflateHashBits = 15
+)
+
+var (
+	// gzipHeader is the header of the gzip stream: deflate compression, no flags, no modification
+	// time, no extra flags and an unknown operating system
+	gzipHeader = []byte{0x1f, 0x8b, 8, 0, 0, 0, 0, 0, 0, 255}
+
+	lengthBase  = []uint32{3, 4, 5, 6, 7, 8, 9, 10, 11, 13, 15, 17, 19, 23, 27, 31, 35, 43, 51, 59, 67, 83, 99, 115, 131, 163, 195, 227, 258}
+	lengthExtra = []uint{0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4, 5, 5, 5, 5, 0}
+
+	distanceBase  = []uint32{1, 2, 3, 4, 5, 7, 9, 13, 17, 25, 33, 49, 65, 97, 129, 193, 257, 385, 513, 769, 1025, 1537, 2049, 3073, 4097, 6145, 8193, 12289, 16385, 24577}
+	distanceExtra = []uint{0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13}
+)
+
+// bitWriter writes bits to a byte slice starting with the least significant bit of each byte.
+type bitWriter struct {
+	bz    []byte
+	bits  uint32
+	nbits uint
+}
+
+// writeBits writes the n least significant bits of v.
+func (w *bitWriter) writeBits(v uint32, n uint) {
+	w.bits |= v << w.nbits
+	w.nbits += n
+	for w.nbits >= 8 {
+		w.bz = append(w.bz, byte(w.bits))
+		w.bits >>= 8
+		w.nbits -= 8
+	}
+}
+
+// writeCode writes a Huffman code of n bits, which is packed starting with its most significant bit.
+func (w *bitWriter) writeCode(code uint32, n uint) {
+	var reversed uint32
+	for i := uint(0); i < n; i++ {
+		reversed = reversed<<1 | (code>>i)&1
+	}
+
+	w.writeBits(reversed, n)
+}
+
+// flush writes the remaining bits padded with zeros to a full byte.
+func (w *bitWriter) flush() {
+	if w.nbits > 0 {
+		w.bz = append(w.bz, byte(w.bits))
+		w.bits, w.nbits = 0, 0
+	}
+}
+
+// writeLiteralLength writes the fixed Huffman code of the provided literal/length symbol.
+func (w *bitWriter) writeLiteralLength(symbol uint32) {
+	switch {
+	case symbol < 144:
+		w.writeCode(0x30+symbol, 8)
+	case symbol < 256:
+		w.writeCode(0x190+symbol-144, 9)
+	case symbol < 280:
+		w.writeCode(symbol-256, 7)
+	default:
+		w.writeCode(0xc0+symbol-280, 8)
+	}
+}
+
+// writeMatch writes the length and distance codes of a match along with their extra bits.
+func (w *bitWriter) writeMatch(length, distance uint32) {
+	code := findCode(lengthBase, length)
+	w.writeLiteralLength(257 + code)
+	w.writeBits(length-lengthBase[code], lengthExtra[code])
+
+	code = findCode(distanceBase, distance)
+	w.writeCode(code, 5)
+	w.writeBits(distance-distanceBase[code], distanceExtra[code])
+}
+
+// findCode returns the index of the greatest base which is less than or equal to the provided value.
+func findCode(bases []uint32, value uint32) uint32 {
+	code := len(bases) - 1
+	for bases[code] > value {
+		code--
+	}
+
+	return uint32(code)
+}
+
+// hashMatch returns the hash of the deflateMinMatch bytes starting at the provided position.
+func hashMatch(bz []byte, pos int) uint32 {
+	v := uint32(bz[pos])<<16 | uint32(bz[pos+1])<<8 | uint32(bz[pos+2])
+	return (v * 0x9e3779b1) >> (32 - deflateHashBits)
+}
+
+// deflate returns the gzip stream of the provided data compressed by a single fixed Huffman block.
+// Every position is matched against the most recent earlier position with the same hash only.
+func deflate(bz []byte) []byte {
+	w := &bitWriter{bz: append([]byte{}, gzipHeader...)}
+
+	// final block compressed with the fixed Huffman codes
+	w.writeBits(1, 1)
+	w.writeBits(1, 2)
+
+	// positions are stored incremented by one so that zero marks an empty entry
+	var head [1 << deflateHashBits]int
+	for pos := 0; pos < len(bz); {
+		length, candidate := 0, -1
+		if pos+deflateMinMatch <= len(bz) {
+			hash := hashMatch(bz, pos)
+			candidate = head[hash] - 1
+			head[hash] = pos + 1
+
+			if candidate >= 0 && pos-candidate <= deflateWindowSize {
+				for length < deflateMaxMatch && pos+length < len(bz) && bz[candidate+length] == bz[pos+length] {
+					length++
+				}
+			}
+		}
+
+		if length < deflateMinMatch {
+			w.writeLiteralLength(uint32(bz[pos]))
+			pos++
+			continue
+		}
+
+		w.writeMatch(uint32(length), uint32(pos-candidate))
+
+		// the positions covered by the match are hashed so that later matches may refer to them
+		end := pos + length
+		for pos++; pos < end; pos++ {
+			if pos+deflateMinMatch <= len(bz) {
+				head[hashMatch(bz, pos)] = pos + 1
+			}
+		}
+	}
+
+	// end of block
+	w.writeLiteralLength(256)
+	w.flush()
+
+	var trailer [8]byte
+	binary.LittleEndian.PutUint32(trailer[:4], crc32.ChecksumIEEE(bz))
+	binary.LittleEndian.PutUint32(trailer[4:], uint32(len(bz)))
+
+	return append(w.bz, trailer[:]...)
+}
@@ -6,12 +6,22 @@ import (
 
 // IBC transfer sentinel errors
 var (
-	ErrInvalidPacketTimeout    = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
-	ErrInvalidDenomForTransfer = sdkerrors.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
-	ErrInvalidVersion          = sdkerrors.Register(ModuleName, 4, "invalid ICS20 version")
-	ErrInvalidAmount           = sdkerrors.Register(ModuleName, 5, "invalid token amount")
-	ErrTraceNotFound           = sdkerrors.Register(ModuleName, 6, "denomination trace not found")
-	ErrSendDisabled            = sdkerrors.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
-	ErrReceiveDisabled         = sdkerrors.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
-	ErrMaxTransferChannels     = sdkerrors.Register(ModuleName, 9, "max transfer channels")
+	ErrInvalidPacketTimeout     = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidDenomForTransfer  = sdkerrors.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
+	ErrInvalidVersion           = sdkerrors.Register(ModuleName, 4, "invalid ICS20 version")
+	ErrInvalidAmount            = sdkerrors.Register(ModuleName, 5, "invalid token amount")
+	ErrTraceNotFound            = sdkerrors.Register(ModuleName, 6, "denomination trace not found")
+	ErrSendDisabled             = sdkerrors.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
+	ErrReceiveDisabled          = sdkerrors.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
+	ErrMaxTransferChannels      = sdkerrors.Register(ModuleName, 9, "max transfer channels")
+	ErrInvalidForwardMetadata   = sdkerrors.Register(ModuleName, 10, "invalid forward metadata")
+	ErrInvalidRelayerFee        = sdkerrors.Register(ModuleName, 11, "invalid relayer fee")
+	ErrInvalidMemo              = sdkerrors.Register(ModuleName, 12, "invalid packet memo")
+	ErrReceiveDenomBlocked      = sdkerrors.Register(ModuleName, 13, "denomination is blocked from being received on this chain")
+	ErrReceiveOnlyChannel       = sdkerrors.Register(ModuleName, 14, "fungible token transfers over this channel are disabled, the channel is receive only")
+	ErrInvalidPacketData        = sdkerrors.Register(ModuleName, 15, "invalid packet data")
+	ErrInvalidMultiTransfer     = sdkerrors.Register(ModuleName, 16, "invalid multi transfer")
+	ErrTimedOutTransferNotFound = sdkerrors.Register(ModuleName, 17, "timed out transfer not found")
+	ErrInvalidDenomMigration    = sdkerrors.Register(ModuleName, 18, "invalid escrow denomination migration")
+	ErrInvalidTimedOutTransfer  = sdkerrors.Register(ModuleName, 19, "invalid timed out transfer")
 )
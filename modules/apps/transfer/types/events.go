@@ -2,21 +2,45 @@ package types
 
 // IBC transfer events
 const (
-	EventTypeTimeout      = "timeout"
-	EventTypePacket       = "fungible_token_packet"
-	EventTypeTransfer     = "ibc_transfer"
-	EventTypeChannelClose = "channel_closed"
-	EventTypeDenomTrace   = "denomination_trace"
+	EventTypeTimeout       = "timeout"
+	EventTypePacket        = "fungible_token_packet"
+	EventTypeTransfer      = "ibc_transfer"
+	EventTypeChannelClose  = "channel_closed"
+	EventTypeDenomTrace    = "denomination_trace"
+	EventTypePacketForward = "packet_forward"
+	EventTypeRelayerFee    = "relayer_fee"
+	EventTypeDenomMetadata = "denomination_metadata"
 
-	AttributeKeyReceiver       = "receiver"
-	AttributeKeyDenom          = "denom"
-	AttributeKeyAmount         = "amount"
-	AttributeKeyRefundReceiver = "refund_receiver"
-	AttributeKeyRefundDenom    = "refund_denom"
-	AttributeKeyRefundAmount   = "refund_amount"
-	AttributeKeyAckSuccess     = "success"
-	AttributeKeyAck            = "acknowledgement"
-	AttributeKeyAckError       = "error"
-	AttributeKeyTraceHash      = "trace_hash"
-	AttributeKeyMemo           = "memo"
+	EventTypeSetReceiveOnlyChannel   = "set_receive_only_channel"
+	EventTypeSetDenomTransferEnabled = "set_denom_transfer_enabled"
+	EventTypeMigrateEscrowDenom      = "migrate_escrow_denom"
+
+	AttributeKeyReceiver        = "receiver"
+	AttributeKeyDenom           = "denom"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyRefundReceiver  = "refund_receiver"
+	AttributeKeyRefundDenom     = "refund_denom"
+	AttributeKeyRefundAmount    = "refund_amount"
+	AttributeKeyAckSuccess      = "success"
+	AttributeKeyAck             = "acknowledgement"
+	AttributeKeyAckError        = "error"
+	AttributeKeyTraceHash       = "trace_hash"
+	AttributeKeyMemo            = "memo"
+	AttributeKeyForwardPort     = "forward_port"
+	AttributeKeyForwardChannel  = "forward_channel"
+	AttributeKeyForwardSequence = "forward_sequence"
+	AttributeKeyRelayer         = "relayer"
+	AttributeKeyTokens          = "tokens"
+	AttributeKeyRefundTokens    = "refund_tokens"
+	AttributeKeyPortID          = "port_id"
+	AttributeKeyChannelID       = "channel_id"
+	AttributeKeyReceiveOnly     = "receive_only"
+	AttributeKeySendEnabled     = "send_enabled"
+	AttributeKeyReceiveEnabled  = "receive_enabled"
+	AttributeKeyRemove          = "remove"
+	AttributeKeyOldDenom        = "old_denom"
+	AttributeKeyNewDenom        = "new_denom"
+	AttributeKeyEscrowAddress   = "escrow_address"
+	AttributeKeyDisplay         = "display"
+	AttributeKeySymbol          = "symbol"
 )
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+const (
+	// MaxForwardHops is the maximum number of hops a token may be forwarded through
+	// from the receiving chain, as specified by the nested forward objects in the packet memo.
+	MaxForwardHops = 4
+
+	// forwardAddressPrefix is used to provide domain separation between escrow and forward addresses
+	forwardAddressPrefix = "forward"
+)
+
+// DefaultForwardTimeout is the default timeout (in nanoseconds) relative to the current block
+// time of the receiving chain which is used for forwarded packets that do not specify a timeout.
+var DefaultForwardTimeout = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+
+// PacketMemo defines the structure of a packet memo which instructs the receiving chain to
+// forward the received tokens to another chain.
+//
+// Example:
+//
+//	{"forward":{"receiver":"cosmos1...","port":"transfer","channel":"channel-1","timeout":600000000000}}
+type PacketMemo struct {
+	Forward *ForwardMetadata `json:"forward,omitempty"`
+}
+
+// ForwardMetadata defines the destination of the next hop a received token is forwarded to.
+// The optional timeout is relative (in nanoseconds) to the block time of the forwarding chain.
+// Additional hops may be specified by the next field, which is used as the forward object of
+// the memo of the forwarded packet.
+type ForwardMetadata struct {
+	Receiver string           `json:"receiver"`
+	Port     string           `json:"port"`
+	Channel  string           `json:"channel"`
+	Timeout  uint64           `json:"timeout,omitempty"`
+	Next     *ForwardMetadata `json:"next,omitempty"`
+}
+
+// ParseForwardMetadata returns the forward metadata contained in the provided packet memo.
+// A nil value is returned if the memo is not a JSON object or does not contain a forward
+// object, since the memo field may be used for arbitrary purposes. An error is returned if
+// the forward object is present but malformed.
+func ParseForwardMetadata(memo string) (*ForwardMetadata, error) {
+	if !strings.Contains(memo, `"forward"`) {
+		return nil, nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &raw); err != nil {
+		return nil, nil
+	}
+
+	if _, ok := raw["forward"]; !ok {
+		return nil, nil
+	}
+
+	var packetMemo PacketMemo
+	if err := json.Unmarshal([]byte(memo), &packetMemo); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidForwardMetadata, "cannot unmarshal forward metadata: %s", err.Error())
+	}
+
+	if packetMemo.Forward == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidForwardMetadata, "forward metadata cannot be null")
+	}
+
+	if err := packetMemo.Forward.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return packetMemo.Forward, nil
+}
+
+// ValidateBasic performs a basic validation of the forward metadata fields, including the
+// nested forward objects of subsequent hops.
+func (fm ForwardMetadata) ValidateBasic() error {
+	hops := 0
+	for next := &fm; next != nil; next = next.Next {
+		hops++
+		if hops > MaxForwardHops {
+			return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "number of forward hops cannot exceed %d", MaxForwardHops)
+		}
+
+		if strings.TrimSpace(next.Receiver) == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "forward receiver address cannot be blank")
+		}
+		if err := host.PortIdentifierValidator(next.Port); err != nil {
+			return sdkerrors.Wrapf(err, "invalid forward port ID %s", next.Port)
+		}
+		if err := host.ChannelIdentifierValidator(next.Channel); err != nil {
+			return sdkerrors.Wrapf(err, "invalid forward channel ID %s", next.Channel)
+		}
+	}
+
+	return nil
+}
+
+// GetTimeout returns the relative timeout of the forwarded packet, using the default forward
+// timeout if none is specified.
+func (fm ForwardMetadata) GetTimeout() uint64 {
+	if fm.Timeout == 0 {
+		return DefaultForwardTimeout
+	}
+
+	return fm.Timeout
+}
+
+// NextMemo returns the memo to be used for the forwarded packet. An empty memo is returned if
+// the current hop is the last one.
+func (fm ForwardMetadata) NextMemo() (string, error) {
+	if fm.Next == nil {
+		return "", nil
+	}
+
+	bz, err := json.Marshal(PacketMemo{Forward: fm.Next})
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// GetForwardAddress returns the address of the account which temporarily holds tokens received
+// on the specified channel while they are forwarded to the next hop. The address is derived
+// using the same construction as the escrow address with an additional domain separator.
+func GetForwardAddress(portID, channelID string) sdk.AccAddress {
+	contents := fmt.Sprintf("%s/%s/%s", forwardAddressPrefix, portID, channelID)
+
+	// ADR 028 AddressHash construction
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+var receiver = sdk.AccAddress("testaddr2").String()
+
+func TestParseForwardMetadata(t *testing.T) {
+	nestedForward := func(hops int) string {
+		memo := fmt.Sprintf(`{"receiver":"%s","port":"transfer","channel":"channel-0"}`, receiver)
+		for i := 1; i < hops; i++ {
+			memo = fmt.Sprintf(`{"receiver":"%s","port":"transfer","channel":"channel-0","next":%s}`, receiver, memo)
+		}
+		return fmt.Sprintf(`{"forward":%s}`, memo)
+	}
+
+	testCases := []struct {
+		name       string
+		memo       string
+		expForward bool
+		expPass    bool
+	}{
+		{"empty memo", "", false, true},
+		{"memo is not json", "memo", false, true},
+		{"memo is not a json object", `"forward"`, false, true},
+		{"memo without forward object", `{"wasm":{"forward":"contract"}}`, false, true},
+		{"valid forward", fmt.Sprintf(`{"forward":{"receiver":"%s","port":"transfer","channel":"channel-1","timeout":600000000000}}`, receiver), true, true},
+		{"valid forward with max hops", nestedForward(types.MaxForwardHops), true, true},
+		{"forward exceeds max hops", nestedForward(types.MaxForwardHops + 1), false, false},
+		{"forward is null", `{"forward":null}`, false, false},
+		{"forward is not an object", `{"forward":"channel-1"}`, false, false},
+		{"blank receiver", `{"forward":{"receiver":" ","port":"transfer","channel":"channel-1"}}`, false, false},
+		{"invalid port", fmt.Sprintf(`{"forward":{"receiver":"%s","port":"(invalidport)","channel":"channel-1"}}`, receiver), false, false},
+		{"invalid channel", fmt.Sprintf(`{"forward":{"receiver":"%s","port":"transfer","channel":"(invalidchannel)"}}`, receiver), false, false},
+		{"invalid next hop", fmt.Sprintf(`{"forward":{"receiver":"%s","port":"transfer","channel":"channel-1","next":{"receiver":"%s","port":"transfer"}}}`, receiver, receiver), false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		forward, err := types.ParseForwardMetadata(tc.memo)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expForward, forward != nil, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+			require.Nil(t, forward, tc.name)
+		}
+	}
+}
+
+func TestForwardMetadataNextMemo(t *testing.T) {
+	forward := types.ForwardMetadata{
+		Receiver: receiver,
+		Port:     "transfer",
+		Channel:  "channel-1",
+		Next: &types.ForwardMetadata{
+			Receiver: receiver,
+			Port:     "transfer",
+			Channel:  "channel-2",
+			Timeout:  100,
+		},
+	}
+
+	memo, err := forward.NextMemo()
+	require.NoError(t, err)
+
+	next, err := types.ParseForwardMetadata(memo)
+	require.NoError(t, err)
+	require.Equal(t, forward.Next, next)
+	require.Equal(t, uint64(100), next.GetTimeout())
+
+	// the last hop does not forward the packet any further
+	memo, err = next.NextMemo()
+	require.NoError(t, err)
+	require.Empty(t, memo)
+	require.Equal(t, types.DefaultForwardTimeout, forward.GetTimeout())
+}
+
+// Test that there is domain separation between forward addresses and escrow addresses
+func TestGetForwardAddress(t *testing.T) {
+	forwardAddr := types.GetForwardAddress("transfer", "channel-0")
+	require.NotEqual(t, types.GetEscrowAddress("transfer", "channel-0"), forwardAddr)
+	require.NotEqual(t, types.GetForwardAddress("transfer", "channel-1"), forwardAddr)
+}
@@ -1,24 +1,43 @@
 package types
 
 import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 )
 
 // NewGenesisState creates a new ibc-transfer GenesisState instance.
-func NewGenesisState(portID string, denomTraces Traces, params Params) *GenesisState {
+func NewGenesisState(
+	portID string, denomTraces Traces, params Params, totalEscrowed sdk.Coins,
+	receiveOnlyChannels []ReceiveOnlyChannel, denomTransferEnabled []DenomTransferEnabled,
+	migratedDenoms []MigratedDenom, timedOutTransfers []IdentifiedTimedOutTransfer,
+) *GenesisState {
 	return &GenesisState{
-		PortId:      portID,
-		DenomTraces: denomTraces,
-		Params:      params,
+		PortId:               portID,
+		DenomTraces:          denomTraces,
+		Params:               params,
+		TotalEscrowed:        totalEscrowed,
+		ReceiveOnlyChannels:  receiveOnlyChannels,
+		DenomTransferEnabled: denomTransferEnabled,
+		MigratedDenoms:       migratedDenoms,
+		TimedOutTransfers:    timedOutTransfers,
 	}
 }
 
 // DefaultGenesisState returns a GenesisState with "transfer" as the default PortID.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PortId:      PortID,
-		DenomTraces: Traces{},
-		Params:      DefaultParams(),
+		PortId:               PortID,
+		DenomTraces:          Traces{},
+		Params:               DefaultParams(),
+		TotalEscrowed:        sdk.Coins{},
+		ReceiveOnlyChannels:  []ReceiveOnlyChannel{},
+		DenomTransferEnabled: []DenomTransferEnabled{},
+		MigratedDenoms:       []MigratedDenom{},
+		TimedOutTransfers:    []IdentifiedTimedOutTransfer{},
 	}
 }
 
@@ -31,5 +50,73 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
+	if err := gs.TotalEscrowed.Validate(); err != nil {
+		return err
+	}
+	for _, channel := range gs.ReceiveOnlyChannels {
+		if err := host.PortIdentifierValidator(channel.PortId); err != nil {
+			return sdkerrors.Wrap(err, "invalid receive only channel port ID")
+		}
+		if err := host.ChannelIdentifierValidator(channel.ChannelId); err != nil {
+			return sdkerrors.Wrap(err, "invalid receive only channel ID")
+		}
+	}
+	seenDenoms := make(map[string]bool)
+	for _, override := range gs.DenomTransferEnabled {
+		if err := ValidateDenomTransferEnabled(override.Denom); err != nil {
+			return err
+		}
+		if seenDenoms[override.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidDenomForTransfer, "duplicate transfer enabled override for denomination %s", override.Denom)
+		}
+		seenDenoms[override.Denom] = true
+	}
+	migratedDenoms := make(map[string]bool)
+	for _, migratedDenom := range gs.MigratedDenoms {
+		if err := ValidateEscrowDenomMigration(migratedDenom.OldDenom, migratedDenom.NewDenom); err != nil {
+			return err
+		}
+		if migratedDenoms[migratedDenom.OldDenom] {
+			return sdkerrors.Wrapf(ErrInvalidDenomMigration, "duplicate migration of denomination %s", migratedDenom.OldDenom)
+		}
+		migratedDenoms[migratedDenom.OldDenom] = true
+	}
+	timedOutTransfers := make(map[string]bool)
+	for _, transfer := range gs.TimedOutTransfers {
+		if err := transfer.Validate(); err != nil {
+			return err
+		}
+		key := string(KeyTimedOutTransfer(transfer.PortId, transfer.ChannelId, transfer.Sequence))
+		if timedOutTransfers[key] {
+			return sdkerrors.Wrapf(ErrInvalidTimedOutTransfer, "duplicate timed out transfer for port ID (%s) channel ID (%s) sequence (%d)", transfer.PortId, transfer.ChannelId, transfer.Sequence)
+		}
+		timedOutTransfers[key] = true
+	}
 	return gs.Params.Validate()
 }
+
+// Validate performs basic validation of the timed out transfer and the identifiers of its packet.
+func (t IdentifiedTimedOutTransfer) Validate() error {
+	if err := host.PortIdentifierValidator(t.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid timed out transfer port ID")
+	}
+	if err := host.ChannelIdentifierValidator(t.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid timed out transfer channel ID")
+	}
+	if t.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidTimedOutTransfer, "sequence cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(t.Transfer.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if strings.TrimSpace(t.Transfer.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if t.Transfer.Tokens.Empty() || !t.Transfer.Tokens.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidTimedOutTransfer, "tokens must be valid and non-empty")
+	}
+	if t.Transfer.ExpiryTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidTimedOutTransfer, "expiry timestamp cannot be 0")
+	}
+	return nil
+}
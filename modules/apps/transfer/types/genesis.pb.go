@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -28,6 +30,19 @@ type GenesisState struct {
 	PortId      string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
 	DenomTraces Traces `protobuf:"bytes,2,rep,name=denom_traces,json=denomTraces,proto3,castrepeated=Traces" json:"denom_traces" yaml:"denom_traces"`
 	Params      Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// total_escrowed contains the total amount of tokens escrowed
+	// by the transfer module
+	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed" yaml:"total_escrowed"`
+	// receive_only_channels contains the channels over which outbound transfers are disabled
+	ReceiveOnlyChannels []ReceiveOnlyChannel `protobuf:"bytes,5,rep,name=receive_only_channels,json=receiveOnlyChannels,proto3" json:"receive_only_channels" yaml:"receive_only_channels"`
+	// denom_transfer_enabled contains the per denomination overrides of the send_enabled
+	// and receive_enabled parameters
+	DenomTransferEnabled []DenomTransferEnabled `protobuf:"bytes,6,rep,name=denom_transfer_enabled,json=denomTransferEnabled,proto3" json:"denom_transfer_enabled" yaml:"denom_transfer_enabled"`
+	// migrated_denoms contains the base denominations whose escrowed tokens were migrated to a
+	// new denomination
+	MigratedDenoms []MigratedDenom `protobuf:"bytes,7,rep,name=migrated_denoms,json=migratedDenoms,proto3" json:"migrated_denoms" yaml:"migrated_denoms"`
+	// timed_out_transfers contains the transfers of timed out packets which may be retried
+	TimedOutTransfers []IdentifiedTimedOutTransfer `protobuf:"bytes,8,rep,name=timed_out_transfers,json=timedOutTransfers,proto3" json:"timed_out_transfers" yaml:"timed_out_transfers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,8 +99,174 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEscrowed
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceiveOnlyChannels() []ReceiveOnlyChannel {
+	if m != nil {
+		return m.ReceiveOnlyChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomTransferEnabled() []DenomTransferEnabled {
+	if m != nil {
+		return m.DenomTransferEnabled
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMigratedDenoms() []MigratedDenom {
+	if m != nil {
+		return m.MigratedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimedOutTransfers() []IdentifiedTimedOutTransfer {
+	if m != nil {
+		return m.TimedOutTransfers
+	}
+	return nil
+}
+
+// ReceiveOnlyChannel contains the PortID & ChannelID for a receive only channel
+type ReceiveOnlyChannel struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *ReceiveOnlyChannel) Reset()         { *m = ReceiveOnlyChannel{} }
+func (m *ReceiveOnlyChannel) String() string { return proto.CompactTextString(m) }
+func (*ReceiveOnlyChannel) ProtoMessage()    {}
+func (*ReceiveOnlyChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f788affd5bea89, []int{1}
+}
+func (m *ReceiveOnlyChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiveOnlyChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiveOnlyChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiveOnlyChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiveOnlyChannel.Merge(m, src)
+}
+func (m *ReceiveOnlyChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiveOnlyChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiveOnlyChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiveOnlyChannel proto.InternalMessageInfo
+
+func (m *ReceiveOnlyChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ReceiveOnlyChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// IdentifiedTimedOutTransfer contains the transfer of a timed out packet along with the port, channel
+// and sequence of the packet
+type IdentifiedTimedOutTransfer struct {
+	// the port on which the packet was sent
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// the channel on which the packet was sent
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the sequence of the packet
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the transfer of the timed out packet
+	Transfer TimedOutTransfer `protobuf:"bytes,4,opt,name=transfer,proto3" json:"transfer"`
+}
+
+func (m *IdentifiedTimedOutTransfer) Reset()         { *m = IdentifiedTimedOutTransfer{} }
+func (m *IdentifiedTimedOutTransfer) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedTimedOutTransfer) ProtoMessage()    {}
+func (*IdentifiedTimedOutTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f788affd5bea89, []int{2}
+}
+func (m *IdentifiedTimedOutTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedTimedOutTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedTimedOutTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedTimedOutTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedTimedOutTransfer.Merge(m, src)
+}
+func (m *IdentifiedTimedOutTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedTimedOutTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedTimedOutTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedTimedOutTransfer proto.InternalMessageInfo
+
+func (m *IdentifiedTimedOutTransfer) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *IdentifiedTimedOutTransfer) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IdentifiedTimedOutTransfer) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *IdentifiedTimedOutTransfer) GetTransfer() TimedOutTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return TimedOutTransfer{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
+	proto.RegisterType((*ReceiveOnlyChannel)(nil), "ibc.applications.transfer.v1.ReceiveOnlyChannel")
+	proto.RegisterType((*IdentifiedTimedOutTransfer)(nil), "ibc.applications.transfer.v1.IdentifiedTimedOutTransfer")
 }
 
 func init() {
@@ -93,28 +274,49 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x1b, 0x27, 0x15, 0xbb, 0xe1, 0xa1, 0x7a, 0x18, 0x43, 0xda, 0x51, 0x14, 0x8a, 0xc3,
-	0x84, 0x4d, 0x50, 0xf0, 0x58, 0x04, 0xf1, 0xa6, 0xd3, 0x93, 0x97, 0x91, 0xa6, 0xb1, 0x06, 0xd6,
-	0xa6, 0xe4, 0x9f, 0x0d, 0xf6, 0x16, 0x3e, 0x87, 0x4f, 0xb2, 0xe3, 0x8e, 0x9e, 0xa6, 0x6c, 0x6f,
-	0x30, 0x5f, 0x40, 0xd2, 0xcd, 0xb1, 0x53, 0x6f, 0x1f, 0xc9, 0xef, 0xfb, 0xbe, 0x3f, 0x9f, 0x73,
-	0x21, 0x62, 0x46, 0x68, 0x51, 0x0c, 0x05, 0xa3, 0x5a, 0xc8, 0x1c, 0x88, 0x56, 0x34, 0x87, 0x37,
-	0xae, 0xc8, 0xb8, 0x4b, 0x52, 0x9e, 0x73, 0x10, 0x80, 0x0b, 0x25, 0xb5, 0x74, 0x4f, 0x45, 0xcc,
-	0xf0, 0x2e, 0x8b, 0xff, 0x59, 0x3c, 0xee, 0xb6, 0x3a, 0x95, 0x49, 0x5b, 0xb2, 0x8c, 0x6a, 0x9d,
-	0xa4, 0x32, 0x95, 0xa5, 0x24, 0x46, 0xad, 0x5f, 0x83, 0x5f, 0xe4, 0x34, 0xee, 0xd7, 0x95, 0xcf,
-	0x9a, 0x6a, 0xee, 0x76, 0x9c, 0x83, 0x42, 0x2a, 0x3d, 0x10, 0x49, 0x13, 0xb5, 0x51, 0x78, 0x18,
-	0xb9, 0xab, 0xb9, 0x7f, 0x34, 0xa1, 0xd9, 0xf0, 0x36, 0xd8, 0x7c, 0x04, 0x7d, 0xdb, 0xa8, 0x87,
-	0xc4, 0x55, 0x4e, 0x23, 0xe1, 0xb9, 0xcc, 0x06, 0x5a, 0x51, 0xc6, 0xa1, 0xb9, 0xd7, 0xae, 0x85,
-	0xf5, 0x5e, 0x88, 0xab, 0xae, 0xc6, 0x77, 0xc6, 0xf1, 0x62, 0x0c, 0xd1, 0xf9, 0x74, 0xee, 0x5b,
-	0xab, 0xb9, 0x7f, 0xbc, 0xce, 0xdf, 0xcd, 0x0a, 0x3e, 0xbf, 0x7d, 0xbb, 0xa4, 0xa0, 0x5f, 0x4f,
-	0xb6, 0x16, 0x70, 0x23, 0xc7, 0x2e, 0xa8, 0xa2, 0x19, 0x34, 0x6b, 0x6d, 0x14, 0xd6, 0x7b, 0x67,
-	0xd5, 0x6d, 0x8f, 0x25, 0x1b, 0xed, 0x9b, 0xa6, 0xfe, 0xc6, 0x19, 0x3d, 0x4d, 0x17, 0x1e, 0x9a,
-	0x2d, 0x3c, 0xf4, 0xb3, 0xf0, 0xd0, 0xc7, 0xd2, 0xb3, 0x66, 0x4b, 0xcf, 0xfa, 0x5a, 0x7a, 0xd6,
-	0xeb, 0x4d, 0x2a, 0xf4, 0xfb, 0x28, 0xc6, 0x4c, 0x66, 0x84, 0x49, 0xc8, 0x24, 0x10, 0x11, 0xb3,
-	0xcb, 0x54, 0x92, 0xf1, 0x35, 0xc9, 0x64, 0x32, 0x1a, 0x72, 0x30, 0x93, 0xef, 0x4c, 0xad, 0x27,
-	0x05, 0x87, 0xd8, 0x2e, 0xf7, 0xbc, 0xfa, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x5a, 0xd2, 0x49,
-	0xde, 0x01, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0x6e, 0xb6, 0xfe, 0xba, 0xcd, 0xdb, 0x6f, 0x68, 0xd9, 0x1f, 0x85, 0x6a, 0xa4, 0x53, 0x34,
+	0xa4, 0x8a, 0x69, 0x09, 0x1d, 0x08, 0x10, 0xc7, 0x8c, 0x09, 0xf5, 0x80, 0x36, 0xc2, 0x4e, 0x5c,
+	0x22, 0x27, 0xf6, 0x3a, 0x8b, 0xc4, 0x2e, 0xb1, 0xdb, 0xa9, 0x5f, 0x01, 0x90, 0xe0, 0x4b, 0x70,
+	0xe1, 0x93, 0xec, 0xb8, 0x23, 0xa7, 0x82, 0xb6, 0x33, 0x97, 0x7d, 0x02, 0x14, 0xdb, 0x0b, 0x5d,
+	0x3b, 0x22, 0xb8, 0x70, 0xaa, 0x63, 0x3f, 0xcf, 0xf3, 0x3e, 0xf6, 0xd3, 0xf7, 0x05, 0xf7, 0x48,
+	0x14, 0x7b, 0xb0, 0xdb, 0x4d, 0x48, 0x0c, 0x05, 0x61, 0x94, 0x7b, 0x22, 0x83, 0x94, 0x1f, 0xe1,
+	0xcc, 0xeb, 0xb7, 0xbc, 0x0e, 0xa6, 0x98, 0x13, 0xee, 0x76, 0x33, 0x26, 0x98, 0xb9, 0x4e, 0xa2,
+	0xd8, 0x1d, 0xc5, 0xba, 0x57, 0x58, 0xb7, 0xdf, 0xaa, 0x6f, 0x95, 0x2a, 0x15, 0x48, 0x29, 0x55,
+	0xb7, 0x63, 0xc6, 0x53, 0xc6, 0xbd, 0x08, 0x72, 0xec, 0xf5, 0x5b, 0x11, 0x16, 0xb0, 0xe5, 0xc5,
+	0x8c, 0x50, 0x7d, 0xbe, 0xd2, 0x61, 0x1d, 0x26, 0x97, 0x5e, 0xbe, 0x52, 0xbb, 0xce, 0xe7, 0x19,
+	0xb0, 0xf0, 0x5c, 0x59, 0x7a, 0x25, 0xa0, 0xc0, 0xe6, 0x16, 0x98, 0xe9, 0xb2, 0x4c, 0x84, 0x04,
+	0x59, 0xc6, 0x86, 0xd1, 0x9c, 0xf3, 0xcd, 0xcb, 0x61, 0x63, 0x71, 0x00, 0xd3, 0xe4, 0xa9, 0xa3,
+	0x0f, 0x9c, 0xa0, 0x96, 0xaf, 0xda, 0xc8, 0xcc, 0xc0, 0x02, 0xc2, 0x94, 0xa5, 0xa1, 0xc8, 0x60,
+	0x8c, 0xb9, 0x35, 0xb5, 0x31, 0xdd, 0x9c, 0xdf, 0x69, 0xba, 0x65, 0xb7, 0x72, 0x9f, 0xe5, 0x8c,
+	0xc3, 0x9c, 0xe0, 0xdf, 0x3d, 0x1d, 0x36, 0x2a, 0x97, 0xc3, 0xc6, 0xb2, 0xd2, 0x1f, 0xd5, 0x72,
+	0xbe, 0x7c, 0x6b, 0xd4, 0x24, 0x8a, 0x07, 0xf3, 0xa8, 0xa0, 0x70, 0xd3, 0x07, 0xb5, 0x2e, 0xcc,
+	0x60, 0xca, 0xad, 0xe9, 0x0d, 0xa3, 0x39, 0xbf, 0xb3, 0x59, 0x5e, 0xed, 0x40, 0x62, 0xfd, 0x6a,
+	0x5e, 0x29, 0xd0, 0x4c, 0xf3, 0xbd, 0x01, 0x16, 0x05, 0x13, 0x30, 0x09, 0x31, 0x8f, 0x33, 0x76,
+	0x82, 0x91, 0x55, 0x95, 0xd6, 0x6f, 0xbb, 0xea, 0x15, 0xdd, 0xfc, 0x15, 0x5d, 0xfd, 0x8a, 0xee,
+	0x2e, 0x23, 0xd4, 0x6f, 0x6b, 0xaf, 0xab, 0xca, 0xeb, 0x75, 0x7a, 0xee, 0xb6, 0xd9, 0x21, 0xe2,
+	0xb8, 0x17, 0xb9, 0x31, 0x4b, 0x3d, 0x9d, 0x85, 0xfa, 0xd9, 0xe6, 0xe8, 0x8d, 0x27, 0x06, 0x5d,
+	0xcc, 0xa5, 0x12, 0x0f, 0xfe, 0x97, 0xe4, 0x3d, 0xcd, 0x35, 0xdf, 0x19, 0x60, 0x35, 0xc3, 0x31,
+	0x26, 0x7d, 0x1c, 0x32, 0x9a, 0x0c, 0xc2, 0xf8, 0x18, 0x52, 0x8a, 0x13, 0x6e, 0xfd, 0x27, 0x4d,
+	0xdd, 0x2f, 0xbf, 0x61, 0xa0, 0xa8, 0xfb, 0x34, 0x19, 0xec, 0x2a, 0xa2, 0xbf, 0xa9, 0xbd, 0xae,
+	0x2b, 0xaf, 0x37, 0x8a, 0x3b, 0xc1, 0x72, 0x36, 0xc1, 0xe4, 0xe6, 0x47, 0x03, 0xac, 0x15, 0x39,
+	0xc8, 0x1a, 0x21, 0xa6, 0x30, 0x4a, 0x30, 0xb2, 0x6a, 0xd2, 0xcd, 0xce, 0x9f, 0xa5, 0x2b, 0x37,
+	0xf6, 0x14, 0xb3, 0xc8, 0xf9, 0xce, 0x58, 0xce, 0xd7, 0xf4, 0x9d, 0x60, 0x05, 0xdd, 0x40, 0x36,
+	0x05, 0xb8, 0x95, 0x92, 0x4e, 0x06, 0x05, 0x46, 0xa1, 0x04, 0x70, 0x6b, 0x46, 0x3a, 0xd9, 0x2a,
+	0x77, 0xf2, 0x42, 0x93, 0xa4, 0x23, 0xdf, 0xd6, 0x16, 0xd6, 0x94, 0x85, 0x31, 0x45, 0x27, 0x58,
+	0x4c, 0x47, 0xe1, 0xdc, 0xfc, 0x60, 0x80, 0x65, 0x41, 0x52, 0x8c, 0x42, 0xd6, 0x13, 0x85, 0x57,
+	0x6e, 0xcd, 0xca, 0xd2, 0x4f, 0xca, 0x4b, 0xb7, 0x11, 0xa6, 0x82, 0x1c, 0x11, 0x8c, 0x0e, 0x73,
+	0x89, 0xfd, 0x9e, 0xb8, 0xba, 0x94, 0xef, 0x68, 0x1f, 0x75, 0xfd, 0x37, 0x9a, 0x2c, 0xe1, 0x04,
+	0x4b, 0x62, 0x8c, 0xc5, 0x9d, 0x13, 0x60, 0x4e, 0xe6, 0xfc, 0x77, 0xcd, 0xfa, 0x10, 0x00, 0x9d,
+	0x7d, 0x8e, 0x9f, 0x92, 0xf8, 0xd5, 0xcb, 0x61, 0x63, 0x49, 0xe1, 0x7f, 0x9d, 0x39, 0xc1, 0x9c,
+	0xfe, 0x68, 0x23, 0xe7, 0x87, 0x01, 0xea, 0xbf, 0xbf, 0xce, 0x3f, 0x70, 0x60, 0xd6, 0xc1, 0x2c,
+	0xc7, 0x6f, 0x7b, 0x98, 0xc6, 0x58, 0xb6, 0x7c, 0x35, 0x28, 0xbe, 0xcd, 0x03, 0x30, 0x7b, 0xf5,
+	0x6e, 0x56, 0x55, 0x8e, 0x03, 0xb7, 0x3c, 0x99, 0x89, 0x3c, 0xd4, 0x60, 0x28, 0x54, 0xfc, 0x97,
+	0xa7, 0xe7, 0xb6, 0x71, 0x76, 0x6e, 0x1b, 0xdf, 0xcf, 0x6d, 0xe3, 0xd3, 0x85, 0x5d, 0x39, 0xbb,
+	0xb0, 0x2b, 0x5f, 0x2f, 0xec, 0xca, 0xeb, 0xc7, 0x93, 0xfd, 0x4d, 0xa2, 0x78, 0xbb, 0xc3, 0xbc,
+	0xfe, 0x23, 0x2f, 0x65, 0xa8, 0x97, 0x60, 0x9e, 0x4f, 0xeb, 0x91, 0x29, 0x2d, 0x9b, 0x3e, 0xaa,
+	0xc9, 0x51, 0xfb, 0xe0, 0xe7, 0x00, 0xa2, 0x3e, 0x36, 0xa8, 0x19, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -137,6 +339,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TimedOutTransfers) > 0 {
+		for iNdEx := len(m.TimedOutTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimedOutTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MigratedDenoms) > 0 {
+		for iNdEx := len(m.MigratedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigratedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DenomTransferEnabled) > 0 {
+		for iNdEx := len(m.DenomTransferEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTransferEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ReceiveOnlyChannels) > 0 {
+		for iNdEx := len(m.ReceiveOnlyChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiveOnlyChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalEscrowed) > 0 {
+		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEscrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -154,62 +426,653 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiveOnlyChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiveOnlyChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiveOnlyChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedTimedOutTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedTimedOutTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedTimedOutTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DenomTraces) > 0 {
+		for _, e := range m.DenomTraces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TotalEscrowed) > 0 {
+		for _, e := range m.TotalEscrowed {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReceiveOnlyChannels) > 0 {
+		for _, e := range m.ReceiveOnlyChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomTransferEnabled) > 0 {
+		for _, e := range m.DenomTransferEnabled {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MigratedDenoms) > 0 {
+		for _, e := range m.MigratedDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimedOutTransfers) > 0 {
+		for _, e := range m.TimedOutTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReceiveOnlyChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *IdentifiedTimedOutTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = m.Transfer.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTraces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTraces = append(m.DenomTraces, DenomTrace{})
+			if err := m.DenomTraces[len(m.DenomTraces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEscrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalEscrowed = append(m.TotalEscrowed, types.Coin{})
+			if err := m.TotalEscrowed[len(m.TotalEscrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveOnlyChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiveOnlyChannels = append(m.ReceiveOnlyChannels, ReceiveOnlyChannel{})
+			if err := m.ReceiveOnlyChannels[len(m.ReceiveOnlyChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTransferEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTransferEnabled = append(m.DenomTransferEnabled, DenomTransferEnabled{})
+			if err := m.DenomTransferEnabled[len(m.DenomTransferEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x12
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigratedDenoms = append(m.MigratedDenoms, MigratedDenom{})
+			if err := m.MigratedDenoms[len(m.MigratedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOutTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimedOutTransfers = append(m.TimedOutTransfers, IdentifiedTimedOutTransfer{})
+			if err := m.TimedOutTransfers[len(m.TimedOutTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.DenomTraces) > 0 {
-		for _, e := range m.DenomTraces {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+func (m *ReceiveOnlyChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiveOnlyChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiveOnlyChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *IdentifiedTimedOutTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -232,10 +1095,10 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifiedTimedOutTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifiedTimedOutTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -272,9 +1135,9 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomTraces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -284,29 +1147,46 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomTraces = append(m.DenomTraces, DenomTrace{})
-			if err := m.DenomTraces[len(m.DenomTraces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -333,7 +1213,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3,12 +3,29 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 )
 
 func TestValidateGenesis(t *testing.T) {
+	timedOutTransfer := func(malleate func(*types.IdentifiedTimedOutTransfer)) []types.IdentifiedTimedOutTransfer {
+		transfer := types.IdentifiedTimedOutTransfer{
+			PortId:    types.PortID,
+			ChannelId: "channel-0",
+			Sequence:  1,
+			Transfer: types.TimedOutTransfer{
+				Sender:          sdk.AccAddress("sender").String(),
+				Receiver:        "receiver",
+				Tokens:          sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))),
+				ExpiryTimestamp: 1,
+			},
+		}
+		malleate(&transfer)
+		return []types.IdentifiedTimedOutTransfer{transfer}
+	}
+
 	testCases := []struct {
 		name     string
 		genState *types.GenesisState
@@ -26,6 +43,106 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid genesis with total escrowed",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))), nil, nil, nil, nil),
+			true,
+		},
+		{
+			"invalid total escrowed",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(-1)}}, nil, nil, nil, nil),
+			false,
+		},
+		{
+			"valid genesis with receive only channels",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, []types.ReceiveOnlyChannel{{PortId: types.PortID, ChannelId: "channel-0"}}, nil, nil, nil),
+			true,
+		},
+		{
+			"invalid receive only channel port ID",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, []types.ReceiveOnlyChannel{{PortId: "(INVALIDPORT)", ChannelId: "channel-0"}}, nil, nil, nil),
+			false,
+		},
+		{
+			"invalid receive only channel ID",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, []types.ReceiveOnlyChannel{{PortId: types.PortID, ChannelId: "(INVALIDCHANNEL)"}}, nil, nil, nil),
+			false,
+		},
+		{
+			"valid genesis with denom transfer enabled overrides",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, []types.DenomTransferEnabled{{Denom: "uatom"}, {Denom: "transfer/channel-0/uatom", SendEnabled: types.NewTransferEnabledFlag(true)}}, nil, nil),
+			true,
+		},
+		{
+			"invalid denom transfer enabled override denomination",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, []types.DenomTransferEnabled{{Denom: "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"}}, nil, nil),
+			false,
+		},
+		{
+			"duplicate denom transfer enabled override",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, []types.DenomTransferEnabled{{Denom: "uatom"}, {Denom: "uatom", SendEnabled: types.NewTransferEnabledFlag(true)}}, nil, nil),
+			false,
+		},
+		{
+			"valid genesis with migrated denoms",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, nil, []types.MigratedDenom{{OldDenom: "uatom", NewDenom: "unewatom"}, {OldDenom: "stake", NewDenom: "unewatom"}}, nil),
+			true,
+		},
+		{
+			"invalid migrated denom",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, nil, []types.MigratedDenom{{OldDenom: "uatom", NewDenom: "transfer/channel-0/uatom"}}, nil),
+			false,
+		},
+		{
+			"duplicate migrated denom",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, nil, []types.MigratedDenom{{OldDenom: "uatom", NewDenom: "unewatom"}, {OldDenom: "uatom", NewDenom: "stake"}}, nil),
+			false,
+		},
+		{
+			"valid timed out transfer",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, nil, nil, timedOutTransfer(func(*types.IdentifiedTimedOutTransfer) {})),
+			true,
+		},
+		{
+			"duplicate timed out transfer",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, nil, nil, append(timedOutTransfer(func(*types.IdentifiedTimedOutTransfer) {}), timedOutTransfer(func(*types.IdentifiedTimedOutTransfer) {})...)),
+			false,
+		},
+		{
+			"timed out transfer with invalid channel ID",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, nil, nil, timedOutTransfer(func(transfer *types.IdentifiedTimedOutTransfer) {
+				transfer.ChannelId = "(INVALIDCHANNEL)"
+			})),
+			false,
+		},
+		{
+			"timed out transfer with zero sequence",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, nil, nil, timedOutTransfer(func(transfer *types.IdentifiedTimedOutTransfer) {
+				transfer.Sequence = 0
+			})),
+			false,
+		},
+		{
+			"timed out transfer with invalid sender",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, nil, nil, timedOutTransfer(func(transfer *types.IdentifiedTimedOutTransfer) {
+				transfer.Transfer.Sender = "invalid"
+			})),
+			false,
+		},
+		{
+			"timed out transfer without tokens",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, nil, nil, timedOutTransfer(func(transfer *types.IdentifiedTimedOutTransfer) {
+				transfer.Transfer.Tokens = sdk.Coins{}
+			})),
+			false,
+		},
+		{
+			"timed out transfer with zero expiry timestamp",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{}, nil, nil, nil, timedOutTransfer(func(transfer *types.IdentifiedTimedOutTransfer) {
+				transfer.Transfer.ExpiryTimestamp = 0
+			})),
+			false,
+		},
 		{
 			"invalid client",
 			&types.GenesisState{
@@ -1,10 +1,15 @@
 package types
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
@@ -15,6 +20,10 @@ const (
 	// module supports
 	Version = "ics20-1"
 
+	// V2 defines the version of IBC transfer channels which transfer multiple
+	// tokens atomically in a single packet
+	V2 = "ics20-2"
+
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
 
@@ -29,6 +38,10 @@ const (
 
 	// DenomPrefix is the prefix used for internal SDK coin representation.
 	DenomPrefix = "ibc"
+
+	// TimedOutTransferRetryPeriod is the duration after the timeout of a packet during which its
+	// transfer may be retried. The transfer is pruned once the period elapsed.
+	TimedOutTransferRetryPeriod = 7 * 24 * time.Hour
 )
 
 var (
@@ -36,11 +49,106 @@ var (
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// TotalEscrowForDenomKey defines the key prefix to store the total amount of tokens in escrow per denomination
+	TotalEscrowForDenomKey = []byte{0x03}
+	// ForwardedPacketKey defines the key prefix to store the inbound packets awaiting the acknowledgement of a forwarded packet
+	ForwardedPacketKey = []byte{0x04}
+	// ReceiveOnlyChannelKey defines the key prefix to store the flag indicating that outbound transfers are disabled for a channel
+	ReceiveOnlyChannelKey = []byte{0x05}
+	// DenomTransferEnabledKey defines the key prefix to store the per denomination overrides of the send and receive enabled params
+	DenomTransferEnabledKey = []byte{0x06}
+	// TimedOutTransferKey defines the key prefix to store the transfers of timed out packets which may be retried
+	TimedOutTransferKey = []byte{0x07}
+	// MigratedDenomKey defines the key prefix to store the new denomination of a base denomination whose escrowed tokens were migrated
+	MigratedDenomKey = []byte{0x08}
+	// TimedOutTransferExpiryKey defines the key prefix to index the transfers of timed out packets by their expiry timestamp
+	TimedOutTransferExpiryKey = []byte{0x09}
 )
 
+// KeyDenomTransferEnabled returns the key under which the send and receive enabled override of the
+// provided denomination is stored.
+func KeyDenomTransferEnabled(denom string) []byte {
+	return append(DenomTransferEnabledKey, []byte(denom)...)
+}
+
+// KeyMigratedDenom returns the key under which the new denomination of the provided migrated base
+// denomination is stored.
+func KeyMigratedDenom(denom string) []byte {
+	return append(MigratedDenomKey, []byte(denom)...)
+}
+
+// KeyForwardedPacket returns the key under which the inbound packet forwarded by the outbound packet
+// with the provided port, channel and sequence is stored.
+func KeyForwardedPacket(portID, channelID string, sequence uint64) []byte {
+	return append(ForwardedPacketKey, []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// KeyTimedOutTransfer returns the key under which the transfer of the timed out packet sent on the
+// provided port and channel with the given sequence is stored.
+func KeyTimedOutTransfer(portID, channelID string, sequence uint64) []byte {
+	return append(TimedOutTransferKey, []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// KeyTimedOutTransferExpiry returns the key under which the transfer of the timed out packet sent on
+// the provided port and channel with the given sequence is indexed by its expiry timestamp. The
+// timestamp is big endian encoded so that the keys are ordered by expiry.
+func KeyTimedOutTransferExpiry(expiryTimestamp uint64, portID, channelID string, sequence uint64) []byte {
+	key := append(TimedOutTransferExpiryKey, sdk.Uint64ToBigEndian(expiryTimestamp)...)
+	return append(key, []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// ParseKeyTimedOutTransfer parses the port and channel identifiers and the sequence from the provided
+// timed out transfer key.
+func ParseKeyTimedOutTransfer(key []byte) (portID, channelID string, sequence uint64, err error) {
+	if !bytes.HasPrefix(key, TimedOutTransferKey) {
+		return "", "", 0, sdkerrors.Wrapf(sdkerrors.ErrLogic, "key prefix is incorrect: expected %x", TimedOutTransferKey)
+	}
+
+	keySplit := strings.Split(string(key[len(TimedOutTransferKey):]), "/")
+	if len(keySplit) != 3 {
+		return "", "", 0, sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	sequence, err = strconv.ParseUint(keySplit[2], 10, 64)
+	if err != nil {
+		return "", "", 0, sdkerrors.Wrapf(sdkerrors.ErrLogic, "key provided is incorrect: invalid sequence: %v", err)
+	}
+
+	return keySplit[0], keySplit[1], sequence, nil
+}
+
+// KeyReceiveOnlyChannel returns the key under which the receive only flag of the channel with the
+// provided port and channel identifiers is stored.
+func KeyReceiveOnlyChannel(portID, channelID string) []byte {
+	return append(ReceiveOnlyChannelKey, []byte(fmt.Sprintf("%s/%s", portID, channelID))...)
+}
+
+// ParseKeyReceiveOnlyChannel parses the port and channel identifiers from the provided receive only
+// channel key.
+func ParseKeyReceiveOnlyChannel(key []byte) (portID, channelID string, err error) {
+	if !bytes.HasPrefix(key, ReceiveOnlyChannelKey) {
+		return "", "", sdkerrors.Wrapf(sdkerrors.ErrLogic, "key prefix is incorrect: expected %x", ReceiveOnlyChannelKey)
+	}
+
+	keySplit := strings.Split(string(key[len(ReceiveOnlyChannelKey):]), "/")
+	if len(keySplit) != 2 {
+		return "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 2, len(keySplit),
+		)
+	}
+
+	return keySplit[0], keySplit[1], nil
+}
+
+// EscrowAddressFn defines the function used to derive the escrow address of a channel.
+type EscrowAddressFn func(portID, channelID string) sdk.AccAddress
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
+// It is the default escrow address derivation of the transfer keeper.
 func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	// a slash is used to create domain separation between port and channel identifiers to
 	// prevent address collisions between escrow addresses created for different channels
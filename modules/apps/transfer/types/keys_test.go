@@ -22,3 +22,37 @@ func TestGetEscrowAddress(t *testing.T) {
 	escrow2 := types.GetEscrowAddress(port2, channel2)
 	require.NotEqual(t, escrow1, escrow2)
 }
+
+func TestParseKeyReceiveOnlyChannel(t *testing.T) {
+	key := types.KeyReceiveOnlyChannel(types.PortID, "channel-0")
+
+	portID, channelID, err := types.ParseKeyReceiveOnlyChannel(key)
+	require.NoError(t, err)
+	require.Equal(t, types.PortID, portID)
+	require.Equal(t, "channel-0", channelID)
+
+	_, _, err = types.ParseKeyReceiveOnlyChannel(append(types.TotalEscrowForDenomKey, []byte(types.PortID+"/channel-0")...))
+	require.Error(t, err)
+
+	_, _, err = types.ParseKeyReceiveOnlyChannel(append(types.ReceiveOnlyChannelKey, []byte(types.PortID)...))
+	require.Error(t, err)
+}
+
+func TestParseKeyTimedOutTransfer(t *testing.T) {
+	key := types.KeyTimedOutTransfer(types.PortID, "channel-0", 5)
+
+	portID, channelID, sequence, err := types.ParseKeyTimedOutTransfer(key)
+	require.NoError(t, err)
+	require.Equal(t, types.PortID, portID)
+	require.Equal(t, "channel-0", channelID)
+	require.Equal(t, uint64(5), sequence)
+
+	_, _, _, err = types.ParseKeyTimedOutTransfer(append(types.ForwardedPacketKey, []byte(types.PortID+"/channel-0/5")...))
+	require.Error(t, err)
+
+	_, _, _, err = types.ParseKeyTimedOutTransfer(append(types.TimedOutTransferKey, []byte(types.PortID+"/channel-0")...))
+	require.Error(t, err)
+
+	_, _, _, err = types.ParseKeyTimedOutTransfer(append(types.TimedOutTransferKey, []byte(types.PortID+"/channel-0/sequence")...))
+	require.Error(t, err)
+}
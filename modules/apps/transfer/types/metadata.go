@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DenomMetadataProvider provides the bank denomination metadata of IBC vouchers. It is invoked by
+// the transfer keeper when a voucher is received for the first time, i.e. when the denomination
+// trace of the voucher is stored, and no metadata is registered for the voucher denomination yet.
+type DenomMetadataProvider interface {
+	// DenomMetadata returns the metadata of the voucher with the provided denomination trace, which
+	// was received with a packet carrying the given memo. The base of the returned metadata must be
+	// the IBC denomination of the voucher (ibc/{hash}). No metadata is registered if false is returned.
+	DenomMetadata(ctx sdk.Context, denomTrace DenomTrace, memo string) (banktypes.Metadata, bool, error)
+}
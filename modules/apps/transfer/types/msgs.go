@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 )
 
@@ -31,6 +32,28 @@ func NewMsgTransfer(
 	}
 }
 
+// NewMsgMultiTokenTransfer creates a new MsgTransfer instance transferring multiple tokens in a
+// single packet. The tokens may only be transferred over channels using the multi token version.
+//
+//nolint:interfacer
+func NewMsgMultiTokenTransfer(
+	sourcePort, sourceChannel string,
+	tokens sdk.Coins, sender, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Tokens:           tokens,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
 // Route implements sdk.Msg
 func (MsgTransfer) Route() string {
 	return RouterKey
@@ -47,11 +70,9 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
 		return sdkerrors.Wrap(err, "invalid source channel ID")
 	}
-	if !msg.Token.IsValid() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Token.String())
-	}
-	if !msg.Token.IsPositive() {
-		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, msg.Token.String())
+	// NOTE: the amount of an unset token is zero rather than nil once the message is decoded
+	if len(msg.Tokens) != 0 && (msg.Token.Denom != "" || !(msg.Token.Amount.IsNil() || msg.Token.Amount.IsZero())) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "token and tokens cannot both be set")
 	}
 	// NOTE: sender format must be validated as it is required by the GetSigners function.
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -61,7 +82,35 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if strings.TrimSpace(msg.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
 	}
-	return ValidateIBCDenom(msg.Token.Denom)
+
+	seenDenoms := make(map[string]bool)
+	for _, token := range msg.GetCoins() {
+		if !token.IsValid() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, token.String())
+		}
+		if !token.IsPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, token.String())
+		}
+		if err := ValidateIBCDenom(token.Denom); err != nil {
+			return err
+		}
+		if seenDenoms[token.Denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "duplicate denomination %s", token.Denom)
+		}
+		seenDenoms[token.Denom] = true
+	}
+
+	return nil
+}
+
+// GetCoins returns the tokens to be transferred, i.e. the list of tokens if set and
+// otherwise the single token.
+func (msg MsgTransfer) GetCoins() sdk.Coins {
+	if len(msg.Tokens) != 0 {
+		return msg.Tokens
+	}
+
+	return sdk.Coins{msg.Token}
 }
 
 // GetSignBytes implements sdk.Msg.
@@ -77,3 +126,240 @@ func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// NewMsgMultiTransfer creates a new MsgMultiTransfer instance
+//
+//nolint:interfacer
+func NewMsgMultiTransfer(sourcePort, sourceChannel, sender string, entries []MultiTransferEntry) *MsgMultiTransfer {
+	return &MsgMultiTransfer{
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+		Sender:        sender,
+		Entries:       entries,
+	}
+}
+
+// NewMultiTransferEntry creates a new MultiTransferEntry instance
+func NewMultiTransferEntry(
+	receiver string, token sdk.Coin,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
+) MultiTransferEntry {
+	return MultiTransferEntry{
+		Receiver:         receiver,
+		Token:            token,
+		Memo:             memo,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgMultiTransfer) Route() string {
+	return RouterKey
+}
+
+// ValidateBasic performs a basic check of the MsgMultiTransfer fields. Each entry is validated
+// as the MsgTransfer it is sent as.
+func (msg MsgMultiTransfer) ValidateBasic() error {
+	if len(msg.Entries) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMultiTransfer, "entries cannot be empty")
+	}
+
+	for i, transfer := range msg.GetTransfers() {
+		if err := transfer.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid entry %d", i)
+		}
+	}
+
+	return nil
+}
+
+// GetTransfers returns the MsgTransfer of each entry, in the order of the entries.
+func (msg MsgMultiTransfer) GetTransfers() []*MsgTransfer {
+	transfers := make([]*MsgTransfer, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		transfers[i] = NewMsgTransfer(
+			msg.SourcePort, msg.SourceChannel, entry.Token, msg.Sender, entry.Receiver,
+			entry.TimeoutHeight, entry.TimeoutTimestamp, entry.Memo,
+		)
+	}
+
+	return transfers
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgMultiTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgMultiTransfer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgRetryTransfer creates a new MsgRetryTransfer instance
+//
+//nolint:interfacer
+func NewMsgRetryTransfer(
+	sourcePort, sourceChannel string, sequence uint64, sender string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) *MsgRetryTransfer {
+	return &MsgRetryTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Sequence:         sequence,
+		Sender:           sender,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRetryTransfer) Route() string {
+	return RouterKey
+}
+
+// ValidateBasic performs a basic check of the MsgRetryTransfer fields.
+// NOTE: timeout height or timestamp values can be 0 to disable the timeout.
+func (msg MsgRetryTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if msg.Sequence == 0 {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "packet sequence cannot be 0")
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgRetryTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRetryTransfer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgSetReceiveOnlyChannel creates a new MsgSetReceiveOnlyChannel instance
+//
+//nolint:interfacer
+func NewMsgSetReceiveOnlyChannel(authority, portID, channelID string, receiveOnly bool) *MsgSetReceiveOnlyChannel {
+	return &MsgSetReceiveOnlyChannel{
+		Authority:   authority,
+		PortId:      portID,
+		ChannelId:   channelID,
+		ReceiveOnly: receiveOnly,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgSetReceiveOnlyChannel fields.
+func (msg MsgSetReceiveOnlyChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSetReceiveOnlyChannel) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewTransferEnabledFlag creates a new TransferEnabledFlag instance
+func NewTransferEnabledFlag(enabled bool) *TransferEnabledFlag {
+	return &TransferEnabledFlag{Enabled: enabled}
+}
+
+// NewMsgSetDenomTransferEnabled creates a new MsgSetDenomTransferEnabled instance. A nil flag keeps
+// the value currently applying to the denomination.
+//
+//nolint:interfacer
+func NewMsgSetDenomTransferEnabled(authority, denom string, sendEnabled, receiveEnabled *TransferEnabledFlag, remove bool) *MsgSetDenomTransferEnabled {
+	return &MsgSetDenomTransferEnabled{
+		Authority:      authority,
+		Denom:          denom,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+		Remove:         remove,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgSetDenomTransferEnabled fields.
+func (msg MsgSetDenomTransferEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if !msg.Remove && msg.SendEnabled == nil && msg.ReceiveEnabled == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "send enabled or receive enabled must be set unless the override is removed")
+	}
+
+	return ValidateDenomTransferEnabled(msg.Denom)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSetDenomTransferEnabled) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgRenameEscrowDenom creates a new MsgRenameEscrowDenom instance
+//
+//nolint:interfacer
+func NewMsgRenameEscrowDenom(authority, oldDenom, newDenom string) *MsgRenameEscrowDenom {
+	return &MsgRenameEscrowDenom{
+		Authority: authority,
+		OldDenom:  oldDenom,
+		NewDenom:  newDenom,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRenameEscrowDenom fields.
+func (msg MsgRenameEscrowDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateEscrowDenomMigration(msg.OldDenom, msg.NewDenom)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRenameEscrowDenom) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
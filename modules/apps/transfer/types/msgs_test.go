@@ -56,6 +56,25 @@ func TestMsgTransferGetSignBytes(t *testing.T) {
 }
 
 // TestMsgTransferValidation tests ValidateBasic for MsgTransfer
+// TestMsgTransferGetSignBytesMultiToken tests that the tokens are included in the sign bytes
+func TestMsgTransferGetSignBytesMultiToken(t *testing.T) {
+	msg := NewMsgMultiTokenTransfer(validPort, validChannel, sdk.NewCoins(coin, ibcCoin), addr1, addr2, timeoutHeight, 0, "")
+	expected := fmt.Sprintf(`{"type":"cosmos-sdk/MsgTransfer","value":{"receiver":"%s","sender":"%s","source_channel":"testchannel","source_port":"testportid","timeout_height":{"revision_height":"10"},"token":{"amount":"0"},"tokens":[{"amount":"100","denom":"atom"},{"amount":"100","denom":"ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"}]}}`, addr2, addr1)
+	require.NotPanics(t, func() {
+		res := msg.GetSignBytes()
+		require.Equal(t, expected, string(res))
+	})
+}
+
+// TestMsgTransferGetCoins tests GetCoins for MsgTransfer
+func TestMsgTransferGetCoins(t *testing.T) {
+	msg := NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, timeoutHeight, 0, "")
+	require.Equal(t, sdk.Coins{coin}, msg.GetCoins())
+
+	msg = NewMsgMultiTokenTransfer(validPort, validChannel, sdk.NewCoins(coin, ibcCoin), addr1, addr2, timeoutHeight, 0, "")
+	require.Equal(t, sdk.NewCoins(coin, ibcCoin), msg.GetCoins())
+}
+
 func TestMsgTransferValidation(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -65,6 +84,8 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"valid msg with base denom", NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, timeoutHeight, 0, ""), true},
 		{"valid msg with trace hash", NewMsgTransfer(validPort, validChannel, ibcCoin, addr1, addr2, timeoutHeight, 0, ""), true},
 		{"invalid ibc denom", NewMsgTransfer(validPort, validChannel, invalidIBCCoin, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"valid msg with factory denom", NewMsgTransfer(validPort, validChannel, sdk.NewCoin("factory/"+addr1+"/atom", sdk.NewInt(100)), addr1, addr2, timeoutHeight, 0, ""), true},
+		{"base denom with trace identifiers", NewMsgTransfer(validPort, validChannel, sdk.NewCoin("transfer/channel-0/atom", sdk.NewInt(100)), addr1, addr2, timeoutHeight, 0, ""), false},
 		{"too short port id", NewMsgTransfer(invalidShortPort, validChannel, coin, addr1, addr2, timeoutHeight, 0, ""), false},
 		{"too long port id", NewMsgTransfer(invalidLongPort, validChannel, coin, addr1, addr2, timeoutHeight, 0, ""), false},
 		{"port id contains non-alpha", NewMsgTransfer(invalidPort, validChannel, coin, addr1, addr2, timeoutHeight, 0, ""), false},
@@ -76,6 +97,11 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"missing sender address", NewMsgTransfer(validPort, validChannel, coin, emptyAddr, addr2, timeoutHeight, 0, ""), false},
 		{"missing recipient address", NewMsgTransfer(validPort, validChannel, coin, addr1, "", timeoutHeight, 0, ""), false},
 		{"empty coin", NewMsgTransfer(validPort, validChannel, sdk.Coin{}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"valid msg with multiple tokens", NewMsgMultiTokenTransfer(validPort, validChannel, sdk.NewCoins(coin, ibcCoin), addr1, addr2, timeoutHeight, 0, ""), true},
+		{"multiple tokens with invalid ibc denom", NewMsgMultiTokenTransfer(validPort, validChannel, sdk.Coins{coin, invalidIBCCoin}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"multiple tokens with zero coin", NewMsgMultiTokenTransfer(validPort, validChannel, sdk.Coins{coin, zeroCoin}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"multiple tokens with duplicate denom", NewMsgMultiTokenTransfer(validPort, validChannel, sdk.Coins{coin, coin}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"token and tokens both set", &MsgTransfer{SourcePort: validPort, SourceChannel: validChannel, Token: coin, Tokens: sdk.NewCoins(ibcCoin), Sender: addr1, Receiver: addr2, TimeoutHeight: timeoutHeight}, false},
 	}
 
 	for i, tc := range testCases {
@@ -97,3 +123,183 @@ func TestMsgTransferGetSigners(t *testing.T) {
 
 	require.Equal(t, []sdk.AccAddress{addr}, res)
 }
+
+// TestMsgMultiTransferValidation tests ValidateBasic for MsgMultiTransfer
+func TestMsgMultiTransferValidation(t *testing.T) {
+	entry := NewMultiTransferEntry(addr2, coin, timeoutHeight, 0, "")
+
+	testCases := []struct {
+		name    string
+		msg     *MsgMultiTransfer
+		expPass bool
+	}{
+		{"success", NewMsgMultiTransfer(validPort, validChannel, addr1, []MultiTransferEntry{entry, NewMultiTransferEntry(addr1, ibcCoin, timeoutHeight, 0, "memo")}), true},
+		{"no entries", NewMsgMultiTransfer(validPort, validChannel, addr1, nil), false},
+		{"invalid port id", NewMsgMultiTransfer(invalidPort, validChannel, addr1, []MultiTransferEntry{entry}), false},
+		{"invalid channel id", NewMsgMultiTransfer(validPort, invalidChannel, addr1, []MultiTransferEntry{entry}), false},
+		{"missing sender address", NewMsgMultiTransfer(validPort, validChannel, emptyAddr, []MultiTransferEntry{entry}), false},
+		{"missing recipient address", NewMsgMultiTransfer(validPort, validChannel, addr1, []MultiTransferEntry{entry, NewMultiTransferEntry(emptyAddr, coin, timeoutHeight, 0, "")}), false},
+		{"zero coin", NewMsgMultiTransfer(validPort, validChannel, addr1, []MultiTransferEntry{entry, NewMultiTransferEntry(addr2, zeroCoin, timeoutHeight, 0, "")}), false},
+		{"invalid denom", NewMsgMultiTransfer(validPort, validChannel, addr1, []MultiTransferEntry{NewMultiTransferEntry(addr2, invalidIBCCoin, timeoutHeight, 0, ""), entry}), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgMultiTransferGetTransfers tests GetTransfers for MsgMultiTransfer
+func TestMsgMultiTransferGetTransfers(t *testing.T) {
+	msg := NewMsgMultiTransfer(validPort, validChannel, addr1, []MultiTransferEntry{
+		NewMultiTransferEntry(addr2, coin, timeoutHeight, 0, ""),
+		NewMultiTransferEntry(addr1, ibcCoin, clienttypes.ZeroHeight(), 100, "memo"),
+	})
+
+	expTransfers := []*MsgTransfer{
+		NewMsgTransfer(validPort, validChannel, coin, addr1, addr2, timeoutHeight, 0, ""),
+		NewMsgTransfer(validPort, validChannel, ibcCoin, addr1, addr1, clienttypes.ZeroHeight(), 100, "memo"),
+	}
+	require.Equal(t, expTransfers, msg.GetTransfers())
+}
+
+// TestMsgMultiTransferGetSigners tests GetSigners for MsgMultiTransfer
+func TestMsgMultiTransferGetSigners(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := NewMsgMultiTransfer(validPort, validChannel, addr.String(), []MultiTransferEntry{NewMultiTransferEntry(addr2, coin, timeoutHeight, 0, "")})
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+}
+
+// TestMsgRetryTransferValidation tests ValidateBasic for MsgRetryTransfer
+func TestMsgRetryTransferValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *MsgRetryTransfer
+		expPass bool
+	}{
+		{"success", NewMsgRetryTransfer(validPort, validChannel, 1, addr1, timeoutHeight, 0), true},
+		{"success: timeout timestamp", NewMsgRetryTransfer(validPort, validChannel, 1, addr1, clienttypes.ZeroHeight(), 100), true},
+		{"invalid port id", NewMsgRetryTransfer(invalidPort, validChannel, 1, addr1, timeoutHeight, 0), false},
+		{"invalid channel id", NewMsgRetryTransfer(validPort, invalidChannel, 1, addr1, timeoutHeight, 0), false},
+		{"zero sequence", NewMsgRetryTransfer(validPort, validChannel, 0, addr1, timeoutHeight, 0), false},
+		{"missing sender address", NewMsgRetryTransfer(validPort, validChannel, 1, emptyAddr, timeoutHeight, 0), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgRetryTransferGetSigners tests GetSigners for MsgRetryTransfer
+func TestMsgRetryTransferGetSigners(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := NewMsgRetryTransfer(validPort, validChannel, 1, addr.String(), timeoutHeight, 0)
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+}
+
+// TestMsgSetReceiveOnlyChannelValidation tests ValidateBasic for MsgSetReceiveOnlyChannel
+func TestMsgSetReceiveOnlyChannelValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *MsgSetReceiveOnlyChannel
+		expPass bool
+	}{
+		{"success: enable receive only", NewMsgSetReceiveOnlyChannel(addr1, validPort, validChannel, true), true},
+		{"success: disable receive only", NewMsgSetReceiveOnlyChannel(addr1, validPort, validChannel, false), true},
+		{"invalid authority address", NewMsgSetReceiveOnlyChannel(emptyAddr, validPort, validChannel, true), false},
+		{"invalid port id", NewMsgSetReceiveOnlyChannel(addr1, invalidPort, validChannel, true), false},
+		{"invalid channel id", NewMsgSetReceiveOnlyChannel(addr1, validPort, invalidChannel, true), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgSetDenomTransferEnabledValidation tests ValidateBasic for MsgSetDenomTransferEnabled
+func TestMsgSetDenomTransferEnabledValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *MsgSetDenomTransferEnabled
+		expPass bool
+	}{
+		{"success: base denomination", NewMsgSetDenomTransferEnabled(addr1, "atom", NewTransferEnabledFlag(false), NewTransferEnabledFlag(false), false), true},
+		{"success: full denomination path", NewMsgSetDenomTransferEnabled(addr1, "transfer/channel-0/atom", NewTransferEnabledFlag(true), NewTransferEnabledFlag(false), false), true},
+		{"success: only send enabled set", NewMsgSetDenomTransferEnabled(addr1, "atom", NewTransferEnabledFlag(false), nil, false), true},
+		{"success: only receive enabled set", NewMsgSetDenomTransferEnabled(addr1, "atom", nil, NewTransferEnabledFlag(false), false), true},
+		{"success: remove override", NewMsgSetDenomTransferEnabled(addr1, "atom", nil, nil, true), true},
+		{"no flag set", NewMsgSetDenomTransferEnabled(addr1, "atom", nil, nil, false), false},
+		{"invalid authority address", NewMsgSetDenomTransferEnabled(emptyAddr, "atom", NewTransferEnabledFlag(false), NewTransferEnabledFlag(false), false), false},
+		{"empty denomination", NewMsgSetDenomTransferEnabled(addr1, "", NewTransferEnabledFlag(false), NewTransferEnabledFlag(false), false), false},
+		{"ibc voucher denomination", NewMsgSetDenomTransferEnabled(addr1, ibcCoin.Denom, NewTransferEnabledFlag(false), NewTransferEnabledFlag(false), false), false},
+		{"invalid denomination path", NewMsgSetDenomTransferEnabled(addr1, "transfer/atom/", NewTransferEnabledFlag(false), NewTransferEnabledFlag(false), false), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgRenameEscrowDenomValidation tests ValidateBasic for MsgRenameEscrowDenom
+func TestMsgRenameEscrowDenomValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *MsgRenameEscrowDenom
+		expPass bool
+	}{
+		{"success", NewMsgRenameEscrowDenom(addr1, "atom", "uatom"), true},
+		{"success: base denomination with slashes", NewMsgRenameEscrowDenom(addr1, "gamm/pool/1", "uatom"), true},
+		{"invalid authority address", NewMsgRenameEscrowDenom(emptyAddr, "atom", "uatom"), false},
+		{"empty old denomination", NewMsgRenameEscrowDenom(addr1, "", "uatom"), false},
+		{"empty new denomination", NewMsgRenameEscrowDenom(addr1, "atom", ""), false},
+		{"ibc voucher denomination", NewMsgRenameEscrowDenom(addr1, ibcCoin.Denom, "uatom"), false},
+		{"full denomination path", NewMsgRenameEscrowDenom(addr1, "atom", "transfer/channel-0/uatom"), false},
+		{"identical denominations", NewMsgRenameEscrowDenom(addr1, "atom", "atom"), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgSetDenomTransferEnabledGetSigners tests GetSigners for MsgSetDenomTransferEnabled
+func TestMsgSetDenomTransferEnabledGetSigners(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := NewMsgSetDenomTransferEnabled(addr.String(), "atom", NewTransferEnabledFlag(false), NewTransferEnabledFlag(false), false)
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+}
+
+// TestMsgSetReceiveOnlyChannelGetSigners tests GetSigners for MsgSetReceiveOnlyChannel
+func TestMsgSetReceiveOnlyChannelGetSigners(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := NewMsgSetReceiveOnlyChannel(addr.String(), validPort, validChannel, true)
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -21,6 +23,39 @@ var (
 	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
 )
 
+// TimeoutTimestampFromDuration returns the absolute packet timeout timestamp (in nanoseconds)
+// obtained by adding the provided duration to the provided block time. It may be used to
+// construct a MsgTransfer which times out a given duration after the current block time.
+func TimeoutTimestampFromDuration(blockTime time.Time, duration time.Duration) (uint64, error) {
+	if duration <= 0 {
+		return 0, sdkerrors.Wrapf(ErrInvalidPacketTimeout, "timeout duration must be positive (got %s)", duration)
+	}
+
+	timeout := blockTime.Add(duration).UnixNano()
+	if timeout <= 0 {
+		return 0, sdkerrors.Wrapf(ErrInvalidPacketTimeout, "timeout timestamp must be after Jan 1st, 1970 12:00 AM (got %s)", blockTime.Add(duration))
+	}
+
+	return uint64(timeout), nil
+}
+
+// packetMemo is used to decode only the memo field of ICS-20 packet data, which is
+// shared by all packet data versions.
+type packetMemo struct {
+	Memo string `json:"memo"`
+}
+
+// DecodePacketMemo returns the memo of the provided JSON encoded ICS-20 packet data without
+// decoding the remaining packet data fields.
+func DecodePacketMemo(packetData []byte) (string, error) {
+	var data packetMemo
+	if err := json.Unmarshal(packetData, &data); err != nil {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data memo: %s", err.Error())
+	}
+
+	return data.Memo, nil
+}
+
 // NewFungibleTokenPacketData contructs a new FungibleTokenPacketData instance
 func NewFungibleTokenPacketData(
 	denom string, amount string,
@@ -60,3 +95,88 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 func (ftpd FungibleTokenPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(mustProtoMarshalJSON(&ftpd))
 }
+
+// GetPacketSender returns the sender address of the packet data. The source port is not
+// required to determine the sender of a transfer.
+func (ftpd FungibleTokenPacketData) GetPacketSender(sourcePortID string) string {
+	return ftpd.Sender
+}
+
+// NewFungibleTokenPacketDataV2 contructs a new FungibleTokenPacketDataV2 instance
+func NewFungibleTokenPacketDataV2(
+	tokens []Token,
+	sender, receiver string,
+	memo string,
+) FungibleTokenPacketDataV2 {
+	return FungibleTokenPacketDataV2{
+		Tokens:   tokens,
+		Sender:   sender,
+		Receiver: receiver,
+		Memo:     memo,
+	}
+}
+
+// ValidateBasic is used for validating the multi token transfer. At least one token must be
+// transferred and each denomination may only be transferred once.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (ftpd FungibleTokenPacketDataV2) ValidateBasic() error {
+	if len(ftpd.Tokens) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAmount, "tokens cannot be empty")
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, token := range ftpd.Tokens {
+		if err := token.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if seenDenoms[token.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidDenomForTransfer, "duplicate denomination %s", token.Denom)
+		}
+		seenDenoms[token.Denom] = true
+	}
+
+	if strings.TrimSpace(ftpd.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(ftpd.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (ftpd FungibleTokenPacketDataV2) GetBytes() []byte {
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&ftpd))
+}
+
+// TokensString returns the comma separated list of the transferred tokens.
+func (ftpd FungibleTokenPacketDataV2) TokensString() string {
+	tokens := make([]string, len(ftpd.Tokens))
+	for i, token := range ftpd.Tokens {
+		tokens[i] = fmt.Sprintf("%s%s", token.Amount, token.Denom)
+	}
+
+	return strings.Join(tokens, ",")
+}
+
+// GetPacketSender returns the sender address of the packet data. The source port is not
+// required to determine the sender of a transfer.
+func (ftpd FungibleTokenPacketDataV2) GetPacketSender(sourcePortID string) string {
+	return ftpd.Sender
+}
+
+// ValidateBasic validates the token denomination and amount.
+func (t Token) ValidateBasic() error {
+	amount, ok := sdk.NewIntFromString(t.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", t.Amount)
+	}
+	if !amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
+	}
+
+	return ValidatePrefixedDenom(t.Denom)
+}
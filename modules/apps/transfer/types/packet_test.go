@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -41,3 +42,86 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+// TestFungibleTokenPacketDataV2ValidateBasic tests ValidateBasic for FungibleTokenPacketDataV2
+func TestFungibleTokenPacketDataV2ValidateBasic(t *testing.T) {
+	token := Token{Denom: denom, Amount: amount}
+
+	testCases := []struct {
+		name       string
+		packetData FungibleTokenPacketDataV2
+		expPass    bool
+	}{
+		{"valid packet", NewFungibleTokenPacketDataV2([]Token{token}, addr1, addr2, ""), true},
+		{"valid packet with multiple tokens", NewFungibleTokenPacketDataV2([]Token{token, {Denom: "atom", Amount: largeAmount}}, addr1, addr2, "memo"), true},
+		{"empty tokens", NewFungibleTokenPacketDataV2(nil, addr1, addr2, ""), false},
+		{"invalid denom", NewFungibleTokenPacketDataV2([]Token{token, {Denom: "", Amount: amount}}, addr1, addr2, ""), false},
+		{"invalid empty amount", NewFungibleTokenPacketDataV2([]Token{token, {Denom: "atom", Amount: ""}}, addr1, addr2, ""), false},
+		{"invalid zero amount", NewFungibleTokenPacketDataV2([]Token{token, {Denom: "atom", Amount: "0"}}, addr1, addr2, ""), false},
+		{"invalid large amount", NewFungibleTokenPacketDataV2([]Token{{Denom: "atom", Amount: invalidLargeAmount}}, addr1, addr2, ""), false},
+		{"duplicate denom", NewFungibleTokenPacketDataV2([]Token{token, token}, addr1, addr2, ""), false},
+		{"missing sender address", NewFungibleTokenPacketDataV2([]Token{token}, emptyAddr, addr2, ""), false},
+		{"missing recipient address", NewFungibleTokenPacketDataV2([]Token{token}, addr1, emptyAddr, ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestTimeoutTimestampFromDuration tests the computation of absolute timeout timestamps from a duration
+func TestTimeoutTimestampFromDuration(t *testing.T) {
+	blockTime := time.Unix(1_600_000_000, 0)
+
+	testCases := []struct {
+		name      string
+		blockTime time.Time
+		duration  time.Duration
+		expPass   bool
+	}{
+		{"valid duration", blockTime, 10 * time.Minute, true},
+		{"zero duration", blockTime, 0, false},
+		{"negative duration", blockTime, -time.Minute, false},
+		{"timestamp before unix epoch", time.Unix(0, 0).Add(-time.Hour), time.Minute, false},
+	}
+
+	for i, tc := range testCases {
+		timeout, err := TimeoutTimestampFromDuration(tc.blockTime, tc.duration)
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+			require.Equal(t, uint64(tc.blockTime.Add(tc.duration).UnixNano()), timeout)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestDecodePacketMemo tests decoding only the memo field of the packet data
+func TestDecodePacketMemo(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData []byte
+		expMemo    string
+		expPass    bool
+	}{
+		{"packet data with memo", NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo").GetBytes(), "memo", true},
+		{"packet data without memo", NewFungibleTokenPacketData(denom, amount, addr1, addr2, "").GetBytes(), "", true},
+		{"multi token packet data with memo", NewFungibleTokenPacketDataV2([]Token{{Denom: denom, Amount: amount}}, addr1, addr2, "memo").GetBytes(), "memo", true},
+		{"invalid packet data", []byte("invalid"), "", false},
+	}
+
+	for i, tc := range testCases {
+		memo, err := DecodePacketMemo(tc.packetData)
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+			require.Equal(t, tc.expMemo, memo)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
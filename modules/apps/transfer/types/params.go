@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -11,6 +12,8 @@ const (
 	DefaultSendEnabled = true
 	// DefaultReceiveEnabled enabled
 	DefaultReceiveEnabled = true
+	// DefaultMaxMemoLength is the default maximum length in bytes of a packet memo
+	DefaultMaxMemoLength = 32768
 )
 
 var (
@@ -18,6 +21,10 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyReceiveEnabled is store's key for ReceiveEnabled Params
 	KeyReceiveEnabled = []byte("ReceiveEnabled")
+	// KeyMaxMemoLength is store's key for MaxMemoLength Params
+	KeyMaxMemoLength = []byte("MaxMemoLength")
+	// KeyReceiveDenomBlocklist is store's key for ReceiveDenomBlocklist Params
+	KeyReceiveDenomBlocklist = []byte("ReceiveDenomBlocklist")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -26,16 +33,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the ibc transfer module
-func NewParams(enableSend, enableReceive bool) Params {
+func NewParams(enableSend, enableReceive bool, maxMemoLength uint64, receiveDenomBlocklist []string) Params {
 	return Params{
-		SendEnabled:    enableSend,
-		ReceiveEnabled: enableReceive,
+		SendEnabled:           enableSend,
+		ReceiveEnabled:        enableReceive,
+		MaxMemoLength:         maxMemoLength,
+		ReceiveDenomBlocklist: receiveDenomBlocklist,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the ibc-transfer module
 func DefaultParams() Params {
-	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
+	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled, DefaultMaxMemoLength, nil)
 }
 
 // Validate all ibc-transfer module parameters
@@ -44,7 +53,15 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateEnabledType(p.ReceiveEnabled)
+	if err := validateEnabledType(p.ReceiveEnabled); err != nil {
+		return err
+	}
+
+	if err := validateMaxMemoLength(p.MaxMemoLength); err != nil {
+		return err
+	}
+
+	return validateReceiveDenomBlocklist(p.ReceiveDenomBlocklist)
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -52,6 +69,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, p.SendEnabled, validateEnabledType),
 		paramtypes.NewParamSetPair(KeyReceiveEnabled, p.ReceiveEnabled, validateEnabledType),
+		paramtypes.NewParamSetPair(KeyMaxMemoLength, p.MaxMemoLength, validateMaxMemoLength),
+		paramtypes.NewParamSetPair(KeyReceiveDenomBlocklist, p.ReceiveDenomBlocklist, validateReceiveDenomBlocklist),
 	}
 }
 
@@ -63,3 +82,33 @@ func validateEnabledType(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxMemoLength(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateReceiveDenomBlocklist(i interface{}) error {
+	blocklist, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, denom := range blocklist {
+		if err := ValidatePrefixedDenom(denom); err != nil {
+			return sdkerrors.Wrapf(err, "invalid blocklisted denomination %s", denom)
+		}
+
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate blocklisted denomination %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
@@ -8,5 +8,9 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, DefaultParams().Validate())
-	require.NoError(t, NewParams(true, false).Validate())
+	require.NoError(t, NewParams(true, false, DefaultMaxMemoLength, nil).Validate())
+	require.NoError(t, NewParams(true, true, DefaultMaxMemoLength, []string{"uatom", "transfer/channel-0/uatom"}).Validate())
+	require.Error(t, NewParams(true, true, DefaultMaxMemoLength, []string{""}).Validate())
+	require.Error(t, NewParams(true, true, DefaultMaxMemoLength, []string{"(transfer)/channel-0/uatom"}).Validate())
+	require.Error(t, NewParams(true, true, DefaultMaxMemoLength, []string{"transfer/channel-0/uatom", "transfer/channel-0/uatom"}).Validate())
 }
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// relayerFeeMemo defines the structure of a packet memo which instructs the receiving chain to
+// reimburse the relayer delivering the packet from the transferred amount.
+//
+// Example:
+//
+//	{"relayer_fee":{"amount":"100"}}
+type relayerFeeMemo struct {
+	RelayerFee *RelayerFeeMetadata `json:"relayer_fee,omitempty"`
+}
+
+// RelayerFeeMetadata defines the amount of the transferred tokens which is paid to the relayer
+// delivering the packet on the receiving chain. The relayer is the signer of the MsgRecvPacket.
+type RelayerFeeMetadata struct {
+	Amount string `json:"amount"`
+}
+
+// ParseRelayerFeeMetadata returns the relayer fee metadata contained in the provided packet memo.
+// A nil value is returned if the memo is not a JSON object or does not contain a relayer_fee
+// object, since the memo field may be used for arbitrary purposes. An error is returned if the
+// relayer_fee object is present but malformed.
+func ParseRelayerFeeMetadata(memo string) (*RelayerFeeMetadata, error) {
+	if !strings.Contains(memo, `"relayer_fee"`) {
+		return nil, nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &raw); err != nil {
+		return nil, nil
+	}
+
+	if _, ok := raw["relayer_fee"]; !ok {
+		return nil, nil
+	}
+
+	var packetMemo relayerFeeMemo
+	if err := json.Unmarshal([]byte(memo), &packetMemo); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidRelayerFee, "cannot unmarshal relayer fee metadata: %s", err.Error())
+	}
+
+	if packetMemo.RelayerFee == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidRelayerFee, "relayer fee metadata cannot be null")
+	}
+
+	if err := packetMemo.RelayerFee.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return packetMemo.RelayerFee, nil
+}
+
+// ValidateBasic performs a basic validation of the relayer fee metadata fields.
+func (rf RelayerFeeMetadata) ValidateBasic() error {
+	amount, ok := sdk.NewIntFromString(rf.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidRelayerFee, "unable to parse relayer fee amount (%s) into math.Int", rf.Amount)
+	}
+
+	if !amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidRelayerFee, "relayer fee amount must be positive (got %s)", rf.Amount)
+	}
+
+	return nil
+}
+
+// GetAmount returns the relayer fee amount. The metadata must have been validated.
+func (rf RelayerFeeMetadata) GetAmount() sdk.Int {
+	amount, _ := sdk.NewIntFromString(rf.Amount)
+	return amount
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+func TestParseRelayerFeeMetadata(t *testing.T) {
+	testCases := []struct {
+		name          string
+		memo          string
+		expRelayerFee bool
+		expPass       bool
+	}{
+		{"empty memo", "", false, true},
+		{"memo is not json", "memo", false, true},
+		{"memo is not a json object", `"relayer_fee"`, false, true},
+		{"memo without relayer fee object", `{"wasm":{"relayer_fee":"100"}}`, false, true},
+		{"valid relayer fee", `{"relayer_fee":{"amount":"100"}}`, true, true},
+		{"valid relayer fee with other memo fields", `{"relayer_fee":{"amount":"100"},"note":"hello"}`, true, true},
+		{"relayer fee is null", `{"relayer_fee":null}`, false, false},
+		{"relayer fee is not an object", `{"relayer_fee":"100"}`, false, false},
+		{"amount is missing", `{"relayer_fee":{}}`, false, false},
+		{"amount is not an integer", `{"relayer_fee":{"amount":"1.5"}}`, false, false},
+		{"amount is zero", `{"relayer_fee":{"amount":"0"}}`, false, false},
+		{"amount is negative", `{"relayer_fee":{"amount":"-100"}}`, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		relayerFee, err := types.ParseRelayerFeeMetadata(tc.memo)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expRelayerFee, relayerFee != nil, tc.name)
+			if tc.expRelayerFee {
+				require.Equal(t, sdk.NewInt(100), relayerFee.GetAmount(), tc.name)
+			}
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidRelayerFee, tc.name)
+			require.Nil(t, relayerFee, tc.name)
+		}
+	}
+}
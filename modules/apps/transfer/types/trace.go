@@ -16,6 +16,10 @@ import (
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 )
 
+// baseDenomFormat describes the format of a valid base denomination. It is included in the errors
+// returned by ValidateBaseDenom.
+const baseDenomFormat = "expected 'segment' or 'segment0/segment1/.../segmentN' where segment0 is not 'ibc' and segment1 is not a channel identifier 'channel-{N}'"
+
 // ParseDenomTrace parses a string with the ibc prefix (denom trace) and the base denomination
 // into a DenomTrace type.
 //
@@ -124,15 +128,18 @@ func validateTraceIdentifiers(identifiers []string) error {
 
 // Validate performs a basic validation of the DenomTrace fields.
 func (dt DenomTrace) Validate() error {
-	// empty trace is accepted when token lives on the original chain
-	switch {
-	case dt.Path == "" && dt.BaseDenom != "":
-		return nil
-	case strings.TrimSpace(dt.BaseDenom) == "":
+	if strings.TrimSpace(dt.BaseDenom) == "" {
 		return fmt.Errorf("base denomination cannot be blank")
 	}
 
-	// NOTE: no base denomination validation
+	if err := ValidateBaseDenom(dt.BaseDenom); err != nil {
+		return err
+	}
+
+	// empty trace is accepted when token lives on the original chain
+	if dt.Path == "" {
+		return nil
+	}
 
 	identifiers := strings.Split(dt.Path, "/")
 	return validateTraceIdentifiers(identifiers)
@@ -181,11 +188,11 @@ func (t Traces) Sort() Traces {
 //   - Prefixed denomination: '{portIDN}/{channelIDN}/.../{portID0}/{channelID0}/baseDenom'
 //   - Unprefixed denomination: 'baseDenom'
 //
-// 'baseDenom' may or may not contain '/'s
+// 'baseDenom' may or may not contain '/'s but must be accepted by ValidateBaseDenom.
 func ValidatePrefixedDenom(denom string) error {
 	denomSplit := strings.Split(denom, "/")
 	if denomSplit[0] == denom && strings.TrimSpace(denom) != "" {
-		// NOTE: no base denomination validation
+		// NOTE: a base denomination without slashes cannot collide with a trace path
 		return nil
 	}
 
@@ -193,9 +200,12 @@ func ValidatePrefixedDenom(denom string) error {
 		return sdkerrors.Wrap(ErrInvalidDenomForTransfer, "base denomination cannot be blank")
 	}
 
-	path, _ := extractPathAndBaseFromFullDenom(denomSplit)
+	path, baseDenom := extractPathAndBaseFromFullDenom(denomSplit)
+	if err := ValidateBaseDenom(baseDenom); err != nil {
+		return err
+	}
+
 	if path == "" {
-		// NOTE: base denom contains slashes, so no base denomination validation
 		return nil
 	}
 
@@ -203,6 +213,42 @@ func ValidatePrefixedDenom(denom string) error {
 	return validateTraceIdentifiers(identifiers)
 }
 
+// ValidateDenomTransferEnabled validates the denomination of a send and receive enabled override. The
+// denomination must be a base denomination or a full denomination path. IBC voucher denominations
+// ('ibc/{hash}') are rejected since the overrides are matched on the denomination path.
+func ValidateDenomTransferEnabled(denom string) error {
+	if strings.TrimSpace(denom) == "" {
+		return sdkerrors.Wrap(ErrInvalidDenomForTransfer, "denomination cannot be blank")
+	}
+
+	if strings.HasPrefix(denom, DenomPrefix+"/") {
+		return sdkerrors.Wrapf(ErrInvalidDenomForTransfer, "expected a base denomination or a full denomination path, got %s", denom)
+	}
+
+	return ValidatePrefixedDenom(denom)
+}
+
+// ValidateEscrowDenomMigration validates the old and new denominations of the migration of escrowed
+// tokens. Both denominations must be distinct base denominations native to this chain, i.e. neither
+// an ibc denomination nor a full denomination path.
+func ValidateEscrowDenomMigration(oldDenom, newDenom string) error {
+	for _, denom := range []string{oldDenom, newDenom} {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidDenomMigration, err.Error())
+		}
+
+		if strings.HasPrefix(denom, DenomPrefix+"/") || ParseDenomTrace(denom).Path != "" {
+			return sdkerrors.Wrapf(ErrInvalidDenomMigration, "expected a base denomination, got %s", denom)
+		}
+	}
+
+	if oldDenom == newDenom {
+		return sdkerrors.Wrapf(ErrInvalidDenomMigration, "old and new denominations are both %s", oldDenom)
+	}
+
+	return nil
+}
+
 // ValidateIBCDenom validates that the given denomination is either:
 //
 //   - A valid base denomination (eg: 'uatom' or 'gamm/pool/1' as in https://github.com/cosmos/ibc-go/issues/894)
@@ -226,6 +272,39 @@ func ValidateIBCDenom(denom string) error {
 		if _, err := ParseHexHash(denomSplit[1]); err != nil {
 			return sdkerrors.Wrapf(err, "invalid denom trace hash %s", denomSplit[1])
 		}
+
+		return nil
+
+	default:
+		return ValidateBaseDenom(denom)
+	}
+}
+
+// ValidateBaseDenom validates that the given base denomination cannot be mistaken for an IBC
+// voucher denomination or for a denomination prefixed with a trace path. A base denomination
+// is accepted if it follows the format:
+//
+//   - 'segment' or 'segment0/segment1/.../segmentN', where segment0 is not 'ibc' and segment1 is
+//     not a channel identifier ('channel-{N}')
+//
+// Denominations such as 'uatom', 'gamm/pool/1' or 'factory/{address}/{subdenom}' are therefore
+// accepted, while 'ibc/{hash}' or 'transfer/channel-0/uatom' are rejected as base denominations.
+func ValidateBaseDenom(baseDenom string) error {
+	if strings.TrimSpace(baseDenom) == "" {
+		return sdkerrors.Wrap(ErrInvalidDenomForTransfer, "base denomination cannot be blank")
+	}
+
+	segments := strings.Split(baseDenom, "/")
+	if len(segments) == 1 {
+		return nil
+	}
+
+	if segments[0] == DenomPrefix {
+		return sdkerrors.Wrapf(ErrInvalidDenomForTransfer, "base denomination %s cannot start with '%s/': %s", baseDenom, DenomPrefix, baseDenomFormat)
+	}
+
+	if channeltypes.IsValidChannelID(segments[1]) {
+		return sdkerrors.Wrapf(ErrInvalidDenomForTransfer, "base denomination %s would be parsed as a trace path: %s", baseDenom, baseDenomFormat)
 	}
 
 	return nil
@@ -74,6 +74,11 @@ func TestDenomTrace_Validate(t *testing.T) {
 		{"invalid port ID", DenomTrace{BaseDenom: "uatom", Path: "(transfer)/channel-1"}, true},
 		{"invalid channel ID", DenomTrace{BaseDenom: "uatom", Path: "transfer/(channel-1)"}, true},
 		{"empty base denom with trace", DenomTrace{BaseDenom: "", Path: "transfer/channel-1"}, true},
+		{"factory base denom with trace", DenomTrace{BaseDenom: "factory/cosmos1qyfkm2y3/uatom", Path: "transfer/channel-1"}, false},
+		{"base denom with 'ibc/' prefix", DenomTrace{BaseDenom: "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"}, true},
+		{"base denom with 'ibc/' prefix and trace", DenomTrace{BaseDenom: "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", Path: "transfer/channel-1"}, true},
+		{"base denom with trace identifiers", DenomTrace{BaseDenom: "transfer/channel-2/uatom"}, true},
+		{"base denom with channel ID as second element", DenomTrace{BaseDenom: "transfer/channel-2", Path: "transfer/channel-1"}, true},
 	}
 
 	for _, tc := range testCases {
@@ -131,6 +136,10 @@ func TestValidatePrefixedDenom(t *testing.T) {
 		{"invalid port ID", "(transfer)/channel-1/uatom", true},
 		{"empty denom", "", true},
 		{"single trace identifier", "transfer/", true},
+		{"prefixed factory denom", "transfer/channel-1/factory/cosmos1qyfkm2y3/uatom", false},
+		{"base denom with 'ibc/' prefix", "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", true},
+		{"prefixed denom with 'ibc/' base denom", "transfer/channel-1/ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", true},
+		{"base denom with channel ID as second element", "transfer/channel-1", true},
 	}
 
 	for _, tc := range testCases {
@@ -159,6 +168,9 @@ func TestValidateIBCDenom(t *testing.T) {
 		{"denom 'ibc'", "ibc", true},
 		{"denom 'ibc/'", "ibc/", true},
 		{"invald hash", "ibc/!@#$!@#", true},
+		{"factory denom", "factory/cosmos1qyfkm2y3/uatom", false},
+		{"base denom with trace identifiers", "transfer/channel-1/uatom", true},
+		{"base denom with channel ID as second element", "transfer/channel-1", true},
 	}
 
 	for _, tc := range testCases {
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -91,6 +93,13 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+	// max_memo_length defines the maximum length in bytes of the memo of packets
+	// sent from or received by this chain. A value of zero disables the limit.
+	MaxMemoLength uint64 `protobuf:"varint,3,opt,name=max_memo_length,json=maxMemoLength,proto3" json:"max_memo_length,omitempty" yaml:"max_memo_length"`
+	// receive_denom_blocklist defines the full denomination paths, i.e.
+	// '{portID}/{channelID}/.../baseDenom', of the tokens which cannot be received
+	// by this chain.
+	ReceiveDenomBlocklist []string `protobuf:"bytes,4,rep,name=receive_denom_blocklist,json=receiveDenomBlocklist,proto3" json:"receive_denom_blocklist,omitempty" yaml:"receive_denom_blocklist"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -140,9 +149,341 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxMemoLength() uint64 {
+	if m != nil {
+		return m.MaxMemoLength
+	}
+	return 0
+}
+
+func (m *Params) GetReceiveDenomBlocklist() []string {
+	if m != nil {
+		return m.ReceiveDenomBlocklist
+	}
+	return nil
+}
+
+// DenomTransferEnabled defines the override of the send_enabled and receive_enabled
+// parameters for a single denomination. The denomination is either a base
+// denomination, matching the token regardless of its trace, or a full denomination
+// path, i.e. '{portID}/{channelID}/.../baseDenom'. A flag which is set takes
+// precedence over the parameter, which only applies to the flags which are not set.
+type DenomTransferEnabled struct {
+	// the denomination the override applies to
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// send_enabled enables or disables the transfer of the denomination from this
+	// chain. If it is not set, the send_enabled parameter applies.
+	SendEnabled *TransferEnabledFlag `protobuf:"bytes,2,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty" yaml:"send_enabled"`
+	// receive_enabled enables or disables the transfer of the denomination to this
+	// chain. If it is not set, the receive_enabled parameter applies.
+	ReceiveEnabled *TransferEnabledFlag `protobuf:"bytes,3,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+}
+
+func (m *DenomTransferEnabled) Reset()         { *m = DenomTransferEnabled{} }
+func (m *DenomTransferEnabled) String() string { return proto.CompactTextString(m) }
+func (*DenomTransferEnabled) ProtoMessage()    {}
+func (*DenomTransferEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{2}
+}
+func (m *DenomTransferEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTransferEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTransferEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTransferEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTransferEnabled.Merge(m, src)
+}
+func (m *DenomTransferEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTransferEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTransferEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTransferEnabled proto.InternalMessageInfo
+
+func (m *DenomTransferEnabled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomTransferEnabled) GetSendEnabled() *TransferEnabledFlag {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return nil
+}
+
+func (m *DenomTransferEnabled) GetReceiveEnabled() *TransferEnabledFlag {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return nil
+}
+
+// TransferEnabledFlag defines an optional send or receive enabled flag of a denomination.
+type TransferEnabledFlag struct {
+	// enabled enables or disables the transfer of the denomination
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *TransferEnabledFlag) Reset()         { *m = TransferEnabledFlag{} }
+func (m *TransferEnabledFlag) String() string { return proto.CompactTextString(m) }
+func (*TransferEnabledFlag) ProtoMessage()    {}
+func (*TransferEnabledFlag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{3}
+}
+func (m *TransferEnabledFlag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferEnabledFlag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferEnabledFlag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferEnabledFlag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferEnabledFlag.Merge(m, src)
+}
+func (m *TransferEnabledFlag) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferEnabledFlag) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferEnabledFlag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferEnabledFlag proto.InternalMessageInfo
+
+func (m *TransferEnabledFlag) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// TimedOutTransfer contains the parameters of a transfer whose packet timed out and whose tokens were
+// refunded to the sender. It is stored until the transfer is retried with a MsgRetryTransfer or until
+// it expires.
+type TimedOutTransfer struct {
+	// the sender address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the recipient address on the destination chain
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// the tokens refunded to the sender, in their denomination on this chain
+	Tokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=tokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens"`
+	// the memo of the transfer
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	// the block time in unix nanoseconds at which the transfer can no longer be retried and is pruned
+	ExpiryTimestamp uint64 `protobuf:"varint,5,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty" yaml:"expiry_timestamp"`
+}
+
+func (m *TimedOutTransfer) Reset()         { *m = TimedOutTransfer{} }
+func (m *TimedOutTransfer) String() string { return proto.CompactTextString(m) }
+func (*TimedOutTransfer) ProtoMessage()    {}
+func (*TimedOutTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{4}
+}
+func (m *TimedOutTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimedOutTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimedOutTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimedOutTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimedOutTransfer.Merge(m, src)
+}
+func (m *TimedOutTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimedOutTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimedOutTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimedOutTransfer proto.InternalMessageInfo
+
+func (m *TimedOutTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *TimedOutTransfer) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *TimedOutTransfer) GetTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *TimedOutTransfer) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *TimedOutTransfer) GetExpiryTimestamp() uint64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
+// EscrowDenomMigration contains the amount of tokens held by a single escrow account which are
+// migrated from the old to the new denomination of a renamed base denomination.
+type EscrowDenomMigration struct {
+	// the escrow address holding the tokens
+	EscrowAddress string `protobuf:"bytes,1,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty" yaml:"escrow_address"`
+	// the escrowed tokens in their old denomination
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EscrowDenomMigration) Reset()         { *m = EscrowDenomMigration{} }
+func (m *EscrowDenomMigration) String() string { return proto.CompactTextString(m) }
+func (*EscrowDenomMigration) ProtoMessage()    {}
+func (*EscrowDenomMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{5}
+}
+func (m *EscrowDenomMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowDenomMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowDenomMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowDenomMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowDenomMigration.Merge(m, src)
+}
+func (m *EscrowDenomMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowDenomMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowDenomMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowDenomMigration proto.InternalMessageInfo
+
+func (m *EscrowDenomMigration) GetEscrowAddress() string {
+	if m != nil {
+		return m.EscrowAddress
+	}
+	return ""
+}
+
+func (m *EscrowDenomMigration) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MigratedDenom records that the escrowed tokens of a renamed base denomination were migrated to
+// its new denomination. Tokens of the old denomination returning to this chain are unescrowed in
+// the new denomination.
+type MigratedDenom struct {
+	// the old base denomination
+	OldDenom string `protobuf:"bytes,1,opt,name=old_denom,json=oldDenom,proto3" json:"old_denom,omitempty" yaml:"old_denom"`
+	// the new base denomination
+	NewDenom string `protobuf:"bytes,2,opt,name=new_denom,json=newDenom,proto3" json:"new_denom,omitempty" yaml:"new_denom"`
+}
+
+func (m *MigratedDenom) Reset()         { *m = MigratedDenom{} }
+func (m *MigratedDenom) String() string { return proto.CompactTextString(m) }
+func (*MigratedDenom) ProtoMessage()    {}
+func (*MigratedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{6}
+}
+func (m *MigratedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigratedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigratedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigratedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigratedDenom.Merge(m, src)
+}
+func (m *MigratedDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigratedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigratedDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigratedDenom proto.InternalMessageInfo
+
+func (m *MigratedDenom) GetOldDenom() string {
+	if m != nil {
+		return m.OldDenom
+	}
+	return ""
+}
+
+func (m *MigratedDenom) GetNewDenom() string {
+	if m != nil {
+		return m.NewDenom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
 	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*DenomTransferEnabled)(nil), "ibc.applications.transfer.v1.DenomTransferEnabled")
+	proto.RegisterType((*TransferEnabledFlag)(nil), "ibc.applications.transfer.v1.TransferEnabledFlag")
+	proto.RegisterType((*TimedOutTransfer)(nil), "ibc.applications.transfer.v1.TimedOutTransfer")
+	proto.RegisterType((*EscrowDenomMigration)(nil), "ibc.applications.transfer.v1.EscrowDenomMigration")
+	proto.RegisterType((*MigratedDenom)(nil), "ibc.applications.transfer.v1.MigratedDenom")
 }
 
 func init() {
@@ -150,26 +491,52 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0x2b, 0x31,
-	0x14, 0x86, 0x9b, 0x72, 0x29, 0xb7, 0x51, 0x14, 0xa2, 0x68, 0x29, 0x9a, 0xca, 0xac, 0x04, 0x71,
-	0x42, 0x11, 0x14, 0xba, 0x11, 0xaa, 0xee, 0xb5, 0xb8, 0x72, 0x53, 0x92, 0xcc, 0x71, 0x1a, 0x98,
-	0x4c, 0x86, 0x24, 0x1d, 0xe8, 0x23, 0xb8, 0xf3, 0xb1, 0x5c, 0x76, 0xe9, 0xaa, 0x48, 0xfb, 0x06,
-	0x7d, 0x02, 0x99, 0xb4, 0x94, 0xc1, 0xdd, 0x7f, 0xce, 0xf9, 0xbe, 0xb3, 0xf8, 0xf1, 0x95, 0x12,
-	0x92, 0xf1, 0xa2, 0xc8, 0x94, 0xe4, 0x5e, 0x99, 0xdc, 0x31, 0x6f, 0x79, 0xee, 0xde, 0xc1, 0xb2,
-	0xb2, 0xbf, 0xcb, 0x71, 0x61, 0x8d, 0x37, 0xe4, 0x4c, 0x09, 0x19, 0xd7, 0xe1, 0x78, 0x07, 0x94,
-	0xfd, 0xee, 0x71, 0x6a, 0x52, 0x13, 0x40, 0x56, 0xa5, 0x8d, 0x13, 0xdd, 0x63, 0xfc, 0x08, 0xb9,
-	0xd1, 0xaf, 0x96, 0x4b, 0x20, 0x04, 0xff, 0x2b, 0xb8, 0x9f, 0x74, 0xd0, 0x05, 0xba, 0x6c, 0x8f,
-	0x42, 0x26, 0xe7, 0x18, 0x0b, 0xee, 0x60, 0x9c, 0x54, 0x58, 0xa7, 0x19, 0x2e, 0xed, 0x6a, 0x13,
-	0xbc, 0xe8, 0x03, 0xe1, 0xd6, 0x33, 0xb7, 0x5c, 0x3b, 0x32, 0xc0, 0xfb, 0x0e, 0xf2, 0x64, 0x0c,
-	0x39, 0x17, 0x19, 0x24, 0xe1, 0xcb, 0xff, 0xe1, 0xe9, 0x7a, 0xd1, 0x3b, 0x9a, 0x71, 0x9d, 0x0d,
-	0xa2, 0xfa, 0x35, 0x1a, 0xed, 0x55, 0xe3, 0xd3, 0x66, 0x22, 0x0f, 0xf8, 0xd0, 0x82, 0x04, 0x55,
-	0xc2, 0x4e, 0x6f, 0x06, 0xbd, 0xbb, 0x5e, 0xf4, 0x4e, 0x36, 0xfa, 0x1f, 0x20, 0x1a, 0x1d, 0x6c,
-	0x37, 0xdb, 0x27, 0xc3, 0x97, 0xaf, 0x25, 0x45, 0xf3, 0x25, 0x45, 0x3f, 0x4b, 0x8a, 0x3e, 0x57,
-	0xb4, 0x31, 0x5f, 0xd1, 0xc6, 0xf7, 0x8a, 0x36, 0xde, 0xee, 0x52, 0xe5, 0x27, 0x53, 0x11, 0x4b,
-	0xa3, 0x99, 0x34, 0x4e, 0x1b, 0xc7, 0x94, 0x90, 0xd7, 0xa9, 0x61, 0xe5, 0x2d, 0xd3, 0x26, 0x99,
-	0x66, 0xe0, 0xaa, 0x9e, 0x6b, 0xfd, 0xfa, 0x59, 0x01, 0x4e, 0xb4, 0x42, 0x4d, 0x37, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x0e, 0x9e, 0x30, 0x60, 0x89, 0x01, 0x00, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xce, 0x1f, 0xb9, 0xc9, 0x70, 0xf9, 0xd1, 0x10, 0xc0, 0xe4, 0xde, 0x6b, 0x47, 0xb3, 0x8a,
+	0x74, 0x85, 0xdd, 0x50, 0xa9, 0x48, 0x6c, 0xda, 0x9a, 0xc2, 0xaa, 0xa8, 0xad, 0xc5, 0x8a, 0x8d,
+	0x35, 0xb6, 0xa7, 0x61, 0x84, 0xc7, 0x13, 0x79, 0x26, 0x01, 0x9e, 0xa0, 0xdb, 0x56, 0x7d, 0x8a,
+	0xf6, 0x49, 0x58, 0xb2, 0xec, 0x2a, 0xad, 0xe0, 0x0d, 0xf2, 0x04, 0x95, 0x67, 0x1c, 0x37, 0x84,
+	0x0a, 0xb5, 0x5d, 0x79, 0xce, 0xcf, 0x77, 0x7c, 0xe6, 0x3b, 0xdf, 0x19, 0xf0, 0x3f, 0x0d, 0x42,
+	0x07, 0x0f, 0x06, 0x31, 0x0d, 0xb1, 0xa4, 0x3c, 0x11, 0x8e, 0x4c, 0x71, 0x22, 0xde, 0x92, 0xd4,
+	0x19, 0xf5, 0x8a, 0xb3, 0x3d, 0x48, 0xb9, 0xe4, 0xf0, 0x5f, 0x1a, 0x84, 0xf6, 0x6c, 0xb2, 0x5d,
+	0x24, 0x8c, 0x7a, 0xed, 0x56, 0x9f, 0xf7, 0xb9, 0x4a, 0x74, 0xb2, 0x93, 0xc6, 0xb4, 0xcd, 0x90,
+	0x0b, 0xc6, 0x85, 0x13, 0x60, 0x41, 0x9c, 0x51, 0x2f, 0x20, 0x12, 0xf7, 0x9c, 0x90, 0xd3, 0x44,
+	0xc7, 0xd1, 0x53, 0x00, 0x5e, 0x90, 0x84, 0xb3, 0xe3, 0x14, 0x87, 0x04, 0x42, 0x50, 0x1b, 0x60,
+	0x79, 0x6a, 0x94, 0x3b, 0xe5, 0x6e, 0xd3, 0x53, 0x67, 0xf8, 0x1f, 0x00, 0x19, 0xd8, 0x8f, 0xb2,
+	0x34, 0xa3, 0xa2, 0x22, 0xcd, 0xcc, 0xa3, 0x70, 0xe8, 0x53, 0x05, 0xd4, 0x5f, 0xe3, 0x14, 0x33,
+	0x01, 0xf7, 0xc0, 0xdf, 0x82, 0x24, 0x91, 0x4f, 0x12, 0x1c, 0xc4, 0x24, 0x52, 0x55, 0x1a, 0xee,
+	0xe6, 0x64, 0x6c, 0xad, 0x5d, 0x62, 0x16, 0xef, 0xa1, 0xd9, 0x28, 0xf2, 0x16, 0x33, 0xf3, 0x40,
+	0x5b, 0x70, 0x1f, 0xac, 0xa4, 0x24, 0x24, 0x74, 0x44, 0x0a, 0x78, 0x45, 0xc1, 0xdb, 0x93, 0xb1,
+	0xb5, 0xa1, 0xe1, 0x73, 0x09, 0xc8, 0x5b, 0xce, 0x3d, 0xd3, 0x22, 0x2e, 0x58, 0x61, 0xf8, 0xc2,
+	0x67, 0x84, 0x71, 0x3f, 0x26, 0x49, 0x5f, 0x9e, 0x1a, 0xd5, 0x4e, 0xb9, 0x5b, 0x9b, 0x2d, 0x32,
+	0x97, 0x80, 0xbc, 0x25, 0x86, 0x2f, 0x8e, 0x08, 0xe3, 0x2f, 0x95, 0x0d, 0x4f, 0xc0, 0xe6, 0xf4,
+	0x3f, 0xea, 0xc6, 0x7e, 0x10, 0xf3, 0xf0, 0x2c, 0xa6, 0x42, 0x1a, 0xb5, 0x4e, 0xb5, 0xdb, 0x74,
+	0xd1, 0x64, 0x6c, 0x99, 0x77, 0x1b, 0x9a, 0x4b, 0x44, 0xde, 0x7a, 0x1e, 0x51, 0x14, 0xb9, 0x85,
+	0xff, 0x63, 0x05, 0xb4, 0xa6, 0x6c, 0xab, 0xb9, 0x4d, 0x1b, 0x6f, 0x81, 0x05, 0x4d, 0xaf, 0x26,
+	0x5e, 0x1b, 0x90, 0xcd, 0xf1, 0x99, 0x11, 0xb2, 0xb8, 0xd3, 0xb3, 0x1f, 0x92, 0x81, 0x3d, 0x57,
+	0xfa, 0x30, 0xc6, 0xfd, 0x5f, 0x1c, 0xc1, 0xe8, 0xfe, 0x08, 0xaa, 0x7f, 0xfa, 0xc7, 0xdf, 0x98,
+	0x1a, 0x72, 0xc0, 0xda, 0x4f, 0x4a, 0x40, 0x03, 0xfc, 0x75, 0x47, 0x48, 0xde, 0xd4, 0x44, 0xef,
+	0x2a, 0x60, 0xf5, 0x98, 0x32, 0x12, 0xbd, 0x1a, 0xca, 0x29, 0x12, 0x6e, 0x80, 0x7a, 0x76, 0x19,
+	0x92, 0xe6, 0x1c, 0xe6, 0x16, 0x6c, 0x83, 0x46, 0xfe, 0xbf, 0x34, 0x17, 0x6f, 0x61, 0xc3, 0x10,
+	0xd4, 0x25, 0x3f, 0x23, 0x89, 0x30, 0xaa, 0x9d, 0x6a, 0x77, 0x71, 0x67, 0xcb, 0xd6, 0xdb, 0x62,
+	0x67, 0xf2, 0xb6, 0xf3, 0x6d, 0xb1, 0xf7, 0x39, 0x4d, 0xdc, 0x47, 0x57, 0x63, 0xab, 0xf4, 0xf9,
+	0xab, 0xd5, 0xed, 0x53, 0x79, 0x3a, 0x0c, 0xec, 0x90, 0x33, 0x27, 0x5f, 0x2d, 0xfd, 0xd9, 0x16,
+	0xd1, 0x99, 0x23, 0x2f, 0x07, 0x44, 0x28, 0x80, 0xf0, 0xf2, 0xd2, 0xd9, 0x4e, 0x65, 0x7a, 0x33,
+	0x6a, 0x7a, 0xa7, 0xb2, 0x33, 0x3c, 0x04, 0xab, 0xe4, 0x62, 0x40, 0xd3, 0x4b, 0x5f, 0x52, 0x46,
+	0x84, 0xc4, 0x6c, 0x60, 0x2c, 0x28, 0xa5, 0xfe, 0x33, 0x19, 0x5b, 0x9b, 0x9a, 0xb8, 0xf9, 0x0c,
+	0xe4, 0xad, 0x68, 0xd7, 0x71, 0xe1, 0xf9, 0x50, 0x06, 0xad, 0x03, 0x11, 0xa6, 0xfc, 0x5c, 0xc9,
+	0xea, 0x88, 0xf6, 0x53, 0x35, 0x20, 0xf8, 0x0c, 0x2c, 0x13, 0xe5, 0xf7, 0x71, 0x14, 0xa5, 0x44,
+	0x08, 0xcd, 0x8a, 0xbb, 0x35, 0x19, 0x5b, 0xeb, 0x79, 0xf9, 0x3b, 0x71, 0xe4, 0x2d, 0x69, 0xc7,
+	0x73, 0x6d, 0xc3, 0x5d, 0x50, 0xc7, 0x8c, 0x0f, 0x13, 0x99, 0xcb, 0xee, 0x01, 0x6e, 0x6a, 0x19,
+	0x37, 0x5e, 0x9e, 0x8e, 0x86, 0x60, 0x49, 0xf7, 0x41, 0x22, 0xd5, 0x14, 0xec, 0x81, 0x26, 0x8f,
+	0x23, 0x7f, 0x46, 0xe0, 0x6e, 0x6b, 0x32, 0xb6, 0x56, 0x75, 0x1b, 0x45, 0x08, 0x79, 0x0d, 0x1e,
+	0xff, 0x80, 0x24, 0xe4, 0x7c, 0xf6, 0xc9, 0x99, 0x85, 0x14, 0x21, 0xe4, 0x35, 0x12, 0xa2, 0xaf,
+	0xee, 0xbe, 0xb9, 0xba, 0x31, 0xcb, 0xd7, 0x37, 0x66, 0xf9, 0xdb, 0x8d, 0x59, 0x7e, 0x7f, 0x6b,
+	0x96, 0xae, 0x6f, 0xcd, 0xd2, 0x97, 0x5b, 0xb3, 0x74, 0xb2, 0x7b, 0x7f, 0x64, 0x34, 0x08, 0xb7,
+	0xfb, 0xdc, 0x19, 0x3d, 0x71, 0x18, 0x8f, 0x86, 0x31, 0x11, 0xd9, 0x1b, 0x3c, 0xf3, 0xf6, 0xaa,
+	0x39, 0x06, 0x75, 0xf5, 0x44, 0x3e, 0xfe, 0x3e, 0x00, 0xeb, 0xb8, 0x91, 0xcc, 0xa5, 0x05, 0x00,
+	0x00,
 }
 
 func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
@@ -229,6 +596,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReceiveDenomBlocklist) > 0 {
+		for iNdEx := len(m.ReceiveDenomBlocklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiveDenomBlocklist[iNdEx])
+			copy(dAtA[i:], m.ReceiveDenomBlocklist[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.ReceiveDenomBlocklist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxMemoLength != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.MaxMemoLength))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ReceiveEnabled {
 		i--
 		if m.ReceiveEnabled {
@@ -252,56 +633,866 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTransfer(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DenomTransferEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DenomTrace) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *DenomTransferEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTransferEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveEnabled != nil {
+		{
+			size, err := m.ReceiveEnabled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransfer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SendEnabled != nil {
+		{
+			size, err := m.SendEnabled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransfer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferEnabledFlag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferEnabledFlag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferEnabledFlag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovTransfer(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovTransfer(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *TimedOutTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimedOutTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimedOutTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowDenomMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowDenomMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowDenomMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.EscrowAddress) > 0 {
+		i -= len(m.EscrowAddress)
+		copy(dAtA[i:], m.EscrowAddress)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.EscrowAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigratedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigratedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigratedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewDenom) > 0 {
+		i -= len(m.NewDenom)
+		copy(dAtA[i:], m.NewDenom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.NewDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldDenom) > 0 {
+		i -= len(m.OldDenom)
+		copy(dAtA[i:], m.OldDenom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.OldDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	if m.MaxMemoLength != 0 {
+		n += 1 + sovTransfer(uint64(m.MaxMemoLength))
+	}
+	if len(m.ReceiveDenomBlocklist) > 0 {
+		for _, s := range m.ReceiveDenomBlocklist {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomTransferEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled != nil {
+		l = m.SendEnabled.Size()
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.ReceiveEnabled != nil {
+		l = m.ReceiveEnabled.Size()
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferEnabledFlag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *TimedOutTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovTransfer(uint64(m.ExpiryTimestamp))
+	}
+	return n
+}
+
+func (m *EscrowDenomMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	return n
+}
+
+func (m *MigratedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldDenom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.NewDenom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func sovTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransfer(x uint64) (n int) {
+	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoLength", wireType)
+			}
+			m.MaxMemoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveDenomBlocklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiveDenomBlocklist = append(m.ReceiveDenomBlocklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTransferEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTransferEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTransferEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SendEnabled == nil {
+				m.SendEnabled = &TransferEnabledFlag{}
+			}
+			if err := m.SendEnabled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceiveEnabled == nil {
+				m.ReceiveEnabled = &TransferEnabledFlag{}
+			}
+			if err := m.ReceiveEnabled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferEnabledFlag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferEnabledFlag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferEnabledFlag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SendEnabled {
-		n += 2
-	}
-	if m.ReceiveEnabled {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTransfer(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTransfer(x uint64) (n int) {
-	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DenomTrace) Unmarshal(dAtA []byte) error {
+func (m *TimedOutTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -324,15 +1515,15 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomTrace: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimedOutTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimedOutTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -360,11 +1551,11 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -392,8 +1583,93 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
@@ -415,7 +1691,7 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EscrowDenomMigration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -438,17 +1714,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EscrowDenomMigration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EscrowDenomMigration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransfer
@@ -458,17 +1734,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SendEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransfer
@@ -478,12 +1766,139 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReceiveEnabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigratedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigratedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigratedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
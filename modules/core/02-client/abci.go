@@ -4,10 +4,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/core/02-client/keeper"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint"
 )
 
-// BeginBlocker is used to perform IBC client upgrades
+// BeginBlocker is used to perform IBC client upgrades and to update the 09-localhost client
+// to the latest block height.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	plan, found := k.GetUpgradePlan(ctx)
 	if found {
@@ -31,4 +33,10 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			keeper.EmitUpgradeChainEvent(ctx, plan.Height)
 		}
 	}
+
+	if clientState, found := k.GetClientState(ctx, exported.LocalhostClientID); found {
+		if _, err := k.UpdateLocalhostClient(ctx, clientState); err != nil {
+			k.Logger(ctx).Error("failed to update localhost client", "error", err)
+		}
+	}
 }
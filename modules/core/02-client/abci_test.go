@@ -12,6 +12,7 @@ import (
 
 	client "github.com/cosmos/ibc-go/v6/modules/core/02-client"
 	"github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 )
@@ -47,6 +48,21 @@ func (suite *ClientTestSuite) TestBeginBlocker() {
 	}
 }
 
+func (suite *ClientTestSuite) TestBeginBlockerLocalhost() {
+	prevHeight := suite.chainA.GetClientState(exported.LocalhostClientID).GetLatestHeight()
+
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().NotPanics(func() {
+		client.BeginBlocker(ctx, suite.chainA.App.GetIBCKeeper().ClientKeeper)
+	}, "BeginBlocker shouldn't panic")
+
+	clientState := suite.chainA.GetClientState(exported.LocalhostClientID)
+	suite.Require().True(clientState.GetLatestHeight().GT(prevHeight))
+	suite.Require().Equal(types.GetSelfHeight(ctx), clientState.GetLatestHeight())
+}
+
 func (suite *ClientTestSuite) TestBeginBlockerConsensusState() {
 	plan := &upgradetypes.Plan{
 		Name:   "test",
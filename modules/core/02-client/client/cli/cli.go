@@ -24,8 +24,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusStateHeights(),
 		GetCmdQueryConsensusState(),
+		GetCmdQueryConsensusStateProcessedTime(),
+		GetCmdQueryConsensusStateProcessedHeight(),
 		GetCmdQueryHeader(),
 		GetCmdSelfConsensusState(),
+		GetCmdQueryIBCProof(),
 		GetCmdParams(),
 	)
 
@@ -15,7 +15,9 @@ import (
 )
 
 const (
-	flagLatestHeight = "latest-height"
+	flagLatestHeight      = "latest-height"
+	flagCarryOverMetadata = "carry-over-metadata"
+	flagDetailed          = "detailed"
 )
 
 // GetCmdQueryClientStates defines the command to query all the light clients
@@ -106,6 +108,24 @@ func GetCmdQueryClientStatus() *cobra.Command {
 			clientID := args[0]
 			queryClient := types.NewQueryClient(clientCtx)
 
+			detailed, err := cmd.Flags().GetBool(flagDetailed)
+			if err != nil {
+				return err
+			}
+
+			if detailed {
+				req := &types.QueryClientStatusDetailedRequest{
+					ClientId: clientID,
+				}
+
+				clientStatusRes, err := queryClient.ClientStatusDetailed(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(clientStatusRes)
+			}
+
 			req := &types.QueryClientStatusRequest{
 				ClientId: clientID,
 			}
@@ -119,6 +139,7 @@ func GetCmdQueryClientStatus() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagDetailed, false, "include the details explaining why the client is not active")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -257,6 +278,90 @@ If the '--latest' flag is included, the query returns the latest consensus state
 	return cmd
 }
 
+// GetCmdQueryConsensusStateProcessedTime defines the command to query the time at which the consensus
+// state of a client at a given height was processed
+func GetCmdQueryConsensusStateProcessedTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "processed-time [client-id] [height]",
+		Short: "Query the time at which the consensus state of a client at a given height was processed",
+		Long: `Query the time in nanoseconds at which this chain processed the consensus state of a light client at a given height.
+A proof at the consensus state height may only be used once the time delay period of the connection has passed since the processed time.`,
+		Example: fmt.Sprintf("%s query %s %s processed-time [client-id] [height]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := types.ParseHeight(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryConsensusStateProcessedTimeRequest{
+				ClientId:       args[0],
+				RevisionNumber: height.GetRevisionNumber(),
+				RevisionHeight: height.GetRevisionHeight(),
+			}
+
+			res, err := queryClient.ConsensusStateProcessedTime(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConsensusStateProcessedHeight defines the command to query the height of this chain at
+// which the consensus state of a client at a given height was processed
+func GetCmdQueryConsensusStateProcessedHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "processed-height [client-id] [height]",
+		Short: "Query the height at which the consensus state of a client at a given height was processed",
+		Long: `Query the height of this chain at which the consensus state of a light client at a given height was processed.
+A proof at the consensus state height may only be used once the block delay period of the connection has passed since the processed height.`,
+		Example: fmt.Sprintf("%s query %s %s processed-height [client-id] [height]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := types.ParseHeight(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryConsensusStateProcessedHeightRequest{
+				ClientId:       args[0],
+				RevisionNumber: height.GetRevisionNumber(),
+				RevisionHeight: height.GetRevisionHeight(),
+			}
+
+			res, err := queryClient.ConsensusStateProcessedHeight(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryHeader defines the command to query the latest header on the chain
 func GetCmdQueryHeader() *cobra.Command {
 	cmd := &cobra.Command{
@@ -311,6 +416,36 @@ func GetCmdSelfConsensusState() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryIBCProof defines the command to query the merkle proof of an IBC store path.
+func GetCmdQueryIBCProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof [path]",
+		Short: "Query the merkle proof of an IBC store path",
+		Long: `Query the merkle proof of an IBC store path, e.g. a packet commitment path, as included in the proofs of IBC messages.
+The proof is retrieved for the app hash of the block at the provided height, or of the latest block if no height is provided.
+A proof of non-existence is returned if no value is stored under the path.`,
+		Example: fmt.Sprintf("%s query %s %s proof commitments/ports/transfer/channels/channel-0/sequences/1 --height 100", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := utils.QueryIBCProof(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for ibc client parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
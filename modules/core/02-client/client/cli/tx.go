@@ -248,7 +248,8 @@ func NewCmdSubmitUpdateClientProposal() *cobra.Command {
 		Short: "Submit an update IBC client proposal",
 		Long: "Submit an update IBC client proposal along with an initial deposit.\n" +
 			"Please specify a subject client identifier you want to update..\n" +
-			"Please specify the substitute client the subject client will be updated to.",
+			"Please specify the substitute client the subject client will be updated to.\n" +
+			"Tendermint clients may carry over the consensus metadata of the consensus states stored by both clients with the --carry-over-metadata flag.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -268,7 +269,18 @@ func NewCmdSubmitUpdateClientProposal() *cobra.Command {
 			subjectClientID := args[0]
 			substituteClientID := args[1]
 
-			content := types.NewClientUpdateProposal(title, description, subjectClientID, substituteClientID)
+			carryOverMetadata, err := cmd.Flags().GetBool(flagCarryOverMetadata)
+			if err != nil {
+				return err
+			}
+
+			content := &types.ClientUpdateProposal{
+				Title:              title,
+				Description:        description,
+				SubjectClientId:    subjectClientID,
+				SubstituteClientId: substituteClientID,
+				CarryOverMetadata:  carryOverMetadata,
+			}
 
 			from := clientCtx.GetFromAddress()
 
@@ -297,6 +309,7 @@ func NewCmdSubmitUpdateClientProposal() *cobra.Command {
 	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")             //nolint:staticcheck // need this till full govv1 conversion.
 	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal") //nolint:staticcheck // need this till full govv1 conversion.
 	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(flagCarryOverMetadata, false, "carry over the consensus metadata of consensus states stored by both the subject and substitute client")
 
 	return cmd
 }
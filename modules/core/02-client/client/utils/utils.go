@@ -2,6 +2,7 @@ package utils
 
 import (
 	"context"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -65,6 +66,35 @@ func QueryClientStateABCI(
 	return clientStateRes, nil
 }
 
+// QueryIBCProof performs an ABCI store query to retrieve the merkle proof of the provided IBC store
+// path, e.g. a packet commitment path, optionally prefixed with the IBC store key. The proof is the
+// proof the chain includes in IBC messages, such that its verification by a counterparty light client
+// may be debugged. A proof of non-existence is returned if no value is stored under the path. The
+// desired tendermint height should be set in the client context, see ibcclient.QueryTendermintProof.
+func QueryIBCProof(clientCtx client.Context, path string) (*types.QueryIBCProofResponse, error) {
+	path = strings.TrimPrefix(strings.TrimSpace(path), host.StoreKey+"/")
+	if path == "" {
+		return nil, sdkerrors.Wrap(host.ErrInvalidPath, "store path cannot be blank")
+	}
+
+	merklePath, err := commitmenttypes.ApplyPrefix(commitmenttypes.NewMerklePrefix([]byte(host.StoreKey)), commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return nil, err
+	}
+
+	value, proofBz, proofHeight, err := ibcclient.QueryTendermintProof(clientCtx, []byte(path))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIBCProofResponse{
+		MerklePath:  merklePath,
+		Value:       value,
+		Proof:       proofBz,
+		ProofHeight: proofHeight,
+	}, nil
+}
+
 // QueryConsensusState returns a consensus state. If prove is true, it performs an ABCI store
 // query in order to retrieve the merkle proof. Otherwise, it uses the gRPC query client.
 func QueryConsensusState(
@@ -0,0 +1,157 @@
+package utils_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/02-client/client/utils"
+	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	ibcmock "github.com/cosmos/ibc-go/v6/testing/mock"
+)
+
+// abciClient is a tendermint RPC client serving ABCI queries from the application of a testing chain.
+type abciClient struct {
+	rpcclient.Client
+
+	app abci.Application
+}
+
+func (c abciClient) ABCIQueryWithOptions(_ context.Context, path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	res := c.app.Query(abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	return &coretypes.ResultABCIQuery{Response: res}, nil
+}
+
+type UtilsTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *UtilsTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestUtilsTestSuite(t *testing.T) {
+	suite.Run(t, new(UtilsTestSuite))
+}
+
+func (suite *UtilsTestSuite) TestQueryIBCProof() {
+	var (
+		path      *ibctesting.Path
+		storePath string
+		expKey    []byte
+		expValue  []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: packet commitment path",
+			func() {},
+			true,
+		},
+		{
+			"success: path prefixed with the IBC store key",
+			func() {
+				storePath = host.StoreKey + "/" + storePath
+			},
+			true,
+		},
+		{
+			"success: surrounding whitespace is trimmed",
+			func() {
+				storePath = "  " + host.StoreKey + "/" + storePath + "\n"
+			},
+			true,
+		},
+		{
+			"success: proof of non-existence",
+			func() {
+				storePath = host.PacketCommitmentPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2)
+				expKey = []byte(storePath)
+				expValue = nil
+			},
+			true,
+		},
+		{
+			"blank path",
+			func() {
+				storePath = " "
+			},
+			false,
+		},
+		{
+			"path is only the IBC store key",
+			func() {
+				storePath = host.StoreKey + "/"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			sequence, err := path.EndpointA.SendPacket(suite.chainB.GetTimeoutHeight(), 0, ibcmock.MockPacketData)
+			suite.Require().NoError(err)
+
+			storePath = host.PacketCommitmentPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+			expKey = host.PacketCommitmentKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+			expValue = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+			suite.Require().NotEmpty(expValue)
+
+			tc.malleate()
+
+			clientCtx := client.Context{}.
+				WithClient(abciClient{app: suite.chainA.App}).
+				WithChainID(suite.chainA.ChainID).
+				WithInterfaceRegistry(suite.chainA.GetSimApp().InterfaceRegistry()).
+				WithHeight(suite.chainA.App.LastBlockHeight())
+
+			res, err := utils.QueryIBCProof(clientCtx, storePath)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				// the merkle path is prefixed with the IBC store key exactly once
+				expMerklePath, err := commitmenttypes.ApplyPrefix(suite.chainA.GetPrefix(), commitmenttypes.NewMerklePath(string(expKey)))
+				suite.Require().NoError(err)
+				suite.Require().Equal(expMerklePath, res.MerklePath)
+				suite.Require().Equal([]string{host.StoreKey, string(expKey)}, res.MerklePath.KeyPath)
+
+				suite.Require().Equal(expValue, res.Value)
+
+				// the proof is the proof included in IBC messages for the path
+				expProof, expProofHeight := suite.chainA.QueryProof(expKey)
+				suite.Require().Equal(expProof, res.Proof)
+				suite.Require().Equal(expProofHeight, res.ProofHeight)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -46,6 +46,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	}
 
 	k.SetNextClientSequence(ctx, gs.NextClientSequence)
+
+	// the 09-localhost client is created on genesis initialization if it is allowed
+	if gs.Params.IsAllowedClient(exported.Localhost) {
+		if err := k.CreateLocalhostClient(ctx); err != nil {
+			panic(fmt.Sprintf("failed to initialize localhost client: %s", err.Error()))
+		}
+	}
 }
 
 // ExportGenesis returns the ibc client submodule's exported genesis.
@@ -8,6 +8,8 @@ import (
 
 	"github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint"
+	localhost "github.com/cosmos/ibc-go/v6/modules/light-clients/09-localhost"
 )
 
 // CreateClient creates a new client state and populates it with a given consensus
@@ -15,6 +17,10 @@ import (
 func (k Keeper) CreateClient(
 	ctx sdk.Context, clientState exported.ClientState, consensusState exported.ConsensusState,
 ) (string, error) {
+	if clientState.ClientType() == exported.Localhost {
+		return "", sdkerrors.Wrapf(types.ErrInvalidClientType, "cannot create client of type: %s", clientState.ClientType())
+	}
+
 	params := k.GetParams(ctx)
 	if !params.IsAllowedClient(clientState.ClientType()) {
 		return "", sdkerrors.Wrapf(
@@ -23,6 +29,19 @@ func (k Keeper) CreateClient(
 		)
 	}
 
+	// prevent the creation of tendermint clients which may be trusted for too long or accept
+	// headers too far in the future. The max clock drift is only checked here: upgrades and
+	// recoveries keep the max clock drift of the existing client.
+	if tmClientState, ok := clientState.(*ibctm.ClientState); ok {
+		if err := tmClientState.ValidateTrustingPeriodFraction(params.MaxTrustingPeriodFraction); err != nil {
+			return "", err
+		}
+
+		if err := tmClientState.ValidateMaxClockDrift(params.MaxAllowedClockDrift); err != nil {
+			return "", err
+		}
+	}
+
 	clientID := k.GenerateClientIdentifier(ctx, clientState.ClientType())
 
 	k.Logger(ctx).Info("client created at height", "client-id", clientID, "height", clientState.GetLatestHeight().String())
@@ -49,6 +68,27 @@ func (k Keeper) CreateClient(
 	return clientID, nil
 }
 
+// CreateLocalhostClient initializes the 09-localhost client at the latest block height of the executing
+// chain. The localhost client uses the reserved 09-localhost client identifier, hence the next client
+// sequence is not incremented.
+func (k Keeper) CreateLocalhostClient(ctx sdk.Context) error {
+	clientState := localhost.NewClientState(types.GetSelfHeight(ctx))
+	if err := clientState.Initialize(ctx, k.cdc, k.ClientStore(ctx, exported.LocalhostClientID), nil); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("client created at height", "client-id", exported.LocalhostClientID, "height", clientState.GetLatestHeight().String())
+
+	EmitCreateClientEvent(ctx, exported.LocalhostClientID, clientState)
+
+	return nil
+}
+
+// UpdateLocalhostClient updates the 09-localhost client to the latest block height of the executing chain.
+func (k Keeper) UpdateLocalhostClient(ctx sdk.Context, clientState exported.ClientState) ([]exported.Height, error) {
+	return clientState.UpdateState(ctx, k.cdc, k.ClientStore(ctx, exported.LocalhostClientID), nil)
+}
+
 // UpdateClient updates the consensus state and the state root from a provided header.
 func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
 	clientState, found := k.GetClientState(ctx, clientID)
@@ -82,12 +122,15 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exporte
 			},
 		)
 
-		EmitSubmitMisbehaviourEvent(ctx, clientID, clientState)
+		EmitSubmitMisbehaviourEvent(ctx, clientID, clientState, clientMsg)
 
 		return nil
 	}
 
-	consensusHeights := clientState.UpdateState(ctx, k.cdc, clientStore, clientMsg)
+	consensusHeights, err := clientState.UpdateState(ctx, k.cdc, clientStore, clientMsg)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
+	}
 
 	k.Logger(ctx).Info("client state updated", "client-id", clientID, "heights", consensusHeights)
 
@@ -101,8 +144,13 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exporte
 		},
 	)
 
+	// the event describes the client state after the update
+	if updatedClientState, found := k.GetClientState(ctx, clientID); found {
+		clientState = updatedClientState
+	}
+
 	// emitting events in the keeper emits for both begin block and handler client updates
-	EmitUpdateClientEvent(ctx, clientID, clientState.ClientType(), consensusHeights, k.cdc, clientMsg)
+	EmitUpdateClientEvent(ctx, clientID, clientState, consensusHeights, k.cdc, clientMsg)
 
 	return nil
 }
@@ -144,3 +192,28 @@ func (k Keeper) UpgradeClient(ctx sdk.Context, clientID string, upgradedClient e
 
 	return nil
 }
+
+// PruneExpiredConsensusStates prunes all expired consensus states, along with their processed time,
+// processed height and iteration metadata, of the tendermint client with the provided identifier and
+// returns the number of pruned consensus states. Expired consensus states are otherwise only pruned
+// upon client updates, so this allows the consensus states of clients which stopped updating to be
+// cleaned up.
+func (k Keeper) PruneExpiredConsensusStates(ctx sdk.Context, clientID string) (int, error) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrClientNotFound, "cannot prune consensus states of client with ID %s", clientID)
+	}
+
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidClientType, "only %s clients support pruning expired consensus states, got %s", exported.Tendermint, clientState.ClientType())
+	}
+
+	totalPruned := ibctm.PruneAllExpiredConsensusStates(ctx, k.ClientStore(ctx, clientID), k.cdc, tmClientState)
+
+	k.Logger(ctx).Info("expired consensus states pruned", "client-id", clientID, "total-pruned", totalPruned)
+
+	EmitPruneExpiredConsensusStatesEvent(ctx, clientID, clientState.ClientType(), totalPruned)
+
+	return totalPruned, nil
+}
@@ -5,14 +5,17 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	"github.com/cosmos/ibc-go/v6/modules/core/02-client/keeper"
 	"github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 	solomachine "github.com/cosmos/ibc-go/v6/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint"
+	localhost "github.com/cosmos/ibc-go/v6/modules/light-clients/09-localhost"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 )
 
@@ -24,6 +27,7 @@ func (suite *KeeperTestSuite) TestCreateClient() {
 	}{
 		{"success", ibctm.NewClientState(testChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath), true},
 		{"client type not supported", solomachine.NewClientState(0, &solomachine.ConsensusState{suite.solomachine.ConsensusState().PublicKey, suite.solomachine.Diversifier, suite.solomachine.Time}), false},
+		{"localhost client cannot be created", localhost.NewClientState(testClientHeight), false},
 	}
 
 	for i, tc := range cases {
@@ -39,6 +43,79 @@ func (suite *KeeperTestSuite) TestCreateClient() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCreateClientMaxTrustingPeriodFraction() {
+	// the trusting period is two thirds of the unbonding period
+	clientState := ibctm.NewClientState(testChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+
+	cases := []struct {
+		msg      string
+		fraction sdk.Dec
+		expPass  bool
+	}{
+		{"success: no restriction", sdk.ZeroDec(), true},
+		{"success: trusting period is within the fraction of the unbonding period", sdk.NewDecWithPrec(7, 1), true},
+		{"success: trusting period is the unbonding period", sdk.OneDec(), true},
+		{"trusting period exceeds the fraction of the unbonding period", sdk.NewDecWithPrec(5, 1), false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			params := suite.keeper.GetParams(suite.ctx)
+			params.MaxTrustingPeriodFraction = tc.fraction
+			suite.keeper.SetParams(suite.ctx, params)
+
+			clientID, err := suite.keeper.CreateClient(suite.ctx, clientState, suite.consensusState)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotEmpty(clientID)
+			} else {
+				suite.Require().ErrorIs(err, ibctm.ErrInvalidTrustingPeriod)
+				suite.Require().Empty(clientID)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateClientMaxAllowedClockDrift() {
+	clientState := ibctm.NewClientState(testChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+
+	cases := []struct {
+		msg           string
+		maxClockDrift time.Duration
+		expPass       bool
+	}{
+		{"success: no restriction", 0, true},
+		{"success: max clock drift is within the maximum allowed clock drift", maxClockDrift * 2, true},
+		{"success: max clock drift is the maximum allowed clock drift", maxClockDrift, true},
+		{"max clock drift exceeds the maximum allowed clock drift", maxClockDrift - time.Second, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			params := suite.keeper.GetParams(suite.ctx)
+			params.MaxAllowedClockDrift = tc.maxClockDrift
+			suite.keeper.SetParams(suite.ctx, params)
+
+			clientID, err := suite.keeper.CreateClient(suite.ctx, clientState, suite.consensusState)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotEmpty(clientID)
+			} else {
+				suite.Require().ErrorIs(err, ibctm.ErrInvalidMaxClockDrift)
+				suite.Require().Empty(clientID)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	var (
 		path         *ibctesting.Path
@@ -214,7 +291,8 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 				clientState = path.EndpointA.GetClientState()
 			}
 
-			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, updateHeader)
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, updateHeader)
 
 			if tc.expPass {
 				suite.Require().NoError(err, err)
@@ -223,6 +301,22 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 
 				if tc.expFreeze {
 					suite.Require().True(!newClientState.(*ibctm.ClientState).FrozenHeight.IsZero(), "client did not freeze after conflicting header was submitted to UpdateClient")
+
+					// the misbehaviour event contains the height of the conflicting header
+					var found bool
+					for _, event := range ctx.EventManager().Events() {
+						if event.Type != types.EventTypeSubmitMisbehaviour {
+							continue
+						}
+
+						for _, attr := range event.Attributes {
+							if string(attr.Key) == types.AttributeKeyConsensusHeight {
+								suite.Require().Equal(updateHeader.GetHeight().String(), string(attr.Value))
+								found = true
+							}
+						}
+					}
+					suite.Require().True(found)
 				} else {
 					expConsensusState := &ibctm.ConsensusState{
 						Timestamp:          updateHeader.GetTime(),
@@ -452,4 +546,153 @@ func (suite *KeeperTestSuite) TestUpdateClientEventEmission() {
 		}
 	}
 	suite.Require().True(contains)
+
+	// the update event describes the client state after the update
+	attributes := make(map[string]string)
+	for _, attr := range updateEvent.Attributes {
+		attributes[string(attr.Key)] = string(attr.Value)
+	}
+
+	clientState := path.EndpointA.GetClientState()
+	suite.Require().Equal(header.GetHeight().String(), clientState.GetLatestHeight().String())
+	suite.Require().Equal(clientState.GetLatestHeight().String(), attributes[clienttypes.AttributeKeyLatestHeight])
+	suite.Require().Equal(header.GetHeight().String(), attributes[clienttypes.AttributeKeyConsensusHeights])
+	suite.Require().Equal(exported.Tendermint, attributes[clienttypes.AttributeKeyClientType])
+	suite.Require().Equal(suite.chainB.ChainID, attributes[clienttypes.AttributeKeyClientChainID])
+}
+
+func (suite *KeeperTestSuite) TestCreateClientEventEmission() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.Require().NoError(path.EndpointA.CreateClient())
+
+	clientState := path.EndpointA.GetClientState()
+	expAttributes := map[string]string{
+		clienttypes.AttributeKeyClientID:        path.EndpointA.ClientID,
+		clienttypes.AttributeKeyClientType:      exported.Tendermint,
+		clienttypes.AttributeKeyConsensusHeight: clientState.GetLatestHeight().String(),
+		clienttypes.AttributeKeyLatestHeight:    clientState.GetLatestHeight().String(),
+		clienttypes.AttributeKeyClientChainID:   suite.chainB.ChainID,
+	}
+
+	ctx := suite.chainA.GetContext()
+	keeper.EmitCreateClientEvent(ctx, path.EndpointA.ClientID, clientState)
+
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(clienttypes.EventTypeCreateClient, events[0].Type)
+
+	attributes := make(map[string]string)
+	for _, attr := range events[0].Attributes {
+		attributes[string(attr.Key)] = string(attr.Value)
+	}
+	suite.Require().Equal(expAttributes, attributes)
+
+	// clients without a chain ID omit the client chain ID attribute
+	ctx = suite.chainA.GetContext()
+	keeper.EmitCreateClientEvent(ctx, exported.LocalhostClientID, suite.chainA.GetClientState(exported.LocalhostClientID))
+
+	for _, attr := range ctx.EventManager().Events()[0].Attributes {
+		suite.Require().NotEqual(clienttypes.AttributeKeyClientChainID, string(attr.Key))
+	}
+}
+
+// TestPruneExpiredConsensusStates tests that all expired consensus states of a tendermint client, along
+// with their metadata, are pruned while consensus states which have not expired are kept.
+func (suite *KeeperTestSuite) TestPruneExpiredConsensusStates() {
+	var (
+		path           *ibctesting.Path
+		clientID       string
+		expPruned      int
+		expireTime     time.Duration
+		expiredHeights []exported.Height
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: no expired consensus states",
+			func() {
+				expireTime = 0
+				expPruned = 0
+			},
+			nil,
+		},
+		{
+			"client not found",
+			func() {
+				clientID = ibctesting.InvalidID
+			},
+			types.ErrClientNotFound,
+		},
+		{
+			"client is not a tendermint client",
+			func() {
+				clientID = "06-solomachine-100"
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, suite.solomachine.ClientState())
+			},
+			types.ErrInvalidClientType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			clientID = path.EndpointA.ClientID
+
+			// the consensus states created by the client creation and this update expire
+			expiredHeights = []exported.Height{path.EndpointA.GetClientState().GetLatestHeight()}
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			expiredHeights = append(expiredHeights, path.EndpointA.GetClientState().GetLatestHeight())
+
+			// the consensus state created by this update does not expire
+			suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod - time.Hour)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			latestHeight := path.EndpointA.GetClientState().GetLatestHeight()
+
+			expireTime = 2 * time.Hour
+			expPruned = 2
+
+			tc.malleate()
+
+			suite.coordinator.IncrementTimeBy(expireTime)
+			ctx := suite.chainA.GetContext()
+
+			totalPruned, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.PruneExpiredConsensusStates(ctx, clientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPruned, totalPruned)
+
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID)
+				for _, height := range expiredHeights {
+					_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(ctx, clientID, height)
+					suite.Require().Equal(expPruned == 0, found)
+
+					_, found = ibctm.GetProcessedTime(clientStore, height)
+					suite.Require().Equal(expPruned == 0, found)
+					_, found = ibctm.GetProcessedHeight(clientStore, height)
+					suite.Require().Equal(expPruned == 0, found)
+					suite.Require().Equal(expPruned == 0, ibctm.GetIterationKey(clientStore, height) != nil)
+				}
+
+				_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(ctx, clientID, latestHeight)
+				suite.Require().True(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Zero(totalPruned)
+			}
+		})
+	}
 }
@@ -14,14 +14,33 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
+// clientStateAttributes returns the event attributes describing the provided client state: its client
+// type, its latest height and, for clients tracking a chain identified by a chain ID such as
+// 07-tendermint clients, the chain ID of the counterparty chain.
+func clientStateAttributes(clientState exported.ClientState) []sdk.Attribute {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
+		sdk.NewAttribute(types.AttributeKeyLatestHeight, clientState.GetLatestHeight().String()),
+	}
+
+	if cs, ok := clientState.(interface{ GetChainID() string }); ok {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyClientChainID, cs.GetChainID()))
+	}
+
+	return attributes
+}
+
 // EmitCreateClientEvent emits a create client event
 func EmitCreateClientEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+	attributes := append([]sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+		sdk.NewAttribute(types.AttributeKeyConsensusHeight, clientState.GetLatestHeight().String()),
+	}, clientStateAttributes(clientState)...)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateClient,
-			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
-			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
-			sdk.NewAttribute(types.AttributeKeyConsensusHeight, clientState.GetLatestHeight().String()),
+			attributes...,
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -30,8 +49,9 @@ func EmitCreateClientEvent(ctx sdk.Context, clientID string, clientState exporte
 	})
 }
 
-// EmitUpdateClientEvent emits an update client event
-func EmitUpdateClientEvent(ctx sdk.Context, clientID string, clientType string, consensusHeights []exported.Height, cdc codec.BinaryCodec, clientMsg exported.ClientMessage) {
+// EmitUpdateClientEvent emits an update client event. The provided client state is expected to be the
+// client state after the update.
+func EmitUpdateClientEvent(ctx sdk.Context, clientID string, clientState exported.ClientState, consensusHeights []exported.Height, cdc codec.BinaryCodec, clientMsg exported.ClientMessage) {
 	// Marshal the ClientMessage as an Any and encode the resulting bytes to hex.
 	// This prevents the event value from containing invalid UTF-8 characters
 	// which may cause data to be lost when JSON encoding/decoding.
@@ -47,16 +67,19 @@ func EmitUpdateClientEvent(ctx sdk.Context, clientID string, clientType string,
 		consensusHeightsAttr[i] = height.String()
 	}
 
+	attributes := append([]sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+		// Deprecated: AttributeKeyConsensusHeight is deprecated and will be removed in a future release.
+		// Please use AttributeKeyConsensusHeights instead.
+		sdk.NewAttribute(types.AttributeKeyConsensusHeight, consensusHeightAttr),
+		sdk.NewAttribute(types.AttributeKeyConsensusHeights, strings.Join(consensusHeightsAttr, ",")),
+		sdk.NewAttribute(types.AttributeKeyHeader, clientMsgStr),
+	}, clientStateAttributes(clientState)...)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeUpdateClient,
-			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
-			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
-			// Deprecated: AttributeKeyConsensusHeight is deprecated and will be removed in a future release.
-			// Please use AttributeKeyConsensusHeights instead.
-			sdk.NewAttribute(types.AttributeKeyConsensusHeight, consensusHeightAttr),
-			sdk.NewAttribute(types.AttributeKeyConsensusHeights, strings.Join(consensusHeightsAttr, ",")),
-			sdk.NewAttribute(types.AttributeKeyHeader, clientMsgStr),
+			attributes...,
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -65,14 +88,17 @@ func EmitUpdateClientEvent(ctx sdk.Context, clientID string, clientType string,
 	})
 }
 
-// EmitUpdateClientEvent emits an upgrade client event
+// EmitUpgradeClientEvent emits an upgrade client event
 func EmitUpgradeClientEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+	attributes := append([]sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+		sdk.NewAttribute(types.AttributeKeyConsensusHeight, clientState.GetLatestHeight().String()),
+	}, clientStateAttributes(clientState)...)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeUpgradeClient,
-			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
-			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
-			sdk.NewAttribute(types.AttributeKeyConsensusHeight, clientState.GetLatestHeight().String()),
+			attributes...,
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -92,6 +118,18 @@ func EmitUpdateClientProposalEvent(ctx sdk.Context, clientID, clientType string)
 	)
 }
 
+// EmitPruneExpiredConsensusStatesEvent emits a prune expired consensus states event
+func EmitPruneExpiredConsensusStatesEvent(ctx sdk.Context, clientID, clientType string, totalPruned int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePruneConsensusStates,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+			sdk.NewAttribute(types.AttributeKeyTotalPruned, fmt.Sprintf("%d", totalPruned)),
+		),
+	)
+}
+
 // EmitUpgradeClientProposalEvent emits an upgrade client proposal event
 func EmitUpgradeClientProposalEvent(ctx sdk.Context, title string, height int64) {
 	ctx.EventManager().EmitEvent(
@@ -103,13 +141,22 @@ func EmitUpgradeClientProposalEvent(ctx sdk.Context, title string, height int64)
 	)
 }
 
-// EmitSubmitMisbehaviourEvent emits a client misbehaviour event
-func EmitSubmitMisbehaviourEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+// EmitSubmitMisbehaviourEvent emits a client misbehaviour event. If the misbehaviour was detected from a
+// header, e.g. a header conflicting with an existing consensus state, the height of the header is included.
+func EmitSubmitMisbehaviourEvent(ctx sdk.Context, clientID string, clientState exported.ClientState, clientMsg exported.ClientMessage) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+		sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
+	}
+
+	if header, ok := clientMsg.(interface{ GetHeight() exported.Height }); ok {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyConsensusHeight, header.GetHeight().String()))
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSubmitMisbehaviour,
-			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
-			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
+			attributes...,
 		),
 	)
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -52,7 +53,8 @@ func (q Keeper) ClientState(c context.Context, req *types.QueryClientStateReques
 	}, nil
 }
 
-// ClientStates implements the Query/ClientStates gRPC method
+// ClientStates implements the Query/ClientStates gRPC method. The ordering by client sequence only
+// applies to the client states of the returned page.
 func (q Keeper) ClientStates(c context.Context, req *types.QueryClientStatesRequest) (*types.QueryClientStatesResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -87,6 +89,8 @@ func (q Keeper) ClientStates(c context.Context, req *types.QueryClientStatesRequ
 		return nil, err
 	}
 
+	// client states are stored in lexicographic order of their identifiers, order the page by
+	// client type and sequence. Pages are not reordered relative to each other.
 	sort.Sort(clientStates)
 
 	return &types.QueryClientStatesResponse{
@@ -142,7 +146,8 @@ func (q Keeper) ConsensusState(c context.Context, req *types.QueryConsensusState
 	}, nil
 }
 
-// ConsensusStates implements the Query/ConsensusStates gRPC method
+// ConsensusStates implements the Query/ConsensusStates gRPC method. The ordering by height only
+// applies to the consensus states of the returned page.
 func (q Keeper) ConsensusStates(c context.Context, req *types.QueryConsensusStatesRequest) (*types.QueryConsensusStatesResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -180,13 +185,21 @@ func (q Keeper) ConsensusStates(c context.Context, req *types.QueryConsensusStat
 		return nil, err
 	}
 
+	// consensus states are stored in lexicographic order of their heights, order the page by
+	// height. Pages are not reordered relative to each other.
+	sort.Slice(consensusStates, func(i, j int) bool {
+		return consensusStates[i].Height.LT(consensusStates[j].Height)
+	})
+
 	return &types.QueryConsensusStatesResponse{
 		ConsensusStates: consensusStates,
 		Pagination:      pageRes,
 	}, nil
 }
 
-// ConsensusStateHeights implements the Query/ConsensusStateHeights gRPC method
+// ConsensusStateHeights implements the Query/ConsensusStateHeights gRPC method. It returns only the heights
+// of the consensus states, read from the iteration keys in ascending order, without loading the consensus
+// states or their metadata. Clients which do not store iteration keys have no heights returned.
 func (q Keeper) ConsensusStateHeights(c context.Context, req *types.QueryConsensusStateHeightsRequest) (*types.QueryConsensusStateHeightsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -199,21 +212,16 @@ func (q Keeper) ConsensusStateHeights(c context.Context, req *types.QueryConsens
 	ctx := sdk.UnwrapSDKContext(c)
 
 	var consensusStateHeights []types.Height
-	store := prefix.NewStore(ctx.KVStore(q.storeKey), host.FullClientKey(req.ClientId, []byte(fmt.Sprintf("%s/", host.KeyConsensusStatePrefix))))
 
-	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
-		// filter any metadata stored under consensus state key
-		if bytes.Contains(key, []byte("/")) {
-			return false, nil
-		}
-
-		height, err := types.ParseHeight(string(key))
-		if err != nil {
-			return false, err
-		}
+	// iterate the iteration keys rather than the consensus state keys so that
+	// the heights are returned in ascending order
+	iterationKeyPrefix := []byte(ibctm.KeyIterateConsensusStatePrefix)
+	store := prefix.NewStore(q.ClientStore(ctx, req.ClientId), iterationKeyPrefix)
 
-		consensusStateHeights = append(consensusStateHeights, height)
-		return true, nil
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		height := ibctm.GetHeightFromIterationKey(append(iterationKeyPrefix, key...))
+		consensusStateHeights = append(consensusStateHeights, height.(types.Height))
+		return nil
 	})
 	if err != nil {
 		return nil, err
@@ -252,6 +260,117 @@ func (q Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusRequ
 	}, nil
 }
 
+// ClientStatusDetailed implements the Query/ClientStatusDetailed gRPC method. In addition to the
+// status of the client, it returns the latest consensus state timestamp and the trusting period of
+// an Expired client and the frozen height of a Frozen client, if the client type exposes them.
+func (q Keeper) ClientStatusDetailed(c context.Context, req *types.QueryClientStatusDetailedRequest) (*types.QueryClientStatusDetailedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	clientState, found := q.GetClientState(ctx, req.ClientId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrClientNotFound, req.ClientId).Error(),
+		)
+	}
+
+	clientStore := q.ClientStore(ctx, req.ClientId)
+	clientStatus := clientState.Status(ctx, clientStore, q.cdc)
+
+	res := &types.QueryClientStatusDetailedResponse{
+		Status:     clientStatus.String(),
+		ClientType: clientState.ClientType(),
+	}
+
+	switch clientStatus {
+	case exported.Expired:
+		if consensusState, found := q.GetClientConsensusState(ctx, req.ClientId, clientState.GetLatestHeight()); found {
+			res.LatestConsensusTimestamp = consensusState.GetTimestamp()
+		}
+
+		if tmClientState, ok := clientState.(*ibctm.ClientState); ok {
+			res.TrustingPeriod = tmClientState.TrustingPeriod
+		}
+	case exported.Frozen:
+		if tmClientState, ok := clientState.(*ibctm.ClientState); ok {
+			res.FrozenHeight = tmClientState.FrozenHeight
+		}
+	}
+
+	return res, nil
+}
+
+// ConsensusStateProcessedTime implements the Query/ConsensusStateProcessedTime gRPC method. The
+// processed time is stored by clients which enforce the connection delay period, such as Tendermint
+// clients, and may be absent for consensus states created before it was stored.
+func (q Keeper) ConsensusStateProcessedTime(c context.Context, req *types.QueryConsensusStateProcessedTimeRequest) (*types.QueryConsensusStateProcessedTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.RevisionHeight == 0 {
+		return nil, status.Error(codes.InvalidArgument, "consensus state height cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height := types.NewHeight(req.RevisionNumber, req.RevisionHeight)
+
+	processedTime, found := ibctm.GetProcessedTime(q.ClientStore(ctx, req.ClientId), height)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(sdkerrors.ErrNotFound, "processed time for client-id: %s, height: %s", req.ClientId, height).Error(),
+		)
+	}
+
+	return &types.QueryConsensusStateProcessedTimeResponse{
+		ProcessedTime: processedTime,
+	}, nil
+}
+
+// ConsensusStateProcessedHeight implements the Query/ConsensusStateProcessedHeight gRPC method. The
+// processed height is stored by clients which enforce the connection delay period, such as Tendermint
+// clients, and may be absent for consensus states created before it was stored.
+func (q Keeper) ConsensusStateProcessedHeight(c context.Context, req *types.QueryConsensusStateProcessedHeightRequest) (*types.QueryConsensusStateProcessedHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.RevisionHeight == 0 {
+		return nil, status.Error(codes.InvalidArgument, "consensus state height cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height := types.NewHeight(req.RevisionNumber, req.RevisionHeight)
+
+	processedHeight, found := ibctm.GetProcessedHeight(q.ClientStore(ctx, req.ClientId), height)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(sdkerrors.ErrNotFound, "processed height for client-id: %s, height: %s", req.ClientId, height).Error(),
+		)
+	}
+
+	return &types.QueryConsensusStateProcessedHeightResponse{
+		ProcessedHeight: processedHeight.(types.Height),
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
@@ -93,7 +94,7 @@ func (suite *KeeperTestSuite) TestQueryClientState() {
 func (suite *KeeperTestSuite) TestQueryClientStates() {
 	var (
 		req             *types.QueryClientStatesRequest
-		expClientStates = types.IdentifiedClientStates{}
+		expClientStates types.IdentifiedClientStates
 	)
 
 	testCases := []struct {
@@ -116,7 +117,7 @@ func (suite *KeeperTestSuite) TestQueryClientStates() {
 			true,
 		},
 		{
-			"success, no results",
+			"success, only localhost client",
 			func() {
 				req = &types.QueryClientStatesRequest{
 					Pagination: &query.PageRequest{
@@ -142,8 +143,7 @@ func (suite *KeeperTestSuite) TestQueryClientStates() {
 				idcs := types.NewIdentifiedClientState(path1.EndpointA.ClientID, clientStateA1)
 				idcs2 := types.NewIdentifiedClientState(path2.EndpointA.ClientID, clientStateA2)
 
-				// order is sorted by client id
-				expClientStates = types.IdentifiedClientStates{idcs, idcs2}.Sort()
+				expClientStates = types.IdentifiedClientStates{idcs, idcs2}
 				req = &types.QueryClientStatesRequest{
 					Pagination: &query.PageRequest{
 						Limit:      20,
@@ -158,8 +158,13 @@ func (suite *KeeperTestSuite) TestQueryClientStates() {
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
+			expClientStates = nil
 			tc.malleate()
 
+			// the localhost client is created on genesis initialization
+			localhostClientState := suite.chainA.GetClientState(exported.LocalhostClientID)
+			expClientStates = append(expClientStates, types.NewIdentifiedClientState(exported.LocalhostClientID, localhostClientState))
+
 			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 
 			res, err := suite.chainA.QueryServer.ClientStates(ctx, req)
@@ -167,6 +172,7 @@ func (suite *KeeperTestSuite) TestQueryClientStates() {
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
+				// order is sorted by client id
 				suite.Require().Equal(expClientStates.Sort(), res.ClientStates)
 			} else {
 				suite.Require().Error(err)
@@ -175,6 +181,31 @@ func (suite *KeeperTestSuite) TestQueryClientStates() {
 	}
 }
 
+// TestQueryClientStatesOrdering tests that client states are ordered by the numeric sequence of
+// their client identifiers rather than lexicographically.
+func (suite *KeeperTestSuite) TestQueryClientStatesOrdering() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	clientState := path.EndpointA.GetClientState()
+
+	// the client created by the path has sequence 0
+	expClientStates := types.IdentifiedClientStates{types.NewIdentifiedClientState(path.EndpointA.ClientID, clientState)}
+	for i := 1; i <= 11; i++ {
+		clientID := types.FormatClientIdentifier(exported.Tendermint, uint64(i))
+		suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+		expClientStates = append(expClientStates, types.NewIdentifiedClientState(clientID, clientState))
+	}
+
+	// the localhost client is created on genesis initialization
+	localhostClientState := suite.chainA.GetClientState(exported.LocalhostClientID)
+	expClientStates = append(expClientStates, types.NewIdentifiedClientState(exported.LocalhostClientID, localhostClientState))
+
+	res, err := suite.chainA.QueryServer.ClientStates(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryClientStatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expClientStates, res.ClientStates)
+}
+
 func (suite *KeeperTestSuite) TestQueryConsensusState() {
 	var (
 		req               *types.QueryConsensusStateRequest
@@ -392,6 +423,33 @@ func (suite *KeeperTestSuite) TestQueryConsensusStates() {
 	}
 }
 
+// TestQueryConsensusStatesOrdering tests that consensus states are ordered by ascending height
+// rather than by the lexicographic order of their store keys.
+func (suite *KeeperTestSuite) TestQueryConsensusStatesOrdering() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	latestHeight := path.EndpointA.GetClientState().GetLatestHeight().(types.Height)
+	consensusState := path.EndpointA.GetConsensusState(latestHeight)
+
+	// replace the consensus state stored by the client creation with consensus states at revision
+	// heights 1 to 12 of the latest revision
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	clientStore.Delete(host.ConsensusStateKey(latestHeight))
+
+	var expConsensusStates []types.ConsensusStateWithHeight
+	for i := uint64(1); i <= 12; i++ {
+		height := types.NewHeight(latestHeight.GetRevisionNumber(), i)
+		suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, height, consensusState)
+		expConsensusStates = append(expConsensusStates, types.NewConsensusStateWithHeight(height, consensusState))
+	}
+
+	req := &types.QueryConsensusStatesRequest{ClientId: path.EndpointA.ClientID}
+	res, err := suite.chainA.QueryServer.ConsensusStates(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expConsensusStates, res.ConsensusStates)
+}
+
 func (suite *KeeperTestSuite) TestQueryConsensusStateHeights() {
 	var (
 		req                      *types.QueryConsensusStateHeightsRequest
@@ -448,6 +506,51 @@ func (suite *KeeperTestSuite) TestQueryConsensusStateHeights() {
 			},
 			true,
 		},
+		{
+			"success: returns consensus heights in ascending order",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				latestHeight := path.EndpointA.GetClientState().GetLatestHeight().(types.Height)
+				expConsensusStateHeights = append(expConsensusStateHeights, latestHeight)
+
+				// heights whose string representations are not in ascending lexicographic order
+				consensusState := path.EndpointA.GetConsensusState(latestHeight)
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				for _, revisionHeight := range []uint64{99, 100} {
+					height := types.NewHeight(latestHeight.GetRevisionNumber(), revisionHeight)
+					suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, height, consensusState)
+					ibctm.SetIterationKey(clientStore, height)
+
+					expConsensusStateHeights = append(expConsensusStateHeights, height)
+				}
+
+				req = &types.QueryConsensusStateHeightsRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+			},
+			true,
+		},
+		{
+			"success: heights are read from the iteration keys only",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				latestHeight := path.EndpointA.GetClientState().GetLatestHeight().(types.Height)
+				expConsensusStateHeights = append(expConsensusStateHeights, latestHeight)
+
+				// a consensus state stored without an iteration key is not returned
+				height := types.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()+1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, height, path.EndpointA.GetConsensusState(latestHeight))
+
+				req = &types.QueryConsensusStateHeightsRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+			},
+			true,
+		},
 		{
 			"invalid client identifier",
 			func() {
@@ -460,6 +563,7 @@ func (suite *KeeperTestSuite) TestQueryConsensusStateHeights() {
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
+			expConsensusStateHeights = nil
 
 			tc.malleate()
 			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
@@ -480,6 +584,100 @@ func (suite *KeeperTestSuite) TestQueryConsensusStateHeights() {
 	}
 }
 
+// TestQueryConsensusStateHeightsPagination tests that paging through the consensus state heights returns
+// every height once and in ascending order across pages.
+func (suite *KeeperTestSuite) TestQueryConsensusStateHeightsPagination() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	expConsensusStateHeights := []types.Height{path.EndpointA.GetClientState().GetLatestHeight().(types.Height)}
+	for i := 0; i < 3; i++ {
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		expConsensusStateHeights = append(expConsensusStateHeights, path.EndpointA.GetClientState().GetLatestHeight().(types.Height))
+	}
+
+	var (
+		consensusStateHeights []types.Height
+		nextKey               []byte
+	)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	for {
+		res, err := suite.chainA.QueryServer.ConsensusStateHeights(ctx, &types.QueryConsensusStateHeightsRequest{
+			ClientId: path.EndpointA.ClientID,
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: 1,
+			},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.ConsensusStateHeights, 1)
+
+		consensusStateHeights = append(consensusStateHeights, res.ConsensusStateHeights...)
+
+		nextKey = res.Pagination.NextKey
+		if len(nextKey) == 0 {
+			break
+		}
+	}
+
+	suite.Require().Equal(expConsensusStateHeights, consensusStateHeights)
+}
+
+// TestQueryFrozenClient tests that the client and consensus states of a frozen client may still be
+// queried, while updating the client is rejected.
+func (suite *KeeperTestSuite) TestQueryFrozenClient() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	firstHeight := path.EndpointA.GetClientState().GetLatestHeight().(types.Height)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	secondHeight := path.EndpointA.GetClientState().GetLatestHeight().(types.Height)
+
+	clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	clientState.FrozenHeight = types.NewHeight(0, 1)
+	path.EndpointA.SetClientState(clientState)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	clientID := path.EndpointA.ClientID
+
+	statusRes, err := suite.chainA.QueryServer.ClientStatus(ctx, &types.QueryClientStatusRequest{ClientId: clientID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(exported.Frozen.String(), statusRes.Status)
+
+	expClientState, err := types.PackClientState(clientState)
+	suite.Require().NoError(err)
+
+	clientStateRes, err := suite.chainA.QueryServer.ClientState(ctx, &types.QueryClientStateRequest{ClientId: clientID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expClientState, clientStateRes.ClientState)
+
+	for _, req := range []*types.QueryConsensusStateRequest{
+		{ClientId: clientID, RevisionNumber: firstHeight.RevisionNumber, RevisionHeight: firstHeight.RevisionHeight},
+		{ClientId: clientID, LatestHeight: true},
+	} {
+		consensusStateRes, err := suite.chainA.QueryServer.ConsensusState(ctx, req)
+		suite.Require().NoError(err)
+		suite.Require().NotNil(consensusStateRes.ConsensusState)
+	}
+
+	consensusStatesRes, err := suite.chainA.QueryServer.ConsensusStates(ctx, &types.QueryConsensusStatesRequest{ClientId: clientID})
+	suite.Require().NoError(err)
+	suite.Require().Len(consensusStatesRes.ConsensusStates, 2)
+
+	heightsRes, err := suite.chainA.QueryServer.ConsensusStateHeights(ctx, &types.QueryConsensusStateHeightsRequest{ClientId: clientID})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Height{firstHeight, secondHeight}, heightsRes.ConsensusStateHeights)
+
+	// only the update path rejects the frozen client
+	suite.coordinator.CommitBlock(suite.chainB)
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, clientID)
+	suite.Require().NoError(err)
+
+	err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, header)
+	suite.Require().ErrorIs(err, types.ErrClientNotActive)
+}
+
 func (suite *KeeperTestSuite) TestQueryClientStatus() {
 	var req *types.QueryClientStatusRequest
 
@@ -577,6 +775,354 @@ func (suite *KeeperTestSuite) TestQueryClientStatus() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryClientStatusDetailed() {
+	var (
+		req    *types.QueryClientStatusDetailedRequest
+		expRes *types.QueryClientStatusDetailedResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid clientID",
+			func() {
+				req = &types.QueryClientStatusDetailedRequest{}
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req = &types.QueryClientStatusDetailedRequest{
+					ClientId: ibctesting.InvalidID,
+				}
+			},
+			false,
+		},
+		{
+			"Active client status",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				req = &types.QueryClientStatusDetailedRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+				expRes = &types.QueryClientStatusDetailedResponse{
+					Status:     exported.Active.String(),
+					ClientType: exported.Tendermint,
+				}
+			},
+			true,
+		},
+		{
+			"Expired client status",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				consensusState := path.EndpointA.GetConsensusState(clientState.GetLatestHeight())
+
+				// expire the client by advancing time past the trusting period
+				suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+
+				req = &types.QueryClientStatusDetailedRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+				expRes = &types.QueryClientStatusDetailedResponse{
+					Status:                   exported.Expired.String(),
+					ClientType:               exported.Tendermint,
+					LatestConsensusTimestamp: consensusState.GetTimestamp(),
+					TrustingPeriod:           clientState.TrustingPeriod,
+				}
+			},
+			true,
+		},
+		{
+			"Expired client status without consensus state at latest height",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+
+				// increment latest height so no consensus state is stored
+				clientState.LatestHeight = clientState.LatestHeight.Increment().(types.Height)
+				path.EndpointA.SetClientState(clientState)
+
+				req = &types.QueryClientStatusDetailedRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+				expRes = &types.QueryClientStatusDetailedResponse{
+					Status:         exported.Expired.String(),
+					ClientType:     exported.Tendermint,
+					TrustingPeriod: clientState.TrustingPeriod,
+				}
+			},
+			true,
+		},
+		{
+			"Frozen client status",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+
+				req = &types.QueryClientStatusDetailedRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+				expRes = &types.QueryClientStatusDetailedResponse{
+					Status:       exported.Frozen.String(),
+					ClientType:   exported.Tendermint,
+					FrozenHeight: types.NewHeight(0, 1),
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.ClientStatusDetailed(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryConsensusStateProcessedTime() {
+	var (
+		req      *types.QueryConsensusStateProcessedTimeRequest
+		expValue uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid clientID",
+			func() {
+				req = &types.QueryConsensusStateProcessedTimeRequest{}
+			},
+			false,
+		},
+		{
+			"invalid height",
+			func() {
+				req = &types.QueryConsensusStateProcessedTimeRequest{
+					ClientId: testClientID,
+				}
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req = &types.QueryConsensusStateProcessedTimeRequest{
+					ClientId:       ibctesting.FirstClientID,
+					RevisionNumber: 1,
+					RevisionHeight: 1,
+				}
+			},
+			false,
+		},
+		{
+			"processed time not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+				height := path.EndpointA.GetClientState().GetLatestHeight()
+
+				// consensus states created before the metadata was stored have no processed time
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				clientStore.Delete(ibctm.ProcessedTimeKey(height))
+
+				req = &types.QueryConsensusStateProcessedTimeRequest{
+					ClientId:       path.EndpointA.ClientID,
+					RevisionNumber: height.GetRevisionNumber(),
+					RevisionHeight: height.GetRevisionHeight(),
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				height := path.EndpointA.GetClientState().GetLatestHeight()
+
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				processedTime, found := ibctm.GetProcessedTime(clientStore, height)
+				suite.Require().True(found)
+				expValue = processedTime
+
+				req = &types.QueryConsensusStateProcessedTimeRequest{
+					ClientId:       path.EndpointA.ClientID,
+					RevisionNumber: height.GetRevisionNumber(),
+					RevisionHeight: height.GetRevisionHeight(),
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.ConsensusStateProcessedTime(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expValue, res.ProcessedTime)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryConsensusStateProcessedHeight() {
+	var (
+		req      *types.QueryConsensusStateProcessedHeightRequest
+		expValue types.Height
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid clientID",
+			func() {
+				req = &types.QueryConsensusStateProcessedHeightRequest{}
+			},
+			false,
+		},
+		{
+			"invalid height",
+			func() {
+				req = &types.QueryConsensusStateProcessedHeightRequest{
+					ClientId: testClientID,
+				}
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req = &types.QueryConsensusStateProcessedHeightRequest{
+					ClientId:       ibctesting.FirstClientID,
+					RevisionNumber: 1,
+					RevisionHeight: 1,
+				}
+			},
+			false,
+		},
+		{
+			"processed height not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+				height := path.EndpointA.GetClientState().GetLatestHeight()
+
+				// consensus states created before the metadata was stored have no processed height
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				clientStore.Delete(ibctm.ProcessedHeightKey(height))
+
+				req = &types.QueryConsensusStateProcessedHeightRequest{
+					ClientId:       path.EndpointA.ClientID,
+					RevisionNumber: height.GetRevisionNumber(),
+					RevisionHeight: height.GetRevisionHeight(),
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				height := path.EndpointA.GetClientState().GetLatestHeight()
+
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				processedHeight, found := ibctm.GetProcessedHeight(clientStore, height)
+				suite.Require().True(found)
+				expValue = processedHeight.(types.Height)
+
+				req = &types.QueryConsensusStateProcessedHeightRequest{
+					ClientId:       path.EndpointA.ClientID,
+					RevisionNumber: height.GetRevisionNumber(),
+					RevisionHeight: height.GetRevisionHeight(),
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.ConsensusStateProcessedHeight(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expValue, res.ProcessedHeight)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryUpgradedConsensusStates() {
 	var (
 		req               *types.QueryUpgradedConsensusStateRequest
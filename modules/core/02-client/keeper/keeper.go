@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strings"
 
+	ics23 "github.com/confio/ics23/go"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -30,22 +31,52 @@ type Keeper struct {
 	paramSpace    paramtypes.Subspace
 	stakingKeeper types.StakingKeeper
 	upgradeKeeper types.UpgradeKeeper
+
+	// selfProofSpecs are the proof specs of the commitment store of the running chain, optional
+	selfProofSpecs []*ics23.ProofSpec
+}
+
+// Option configures optional behaviour of the client Keeper
+type Option func(*Keeper)
+
+// WithSelfProofSpecs sets the proof specs of the commitment store of the running chain. Clients
+// of the running chain stored by counterparties must use these proof specs. Chains using the
+// SDK commitment store do not need to set them, as the SDK proof specs are used by default.
+func WithSelfProofSpecs(proofSpecs []*ics23.ProofSpec) Option {
+	return func(k *Keeper) {
+		k.selfProofSpecs = proofSpecs
+	}
 }
 
 // NewKeeper creates a new NewKeeper instance
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace, sk types.StakingKeeper, uk types.UpgradeKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace, sk types.StakingKeeper, uk types.UpgradeKeeper, opts ...Option) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return Keeper{
+	keeper := Keeper{
 		storeKey:      key,
 		cdc:           cdc,
 		paramSpace:    paramSpace,
 		stakingKeeper: sk,
 		upgradeKeeper: uk,
 	}
+
+	for _, opt := range opts {
+		opt(&keeper)
+	}
+
+	return keeper
+}
+
+// GetSelfProofSpecs returns the proof specs of the commitment store of the running chain.
+func (k Keeper) GetSelfProofSpecs() []*ics23.ProofSpec {
+	if len(k.selfProofSpecs) == 0 {
+		return commitmenttypes.GetSDKSpecs()
+	}
+
+	return k.selfProofSpecs
 }
 
 // Logger returns a module-specific logger.
@@ -144,10 +175,15 @@ func (k Keeper) IterateConsensusStates(ctx sdk.Context, cb func(clientID string,
 	}
 }
 
-// GetAllGenesisClients returns all the clients in state with their client ids returned as IdentifiedClientState
+// GetAllGenesisClients returns all the clients in state with their client ids returned as IdentifiedClientState.
+// The 09-localhost client is omitted as it is created on genesis initialization.
 func (k Keeper) GetAllGenesisClients(ctx sdk.Context) types.IdentifiedClientStates {
 	var genClients types.IdentifiedClientStates
 	k.IterateClients(ctx, func(clientID string, cs exported.ClientState) bool {
+		if clientID == exported.LocalhostClientID {
+			return false
+		}
+
 		genClients = append(genClients, types.NewIdentifiedClientState(clientID, cs))
 		return false
 	})
@@ -298,7 +334,7 @@ func (k Keeper) ValidateSelfClient(ctx sdk.Context, clientState exported.ClientS
 			tmClient.LatestHeight, selfHeight)
 	}
 
-	expectedProofSpecs := commitmenttypes.GetSDKSpecs()
+	expectedProofSpecs := k.GetSelfProofSpecs()
 	if !reflect.DeepEqual(expectedProofSpecs, tmClient.ProofSpecs) {
 		return sdkerrors.Wrapf(types.ErrInvalidClient, "client has invalid proof specs. expected: %v got: %v",
 			expectedProofSpecs, tmClient.ProofSpecs)
@@ -330,6 +366,31 @@ func (k Keeper) ValidateSelfClient(ctx sdk.Context, clientState exported.ClientS
 	return nil
 }
 
+// ValidateSameChain returns an error if the provided client states are not of the same client type or,
+// for tendermint clients, do not track the same chain ID. It allows other submodules to check that two
+// clients track the same counterparty chain without depending on the light client implementations.
+func (k Keeper) ValidateSameChain(clientState, otherClientState exported.ClientState) error {
+	if clientState.ClientType() != otherClientState.ClientType() {
+		return sdkerrors.Wrapf(types.ErrInvalidClientType, "client type (%s) does not match client type (%s)", otherClientState.ClientType(), clientState.ClientType())
+	}
+
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return nil
+	}
+
+	otherTmClientState, ok := otherClientState.(*ibctm.ClientState)
+	if !ok {
+		return nil
+	}
+
+	if tmClientState.ChainId != otherTmClientState.ChainId {
+		return sdkerrors.Wrapf(types.ErrInvalidClient, "client chain ID (%s) does not match client chain ID (%s)", otherTmClientState.ChainId, tmClientState.ChainId)
+	}
+
+	return nil
+}
+
 // GetUpgradePlan executes the upgrade keeper GetUpgradePlan function.
 func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool) {
 	return k.upgradeKeeper.GetUpgradePlan(ctx)
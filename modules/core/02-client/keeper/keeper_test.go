@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	ics23 "github.com/confio/ics23/go"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -18,6 +19,7 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/core/02-client/keeper"
 	"github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 	solomachine "github.com/cosmos/ibc-go/v6/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint"
@@ -234,6 +236,68 @@ func (suite *KeeperTestSuite) TestValidateSelfClient() {
 	}
 }
 
+// TestValidateSelfClientProofSpecs tests that clients of the running chain must use the proof
+// specs set with WithSelfProofSpecs.
+func (suite *KeeperTestSuite) TestValidateSelfClientProofSpecs() {
+	testClientHeight := types.GetSelfHeight(suite.chainA.GetContext())
+	testClientHeight.RevisionHeight--
+
+	// the proof specs differ from the SDK proof specs in their maximum depth
+	iavlSpec, tmSpec := *ics23.IavlSpec, *ics23.TendermintSpec
+	iavlSpec.MaxDepth, tmSpec.MaxDepth = 64, 16
+	customProofSpecs := []*ics23.ProofSpec{&iavlSpec, &tmSpec}
+
+	suite.Require().Equal(commitmenttypes.GetSDKSpecs(), suite.chainA.App.GetIBCKeeper().ClientKeeper.GetSelfProofSpecs())
+
+	app := suite.chainA.App.(*simapp.SimApp)
+	clientKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(host.StoreKey), app.GetSubspace(host.ModuleName), app.StakingKeeper, app.UpgradeKeeper,
+		keeper.WithSelfProofSpecs(customProofSpecs),
+	)
+	suite.Require().Equal(customProofSpecs, clientKeeper.GetSelfProofSpecs())
+
+	clientState := ibctm.NewClientState(suite.chainA.ChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, customProofSpecs, ibctesting.UpgradePath)
+	suite.Require().NoError(clientKeeper.ValidateSelfClient(suite.chainA.GetContext(), clientState))
+
+	clientState = ibctm.NewClientState(suite.chainA.ChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+	suite.Require().ErrorIs(clientKeeper.ValidateSelfClient(suite.chainA.GetContext(), clientState), types.ErrInvalidClient)
+}
+
+// TestValidateSameChain tests that two client states are only considered to track the same chain
+// if they are of the same client type and, for tendermint clients, have the same chain ID.
+func (suite *KeeperTestSuite) TestValidateSameChain() {
+	tmClientState := ibctm.NewClientState(testChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, types.ZeroHeight(), commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+	otherRevisionClientState := ibctm.NewClientState(testChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, types.NewHeight(1, 1), commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+	otherChainClientState := ibctm.NewClientState(testChainIDRevision1, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, types.ZeroHeight(), commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+	solomachineClientState := suite.solomachine.ClientState()
+
+	testCases := []struct {
+		name             string
+		otherClientState exported.ClientState
+		expError         error
+	}{
+		{"success: same chain ID", otherRevisionClientState, nil},
+		{"different chain ID", otherChainClientState, types.ErrInvalidClient},
+		{"different client type", solomachineClientState, types.ErrInvalidClientType},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.ValidateSameChain(tmClientState, tc.otherClientState)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+
+	// clients of other types are only compared by client type
+	suite.Require().NoError(suite.chainA.App.GetIBCKeeper().ClientKeeper.ValidateSameChain(solomachineClientState, suite.solomachine.ClientState()))
+}
+
 func (suite KeeperTestSuite) TestGetAllGenesisClients() {
 	clientIDs := []string{
 		testClientID2, testClientID3, testClientID,
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
@@ -13,9 +15,32 @@ func (k Keeper) GetAllowedClients(ctx sdk.Context) []string {
 	return res
 }
 
+// GetMaxTrustingPeriodFraction retrieves the max trusting period fraction from the paramstore.
+// Zero is returned if the parameter has not been set.
+func (k Keeper) GetMaxTrustingPeriodFraction(ctx sdk.Context) sdk.Dec {
+	var res sdk.Dec
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxTrustingPeriodFraction, &res)
+	if res.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return res
+}
+
+// GetMaxAllowedClockDrift retrieves the max allowed clock drift from the paramstore. Zero is returned
+// if the parameter has not been set.
+func (k Keeper) GetMaxAllowedClockDrift(ctx sdk.Context) time.Duration {
+	var res time.Duration
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxAllowedClockDrift, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-client parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetAllowedClients(ctx)...)
+	params := types.NewParams(k.GetAllowedClients(ctx)...)
+	params.MaxTrustingPeriodFraction = k.GetMaxTrustingPeriodFraction(ctx)
+	params.MaxAllowedClockDrift = k.GetMaxAllowedClockDrift(ctx)
+
+	return params
 }
 
 // SetParams sets the total set of ibc-client parameters.
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 )
 
@@ -14,4 +18,20 @@ func (suite *KeeperTestSuite) TestParams() {
 	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), expParams)
 	params = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Empty(expParams.AllowedClients)
+
+	expParams.MaxTrustingPeriodFraction = sdk.NewDecWithPrec(5, 1)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	params = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams.MaxTrustingPeriodFraction, params.MaxTrustingPeriodFraction)
+
+	// a nil max trusting period fraction is treated as the default of zero
+	expParams.MaxTrustingPeriodFraction = sdk.Dec{}
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	params = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().True(params.MaxTrustingPeriodFraction.IsZero())
+
+	expParams.MaxAllowedClockDrift = time.Minute
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	params = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(time.Minute, params.MaxAllowedClockDrift)
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint"
 )
 
 // ClientUpdateProposal will retrieve the subject and substitute client.
@@ -17,6 +18,8 @@ import (
 // subtitute (enusring they match the subject's parameters) as well as copying
 // the necessary consensus states from the subtitute to the subject client
 // store. The substitute must be Active and the subject must not be Active.
+// If CarryOverMetadata is set, the subject must be a tendermint client and the consensus
+// metadata of the consensus states stored by both clients is copied from the substitute.
 func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdateProposal) error {
 	subjectClientState, found := k.GetClientState(ctx, p.SubjectClientId)
 	if !found {
@@ -44,7 +47,16 @@ func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdatePropo
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "substitute client is not Active, status is %s", status)
 	}
 
-	if err := subjectClientState.CheckSubstituteAndUpdateState(ctx, k.cdc, subjectClientStore, substituteClientStore, substituteClientState); err != nil {
+	if p.CarryOverMetadata {
+		tmClientState, ok := subjectClientState.(*ibctm.ClientState)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidUpdateClientProposal, "consensus metadata can only be carried over for %s clients, got %s", exported.Tendermint, subjectClientState.ClientType())
+		}
+
+		if err := tmClientState.CheckSubstituteAndUpdateStateWithMetadata(ctx, k.cdc, subjectClientStore, substituteClientStore, substituteClientState); err != nil {
+			return err
+		}
+	} else if err := subjectClientState.CheckSubstituteAndUpdateState(ctx, k.cdc, subjectClientStore, substituteClientStore, substituteClientState); err != nil {
 		return err
 	}
 
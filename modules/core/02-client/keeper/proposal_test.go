@@ -28,6 +28,17 @@ func (suite *KeeperTestSuite) TestClientUpdateProposal() {
 				content = types.NewClientUpdateProposal(ibctesting.Title, ibctesting.Description, subject, substitute)
 			}, true,
 		},
+		{
+			"valid update client proposal carrying over consensus metadata", func() {
+				content = &types.ClientUpdateProposal{
+					Title:              ibctesting.Title,
+					Description:        ibctesting.Description,
+					SubjectClientId:    subject,
+					SubstituteClientId: substitute,
+					CarryOverMetadata:  true,
+				}
+			}, true,
+		},
 		{
 			"subject and substitute use different revision numbers", func() {
 				tmClientState, ok := substituteClientState.(*ibctm.ClientState)
@@ -56,6 +67,21 @@ func (suite *KeeperTestSuite) TestClientUpdateProposal() {
 				content = types.NewClientUpdateProposal(ibctesting.Title, ibctesting.Description, subject, substitute)
 			}, false,
 		},
+		{
+			"cannot carry over consensus metadata for solomachine subject", func() {
+				smClientState := ibctesting.NewSolomachine(suite.T(), suite.cdc, "solo machine", "", 1).ClientState()
+				smClientState.IsFrozen = true
+				subjectClientState = smClientState
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subject, subjectClientState)
+				content = &types.ClientUpdateProposal{
+					Title:              ibctesting.Title,
+					Description:        ibctesting.Description,
+					SubjectClientId:    subject,
+					SubstituteClientId: substitute,
+					CarryOverMetadata:  true,
+				}
+			}, false,
+		},
 		{
 			"subject client does not exist", func() {
 				content = types.NewClientUpdateProposal(ibctesting.Title, ibctesting.Description, ibctesting.InvalidID, substitute)
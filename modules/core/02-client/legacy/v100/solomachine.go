@@ -108,7 +108,7 @@ func (cs *ClientState) VerifyClientMessage(
 }
 
 // UpdateState panis!
-func (cs *ClientState) UpdateState(_ sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore, _ exported.ClientMessage) []exported.Height {
+func (cs *ClientState) UpdateState(_ sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore, _ exported.ClientMessage) ([]exported.Height, error) {
 	panic("legacy solo machine is deprecated!")
 }
 
@@ -45,6 +45,11 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Binar
 	}
 
 	for _, clientID := range clients {
+		// the 09-localhost client does not require migration
+		if clientID == exported.LocalhostClientID {
+			continue
+		}
+
 		clientType, _, err := clienttypes.ParseClientIdentifier(clientID)
 		if err != nil {
 			return err
@@ -60,28 +65,10 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Binar
 
 		switch clientType {
 		case exported.Solomachine:
-			any := &codectypes.Any{}
-			if err := cdc.Unmarshal(bz, any); err != nil {
-				return sdkerrors.Wrap(err, "failed to unmarshal client state bytes into solo machine client state")
-			}
-
-			clientState := &ClientState{}
-			if err := cdc.Unmarshal(any.Value, clientState); err != nil {
-				return sdkerrors.Wrap(err, "failed to unmarshal client state bytes into solo machine client state")
-			}
-
-			updatedClientState := migrateSolomachine(clientState)
-
-			bz, err := clienttypes.MarshalClientState(cdc, updatedClientState)
-			if err != nil {
-				return sdkerrors.Wrap(err, "failed to unmarshal client state bytes into solo machine client state")
+			if err := migrateSolomachineClientStore(cdc, clientStore, bz); err != nil {
+				return err
 			}
 
-			// update solomachine in store
-			clientStore.Set(host.ClientStateKey(), bz)
-
-			pruneSolomachineConsensusStates(clientStore)
-
 		case exported.Tendermint:
 			var clientState exported.ClientState
 			if err := cdc.UnmarshalInterface(bz, &clientState); err != nil {
@@ -105,6 +92,89 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Binar
 	return nil
 }
 
+// MigrateSolomachineV1ToV2 migrates all solo machine client states which are still stored using the v1
+// protobuf definition to the v2 protobuf definition and prunes their consensus states. It may be used by
+// chains which did not run MigrateStore and still have v1 solo machine clients in their store. Solo machine
+// client states which are already stored using the v2 protobuf definition are left untouched, so the
+// migration may be run multiple times.
+func MigrateSolomachineV1ToV2(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, host.KeyClientStorePrefix)
+
+	var clients []string
+
+	// collect all solo machine clients
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+		if keySplit[len(keySplit)-1] != host.KeyClientState {
+			continue
+		}
+
+		// key is clients/{clientid}/clientState
+		// Thus, keySplit[1] is clientID
+		clientType, _, err := clienttypes.ParseClientIdentifier(keySplit[1])
+		if err != nil || clientType != exported.Solomachine {
+			continue
+		}
+
+		clients = append(clients, keySplit[1])
+	}
+
+	for _, clientID := range clients {
+		clientPrefix := []byte(fmt.Sprintf("%s/%s/", host.KeyClientStorePrefix, clientID))
+		clientStore := prefix.NewStore(ctx.KVStore(storeKey), clientPrefix)
+
+		bz := clientStore.Get(host.ClientStateKey())
+		if bz == nil {
+			return clienttypes.ErrClientNotFound
+		}
+
+		// client states which decode to the v2 protobuf definition have already been migrated
+		var clientState exported.ClientState
+		if err := cdc.UnmarshalInterface(bz, &clientState); err == nil {
+			if _, ok := clientState.(*solomachine.ClientState); ok {
+				continue
+			}
+		}
+
+		if err := migrateSolomachineClientStore(cdc, clientStore, bz); err != nil {
+			return sdkerrors.Wrapf(err, "client ID (%s)", clientID)
+		}
+	}
+
+	return nil
+}
+
+// migrateSolomachineClientStore migrates the provided v1 solo machine client state bytes to the v2
+// solo machine protobuf definition, sets the migrated client state in the client store and prunes
+// all consensus states of the client.
+func migrateSolomachineClientStore(cdc codec.BinaryCodec, clientStore sdk.KVStore, bz []byte) error {
+	any := &codectypes.Any{}
+	if err := cdc.Unmarshal(bz, any); err != nil {
+		return sdkerrors.Wrap(err, "failed to unmarshal client state bytes into solo machine client state")
+	}
+
+	clientState := &ClientState{}
+	if err := cdc.Unmarshal(any.Value, clientState); err != nil {
+		return sdkerrors.Wrap(err, "failed to unmarshal client state bytes into solo machine client state")
+	}
+
+	updatedClientState := migrateSolomachine(clientState)
+
+	bz, err := clienttypes.MarshalClientState(cdc, updatedClientState)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to unmarshal client state bytes into solo machine client state")
+	}
+
+	// update solomachine in store
+	clientStore.Set(host.ClientStateKey(), bz)
+
+	pruneSolomachineConsensusStates(clientStore)
+
+	return nil
+}
+
 // migrateSolomachine migrates the solomachine from v1 to v2 solo machine protobuf definition.
 func migrateSolomachine(clientState *ClientState) *solomachine.ClientState {
 	isFrozen := clientState.FrozenSequence != 0
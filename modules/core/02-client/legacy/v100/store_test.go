@@ -113,6 +113,66 @@ func (suite *LegacyTestSuite) TestMigrateStoreSolomachine() {
 	}
 }
 
+// ensure v1 solo machine client states are migrated and their consensus states are removed,
+// while solo machine clients already using the v2 definition are left untouched
+func (suite *LegacyTestSuite) TestMigrateSolomachineV1ToV2() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	ctx := path.EndpointA.Chain.GetContext()
+	clientKeeper := path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper
+	height := types.NewHeight(0, 1)
+
+	legacySolomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "06-solomachine-0", "testing", 1)
+	solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "06-solomachine-1", "testing", 1)
+
+	// generate the old proto buf definition for the legacy solo machine
+	clientState := legacySolomachine.ClientState()
+	legacyClientState := &v100.ClientState{
+		Sequence: clientState.Sequence,
+		ConsensusState: &v100.ConsensusState{
+			PublicKey:   clientState.ConsensusState.PublicKey,
+			Diversifier: clientState.ConsensusState.Diversifier,
+			Timestamp:   clientState.ConsensusState.Timestamp,
+		},
+	}
+
+	clientStore := clientKeeper.ClientStore(ctx, legacySolomachine.ClientID)
+	bz, err := path.EndpointA.Chain.App.AppCodec().MarshalInterface(legacyClientState)
+	suite.Require().NoError(err)
+	clientStore.Set(host.ClientStateKey(), bz)
+
+	bz, err = path.EndpointA.Chain.App.AppCodec().MarshalInterface(legacyClientState.ConsensusState)
+	suite.Require().NoError(err)
+	clientStore.Set(host.ConsensusStateKey(height), bz)
+
+	// the second solo machine already uses the v2 definition
+	clientKeeper.SetClientState(ctx, solomachine.ClientID, solomachine.ClientState())
+	clientKeeper.SetClientConsensusState(ctx, solomachine.ClientID, height, solomachine.ConsensusState())
+
+	// create tendermint clients
+	suite.coordinator.SetupClients(path)
+
+	// the migration is a no-op when run again
+	for i := 0; i < 2; i++ {
+		err = v100.MigrateSolomachineV1ToV2(ctx, path.EndpointA.Chain.GetSimApp().GetKey(host.StoreKey), path.EndpointA.Chain.App.AppCodec())
+		suite.Require().NoError(err)
+
+		for _, sm := range []*ibctesting.Solomachine{legacySolomachine, solomachine} {
+			clientState, ok := clientKeeper.GetClientState(ctx, sm.ClientID)
+			suite.Require().True(ok)
+			suite.Require().Equal(sm.ClientState(), clientState)
+		}
+
+		_, found := clientKeeper.GetClientConsensusState(ctx, legacySolomachine.ClientID, height)
+		suite.Require().False(found)
+
+		_, found = clientKeeper.GetClientConsensusState(ctx, solomachine.ClientID, height)
+		suite.Require().True(found)
+
+		_, found = clientKeeper.GetClientState(ctx, path.EndpointA.ClientID)
+		suite.Require().True(found)
+	}
+}
+
 // only test migration for tendermint clients
 // ensure all expired consensus states are removed from tendermint client stores
 func (suite *LegacyTestSuite) TestMigrateStoreTendermint() {
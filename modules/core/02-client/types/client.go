@@ -50,8 +50,10 @@ type IdentifiedClientStates []IdentifiedClientState
 // Len implements sort.Interface
 func (ics IdentifiedClientStates) Len() int { return len(ics) }
 
-// Less implements sort.Interface
-func (ics IdentifiedClientStates) Less(i, j int) bool { return ics[i].ClientId < ics[j].ClientId }
+// Less implements sort.Interface. Client identifiers of the same client type are ordered by their sequence.
+func (ics IdentifiedClientStates) Less(i, j int) bool {
+	return clientIdentifierLess(ics[i].ClientId, ics[j].ClientId)
+}
 
 // Swap implements sort.Interface
 func (ics IdentifiedClientStates) Swap(i, j int) { ics[i], ics[j] = ics[j], ics[i] }
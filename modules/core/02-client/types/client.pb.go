@@ -7,18 +7,23 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -208,6 +213,10 @@ type ClientUpdateProposal struct {
 	// the substitute client identifier for the client standing in for the subject
 	// client
 	SubstituteClientId string `protobuf:"bytes,4,opt,name=substitute_client_id,json=substituteClientId,proto3" json:"substitute_client_id,omitempty" yaml:"substitute_client_id"`
+	// carry over the processed time and processed height of the consensus states
+	// stored by both the subject and the substitute client. Only supported for
+	// tendermint clients.
+	CarryOverMetadata bool `protobuf:"varint,5,opt,name=carry_over_metadata,json=carryOverMetadata,proto3" json:"carry_over_metadata,omitempty" yaml:"carry_over_metadata"`
 }
 
 func (m *ClientUpdateProposal) Reset()         { *m = ClientUpdateProposal{} }
@@ -343,6 +352,13 @@ var xxx_messageInfo_Height proto.InternalMessageInfo
 type Params struct {
 	// allowed_clients defines the list of allowed client state types.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty" yaml:"allowed_clients"`
+	// max_trusting_period_fraction defines the maximum fraction of the unbonding period which may be used as the
+	// trusting period of tendermint clients upon creation. A value of zero applies no restriction.
+	MaxTrustingPeriodFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_trusting_period_fraction,json=maxTrustingPeriodFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_trusting_period_fraction" yaml:"max_trusting_period_fraction"`
+	// max_allowed_clock_drift defines the maximum clock drift which may be used by tendermint clients upon creation.
+	// A value of zero applies no restriction. It is not checked upon client upgrade or recovery, which keep the
+	// max clock drift of the existing client.
+	MaxAllowedClockDrift time.Duration `protobuf:"bytes,6,opt,name=max_allowed_clock_drift,json=maxAllowedClockDrift,proto3,stdduration" json:"max_allowed_clock_drift" yaml:"max_allowed_clock_drift"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -385,6 +401,13 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetMaxAllowedClockDrift() time.Duration {
+	if m != nil {
+		return m.MaxAllowedClockDrift
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
@@ -398,53 +421,64 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbd, 0x6e, 0x13, 0x4b,
-	0x18, 0xf5, 0x3a, 0xbe, 0x56, 0x3c, 0xbe, 0x8a, 0x73, 0x37, 0xce, 0x8d, 0xaf, 0x6f, 0xe4, 0xb1,
-	0x46, 0x14, 0x16, 0x22, 0xbb, 0xd8, 0x48, 0x51, 0xe4, 0x0e, 0xbb, 0x49, 0x0a, 0x90, 0x59, 0x14,
-	0x21, 0x68, 0xac, 0xfd, 0x99, 0xac, 0x27, 0x5a, 0xef, 0x58, 0x3b, 0xb3, 0x06, 0xbf, 0x01, 0x1d,
-	0x94, 0x20, 0xa5, 0xc8, 0x1b, 0xd0, 0xf0, 0x08, 0x14, 0x11, 0x55, 0x4a, 0xaa, 0x15, 0x4a, 0x1a,
-	0x6a, 0x3f, 0x01, 0xf2, 0xcc, 0x6c, 0x62, 0xe7, 0x07, 0x10, 0x74, 0x33, 0x67, 0xce, 0x9e, 0x39,
-	0xdf, 0xf1, 0x1e, 0x2f, 0x80, 0xc4, 0x71, 0x4d, 0x97, 0x46, 0xd8, 0x74, 0x03, 0x82, 0x43, 0x6e,
-	0x8e, 0x9b, 0x6a, 0x65, 0x8c, 0x22, 0xca, 0xa9, 0xae, 0x13, 0xc7, 0x35, 0x66, 0x04, 0x43, 0xc1,
-	0xe3, 0x66, 0xb5, 0xec, 0x53, 0x9f, 0x8a, 0x63, 0x73, 0xb6, 0x92, 0xcc, 0xea, 0x7f, 0x3e, 0xa5,
-	0x7e, 0x80, 0x4d, 0xb1, 0x73, 0xe2, 0x03, 0xd3, 0x0e, 0x27, 0xea, 0xe8, 0x8e, 0x4b, 0xd9, 0x90,
-	0x32, 0x33, 0x1e, 0xf9, 0x91, 0xed, 0x61, 0x73, 0xdc, 0x74, 0x30, 0xb7, 0x9b, 0xe9, 0x3e, 0x15,
-	0x90, 0xac, 0xbe, 0x54, 0x96, 0x1b, 0x79, 0x84, 0x8e, 0x34, 0xb0, 0xbe, 0xe7, 0xe1, 0x90, 0x93,
-	0x03, 0x82, 0xbd, 0xae, 0x70, 0xf2, 0x94, 0xdb, 0x1c, 0xeb, 0x4d, 0x50, 0x90, 0xc6, 0xfa, 0xc4,
-	0xab, 0x68, 0x75, 0xad, 0x51, 0xe8, 0x94, 0xa7, 0x09, 0x5c, 0x9d, 0xd8, 0xc3, 0xa0, 0x8d, 0x2e,
-	0x8e, 0x90, 0xb5, 0x2c, 0xd7, 0x7b, 0x9e, 0xde, 0x03, 0x7f, 0x2b, 0x9c, 0xcd, 0x24, 0x2a, 0xd9,
-	0xba, 0xd6, 0x28, 0xb6, 0xca, 0x86, 0xf4, 0x6f, 0xa4, 0xfe, 0x8d, 0x87, 0xe1, 0xa4, 0xb3, 0x31,
-	0x4d, 0xe0, 0xda, 0x82, 0x96, 0x78, 0x06, 0x59, 0x45, 0xf7, 0xd2, 0x04, 0xfa, 0xa0, 0x81, 0x4a,
-	0x97, 0x86, 0x0c, 0x87, 0x2c, 0x66, 0x02, 0x7a, 0x46, 0xf8, 0x60, 0x17, 0x13, 0x7f, 0xc0, 0xf5,
-	0x1d, 0x90, 0x1f, 0x88, 0x95, 0xb0, 0x57, 0x6c, 0x55, 0x8d, 0xeb, 0x91, 0x1a, 0x92, 0xdb, 0xc9,
-	0x9d, 0x24, 0x30, 0x63, 0x29, 0xbe, 0xfe, 0x1c, 0x94, 0xdc, 0x54, 0xf5, 0x17, 0xbc, 0x56, 0xa7,
-	0x09, 0xfc, 0x57, 0x79, 0x5d, 0x7c, 0x0c, 0x59, 0x2b, 0xee, 0x82, 0x3d, 0xf4, 0x49, 0x03, 0xeb,
-	0x32, 0xc6, 0x45, 0xdf, 0xec, 0x77, 0x02, 0x7d, 0x05, 0x56, 0xaf, 0x5c, 0xc8, 0x2a, 0xd9, 0xfa,
-	0x52, 0xa3, 0xd8, 0xba, 0x77, 0xd3, 0xac, 0xb7, 0x25, 0xd5, 0x81, 0xb3, 0xe9, 0xa7, 0x09, 0xdc,
-	0xb8, 0x71, 0x08, 0x86, 0xac, 0xd2, 0xe2, 0x14, 0x0c, 0xbd, 0xc9, 0x82, 0xb2, 0x1c, 0x63, 0x7f,
-	0xe4, 0xd9, 0x1c, 0xf7, 0x22, 0x3a, 0xa2, 0xcc, 0x0e, 0xf4, 0x32, 0xf8, 0x8b, 0x13, 0x1e, 0x60,
-	0x39, 0x81, 0x25, 0x37, 0x7a, 0x1d, 0x14, 0x3d, 0xcc, 0xdc, 0x88, 0x8c, 0x38, 0xa1, 0xa1, 0x08,
-	0xb3, 0x60, 0xcd, 0x43, 0xfa, 0x2e, 0xf8, 0x87, 0xc5, 0xce, 0x21, 0x76, 0x79, 0xff, 0x32, 0x85,
-	0x25, 0x91, 0xc2, 0xe6, 0x34, 0x81, 0x15, 0xe9, 0xec, 0x1a, 0x05, 0x59, 0x25, 0x85, 0x75, 0xd3,
-	0x50, 0x9e, 0x80, 0x32, 0x8b, 0x1d, 0xc6, 0x09, 0x8f, 0x39, 0x9e, 0x13, 0xcb, 0x09, 0x31, 0x38,
-	0x4d, 0xe0, 0xff, 0x17, 0x62, 0xd7, 0x58, 0xc8, 0xd2, 0x2f, 0xe1, 0x54, 0xb2, 0x8d, 0x5e, 0x1f,
-	0xc3, 0xcc, 0xe7, 0x8f, 0x5b, 0x55, 0xd5, 0x0d, 0x9f, 0x8e, 0x0d, 0x55, 0xa5, 0x59, 0xa8, 0x1c,
-	0x87, 0x1c, 0xbd, 0xcf, 0x82, 0xd2, 0xbe, 0xac, 0xd5, 0x1f, 0x87, 0xb1, 0x0d, 0x72, 0xa3, 0xc0,
-	0x0e, 0xc5, 0xfc, 0xc5, 0xd6, 0xa6, 0xa1, 0xae, 0x4d, 0x5b, 0x9b, 0x5e, 0xdd, 0x0b, 0xec, 0x50,
-	0xbd, 0xb9, 0x82, 0xaf, 0x1f, 0x82, 0x75, 0xc5, 0xf1, 0xfa, 0x0b, 0x4d, 0xcb, 0xfd, 0xe0, 0xed,
-	0xad, 0x4f, 0x13, 0xb8, 0x29, 0x13, 0xb9, 0xf1, 0x61, 0x64, 0xad, 0xa5, 0xf8, 0x5c, 0xff, 0xdb,
-	0x77, 0x67, 0x99, 0xbc, 0x3b, 0x86, 0x99, 0x6f, 0xc7, 0x50, 0xfb, 0x49, 0x36, 0x47, 0x1a, 0xc8,
-	0xab, 0x52, 0x76, 0x41, 0x29, 0xc2, 0x63, 0xc2, 0x08, 0x0d, 0xfb, 0x61, 0x3c, 0x74, 0x70, 0x24,
-	0xc2, 0xc9, 0xcd, 0x97, 0xe8, 0x0a, 0x01, 0x59, 0x2b, 0x29, 0xf2, 0x58, 0x00, 0x0b, 0x22, 0xaa,
-	0xe2, 0xd9, 0x5b, 0x45, 0x24, 0x61, 0x4e, 0x44, 0x3a, 0x69, 0x2f, 0xa7, 0x03, 0xa0, 0x47, 0x20,
-	0xdf, 0xb3, 0x23, 0x7b, 0xc8, 0x66, 0xc2, 0x76, 0x10, 0xd0, 0x97, 0x17, 0x11, 0xb0, 0x8a, 0x56,
-	0x5f, 0x6a, 0x14, 0xe6, 0x85, 0xaf, 0x10, 0x90, 0xb5, 0xa2, 0x10, 0x99, 0x0e, 0xeb, 0x58, 0x27,
-	0x67, 0x35, 0xed, 0xf4, 0xac, 0xa6, 0x7d, 0x3d, 0xab, 0x69, 0x6f, 0xcf, 0x6b, 0x99, 0xd3, 0xf3,
-	0x5a, 0xe6, 0xcb, 0x79, 0x2d, 0xf3, 0x62, 0xc7, 0x27, 0x7c, 0x10, 0x3b, 0x86, 0x4b, 0x87, 0xea,
-	0x6f, 0xd6, 0x24, 0x8e, 0xbb, 0xe5, 0x53, 0x73, 0xbc, 0x6d, 0x0e, 0xa9, 0x17, 0x07, 0x98, 0xc9,
-	0x8f, 0xc2, 0xfd, 0xd6, 0x96, 0xfa, 0x2e, 0xf0, 0xc9, 0x08, 0x33, 0x27, 0x2f, 0x7e, 0xb2, 0x07,
-	0xdf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x94, 0xba, 0xc2, 0x37, 0x06, 0x00, 0x00,
+	// 909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xc1, 0x6e, 0xe3, 0x44,
+	0x18, 0x8e, 0xb3, 0xd9, 0xa8, 0x9d, 0xa0, 0x66, 0xd7, 0x4d, 0x69, 0x1a, 0xaa, 0x38, 0x1a, 0x10,
+	0x8a, 0x56, 0xd4, 0x26, 0x41, 0x5a, 0xad, 0x7a, 0xdb, 0xb4, 0x42, 0xbb, 0x07, 0x96, 0x60, 0xa8,
+	0x10, 0x5c, 0xac, 0xb1, 0x3d, 0x75, 0x67, 0x6b, 0x7b, 0x22, 0xcf, 0x38, 0x34, 0x12, 0x0f, 0xc0,
+	0x91, 0x13, 0x5a, 0xa4, 0x3d, 0xf4, 0x0d, 0xb8, 0xf0, 0x08, 0x1c, 0x56, 0x9c, 0xf6, 0x88, 0x38,
+	0x78, 0x51, 0xcb, 0x81, 0xb3, 0x9f, 0x00, 0x79, 0x66, 0xdc, 0x24, 0x4d, 0x77, 0x41, 0x70, 0xf2,
+	0xcc, 0xff, 0x7f, 0xf3, 0xf9, 0xfb, 0xbf, 0x99, 0x7f, 0x06, 0x18, 0xc4, 0xf5, 0x2c, 0x8f, 0x26,
+	0xd8, 0xf2, 0x42, 0x82, 0x63, 0x6e, 0x4d, 0x07, 0x6a, 0x64, 0x4e, 0x12, 0xca, 0xa9, 0xae, 0x13,
+	0xd7, 0x33, 0x0b, 0x80, 0xa9, 0xc2, 0xd3, 0x41, 0xa7, 0x15, 0xd0, 0x80, 0x8a, 0xb4, 0x55, 0x8c,
+	0x24, 0xb2, 0xb3, 0x13, 0x50, 0x1a, 0x84, 0xd8, 0x12, 0x33, 0x37, 0x3d, 0xb6, 0x50, 0x3c, 0x53,
+	0xa9, 0xf7, 0x3c, 0xca, 0x22, 0xca, 0xac, 0x74, 0x12, 0x24, 0xc8, 0xc7, 0xd6, 0x74, 0xe0, 0x62,
+	0x8e, 0x06, 0xe5, 0xbc, 0x24, 0x90, 0x28, 0x47, 0x32, 0xcb, 0x89, 0x4a, 0x75, 0xaf, 0x73, 0xfb,
+	0x69, 0x82, 0x38, 0xa1, 0xb1, 0xcc, 0xc3, 0xe7, 0x1a, 0xd8, 0x7a, 0xec, 0xe3, 0x98, 0x93, 0x63,
+	0x82, 0xfd, 0x03, 0xa1, 0xf4, 0x73, 0x8e, 0x38, 0xd6, 0x07, 0x60, 0x5d, 0x0a, 0x77, 0x88, 0xdf,
+	0xd6, 0x7a, 0x5a, 0x7f, 0x7d, 0xd4, 0xca, 0x33, 0xe3, 0xce, 0x0c, 0x45, 0xe1, 0x3e, 0xbc, 0x4a,
+	0x41, 0x7b, 0x4d, 0x8e, 0x1f, 0xfb, 0xfa, 0x18, 0xbc, 0xa5, 0xe2, 0xac, 0xa0, 0x68, 0x57, 0x7b,
+	0x5a, 0xbf, 0x31, 0x6c, 0x99, 0x52, 0x83, 0x59, 0x6a, 0x30, 0x1f, 0xc6, 0xb3, 0xd1, 0x76, 0x9e,
+	0x19, 0x9b, 0x4b, 0x5c, 0x62, 0x0d, 0xb4, 0x1b, 0xde, 0x5c, 0x04, 0xfc, 0x49, 0x03, 0xed, 0x03,
+	0x1a, 0x33, 0x1c, 0xb3, 0x94, 0x89, 0xd0, 0x97, 0x84, 0x9f, 0x3c, 0xc2, 0x24, 0x38, 0xe1, 0xfa,
+	0x03, 0x50, 0x3f, 0x11, 0x23, 0x21, 0xaf, 0x31, 0xec, 0x98, 0xab, 0x96, 0x9b, 0x12, 0x3b, 0xaa,
+	0xbd, 0xc8, 0x8c, 0x8a, 0xad, 0xf0, 0xfa, 0x57, 0xa0, 0xe9, 0x95, 0xac, 0xff, 0x42, 0x6b, 0x27,
+	0xcf, 0x8c, 0xb7, 0x95, 0xd6, 0xe5, 0x65, 0xd0, 0xde, 0xf0, 0x96, 0xe4, 0xc1, 0x5f, 0x34, 0xb0,
+	0x25, 0x6d, 0x5c, 0xd6, 0xcd, 0xfe, 0x8b, 0xa1, 0x67, 0xe0, 0xce, 0xb5, 0x1f, 0xb2, 0x76, 0xb5,
+	0x77, 0xab, 0xdf, 0x18, 0x7e, 0x70, 0x53, 0xad, 0xaf, 0x73, 0x6a, 0x64, 0x14, 0xd5, 0xe7, 0x99,
+	0xb1, 0x7d, 0x63, 0x11, 0x0c, 0xda, 0xcd, 0xe5, 0x2a, 0x18, 0x7c, 0x55, 0x05, 0x2d, 0x59, 0xc6,
+	0xd1, 0xc4, 0x47, 0x1c, 0x8f, 0x13, 0x3a, 0xa1, 0x0c, 0x85, 0x7a, 0x0b, 0xdc, 0xe6, 0x84, 0x87,
+	0x58, 0x56, 0x60, 0xcb, 0x89, 0xde, 0x03, 0x0d, 0x1f, 0x33, 0x2f, 0x21, 0x93, 0xe2, 0x6c, 0x09,
+	0x33, 0xd7, 0xed, 0xc5, 0x90, 0xfe, 0x08, 0xdc, 0x65, 0xa9, 0xfb, 0x14, 0x7b, 0xdc, 0x99, 0xbb,
+	0x70, 0x4b, 0xb8, 0xb0, 0x9b, 0x67, 0x46, 0x5b, 0x2a, 0x5b, 0x81, 0x40, 0xbb, 0xa9, 0x62, 0x07,
+	0xa5, 0x29, 0x9f, 0x81, 0x16, 0x4b, 0x5d, 0xc6, 0x09, 0x4f, 0x39, 0x5e, 0x20, 0xab, 0x09, 0x32,
+	0x23, 0xcf, 0x8c, 0x77, 0xae, 0xc8, 0x56, 0x50, 0xd0, 0xd6, 0xe7, 0xe1, 0x2b, 0xca, 0x27, 0x60,
+	0xd3, 0x43, 0x49, 0x32, 0x73, 0xe8, 0x14, 0x27, 0x4e, 0x84, 0x39, 0xf2, 0x11, 0x47, 0xed, 0xdb,
+	0x3d, 0xad, 0xbf, 0x36, 0xea, 0xe6, 0x99, 0xd1, 0x51, 0xc6, 0xad, 0x82, 0xa0, 0x7d, 0x57, 0x44,
+	0x3f, 0x9d, 0xe2, 0xe4, 0x13, 0x15, 0xdb, 0x87, 0xdf, 0x9d, 0x1b, 0x95, 0x5f, 0x7f, 0xde, 0xeb,
+	0xa8, 0x5e, 0x0c, 0xe8, 0xd4, 0x54, 0xad, 0x5b, 0x6c, 0x12, 0xc7, 0x31, 0x87, 0x3f, 0x56, 0x41,
+	0xf3, 0x48, 0xb6, 0xf1, 0xff, 0x36, 0xf7, 0x3e, 0xa8, 0x4d, 0x42, 0x14, 0x0b, 0x3f, 0x1b, 0xc3,
+	0x5d, 0x53, 0xfd, 0xb6, 0xbc, 0x25, 0xca, 0x5f, 0x8f, 0x43, 0x14, 0xab, 0x4e, 0x10, 0x78, 0xfd,
+	0x29, 0xd8, 0x52, 0x18, 0xdf, 0x59, 0xea, 0xdc, 0xda, 0x1b, 0xba, 0xa1, 0x97, 0x67, 0xc6, 0xae,
+	0xf4, 0xe3, 0xc6, 0xc5, 0xd0, 0xde, 0x2c, 0xe3, 0x0b, 0xf7, 0xc9, 0xfe, 0xbd, 0xc2, 0x93, 0x67,
+	0xe7, 0x46, 0xe5, 0xaf, 0x73, 0x43, 0xfb, 0x07, 0x6f, 0x9e, 0x6b, 0xa0, 0xae, 0x9a, 0xfc, 0x00,
+	0x34, 0x13, 0x3c, 0x25, 0x8c, 0xd0, 0xd8, 0x89, 0xd3, 0xc8, 0xc5, 0x89, 0x30, 0xa7, 0xb6, 0xd8,
+	0x94, 0xd7, 0x00, 0xd0, 0xde, 0x28, 0x23, 0x4f, 0x44, 0x60, 0x89, 0x44, 0x5d, 0x19, 0xd5, 0xd7,
+	0x92, 0x48, 0xc0, 0x02, 0x89, 0x54, 0xb2, 0xbf, 0x56, 0x16, 0x00, 0xff, 0xac, 0x82, 0xfa, 0x18,
+	0x25, 0x28, 0x62, 0x05, 0x33, 0x0a, 0x43, 0xfa, 0xcd, 0x95, 0x07, 0xac, 0xad, 0xf5, 0x6e, 0xf5,
+	0xd7, 0x17, 0x99, 0xaf, 0x01, 0xa0, 0xbd, 0xa1, 0x22, 0xd2, 0x1e, 0xa6, 0xff, 0xa0, 0x81, 0xdd,
+	0x08, 0x9d, 0x39, 0x3c, 0x49, 0x19, 0x27, 0x71, 0xe0, 0x4c, 0x70, 0x42, 0xa8, 0xef, 0x1c, 0x27,
+	0xc8, 0x9b, 0x6f, 0xf9, 0xe8, 0xa8, 0xd8, 0xb9, 0xdf, 0x33, 0xe3, 0xfd, 0x80, 0xf0, 0x93, 0xd4,
+	0x35, 0x3d, 0x1a, 0xa9, 0xcb, 0x5e, 0x7d, 0xf6, 0x98, 0x7f, 0x6a, 0xf1, 0xd9, 0x04, 0x33, 0xf3,
+	0x10, 0x7b, 0x79, 0x66, 0xbc, 0x2b, 0x05, 0xbc, 0x89, 0x1b, 0xda, 0x3b, 0x11, 0x3a, 0xfb, 0x42,
+	0x65, 0xc7, 0x22, 0xf9, 0xb1, 0xca, 0xe9, 0xdf, 0x82, 0xed, 0x62, 0xed, 0xbc, 0x00, 0xea, 0x9d,
+	0x3a, 0x7e, 0x42, 0x8e, 0x79, 0xbb, 0x2e, 0x4e, 0xc8, 0xce, 0xca, 0x09, 0x39, 0x54, 0xef, 0xcb,
+	0xe8, 0x9e, 0xba, 0x73, 0xba, 0x73, 0x0d, 0x37, 0xf0, 0xc0, 0x67, 0xaf, 0x0c, 0xcd, 0x6e, 0x45,
+	0xe8, 0xec, 0x61, 0xe9, 0x09, 0xf5, 0x4e, 0x0f, 0x8b, 0xd4, 0xc8, 0x7e, 0x71, 0xd1, 0xd5, 0x5e,
+	0x5e, 0x74, 0xb5, 0x3f, 0x2e, 0xba, 0xda, 0xf7, 0x97, 0xdd, 0xca, 0xcb, 0xcb, 0x6e, 0xe5, 0xb7,
+	0xcb, 0x6e, 0xe5, 0xeb, 0x07, 0xab, 0x0e, 0x10, 0xd7, 0xdb, 0x0b, 0xa8, 0x35, 0xbd, 0x6f, 0x45,
+	0xd4, 0x4f, 0x43, 0xcc, 0xe4, 0xe3, 0xfc, 0xe1, 0x70, 0x4f, 0xbd, 0xcf, 0xc2, 0x17, 0xb7, 0x2e,
+	0x84, 0x7e, 0xf4, 0xf7, 0x00, 0x40, 0x7a, 0x7a, 0x20, 0xbf, 0x07, 0x00, 0x00,
 }
 
 func (this *UpgradeProposal) Equal(that interface{}) bool {
@@ -631,6 +665,16 @@ func (m *ClientUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CarryOverMetadata {
+		i--
+		if m.CarryOverMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.SubstituteClientId) > 0 {
 		i -= len(m.SubstituteClientId)
 		copy(dAtA[i:], m.SubstituteClientId)
@@ -774,6 +818,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAllowedClockDrift, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAllowedClockDrift):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintClient(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxTrustingPeriodFraction.Size()
+		i -= size
+		if _, err := m.MaxTrustingPeriodFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.AllowedClients) > 0 {
 		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedClients[iNdEx])
@@ -870,6 +932,9 @@ func (m *ClientUpdateProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovClient(uint64(l))
 	}
+	if m.CarryOverMetadata {
+		n += 2
+	}
 	return n
 }
 
@@ -923,6 +988,10 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
+	l = m.MaxTrustingPeriodFraction.Size()
+	n += 1 + l + sovClient(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAllowedClockDrift)
+	n += 1 + l + sovClient(uint64(l))
 	return n
 }
 
@@ -1442,6 +1511,26 @@ func (m *ClientUpdateProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.SubstituteClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarryOverMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CarryOverMetadata = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
@@ -1795,6 +1884,73 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTrustingPeriodFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTrustingPeriodFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedClockDrift", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxAllowedClockDrift, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 )
 
@@ -57,6 +58,38 @@ func (suite *TypesTestSuite) TestMarshalConsensusStateWithHeight() {
 	}
 }
 
+func TestIdentifiedClientStatesSort(t *testing.T) {
+	clientStates := types.IdentifiedClientStates{
+		{ClientId: exported.LocalhostClientID},
+		{ClientId: "07-tendermint-10"},
+		{ClientId: "07-tendermint-2"},
+		{ClientId: "06-solomachine-11"},
+		{ClientId: "07-tendermint-11"},
+		{ClientId: "06-solomachine-9"},
+		{ClientId: "07-tendermint-0"},
+		{ClientId: "07-tendermint-1"},
+		{ClientId: "07-tendermint-9"},
+	}
+
+	var clientIDs []string
+	for _, clientState := range clientStates.Sort() {
+		clientIDs = append(clientIDs, clientState.ClientId)
+	}
+
+	expClientIDs := []string{
+		"06-solomachine-9",
+		"06-solomachine-11",
+		"07-tendermint-0",
+		"07-tendermint-1",
+		"07-tendermint-2",
+		"07-tendermint-9",
+		"07-tendermint-10",
+		"07-tendermint-11",
+		exported.LocalhostClientID,
+	}
+	require.Equal(t, expClientIDs, clientIDs)
+}
+
 func TestValidateClientType(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -34,4 +34,6 @@ var (
 	ErrInvalidSubstitute                      = sdkerrors.Register(SubModuleName, 27, "invalid client state substitute")
 	ErrInvalidUpgradeProposal                 = sdkerrors.Register(SubModuleName, 28, "invalid upgrade proposal")
 	ErrClientNotActive                        = sdkerrors.Register(SubModuleName, 29, "client state is not active")
+	ErrFailedMembershipVerification           = sdkerrors.Register(SubModuleName, 30, "membership verification failed")
+	ErrFailedNonMembershipVerification        = sdkerrors.Register(SubModuleName, 31, "non-membership verification failed")
 )
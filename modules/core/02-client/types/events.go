@@ -8,15 +8,19 @@ import (
 
 // IBC client events
 const (
-	AttributeKeyClientID          = "client_id"
-	AttributeKeySubjectClientID   = "subject_client_id"
-	AttributeKeyClientType        = "client_type"
-	AttributeKeyConsensusHeight   = "consensus_height"
-	AttributeKeyConsensusHeights  = "consensus_heights"
-	AttributeKeyHeader            = "header"
-	AttributeKeyUpgradeStore      = "upgrade_store"
-	AttributeKeyUpgradePlanHeight = "upgrade_plan_height"
-	AttributeKeyUpgradePlanTitle  = "title"
+	AttributeKeyClientID           = "client_id"
+	AttributeKeySubjectClientID    = "subject_client_id"
+	AttributeKeySubstituteClientID = "substitute_client_id"
+	AttributeKeyClientType         = "client_type"
+	AttributeKeyClientChainID      = "client_chain_id"
+	AttributeKeyLatestHeight       = "latest_height"
+	AttributeKeyConsensusHeight    = "consensus_height"
+	AttributeKeyConsensusHeights   = "consensus_heights"
+	AttributeKeyHeader             = "header"
+	AttributeKeyUpgradeStore       = "upgrade_store"
+	AttributeKeyUpgradePlanHeight  = "upgrade_plan_height"
+	AttributeKeyUpgradePlanTitle   = "title"
+	AttributeKeyTotalPruned        = "total_pruned"
 )
 
 // IBC client events vars
@@ -28,6 +32,7 @@ var (
 	EventTypeUpdateClientProposal  = "update_client_proposal"
 	EventTypeUpgradeChain          = "upgrade_chain"
 	EventTypeUpgradeClientProposal = "upgrade_client_proposal"
+	EventTypePruneConsensusStates  = "prune_expired_consensus_states"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
@@ -28,8 +28,10 @@ type ClientsConsensusStates []ClientConsensusStates
 // Len implements sort.Interface
 func (ccs ClientsConsensusStates) Len() int { return len(ccs) }
 
-// Less implements sort.Interface
-func (ccs ClientsConsensusStates) Less(i, j int) bool { return ccs[i].ClientId < ccs[j].ClientId }
+// Less implements sort.Interface. Client identifiers of the same client type are ordered by their sequence.
+func (ccs ClientsConsensusStates) Less(i, j int) bool {
+	return clientIdentifierLess(ccs[i].ClientId, ccs[j].ClientId)
+}
 
 // Swap implements sort.Interface
 func (ccs ClientsConsensusStates) Swap(i, j int) { ccs[i], ccs[j] = ccs[j], ccs[i] }
@@ -200,7 +202,8 @@ func (gs GenesisState) Validate() error {
 
 	}
 
-	if maxSequence != 0 && maxSequence >= gs.NextClientSequence {
+	// the next sequence must also be greater than zero if a client with sequence zero is provided
+	if len(gs.Clients) != 0 && maxSequence >= gs.NextClientSequence {
 		return fmt.Errorf("next client identifier sequence %d must be greater than the maximum sequence used in the provided client identifiers %d", gs.NextClientSequence, maxSequence)
 	}
 
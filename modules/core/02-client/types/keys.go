@@ -64,3 +64,23 @@ func ParseClientIdentifier(clientID string) (string, uint64, error) {
 
 	return clientType, sequence, nil
 }
+
+// clientIdentifierLess returns true if the client identifier a is ordered before b. Identifiers in
+// the format `{client-type}-{N}` are ordered by client type and then by their numeric sequence, such
+// that 07-tendermint-9 is ordered before 07-tendermint-10. They are ordered before any identifiers
+// which cannot be parsed, which are ordered lexicographically.
+func clientIdentifierLess(a, b string) bool {
+	clientTypeA, sequenceA, errA := ParseClientIdentifier(a)
+	clientTypeB, sequenceB, errB := ParseClientIdentifier(b)
+
+	switch {
+	case errA != nil && errB != nil:
+		return a < b
+	case errA != nil || errB != nil:
+		return errB != nil
+	case clientTypeA != clientTypeB:
+		return clientTypeA < clientTypeB
+	default:
+		return sequenceA < sequenceB
+	}
+}
@@ -14,6 +14,7 @@ var (
 	_ sdk.Msg = &MsgUpdateClient{}
 	_ sdk.Msg = &MsgSubmitMisbehaviour{}
 	_ sdk.Msg = &MsgUpgradeClient{}
+	_ sdk.Msg = &MsgPruneExpiredConsensusStates{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgCreateClient{}
 	_ codectypes.UnpackInterfacesMessage = MsgUpdateClient{}
@@ -263,3 +264,35 @@ func (msg MsgSubmitMisbehaviour) UnpackInterfaces(unpacker codectypes.AnyUnpacke
 	var misbehaviour exported.ClientMessage
 	return unpacker.UnpackAny(msg.Misbehaviour, &misbehaviour)
 }
+
+// NewMsgPruneExpiredConsensusStates creates a new MsgPruneExpiredConsensusStates instance
+//
+//nolint:interfacer
+func NewMsgPruneExpiredConsensusStates(authority, clientID string) *MsgPruneExpiredConsensusStates {
+	return &MsgPruneExpiredConsensusStates{
+		Authority: authority,
+		ClientId:  clientID,
+	}
+}
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgPruneExpiredConsensusStates.
+func (msg MsgPruneExpiredConsensusStates) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if _, _, err := ParseClientIdentifier(msg.ClientId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgPruneExpiredConsensusStates.
+func (msg MsgPruneExpiredConsensusStates) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
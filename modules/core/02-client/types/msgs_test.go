@@ -609,3 +609,26 @@ func (suite *TypesTestSuite) TestMsgSubmitMisbehaviour_ValidateBasic() {
 		}
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgPruneExpiredConsensusStates_ValidateBasic() {
+	authority := suite.chainA.App.GetIBCKeeper().GetAuthority()
+
+	cases := []struct {
+		name    string
+		msg     *types.MsgPruneExpiredConsensusStates
+		expPass bool
+	}{
+		{"success", types.NewMsgPruneExpiredConsensusStates(authority, ibctesting.FirstClientID), true},
+		{"invalid authority address", types.NewMsgPruneExpiredConsensusStates("", ibctesting.FirstClientID), false},
+		{"invalid client ID", types.NewMsgPruneExpiredConsensusStates(authority, ibctesting.InvalidID), false},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
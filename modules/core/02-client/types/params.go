@@ -3,18 +3,24 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
 var (
-	// DefaultAllowedClients are "06-solomachine" and "07-tendermint"
-	DefaultAllowedClients = []string{exported.Solomachine, exported.Tendermint}
+	// DefaultAllowedClients are "06-solomachine", "07-tendermint" and "09-localhost"
+	DefaultAllowedClients = []string{exported.Solomachine, exported.Tendermint, exported.Localhost}
 
 	// KeyAllowedClients is store's key for AllowedClients Params
 	KeyAllowedClients = []byte("AllowedClients")
+	// KeyMaxTrustingPeriodFraction is store's key for MaxTrustingPeriodFraction Params
+	KeyMaxTrustingPeriodFraction = []byte("MaxTrustingPeriodFraction")
+	// KeyMaxAllowedClockDrift is store's key for MaxAllowedClockDrift Params
+	KeyMaxAllowedClockDrift = []byte("MaxAllowedClockDrift")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -25,7 +31,8 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new parameter configuration for the ibc client module
 func NewParams(allowedClients ...string) Params {
 	return Params{
-		AllowedClients: allowedClients,
+		AllowedClients:            allowedClients,
+		MaxTrustingPeriodFraction: sdk.ZeroDec(),
 	}
 }
 
@@ -36,13 +43,23 @@ func DefaultParams() Params {
 
 // Validate all ibc-client module parameters
 func (p Params) Validate() error {
-	return validateClients(p.AllowedClients)
+	if err := validateClients(p.AllowedClients); err != nil {
+		return err
+	}
+
+	if err := validateMaxTrustingPeriodFraction(p.MaxTrustingPeriodFraction); err != nil {
+		return err
+	}
+
+	return validateMaxAllowedClockDrift(p.MaxAllowedClockDrift)
 }
 
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAllowedClients, p.AllowedClients, validateClients),
+		paramtypes.NewParamSetPair(KeyMaxTrustingPeriodFraction, &p.MaxTrustingPeriodFraction, validateMaxTrustingPeriodFraction),
+		paramtypes.NewParamSetPair(KeyMaxAllowedClockDrift, &p.MaxAllowedClockDrift, validateMaxAllowedClockDrift),
 	}
 }
 
@@ -70,3 +87,35 @@ func validateClients(i interface{}) error {
 
 	return nil
 }
+
+// validateMaxTrustingPeriodFraction validates the max trusting period fraction. A nil fraction, as
+// decoded from a genesis file omitting the parameter, is treated as the default of zero.
+func validateMaxTrustingPeriodFraction(i interface{}) error {
+	fraction, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fraction.IsNil() {
+		return nil
+	}
+
+	if fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("max trusting period fraction must be between 0 and 1 (got %s)", fraction)
+	}
+
+	return nil
+}
+
+func validateMaxAllowedClockDrift(i interface{}) error {
+	maxClockDrift, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if maxClockDrift < 0 {
+		return fmt.Errorf("max allowed clock drift cannot be negative (got %s)", maxClockDrift)
+	}
+
+	return nil
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
@@ -17,6 +19,13 @@ func TestValidateParams(t *testing.T) {
 		{"default params", DefaultParams(), true},
 		{"custom params", NewParams(exported.Tendermint), true},
 		{"blank client", NewParams(" "), false},
+		{"max trusting period fraction set", Params{AllowedClients: DefaultAllowedClients, MaxTrustingPeriodFraction: sdk.NewDecWithPrec(2, 1)}, true},
+		{"max trusting period fraction is one", Params{AllowedClients: DefaultAllowedClients, MaxTrustingPeriodFraction: sdk.OneDec()}, true},
+		{"max trusting period fraction is nil", Params{AllowedClients: DefaultAllowedClients}, true},
+		{"max trusting period fraction is negative", Params{AllowedClients: DefaultAllowedClients, MaxTrustingPeriodFraction: sdk.NewDec(-1)}, false},
+		{"max trusting period fraction is greater than one", Params{AllowedClients: DefaultAllowedClients, MaxTrustingPeriodFraction: sdk.NewDecWithPrec(11, 1)}, false},
+		{"max allowed clock drift set", Params{AllowedClients: DefaultAllowedClients, MaxTrustingPeriodFraction: sdk.ZeroDec(), MaxAllowedClockDrift: time.Minute}, true},
+		{"negative max allowed clock drift", Params{AllowedClients: DefaultAllowedClients, MaxTrustingPeriodFraction: sdk.ZeroDec(), MaxAllowedClockDrift: -time.Minute}, false},
 	}
 
 	for _, tc := range testCases {
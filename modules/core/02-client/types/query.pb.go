@@ -8,22 +8,27 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	types1 "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -194,7 +199,7 @@ func (m *QueryClientStatesRequest) GetPagination() *query.PageRequest {
 // QueryClientStatesResponse is the response type for the Query/ClientStates RPC
 // method.
 type QueryClientStatesResponse struct {
-	// list of stored ClientStates of the chain.
+	// list of stored ClientStates of the chain, ordered within the page.
 	ClientStates IdentifiedClientStates `protobuf:"bytes,1,rep,name=client_states,json=clientStates,proto3,castrepeated=IdentifiedClientStates" json:"client_states"`
 	// pagination response
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -447,7 +452,7 @@ func (m *QueryConsensusStatesRequest) GetPagination() *query.PageRequest {
 // QueryConsensusStatesResponse is the response type for the
 // Query/ConsensusStates RPC method
 type QueryConsensusStatesResponse struct {
-	// consensus states associated with the identifier
+	// consensus states associated with the identifier, ordered by height within the page.
 	ConsensusStates []ConsensusStateWithHeight `protobuf:"bytes,1,rep,name=consensus_states,json=consensusStates,proto3" json:"consensus_states"`
 	// pagination response
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -559,7 +564,7 @@ func (m *QueryConsensusStateHeightsRequest) GetPagination() *query.PageRequest {
 // QueryConsensusStateHeightsResponse is the response type for the
 // Query/ConsensusStateHeights RPC method
 type QueryConsensusStateHeightsResponse struct {
-	// consensus state heights
+	// consensus state heights in ascending order, without the consensus states or their metadata
 	ConsensusStateHeights []Height `protobuf:"bytes,1,rep,name=consensus_state_heights,json=consensusStateHeights,proto3" json:"consensus_state_heights"`
 	// pagination response
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -705,6 +710,373 @@ func (m *QueryClientStatusResponse) GetStatus() string {
 	return ""
 }
 
+// QueryClientStatusDetailedRequest is the request type for the Query/ClientStatusDetailed RPC
+// method
+type QueryClientStatusDetailedRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryClientStatusDetailedRequest) Reset()         { *m = QueryClientStatusDetailedRequest{} }
+func (m *QueryClientStatusDetailedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientStatusDetailedRequest) ProtoMessage()    {}
+func (*QueryClientStatusDetailedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{12}
+}
+func (m *QueryClientStatusDetailedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientStatusDetailedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientStatusDetailedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientStatusDetailedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientStatusDetailedRequest.Merge(m, src)
+}
+func (m *QueryClientStatusDetailedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientStatusDetailedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientStatusDetailedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientStatusDetailedRequest proto.InternalMessageInfo
+
+func (m *QueryClientStatusDetailedRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryClientStatusDetailedResponse is the response type for the Query/ClientStatusDetailed RPC
+// method. It returns the current status of the IBC client and the details explaining the status.
+type QueryClientStatusDetailedResponse struct {
+	// status of the client
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// type of the client
+	ClientType string `protobuf:"bytes,2,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+	// timestamp in nanoseconds of the consensus state at the latest height of the client,
+	// only set if the client is Expired
+	LatestConsensusTimestamp uint64 `protobuf:"varint,3,opt,name=latest_consensus_timestamp,json=latestConsensusTimestamp,proto3" json:"latest_consensus_timestamp,omitempty"`
+	// trusting period of the client, only set if the client is Expired and has a trusting period
+	TrustingPeriod time.Duration `protobuf:"bytes,4,opt,name=trusting_period,json=trustingPeriod,proto3,stdduration" json:"trusting_period"`
+	// height at which the client was frozen, only set if the client is Frozen and has a frozen height
+	FrozenHeight Height `protobuf:"bytes,5,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height"`
+}
+
+func (m *QueryClientStatusDetailedResponse) Reset()         { *m = QueryClientStatusDetailedResponse{} }
+func (m *QueryClientStatusDetailedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientStatusDetailedResponse) ProtoMessage()    {}
+func (*QueryClientStatusDetailedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{13}
+}
+func (m *QueryClientStatusDetailedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientStatusDetailedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientStatusDetailedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientStatusDetailedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientStatusDetailedResponse.Merge(m, src)
+}
+func (m *QueryClientStatusDetailedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientStatusDetailedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientStatusDetailedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientStatusDetailedResponse proto.InternalMessageInfo
+
+func (m *QueryClientStatusDetailedResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QueryClientStatusDetailedResponse) GetClientType() string {
+	if m != nil {
+		return m.ClientType
+	}
+	return ""
+}
+
+func (m *QueryClientStatusDetailedResponse) GetLatestConsensusTimestamp() uint64 {
+	if m != nil {
+		return m.LatestConsensusTimestamp
+	}
+	return 0
+}
+
+func (m *QueryClientStatusDetailedResponse) GetTrustingPeriod() time.Duration {
+	if m != nil {
+		return m.TrustingPeriod
+	}
+	return 0
+}
+
+func (m *QueryClientStatusDetailedResponse) GetFrozenHeight() Height {
+	if m != nil {
+		return m.FrozenHeight
+	}
+	return Height{}
+}
+
+// QueryConsensusStateProcessedTimeRequest is the request type for the
+// Query/ConsensusStateProcessedTime RPC method
+type QueryConsensusStateProcessedTimeRequest struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// consensus state revision number
+	RevisionNumber uint64 `protobuf:"varint,2,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	// consensus state revision height
+	RevisionHeight uint64 `protobuf:"varint,3,opt,name=revision_height,json=revisionHeight,proto3" json:"revision_height,omitempty"`
+}
+
+func (m *QueryConsensusStateProcessedTimeRequest) Reset() {
+	*m = QueryConsensusStateProcessedTimeRequest{}
+}
+func (m *QueryConsensusStateProcessedTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusStateProcessedTimeRequest) ProtoMessage()    {}
+func (*QueryConsensusStateProcessedTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{14}
+}
+func (m *QueryConsensusStateProcessedTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStateProcessedTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStateProcessedTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusStateProcessedTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStateProcessedTimeRequest.Merge(m, src)
+}
+func (m *QueryConsensusStateProcessedTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStateProcessedTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStateProcessedTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStateProcessedTimeRequest proto.InternalMessageInfo
+
+func (m *QueryConsensusStateProcessedTimeRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryConsensusStateProcessedTimeRequest) GetRevisionNumber() uint64 {
+	if m != nil {
+		return m.RevisionNumber
+	}
+	return 0
+}
+
+func (m *QueryConsensusStateProcessedTimeRequest) GetRevisionHeight() uint64 {
+	if m != nil {
+		return m.RevisionHeight
+	}
+	return 0
+}
+
+// QueryConsensusStateProcessedTimeResponse is the response type for the
+// Query/ConsensusStateProcessedTime RPC method
+type QueryConsensusStateProcessedTimeResponse struct {
+	// time in nanoseconds at which the consensus state was processed
+	ProcessedTime uint64 `protobuf:"varint,1,opt,name=processed_time,json=processedTime,proto3" json:"processed_time,omitempty"`
+}
+
+func (m *QueryConsensusStateProcessedTimeResponse) Reset() {
+	*m = QueryConsensusStateProcessedTimeResponse{}
+}
+func (m *QueryConsensusStateProcessedTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusStateProcessedTimeResponse) ProtoMessage()    {}
+func (*QueryConsensusStateProcessedTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{15}
+}
+func (m *QueryConsensusStateProcessedTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStateProcessedTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStateProcessedTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusStateProcessedTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStateProcessedTimeResponse.Merge(m, src)
+}
+func (m *QueryConsensusStateProcessedTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStateProcessedTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStateProcessedTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStateProcessedTimeResponse proto.InternalMessageInfo
+
+func (m *QueryConsensusStateProcessedTimeResponse) GetProcessedTime() uint64 {
+	if m != nil {
+		return m.ProcessedTime
+	}
+	return 0
+}
+
+// QueryConsensusStateProcessedHeightRequest is the request type for the
+// Query/ConsensusStateProcessedHeight RPC method
+type QueryConsensusStateProcessedHeightRequest struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// consensus state revision number
+	RevisionNumber uint64 `protobuf:"varint,2,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	// consensus state revision height
+	RevisionHeight uint64 `protobuf:"varint,3,opt,name=revision_height,json=revisionHeight,proto3" json:"revision_height,omitempty"`
+}
+
+func (m *QueryConsensusStateProcessedHeightRequest) Reset() {
+	*m = QueryConsensusStateProcessedHeightRequest{}
+}
+func (m *QueryConsensusStateProcessedHeightRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsensusStateProcessedHeightRequest) ProtoMessage() {}
+func (*QueryConsensusStateProcessedHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{16}
+}
+func (m *QueryConsensusStateProcessedHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStateProcessedHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStateProcessedHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusStateProcessedHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStateProcessedHeightRequest.Merge(m, src)
+}
+func (m *QueryConsensusStateProcessedHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStateProcessedHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStateProcessedHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStateProcessedHeightRequest proto.InternalMessageInfo
+
+func (m *QueryConsensusStateProcessedHeightRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryConsensusStateProcessedHeightRequest) GetRevisionNumber() uint64 {
+	if m != nil {
+		return m.RevisionNumber
+	}
+	return 0
+}
+
+func (m *QueryConsensusStateProcessedHeightRequest) GetRevisionHeight() uint64 {
+	if m != nil {
+		return m.RevisionHeight
+	}
+	return 0
+}
+
+// QueryConsensusStateProcessedHeightResponse is the response type for the
+// Query/ConsensusStateProcessedHeight RPC method
+type QueryConsensusStateProcessedHeightResponse struct {
+	// height of this chain at which the consensus state was processed
+	ProcessedHeight Height `protobuf:"bytes,1,opt,name=processed_height,json=processedHeight,proto3" json:"processed_height"`
+}
+
+func (m *QueryConsensusStateProcessedHeightResponse) Reset() {
+	*m = QueryConsensusStateProcessedHeightResponse{}
+}
+func (m *QueryConsensusStateProcessedHeightResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsensusStateProcessedHeightResponse) ProtoMessage() {}
+func (*QueryConsensusStateProcessedHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{17}
+}
+func (m *QueryConsensusStateProcessedHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStateProcessedHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStateProcessedHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusStateProcessedHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStateProcessedHeightResponse.Merge(m, src)
+}
+func (m *QueryConsensusStateProcessedHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStateProcessedHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStateProcessedHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStateProcessedHeightResponse proto.InternalMessageInfo
+
+func (m *QueryConsensusStateProcessedHeightResponse) GetProcessedHeight() Height {
+	if m != nil {
+		return m.ProcessedHeight
+	}
+	return Height{}
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC
 // method.
 type QueryParamsRequest struct {
@@ -714,7 +1086,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{12}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{18}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +1126,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{13}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{19}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +1171,7 @@ func (m *QueryUpgradedClientStateRequest) Reset()         { *m = QueryUpgradedCl
 func (m *QueryUpgradedClientStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedClientStateRequest) ProtoMessage()    {}
 func (*QueryUpgradedClientStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{14}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{20}
 }
 func (m *QueryUpgradedClientStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -839,7 +1211,7 @@ func (m *QueryUpgradedClientStateResponse) Reset()         { *m = QueryUpgradedC
 func (m *QueryUpgradedClientStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedClientStateResponse) ProtoMessage()    {}
 func (*QueryUpgradedClientStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{15}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{21}
 }
 func (m *QueryUpgradedClientStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +1256,7 @@ func (m *QueryUpgradedConsensusStateRequest) Reset()         { *m = QueryUpgrade
 func (m *QueryUpgradedConsensusStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedConsensusStateRequest) ProtoMessage()    {}
 func (*QueryUpgradedConsensusStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{16}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{22}
 }
 func (m *QueryUpgradedConsensusStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1296,7 @@ func (m *QueryUpgradedConsensusStateResponse) Reset()         { *m = QueryUpgrad
 func (m *QueryUpgradedConsensusStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedConsensusStateResponse) ProtoMessage()    {}
 func (*QueryUpgradedConsensusStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc42cdfd1d52d76e, []int{17}
+	return fileDescriptor_dc42cdfd1d52d76e, []int{23}
 }
 func (m *QueryUpgradedConsensusStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -960,97 +1332,204 @@ func (m *QueryUpgradedConsensusStateResponse) GetUpgradedConsensusState() *types
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryClientStateRequest)(nil), "ibc.core.client.v1.QueryClientStateRequest")
-	proto.RegisterType((*QueryClientStateResponse)(nil), "ibc.core.client.v1.QueryClientStateResponse")
-	proto.RegisterType((*QueryClientStatesRequest)(nil), "ibc.core.client.v1.QueryClientStatesRequest")
-	proto.RegisterType((*QueryClientStatesResponse)(nil), "ibc.core.client.v1.QueryClientStatesResponse")
-	proto.RegisterType((*QueryConsensusStateRequest)(nil), "ibc.core.client.v1.QueryConsensusStateRequest")
-	proto.RegisterType((*QueryConsensusStateResponse)(nil), "ibc.core.client.v1.QueryConsensusStateResponse")
-	proto.RegisterType((*QueryConsensusStatesRequest)(nil), "ibc.core.client.v1.QueryConsensusStatesRequest")
-	proto.RegisterType((*QueryConsensusStatesResponse)(nil), "ibc.core.client.v1.QueryConsensusStatesResponse")
-	proto.RegisterType((*QueryConsensusStateHeightsRequest)(nil), "ibc.core.client.v1.QueryConsensusStateHeightsRequest")
-	proto.RegisterType((*QueryConsensusStateHeightsResponse)(nil), "ibc.core.client.v1.QueryConsensusStateHeightsResponse")
-	proto.RegisterType((*QueryClientStatusRequest)(nil), "ibc.core.client.v1.QueryClientStatusRequest")
-	proto.RegisterType((*QueryClientStatusResponse)(nil), "ibc.core.client.v1.QueryClientStatusResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.core.client.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.core.client.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryUpgradedClientStateRequest)(nil), "ibc.core.client.v1.QueryUpgradedClientStateRequest")
-	proto.RegisterType((*QueryUpgradedClientStateResponse)(nil), "ibc.core.client.v1.QueryUpgradedClientStateResponse")
-	proto.RegisterType((*QueryUpgradedConsensusStateRequest)(nil), "ibc.core.client.v1.QueryUpgradedConsensusStateRequest")
-	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "ibc.core.client.v1.QueryUpgradedConsensusStateResponse")
+// QueryIBCProofResponse is the response type for the IBCProof query, which returns the merkle proof
+// of an IBC store path. The query is performed with an ABCI store query rather than over gRPC, since
+// gRPC queries have no access to the merkle proofs of the store.
+type QueryIBCProofResponse struct {
+	// merkle path of the store path, prefixed with the IBC store key, as verified by the counterparty
+	// light client
+	MerklePath types1.MerklePath `protobuf:"bytes,1,opt,name=merkle_path,json=merklePath,proto3" json:"merkle_path"`
+	// value stored under the store path, empty if the proof is a proof of non-existence
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// proto encoded merkle proof of the store path, as included in the proofs of IBC messages
+	Proof []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight Height `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryIBCProofResponse) Reset()         { *m = QueryIBCProofResponse{} }
+func (m *QueryIBCProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCProofResponse) ProtoMessage()    {}
+func (*QueryIBCProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{24}
+}
+func (m *QueryIBCProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCProofResponse.Merge(m, src)
+}
+func (m *QueryIBCProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCProofResponse proto.InternalMessageInfo
+
+func (m *QueryIBCProofResponse) GetMerklePath() types1.MerklePath {
+	if m != nil {
+		return m.MerklePath
+	}
+	return types1.MerklePath{}
+}
+
+func (m *QueryIBCProofResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *QueryIBCProofResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryIBCProofResponse) GetProofHeight() Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return Height{}
+}
+
+func init() {
+	proto.RegisterType((*QueryClientStateRequest)(nil), "ibc.core.client.v1.QueryClientStateRequest")
+	proto.RegisterType((*QueryClientStateResponse)(nil), "ibc.core.client.v1.QueryClientStateResponse")
+	proto.RegisterType((*QueryClientStatesRequest)(nil), "ibc.core.client.v1.QueryClientStatesRequest")
+	proto.RegisterType((*QueryClientStatesResponse)(nil), "ibc.core.client.v1.QueryClientStatesResponse")
+	proto.RegisterType((*QueryConsensusStateRequest)(nil), "ibc.core.client.v1.QueryConsensusStateRequest")
+	proto.RegisterType((*QueryConsensusStateResponse)(nil), "ibc.core.client.v1.QueryConsensusStateResponse")
+	proto.RegisterType((*QueryConsensusStatesRequest)(nil), "ibc.core.client.v1.QueryConsensusStatesRequest")
+	proto.RegisterType((*QueryConsensusStatesResponse)(nil), "ibc.core.client.v1.QueryConsensusStatesResponse")
+	proto.RegisterType((*QueryConsensusStateHeightsRequest)(nil), "ibc.core.client.v1.QueryConsensusStateHeightsRequest")
+	proto.RegisterType((*QueryConsensusStateHeightsResponse)(nil), "ibc.core.client.v1.QueryConsensusStateHeightsResponse")
+	proto.RegisterType((*QueryClientStatusRequest)(nil), "ibc.core.client.v1.QueryClientStatusRequest")
+	proto.RegisterType((*QueryClientStatusResponse)(nil), "ibc.core.client.v1.QueryClientStatusResponse")
+	proto.RegisterType((*QueryClientStatusDetailedRequest)(nil), "ibc.core.client.v1.QueryClientStatusDetailedRequest")
+	proto.RegisterType((*QueryClientStatusDetailedResponse)(nil), "ibc.core.client.v1.QueryClientStatusDetailedResponse")
+	proto.RegisterType((*QueryConsensusStateProcessedTimeRequest)(nil), "ibc.core.client.v1.QueryConsensusStateProcessedTimeRequest")
+	proto.RegisterType((*QueryConsensusStateProcessedTimeResponse)(nil), "ibc.core.client.v1.QueryConsensusStateProcessedTimeResponse")
+	proto.RegisterType((*QueryConsensusStateProcessedHeightRequest)(nil), "ibc.core.client.v1.QueryConsensusStateProcessedHeightRequest")
+	proto.RegisterType((*QueryConsensusStateProcessedHeightResponse)(nil), "ibc.core.client.v1.QueryConsensusStateProcessedHeightResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.core.client.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.core.client.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryUpgradedClientStateRequest)(nil), "ibc.core.client.v1.QueryUpgradedClientStateRequest")
+	proto.RegisterType((*QueryUpgradedClientStateResponse)(nil), "ibc.core.client.v1.QueryUpgradedClientStateResponse")
+	proto.RegisterType((*QueryUpgradedConsensusStateRequest)(nil), "ibc.core.client.v1.QueryUpgradedConsensusStateRequest")
+	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "ibc.core.client.v1.QueryUpgradedConsensusStateResponse")
+	proto.RegisterType((*QueryIBCProofResponse)(nil), "ibc.core.client.v1.QueryIBCProofResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/query.proto", fileDescriptor_dc42cdfd1d52d76e) }
 
 var fileDescriptor_dc42cdfd1d52d76e = []byte{
-	// 1053 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xa4, 0x69, 0xd4, 0x3e, 0xbb, 0x09, 0x9a, 0x7c, 0xd4, 0xdd, 0x46, 0x8e, 0xb3, 0x41,
-	0x4d, 0x5a, 0x92, 0x9d, 0xc4, 0xa1, 0x49, 0x84, 0x84, 0x04, 0xa9, 0x54, 0x9a, 0x4b, 0x55, 0x16,
-	0x21, 0x10, 0x12, 0x8a, 0x76, 0xd7, 0x93, 0xcd, 0x4a, 0xf6, 0x8e, 0xeb, 0xd9, 0xb5, 0x14, 0x55,
-	0xb9, 0xf4, 0xc4, 0x11, 0x09, 0x89, 0x2b, 0x12, 0x47, 0x0e, 0x15, 0x07, 0x24, 0x6e, 0x88, 0x13,
-	0xe4, 0x58, 0x09, 0x0e, 0x9c, 0x28, 0x4a, 0xf8, 0x43, 0x90, 0x67, 0x66, 0xe3, 0x5d, 0x7b, 0x5c,
-	0xaf, 0x51, 0xe0, 0xb6, 0xfb, 0x3e, 0x7f, 0xef, 0xf7, 0x9e, 0xdf, 0x5b, 0x43, 0x39, 0x70, 0x3d,
-	0xe2, 0xb1, 0x16, 0x25, 0x5e, 0x3d, 0xa0, 0x61, 0x44, 0xda, 0x9b, 0xe4, 0x69, 0x4c, 0x5b, 0xc7,
-	0x56, 0xb3, 0xc5, 0x22, 0x86, 0x71, 0xe0, 0x7a, 0x56, 0x47, 0x6f, 0x49, 0xbd, 0xd5, 0xde, 0x34,
-	0xee, 0x79, 0x8c, 0x37, 0x18, 0x27, 0xae, 0xc3, 0xa9, 0x34, 0x26, 0xed, 0x4d, 0x97, 0x46, 0xce,
-	0x26, 0x69, 0x3a, 0x7e, 0x10, 0x3a, 0x51, 0xc0, 0x42, 0xe9, 0x6f, 0x2c, 0x6a, 0xe2, 0xab, 0x48,
-	0xd2, 0xe0, 0x96, 0xcf, 0x98, 0x5f, 0xa7, 0x44, 0xbc, 0xb9, 0xf1, 0x21, 0x71, 0x42, 0x95, 0xdb,
-	0x58, 0x50, 0x2a, 0xa7, 0x19, 0x10, 0x27, 0x0c, 0x59, 0x24, 0x02, 0x73, 0xa5, 0x9d, 0xf5, 0x99,
-	0xcf, 0xc4, 0x23, 0xe9, 0x3c, 0x49, 0xa9, 0xb9, 0x0d, 0x37, 0x3f, 0xec, 0x20, 0x7a, 0x20, 0x72,
-	0x7c, 0x14, 0x39, 0x11, 0xb5, 0xe9, 0xd3, 0x98, 0xf2, 0x08, 0xdf, 0x86, 0xeb, 0x32, 0xf3, 0x41,
-	0x50, 0x2b, 0xa1, 0x0a, 0x5a, 0xbd, 0x6e, 0x5f, 0x93, 0x82, 0xfd, 0x9a, 0xf9, 0x02, 0x41, 0xa9,
-	0xdf, 0x91, 0x37, 0x59, 0xc8, 0x29, 0xde, 0x81, 0xa2, 0xf2, 0xe4, 0x1d, 0xb9, 0x70, 0x2e, 0x54,
-	0x67, 0x2d, 0x89, 0xcf, 0x4a, 0xa0, 0x5b, 0xef, 0x87, 0xc7, 0x76, 0xc1, 0xeb, 0x06, 0xc0, 0xb3,
-	0x70, 0xb5, 0xd9, 0x62, 0xec, 0xb0, 0x34, 0x5e, 0x41, 0xab, 0x45, 0x5b, 0xbe, 0xe0, 0x07, 0x50,
-	0x14, 0x0f, 0x07, 0x47, 0x34, 0xf0, 0x8f, 0xa2, 0xd2, 0x15, 0x11, 0xce, 0xb0, 0xfa, 0xa9, 0xb6,
-	0x1e, 0x09, 0x8b, 0xbd, 0x89, 0xd3, 0x3f, 0x17, 0xc7, 0xec, 0x82, 0xf0, 0x92, 0x22, 0xd3, 0xed,
-	0xc7, 0xcb, 0x93, 0x4a, 0x1f, 0x02, 0x74, 0x1b, 0xa1, 0xd0, 0xde, 0xb1, 0x64, 0xd7, 0xac, 0x4e,
-	0xd7, 0x2c, 0xd9, 0x62, 0xd5, 0x35, 0xeb, 0x89, 0xe3, 0x27, 0x2c, 0xd9, 0x29, 0x4f, 0xf3, 0x77,
-	0x04, 0xb7, 0x34, 0x49, 0x14, 0x2b, 0x21, 0xdc, 0x48, 0xb3, 0xc2, 0x4b, 0xa8, 0x72, 0x65, 0xb5,
-	0x50, 0xbd, 0xab, 0xab, 0x63, 0xbf, 0x46, 0xc3, 0x28, 0x38, 0x0c, 0x68, 0x2d, 0x15, 0x6a, 0xaf,
-	0xdc, 0x29, 0xeb, 0xbb, 0x57, 0x8b, 0xf3, 0x5a, 0x35, 0xb7, 0x8b, 0x29, 0x2e, 0x39, 0xfe, 0x20,
-	0x53, 0xd5, 0xb8, 0xa8, 0x6a, 0x65, 0x68, 0x55, 0x12, 0x6c, 0xa6, 0xac, 0xef, 0x11, 0x18, 0xb2,
-	0xac, 0x8e, 0x2a, 0xe4, 0x31, 0xcf, 0x3d, 0x27, 0x78, 0x05, 0xa6, 0x5b, 0xb4, 0x1d, 0xf0, 0x80,
-	0x85, 0x07, 0x61, 0xdc, 0x70, 0x69, 0x4b, 0x20, 0x99, 0xb0, 0xa7, 0x12, 0xf1, 0x63, 0x21, 0xcd,
-	0x18, 0xa6, 0xfa, 0x9c, 0x32, 0x94, 0x8d, 0xc4, 0xcb, 0x70, 0xa3, 0xde, 0xa9, 0x2f, 0x4a, 0xcc,
-	0x26, 0x2a, 0x68, 0xf5, 0x9a, 0x5d, 0x94, 0x42, 0xd5, 0xed, 0x1f, 0x11, 0xdc, 0xd6, 0x42, 0x56,
-	0xbd, 0x78, 0x17, 0xa6, 0xbd, 0x44, 0x93, 0x63, 0x48, 0xa7, 0xbc, 0x4c, 0x98, 0xff, 0x72, 0x4e,
-	0x9f, 0xeb, 0x91, 0xf3, 0x5c, 0x6c, 0x3f, 0xd4, 0xb4, 0xfc, 0xdf, 0x0c, 0xf2, 0x2f, 0x08, 0x16,
-	0xf4, 0x20, 0x14, 0x7f, 0x9f, 0xc3, 0x1b, 0x3d, 0xfc, 0x25, 0xe3, 0xbc, 0xa6, 0x2b, 0x37, 0x1b,
-	0xe6, 0x93, 0x20, 0x3a, 0xca, 0x10, 0x30, 0x9d, 0xa5, 0xf7, 0x12, 0x47, 0xf7, 0x0b, 0x04, 0x4b,
-	0x9a, 0x42, 0x64, 0xf6, 0xff, 0x97, 0xd3, 0x5f, 0x11, 0x98, 0xaf, 0x83, 0xa2, 0x98, 0xfd, 0x14,
-	0x6e, 0xf6, 0x30, 0xab, 0xc6, 0x29, 0x21, 0x78, 0xf8, 0x3c, 0xcd, 0x79, 0xba, 0x0c, 0x97, 0x47,
-	0xea, 0x4e, 0xdf, 0x2a, 0x8d, 0x73, 0x51, 0x69, 0x6e, 0xf5, 0xad, 0xc7, 0xb8, 0x5b, 0xf8, 0x3c,
-	0x4c, 0x72, 0x21, 0x51, 0x6e, 0xea, 0xcd, 0x9c, 0x05, 0x2c, 0x9c, 0x9e, 0x38, 0x2d, 0xa7, 0x91,
-	0xe4, 0x31, 0xf7, 0x61, 0x26, 0x23, 0x55, 0x41, 0xaa, 0x30, 0xd9, 0x14, 0x12, 0xf5, 0x73, 0xd6,
-	0x92, 0xa5, 0x7c, 0x94, 0xa5, 0xb9, 0x04, 0x8b, 0x22, 0xd4, 0xc7, 0x4d, 0xbf, 0xe5, 0xd4, 0x32,
-	0x2b, 0x35, 0xc9, 0x56, 0x87, 0xca, 0x60, 0x13, 0x95, 0xfa, 0x11, 0xcc, 0xc5, 0x4a, 0x7d, 0x90,
-	0xfb, 0xfa, 0xcd, 0xc4, 0xfd, 0x11, 0xcd, 0x37, 0xd5, 0xa0, 0x5c, 0x64, 0xd3, 0xad, 0x5d, 0x33,
-	0x86, 0xe5, 0xd7, 0x5a, 0x29, 0x58, 0x8f, 0xa1, 0xd4, 0x85, 0x35, 0xc2, 0xca, 0x9b, 0x8f, 0xb5,
-	0x71, 0xab, 0x3f, 0x15, 0xe1, 0xaa, 0xc8, 0x8b, 0xbf, 0x41, 0x50, 0x48, 0xc1, 0xc6, 0x6f, 0xe9,
-	0xb8, 0x1e, 0xf0, 0x71, 0x61, 0xac, 0xe5, 0x33, 0x96, 0x45, 0x98, 0xf7, 0x9f, 0xff, 0xf6, 0xf7,
-	0x57, 0xe3, 0x04, 0xaf, 0x93, 0x81, 0x9f, 0x47, 0x6a, 0x0b, 0x91, 0x67, 0x17, 0xe3, 0x77, 0x82,
-	0xbf, 0x46, 0x50, 0x4c, 0x1f, 0x48, 0x9c, 0x2b, 0x6b, 0x32, 0x63, 0xc6, 0x7a, 0x4e, 0x6b, 0x05,
-	0xf2, 0xae, 0x00, 0xb9, 0x8c, 0x97, 0x86, 0x82, 0xc4, 0xaf, 0x10, 0x4c, 0x65, 0x79, 0xc5, 0xd6,
-	0xe0, 0x64, 0xba, 0xf6, 0x1b, 0x24, 0xb7, 0xbd, 0x82, 0x57, 0x17, 0xf0, 0x0e, 0x71, 0x4d, 0x0b,
-	0xaf, 0x67, 0x99, 0xa7, 0x69, 0x24, 0xc9, 0x01, 0x26, 0xcf, 0x7a, 0x4e, 0xf9, 0x09, 0x91, 0xab,
-	0x29, 0xa5, 0x90, 0x82, 0x13, 0xfc, 0x02, 0xc1, 0x74, 0xcf, 0xf1, 0xc0, 0x79, 0x21, 0x5f, 0x34,
-	0x60, 0x23, 0xbf, 0x83, 0x2a, 0x72, 0x57, 0x14, 0x59, 0xc5, 0x1b, 0xa3, 0x16, 0x89, 0x4f, 0x11,
-	0xcc, 0x69, 0x37, 0x33, 0xbe, 0x9f, 0x13, 0x45, 0xf6, 0xa8, 0x18, 0xdb, 0xa3, 0xba, 0xa9, 0x12,
-	0xde, 0x13, 0x25, 0xbc, 0x83, 0x77, 0x47, 0xee, 0x93, 0xba, 0x13, 0xf8, 0xdb, 0xcc, 0xd8, 0xc7,
-	0xf9, 0xc6, 0x3e, 0x1e, 0x69, 0xec, 0xbb, 0x7b, 0x3b, 0xf7, 0x6f, 0x33, 0xce, 0xf2, 0x7d, 0x02,
-	0x93, 0x72, 0x0f, 0xe3, 0x3b, 0x03, 0xf3, 0x65, 0x56, 0xbe, 0xb1, 0x32, 0xd4, 0x4e, 0x21, 0x32,
-	0x05, 0xa2, 0x05, 0x6c, 0xe8, 0x10, 0xc9, 0xa5, 0x8f, 0x7f, 0x40, 0x30, 0xa3, 0xd9, 0xe6, 0x78,
-	0x6b, 0x60, 0x92, 0xc1, 0xe7, 0xc1, 0x78, 0x7b, 0x34, 0x27, 0x05, 0xb3, 0x2a, 0x60, 0xae, 0xe1,
-	0x7b, 0x3a, 0x98, 0xda, 0x53, 0xc2, 0xf1, 0xcf, 0x08, 0xe6, 0xf5, 0x0b, 0x1f, 0x6f, 0x0f, 0x07,
-	0xa1, 0x5d, 0x24, 0x3b, 0x23, 0xfb, 0xe5, 0x69, 0xfc, 0xa0, 0x9b, 0xc3, 0xf7, 0xec, 0xd3, 0xb3,
-	0x32, 0x7a, 0x79, 0x56, 0x46, 0x7f, 0x9d, 0x95, 0xd1, 0x97, 0xe7, 0xe5, 0xb1, 0x97, 0xe7, 0xe5,
-	0xb1, 0x3f, 0xce, 0xcb, 0x63, 0x9f, 0xed, 0xfa, 0x41, 0x74, 0x14, 0xbb, 0x96, 0xc7, 0x1a, 0x44,
-	0xfd, 0x65, 0x0e, 0x5c, 0x6f, 0xdd, 0x67, 0xa4, 0xbd, 0x4d, 0x1a, 0xac, 0x16, 0xd7, 0x29, 0x97,
-	0x79, 0x36, 0xaa, 0xeb, 0x2a, 0x55, 0x74, 0xdc, 0xa4, 0xdc, 0x9d, 0x14, 0xa7, 0x6b, 0xeb, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x84, 0x2a, 0x94, 0x6e, 0x9e, 0x0f, 0x00, 0x00,
+	// 1439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xa4, 0x69, 0xd4, 0x3e, 0x3b, 0x49, 0x35, 0x4d, 0x52, 0x67, 0xdb, 0xaf, 0x93, 0x6e,
+	0xbf, 0x34, 0x69, 0x69, 0x76, 0x1b, 0xb7, 0x4d, 0x23, 0x68, 0x0b, 0x24, 0xa5, 0x34, 0x02, 0xaa,
+	0x74, 0x29, 0x02, 0x21, 0x21, 0x6b, 0x6d, 0x4f, 0x9c, 0x15, 0xf6, 0xee, 0x76, 0x67, 0xd7, 0x28,
+	0x54, 0xb9, 0xf4, 0xc4, 0x11, 0x09, 0x81, 0x2a, 0x24, 0x84, 0xc4, 0x91, 0x43, 0xe1, 0x80, 0xc4,
+	0x85, 0x03, 0x12, 0x12, 0xf4, 0x58, 0xa9, 0x1c, 0x38, 0x51, 0xd4, 0xf2, 0x87, 0xa0, 0x9d, 0x99,
+	0xb5, 0x77, 0xed, 0xb1, 0xbd, 0x8e, 0x4a, 0x7a, 0xcb, 0xbe, 0x79, 0x3f, 0x3e, 0x9f, 0xf7, 0xde,
+	0x8e, 0x3f, 0x1b, 0xc8, 0x5b, 0xa5, 0xb2, 0x5e, 0x76, 0x3c, 0xa2, 0x97, 0x6b, 0x16, 0xb1, 0x7d,
+	0xbd, 0xb1, 0xa4, 0xdf, 0x0e, 0x88, 0xb7, 0xad, 0xb9, 0x9e, 0xe3, 0x3b, 0x18, 0x5b, 0xa5, 0xb2,
+	0x16, 0x9e, 0x6b, 0xfc, 0x5c, 0x6b, 0x2c, 0x29, 0xa7, 0xcb, 0x0e, 0xad, 0x3b, 0x54, 0x2f, 0x99,
+	0x94, 0x70, 0x67, 0xbd, 0xb1, 0x54, 0x22, 0xbe, 0xb9, 0xa4, 0xbb, 0x66, 0xd5, 0xb2, 0x4d, 0xdf,
+	0x72, 0x6c, 0x1e, 0xaf, 0xcc, 0x4a, 0xf2, 0x8b, 0x4c, 0xdc, 0x61, 0xbe, 0xe5, 0xe0, 0xd4, 0xeb,
+	0x96, 0x5f, 0x8f, 0x9c, 0x9a, 0x4f, 0xc2, 0x71, 0xa6, 0xea, 0x38, 0xd5, 0x1a, 0xd1, 0xd9, 0x53,
+	0x29, 0xd8, 0xd4, 0x4d, 0x5b, 0x80, 0x54, 0xf2, 0xed, 0x47, 0x95, 0xc0, 0x8b, 0x83, 0x38, 0x26,
+	0xce, 0x4d, 0xd7, 0xd2, 0x4d, 0xdb, 0x76, 0x7c, 0x76, 0x48, 0xc5, 0xe9, 0x64, 0xd5, 0xa9, 0x3a,
+	0xec, 0x4f, 0x3d, 0xfc, 0x8b, 0x5b, 0xd5, 0x65, 0x38, 0x72, 0x33, 0xa4, 0xb6, 0xc6, 0xc0, 0xbe,
+	0xe3, 0x9b, 0x3e, 0x31, 0xc8, 0xed, 0x80, 0x50, 0x1f, 0x1f, 0x85, 0x83, 0x9c, 0x42, 0xd1, 0xaa,
+	0xe4, 0xd0, 0x1c, 0x5a, 0x38, 0x68, 0x1c, 0xe0, 0x86, 0xf5, 0x8a, 0x7a, 0x1f, 0x41, 0xae, 0x33,
+	0x90, 0xba, 0x8e, 0x4d, 0x09, 0xbe, 0x08, 0x59, 0x11, 0x49, 0x43, 0x3b, 0x0b, 0xce, 0x14, 0x26,
+	0x35, 0x8e, 0x4f, 0x8b, 0xf0, 0x6b, 0xaf, 0xd9, 0xdb, 0x46, 0xa6, 0xdc, 0x4a, 0x80, 0x27, 0x61,
+	0xbf, 0xeb, 0x39, 0xce, 0x66, 0x6e, 0x78, 0x0e, 0x2d, 0x64, 0x0d, 0xfe, 0x80, 0xd7, 0x20, 0xcb,
+	0xfe, 0x28, 0x6e, 0x11, 0xab, 0xba, 0xe5, 0xe7, 0xf6, 0xb1, 0x74, 0x8a, 0xd6, 0x39, 0x33, 0xed,
+	0x3a, 0xf3, 0x58, 0x1d, 0x79, 0xf0, 0xd7, 0xec, 0x90, 0x91, 0x61, 0x51, 0xdc, 0xa4, 0x96, 0x3a,
+	0xf1, 0xd2, 0x88, 0xe9, 0x35, 0x80, 0xd6, 0x44, 0x05, 0xda, 0x93, 0x1a, 0x1f, 0xbf, 0x16, 0x8e,
+	0x5f, 0xe3, 0xbb, 0x22, 0xc6, 0xaf, 0x6d, 0x98, 0xd5, 0xa8, 0x4b, 0x46, 0x2c, 0x52, 0xfd, 0x03,
+	0xc1, 0x8c, 0xa4, 0x88, 0xe8, 0x8a, 0x0d, 0x63, 0xf1, 0xae, 0xd0, 0x1c, 0x9a, 0xdb, 0xb7, 0x90,
+	0x29, 0x9c, 0x92, 0xf1, 0x58, 0xaf, 0x10, 0xdb, 0xb7, 0x36, 0x2d, 0x52, 0x89, 0xa5, 0x5a, 0xcd,
+	0x87, 0xb4, 0xbe, 0x7b, 0x3c, 0x3b, 0x2d, 0x3d, 0xa6, 0x46, 0x36, 0xd6, 0x4b, 0x8a, 0xdf, 0x48,
+	0xb0, 0x1a, 0x66, 0xac, 0xe6, 0xfb, 0xb2, 0xe2, 0x60, 0x13, 0xb4, 0x7e, 0x40, 0xa0, 0x70, 0x5a,
+	0xe1, 0x91, 0x4d, 0x03, 0x9a, 0x7a, 0x4f, 0xf0, 0x3c, 0x4c, 0x78, 0xa4, 0x61, 0x51, 0xcb, 0xb1,
+	0x8b, 0x76, 0x50, 0x2f, 0x11, 0x8f, 0x21, 0x19, 0x31, 0xc6, 0x23, 0xf3, 0x0d, 0x66, 0x4d, 0x38,
+	0xc6, 0xe6, 0x1c, 0x73, 0xe4, 0x83, 0xc4, 0x27, 0x60, 0xac, 0x16, 0xf2, 0xf3, 0x23, 0xb7, 0x91,
+	0x39, 0xb4, 0x70, 0xc0, 0xc8, 0x72, 0xa3, 0x98, 0xf6, 0x4f, 0x08, 0x8e, 0x4a, 0x21, 0x8b, 0x59,
+	0x5c, 0x86, 0x89, 0x72, 0x74, 0x92, 0x62, 0x49, 0xc7, 0xcb, 0x89, 0x34, 0xff, 0xe5, 0x9e, 0xde,
+	0x95, 0x23, 0xa7, 0xa9, 0xba, 0x7d, 0x4d, 0x32, 0xf2, 0xdd, 0x2c, 0xf2, 0x6f, 0x08, 0x8e, 0xc9,
+	0x41, 0x88, 0xfe, 0x7d, 0x08, 0x87, 0xda, 0xfa, 0x17, 0xad, 0xf3, 0x19, 0x19, 0xdd, 0x64, 0x9a,
+	0xf7, 0x2c, 0x7f, 0x2b, 0xd1, 0x80, 0x89, 0x64, 0x7b, 0x9f, 0xe1, 0xea, 0x7e, 0x8a, 0xe0, 0xb8,
+	0x84, 0x08, 0xaf, 0xbe, 0xb7, 0x3d, 0xfd, 0x1d, 0x81, 0xda, 0x0b, 0x8a, 0xe8, 0xec, 0xfb, 0x70,
+	0xa4, 0xad, 0xb3, 0x62, 0x9d, 0xa2, 0x06, 0xf7, 0xdf, 0xa7, 0xa9, 0xb2, 0xac, 0xc2, 0xb3, 0x6b,
+	0xea, 0xc5, 0x8e, 0xab, 0x34, 0x48, 0xd5, 0x4a, 0xf5, 0x1c, 0xcc, 0x48, 0x02, 0x05, 0xf1, 0x69,
+	0x18, 0xa5, 0xcc, 0x22, 0xc2, 0xc4, 0x93, 0xfa, 0x0a, 0xcc, 0x75, 0x04, 0x5d, 0x25, 0xbe, 0x69,
+	0xd5, 0x48, 0x25, 0x55, 0xd5, 0xef, 0x87, 0xa3, 0x1d, 0x90, 0x66, 0xe8, 0x5d, 0x1e, 0xcf, 0x82,
+	0xf8, 0x85, 0x2a, 0xfa, 0xdb, 0x2e, 0x61, 0x6d, 0x3b, 0x68, 0x00, 0x37, 0xdd, 0xda, 0x76, 0x09,
+	0xbe, 0x04, 0x8a, 0xb8, 0x8f, 0x5a, 0x73, 0xf3, 0xad, 0x3a, 0xa1, 0xbe, 0x59, 0x77, 0xc5, 0x1d,
+	0x96, 0xe3, 0x1e, 0xcd, 0xc9, 0xdf, 0x8a, 0xce, 0xf1, 0x5b, 0x30, 0xe1, 0x7b, 0x01, 0xf5, 0x2d,
+	0xbb, 0x5a, 0x74, 0x89, 0x67, 0x39, 0x15, 0x76, 0x9f, 0x65, 0x0a, 0x33, 0x1d, 0x17, 0xd1, 0x55,
+	0xf1, 0x6b, 0xbf, 0x7a, 0x20, 0x9c, 0xf2, 0xbd, 0xc7, 0xb3, 0xc8, 0x18, 0x8f, 0x62, 0x37, 0x58,
+	0x28, 0x7e, 0x1d, 0xc6, 0x36, 0x3d, 0xe7, 0x13, 0xd2, 0xbc, 0x42, 0xf7, 0xa7, 0xbc, 0x82, 0xb2,
+	0x3c, 0x4c, 0xdc, 0x41, 0xf7, 0x10, 0xcc, 0x4b, 0x56, 0x75, 0xc3, 0x73, 0xca, 0x84, 0x52, 0x52,
+	0x09, 0xe1, 0x3f, 0x9f, 0xdb, 0x5f, 0xbd, 0x09, 0x0b, 0xfd, 0x91, 0x89, 0x91, 0xbe, 0x00, 0xe3,
+	0x6e, 0x74, 0xc0, 0x46, 0xc2, 0xf0, 0x8d, 0x18, 0x63, 0x6e, 0xdc, 0x5d, 0xfd, 0x0a, 0xc1, 0xa9,
+	0x5e, 0x39, 0x79, 0xe5, 0xe7, 0xc4, 0x77, 0x1b, 0x4e, 0xa7, 0xc1, 0x26, 0x18, 0xbf, 0x09, 0x87,
+	0x5a, 0x8c, 0x45, 0x5e, 0x94, 0x72, 0x05, 0x26, 0xdc, 0x64, 0x52, 0x75, 0x12, 0x30, 0x2b, 0xbd,
+	0x61, 0x7a, 0x66, 0x3d, 0x7a, 0xc1, 0xd5, 0x75, 0x38, 0x9c, 0xb0, 0x8a, 0xca, 0x05, 0x18, 0x75,
+	0x99, 0xa5, 0x57, 0x3d, 0x11, 0x23, 0x3c, 0xd5, 0xe3, 0x30, 0xcb, 0x52, 0xbd, 0xeb, 0x56, 0x3d,
+	0xb3, 0x92, 0xd0, 0x32, 0x51, 0xb5, 0x1a, 0xcc, 0x75, 0x77, 0x11, 0xa5, 0xaf, 0xc3, 0x54, 0x20,
+	0x8e, 0x8b, 0xa9, 0x65, 0xe7, 0xe1, 0xa0, 0x33, 0xa3, 0xfa, 0x7f, 0x50, 0x93, 0xd5, 0x64, 0x7a,
+	0x47, 0x0d, 0xe0, 0x44, 0x4f, 0x2f, 0x01, 0xeb, 0x06, 0xe4, 0x5a, 0xb0, 0x06, 0xd0, 0x1a, 0xd3,
+	0x81, 0x34, 0xaf, 0xfa, 0x08, 0xc1, 0x14, 0xab, 0xbb, 0xbe, 0xba, 0xb6, 0x11, 0x0a, 0x86, 0x66,
+	0xa5, 0x75, 0xc8, 0xd4, 0x89, 0xf7, 0x51, 0x8d, 0x14, 0x5d, 0xd3, 0xdf, 0x12, 0xc9, 0xd5, 0xd8,
+	0x00, 0x5a, 0xdf, 0x18, 0x8d, 0x25, 0xed, 0x6d, 0xe6, 0xba, 0x61, 0xfa, 0x5b, 0x62, 0xf0, 0x50,
+	0x6f, 0x5a, 0x42, 0x61, 0xd3, 0x30, 0x6b, 0x01, 0x89, 0x84, 0x0d, 0x7b, 0x68, 0xc9, 0x9d, 0x7d,
+	0xbd, 0xe4, 0xce, 0xc8, 0x2e, 0xe4, 0x4e, 0xe1, 0x67, 0x0c, 0xfb, 0x19, 0x2b, 0xfc, 0x0d, 0x82,
+	0x4c, 0x6c, 0x18, 0xf8, 0x45, 0x59, 0xa2, 0x2e, 0xdf, 0x2a, 0xca, 0x99, 0x74, 0xce, 0xbc, 0x61,
+	0xea, 0x85, 0xbb, 0x8f, 0xfe, 0xf9, 0x7c, 0x58, 0xc7, 0x8b, 0x7a, 0xd7, 0xcf, 0x36, 0x21, 0x6a,
+	0xf4, 0x3b, 0xcd, 0x97, 0x7d, 0x07, 0x7f, 0x89, 0x20, 0xbb, 0x16, 0x57, 0xd8, 0xa9, 0xaa, 0x46,
+	0x6f, 0x8e, 0xb2, 0x98, 0xd2, 0x5b, 0x80, 0x3c, 0xc5, 0x40, 0x9e, 0xc0, 0xc7, 0xfb, 0x82, 0xc4,
+	0x8f, 0x11, 0x8c, 0x27, 0xb7, 0x05, 0x6b, 0xdd, 0x8b, 0xc9, 0x96, 0x5a, 0xd1, 0x53, 0xfb, 0x0b,
+	0x78, 0x35, 0x06, 0x6f, 0x13, 0x57, 0xa4, 0xf0, 0xda, 0xb4, 0x61, 0xbc, 0x8d, 0x7a, 0x74, 0xc3,
+	0xe9, 0x77, 0xda, 0xee, 0xca, 0x1d, 0x9d, 0x6f, 0x52, 0xec, 0x80, 0x1b, 0x76, 0xf0, 0x7d, 0x04,
+	0x13, 0x6b, 0x6d, 0x22, 0x31, 0x2d, 0xe4, 0xe6, 0x00, 0xce, 0xa6, 0x0f, 0x10, 0x24, 0x57, 0x18,
+	0xc9, 0x02, 0x3e, 0x3b, 0x28, 0x49, 0xfc, 0x00, 0xc1, 0x94, 0x54, 0xe8, 0xe1, 0x0b, 0x29, 0x51,
+	0x24, 0x35, 0xaa, 0xb2, 0x3c, 0x68, 0x98, 0xa0, 0xf0, 0x2a, 0xa3, 0xf0, 0x12, 0x5e, 0x19, 0x78,
+	0x4e, 0x42, 0x76, 0xe2, 0x6f, 0x13, 0x6b, 0x1f, 0xa4, 0x5b, 0xfb, 0x60, 0xa0, 0xb5, 0x0f, 0xe8,
+	0xc0, 0xef, 0x66, 0x90, 0xec, 0xf7, 0xaf, 0x08, 0x26, 0x65, 0xfa, 0x0e, 0x9f, 0x4f, 0x55, 0xbe,
+	0x4d, 0x50, 0x2a, 0x17, 0x06, 0x8c, 0x12, 0xe0, 0xaf, 0x30, 0xf0, 0x2b, 0x78, 0x79, 0x20, 0xf0,
+	0x7a, 0x25, 0x02, 0xfb, 0xc5, 0x30, 0x1c, 0xed, 0xa1, 0x6c, 0xf0, 0xcb, 0x29, 0x97, 0x40, 0xa6,
+	0xd4, 0x94, 0x4b, 0xbb, 0x0b, 0x16, 0xd4, 0xee, 0x30, 0x6a, 0x01, 0xa6, 0x7b, 0xf1, 0xbe, 0xeb,
+	0x49, 0xdd, 0x86, 0xbf, 0x1e, 0x86, 0xff, 0xf5, 0x54, 0x40, 0xf8, 0xf2, 0xa0, 0xe4, 0x12, 0xaa,
+	0x4e, 0xb9, 0xb2, 0xdb, 0x70, 0xd1, 0x9d, 0x1d, 0xd6, 0x9d, 0x8f, 0x71, 0xb0, 0xc7, 0xdd, 0xe1,
+	0x16, 0xbc, 0x03, 0xa3, 0x5c, 0x5b, 0xe1, 0x93, 0x5d, 0x89, 0x24, 0x64, 0x9c, 0x32, 0xdf, 0xd7,
+	0x4f, 0x30, 0x53, 0x19, 0xb3, 0x63, 0x58, 0x91, 0x31, 0xe3, 0x42, 0x0e, 0xff, 0x88, 0xe0, 0xb0,
+	0x44, 0xa1, 0xe1, 0x73, 0x5d, 0x8b, 0x74, 0x97, 0x7c, 0xca, 0xf9, 0xc1, 0x82, 0x04, 0xcc, 0x02,
+	0x83, 0x79, 0x06, 0x9f, 0x96, 0xc1, 0x94, 0xca, 0x43, 0x8a, 0x7f, 0x41, 0x30, 0x2d, 0x17, 0x71,
+	0x78, 0xb9, 0x3f, 0x08, 0xe9, 0xcf, 0xe8, 0xc5, 0x81, 0xe3, 0xd2, 0x5c, 0x7b, 0xdd, 0x74, 0x24,
+	0x5d, 0x35, 0x1e, 0x3c, 0xc9, 0xa3, 0x87, 0x4f, 0xf2, 0xe8, 0xef, 0x27, 0x79, 0xf4, 0xd9, 0xd3,
+	0xfc, 0xd0, 0xc3, 0xa7, 0xf9, 0xa1, 0x3f, 0x9f, 0xe6, 0x87, 0x3e, 0x58, 0xa9, 0x5a, 0xfe, 0x56,
+	0x50, 0x0a, 0xc5, 0x9f, 0x2e, 0xfe, 0x91, 0x6d, 0x95, 0xca, 0x8b, 0x55, 0x47, 0x6f, 0x2c, 0xeb,
+	0x75, 0xa7, 0x12, 0xd4, 0x08, 0xe5, 0x75, 0xce, 0x16, 0x16, 0x45, 0xa9, 0xf0, 0x0b, 0x97, 0x96,
+	0x46, 0x99, 0x1c, 0x3d, 0xf7, 0xef, 0x00, 0x82, 0x78, 0x41, 0xf9, 0x34, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1067,18 +1546,34 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// ClientState queries an IBC light client.
 	ClientState(ctx context.Context, in *QueryClientStateRequest, opts ...grpc.CallOption) (*QueryClientStateResponse, error)
-	// ClientStates queries all the IBC light clients of a chain.
+	// ClientStates queries all the IBC light clients of a chain. Pages are read in
+	// store key order, the client states of each page are then ordered by client
+	// type and numeric client sequence. The ordering does not span pages.
 	ClientStates(ctx context.Context, in *QueryClientStatesRequest, opts ...grpc.CallOption) (*QueryClientStatesResponse, error)
 	// ConsensusState queries a consensus state associated with a client state at
 	// a given height.
 	ConsensusState(ctx context.Context, in *QueryConsensusStateRequest, opts ...grpc.CallOption) (*QueryConsensusStateResponse, error)
 	// ConsensusStates queries all the consensus state associated with a given
-	// client.
+	// client. Pages are read in store key order, which is the lexicographic order
+	// of the heights, the consensus states of each page are then ordered by
+	// ascending height. The ordering does not span pages.
 	ConsensusStates(ctx context.Context, in *QueryConsensusStatesRequest, opts ...grpc.CallOption) (*QueryConsensusStatesResponse, error)
-	// ConsensusStateHeights queries the height of every consensus states associated with a given client.
+	// ConsensusStateHeights queries the height of every consensus states associated with a given client,
+	// in ascending order. Only the heights are returned, the consensus states and their metadata may be
+	// queried with ConsensusState. The heights are read from the consensus state iteration keys, so no
+	// heights are returned for clients which do not store iteration keys.
 	ConsensusStateHeights(ctx context.Context, in *QueryConsensusStateHeightsRequest, opts ...grpc.CallOption) (*QueryConsensusStateHeightsResponse, error)
 	// Status queries the status of an IBC client.
 	ClientStatus(ctx context.Context, in *QueryClientStatusRequest, opts ...grpc.CallOption) (*QueryClientStatusResponse, error)
+	// ClientStatusDetailed queries the status of an IBC client along with the details explaining
+	// why the client is not Active.
+	ClientStatusDetailed(ctx context.Context, in *QueryClientStatusDetailedRequest, opts ...grpc.CallOption) (*QueryClientStatusDetailedResponse, error)
+	// ConsensusStateProcessedTime queries the time at which the consensus state of a client at a
+	// given height was processed by this chain.
+	ConsensusStateProcessedTime(ctx context.Context, in *QueryConsensusStateProcessedTimeRequest, opts ...grpc.CallOption) (*QueryConsensusStateProcessedTimeResponse, error)
+	// ConsensusStateProcessedHeight queries the height of this chain at which the consensus state
+	// of a client at a given height was processed.
+	ConsensusStateProcessedHeight(ctx context.Context, in *QueryConsensusStateProcessedHeightRequest, opts ...grpc.CallOption) (*QueryConsensusStateProcessedHeightResponse, error)
 	// Params queries all parameters of the ibc client.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// UpgradedClientState queries an Upgraded IBC light client.
@@ -1149,6 +1644,33 @@ func (c *queryClient) ClientStatus(ctx context.Context, in *QueryClientStatusReq
 	return out, nil
 }
 
+func (c *queryClient) ClientStatusDetailed(ctx context.Context, in *QueryClientStatusDetailedRequest, opts ...grpc.CallOption) (*QueryClientStatusDetailedResponse, error) {
+	out := new(QueryClientStatusDetailedResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/ClientStatusDetailed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsensusStateProcessedTime(ctx context.Context, in *QueryConsensusStateProcessedTimeRequest, opts ...grpc.CallOption) (*QueryConsensusStateProcessedTimeResponse, error) {
+	out := new(QueryConsensusStateProcessedTimeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/ConsensusStateProcessedTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsensusStateProcessedHeight(ctx context.Context, in *QueryConsensusStateProcessedHeightRequest, opts ...grpc.CallOption) (*QueryConsensusStateProcessedHeightResponse, error) {
+	out := new(QueryConsensusStateProcessedHeightResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/ConsensusStateProcessedHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/Params", in, out, opts...)
@@ -1180,18 +1702,34 @@ func (c *queryClient) UpgradedConsensusState(ctx context.Context, in *QueryUpgra
 type QueryServer interface {
 	// ClientState queries an IBC light client.
 	ClientState(context.Context, *QueryClientStateRequest) (*QueryClientStateResponse, error)
-	// ClientStates queries all the IBC light clients of a chain.
+	// ClientStates queries all the IBC light clients of a chain. Pages are read in
+	// store key order, the client states of each page are then ordered by client
+	// type and numeric client sequence. The ordering does not span pages.
 	ClientStates(context.Context, *QueryClientStatesRequest) (*QueryClientStatesResponse, error)
 	// ConsensusState queries a consensus state associated with a client state at
 	// a given height.
 	ConsensusState(context.Context, *QueryConsensusStateRequest) (*QueryConsensusStateResponse, error)
 	// ConsensusStates queries all the consensus state associated with a given
-	// client.
+	// client. Pages are read in store key order, which is the lexicographic order
+	// of the heights, the consensus states of each page are then ordered by
+	// ascending height. The ordering does not span pages.
 	ConsensusStates(context.Context, *QueryConsensusStatesRequest) (*QueryConsensusStatesResponse, error)
-	// ConsensusStateHeights queries the height of every consensus states associated with a given client.
+	// ConsensusStateHeights queries the height of every consensus states associated with a given client,
+	// in ascending order. Only the heights are returned, the consensus states and their metadata may be
+	// queried with ConsensusState. The heights are read from the consensus state iteration keys, so no
+	// heights are returned for clients which do not store iteration keys.
 	ConsensusStateHeights(context.Context, *QueryConsensusStateHeightsRequest) (*QueryConsensusStateHeightsResponse, error)
 	// Status queries the status of an IBC client.
 	ClientStatus(context.Context, *QueryClientStatusRequest) (*QueryClientStatusResponse, error)
+	// ClientStatusDetailed queries the status of an IBC client along with the details explaining
+	// why the client is not Active.
+	ClientStatusDetailed(context.Context, *QueryClientStatusDetailedRequest) (*QueryClientStatusDetailedResponse, error)
+	// ConsensusStateProcessedTime queries the time at which the consensus state of a client at a
+	// given height was processed by this chain.
+	ConsensusStateProcessedTime(context.Context, *QueryConsensusStateProcessedTimeRequest) (*QueryConsensusStateProcessedTimeResponse, error)
+	// ConsensusStateProcessedHeight queries the height of this chain at which the consensus state
+	// of a client at a given height was processed.
+	ConsensusStateProcessedHeight(context.Context, *QueryConsensusStateProcessedHeightRequest) (*QueryConsensusStateProcessedHeightResponse, error)
 	// Params queries all parameters of the ibc client.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// UpgradedClientState queries an Upgraded IBC light client.
@@ -1222,6 +1760,15 @@ func (*UnimplementedQueryServer) ConsensusStateHeights(ctx context.Context, req
 func (*UnimplementedQueryServer) ClientStatus(ctx context.Context, req *QueryClientStatusRequest) (*QueryClientStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClientStatus not implemented")
 }
+func (*UnimplementedQueryServer) ClientStatusDetailed(ctx context.Context, req *QueryClientStatusDetailedRequest) (*QueryClientStatusDetailedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientStatusDetailed not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusStateProcessedTime(ctx context.Context, req *QueryConsensusStateProcessedTimeRequest) (*QueryConsensusStateProcessedTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusStateProcessedTime not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusStateProcessedHeight(ctx context.Context, req *QueryConsensusStateProcessedHeightRequest) (*QueryConsensusStateProcessedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusStateProcessedHeight not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1344,6 +1891,60 @@ func _Query_ClientStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClientStatusDetailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientStatusDetailedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientStatusDetailed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Query/ClientStatusDetailed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientStatusDetailed(ctx, req.(*QueryClientStatusDetailedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusStateProcessedTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusStateProcessedTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusStateProcessedTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Query/ConsensusStateProcessedTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusStateProcessedTime(ctx, req.(*QueryConsensusStateProcessedTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusStateProcessedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusStateProcessedHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusStateProcessedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Query/ConsensusStateProcessedHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusStateProcessedHeight(ctx, req.(*QueryConsensusStateProcessedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1426,6 +2027,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClientStatus",
 			Handler:    _Query_ClientStatus_Handler,
 		},
+		{
+			MethodName: "ClientStatusDetailed",
+			Handler:    _Query_ClientStatusDetailed_Handler,
+		},
+		{
+			MethodName: "ConsensusStateProcessedTime",
+			Handler:    _Query_ConsensusStateProcessedTime_Handler,
+		},
+		{
+			MethodName: "ConsensusStateProcessedHeight",
+			Handler:    _Query_ConsensusStateProcessedHeight_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1953,7 +2566,7 @@ func (m *QueryClientStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClientStatusDetailedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1963,20 +2576,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClientStatusDetailedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClientStatusDetailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClientStatusDetailedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1986,32 +2606,57 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClientStatusDetailedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClientStatusDetailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.FrozenHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TrustingPeriod):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if m.LatestConsensusTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestConsensusTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUpgradedClientStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryConsensusStateProcessedTimeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2021,20 +2666,37 @@ func (m *QueryUpgradedClientStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryUpgradedClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryConsensusStateProcessedTimeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUpgradedClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryConsensusStateProcessedTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.RevisionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevisionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RevisionNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevisionNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUpgradedClientStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryConsensusStateProcessedTimeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2044,32 +2706,25 @@ func (m *QueryUpgradedClientStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryUpgradedClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryConsensusStateProcessedTimeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUpgradedClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryConsensusStateProcessedTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UpgradedClientState != nil {
-		{
-			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.ProcessedTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProcessedTime))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUpgradedConsensusStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryConsensusStateProcessedHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2079,20 +2734,37 @@ func (m *QueryUpgradedConsensusStateRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryUpgradedConsensusStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryConsensusStateProcessedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUpgradedConsensusStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryConsensusStateProcessedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.RevisionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevisionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RevisionNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevisionNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUpgradedConsensusStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryConsensusStateProcessedHeightResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2102,43 +2774,272 @@ func (m *QueryUpgradedConsensusStateResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryUpgradedConsensusStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryConsensusStateProcessedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUpgradedConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryConsensusStateProcessedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UpgradedConsensusState != nil {
-		{
-			size, err := m.UpgradedConsensusState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.ProcessedHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryClientStateRequest) Size() (n int) {
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradedClientStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradedClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradedClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradedClientStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradedClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradedClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradedClientState != nil {
+		{
+			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradedConsensusStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradedConsensusStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradedConsensusStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradedConsensusStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradedConsensusStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradedConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradedConsensusState != nil {
+		{
+			size, err := m.UpgradedConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.MerklePath.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryClientStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2341,57 +3242,155 @@ func (m *QueryClientStatusResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryClientStatusDetailedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryClientStatusDetailedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientType)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.LatestConsensusTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.LatestConsensusTimestamp))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TrustingPeriod)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FrozenHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryUpgradedClientStateRequest) Size() (n int) {
+func (m *QueryConsensusStateProcessedTimeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RevisionNumber != 0 {
+		n += 1 + sovQuery(uint64(m.RevisionNumber))
+	}
+	if m.RevisionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RevisionHeight))
+	}
 	return n
 }
 
-func (m *QueryUpgradedClientStateResponse) Size() (n int) {
+func (m *QueryConsensusStateProcessedTimeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UpgradedClientState != nil {
-		l = m.UpgradedClientState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ProcessedTime != 0 {
+		n += 1 + sovQuery(uint64(m.ProcessedTime))
 	}
 	return n
 }
 
-func (m *QueryUpgradedConsensusStateRequest) Size() (n int) {
+func (m *QueryConsensusStateProcessedHeightRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RevisionNumber != 0 {
+		n += 1 + sovQuery(uint64(m.RevisionNumber))
+	}
+	if m.RevisionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RevisionHeight))
+	}
+	return n
+}
+
+func (m *QueryConsensusStateProcessedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProcessedHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradedClientStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpgradedClientStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpgradedClientState != nil {
+		l = m.UpgradedClientState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradedConsensusStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryUpgradedConsensusStateResponse) Size() (n int) {
@@ -2407,6 +3406,27 @@ func (m *QueryUpgradedConsensusStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryIBCProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MerklePath.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2648,7 +3668,506 @@ func (m *QueryClientStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClientStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClientStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientStates = append(m.ClientStates, IdentifiedClientState{})
+			if err := m.ClientStates[len(m.ClientStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			}
+			m.RevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			}
+			m.RevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LatestHeight = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2671,13 +4190,45 @@ func (m *QueryClientStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsensusStatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsensusStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2734,7 +4285,7 @@ func (m *QueryClientStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClientStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsensusStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2757,15 +4308,15 @@ func (m *QueryClientStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsensusStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2792,8 +4343,8 @@ func (m *QueryClientStatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientStates = append(m.ClientStates, IdentifiedClientState{})
-			if err := m.ClientStates[len(m.ClientStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConsensusStates = append(m.ConsensusStates, ConsensusStateWithHeight{})
+			if err := m.ConsensusStates[len(m.ConsensusStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2854,7 +4405,7 @@ func (m *QueryClientStatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsensusStateHeightsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2877,10 +4428,10 @@ func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsensusStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsensusStateHeightsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsensusStateHeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2916,10 +4467,10 @@ func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.RevisionNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2929,16 +4480,83 @@ func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RevisionNumber |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.RevisionHeight = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStateHeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStateHeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStateHeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStateHeights", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2948,16 +4566,31 @@ func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RevisionHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusStateHeights = append(m.ConsensusStateHeights, Height{})
+			if err := m.ConsensusStateHeights[len(m.ConsensusStateHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2967,12 +4600,28 @@ func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LatestHeight = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2994,7 +4643,7 @@ func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsensusStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClientStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3017,17 +4666,17 @@ func (m *QueryConsensusStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsensusStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3037,67 +4686,79 @@ func (m *QueryConsensusStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusState == nil {
-				m.ConsensusState = &types.Any{}
-			}
-			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3107,24 +4768,23 @@ func (m *QueryConsensusStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3147,7 +4807,7 @@ func (m *QueryConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsensusStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClientStatusDetailedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3170,10 +4830,10 @@ func (m *QueryConsensusStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsensusStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientStatusDetailedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsensusStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientStatusDetailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3208,42 +4868,6 @@ func (m *QueryConsensusStatesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3265,7 +4889,7 @@ func (m *QueryConsensusStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsensusStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClientStatusDetailedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,15 +4912,98 @@ func (m *QueryConsensusStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsensusStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientStatusDetailedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientStatusDetailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestConsensusTimestamp", wireType)
+			}
+			m.LatestConsensusTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestConsensusTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3323,14 +5030,13 @@ func (m *QueryConsensusStatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusStates = append(m.ConsensusStates, ConsensusStateWithHeight{})
-			if err := m.ConsensusStates[len(m.ConsensusStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TrustingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3357,10 +5063,7 @@ func (m *QueryConsensusStatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FrozenHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3385,7 +5088,7 @@ func (m *QueryConsensusStatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsensusStateHeightsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsensusStateProcessedTimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3408,10 +5111,10 @@ func (m *QueryConsensusStateHeightsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsensusStateHeightsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsensusStateProcessedTimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsensusStateHeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsensusStateProcessedTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3447,10 +5150,10 @@ func (m *QueryConsensusStateHeightsRequest) Unmarshal(dAtA []byte) error {
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
 			}
-			var msglen int
+			m.RevisionNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3460,28 +5163,30 @@ func (m *QueryConsensusStateHeightsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RevisionNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3503,7 +5208,7 @@ func (m *QueryConsensusStateHeightsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsensusStateHeightsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsensusStateProcessedTimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3526,51 +5231,17 @@ func (m *QueryConsensusStateHeightsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsensusStateHeightsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsensusStateHeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsensusStateProcessedTimeResponse: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStateHeights", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsensusStateHeights = append(m.ConsensusStateHeights, Height{})
-			if err := m.ConsensusStateHeights[len(m.ConsensusStateHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStateProcessedTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedTime", wireType)
 			}
-			var msglen int
+			m.ProcessedTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3580,28 +5251,11 @@ func (m *QueryConsensusStateHeightsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProcessedTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3623,7 +5277,7 @@ func (m *QueryConsensusStateHeightsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClientStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsensusStateProcessedHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3646,10 +5300,10 @@ func (m *QueryClientStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsensusStateProcessedHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsensusStateProcessedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3684,6 +5338,44 @@ func (m *QueryClientStatusRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			}
+			m.RevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			}
+			m.RevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3705,7 +5397,7 @@ func (m *QueryClientStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClientStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsensusStateProcessedHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3728,17 +5420,17 @@ func (m *QueryClientStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsensusStateProcessedHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsensusStateProcessedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3748,23 +5440,24 @@ func (m *QueryClientStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			if err := m.ProcessedHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4195,6 +5888,190 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryIBCProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerklePath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -362,6 +362,97 @@ func (m *MsgSubmitMisbehaviourResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitMisbehaviourResponse proto.InternalMessageInfo
 
+// MsgPruneExpiredConsensusStates defines an sdk.Msg to prune all expired consensus states, along
+// with their metadata, of a tendermint client out-of-band from client updates. It may only be
+// executed by the governance authority.
+type MsgPruneExpiredConsensusStates struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the client identifier for the client whose expired consensus states are pruned
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+}
+
+func (m *MsgPruneExpiredConsensusStates) Reset()         { *m = MsgPruneExpiredConsensusStates{} }
+func (m *MsgPruneExpiredConsensusStates) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneExpiredConsensusStates) ProtoMessage()    {}
+func (*MsgPruneExpiredConsensusStates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{8}
+}
+func (m *MsgPruneExpiredConsensusStates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneExpiredConsensusStates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneExpiredConsensusStates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneExpiredConsensusStates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneExpiredConsensusStates.Merge(m, src)
+}
+func (m *MsgPruneExpiredConsensusStates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneExpiredConsensusStates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneExpiredConsensusStates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneExpiredConsensusStates proto.InternalMessageInfo
+
+// MsgPruneExpiredConsensusStatesResponse defines the Msg/PruneExpiredConsensusStates response type.
+type MsgPruneExpiredConsensusStatesResponse struct {
+	// the number of consensus states pruned
+	TotalPruned uint64 `protobuf:"varint,1,opt,name=total_pruned,json=totalPruned,proto3" json:"total_pruned,omitempty"`
+}
+
+func (m *MsgPruneExpiredConsensusStatesResponse) Reset() {
+	*m = MsgPruneExpiredConsensusStatesResponse{}
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneExpiredConsensusStatesResponse) ProtoMessage()    {}
+func (*MsgPruneExpiredConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{9}
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneExpiredConsensusStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneExpiredConsensusStatesResponse.Merge(m, src)
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneExpiredConsensusStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneExpiredConsensusStatesResponse proto.InternalMessageInfo
+
+func (m *MsgPruneExpiredConsensusStatesResponse) GetTotalPruned() uint64 {
+	if m != nil {
+		return m.TotalPruned
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateClient)(nil), "ibc.core.client.v1.MsgCreateClient")
 	proto.RegisterType((*MsgCreateClientResponse)(nil), "ibc.core.client.v1.MsgCreateClientResponse")
@@ -371,51 +462,59 @@ func init() {
 	proto.RegisterType((*MsgUpgradeClientResponse)(nil), "ibc.core.client.v1.MsgUpgradeClientResponse")
 	proto.RegisterType((*MsgSubmitMisbehaviour)(nil), "ibc.core.client.v1.MsgSubmitMisbehaviour")
 	proto.RegisterType((*MsgSubmitMisbehaviourResponse)(nil), "ibc.core.client.v1.MsgSubmitMisbehaviourResponse")
+	proto.RegisterType((*MsgPruneExpiredConsensusStates)(nil), "ibc.core.client.v1.MsgPruneExpiredConsensusStates")
+	proto.RegisterType((*MsgPruneExpiredConsensusStatesResponse)(nil), "ibc.core.client.v1.MsgPruneExpiredConsensusStatesResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/tx.proto", fileDescriptor_cb5dc4651eb49a04) }
 
 var fileDescriptor_cb5dc4651eb49a04 = []byte{
-	// 623 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x9b, 0xef, 0x8b, 0x9a, 0x69, 0xfa, 0x23, 0x13, 0x52, 0xd7, 0x55, 0xed, 0xc8, 0x74,
-	0x11, 0x04, 0xb5, 0x49, 0x2a, 0x21, 0x54, 0xd8, 0x90, 0xae, 0x58, 0x44, 0x02, 0x57, 0x2c, 0x60,
-	0x13, 0xfc, 0x33, 0x9d, 0x8e, 0x88, 0x3d, 0x91, 0xc7, 0x8e, 0xc8, 0x1b, 0xb0, 0xe4, 0x11, 0x2a,
-	0x78, 0x01, 0x1e, 0x83, 0x65, 0x17, 0x2c, 0x58, 0x45, 0x55, 0xb2, 0x61, 0x9d, 0x27, 0x40, 0xf1,
-	0x38, 0xc1, 0x76, 0xec, 0x28, 0x12, 0xb0, 0xf3, 0xcc, 0x3d, 0x73, 0xce, 0x3d, 0x3e, 0x77, 0x6c,
-	0x70, 0x88, 0x4d, 0x4b, 0xb3, 0x88, 0x07, 0x35, 0xab, 0x87, 0xa1, 0xeb, 0x6b, 0x83, 0xa6, 0xe6,
-	0x7f, 0x50, 0xfb, 0x1e, 0xf1, 0x09, 0xcf, 0x63, 0xd3, 0x52, 0x67, 0x45, 0x95, 0x15, 0xd5, 0x41,
-	0x53, 0xac, 0x22, 0x82, 0x48, 0x58, 0xd6, 0x66, 0x4f, 0x0c, 0x29, 0x1e, 0x20, 0x42, 0x50, 0x0f,
-	0x6a, 0xe1, 0xca, 0x0c, 0x2e, 0x35, 0xc3, 0x1d, 0xb2, 0x92, 0x72, 0xcb, 0x81, 0xdd, 0x0e, 0x45,
-	0xe7, 0x1e, 0x34, 0x7c, 0x78, 0x1e, 0xf2, 0xf0, 0x2f, 0x41, 0x85, 0x31, 0x76, 0xa9, 0x6f, 0xf8,
-	0x50, 0xe0, 0xea, 0x5c, 0x63, 0xab, 0x55, 0x55, 0x19, 0x8b, 0x3a, 0x67, 0x51, 0x9f, 0xbb, 0xc3,
-	0xf6, 0xfe, 0x74, 0x24, 0xdf, 0x19, 0x1a, 0x4e, 0xef, 0x4c, 0x89, 0x9f, 0x51, 0xf4, 0x2d, 0xb6,
-	0xbc, 0x98, 0xad, 0xf8, 0x37, 0x60, 0xd7, 0x22, 0x2e, 0x85, 0x2e, 0x0d, 0x68, 0x44, 0xba, 0xb1,
-	0x82, 0x54, 0x9c, 0x8e, 0xe4, 0x5a, 0x44, 0x9a, 0x3c, 0xa6, 0xe8, 0x3b, 0x8b, 0x1d, 0x46, 0x5d,
-	0x03, 0x25, 0x8a, 0x91, 0x0b, 0x3d, 0xa1, 0x58, 0xe7, 0x1a, 0x65, 0x3d, 0x5a, 0x9d, 0x6d, 0x7e,
-	0xbc, 0x96, 0x0b, 0x3f, 0xaf, 0xe5, 0x82, 0x72, 0x00, 0xf6, 0x53, 0x0e, 0x75, 0x48, 0xfb, 0x33,
-	0x16, 0xe5, 0x0b, 0x73, 0xff, 0xba, 0x6f, 0xff, 0x76, 0xdf, 0x04, 0xe5, 0xc8, 0x09, 0xb6, 0x43,
-	0xeb, 0xe5, 0x76, 0x75, 0x3a, 0x92, 0xf7, 0x12, 0x26, 0xb1, 0xad, 0xe8, 0x9b, 0xec, 0xf9, 0x85,
-	0xcd, 0x3f, 0x05, 0x3b, 0xd1, 0xbe, 0x03, 0x29, 0x35, 0xd0, 0x4a, 0x77, 0xfa, 0x36, 0xc3, 0x76,
-	0x18, 0x74, 0x6d, 0x03, 0xf1, 0x26, 0x17, 0x06, 0xbe, 0x17, 0xc1, 0x5e, 0x58, 0x43, 0x9e, 0x61,
-	0xff, 0x81, 0x83, 0x74, 0xe4, 0x1b, 0xff, 0x22, 0xf2, 0xe2, 0x5f, 0x8a, 0xfc, 0x15, 0xa8, 0xf6,
-	0x3d, 0x42, 0x2e, 0xbb, 0x01, 0xb3, 0xdd, 0x65, 0xba, 0xc2, 0x7f, 0x75, 0xae, 0x51, 0x69, 0xcb,
-	0xd3, 0x91, 0x7c, 0xc8, 0x98, 0xb2, 0x50, 0x8a, 0xce, 0x87, 0xdb, 0xc9, 0x57, 0xf6, 0x1e, 0x1c,
-	0xa5, 0xc0, 0xa9, 0xde, 0xff, 0x0f, 0xb9, 0x1b, 0xd3, 0x91, 0x7c, 0x9c, 0xc9, 0x9d, 0xee, 0x59,
-	0x4c, 0x88, 0xe4, 0x8d, 0x6c, 0x29, 0x27, 0x71, 0x11, 0x08, 0xe9, 0x54, 0x17, 0x91, 0x7f, 0xe5,
-	0xc0, 0xdd, 0x0e, 0x45, 0x17, 0x81, 0xe9, 0x60, 0xbf, 0x83, 0xa9, 0x09, 0xaf, 0x8c, 0x01, 0x26,
-	0x81, 0xc7, 0x9f, 0x2e, 0xe7, 0x5e, 0xcb, 0xca, 0x5d, 0xe0, 0x62, 0xc9, 0x3f, 0x03, 0x15, 0x27,
-	0x46, 0xb2, 0x32, 0xf9, 0x0d, 0x81, 0xd3, 0x13, 0x68, 0x5e, 0x4c, 0x0e, 0x6f, 0x88, 0x58, 0xb6,
-	0x23, 0x83, 0xa3, 0xcc, 0x8e, 0xe7, 0x9e, 0x5a, 0x9f, 0x8b, 0xa0, 0xd8, 0xa1, 0x88, 0x7f, 0x07,
-	0x2a, 0x89, 0x2f, 0xd1, 0x3d, 0x75, 0xf9, 0x1b, 0xa7, 0xa6, 0x2e, 0xb3, 0xf8, 0x60, 0x0d, 0xd0,
-	0x5c, 0x69, 0xa6, 0x90, 0xb8, 0xed, 0x79, 0x0a, 0x71, 0x50, 0xae, 0x42, 0xd6, 0x95, 0xe4, 0x2d,
-	0xb0, 0x9d, 0x9c, 0xad, 0xe3, 0xdc, 0xd3, 0x31, 0x94, 0xf8, 0x70, 0x1d, 0xd4, 0x42, 0xc4, 0x03,
-	0x7c, 0xc6, 0x00, 0xdc, 0xcf, 0xe1, 0x58, 0x86, 0x8a, 0xcd, 0xb5, 0xa1, 0x73, 0xcd, 0xb6, 0xfe,
-	0x6d, 0x2c, 0x71, 0x37, 0x63, 0x89, 0xbb, 0x1d, 0x4b, 0xdc, 0xa7, 0x89, 0x54, 0xb8, 0x99, 0x48,
-	0x85, 0x1f, 0x13, 0xa9, 0xf0, 0xf6, 0x09, 0xc2, 0xfe, 0x55, 0x60, 0xaa, 0x16, 0x71, 0x34, 0x8b,
-	0x50, 0x87, 0x50, 0x0d, 0x9b, 0xd6, 0x09, 0x22, 0xda, 0xe0, 0xb1, 0xe6, 0x10, 0x3b, 0xe8, 0x41,
-	0xca, 0x7e, 0x63, 0x8f, 0x5a, 0x27, 0xd1, 0x9f, 0xcc, 0x1f, 0xf6, 0x21, 0x35, 0x4b, 0xe1, 0x7c,
-	0x9d, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xef, 0x72, 0xab, 0x14, 0xe9, 0x06, 0x00, 0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x93, 0x7e, 0x55, 0x33, 0x4d, 0x7f, 0xe4, 0x2f, 0xb4, 0xa9, 0x4b, 0xe3, 0x62, 0x2a,
+	0x14, 0x54, 0x6a, 0x93, 0x54, 0x42, 0xa8, 0xb0, 0x21, 0x15, 0x0b, 0x84, 0x22, 0x15, 0x57, 0x2c,
+	0x60, 0x93, 0xfa, 0x67, 0x3a, 0x1d, 0x11, 0x7b, 0x2c, 0xcf, 0x38, 0x6a, 0xc4, 0x0b, 0xb0, 0x41,
+	0xe2, 0x11, 0x2a, 0xf1, 0x02, 0x88, 0xa7, 0x60, 0xd9, 0x05, 0x0b, 0x56, 0x51, 0xd5, 0x6e, 0x58,
+	0xe7, 0x09, 0x50, 0x3c, 0xa9, 0x6b, 0x3b, 0x3f, 0x0a, 0x02, 0x76, 0x9e, 0x7b, 0xcf, 0x3d, 0xf7,
+	0x1e, 0x9f, 0xeb, 0x91, 0xc1, 0x3a, 0x36, 0x2d, 0xcd, 0x22, 0x3e, 0xd4, 0xac, 0x16, 0x86, 0x2e,
+	0xd3, 0xda, 0x55, 0x8d, 0x9d, 0xaa, 0x9e, 0x4f, 0x18, 0x11, 0x45, 0x6c, 0x5a, 0x6a, 0x3f, 0xa9,
+	0xf2, 0xa4, 0xda, 0xae, 0x4a, 0x45, 0x44, 0x10, 0x09, 0xd3, 0x5a, 0xff, 0x89, 0x23, 0xa5, 0x35,
+	0x44, 0x08, 0x6a, 0x41, 0x2d, 0x3c, 0x99, 0xc1, 0xb1, 0x66, 0xb8, 0x1d, 0x9e, 0x52, 0x2e, 0x04,
+	0xb0, 0xd4, 0xa0, 0x68, 0xdf, 0x87, 0x06, 0x83, 0xfb, 0x21, 0x8f, 0x78, 0x00, 0x0a, 0x9c, 0xb1,
+	0x49, 0x99, 0xc1, 0x60, 0x49, 0xd8, 0x14, 0x2a, 0xf3, 0xb5, 0xa2, 0xca, 0x59, 0xd4, 0x6b, 0x16,
+	0xf5, 0x99, 0xdb, 0xa9, 0xaf, 0xf6, 0xba, 0xf2, 0xff, 0x1d, 0xc3, 0x69, 0xed, 0x29, 0xf1, 0x1a,
+	0x45, 0x9f, 0xe7, 0xc7, 0xc3, 0xfe, 0x49, 0x7c, 0x03, 0x96, 0x2c, 0xe2, 0x52, 0xe8, 0xd2, 0x80,
+	0x0e, 0x48, 0xb3, 0x13, 0x48, 0xa5, 0x5e, 0x57, 0x5e, 0x19, 0x90, 0x26, 0xcb, 0x14, 0x7d, 0x31,
+	0x8a, 0x70, 0xea, 0x15, 0x30, 0x4b, 0x31, 0x72, 0xa1, 0x5f, 0xca, 0x6d, 0x0a, 0x95, 0xbc, 0x3e,
+	0x38, 0xed, 0xcd, 0x7d, 0x38, 0x93, 0x33, 0x3f, 0xcf, 0xe4, 0x8c, 0xb2, 0x06, 0x56, 0x53, 0x0a,
+	0x75, 0x48, 0xbd, 0x3e, 0x8b, 0xf2, 0x99, 0xab, 0x7f, 0xed, 0xd9, 0x37, 0xea, 0xab, 0x20, 0x3f,
+	0x50, 0x82, 0xed, 0x50, 0x7a, 0xbe, 0x5e, 0xec, 0x75, 0xe5, 0xe5, 0x84, 0x48, 0x6c, 0x2b, 0xfa,
+	0x1c, 0x7f, 0x7e, 0x61, 0x8b, 0x4f, 0xc0, 0xe2, 0x20, 0xee, 0x40, 0x4a, 0x0d, 0x34, 0x51, 0x9d,
+	0xbe, 0xc0, 0xb1, 0x0d, 0x0e, 0x9d, 0x5a, 0x40, 0x7c, 0xc8, 0x48, 0xc0, 0xf7, 0x1c, 0x58, 0x0e,
+	0x73, 0xc8, 0x37, 0xec, 0x3f, 0x50, 0x90, 0xb6, 0x3c, 0xfb, 0x2f, 0x2c, 0xcf, 0xfd, 0x25, 0xcb,
+	0x5f, 0x81, 0xa2, 0xe7, 0x13, 0x72, 0xdc, 0x0c, 0xb8, 0xec, 0x26, 0xef, 0x5b, 0x9a, 0xd9, 0x14,
+	0x2a, 0x85, 0xba, 0xdc, 0xeb, 0xca, 0xeb, 0x9c, 0x69, 0x14, 0x4a, 0xd1, 0xc5, 0x30, 0x9c, 0x7c,
+	0x65, 0xef, 0xc0, 0x46, 0x0a, 0x9c, 0x9a, 0xfd, 0xbf, 0x90, 0xbb, 0xd2, 0xeb, 0xca, 0x5b, 0x23,
+	0xb9, 0xd3, 0x33, 0x4b, 0x89, 0x26, 0xe3, 0x56, 0x76, 0x76, 0x8c, 0xe3, 0x12, 0x28, 0xa5, 0x5d,
+	0x8d, 0x2c, 0xff, 0x22, 0x80, 0x5b, 0x0d, 0x8a, 0x0e, 0x03, 0xd3, 0xc1, 0xac, 0x81, 0xa9, 0x09,
+	0x4f, 0x8c, 0x36, 0x26, 0x81, 0x2f, 0xee, 0x0e, 0xfb, 0xbe, 0x32, 0xca, 0xf7, 0x92, 0x10, 0x73,
+	0xfe, 0x29, 0x28, 0x38, 0x31, 0x92, 0x89, 0xce, 0x67, 0x4b, 0x82, 0x9e, 0x40, 0x8b, 0x52, 0x72,
+	0x79, 0x43, 0xc4, 0xb0, 0x1c, 0x19, 0x6c, 0x8c, 0x9c, 0x38, 0xd2, 0xf4, 0x1e, 0x94, 0x1b, 0x14,
+	0x1d, 0xf8, 0x81, 0x0b, 0x9f, 0x9f, 0x7a, 0xd8, 0x87, 0x76, 0xf2, 0x95, 0x51, 0xf1, 0x36, 0xc8,
+	0x1b, 0x01, 0x3b, 0x21, 0x3e, 0x66, 0x1d, 0xae, 0x4d, 0xbf, 0x09, 0x24, 0x37, 0x3e, 0x3b, 0xcd,
+	0xc6, 0xc7, 0xa6, 0x7b, 0x09, 0xee, 0x4d, 0x6e, 0x7e, 0x3d, 0xa6, 0x78, 0x07, 0x14, 0x18, 0x61,
+	0x46, 0xab, 0xe9, 0xf5, 0xc1, 0xfc, 0x1d, 0xcf, 0xe8, 0xf3, 0x61, 0x2c, 0xac, 0xb7, 0x6b, 0x5f,
+	0x67, 0x40, 0xae, 0x41, 0x91, 0x78, 0x04, 0x0a, 0x89, 0x3b, 0xf5, 0xae, 0x3a, 0x7c, 0x5b, 0xab,
+	0xa9, 0x6b, 0x49, 0xda, 0x9e, 0x02, 0x14, 0x0d, 0x73, 0x04, 0x0a, 0x89, 0x7b, 0x6b, 0x5c, 0x87,
+	0x38, 0x48, 0xda, 0x9e, 0x02, 0x14, 0x75, 0xb0, 0xc0, 0x42, 0xf2, 0x2b, 0xd9, 0x1a, 0x5b, 0x1d,
+	0x43, 0x49, 0x0f, 0xa6, 0x41, 0x45, 0x4d, 0x7c, 0x20, 0x8e, 0x58, 0xe5, 0xfb, 0x63, 0x38, 0x86,
+	0xa1, 0x52, 0x75, 0x6a, 0x68, 0xd4, 0xf3, 0xa3, 0x00, 0xd6, 0x27, 0x2d, 0x5b, 0x6d, 0x0c, 0xe5,
+	0x84, 0x1a, 0x69, 0xef, 0xf7, 0x6b, 0xae, 0xe7, 0xa9, 0xeb, 0xdf, 0x2e, 0xcb, 0xc2, 0xf9, 0x65,
+	0x59, 0xb8, 0xb8, 0x2c, 0x0b, 0x9f, 0xae, 0xca, 0x99, 0xf3, 0xab, 0x72, 0xe6, 0xc7, 0x55, 0x39,
+	0xf3, 0xf6, 0x31, 0xc2, 0xec, 0x24, 0x30, 0x55, 0x8b, 0x38, 0x9a, 0x45, 0xa8, 0x43, 0xa8, 0x86,
+	0x4d, 0x6b, 0x07, 0x11, 0xad, 0xfd, 0x48, 0x73, 0x88, 0x1d, 0xb4, 0x20, 0xe5, 0x3f, 0x08, 0x0f,
+	0x6b, 0x3b, 0x83, 0x7f, 0x04, 0xd6, 0xf1, 0x20, 0x35, 0x67, 0xc3, 0x2f, 0x77, 0xf7, 0xd7, 0x00,
+	0xf5, 0x81, 0xd7, 0x21, 0x43, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -438,6 +537,8 @@ type MsgClient interface {
 	UpgradeClient(ctx context.Context, in *MsgUpgradeClient, opts ...grpc.CallOption) (*MsgUpgradeClientResponse, error)
 	// SubmitMisbehaviour defines a rpc handler method for MsgSubmitMisbehaviour.
 	SubmitMisbehaviour(ctx context.Context, in *MsgSubmitMisbehaviour, opts ...grpc.CallOption) (*MsgSubmitMisbehaviourResponse, error)
+	// PruneExpiredConsensusStates defines a rpc handler method for MsgPruneExpiredConsensusStates.
+	PruneExpiredConsensusStates(ctx context.Context, in *MsgPruneExpiredConsensusStates, opts ...grpc.CallOption) (*MsgPruneExpiredConsensusStatesResponse, error)
 }
 
 type msgClient struct {
@@ -484,6 +585,15 @@ func (c *msgClient) SubmitMisbehaviour(ctx context.Context, in *MsgSubmitMisbeha
 	return out, nil
 }
 
+func (c *msgClient) PruneExpiredConsensusStates(ctx context.Context, in *MsgPruneExpiredConsensusStates, opts ...grpc.CallOption) (*MsgPruneExpiredConsensusStatesResponse, error) {
+	out := new(MsgPruneExpiredConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/PruneExpiredConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClient defines a rpc handler method for MsgCreateClient.
@@ -494,6 +604,8 @@ type MsgServer interface {
 	UpgradeClient(context.Context, *MsgUpgradeClient) (*MsgUpgradeClientResponse, error)
 	// SubmitMisbehaviour defines a rpc handler method for MsgSubmitMisbehaviour.
 	SubmitMisbehaviour(context.Context, *MsgSubmitMisbehaviour) (*MsgSubmitMisbehaviourResponse, error)
+	// PruneExpiredConsensusStates defines a rpc handler method for MsgPruneExpiredConsensusStates.
+	PruneExpiredConsensusStates(context.Context, *MsgPruneExpiredConsensusStates) (*MsgPruneExpiredConsensusStatesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -512,6 +624,9 @@ func (*UnimplementedMsgServer) UpgradeClient(ctx context.Context, req *MsgUpgrad
 func (*UnimplementedMsgServer) SubmitMisbehaviour(ctx context.Context, req *MsgSubmitMisbehaviour) (*MsgSubmitMisbehaviourResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitMisbehaviour not implemented")
 }
+func (*UnimplementedMsgServer) PruneExpiredConsensusStates(ctx context.Context, req *MsgPruneExpiredConsensusStates) (*MsgPruneExpiredConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredConsensusStates not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -589,6 +704,24 @@ func _Msg_SubmitMisbehaviour_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PruneExpiredConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneExpiredConsensusStates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneExpiredConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/PruneExpiredConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneExpiredConsensusStates(ctx, req.(*MsgPruneExpiredConsensusStates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.client.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -609,6 +742,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitMisbehaviour",
 			Handler:    _Msg_SubmitMisbehaviour_Handler,
 		},
+		{
+			MethodName: "PruneExpiredConsensusStates",
+			Handler:    _Msg_PruneExpiredConsensusStates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/client/v1/tx.proto",
@@ -933,6 +1070,71 @@ func (m *MsgSubmitMisbehaviourResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPruneExpiredConsensusStates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneExpiredConsensusStates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneExpiredConsensusStates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneExpiredConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneExpiredConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneExpiredConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPruned != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalPruned))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1076,6 +1278,35 @@ func (m *MsgSubmitMisbehaviourResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPruneExpiredConsensusStates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneExpiredConsensusStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalPruned != 0 {
+		n += 1 + sovTx(uint64(m.TotalPruned))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1990,6 +2221,189 @@ func (m *MsgSubmitMisbehaviourResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPruneExpiredConsensusStates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneExpiredConsensusStates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneExpiredConsensusStates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneExpiredConsensusStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneExpiredConsensusStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneExpiredConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPruned", wireType)
+			}
+			m.TotalPruned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPruned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v6/modules/core/03-connection/keeper"
 	"github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
 // InitGenesis initializes the ibc connection submodule's state from a provided genesis
@@ -22,9 +23,17 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 }
 
 // ExportGenesis returns the ibc connection submodule's exported genesis.
+// The sentinel localhost connection is omitted as it is created on genesis initialization.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	var connections []types.IdentifiedConnection
+	for _, connection := range k.GetAllConnections(ctx) {
+		if connection.Id != exported.LocalhostConnectionID {
+			connections = append(connections, connection)
+		}
+	}
+
 	return types.GenesisState{
-		Connections:            k.GetAllConnections(ctx),
+		Connections:            connections,
 		ClientConnectionPaths:  k.GetAllClientConnectionPaths(ctx),
 		NextConnectionSequence: k.GetNextConnectionSequence(ctx),
 		Params:                 k.GetParams(ctx),
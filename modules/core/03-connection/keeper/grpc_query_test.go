@@ -107,6 +107,13 @@ func (suite *KeeperTestSuite) TestQueryConnections() {
 		{
 			"empty pagination",
 			func() {
+				// the sentinel localhost connection is created on genesis initialization
+				connection, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetConnection(suite.chainA.GetContext(), exported.LocalhostConnectionID)
+				suite.Require().True(found)
+
+				localhostConnection := types.NewIdentifiedConnection(exported.LocalhostConnectionID, connection)
+				expConnections = []*types.IdentifiedConnection{&localhostConnection}
+
 				req = &types.QueryConnectionsRequest{}
 			},
 			true,
@@ -23,9 +23,13 @@ func (k Keeper) ConnOpenInit(
 	version *types.Version,
 	delayPeriod uint64,
 ) (string, error) {
-	versions := types.GetCompatibleVersions()
+	if clientID == exported.LocalhostClientID {
+		return "", sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "cannot initialise a connection with the %s client, use the %s connection instead", exported.LocalhostClientID, exported.LocalhostConnectionID)
+	}
+
+	versions := k.GetVersionNegotiator().GetCompatibleVersions()
 	if version != nil {
-		if !types.IsSupportedVersion(types.GetCompatibleVersions(), version) {
+		if !types.IsSupportedVersion(versions, version) {
 			return "", sdkerrors.Wrap(types.ErrInvalidVersion, "version is not supported")
 		}
 
@@ -100,13 +104,19 @@ func (k Keeper) ConnOpenTry(
 	expectedConnection := types.NewConnectionEnd(types.INIT, counterparty.ClientId, expectedCounterparty, types.ExportedVersionsToProto(counterpartyVersions), delayPeriod)
 
 	// chain B picks a version from Chain A's available versions that is compatible
-	// with Chain B's supported IBC versions. PickVersion will select the intersection
-	// of the supported versions and the counterparty versions.
-	version, err := types.PickVersion(types.GetCompatibleVersions(), counterpartyVersions)
+	// with Chain B's supported IBC versions. The version negotiator will select the
+	// intersection of the supported versions and the counterparty versions.
+	versionNegotiator := k.GetVersionNegotiator()
+	version, err := versionNegotiator.PickVersion(counterpartyVersions)
 	if err != nil {
 		return "", err
 	}
 
+	// enforce the contract of the version negotiator, a custom negotiator must not pick a version unsupported by either chain
+	if version == nil || !types.IsSupportedVersion(counterpartyVersions, version) || !types.IsSupportedVersion(versionNegotiator.GetCompatibleVersions(), version) {
+		return "", sdkerrors.Wrapf(types.ErrVersionNegotiationFailed, "picked version (%v) is not supported by both chains", version)
+	}
+
 	// connection defines chain B's ConnectionEnd
 	connection := types.NewConnectionEnd(types.TRYOPEN, clientID, counterparty, []*types.Version{version}, delayPeriod)
 
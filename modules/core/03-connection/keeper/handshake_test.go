@@ -4,6 +4,7 @@ import (
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/03-connection/keeper"
 	"github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
@@ -45,6 +46,9 @@ func (suite *KeeperTestSuite) TestConnOpenInit() {
 			// set path.EndpointA.ClientID to invalid client identifier
 			path.EndpointA.ClientID = "clientidentifier"
 		}, false},
+		{"connection handshake with the localhost client is not allowed", func() {
+			path.EndpointA.ClientID = exported.LocalhostClientID
+		}, false},
 	}
 
 	for _, tc := range testCases {
@@ -168,6 +172,24 @@ func (suite *KeeperTestSuite) TestConnOpenTry() {
 			version := types.NewVersion("0.0", nil)
 			versions = []exported.Version{version}
 		}, false},
+		{"version negotiator picks a version not supported by the counterparty", func() {
+			err := path.EndpointA.ConnOpenInit()
+			suite.Require().NoError(err)
+
+			// retrieve client state of chainA to pass as counterpartyClient
+			counterpartyClient = suite.chainA.GetClientState(path.EndpointA.ClientID)
+
+			setVersionNegotiator(suite.chainB, featureVersionNegotiator{version: types.NewVersion("2", []string{"ORDER_UNORDERED"})})
+		}, false},
+		{"version negotiator picks a feature not supported by the counterparty", func() {
+			err := path.EndpointA.ConnOpenInit()
+			suite.Require().NoError(err)
+
+			// retrieve client state of chainA to pass as counterpartyClient
+			counterpartyClient = suite.chainA.GetClientState(path.EndpointA.ClientID)
+
+			setVersionNegotiator(suite.chainB, featureVersionNegotiator{version: types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_UNORDERED", "ORDER_NONE"})})
+		}, false},
 		{"connection state verification failed", func() {
 			// chainA connection not created
 
@@ -569,3 +591,66 @@ func (suite *KeeperTestSuite) TestConnOpenConfirm() {
 		})
 	}
 }
+
+// setVersionNegotiator replaces the connection keeper of the IBC keeper of the provided chain with a
+// connection keeper constructed with the provided version negotiator.
+func setVersionNegotiator(chain *ibctesting.TestChain, versionNegotiator types.VersionNegotiator) {
+	app := chain.GetSimApp()
+	app.IBCKeeper.ConnectionKeeper = keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(host.StoreKey), app.GetSubspace(host.ModuleName), app.IBCKeeper.ClientKeeper,
+		keeper.WithVersionNegotiator(versionNegotiator),
+	)
+}
+
+// featureVersionNegotiator is a VersionNegotiator supporting a single version. The picked version is not
+// checked against the counterparty versions to test the enforcement of the negotiator contract.
+type featureVersionNegotiator struct {
+	version *types.Version
+}
+
+func (vn featureVersionNegotiator) GetCompatibleVersions() []exported.Version {
+	return []exported.Version{vn.version}
+}
+
+func (vn featureVersionNegotiator) PickVersion(_ []exported.Version) (*types.Version, error) {
+	return vn.version, nil
+}
+
+// TestVersionNegotiator tests the negotiation of the connection version with the default version negotiator
+// and with a custom version negotiator set on chainB.
+func (suite *KeeperTestSuite) TestVersionNegotiator() {
+	var (
+		path       *ibctesting.Path
+		expVersion *types.Version
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{"success: default version negotiator", func() {}},
+		{"success: custom version negotiator", func() {
+			expVersion = types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_UNORDERED"})
+			setVersionNegotiator(suite.chainB, featureVersionNegotiator{version: expVersion})
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expVersion = types.DefaultIBCVersion
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			tc.malleate()
+
+			suite.coordinator.CreateConnections(path)
+
+			suite.Require().Equal([]*types.Version{expVersion}, path.EndpointA.GetConnection().Versions)
+			suite.Require().Equal([]*types.Version{expVersion}, path.EndpointB.GetConnection().Versions)
+		})
+	}
+}
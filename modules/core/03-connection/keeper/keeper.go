@@ -24,21 +24,51 @@ type Keeper struct {
 	paramSpace   paramtypes.Subspace
 	cdc          codec.BinaryCodec
 	clientKeeper types.ClientKeeper
+
+	// versionNegotiator is the hook used by the connection handshake to negotiate connection versions, optional
+	versionNegotiator types.VersionNegotiator
+}
+
+// Option configures optional behaviour of the connection Keeper
+type Option func(*Keeper)
+
+// WithVersionNegotiator sets the hook used by the connection handshake to advertise the supported
+// connection versions and to select the version of a connection from the counterparty versions.
+// The DefaultVersionNegotiator is used if no hook is set.
+func WithVersionNegotiator(versionNegotiator types.VersionNegotiator) Option {
+	return func(k *Keeper) {
+		k.versionNegotiator = versionNegotiator
+	}
 }
 
 // NewKeeper creates a new IBC connection Keeper instance
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace, ck types.ClientKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace, ck types.ClientKeeper, opts ...Option) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return Keeper{
+	keeper := Keeper{
 		storeKey:     key,
 		cdc:          cdc,
 		paramSpace:   paramSpace,
 		clientKeeper: ck,
 	}
+
+	for _, opt := range opts {
+		opt(&keeper)
+	}
+
+	return keeper
+}
+
+// GetVersionNegotiator returns the hook used by the connection handshake to negotiate connection versions.
+func (k Keeper) GetVersionNegotiator() types.VersionNegotiator {
+	if k.versionNegotiator == nil {
+		return types.DefaultVersionNegotiator{}
+	}
+
+	return k.versionNegotiator
 }
 
 // Logger returns a module-specific logger.
@@ -83,6 +113,16 @@ func (k Keeper) SetConnection(ctx sdk.Context, connectionID string, connection t
 	store.Set(host.ConnectionKey(connectionID), bz)
 }
 
+// CreateSentinelLocalhostConnection creates and sets the sentinel localhost connection end in the IBC store.
+// The localhost connection is in the OPEN state and uses the 09-localhost client on both ends, allowing
+// channel handshakes between applications of the executing chain to be self-relayed.
+func (k Keeper) CreateSentinelLocalhostConnection(ctx sdk.Context) {
+	counterparty := types.NewCounterparty(exported.LocalhostClientID, exported.LocalhostConnectionID, commitmenttypes.NewMerklePrefix(k.GetCommitmentPrefix().Bytes()))
+	connectionEnd := types.NewConnectionEnd(types.OPEN, exported.LocalhostClientID, counterparty, types.ExportedVersionsToProto(k.GetVersionNegotiator().GetCompatibleVersions()), 0)
+
+	k.SetConnection(ctx, exported.LocalhostConnectionID, connectionEnd)
+}
+
 // GetTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the
 // given height.
 func (k Keeper) GetTimestampAtHeight(ctx sdk.Context, connection types.ConnectionEnd, height exported.Height) (uint64, error) {
@@ -79,7 +79,12 @@ func (suite KeeperTestSuite) TestGetAllConnections() {
 	iconn1 := types.NewIdentifiedConnection(path1.EndpointA.ConnectionID, conn1)
 	iconn2 := types.NewIdentifiedConnection(path2.EndpointA.ConnectionID, conn2)
 
-	expConnections := []types.IdentifiedConnection{iconn1, iconn2}
+	// the sentinel localhost connection is created on genesis initialization
+	localhostCounterparty := types.NewCounterparty(exported.LocalhostClientID, exported.LocalhostConnectionID, suite.chainA.GetPrefix())
+	localhostConn := types.NewConnectionEnd(types.OPEN, exported.LocalhostClientID, localhostCounterparty, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0)
+	iconnLocalhost := types.NewIdentifiedConnection(exported.LocalhostConnectionID, localhostConn)
+
+	expConnections := []types.IdentifiedConnection{iconn1, iconn2, iconnLocalhost}
 
 	connections := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetAllConnections(suite.chainA.GetContext())
 	suite.Require().Len(connections, len(expConnections))
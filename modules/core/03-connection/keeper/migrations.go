@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateParams initializes the connection parameters with their default values if the maximum
+// expected time per block has not been set in the paramstore. Parameters which have already been
+// set are left unchanged.
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	if m.keeper.paramSpace.Has(ctx, types.KeyMaxExpectedTimePerBlock) {
+		return nil
+	}
+
+	params := types.DefaultParams()
+	m.keeper.SetParams(ctx, params)
+
+	m.keeper.Logger(ctx).Info("successfully initialized connection params", "max expected time per block", params.MaxExpectedTimePerBlock)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v6/modules/core/03-connection/keeper"
+	"github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// TestMigrateParams tests that the connection parameters are initialized with their default values
+// if they have not been set and are otherwise left unchanged.
+func (suite *KeeperTestSuite) TestMigrateParams() {
+	var connectionKeeper keeper.Keeper
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expParams types.Params
+	}{
+		{
+			"success: params not set",
+			func() {
+				app := suite.chainA.GetSimApp()
+				subspace := app.ParamsKeeper.Subspace("connection-migration-test")
+				connectionKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(host.StoreKey), subspace, app.IBCKeeper.ClientKeeper)
+			},
+			types.DefaultParams(),
+		},
+		{
+			"success: params already set",
+			func() {
+				connectionKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(10))
+			},
+			types.NewParams(10),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			connectionKeeper = suite.chainA.App.GetIBCKeeper().ConnectionKeeper
+
+			tc.malleate()
+
+			migrator := keeper.NewMigrator(connectionKeeper)
+			suite.Require().NoError(migrator.MigrateParams(suite.chainA.GetContext()))
+
+			suite.Require().Equal(tc.expParams, connectionKeeper.GetParams(suite.chainA.GetContext()))
+		})
+	}
+}
@@ -30,7 +30,7 @@ func TestValidateGenesis(t *testing.T) {
 				[]types.ConnectionPaths{
 					{clientID, []string{connectionID}},
 				},
-				0,
+				1,
 				types.DefaultParams(),
 			),
 			expPass: true,
@@ -105,6 +105,20 @@ func TestValidateGenesis(t *testing.T) {
 			),
 			expPass: false,
 		},
+		{
+			name: "next connection sequence equal to the sequence of the only connection",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedConnection{
+					types.NewIdentifiedConnection(connectionID, types.NewConnectionEnd(types.INIT, clientID, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, []*types.Version{ibctesting.ConnectionVersion}, 500)),
+				},
+				[]types.ConnectionPaths{
+					{clientID, []string{connectionID}},
+				},
+				0,
+				types.DefaultParams(),
+			),
+			expPass: false,
+		},
 		{
 			name: "invalid params",
 			genState: types.NewGenesisState(
@@ -16,6 +16,7 @@ var (
 	_ sdk.Msg = &MsgConnectionOpenConfirm{}
 	_ sdk.Msg = &MsgConnectionOpenAck{}
 	_ sdk.Msg = &MsgConnectionOpenTry{}
+	_ sdk.Msg = &MsgUpdateConnectionParams{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgConnectionOpenTry{}
 	_ codectypes.UnpackInterfacesMessage = MsgConnectionOpenAck{}
@@ -289,3 +290,34 @@ func (msg MsgConnectionOpenConfirm) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{accAddr}
 }
+
+// NewMsgUpdateConnectionParams creates a new MsgUpdateConnectionParams instance
+//
+//nolint:interfacer
+func NewMsgUpdateConnectionParams(authority string, params Params) *MsgUpdateConnectionParams {
+	return &MsgUpdateConnectionParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateConnectionParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateConnectionParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
@@ -238,3 +238,26 @@ func (suite *MsgTestSuite) TestNewMsgConnectionOpenConfirm() {
 		}
 	}
 }
+
+func (suite *MsgTestSuite) TestMsgUpdateConnectionParamsValidateBasic() {
+	authority := suite.chainA.App.GetIBCKeeper().GetAuthority()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateConnectionParams
+		expPass bool
+	}{
+		{"success", types.NewMsgUpdateConnectionParams(authority, types.DefaultParams()), true},
+		{"invalid authority", types.NewMsgUpdateConnectionParams("invalid", types.DefaultParams()), false},
+		{"zero max expected time per block", types.NewMsgUpdateConnectionParams(authority, types.NewParams(0)), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -373,6 +373,85 @@ func (m *MsgConnectionOpenConfirmResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConnectionOpenConfirmResponse proto.InternalMessageInfo
 
+// MsgUpdateConnectionParams defines the request type for the UpdateConnectionParams rpc. It updates the
+// ibc connection parameters and may only be executed by the governance authority.
+type MsgUpdateConnectionParams struct {
+	// the governance module account address
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the connection parameters to be set, all parameters must be supplied
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateConnectionParams) Reset()         { *m = MsgUpdateConnectionParams{} }
+func (m *MsgUpdateConnectionParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateConnectionParams) ProtoMessage()    {}
+func (*MsgUpdateConnectionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{8}
+}
+func (m *MsgUpdateConnectionParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateConnectionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateConnectionParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateConnectionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateConnectionParams.Merge(m, src)
+}
+func (m *MsgUpdateConnectionParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateConnectionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateConnectionParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateConnectionParams proto.InternalMessageInfo
+
+// MsgUpdateConnectionParamsResponse defines the response type for the UpdateConnectionParams rpc.
+type MsgUpdateConnectionParamsResponse struct {
+}
+
+func (m *MsgUpdateConnectionParamsResponse) Reset()         { *m = MsgUpdateConnectionParamsResponse{} }
+func (m *MsgUpdateConnectionParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateConnectionParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateConnectionParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{9}
+}
+func (m *MsgUpdateConnectionParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateConnectionParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateConnectionParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateConnectionParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateConnectionParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateConnectionParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateConnectionParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateConnectionParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateConnectionParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConnectionOpenInit)(nil), "ibc.core.connection.v1.MsgConnectionOpenInit")
 	proto.RegisterType((*MsgConnectionOpenInitResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenInitResponse")
@@ -382,71 +461,77 @@ func init() {
 	proto.RegisterType((*MsgConnectionOpenAckResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenAckResponse")
 	proto.RegisterType((*MsgConnectionOpenConfirm)(nil), "ibc.core.connection.v1.MsgConnectionOpenConfirm")
 	proto.RegisterType((*MsgConnectionOpenConfirmResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenConfirmResponse")
+	proto.RegisterType((*MsgUpdateConnectionParams)(nil), "ibc.core.connection.v1.MsgUpdateConnectionParams")
+	proto.RegisterType((*MsgUpdateConnectionParamsResponse)(nil), "ibc.core.connection.v1.MsgUpdateConnectionParamsResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/connection/v1/tx.proto", fileDescriptor_5d00fde5fc97399e) }
 
 var fileDescriptor_5d00fde5fc97399e = []byte{
-	// 929 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x31, 0x73, 0xe3, 0x44,
-	0x14, 0xb6, 0x62, 0x27, 0xb1, 0xd7, 0x86, 0xbb, 0x5b, 0x9c, 0x44, 0x88, 0x3b, 0xcb, 0x08, 0x18,
-	0x52, 0x10, 0xe9, 0x7c, 0x77, 0x30, 0x90, 0x81, 0x22, 0x76, 0x43, 0x8a, 0x83, 0x1b, 0x71, 0x73,
-	0x33, 0x5c, 0xe3, 0xb1, 0xe5, 0x8d, 0xb2, 0x63, 0x5b, 0xab, 0xd1, 0xca, 0x06, 0xd1, 0xd2, 0x30,
-	0x54, 0x74, 0xb4, 0xf7, 0x1f, 0xf8, 0x13, 0x57, 0xa6, 0xa4, 0xd2, 0x40, 0xd2, 0x50, 0xab, 0xa3,
-	0x63, 0xb4, 0x2b, 0xc9, 0x6b, 0x5b, 0x1e, 0x6c, 0x1c, 0xba, 0x7d, 0xfb, 0xbe, 0xf7, 0xde, 0xee,
-	0x7b, 0xdf, 0xb7, 0xb3, 0x40, 0xc5, 0x7d, 0xcb, 0xb0, 0x88, 0x87, 0x0c, 0x8b, 0x38, 0x0e, 0xb2,
-	0x7c, 0x4c, 0x1c, 0x63, 0xda, 0x32, 0xfc, 0xef, 0x75, 0xd7, 0x23, 0x3e, 0x81, 0x87, 0xb8, 0x6f,
-	0xe9, 0x31, 0x40, 0x9f, 0x01, 0xf4, 0x69, 0x4b, 0xa9, 0xdb, 0xc4, 0x26, 0x0c, 0x62, 0xc4, 0x2b,
-	0x8e, 0x56, 0xde, 0xb6, 0x09, 0xb1, 0x47, 0xc8, 0x60, 0x56, 0x7f, 0x72, 0x61, 0xf4, 0x9c, 0x20,
-	0x71, 0x09, 0x95, 0x46, 0x18, 0x39, 0x7e, 0x5c, 0x85, 0xaf, 0x12, 0xc0, 0x87, 0x2b, 0x8e, 0x22,
-	0xd4, 0x65, 0x40, 0xed, 0xb7, 0x1d, 0x70, 0xf0, 0x94, 0xda, 0x9d, 0x6c, 0xff, 0x6b, 0x17, 0x39,
-	0xe7, 0x0e, 0xf6, 0x61, 0x0b, 0x54, 0x78, 0xca, 0x2e, 0x1e, 0xc8, 0x52, 0x53, 0x3a, 0xae, 0xb4,
-	0xeb, 0x51, 0xa8, 0xde, 0x0d, 0x7a, 0xe3, 0xd1, 0xa9, 0x96, 0xb9, 0x34, 0xb3, 0xcc, 0xd7, 0xe7,
-	0x03, 0xf8, 0x15, 0xa8, 0x59, 0x64, 0xe2, 0xf8, 0xc8, 0x73, 0x7b, 0x9e, 0x1f, 0xc8, 0x3b, 0x4d,
-	0xe9, 0xb8, 0xfa, 0xe8, 0x7d, 0x3d, 0xff, 0xda, 0x7a, 0x47, 0xc0, 0xb6, 0x4b, 0xaf, 0x43, 0xb5,
-	0x60, 0xce, 0xc5, 0xc3, 0xcf, 0xc0, 0xfe, 0x14, 0x79, 0x14, 0x13, 0x47, 0x2e, 0xb2, 0x54, 0xea,
-	0xaa, 0x54, 0x2f, 0x38, 0xcc, 0x4c, 0xf1, 0xf0, 0x14, 0xd4, 0x06, 0x68, 0xd4, 0x0b, 0xba, 0x2e,
-	0xf2, 0x30, 0x19, 0xc8, 0xa5, 0xa6, 0x74, 0x5c, 0x6a, 0x1f, 0x45, 0xa1, 0xfa, 0x16, 0xbf, 0x80,
-	0xe8, 0xd5, 0xcc, 0x2a, 0x33, 0x9f, 0x31, 0x0b, 0x1e, 0x82, 0x3d, 0x8a, 0x6d, 0x07, 0x79, 0xf2,
-	0x6e, 0x7c, 0x6d, 0x33, 0xb1, 0x4e, 0xcb, 0x3f, 0xbd, 0x52, 0x0b, 0x7f, 0xbd, 0x52, 0x0b, 0x9a,
-	0x0a, 0x1e, 0xe4, 0x36, 0xcd, 0x44, 0xd4, 0x25, 0x0e, 0x45, 0xda, 0xaf, 0xfb, 0xa0, 0xbe, 0x84,
-	0x78, 0xee, 0x05, 0xff, 0xa5, 0xab, 0xdf, 0x82, 0x43, 0xd7, 0x43, 0x53, 0x4c, 0x26, 0xb4, 0x3b,
-	0xbb, 0x75, 0x1c, 0xbf, 0xc3, 0xe2, 0xdf, 0x8b, 0x42, 0xf5, 0x01, 0x8f, 0xcf, 0xc7, 0x69, 0xb2,
-	0x64, 0xd6, 0x53, 0xd7, 0xec, 0x48, 0xe7, 0x03, 0xf8, 0x0c, 0xd4, 0x92, 0x92, 0xd4, 0xef, 0xf9,
-	0x28, 0xe9, 0x72, 0x5d, 0xe7, 0xcc, 0xd3, 0x53, 0xe6, 0xe9, 0x67, 0x4e, 0x20, 0xf6, 0x4e, 0x8c,
-	0xd1, 0xcc, 0x2a, 0x37, 0xbf, 0x89, 0xad, 0x25, 0x0a, 0x94, 0xb6, 0xa4, 0xc0, 0xe2, 0x1c, 0x77,
-	0x37, 0x98, 0xe3, 0x14, 0x1c, 0x88, 0xb9, 0xba, 0x09, 0x37, 0xa8, 0xbc, 0xd7, 0x2c, 0xae, 0x41,
-	0xa6, 0x76, 0x33, 0x0a, 0xd5, 0xfb, 0xc9, 0x8d, 0xf3, 0xf2, 0x68, 0x66, 0x5d, 0xdc, 0x4f, 0xc2,
-	0x28, 0x7c, 0x09, 0x6a, 0xae, 0x47, 0xc8, 0x45, 0xf7, 0x12, 0x61, 0xfb, 0xd2, 0x97, 0xf7, 0x59,
-	0x0f, 0x14, 0xa1, 0x1c, 0x97, 0xea, 0xb4, 0xa5, 0x7f, 0xc9, 0x10, 0xed, 0x77, 0xe2, 0x9b, 0xcf,
-	0xee, 0x24, 0x46, 0x6b, 0x66, 0x95, 0x99, 0x1c, 0x09, 0x9f, 0x00, 0xc0, 0xbd, 0xd8, 0xc1, 0xbe,
-	0x5c, 0x6e, 0x4a, 0xc7, 0xb5, 0xf6, 0x41, 0x14, 0xaa, 0xf7, 0xc4, 0xc8, 0xd8, 0xa7, 0x99, 0x15,
-	0x66, 0x30, 0x2d, 0x9f, 0xa6, 0x27, 0xe2, 0x95, 0xe5, 0x0a, 0x8b, 0x3b, 0x5a, 0xac, 0xc8, 0xbd,
-	0x69, 0xc5, 0x0e, 0xb3, 0x60, 0x07, 0xdc, 0x49, 0xbc, 0x31, 0xb3, 0x1d, 0x3a, 0xa1, 0x32, 0x60,
-	0xe1, 0x4a, 0x14, 0xaa, 0x87, 0x73, 0xe1, 0x29, 0x40, 0x33, 0xdf, 0xe4, 0x19, 0xd2, 0x0d, 0x78,
-	0x01, 0xee, 0x66, 0xde, 0xb4, 0x2d, 0xd5, 0x7f, 0x6d, 0x8b, 0x9a, 0xb4, 0xe5, 0x28, 0x1d, 0xc2,
-	0x7c, 0x06, 0xcd, 0xbc, 0x93, 0x6d, 0x25, 0xed, 0x99, 0x49, 0xb7, 0xb6, 0x42, 0xba, 0x0d, 0x70,
-	0x3f, 0x4f, 0x98, 0x99, 0x72, 0xff, 0xdc, 0xcd, 0x51, 0xee, 0x99, 0x35, 0x84, 0x5f, 0x80, 0x37,
-	0xe6, 0xd5, 0xc7, 0xd5, 0x2b, 0x47, 0xa1, 0x5a, 0xcf, 0xce, 0x27, 0x88, 0x2e, 0x26, 0xb2, 0x20,
-	0x35, 0x0b, 0x28, 0x73, 0x24, 0xca, 0x53, 0xf2, 0x07, 0x51, 0xa8, 0xbe, 0x9b, 0x43, 0xb8, 0x85,
-	0xc4, 0xb2, 0xe8, 0x9c, 0xd3, 0xf3, 0x16, 0x0f, 0xe6, 0xe2, 0x53, 0x50, 0xda, 0xfa, 0x29, 0x58,
-	0x94, 0xc1, 0xee, 0x2d, 0xca, 0xa0, 0x05, 0x38, 0xbb, 0xbb, 0xbe, 0x17, 0xc8, 0x7b, 0x8c, 0x8e,
-	0xc2, 0x33, 0x9a, 0xb9, 0x34, 0xb3, 0xcc, 0xd6, 0xf1, 0xcb, 0xbb, 0xa8, 0x81, 0xfd, 0xed, 0x34,
-	0x50, 0xbe, 0x15, 0x0d, 0x54, 0xfe, 0x57, 0x0d, 0x80, 0x0d, 0x34, 0x70, 0x66, 0x0d, 0x33, 0x0d,
-	0xfc, 0xbc, 0x03, 0xe4, 0x25, 0x40, 0x87, 0x38, 0x17, 0xd8, 0x1b, 0x6f, 0xab, 0x83, 0x6c, 0x72,
-	0x3d, 0x6b, 0xc8, 0x68, 0x9f, 0x33, 0xb9, 0x9e, 0x35, 0x4c, 0x27, 0x17, 0x2b, 0x6f, 0x91, 0x48,
-	0xc5, 0x5b, 0x24, 0xd2, 0xac, 0x59, 0xa5, 0x15, 0xcd, 0xd2, 0x40, 0x73, 0x55, 0x2f, 0xd2, 0x86,
-	0x3d, 0xfa, 0xbb, 0x08, 0x8a, 0x4f, 0xa9, 0x0d, 0x7f, 0x00, 0x30, 0xe7, 0x27, 0x75, 0xb2, 0x4a,
-	0x84, 0xb9, 0x7f, 0x08, 0xe5, 0xe3, 0x8d, 0xe0, 0xe9, 0x19, 0xe0, 0x77, 0xe0, 0xde, 0xf2, 0x77,
-	0xe3, 0xa3, 0xb5, 0x73, 0x3d, 0xf7, 0x02, 0xe5, 0xc9, 0x26, 0xe8, 0xd5, 0x85, 0xe3, 0x99, 0xad,
-	0x5f, 0xf8, 0xcc, 0x1a, 0x6e, 0x50, 0x58, 0xa0, 0x29, 0xfc, 0x51, 0x02, 0x07, 0xf9, 0x1c, 0x7d,
-	0xb8, 0x76, 0xbe, 0x24, 0x42, 0xf9, 0x74, 0xd3, 0x88, 0xf4, 0x14, 0xed, 0x17, 0xaf, 0xaf, 0x1b,
-	0xd2, 0xd5, 0x75, 0x43, 0xfa, 0xe3, 0xba, 0x21, 0xfd, 0x72, 0xd3, 0x28, 0x5c, 0xdd, 0x34, 0x0a,
-	0xbf, 0xdf, 0x34, 0x0a, 0x2f, 0x3f, 0xb7, 0xb1, 0x7f, 0x39, 0xe9, 0xeb, 0x16, 0x19, 0x1b, 0x16,
-	0xa1, 0x63, 0x42, 0x0d, 0xdc, 0xb7, 0x4e, 0x6c, 0x62, 0x4c, 0x3f, 0x31, 0xc6, 0x64, 0x30, 0x19,
-	0x21, 0xca, 0x3f, 0xe9, 0x0f, 0x1f, 0x9f, 0x08, 0xff, 0x74, 0x3f, 0x70, 0x11, 0xed, 0xef, 0xb1,
-	0x27, 0xf7, 0xf1, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x40, 0x1d, 0x16, 0xb2, 0x56, 0x0c, 0x00,
-	0x00,
+	// 1008 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x73, 0xdb, 0xc4,
+	0x17, 0xb7, 0x1c, 0xdb, 0xb1, 0xd7, 0xfe, 0x7e, 0xdb, 0x2e, 0x8e, 0xa3, 0x8a, 0xd4, 0x72, 0x55,
+	0x18, 0x72, 0x20, 0x52, 0xdd, 0x16, 0x86, 0x66, 0xca, 0x21, 0xf6, 0x85, 0x1c, 0x02, 0x19, 0x51,
+	0x3a, 0x43, 0x2f, 0x1e, 0x59, 0xde, 0x28, 0x1a, 0xdb, 0x5a, 0x8d, 0x56, 0x36, 0x88, 0x1b, 0x30,
+	0xc3, 0x30, 0x9c, 0xb8, 0x71, 0xed, 0xff, 0xc0, 0x3f, 0xd1, 0x63, 0x8f, 0x9c, 0x34, 0x90, 0x5c,
+	0x38, 0xfb, 0x2f, 0x60, 0xb4, 0xab, 0x5f, 0x76, 0x24, 0x88, 0x71, 0xb8, 0xe9, 0xed, 0xfb, 0x7c,
+	0xde, 0x7b, 0xfb, 0xf6, 0x7d, 0xd6, 0x6b, 0x20, 0x9a, 0x43, 0x5d, 0xd1, 0xb1, 0x83, 0x14, 0x1d,
+	0x5b, 0x16, 0xd2, 0x5d, 0x13, 0x5b, 0xca, 0xbc, 0xab, 0xb8, 0x5f, 0xcb, 0xb6, 0x83, 0x5d, 0x0c,
+	0x5b, 0xe6, 0x50, 0x97, 0x03, 0x80, 0x9c, 0x00, 0xe4, 0x79, 0x57, 0x68, 0x1a, 0xd8, 0xc0, 0x14,
+	0xa2, 0x04, 0x5f, 0x0c, 0x2d, 0xdc, 0x35, 0x30, 0x36, 0x26, 0x48, 0xa1, 0xd6, 0x70, 0x76, 0xa6,
+	0x68, 0x96, 0x17, 0xba, 0x52, 0x99, 0x26, 0x26, 0xb2, 0xdc, 0x20, 0x0b, 0xfb, 0x0a, 0x01, 0xef,
+	0xe5, 0x94, 0x92, 0xca, 0x4b, 0x81, 0xd2, 0xaf, 0x45, 0xb0, 0x73, 0x42, 0x8c, 0x7e, 0xbc, 0xfe,
+	0x99, 0x8d, 0xac, 0x63, 0xcb, 0x74, 0x61, 0x17, 0xd4, 0x58, 0xc8, 0x81, 0x39, 0xe2, 0xb9, 0x0e,
+	0xb7, 0x5f, 0xeb, 0x35, 0x17, 0xbe, 0x78, 0xdb, 0xd3, 0xa6, 0x93, 0x43, 0x29, 0x76, 0x49, 0x6a,
+	0x95, 0x7d, 0x1f, 0x8f, 0xe0, 0xa7, 0xa0, 0xa1, 0xe3, 0x99, 0xe5, 0x22, 0xc7, 0xd6, 0x1c, 0xd7,
+	0xe3, 0x8b, 0x1d, 0x6e, 0xbf, 0xfe, 0xe8, 0x1d, 0x39, 0x7b, 0xdb, 0x72, 0x3f, 0x85, 0xed, 0x95,
+	0x5e, 0xfb, 0x62, 0x41, 0x5d, 0xe2, 0xc3, 0xa7, 0x60, 0x7b, 0x8e, 0x1c, 0x62, 0x62, 0x8b, 0xdf,
+	0xa2, 0xa1, 0xc4, 0xbc, 0x50, 0x2f, 0x18, 0x4c, 0x8d, 0xf0, 0xf0, 0x10, 0x34, 0x46, 0x68, 0xa2,
+	0x79, 0x03, 0x1b, 0x39, 0x26, 0x1e, 0xf1, 0xa5, 0x0e, 0xb7, 0x5f, 0xea, 0xed, 0x2e, 0x7c, 0xf1,
+	0x2d, 0xb6, 0x81, 0xb4, 0x57, 0x52, 0xeb, 0xd4, 0x3c, 0xa5, 0x16, 0x6c, 0x81, 0x0a, 0x31, 0x0d,
+	0x0b, 0x39, 0x7c, 0x39, 0xd8, 0xb6, 0x1a, 0x5a, 0x87, 0xd5, 0x1f, 0x5f, 0x89, 0x85, 0x3f, 0x5f,
+	0x89, 0x05, 0x49, 0x04, 0xf7, 0x32, 0x9b, 0xa6, 0x22, 0x62, 0x63, 0x8b, 0x20, 0xe9, 0x97, 0x6d,
+	0xd0, 0xbc, 0x82, 0x78, 0xee, 0x78, 0xff, 0xa6, 0xab, 0x5f, 0x82, 0x96, 0xed, 0xa0, 0xb9, 0x89,
+	0x67, 0x64, 0x90, 0xec, 0x3a, 0xe0, 0x17, 0x29, 0xff, 0xc1, 0xc2, 0x17, 0xef, 0x31, 0x7e, 0x36,
+	0x4e, 0xe2, 0x39, 0xb5, 0x19, 0xb9, 0x92, 0x92, 0x8e, 0x47, 0xf0, 0x14, 0x34, 0xc2, 0x94, 0xc4,
+	0xd5, 0x5c, 0x14, 0x76, 0xb9, 0x29, 0xb3, 0xc9, 0x93, 0xa3, 0xc9, 0x93, 0x8f, 0x2c, 0x2f, 0xdd,
+	0xbb, 0x34, 0x47, 0x52, 0xeb, 0xcc, 0xfc, 0x3c, 0xb0, 0xae, 0x8c, 0x40, 0x69, 0xc3, 0x11, 0x58,
+	0x3d, 0xc7, 0xf2, 0x1a, 0xe7, 0x38, 0x07, 0x3b, 0xe9, 0x58, 0x83, 0x70, 0x36, 0x08, 0x5f, 0xe9,
+	0x6c, 0x5d, 0x63, 0x98, 0x7a, 0x9d, 0x85, 0x2f, 0xee, 0x85, 0x3b, 0xce, 0x8a, 0x23, 0xa9, 0xcd,
+	0xf4, 0x7a, 0x48, 0x23, 0xf0, 0x25, 0x68, 0xd8, 0x0e, 0xc6, 0x67, 0x83, 0x73, 0x64, 0x1a, 0xe7,
+	0x2e, 0xbf, 0x4d, 0x7b, 0x20, 0xa4, 0xd2, 0x31, 0xa9, 0xce, 0xbb, 0xf2, 0x27, 0x14, 0xd1, 0x7b,
+	0x3b, 0xd8, 0x79, 0xb2, 0xa7, 0x34, 0x5b, 0x52, 0xeb, 0xd4, 0x64, 0x48, 0xf8, 0x04, 0x00, 0xe6,
+	0x35, 0x2d, 0xd3, 0xe5, 0xab, 0x1d, 0x6e, 0xbf, 0xd1, 0xdb, 0x59, 0xf8, 0xe2, 0x9d, 0x34, 0x33,
+	0xf0, 0x49, 0x6a, 0x8d, 0x1a, 0x54, 0xcb, 0x87, 0x51, 0x45, 0x2c, 0x33, 0x5f, 0xa3, 0xbc, 0xdd,
+	0xd5, 0x8c, 0xcc, 0x1b, 0x65, 0xec, 0x53, 0x0b, 0xf6, 0xc1, 0xad, 0xd0, 0x1b, 0x4c, 0xb6, 0x45,
+	0x66, 0x84, 0x07, 0x94, 0x2e, 0x2c, 0x7c, 0xb1, 0xb5, 0x44, 0x8f, 0x00, 0x92, 0xfa, 0x7f, 0x16,
+	0x21, 0x5a, 0x80, 0x67, 0xe0, 0x76, 0xec, 0x8d, 0xda, 0x52, 0xff, 0xc7, 0xb6, 0x88, 0x61, 0x5b,
+	0x76, 0xa3, 0x43, 0x58, 0x8e, 0x20, 0xa9, 0xb7, 0xe2, 0xa5, 0xb0, 0x3d, 0x89, 0x74, 0x1b, 0x39,
+	0xd2, 0x6d, 0x83, 0xbd, 0x2c, 0x61, 0xc6, 0xca, 0xfd, 0xa3, 0x9c, 0xa1, 0xdc, 0x23, 0x7d, 0x0c,
+	0x3f, 0x06, 0xff, 0x5b, 0x56, 0x1f, 0x53, 0x2f, 0xbf, 0xf0, 0xc5, 0x66, 0x5c, 0x5f, 0xe2, 0x96,
+	0xd4, 0x46, 0x62, 0x1f, 0x8f, 0xa0, 0x0e, 0x84, 0xa5, 0x21, 0xca, 0x52, 0xf2, 0xbb, 0x0b, 0x5f,
+	0xbc, 0x9f, 0x31, 0x70, 0x2b, 0x81, 0xf9, 0xb4, 0x73, 0x49, 0xcf, 0x1b, 0x5c, 0x98, 0xab, 0x57,
+	0x41, 0x69, 0xe3, 0xab, 0x60, 0x55, 0x06, 0xe5, 0x1b, 0x94, 0x41, 0x17, 0xb0, 0xe9, 0x1e, 0xb8,
+	0x8e, 0xc7, 0x57, 0xe8, 0x38, 0xa6, 0xae, 0xd1, 0xd8, 0x25, 0xa9, 0x55, 0xfa, 0x1d, 0xdc, 0xbc,
+	0xab, 0x1a, 0xd8, 0xde, 0x4c, 0x03, 0xd5, 0x1b, 0xd1, 0x40, 0xed, 0x3f, 0xd5, 0x00, 0x58, 0x43,
+	0x03, 0x47, 0xfa, 0x38, 0xd6, 0xc0, 0x4f, 0x45, 0xc0, 0x5f, 0x01, 0xf4, 0xb1, 0x75, 0x66, 0x3a,
+	0xd3, 0x4d, 0x75, 0x10, 0x9f, 0x9c, 0xa6, 0x8f, 0xf9, 0x62, 0xf6, 0xc9, 0x69, 0xfa, 0x38, 0x3a,
+	0xb9, 0x40, 0x79, 0xab, 0x83, 0xb4, 0x75, 0x83, 0x83, 0x94, 0x34, 0xab, 0x94, 0xd3, 0x2c, 0x09,
+	0x74, 0xf2, 0x7a, 0x11, 0x37, 0xec, 0x5b, 0x0e, 0xdc, 0x3d, 0x21, 0xc6, 0x17, 0xf6, 0x48, 0x73,
+	0x51, 0x02, 0x3d, 0xd5, 0x1c, 0x6d, 0x4a, 0xe0, 0x1e, 0xa8, 0x69, 0x33, 0xf7, 0x1c, 0x3b, 0xa6,
+	0xeb, 0xb1, 0x6e, 0xa9, 0xc9, 0x02, 0x7c, 0x06, 0x2a, 0x36, 0xc5, 0x85, 0xcf, 0xa5, 0x76, 0x9e,
+	0x64, 0x59, 0xb4, 0xf0, 0x57, 0x32, 0xe4, 0xa4, 0xea, 0x7c, 0x00, 0xee, 0xe7, 0x96, 0x10, 0x15,
+	0xfa, 0xe8, 0xbb, 0x32, 0xd8, 0x3a, 0x21, 0x06, 0xfc, 0x06, 0xc0, 0x8c, 0x27, 0xdf, 0x41, 0x5e,
+	0xea, 0xcc, 0xc7, 0x8e, 0xf0, 0xc1, 0x5a, 0xf0, 0xa8, 0x06, 0xf8, 0x15, 0xb8, 0x73, 0xf5, 0x5d,
+	0xf4, 0xfe, 0xb5, 0x63, 0x3d, 0x77, 0x3c, 0xe1, 0xc9, 0x3a, 0xe8, 0xfc, 0xc4, 0xc1, 0x70, 0x5d,
+	0x3f, 0xf1, 0x91, 0x3e, 0x5e, 0x23, 0x71, 0x4a, 0x4f, 0xf0, 0x7b, 0x0e, 0xec, 0x64, 0x8b, 0xe9,
+	0xe1, 0xb5, 0xe3, 0x85, 0x0c, 0xe1, 0xa3, 0x75, 0x19, 0x71, 0x15, 0x3f, 0x70, 0xa0, 0x95, 0x33,
+	0xa1, 0xdd, 0xbf, 0x09, 0x9a, 0x4d, 0x11, 0x9e, 0xae, 0x4d, 0x89, 0x0a, 0xe9, 0xbd, 0x78, 0x7d,
+	0xd1, 0xe6, 0xde, 0x5c, 0xb4, 0xb9, 0xdf, 0x2f, 0xda, 0xdc, 0xcf, 0x97, 0xed, 0xc2, 0x9b, 0xcb,
+	0x76, 0xe1, 0xb7, 0xcb, 0x76, 0xe1, 0xe5, 0x33, 0xc3, 0x74, 0xcf, 0x67, 0x43, 0x59, 0xc7, 0x53,
+	0x45, 0xc7, 0x64, 0x8a, 0x89, 0x62, 0x0e, 0xf5, 0x03, 0x03, 0x2b, 0xf3, 0x0f, 0x95, 0x29, 0x1e,
+	0xcd, 0x26, 0x88, 0xb0, 0xbf, 0x35, 0x0f, 0x1f, 0x1f, 0xa4, 0xfe, 0xd9, 0xb8, 0x9e, 0x8d, 0xc8,
+	0xb0, 0x42, 0x7f, 0xa4, 0x1e, 0xff, 0x35, 0x00, 0xf7, 0x8b, 0x9c, 0xf9, 0x88, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -470,6 +555,9 @@ type MsgClient interface {
 	// ConnectionOpenConfirm defines a rpc handler method for
 	// MsgConnectionOpenConfirm.
 	ConnectionOpenConfirm(ctx context.Context, in *MsgConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgConnectionOpenConfirmResponse, error)
+	// UpdateConnectionParams defines a rpc handler method for
+	// MsgUpdateConnectionParams.
+	UpdateConnectionParams(ctx context.Context, in *MsgUpdateConnectionParams, opts ...grpc.CallOption) (*MsgUpdateConnectionParamsResponse, error)
 }
 
 type msgClient struct {
@@ -516,6 +604,15 @@ func (c *msgClient) ConnectionOpenConfirm(ctx context.Context, in *MsgConnection
 	return out, nil
 }
 
+func (c *msgClient) UpdateConnectionParams(ctx context.Context, in *MsgUpdateConnectionParams, opts ...grpc.CallOption) (*MsgUpdateConnectionParamsResponse, error) {
+	out := new(MsgUpdateConnectionParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/UpdateConnectionParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
@@ -527,6 +624,9 @@ type MsgServer interface {
 	// ConnectionOpenConfirm defines a rpc handler method for
 	// MsgConnectionOpenConfirm.
 	ConnectionOpenConfirm(context.Context, *MsgConnectionOpenConfirm) (*MsgConnectionOpenConfirmResponse, error)
+	// UpdateConnectionParams defines a rpc handler method for
+	// MsgUpdateConnectionParams.
+	UpdateConnectionParams(context.Context, *MsgUpdateConnectionParams) (*MsgUpdateConnectionParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -545,6 +645,9 @@ func (*UnimplementedMsgServer) ConnectionOpenAck(ctx context.Context, req *MsgCo
 func (*UnimplementedMsgServer) ConnectionOpenConfirm(ctx context.Context, req *MsgConnectionOpenConfirm) (*MsgConnectionOpenConfirmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenConfirm not implemented")
 }
+func (*UnimplementedMsgServer) UpdateConnectionParams(ctx context.Context, req *MsgUpdateConnectionParams) (*MsgUpdateConnectionParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConnectionParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -622,6 +725,24 @@ func _Msg_ConnectionOpenConfirm_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateConnectionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateConnectionParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateConnectionParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/UpdateConnectionParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateConnectionParams(ctx, req.(*MsgUpdateConnectionParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.connection.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -642,6 +763,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectionOpenConfirm",
 			Handler:    _Msg_ConnectionOpenConfirm_Handler,
 		},
+		{
+			MethodName: "UpdateConnectionParams",
+			Handler:    _Msg_UpdateConnectionParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/connection/v1/tx.proto",
@@ -1092,6 +1217,69 @@ func (m *MsgConnectionOpenConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateConnectionParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConnectionParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConnectionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateConnectionParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConnectionParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConnectionParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1285,6 +1473,30 @@ func (m *MsgConnectionOpenConfirmResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateConnectionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateConnectionParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2696,6 +2908,171 @@ func (m *MsgConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateConnectionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateConnectionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateConnectionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateConnectionParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateConnectionParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateConnectionParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
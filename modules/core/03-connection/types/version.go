@@ -172,6 +172,36 @@ func PickVersion(supportedVersions, counterpartyVersions []exported.Version) (*V
 	)
 }
 
+// VersionNegotiator defines the hook used by the connection handshake to advertise the connection
+// versions supported by the chain and to select the version of a connection from the versions
+// proposed by a counterparty. Implementations must be deterministic.
+type VersionNegotiator interface {
+	// GetCompatibleVersions returns the descending ordered set of versions supported by the chain,
+	// which are proposed to the counterparty in ConnOpenInit.
+	GetCompatibleVersions() []exported.Version
+
+	// PickVersion returns the version selected in ConnOpenTry from the versions proposed by the
+	// counterparty. The returned version must be in the intersection of the supported versions
+	// and the counterparty versions.
+	PickVersion(counterpartyVersions []exported.Version) (*Version, error)
+}
+
+var _ VersionNegotiator = DefaultVersionNegotiator{}
+
+// DefaultVersionNegotiator is the VersionNegotiator used if none is set. It supports the
+// DefaultIBCVersion and selects versions using PickVersion.
+type DefaultVersionNegotiator struct{}
+
+// GetCompatibleVersions implements VersionNegotiator.
+func (DefaultVersionNegotiator) GetCompatibleVersions() []exported.Version {
+	return GetCompatibleVersions()
+}
+
+// PickVersion implements VersionNegotiator.
+func (DefaultVersionNegotiator) PickVersion(counterpartyVersions []exported.Version) (*Version, error) {
+	return PickVersion(GetCompatibleVersions(), counterpartyVersions)
+}
+
 // GetFeatureSetIntersection returns the intersections of source feature set
 // and the counterparty feature set. This is done by iterating over all the
 // features in the source version and seeing if they exist in the feature
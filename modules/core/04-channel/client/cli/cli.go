@@ -21,15 +21,20 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryChannels(),
 		GetCmdQueryChannel(),
 		GetCmdQueryConnectionChannels(),
+		GetCmdQueryClientChannels(),
 		GetCmdQueryChannelClientState(),
 		GetCmdQueryPacketCommitment(),
 		GetCmdQueryPacketCommitments(),
+		GetCmdQueryPacketCommitmentsAfterSequence(),
 		GetCmdQueryPacketReceipt(),
 		GetCmdQueryPacketAcknowledgement(),
 		GetCmdQueryUnreceivedPackets(),
 		GetCmdQueryUnreceivedAcks(),
 		GetCmdQueryNextSequenceReceive(),
-		// TODO: next sequence Send ?
+		GetCmdQueryNextSequenceSend(),
+		GetCmdQueryPacketSequenceGaps(),
+		GetCmdQueryPacketTimeoutStatus(),
+		GetCmdDecodePacket(),
 	)
 
 	return queryCmd
@@ -45,7 +50,9 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand()
+	txCmd.AddCommand(
+		NewSetupLocalhostPathCmd(),
+	)
 
 	return txCmd
 }
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// decodedPacket defines the output of the decode-packet command. Only the fields which could be
+// decoded from the provided bytes are populated.
+type decodedPacket struct {
+	Packet json.RawMessage `json:"packet,omitempty"`
+	Data   string          `json:"data,omitempty"`
+}
+
+// GetCmdDecodePacket defines the command to decode a packet or packet data from its hex encoded bytes.
+func GetCmdDecodePacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-packet [hex-bytes]",
+		Short: "Decode a packet or packet data",
+		Long: `Decode the hex encoded bytes of a protobuf encoded packet or of raw packet data and print the decoded fields.
+The packet data of an application, such as ICS20 or ICS27 packet data, is decoded with the decode-packet-data command of the application.
+The bytes are decoded locally and no connection to a node is required.`,
+		Example: fmt.Sprintf("%s query %s %s decode-packet [hex-bytes]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ParseHexBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			decoded, err := decodePacket(codec.NewProtoCodec(clientCtx.InterfaceRegistry), bz)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(decoded)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// ParseHexBytes returns the bytes of the provided hex string, which may be prefixed with 0x.
+func ParseHexBytes(hexBytes string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(hexBytes, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex bytes: %w", err)
+	}
+
+	return bz, nil
+}
+
+// parsePacket returns the packet encoded by the provided bytes if they form a valid protobuf encoded
+// packet and false otherwise.
+func parsePacket(bz []byte) (types.Packet, bool) {
+	var packet types.Packet
+	if err := packet.Unmarshal(bz); err != nil || packet.ValidateBasic() != nil {
+		return types.Packet{}, false
+	}
+
+	return packet, true
+}
+
+// ParsePacketData returns the packet data of the packet encoded by the provided bytes if they form a
+// valid protobuf encoded packet and the provided bytes otherwise.
+func ParsePacketData(bz []byte) []byte {
+	if packet, ok := parsePacket(bz); ok {
+		return packet.GetData()
+	}
+
+	return bz
+}
+
+// decodePacket decodes the provided bytes as a protobuf encoded packet if they form a valid packet
+// and as packet data otherwise.
+func decodePacket(cdc *codec.ProtoCodec, bz []byte) (*decodedPacket, error) {
+	var decoded decodedPacket
+
+	data := bz
+	if packet, ok := parsePacket(bz); ok {
+		packetJSON, err := cdc.MarshalJSON(&packet)
+		if err != nil {
+			return nil, err
+		}
+
+		decoded.Packet = packetJSON
+		data = packet.GetData()
+	}
+
+	if utf8.Valid(data) {
+		decoded.Data = string(data)
+	} else if decoded.Packet == nil {
+		return nil, fmt.Errorf("bytes are neither a valid packet nor UTF-8 encoded packet data")
+	}
+
+	return &decoded, nil
+}
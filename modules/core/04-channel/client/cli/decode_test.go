@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+const packetData = `{"denom":"uatom","amount":"100"}`
+
+func TestDecodePacket(t *testing.T) {
+	var bz []byte
+
+	packet := types.NewPacket([]byte(packetData), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expPass     bool
+		assertionFn func(t *testing.T, decoded *decodedPacket)
+	}{
+		{
+			"success: packet data",
+			func() {
+				bz = []byte(packetData)
+			},
+			true,
+			func(t *testing.T, decoded *decodedPacket) {
+				require.Nil(t, decoded.Packet)
+				require.Equal(t, packetData, decoded.Data)
+			},
+		},
+		{
+			"success: packet",
+			func() {
+				var err error
+				bz, err = packet.Marshal()
+				require.NoError(t, err)
+			},
+			true,
+			func(t *testing.T, decoded *decodedPacket) {
+				require.NotNil(t, decoded.Packet)
+				require.Contains(t, string(decoded.Packet), `"source_channel":"channel-0"`)
+				require.Equal(t, packetData, decoded.Data)
+			},
+		},
+		{
+			"success: packet with packet data which is not UTF-8 encoded",
+			func() {
+				packet := packet
+				packet.Data = []byte{0xff, 0xfe, 0xfd}
+
+				var err error
+				bz, err = packet.Marshal()
+				require.NoError(t, err)
+			},
+			true,
+			func(t *testing.T, decoded *decodedPacket) {
+				require.NotNil(t, decoded.Packet)
+				require.Empty(t, decoded.Data)
+			},
+		},
+		{
+			"bytes are neither a packet nor UTF-8 encoded packet data",
+			func() {
+				bz = []byte{0xff, 0xfe, 0xfd}
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tc.malleate()
+
+			decoded, err := decodePacket(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), bz)
+			if tc.expPass {
+				require.NoError(t, err)
+				tc.assertionFn(t, decoded)
+			} else {
+				require.Error(t, err)
+				require.Nil(t, decoded)
+			}
+		})
+	}
+}
+
+func TestParsePacketData(t *testing.T) {
+	packet := types.NewPacket([]byte(packetData), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+	bz, err := packet.Marshal()
+	require.NoError(t, err)
+
+	require.Equal(t, []byte(packetData), ParsePacketData(bz))
+	require.Equal(t, []byte(packetData), ParsePacketData([]byte(packetData)))
+}
@@ -1,14 +1,17 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/client/utils"
 	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
@@ -16,6 +19,12 @@ import (
 
 const (
 	flagSequences = "sequences"
+	flagSequence  = "sequence"
+	flagMaxBytes  = "max-bytes"
+
+	flagTimeoutHeight    = "timeout-height"
+	flagTimeoutTimestamp = "timeout-timestamp"
+	flagData             = "data"
 )
 
 // GetCmdQueryChannels defines the command to query all the channels ends
@@ -132,6 +141,46 @@ func GetCmdQueryConnectionChannels() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryClientChannels defines the command to query all the channels associated with a
+// client through the connections built on top of it
+func GetCmdQueryClientChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "client [client-id]",
+		Short:   "Query all channels associated with a client",
+		Long:    "Query all channels associated with a client through the connections built on top of it",
+		Example: fmt.Sprintf("%s query %s %s client [client-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClientChannelsRequest{
+				ClientId:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ClientChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channels associated with a client")
+
+	return cmd
+}
+
 // GetCmdQueryChannelClientState defines the command to query a client state from a channel
 func GetCmdQueryChannelClientState() *cobra.Command {
 	cmd := &cobra.Command{
@@ -203,6 +252,54 @@ func GetCmdQueryPacketCommitments() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryPacketCommitmentsAfterSequence defines the command to query the packet commitments
+// of a channel with a sequence greater than the provided sequence
+func GetCmdQueryPacketCommitmentsAfterSequence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-commitments-after [port-id] [channel-id] [sequence]",
+		Short:   "Query the packet commitments of a channel after a sequence",
+		Long:    "Query the packet commitments of a channel with a sequence greater than the provided sequence in ascending sequence order. The size of the response is capped by a byte limit and the number of sequences checked is capped by the chain, if the response has remaining commitments the query may be repeated with its last sequence.",
+		Example: fmt.Sprintf("%s query %s %s packet-commitments-after [port-id] [channel-id] [sequence] --%s 1048576", version.AppName, host.ModuleName, types.SubModuleName, flagMaxBytes),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			maxBytes, err := cmd.Flags().GetUint64(flagMaxBytes)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketCommitmentsAfterSequenceRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  sequence,
+				MaxBytes:  maxBytes,
+			}
+
+			res, err := queryClient.PacketCommitmentsAfterSequence(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagMaxBytes, 0, "maximum size in bytes of the returned commitments, the default limit of the chain is used if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryPacketCommitment defines the command to query a packet commitment
 func GetCmdQueryPacketCommitment() *cobra.Command {
 	cmd := &cobra.Command{
@@ -455,3 +552,164 @@ func GetCmdQueryNextSequenceReceive() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryNextSequenceSend defines the command to query a next send sequence for a given channel
+func GetCmdQueryNextSequenceSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-sequence-send [port-id] [channel-id]",
+		Short: "Query a next send sequence",
+		Long:  "Query the next send sequence for a given channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s next-sequence-send [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			portID := args[0]
+			channelID := args[1]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			sequenceRes, err := utils.QueryNextSequenceSend(clientCtx, portID, channelID, prove)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(sequenceRes.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(sequenceRes)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPacketSequenceGaps defines the command to query the sequences of the packets
+// which were never received on an unordered channel
+func GetCmdQueryPacketSequenceGaps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-sequence-gaps [port-id] [channel-id] [max-sequence]",
+		Short: "Query the sequences of the packets which were never received on an unordered channel",
+		Long: `Query the sequences up to the highest sent sequence for which no packet receipt exists on the receiving (executing) chain.
+
+The highest sent sequence is the next sequence send of the counterparty channel end minus one. The number of sequences checked per query is capped by the chain, if the response has remaining sequences the query may be repeated with the last sequence of the response passed as --sequence.
+`,
+		Example: fmt.Sprintf("%s query %s %s packet-sequence-gaps [port-id] [channel-id] [max-sequence] --%s=10", version.AppName, host.ModuleName, types.SubModuleName, flagSequence),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			maxSequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := cmd.Flags().GetUint64(flagSequence)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketSequenceGapsRequest{
+				PortId:      args[0],
+				ChannelId:   args[1],
+				MaxSequence: maxSequence,
+				Sequence:    sequence,
+			}
+
+			res, err := queryClient.PacketSequenceGaps(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagSequence, 0, "only sequences greater than the provided sequence are checked")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPacketTimeoutStatus defines the command to query whether a packet has timed out according
+// to the latest consensus state of the counterparty client.
+func GetCmdQueryPacketTimeoutStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-timeout-status [port-id] [channel-id] [sequence]",
+		Short: "Query whether a packet sent on a channel has timed out",
+		Long: `Query whether a packet sent on a channel which has been neither acknowledged nor timed out has timed out according to the latest consensus state of the counterparty client.
+
+The timeout height, timeout timestamp and hex encoded data of the packet must match its packet commitment.
+`,
+		Example: fmt.Sprintf("%s query %s %s packet-timeout-status [port-id] [channel-id] [sequence] --timeout-height=1-100 --data=[hex-data]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(flagTimeoutHeight)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			dataStr, err := cmd.Flags().GetString(flagData)
+			if err != nil {
+				return err
+			}
+
+			data, err := hex.DecodeString(strings.TrimPrefix(dataStr, "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid hex packet data: %w", err)
+			}
+
+			req := &types.QueryPacketTimeoutStatusRequest{
+				PortId:           args[0],
+				ChannelId:        args[1],
+				Sequence:         seq,
+				TimeoutHeight:    timeoutHeight,
+				TimeoutTimestamp: timeoutTimestamp,
+				Data:             data,
+			}
+
+			res, err := queryClient.PacketTimeoutStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagTimeoutHeight, "0-0", "timeout height of the packet in the format {revision}-{height}")
+	cmd.Flags().Uint64(flagTimeoutTimestamp, 0, "timeout timestamp of the packet in nanoseconds since the unix epoch")
+	cmd.Flags().String(flagData, "", "hex encoded data of the packet")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
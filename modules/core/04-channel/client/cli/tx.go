@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+const flagOrdered = "ordered"
+
+// NewSetupLocalhostPathCmd defines the command to open a channel between two ports of the chain
+// over the localhost connection in a single transaction.
+func NewSetupLocalhostPathCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "setup-localhost-path [port-id-a] [port-id-b] [version]",
+		Short: "open a channel between two ports over the localhost connection",
+		Long: `open a channel between two ports of the chain over the sentinel localhost connection in a single transaction.
+The channel handshake is executed with port-id-a as the initiating channel end and the 09-localhost client verifying each step.
+The channel is unordered unless the --ordered flag is set.`,
+		Example: fmt.Sprintf("%s tx %s %s setup-localhost-path transfer transfer ics20-1 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			order := types.UNORDERED
+			if ordered, _ := cmd.Flags().GetBool(flagOrdered); ordered {
+				order = types.ORDERED
+			}
+
+			msg := types.NewMsgSetupLocalhostPath(args[0], args[1], order, args[2], clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagOrdered, false, "open an ordered channel")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -20,6 +20,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, commitment := range gs.Commitments {
 		k.SetPacketCommitment(ctx, commitment.PortId, commitment.ChannelId, commitment.Sequence, commitment.Data)
 	}
+	for _, pt := range gs.PacketTimeouts {
+		k.SetPacketTimeout(ctx, pt)
+	}
 	for _, receipt := range gs.Receipts {
 		k.SetPacketReceipt(ctx, receipt.PortId, receipt.ChannelId, receipt.Sequence)
 	}
@@ -32,7 +35,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, fc := range gs.FrozenChannels {
+		k.SetChannelFrozen(ctx, fc.PortId, fc.ChannelId)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
+	k.SetParams(ctx, gs.Params)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
@@ -46,5 +53,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
 		AckSequences:        k.GetAllPacketAckSeqs(ctx),
 		NextChannelSequence: k.GetNextChannelSequence(ctx),
+		FrozenChannels:      k.GetAllFrozenChannels(ctx),
+		Params:              k.GetParams(ctx),
+		PacketTimeouts:      k.GetAllPacketTimeouts(ctx),
 	}
 }
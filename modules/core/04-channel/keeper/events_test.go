@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+// TestPacketEventAttributes tests that all packet lifecycle events emit the same attribute keys.
+func (suite *KeeperTestSuite) TestPacketEventAttributes() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channel := path.EndpointA.GetChannel()
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+	emitters := map[string]func(ctx sdk.Context){
+		types.EventTypeSendPacket: func(ctx sdk.Context) {
+			keeper.EmitSendPacketEvent(ctx, packet, channel, packet.GetTimeoutHeight())
+		},
+		types.EventTypeRecvPacket: func(ctx sdk.Context) {
+			keeper.EmitRecvPacketEvent(ctx, packet, channel)
+		},
+		types.EventTypeWriteAck: func(ctx sdk.Context) {
+			keeper.EmitWriteAcknowledgementEvent(ctx, packet, channel, ibctesting.MockAcknowledgement)
+		},
+		types.EventTypeAcknowledgePacket: func(ctx sdk.Context) {
+			keeper.EmitAcknowledgePacketEvent(ctx, packet, channel)
+		},
+		types.EventTypeTimeoutPacket: func(ctx sdk.Context) {
+			keeper.EmitTimeoutPacketEvent(ctx, packet, channel)
+		},
+	}
+
+	var expKeys []string
+	for _, eventType := range []string{
+		types.EventTypeSendPacket, types.EventTypeRecvPacket, types.EventTypeWriteAck,
+		types.EventTypeAcknowledgePacket, types.EventTypeTimeoutPacket,
+	} {
+		ctx := suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+		emitters[eventType](ctx)
+
+		var (
+			keys       []string
+			attributes = make(map[string]string)
+		)
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != eventType {
+				continue
+			}
+
+			for _, attr := range event.Attributes {
+				key := string(attr.Key)
+				// the acknowledgement is only known to the write_acknowledgement event
+				if key == types.AttributeKeyAck || key == types.AttributeKeyAckHex { //nolint:staticcheck // DEPRECATED
+					suite.Require().Equal(types.EventTypeWriteAck, eventType)
+					continue
+				}
+
+				keys = append(keys, key)
+				attributes[key] = string(attr.Value)
+			}
+		}
+
+		suite.Require().Equal(hex.EncodeToString(packet.GetData()), attributes[types.AttributeKeyDataHex], eventType)
+		suite.Require().Equal(channel.ConnectionHops[0], attributes[types.AttributeKeyConnection], eventType)
+		suite.Require().Equal(channel.Ordering.String(), attributes[types.AttributeKeyChannelOrdering], eventType)
+
+		if expKeys == nil {
+			expKeys = keys
+		}
+		suite.Require().Equal(expKeys, keys, eventType)
+	}
+}
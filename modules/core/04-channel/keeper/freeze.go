@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+// ChanFreeze is called by the governance authority to pause an open channel without affecting the
+// other channels using the same connection and client. Packets can no longer be sent or received on
+// a frozen channel. Acknowledgements and timeouts of packets sent before the channel was frozen are
+// still processed, such that in flight packets can be completed and their funds refunded.
+func (k Keeper) ChanFreeze(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "channel state is not OPEN (got %s)", channel.State.String())
+	}
+
+	if k.IsChannelFrozen(ctx, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrChannelFrozen, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.SetChannelFrozen(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel frozen", "port-id", portID, "channel-id", channelID)
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "freeze")
+	}()
+
+	EmitChannelFreezeEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
+
+// ChanUnfreeze is called by the governance authority to resume sending and receiving packets on a
+// frozen channel.
+func (k Keeper) ChanUnfreeze(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !k.IsChannelFrozen(ctx, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrChannelNotFrozen, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.DeleteChannelFrozen(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel unfrozen", "port-id", portID, "channel-id", channelID)
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "unfreeze")
+	}()
+
+	EmitChannelUnfreezeEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+// TestChanFreeze tests the freezing of a channel on chainA by the governance authority.
+func (suite *KeeperTestSuite) TestChanFreeze() {
+	var (
+		path     *ibctesting.Path
+		expError *sdkerrors.Error
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = ibctesting.InvalidID
+			expError = types.ErrChannelNotFound
+		}, false},
+		{"channel is not open", func() {
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+			expError = types.ErrInvalidChannelState
+		}, false},
+		{"channel is already frozen", func() {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelFrozen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			expError = types.ErrChannelFrozen
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expError = nil
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanFreeze(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelFrozen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			} else {
+				suite.Require().ErrorIs(err, expError)
+			}
+		})
+	}
+}
+
+// TestChanUnfreeze tests the unfreezing of a frozen channel on chainA by the governance authority.
+func (suite *KeeperTestSuite) TestChanUnfreeze() {
+	var (
+		path     *ibctesting.Path
+		expError *sdkerrors.Error
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"success: channel is closed", func() {
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+		}, true},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = ibctesting.InvalidID
+			expError = types.ErrChannelNotFound
+		}, false},
+		{"channel is not frozen", func() {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.DeleteChannelFrozen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			expError = types.ErrChannelNotFrozen
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expError = nil
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanFreeze(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanUnfreeze(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelFrozen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			} else {
+				suite.Require().ErrorIs(err, expError)
+			}
+		})
+	}
+}
+
+// TestFrozenChannel tests that freezing a channel on chainA only pauses that channel. Packets
+// sent before the channel was frozen can still be timed out and other channels on the same
+// connection keep sending packets.
+func (suite *KeeperTestSuite) TestFrozenChannel() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// create a second channel on the same connection
+	path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path1.EndpointA.ClientID = path.EndpointA.ClientID
+	path1.EndpointB.ClientID = path.EndpointB.ClientID
+	path1.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+	path1.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+	suite.coordinator.CreateMockChannels(path1)
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanFreeze(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+
+	_, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().ErrorIs(err, types.ErrChannelFrozen)
+
+	_, err = path1.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	// the packet sent before the channel was frozen is timed out
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+	suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanUnfreeze(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+
+	_, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"strconv"
 	"strings"
@@ -129,6 +130,57 @@ func (q Keeper) ConnectionChannels(c context.Context, req *types.QueryConnection
 	}, nil
 }
 
+// ClientChannels implements the Query/ClientChannels gRPC method
+func (q Keeper) ClientChannels(c context.Context, req *types.QueryClientChannelsRequest) (*types.QueryClientChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channels := []*types.IdentifiedChannel{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.KeyChannelEndPrefix))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var result types.Channel
+		if err := q.cdc.Unmarshal(value, &result); err != nil {
+			return false, err
+		}
+
+		// ignore channels whose connection is not built on top of the requested client
+		connection, found := q.connectionKeeper.GetConnection(ctx, result.ConnectionHops[0])
+		if !found || connection.GetClientID() != req.ClientId {
+			return false, nil
+		}
+
+		if accumulate {
+			portID, channelID, err := host.ParseChannelPath(string(key))
+			if err != nil {
+				return false, err
+			}
+
+			identifiedChannel := types.NewIdentifiedChannel(portID, channelID, result)
+			channels = append(channels, &identifiedChannel)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryClientChannelsResponse{
+		Channels:   channels,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
 // ChannelClientState implements the Query/ChannelClientState gRPC method
 func (q Keeper) ChannelClientState(c context.Context, req *types.QueryChannelClientStateRequest) (*types.QueryChannelClientStateResponse, error) {
 	if req == nil {
@@ -262,6 +314,77 @@ func (q Keeper) PacketCommitments(c context.Context, req *types.QueryPacketCommi
 	}, nil
 }
 
+// PacketCommitmentsAfterSequence implements the Query/PacketCommitmentsAfterSequence gRPC method.
+// The sequences after the requested sequence are looked up one by one up to the highest sent
+// sequence of the channel, checking at most MaxPacketSequenceLookups sequences.
+func (q Keeper) PacketCommitmentsAfterSequence(c context.Context, req *types.QueryPacketCommitmentsAfterSequenceRequest) (*types.QueryPacketCommitmentsAfterSequenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	maxBytes := req.MaxBytes
+	if maxBytes == 0 {
+		maxBytes = types.DefaultPacketCommitmentsMaxBytes
+	}
+
+	if maxBytes > types.MaxPacketCommitmentsMaxBytes {
+		maxBytes = types.MaxPacketCommitmentsMaxBytes
+	}
+
+	nextSequenceSend, found := q.GetNextSequenceSend(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrSequenceSendNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	// commitments are stored by the decimal representation of their sequence, therefore the
+	// sequences are looked up individually rather than iterated over in key order
+	var (
+		commitments  = []*types.PacketState{}
+		totalBytes   uint64
+		hasRemaining bool
+	)
+	sequence := req.Sequence
+	for lookups := 0; sequence+1 < nextSequenceSend; lookups++ {
+		if lookups == types.MaxPacketSequenceLookups {
+			hasRemaining = true
+			break
+		}
+
+		hash := q.GetPacketCommitment(ctx, req.PortId, req.ChannelId, sequence+1)
+		if len(hash) != 0 {
+			commitment := types.NewPacketState(req.PortId, req.ChannelId, sequence+1, hash)
+
+			// at least one commitment is returned to guarantee progress
+			totalBytes += uint64(commitment.Size())
+			if len(commitments) > 0 && totalBytes > maxBytes {
+				hasRemaining = true
+				break
+			}
+
+			commitments = append(commitments, &commitment)
+		}
+
+		sequence++
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketCommitmentsAfterSequenceResponse{
+		Commitments:  commitments,
+		HasRemaining: hasRemaining,
+		Height:       selfHeight,
+		LastSequence: sequence,
+	}, nil
+}
+
 // PacketReceipt implements the Query/PacketReceipt gRPC method
 func (q Keeper) PacketReceipt(c context.Context, req *types.QueryPacketReceiptRequest) (*types.QueryPacketReceiptResponse, error) {
 	if req == nil {
@@ -281,7 +404,9 @@ func (q Keeper) PacketReceipt(c context.Context, req *types.QueryPacketReceiptRe
 	_, recvd := q.GetPacketReceipt(ctx, req.PortId, req.ChannelId, req.Sequence)
 
 	selfHeight := clienttypes.GetSelfHeight(ctx)
-	return types.NewQueryPacketReceiptResponse(recvd, nil, selfHeight), nil
+	res := types.NewQueryPacketReceiptResponse(recvd, nil, selfHeight)
+	res.ProofPath = host.PacketReceiptPath(req.PortId, req.ChannelId, req.Sequence)
+	return res, nil
 }
 
 // PacketAcknowledgement implements the Query/PacketAcknowledgement gRPC method
@@ -491,6 +616,169 @@ func (q Keeper) NextSequenceReceive(c context.Context, req *types.QueryNextSeque
 	return types.NewQueryNextSequenceReceiveResponse(sequence, nil, selfHeight), nil
 }
 
+// NextSequenceSend implements the Query/NextSequenceSend gRPC method
+func (q Keeper) NextSequenceSend(c context.Context, req *types.QueryNextSequenceSendRequest) (*types.QueryNextSequenceSendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sequence, found := q.GetNextSequenceSend(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrSequenceSendNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryNextSequenceSendResponse(sequence, nil, selfHeight), nil
+}
+
+// PacketSequenceGaps implements the Query/PacketSequenceGaps gRPC method. The packet
+// receipts of the unordered channel are looked up for the sequences after the requested
+// sequence up to the highest sent sequence to find those for which no receipt exists,
+// i.e. the packets which were never delivered. At most MaxPacketSequenceGaps sequences
+// are returned and MaxPacketSequenceLookups sequences are checked.
+//
+// NOTE: the highest sent sequence is only known to the counterparty chain and must
+// therefore be provided.
+func (q Keeper) PacketSequenceGaps(c context.Context, req *types.QueryPacketSequenceGapsRequest) (*types.QueryPacketSequenceGapsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id: %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	if channel.Ordering != types.UNORDERED {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			sdkerrors.Wrapf(types.ErrInvalidChannelOrdering, "packet sequence gaps are only tracked for %s channels, got %s", types.UNORDERED, channel.Ordering).Error(),
+		)
+	}
+
+	if req.MaxSequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "max sequence cannot be zero")
+	}
+
+	var (
+		sequences    = []uint64{}
+		hasRemaining bool
+	)
+	sequence := req.Sequence
+	for lookups := 0; sequence < req.MaxSequence; lookups++ {
+		if lookups == types.MaxPacketSequenceLookups || len(sequences) == types.MaxPacketSequenceGaps {
+			hasRemaining = true
+			break
+		}
+
+		sequence++
+		if _, found := q.GetPacketReceipt(ctx, req.PortId, req.ChannelId, sequence); !found {
+			sequences = append(sequences, sequence)
+		}
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketSequenceGapsResponse{
+		Sequences:    sequences,
+		Height:       selfHeight,
+		HasRemaining: hasRemaining,
+		LastSequence: sequence,
+	}, nil
+}
+
+// PacketTimeoutStatus implements the Query/PacketTimeoutStatus gRPC method. The timeouts of the
+// packet are checked against the latest height of the counterparty client and the timestamp of
+// its consensus state at that height, i.e. the proof height a timeout would be submitted with.
+func (q Keeper) PacketTimeoutStatus(c context.Context, req *types.QueryPacketTimeoutStatusRequest) (*types.QueryPacketTimeoutStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	commitment := q.GetPacketCommitment(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if len(commitment) == 0 {
+		return nil, status.Error(codes.NotFound, "packet commitment hash not found")
+	}
+
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id: %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	packet := types.NewPacket(
+		req.Data, req.Sequence, req.PortId, req.ChannelId, channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		req.TimeoutHeight, req.TimeoutTimestamp,
+	)
+	if !bytes.Equal(commitment, types.CommitPacket(q.cdc, packet)) {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			sdkerrors.Wrap(types.ErrInvalidPacket, "packet timeouts and data do not match the packet commitment").Error(),
+		)
+	}
+
+	connection, found := q.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0]).Error())
+	}
+
+	clientState, found := q.clientKeeper.GetClientState(ctx, connection.GetClientID())
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(clienttypes.ErrClientNotFound, connection.GetClientID()).Error())
+	}
+
+	latestHeight := clientState.GetLatestHeight()
+	latestTimestamp, err := q.connectionKeeper.GetTimestampAtHeight(ctx, connection, latestHeight)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	timeoutStatus := types.TIMEOUT_STATUS_LIVE
+	counterpartyClock := types.NewClock(latestHeight, latestTimestamp)
+	switch {
+	case types.TimeoutHeightReached(packet, counterpartyClock):
+		timeoutStatus = types.TIMEOUT_STATUS_TIMED_OUT_BY_HEIGHT
+	case types.TimeoutTimestampReached(packet, counterpartyClock):
+		timeoutStatus = types.TIMEOUT_STATUS_TIMED_OUT_BY_TIMESTAMP
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketTimeoutStatusResponse{
+		Status:                timeoutStatus,
+		CounterpartyHeight:    clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()),
+		CounterpartyTimestamp: latestTimestamp,
+		Height:                selfHeight,
+	}, nil
+}
+
 func validategRPCRequest(portID, channelID string) error {
 	if err := host.PortIdentifierValidator(portID); err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
@@ -9,6 +9,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 )
@@ -316,6 +317,140 @@ func (suite *KeeperTestSuite) TestQueryConnectionChannels() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryClientChannels() {
+	var (
+		req         *types.QueryClientChannelsRequest
+		expChannels = []*types.IdentifiedChannel{}
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = &types.QueryClientChannelsRequest{
+					ClientId: "",
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				// path1 creates a second channel on the first connection on chainA and closes it
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path1.SetChannelOrdered()
+				path1.EndpointA.ClientID = path.EndpointA.ClientID
+				path1.EndpointB.ClientID = path.EndpointB.ClientID
+				path1.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+				path1.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+
+				suite.coordinator.CreateMockChannels(path1)
+				suite.Require().NoError(path1.EndpointA.SetChannelClosed())
+
+				// path2 creates a channel over a different client which is not returned
+				path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path2)
+
+				channel0 := path.EndpointA.GetChannel()
+				channel1 := path1.EndpointA.GetChannel()
+				suite.Require().Equal(types.CLOSED, channel1.State)
+
+				idCh0 := types.NewIdentifiedChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channel0)
+				idCh1 := types.NewIdentifiedChannel(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, channel1)
+
+				expChannels = []*types.IdentifiedChannel{&idCh0, &idCh1}
+
+				req = &types.QueryClientChannelsRequest{
+					ClientId: path.EndpointA.ClientID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success, paginated",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path1.EndpointA.ClientID = path.EndpointA.ClientID
+				path1.EndpointB.ClientID = path.EndpointB.ClientID
+				path1.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+				path1.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+				suite.coordinator.CreateMockChannels(path1)
+
+				idCh0 := types.NewIdentifiedChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointA.GetChannel())
+				expChannels = []*types.IdentifiedChannel{&idCh0}
+
+				req = &types.QueryClientChannelsRequest{
+					ClientId: path.EndpointA.ClientID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      1,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success, empty response",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				expChannels = []*types.IdentifiedChannel{}
+				req = &types.QueryClientChannelsRequest{
+					ClientId: ibctesting.InvalidID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      2,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.ClientChannels(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expChannels, res.Channels)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryChannelClientState() {
 	var (
 		req                      *types.QueryChannelClientStateRequest
@@ -782,6 +917,146 @@ func (suite *KeeperTestSuite) TestQueryPacketCommitments() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryPacketCommitmentsAfterSequence() {
+	var (
+		req             *types.QueryPacketCommitmentsAfterSequenceRequest
+		commitments     []*types.PacketState
+		expCommitments  []*types.PacketState
+		expHasRemaining bool
+		expLastSequence uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"next sequence send not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"success: all commitments in ascending sequence order",
+			func() {
+				expCommitments = commitments
+			},
+			true,
+		},
+		{
+			"success: commitments after sequence",
+			func() {
+				req.Sequence = 9
+				expCommitments = commitments[9:]
+			},
+			true,
+		},
+		{
+			"success: no commitments after sequence",
+			func() {
+				req.Sequence = 12
+				expCommitments = []*types.PacketState{}
+			},
+			true,
+		},
+		{
+			"success: sequences without commitments are skipped",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), req.PortId, req.ChannelId, 21)
+				expCommitments = commitments
+				expLastSequence = 20
+			},
+			true,
+		},
+		{
+			"success: commitments capped by byte limit",
+			func() {
+				req.MaxBytes = uint64(commitments[0].Size() + commitments[1].Size() + commitments[2].Size())
+				expCommitments = commitments[:3]
+				expHasRemaining = true
+				expLastSequence = 3
+			},
+			true,
+		},
+		{
+			"success: first commitment returned if it exceeds byte limit",
+			func() {
+				req.MaxBytes = 1
+				expCommitments = commitments[:1]
+				expHasRemaining = true
+				expLastSequence = 1
+			},
+			true,
+		},
+		{
+			"success: sequence lookups are capped",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), req.PortId, req.ChannelId, types.MaxPacketSequenceLookups*2)
+				expCommitments = commitments
+				expHasRemaining = true
+				expLastSequence = types.MaxPacketSequenceLookups
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expHasRemaining = false
+			expLastSequence = 12
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// sequences 10 to 12 are stored before sequence 2 in lexicographic key order
+			commitments = make([]*types.PacketState, 12)
+			for i := uint64(1); i <= 12; i++ {
+				commitment := types.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, i, []byte(fmt.Sprintf("hash_%d", i)))
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), commitment.PortId, commitment.ChannelId, commitment.Sequence, commitment.Data)
+				commitments[i-1] = &commitment
+			}
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 13)
+
+			req = &types.QueryPacketCommitmentsAfterSequenceRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.PacketCommitmentsAfterSequence(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expCommitments, res.Commitments)
+				suite.Require().Equal(expHasRemaining, res.HasRemaining)
+				suite.Require().Equal(expLastSequence, res.LastSequence)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryPacketReceipt() {
 	var (
 		req         *types.QueryPacketReceiptRequest
@@ -880,6 +1155,7 @@ func (suite *KeeperTestSuite) TestQueryPacketReceipt() {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
 				suite.Require().Equal(expReceived, res.Received)
+				suite.Require().Equal(host.PacketReceiptPath(req.PortId, req.ChannelId, req.Sequence), res.ProofPath)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -1368,6 +1644,327 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryPacketSequenceGaps() {
+	var (
+		path            *ibctesting.Path
+		req             *types.QueryPacketSequenceGapsRequest
+		expSeq          []uint64
+		expHasRemaining bool
+		expLastSequence uint64
+	)
+
+	setReceipts := func(sequences ...uint64) {
+		for _, seq := range sequences {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+		}
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"channel is ordered",
+			func() {
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
+				suite.coordinator.Setup(path)
+
+				req = &types.QueryPacketSequenceGapsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			false,
+		},
+		{
+			"max sequence is zero",
+			func() {
+				req.MaxSequence = 0
+			},
+			false,
+		},
+		{
+			"success: no packets received",
+			func() {
+				req.MaxSequence = 3
+				expSeq = []uint64{1, 2, 3}
+				expLastSequence = 3
+			},
+			true,
+		},
+		{
+			"success: gaps up to the max sequence",
+			func() {
+				setReceipts(2, 4, 5, 10)
+				req.MaxSequence = 12
+				expSeq = []uint64{1, 3, 6, 7, 8, 9, 11, 12}
+				expLastSequence = 12
+			},
+			true,
+		},
+		{
+			"success: max sequence below the highest received sequence",
+			func() {
+				setReceipts(2, 4, 5, 10)
+				req.MaxSequence = 4
+				expSeq = []uint64{1, 3}
+				expLastSequence = 4
+			},
+			true,
+		},
+		{
+			"success: gaps after sequence",
+			func() {
+				setReceipts(2, 4, 5, 10)
+				req.MaxSequence = 12
+				req.Sequence = 4
+				expSeq = []uint64{6, 7, 8, 9, 11, 12}
+				expLastSequence = 12
+			},
+			true,
+		},
+		{
+			"success: all packets received",
+			func() {
+				setReceipts(1, 2, 3)
+				req.MaxSequence = 3
+				expSeq = []uint64{}
+				expLastSequence = 3
+			},
+			true,
+		},
+		{
+			"success: gaps are capped",
+			func() {
+				req.MaxSequence = types.MaxPacketSequenceGaps * 2
+				expSeq = make([]uint64, types.MaxPacketSequenceGaps)
+				for i := range expSeq {
+					expSeq[i] = uint64(i + 1)
+				}
+				expHasRemaining = true
+				expLastSequence = types.MaxPacketSequenceGaps
+			},
+			true,
+		},
+		{
+			"success: sequence lookups are capped",
+			func() {
+				for seq := uint64(1); seq <= types.MaxPacketSequenceLookups; seq++ {
+					setReceipts(seq)
+				}
+				req.MaxSequence = types.MaxPacketSequenceLookups * 2
+				expSeq = []uint64{}
+				expHasRemaining = true
+				expLastSequence = types.MaxPacketSequenceLookups
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			expHasRemaining = false
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			req = &types.QueryPacketSequenceGapsRequest{
+				PortId:      path.EndpointA.ChannelConfig.PortID,
+				ChannelId:   path.EndpointA.ChannelID,
+				MaxSequence: 1,
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.PacketSequenceGaps(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSeq, res.Sequences)
+				suite.Require().Equal(expHasRemaining, res.HasRemaining)
+				suite.Require().Equal(expLastSequence, res.LastSequence)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketTimeoutStatus() {
+	var (
+		path      *ibctesting.Path
+		req       *types.QueryPacketTimeoutStatusRequest
+		expStatus types.PacketTimeoutStatus
+	)
+
+	// sendPacket sends a packet with the provided timeouts on path and sets the request for it
+	sendPacket := func(timeoutHeight clienttypes.Height, timeoutTimestamp uint64) {
+		sequence, err := path.EndpointA.SendPacket(timeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		req = &types.QueryPacketTimeoutStatusRequest{
+			PortId:           path.EndpointA.ChannelConfig.PortID,
+			ChannelId:        path.EndpointA.ChannelID,
+			Sequence:         sequence,
+			TimeoutHeight:    timeoutHeight,
+			TimeoutTimestamp: timeoutTimestamp,
+			Data:             ibctesting.MockPacketData,
+		}
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryPacketTimeoutStatusRequest{
+					PortId:    "",
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				req = &types.QueryPacketTimeoutStatusRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  0,
+				}
+			},
+			false,
+		},
+		{
+			"packet commitment not found",
+			func() {
+				sendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp)
+				req.Sequence++
+			},
+			false,
+		},
+		{
+			"packet data does not match the packet commitment",
+			func() {
+				sendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp)
+				req.Data = []byte("invalid packet data")
+			},
+			false,
+		},
+		{
+			"packet timeouts do not match the packet commitment",
+			func() {
+				sendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp)
+				req.TimeoutHeight = req.TimeoutHeight.Increment().(clienttypes.Height)
+			},
+			false,
+		},
+		{
+			"success: packet is live",
+			func() {
+				sendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp)
+				expStatus = types.TIMEOUT_STATUS_LIVE
+			},
+			true,
+		},
+		{
+			"success: packet timed out by height",
+			func() {
+				sendPacket(clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				expStatus = types.TIMEOUT_STATUS_TIMED_OUT_BY_HEIGHT
+			},
+			true,
+		},
+		{
+			"success: packet timed out by timestamp",
+			func() {
+				sendPacket(disabledTimeoutHeight, uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				expStatus = types.TIMEOUT_STATUS_TIMED_OUT_BY_TIMESTAMP
+			},
+			true,
+		},
+		{
+			"success: packet timed out by height and timestamp",
+			func() {
+				sendPacket(clienttypes.GetSelfHeight(suite.chainB.GetContext()), uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				expStatus = types.TIMEOUT_STATUS_TIMED_OUT_BY_HEIGHT
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.PacketTimeoutStatus(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expStatus, res.Status)
+				suite.Require().Equal(path.EndpointA.GetClientState().GetLatestHeight(), res.CounterpartyHeight)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 	var (
 		req    *types.QueryNextSequenceReceiveRequest
@@ -1452,3 +2049,105 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryNextSequenceSend() {
+	var (
+		req    *types.QueryNextSequenceSendRequest
+		expSeq uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryNextSequenceSendRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryNextSequenceSendRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryNextSequenceSendRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				expSeq = 1
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, expSeq)
+
+				req = &types.QueryNextSequenceSendRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+		{
+			"success: unordered channel after sending a packet",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				_, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				expSeq = 2
+
+				req = &types.QueryNextSequenceSendRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.NextSequenceSend(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSeq, res.NextSequenceSend)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
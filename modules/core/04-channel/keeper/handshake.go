@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -8,6 +9,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
@@ -17,7 +19,8 @@ import (
 
 // ChanOpenInit is called by a module to initiate a channel opening handshake with
 // a module on another chain. The counterparty channel identifier is validated to be
-// empty in msg validation.
+// empty in msg validation. The delay period of the channel is optional and may not
+// be less than the delay period of the connection if set.
 func (k Keeper) ChanOpenInit(
 	ctx sdk.Context,
 	order types.Order,
@@ -26,13 +29,21 @@ func (k Keeper) ChanOpenInit(
 	portCap *capabilitytypes.Capability,
 	counterparty types.Counterparty,
 	version string,
+	delayPeriod uint64,
 ) (string, *capabilitytypes.Capability, error) {
-	// connection hop length checked on msg.ValidateBasic()
+	if err := k.validateConnectionHops(ctx, connectionHops); err != nil {
+		return "", nil, err
+	}
+
 	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, connectionHops[0])
 	if !found {
 		return "", nil, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionHops[0])
 	}
 
+	if err := validateChannelDelayPeriod(connectionEnd, delayPeriod); err != nil {
+		return "", nil, err
+	}
+
 	getVersions := connectionEnd.GetVersions()
 	if len(getVersions) != 1 {
 		return "", nil, sdkerrors.Wrapf(
@@ -75,8 +86,10 @@ func (k Keeper) WriteOpenInitChannel(
 	connectionHops []string,
 	counterparty types.Counterparty,
 	version string,
+	delayPeriod uint64,
 ) {
 	channel := types.NewChannel(types.INIT, order, counterparty, connectionHops, version)
+	channel.DelayPeriod = delayPeriod
 	k.SetChannel(ctx, portID, channelID, channel)
 
 	k.SetNextSequenceSend(ctx, portID, channelID, 1)
@@ -93,7 +106,8 @@ func (k Keeper) WriteOpenInitChannel(
 }
 
 // ChanOpenTry is called by a module to accept the first step of a channel opening
-// handshake initiated by a module on another chain.
+// handshake initiated by a module on another chain. The delay period must match the
+// delay period of the counterparty channel.
 func (k Keeper) ChanOpenTry(
 	ctx sdk.Context,
 	order types.Order,
@@ -102,12 +116,12 @@ func (k Keeper) ChanOpenTry(
 	portCap *capabilitytypes.Capability,
 	counterparty types.Counterparty,
 	counterpartyVersion string,
+	delayPeriod uint64,
 	proofInit []byte,
 	proofHeight exported.Height,
 ) (string, *capabilitytypes.Capability, error) {
-	// connection hops only supports a single connection
-	if len(connectionHops) != 1 {
-		return "", nil, sdkerrors.Wrapf(types.ErrTooManyConnectionHops, "expected 1, got %d", len(connectionHops))
+	if err := k.validateConnectionHops(ctx, connectionHops); err != nil {
+		return "", nil, err
 	}
 
 	// generate a new channel
@@ -146,6 +160,10 @@ func (k Keeper) ChanOpenTry(
 		)
 	}
 
+	if err := validateChannelDelayPeriod(connectionEnd, delayPeriod); err != nil {
+		return "", nil, err
+	}
+
 	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
 
 	// expectedCounterpaty is the counterparty of the counterparty's channel end
@@ -155,6 +173,7 @@ func (k Keeper) ChanOpenTry(
 		types.INIT, order, expectedCounterparty,
 		counterpartyHops, counterpartyVersion,
 	)
+	expectedChannel.DelayPeriod = delayPeriod
 
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx, connectionEnd, proofHeight, proofInit,
@@ -187,12 +206,14 @@ func (k Keeper) WriteOpenTryChannel(
 	connectionHops []string,
 	counterparty types.Counterparty,
 	version string,
+	delayPeriod uint64,
 ) {
 	k.SetNextSequenceSend(ctx, portID, channelID, 1)
 	k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 	k.SetNextSequenceAck(ctx, portID, channelID, 1)
 
 	channel := types.NewChannel(types.TRYOPEN, order, counterparty, connectionHops, version)
+	channel.DelayPeriod = delayPeriod
 
 	k.SetChannel(ctx, portID, channelID, channel)
 
@@ -250,6 +271,7 @@ func (k Keeper) ChanOpenAck(
 		types.TRYOPEN, channel.Ordering, expectedCounterparty,
 		counterpartyHops, counterpartyVersion,
 	)
+	expectedChannel.DelayPeriod = channel.DelayPeriod
 
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx, connectionEnd, proofHeight, proofTry,
@@ -335,6 +357,7 @@ func (k Keeper) ChanOpenConfirm(
 		types.OPEN, channel.Ordering, counterparty,
 		counterpartyHops, channel.Version,
 	)
+	expectedChannel.DelayPeriod = channel.DelayPeriod
 
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx, connectionEnd, proofHeight, proofAck,
@@ -464,6 +487,7 @@ func (k Keeper) ChanCloseConfirm(
 		types.CLOSED, channel.Ordering, counterparty,
 		counterpartyHops, channel.Version,
 	)
+	expectedChannel.DelayPeriod = channel.DelayPeriod
 
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx, connectionEnd, proofHeight, proofInit,
@@ -486,3 +510,141 @@ func (k Keeper) ChanCloseConfirm(
 
 	return nil
 }
+
+// ChanUpdateConnection is called by the governance authority to move an open channel from a
+// connection whose client is frozen or expired to a healthy connection to the same counterparty
+// chain, such as a connection established after recovering from the loss of the previous client.
+// The new connection must be open, its client must be active and must track the same chain as the
+// previous client. Packet proofs verified after the update, including proofs for packets sent
+// before the update, are verified by the client of the new connection. The counterparty channel
+// end is not modified and must reference a connection to the new client on the counterparty chain.
+func (k Keeper) ChanUpdateConnection(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	connectionID string,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "channel state is not OPEN (got %s)", channel.State.String())
+	}
+
+	previousConnectionID := channel.ConnectionHops[0]
+	if previousConnectionID == connectionID {
+		return sdkerrors.Wrapf(types.ErrConnectionUpdateNotAllowed, "channel already uses connection %s", connectionID)
+	}
+
+	previousConnection, found := k.connectionKeeper.GetConnection(ctx, previousConnectionID)
+	if !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, previousConnectionID)
+	}
+
+	previousClientState, found := k.clientKeeper.GetClientState(ctx, previousConnection.GetClientID())
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, previousConnection.GetClientID())
+	}
+
+	// a channel on a healthy connection must not be moved to another connection
+	status := previousClientState.Status(ctx, k.clientKeeper.ClientStore(ctx, previousConnection.GetClientID()), k.cdc)
+	if status != exported.Frozen && status != exported.Expired {
+		return sdkerrors.Wrapf(types.ErrConnectionUpdateNotAllowed, "client (%s) status must be %s or %s (got %s)", previousConnection.GetClientID(), exported.Frozen, exported.Expired, status)
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
+		)
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, connectionEnd.GetClientID())
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, connectionEnd.GetClientID())
+	}
+
+	if status := clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, connectionEnd.GetClientID()), k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", connectionEnd.GetClientID(), status)
+	}
+
+	if err := k.clientKeeper.ValidateSameChain(previousClientState, clientState); err != nil {
+		return sdkerrors.Wrap(types.ErrConnectionUpdateNotAllowed, err.Error())
+	}
+
+	if !bytes.Equal(previousConnection.GetCounterparty().GetPrefix().Bytes(), connectionEnd.GetCounterparty().GetPrefix().Bytes()) {
+		return sdkerrors.Wrapf(
+			types.ErrConnectionUpdateNotAllowed,
+			"connection counterparty prefix (%s) does not match previous connection counterparty prefix (%s)",
+			connectionEnd.GetCounterparty().GetPrefix(), previousConnection.GetCounterparty().GetPrefix(),
+		)
+	}
+
+	if err := validateChannelDelayPeriod(connectionEnd, channel.DelayPeriod); err != nil {
+		return err
+	}
+
+	getVersions := connectionEnd.GetVersions()
+	if len(getVersions) != 1 {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidVersion,
+			"single version must be negotiated on connection, got: %v",
+			getVersions,
+		)
+	}
+
+	if !connectiontypes.VerifySupportedFeature(getVersions[0], channel.Ordering.String()) {
+		return sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidVersion,
+			"connection version %s does not support channel ordering: %s",
+			getVersions[0], channel.Ordering.String(),
+		)
+	}
+
+	k.Logger(ctx).Info("channel connection updated", "port-id", portID, "channel-id", channelID, "previous-connection-id", previousConnectionID, "connection-id", connectionID)
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "update-connection")
+	}()
+
+	channel.ConnectionHops = []string{connectionID}
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	EmitChannelUpdateConnectionEvent(ctx, portID, channelID, previousConnectionID, channel)
+
+	return nil
+}
+
+// validateConnectionHops returns an error if no connection hops are provided or if the number of
+// provided connection hops exceeds the maximum number of connection hops of the channel parameters.
+func (k Keeper) validateConnectionHops(ctx sdk.Context, connectionHops []string) error {
+	if len(connectionHops) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidChannel, "connection hops cannot be empty")
+	}
+
+	if maxConnectionHops := k.GetMaxConnectionHops(ctx); uint64(len(connectionHops)) > maxConnectionHops {
+		return sdkerrors.Wrapf(types.ErrTooManyConnectionHops, "expected at most %d, got %d", maxConnectionHops, len(connectionHops))
+	}
+
+	return nil
+}
+
+// validateChannelDelayPeriod returns an error if the provided channel delay period is set and
+// less than the delay period of the underlying connection.
+func validateChannelDelayPeriod(connectionEnd connectiontypes.ConnectionEnd, delayPeriod uint64) error {
+	if delayPeriod != 0 && delayPeriod < connectionEnd.GetDelayPeriod() {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidDelayPeriod,
+			"channel delay period (%d) cannot be less than the connection delay period (%d)", delayPeriod, connectionEnd.GetDelayPeriod(),
+		)
+	}
+
+	return nil
+}
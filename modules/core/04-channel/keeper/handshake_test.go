@@ -8,8 +8,10 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 )
 
@@ -25,9 +27,10 @@ type testCase = struct {
 // can succeed.
 func (suite *KeeperTestSuite) TestChanOpenInit() {
 	var (
-		path     *ibctesting.Path
-		features []string
-		portCap  *capabilitytypes.Capability
+		path           *ibctesting.Path
+		features       []string
+		portCap        *capabilitytypes.Capability
+		connectionHops []string
 	)
 
 	testCases := []testCase{
@@ -85,6 +88,31 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
 			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
 		}, true},
+		{"success with channel delay period", func() {
+			path.EndpointA.ConnectionConfig.DelayPeriod = 10
+			path.EndpointB.ConnectionConfig.DelayPeriod = 10
+			path.EndpointA.ChannelConfig.DelayPeriod = 20
+			suite.coordinator.SetupConnections(path)
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
+		}, true},
+		{"channel delay period less than connection delay period", func() {
+			path.EndpointA.ConnectionConfig.DelayPeriod = 20
+			path.EndpointB.ConnectionConfig.DelayPeriod = 20
+			path.EndpointA.ChannelConfig.DelayPeriod = 10
+			suite.coordinator.SetupConnections(path)
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
+		}, false},
+		{"too many connection hops", func() {
+			suite.coordinator.SetupConnections(path)
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
+			connectionHops = []string{path.EndpointA.ConnectionID, path.EndpointA.ConnectionID}
+		}, false},
 	}
 
 	for _, tc := range testCases {
@@ -96,14 +124,20 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 				path = ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.EndpointA.ChannelConfig.Order = order
 				path.EndpointB.ChannelConfig.Order = order
+				connectionHops = nil // defaults to the connection of endpoint A if not set in malleate
 
 				tc.malleate()
 
+				if connectionHops == nil {
+					connectionHops = []string{path.EndpointA.ConnectionID}
+				}
+
 				counterparty := types.NewCounterparty(ibctesting.MockPort, ibctesting.FirstChannelID)
 
 				channelID, cap, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanOpenInit(
-					suite.chainA.GetContext(), path.EndpointA.ChannelConfig.Order, []string{path.EndpointA.ConnectionID},
+					suite.chainA.GetContext(), path.EndpointA.ChannelConfig.Order, connectionHops,
 					path.EndpointA.ChannelConfig.PortID, portCap, counterparty, path.EndpointA.ChannelConfig.Version,
+					path.EndpointA.ChannelConfig.DelayPeriod,
 				)
 
 				// check if order is supported by channel to determine expected behaviour
@@ -143,9 +177,10 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 // ChanOpenTry can succeed.
 func (suite *KeeperTestSuite) TestChanOpenTry() {
 	var (
-		path       *ibctesting.Path
-		portCap    *capabilitytypes.Capability
-		heightDiff uint64
+		path           *ibctesting.Path
+		portCap        *capabilitytypes.Capability
+		heightDiff     uint64
+		connectionHops []string
 	)
 
 	testCases := []testCase{
@@ -232,17 +267,68 @@ func (suite *KeeperTestSuite) TestChanOpenTry() {
 			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
 			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
 		}, false},
+		{"success with channel delay period", func() {
+			path.EndpointA.ChannelConfig.DelayPeriod = 10
+			path.EndpointB.ChannelConfig.DelayPeriod = 10
+			suite.coordinator.SetupConnections(path)
+			path.SetChannelOrdered()
+			path.EndpointA.ChanOpenInit()
+
+			suite.chainB.CreatePortCapability(suite.chainB.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainB.GetPortCapability(ibctesting.MockPort)
+		}, true},
+		{"channel delay period does not match counterparty channel", func() {
+			path.EndpointA.ChannelConfig.DelayPeriod = 10
+			suite.coordinator.SetupConnections(path)
+			path.SetChannelOrdered()
+			path.EndpointA.ChanOpenInit()
+
+			suite.chainB.CreatePortCapability(suite.chainB.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainB.GetPortCapability(ibctesting.MockPort)
+		}, false},
+		{"channel delay period less than connection delay period", func() {
+			path.EndpointA.ChannelConfig.DelayPeriod = 10
+			path.EndpointB.ChannelConfig.DelayPeriod = 10
+			suite.coordinator.SetupConnections(path)
+			path.SetChannelOrdered()
+			path.EndpointA.ChanOpenInit()
+
+			// modify connB delay period to be greater than the channel delay period
+			conn := path.EndpointB.GetConnection()
+			conn.DelayPeriod = 20
+
+			suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetConnection(
+				suite.chainB.GetContext(),
+				path.EndpointB.ConnectionID, conn,
+			)
+			suite.chainB.CreatePortCapability(suite.chainB.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainB.GetPortCapability(ibctesting.MockPort)
+		}, false},
+		{"too many connection hops", func() {
+			suite.coordinator.SetupConnections(path)
+			path.SetChannelOrdered()
+			path.EndpointA.ChanOpenInit()
+
+			suite.chainB.CreatePortCapability(suite.chainB.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainB.GetPortCapability(ibctesting.MockPort)
+			connectionHops = []string{path.EndpointB.ConnectionID, path.EndpointB.ConnectionID}
+		}, false},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
-			suite.SetupTest() // reset
-			heightDiff = 0    // must be explicitly changed in malleate
+			suite.SetupTest()    // reset
+			heightDiff = 0       // must be explicitly changed in malleate
+			connectionHops = nil // defaults to the connection of endpoint B if not set in malleate
 			path = ibctesting.NewPath(suite.chainA, suite.chainB)
 
 			tc.malleate()
 
+			if connectionHops == nil {
+				connectionHops = []string{path.EndpointB.ConnectionID}
+			}
+
 			if path.EndpointB.ClientID != "" {
 				// ensure client is up to date
 				err := path.EndpointB.UpdateClient()
@@ -255,9 +341,9 @@ func (suite *KeeperTestSuite) TestChanOpenTry() {
 			proof, proofHeight := suite.chainA.QueryProof(channelKey)
 
 			channelID, cap, err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanOpenTry(
-				suite.chainB.GetContext(), types.ORDERED, []string{path.EndpointB.ConnectionID},
+				suite.chainB.GetContext(), types.ORDERED, connectionHops,
 				path.EndpointB.ChannelConfig.PortID, portCap, counterparty, path.EndpointA.ChannelConfig.Version,
-				proof, malleateHeight(proofHeight, heightDiff),
+				path.EndpointB.ChannelConfig.DelayPeriod, proof, malleateHeight(proofHeight, heightDiff),
 			)
 
 			if tc.expPass {
@@ -640,7 +726,7 @@ func (suite *KeeperTestSuite) TestChanCloseInit() {
 			path.SetChannelOrdered()
 			err = path.EndpointA.ChanOpenInit()
 			suite.Require().NoError(err)
-			
+
 			// ensure channel capability check passes
 			suite.chainA.CreateChannelCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
@@ -690,6 +776,28 @@ func (suite *KeeperTestSuite) TestChanCloseConfirm() {
 			err := path.EndpointA.SetChannelClosed()
 			suite.Require().NoError(err)
 		}, true},
+		{"success: channel with delay period", func() {
+			path.EndpointA.ChannelConfig.DelayPeriod = 10
+			path.EndpointB.ChannelConfig.DelayPeriod = 10
+			suite.coordinator.Setup(path)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			err := path.EndpointA.SetChannelClosed()
+			suite.Require().NoError(err)
+		}, true},
+		{"counterparty channel delay period does not match", func() {
+			path.EndpointA.ChannelConfig.DelayPeriod = 10
+			path.EndpointB.ChannelConfig.DelayPeriod = 10
+			suite.coordinator.Setup(path)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			err := path.EndpointA.SetChannelClosed()
+			suite.Require().NoError(err)
+
+			channel := path.EndpointB.GetChannel()
+			channel.DelayPeriod = 20
+			path.EndpointB.SetChannel(channel)
+		}, false},
 		{"channel doesn't exist", func() {
 			// any non-nil values work for connections
 			path.EndpointA.ChannelID = ibctesting.FirstChannelID
@@ -781,6 +889,127 @@ func (suite *KeeperTestSuite) TestChanCloseConfirm() {
 	}
 }
 
+// TestChanUpdateConnection tests moving the channel on chainA from a connection whose client is
+// frozen to a new connection to chainB.
+func (suite *KeeperTestSuite) TestChanUpdateConnection() {
+	var (
+		path         *ibctesting.Path
+		newPath      *ibctesting.Path
+		connectionID string
+		expError     error
+	)
+
+	freezeClient := func(endpoint *ibctesting.Endpoint) {
+		clientState := endpoint.GetClientState().(*ibctm.ClientState)
+		clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+		endpoint.SetClientState(clientState)
+	}
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = ibctesting.InvalidID
+			expError = types.ErrChannelNotFound
+		}, false},
+		{"channel is not open", func() {
+			err := path.EndpointA.SetChannelClosed()
+			suite.Require().NoError(err)
+
+			expError = types.ErrInvalidChannelState
+		}, false},
+		{"channel already uses the connection", func() {
+			connectionID = path.EndpointA.ConnectionID
+			expError = types.ErrConnectionUpdateNotAllowed
+		}, false},
+		{"client of the previous connection is active", func() {
+			clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			clientState.FrozenHeight = clienttypes.ZeroHeight()
+			path.EndpointA.SetClientState(clientState)
+
+			expError = types.ErrConnectionUpdateNotAllowed
+		}, false},
+		{"connection not found", func() {
+			connectionID = ibctesting.InvalidID
+			expError = connectiontypes.ErrConnectionNotFound
+		}, false},
+		{"connection is not open", func() {
+			connection := newPath.EndpointA.GetConnection()
+			connection.State = connectiontypes.INIT
+			newPath.EndpointA.SetConnection(connection)
+
+			expError = connectiontypes.ErrInvalidConnectionState
+		}, false},
+		{"client of the connection is not active", func() {
+			freezeClient(newPath.EndpointA)
+			expError = clienttypes.ErrClientNotActive
+		}, false},
+		{"client of the connection tracks a different chain", func() {
+			clientState := newPath.EndpointA.GetClientState().(*ibctm.ClientState)
+			clientState.ChainId = "different-chain"
+			newPath.EndpointA.SetClientState(clientState)
+
+			expError = types.ErrConnectionUpdateNotAllowed
+		}, false},
+		{"counterparty prefix does not match", func() {
+			connection := newPath.EndpointA.GetConnection()
+			connection.Counterparty.Prefix = commitmenttypes.NewMerklePrefix([]byte("different-prefix"))
+			newPath.EndpointA.SetConnection(connection)
+
+			expError = types.ErrConnectionUpdateNotAllowed
+		}, false},
+		{"connection delay period exceeds channel delay period", func() {
+			channel := path.EndpointA.GetChannel()
+			channel.DelayPeriod = 1
+			path.EndpointA.SetChannel(channel)
+
+			connection := newPath.EndpointA.GetConnection()
+			connection.DelayPeriod = 10
+			newPath.EndpointA.SetConnection(connection)
+
+			expError = types.ErrInvalidDelayPeriod
+		}, false},
+		{"connection does not support channel ordering", func() {
+			connection := newPath.EndpointA.GetConnection()
+			connection.Versions = []*connectiontypes.Version{connectiontypes.NewVersion(connectiontypes.DefaultIBCVersionIdentifier, []string{"ORDER_ORDERED"})}
+			newPath.EndpointA.SetConnection(connection)
+
+			expError = connectiontypes.ErrInvalidVersion
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expError = nil
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// establish a new client and connection to chainB
+			newPath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(newPath)
+			connectionID = newPath.EndpointA.ConnectionID
+
+			// the client of the channel's connection can no longer be used
+			freezeClient(path.EndpointA)
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanUpdateConnection(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, connectionID,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]string{newPath.EndpointA.ConnectionID}, path.EndpointA.GetChannel().ConnectionHops)
+			} else {
+				suite.Require().ErrorIs(err, expError)
+			}
+		})
+	}
+}
+
 func malleateHeight(height exported.Height, diff uint64) exported.Height {
 	return clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()+diff)
 }
@@ -1,14 +1,17 @@
 package keeper
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 	db "github.com/tendermint/tm-db"
 
@@ -29,25 +32,36 @@ type Keeper struct {
 
 	storeKey         storetypes.StoreKey
 	cdc              codec.BinaryCodec
+	paramSpace       paramtypes.Subspace
 	clientKeeper     types.ClientKeeper
 	connectionKeeper types.ConnectionKeeper
 	portKeeper       types.PortKeeper
 	scopedKeeper     exported.ScopedKeeper
+
+	// ackCodecs holds the acknowledgement codecs registered by applications keyed by port ID
+	ackCodecs map[string]types.AcknowledgementCodec
 }
 
 // NewKeeper creates a new IBC channel Keeper instance
 func NewKeeper(
-	cdc codec.BinaryCodec, key storetypes.StoreKey,
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	clientKeeper types.ClientKeeper, connectionKeeper types.ConnectionKeeper,
 	portKeeper types.PortKeeper, scopedKeeper exported.ScopedKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		storeKey:         key,
 		cdc:              cdc,
+		paramSpace:       paramSpace,
 		clientKeeper:     clientKeeper,
 		connectionKeeper: connectionKeeper,
 		portKeeper:       portKeeper,
 		scopedKeeper:     scopedKeeper,
+		ackCodecs:        make(map[string]types.AcknowledgementCodec),
 	}
 }
 
@@ -56,6 +70,76 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"/"+types.SubModuleName)
 }
 
+// RegisterAcknowledgementCodec registers the codec used to marshal the acknowledgements of packets
+// received on the provided port, allowing an application to commit to acknowledgements in a custom
+// format. The acknowledgement bytes returned by the codec are stored and hashed as is; their
+// interpretation is left to the application. It panics if the port identifier is invalid or if a
+// codec is already registered for the port, and must only be called during app initialization.
+func (k Keeper) RegisterAcknowledgementCodec(portID string, codec types.AcknowledgementCodec) {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		panic(err)
+	}
+
+	if codec == nil {
+		panic(fmt.Errorf("acknowledgement codec for port %s cannot be nil", portID))
+	}
+
+	if _, found := k.ackCodecs[portID]; found {
+		panic(fmt.Errorf("acknowledgement codec already registered for port %s", portID))
+	}
+
+	k.ackCodecs[portID] = codec
+}
+
+// GetAcknowledgementCodec returns the acknowledgement codec registered for the provided port.
+func (k Keeper) GetAcknowledgementCodec(portID string) (types.AcknowledgementCodec, bool) {
+	codec, found := k.ackCodecs[portID]
+	return codec, found
+}
+
+// MarshalAcknowledgement returns the bytes committed to for an acknowledgement of a packet received
+// on the provided port. The acknowledgement codec registered for the port is used if any, otherwise
+// the bytes returned by the Acknowledgement function of the acknowledgement are used.
+func (k Keeper) MarshalAcknowledgement(portID string, ack exported.Acknowledgement) ([]byte, error) {
+	codec, found := k.GetAcknowledgementCodec(portID)
+	if !found {
+		return ack.Acknowledgement(), nil
+	}
+
+	bz, err := codec.MarshalAcknowledgement(ack)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAcknowledgement, "failed to marshal acknowledgement for port %s: %s", portID, err.Error())
+	}
+
+	return bz, nil
+}
+
+// UnmarshalAcknowledgement decodes the acknowledgement bytes of a packet sent from the provided port.
+// The acknowledgement codec registered for the port is used if any, otherwise the bytes are decoded as
+// a standard IBC acknowledgement.
+func (k Keeper) UnmarshalAcknowledgement(portID string, bz []byte) (exported.Acknowledgement, error) {
+	codec, found := k.GetAcknowledgementCodec(portID)
+	if found {
+		ack, err := codec.UnmarshalAcknowledgement(bz)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAcknowledgement, "failed to unmarshal acknowledgement for port %s: %s", portID, err.Error())
+		}
+
+		return ack, nil
+	}
+
+	var ack types.Acknowledgement
+	if err := types.SubModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAcknowledgement, "cannot unmarshal acknowledgement: %s", err.Error())
+	}
+
+	if err := ack.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return ack, nil
+}
+
 // GenerateChannelIdentifier returns the next channel identifier.
 func (k Keeper) GenerateChannelIdentifier(ctx sdk.Context) string {
 	nextChannelSeq := k.GetNextChannelSequence(ctx)
@@ -185,6 +269,11 @@ func (k Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequ
 	store.Set(host.PacketReceiptKey(portID, channelID, sequence), []byte{byte(1)})
 }
 
+func (k Keeper) deletePacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketReceiptKey(portID, channelID, sequence))
+}
+
 // GetPacketCommitment gets the packet commitment hash from the store
 func (k Keeper) GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte {
 	store := ctx.KVStore(k.storeKey)
@@ -198,15 +287,133 @@ func (k Keeper) HasPacketCommitment(ctx sdk.Context, portID, channelID string, s
 	return store.Has(host.PacketCommitmentKey(portID, channelID, sequence))
 }
 
-// SetPacketCommitment sets the packet commitment hash to the store
+// SetPacketCommitment sets the packet commitment hash to the store. The number of packets in
+// flight on the channel is incremented if no commitment was stored for the sequence.
 func (k Keeper) SetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64, commitmentHash []byte) {
+	if !k.HasPacketCommitment(ctx, portID, channelID, sequence) {
+		k.setInFlightPackets(ctx, portID, channelID, k.GetInFlightPackets(ctx, portID, channelID)+1)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Set(host.PacketCommitmentKey(portID, channelID, sequence), commitmentHash)
 }
 
 func (k Keeper) deletePacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	// the number of packets in flight is never decremented below zero
+	if inFlightPackets := k.GetInFlightPackets(ctx, portID, channelID); inFlightPackets > 0 && k.HasPacketCommitment(ctx, portID, channelID, sequence) {
+		k.setInFlightPackets(ctx, portID, channelID, inFlightPackets-1)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(host.PacketCommitmentKey(portID, channelID, sequence))
+	store.Delete(types.PacketTimeoutKey(portID, channelID, sequence))
+}
+
+// GetPacketTimeout returns the timeouts of the sent packet with the provided sequence. The timeouts
+// are stored as long as the packet commitment is stored.
+func (k Keeper) GetPacketTimeout(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketTimeout, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketTimeoutKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.PacketTimeout{}, false
+	}
+
+	var packetTimeout types.PacketTimeout
+	k.cdc.MustUnmarshal(bz, &packetTimeout)
+	return packetTimeout, true
+}
+
+// SetPacketTimeout stores the timeouts of a sent packet.
+func (k Keeper) SetPacketTimeout(ctx sdk.Context, packetTimeout types.PacketTimeout) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packetTimeout)
+	store.Set(types.PacketTimeoutKey(packetTimeout.PortId, packetTimeout.ChannelId, packetTimeout.Sequence), bz)
+}
+
+// GetAllPacketTimeouts returns the timeouts of all sent packets with a stored commitment.
+func (k Keeper) GetAllPacketTimeouts(ctx sdk.Context) []types.PacketTimeout {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.KeyPacketTimeoutPrefix))
+	defer iterator.Close()
+
+	var packetTimeouts []types.PacketTimeout
+	for ; iterator.Valid(); iterator.Next() {
+		var packetTimeout types.PacketTimeout
+		k.cdc.MustUnmarshal(iterator.Value(), &packetTimeout)
+		packetTimeouts = append(packetTimeouts, packetTimeout)
+	}
+	return packetTimeouts
+}
+
+// GetTimedOutPacketCommitments returns the commitments of the packets sent on a channel which can
+// be timed out, i.e. whose timeout height or timeout timestamp has been reached by the provided
+// height and timestamp (in nanoseconds) of the counterparty chain. Commitments without stored
+// timeouts, e.g. those of packets sent before the timeouts were stored, are not returned.
+//
+// The stored packet commitments of the channel are iterated in store key order, starting after the
+// commitment of the provided cursor sequence, or at the first commitment if it is 0. At most limit
+// commitments are checked per call, all remaining commitments are checked if limit is 0. The sequence
+// of the last checked commitment is returned along with whether commitments remain, in which case the
+// lookup may be continued by passing the returned sequence as the cursor.
+func (k Keeper) GetTimedOutPacketCommitments(
+	ctx sdk.Context, portID, channelID string, counterpartyHeight exported.Height, counterpartyTime, sequence, limit uint64,
+) (commitments []types.PacketState, lastSequence uint64, hasRemaining bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.PacketCommitmentPrefixPath(portID, channelID)+"/"))
+
+	// the iteration starts at the key directly following the key of the cursor sequence
+	var start []byte
+	if sequence != 0 {
+		start = append([]byte(strconv.FormatUint(sequence, 10)), 0)
+	}
+
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	counterpartyClock := types.NewClock(counterpartyHeight, counterpartyTime)
+	for checked := uint64(0); iterator.Valid(); iterator.Next() {
+		if limit != 0 && checked == limit {
+			return commitments, sequence, true
+		}
+
+		var err error
+		sequence, err = strconv.ParseUint(string(iterator.Key()), 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		checked++
+
+		packetTimeout, found := k.GetPacketTimeout(ctx, portID, channelID, sequence)
+		if !found || !types.TimeoutReached(packetTimeout, counterpartyClock) {
+			continue
+		}
+
+		commitments = append(commitments, types.NewPacketState(portID, channelID, sequence, iterator.Value()))
+	}
+
+	return commitments, sequence, false
+}
+
+// GetInFlightPackets returns the number of packets in flight on a channel, i.e. the number of
+// packets sent on the channel which have been neither acknowledged nor timed out.
+func (k Keeper) GetInFlightPackets(ctx sdk.Context, portID, channelID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.InFlightPacketsKey(portID, channelID))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setInFlightPackets sets the number of packets in flight on a channel.
+func (k Keeper) setInFlightPackets(ctx sdk.Context, portID, channelID string, inFlightPackets uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if inFlightPackets == 0 {
+		store.Delete(types.InFlightPacketsKey(portID, channelID))
+		return
+	}
+
+	store.Set(types.InFlightPacketsKey(portID, channelID), sdk.Uint64ToBigEndian(inFlightPackets))
 }
 
 // SetPacketAcknowledgement sets the packet ack hash to the store
@@ -231,6 +438,11 @@ func (k Keeper) HasPacketAcknowledgement(ctx sdk.Context, portID, channelID stri
 	return store.Has(host.PacketAcknowledgementKey(portID, channelID, sequence))
 }
 
+func (k Keeper) deletePacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketAcknowledgementKey(portID, channelID, sequence))
+}
+
 // IteratePacketSequence provides an iterator over all send, receive or ack sequences.
 // For each sequence, cb will be called. If the cb returns true, the iterator
 // will close and stop.
@@ -335,6 +547,15 @@ func (k Keeper) IteratePacketReceipt(ctx sdk.Context, cb func(portID, channelID
 	k.iterateHashes(ctx, iterator, cb)
 }
 
+// IteratePacketReceiptAtChannel provides an iterator over all PacketReceipt objects
+// at a specified channel. For each packet receipt, cb will be called. If the cb returns
+// true, the iterator will close and stop.
+func (k Keeper) IteratePacketReceiptAtChannel(ctx sdk.Context, portID, channelID string, cb func(_, _ string, sequence uint64, receipt []byte) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.PacketReceiptPrefixPath(portID, channelID)))
+	k.iterateHashes(ctx, iterator, cb)
+}
+
 // GetAllPacketReceipts returns all stored PacketReceipt objects.
 func (k Keeper) GetAllPacketReceipts(ctx sdk.Context) (receipts []types.PacketState) {
 	k.IteratePacketReceipt(ctx, func(portID, channelID string, sequence uint64, receipt []byte) bool {
@@ -354,6 +575,15 @@ func (k Keeper) IteratePacketAcknowledgement(ctx sdk.Context, cb func(portID, ch
 	k.iterateHashes(ctx, iterator, cb)
 }
 
+// IteratePacketAcknowledgementAtChannel provides an iterator over all PacketAcknowledgement objects
+// at a specified channel. For each acknowledgement, cb will be called. If the cb returns true, the
+// iterator will close and stop.
+func (k Keeper) IteratePacketAcknowledgementAtChannel(ctx sdk.Context, portID, channelID string, cb func(_, _ string, sequence uint64, hash []byte) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.PacketAcknowledgementPrefixPath(portID, channelID)))
+	k.iterateHashes(ctx, iterator, cb)
+}
+
 // GetAllPacketAcks returns all stored PacketAcknowledgements objects.
 func (k Keeper) GetAllPacketAcks(ctx sdk.Context) (acks []types.PacketState) {
 	k.IteratePacketAcknowledgement(ctx, func(portID, channelID string, sequence uint64, ack []byte) bool {
@@ -415,6 +645,38 @@ func (k Keeper) GetAllChannels(ctx sdk.Context) (channels []types.IdentifiedChan
 	return channels
 }
 
+// SetChannelFrozen marks the channel as frozen.
+func (k Keeper) SetChannelFrozen(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FrozenChannelKey(portID, channelID), []byte{byte(1)})
+}
+
+// DeleteChannelFrozen removes the frozen mark of the channel.
+func (k Keeper) DeleteChannelFrozen(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FrozenChannelKey(portID, channelID))
+}
+
+// IsChannelFrozen returns true if the channel is frozen.
+func (k Keeper) IsChannelFrozen(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.FrozenChannelKey(portID, channelID))
+}
+
+// GetAllFrozenChannels returns the identifiers of all frozen channels.
+func (k Keeper) GetAllFrozenChannels(ctx sdk.Context) []types.FrozenChannel {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.KeyFrozenChannelPrefix))
+	defer iterator.Close()
+
+	var frozenChannels []types.FrozenChannel
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID := host.MustParseChannelPath(string(iterator.Key()))
+		frozenChannels = append(frozenChannels, types.NewFrozenChannel(portID, channelID))
+	}
+	return frozenChannels
+}
+
 // GetChannelClientState returns the associated client state with its ID, from a port and channel identifier.
 func (k Keeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, exported.ClientState, error) {
 	channel, found := k.GetChannel(ctx, portID, channelID)
@@ -472,6 +734,14 @@ func (k Keeper) LookupModuleByChannel(ctx sdk.Context, portID, channelID string)
 	return porttypes.GetModuleOwner(modules), cap, nil
 }
 
+// HasChannelCapability returns true if the IBC module owns a capability for the channel defined by its
+// portID and channelID. The capability is created by the IBC module during the channel opening
+// handshake, before the application callback is invoked.
+func (k Keeper) HasChannelCapability(ctx sdk.Context, portID, channelID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	return ok
+}
+
 // common functionality for IteratePacketCommitment and IteratePacketAcknowledgement
 func (k Keeper) iterateHashes(_ sdk.Context, iterator db.Iterator, cb func(portID, channelID string, sequence uint64, hash []byte) bool) {
 	defer iterator.Close()
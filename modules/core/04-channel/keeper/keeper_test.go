@@ -3,10 +3,12 @@ package keeper_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 	ibcmock "github.com/cosmos/ibc-go/v6/testing/mock"
@@ -84,6 +86,52 @@ func (suite *KeeperTestSuite) TestGetAppVersion() {
 }
 
 // TestGetAllChannelsWithPortPrefix verifies ports are filtered correctly using a port prefix.
+// TestRegisterAcknowledgementCodec tests the registration of acknowledgement codecs by port.
+func (suite *KeeperTestSuite) TestRegisterAcknowledgementCodec() {
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	_, found := channelKeeper.GetAcknowledgementCodec(ibctesting.MockPort)
+	suite.Require().False(found)
+
+	suite.Require().Panics(func() {
+		channelKeeper.RegisterAcknowledgementCodec(ibctesting.MockPort, nil)
+	})
+	suite.Require().Panics(func() {
+		channelKeeper.RegisterAcknowledgementCodec("", prefixAckCodec{})
+	})
+
+	channelKeeper.RegisterAcknowledgementCodec(ibctesting.MockPort, prefixAckCodec{})
+
+	codec, found := channelKeeper.GetAcknowledgementCodec(ibctesting.MockPort)
+	suite.Require().True(found)
+	suite.Require().Equal(prefixAckCodec{}, codec)
+
+	// the codec is shared with copies of the keeper
+	_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAcknowledgementCodec(ibctesting.MockPort)
+	suite.Require().True(found)
+
+	suite.Require().Panics(func() {
+		channelKeeper.RegisterAcknowledgementCodec(ibctesting.MockPort, prefixAckCodec{})
+	})
+}
+
+// TestUnmarshalAcknowledgement tests decoding standard acknowledgements when no
+// acknowledgement codec is registered.
+func (suite *KeeperTestSuite) TestUnmarshalAcknowledgement() {
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	ack := types.NewErrorAcknowledgement(types.ErrInvalidPacket)
+	decodedAck, err := channelKeeper.UnmarshalAcknowledgement(ibctesting.MockPort, ack.Acknowledgement())
+	suite.Require().NoError(err)
+	suite.Require().Equal(ack, decodedAck)
+
+	_, err = channelKeeper.UnmarshalAcknowledgement(ibctesting.MockPort, []byte("invalid"))
+	suite.Require().ErrorIs(err, types.ErrInvalidAcknowledgement)
+
+	_, err = channelKeeper.UnmarshalAcknowledgement(ibctesting.MockPort, []byte("{}"))
+	suite.Require().ErrorIs(err, types.ErrInvalidAcknowledgement)
+}
+
 func (suite *KeeperTestSuite) TestGetAllChannelsWithPortPrefix() {
 	const (
 		secondChannelID        = "channel-1"
@@ -444,6 +492,204 @@ func (suite *KeeperTestSuite) TestGetAllPacketCommitmentsAtChannel() {
 	}
 }
 
+// TestInFlightPackets verifies that the number of packets in flight on a channel follows the
+// packet commitments stored for the channel.
+func (suite *KeeperTestSuite) TestInFlightPackets() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	suite.Require().Zero(channelKeeper.GetInFlightPackets(suite.chainA.GetContext(), portID, channelID))
+
+	sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, portID, channelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err = path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	timedOutPacket := types.NewPacket(ibctesting.MockPacketData, sequence, portID, channelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().Equal(uint64(2), channelKeeper.GetInFlightPackets(suite.chainA.GetContext(), portID, channelID))
+
+	// overwriting a commitment does not change the number of packets in flight
+	channelKeeper.SetPacketCommitment(suite.chainA.GetContext(), portID, channelID, packet.GetSequence(), types.CommitPacket(suite.chainA.Codec, packet))
+	suite.Require().Equal(uint64(2), channelKeeper.GetInFlightPackets(suite.chainA.GetContext(), portID, channelID))
+
+	// the other channels on chainA are not affected
+	suite.Require().Zero(channelKeeper.GetInFlightPackets(suite.chainA.GetContext(), portID, ibctesting.InvalidID))
+
+	suite.Require().NoError(path.RelayPacket(packet))
+	suite.Require().Equal(uint64(1), channelKeeper.GetInFlightPackets(suite.chainA.GetContext(), portID, channelID))
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(timedOutPacket))
+	suite.Require().Zero(channelKeeper.GetInFlightPackets(suite.chainA.GetContext(), portID, channelID))
+}
+
+// TestGetTimedOutPacketCommitments verifies that the commitments of the packets whose timeouts are
+// reached by the counterparty height and timestamp are returned, and that the stored timeouts are
+// removed with the packet commitments.
+func (suite *KeeperTestSuite) TestGetTimedOutPacketCommitments() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	counterpartyPortID, counterpartyChannelID := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+
+	heightTimeout := clienttypes.NewHeight(1, 50)
+	sequence, err := path.EndpointA.SendPacket(heightTimeout, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	heightPacket := types.NewPacket(ibctesting.MockPacketData, sequence, portID, channelID, counterpartyPortID, counterpartyChannelID, heightTimeout, disabledTimeoutTimestamp)
+
+	timestampTimeout := uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())
+	sequence, err = path.EndpointA.SendPacket(clienttypes.ZeroHeight(), timestampTimeout, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	timestampPacket := types.NewPacket(ibctesting.MockPacketData, sequence, portID, channelID, counterpartyPortID, counterpartyChannelID, clienttypes.ZeroHeight(), timestampTimeout)
+
+	sequence, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, portID, channelID, counterpartyPortID, counterpartyChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+	packetTimeout, found := channelKeeper.GetPacketTimeout(suite.chainA.GetContext(), portID, channelID, heightPacket.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewPacketTimeout(portID, channelID, heightPacket.GetSequence(), heightTimeout, disabledTimeoutTimestamp), packetTimeout)
+
+	commitment := func(packet types.Packet) types.PacketState {
+		return types.NewPacketState(portID, channelID, packet.GetSequence(), types.CommitPacket(suite.chainA.Codec, packet))
+	}
+
+	// getTimedOutPacketCommitments checks all commitments of the channel in a single call
+	getTimedOutPacketCommitments := func(channelID string, counterpartyHeight clienttypes.Height, counterpartyTime uint64) []types.PacketState {
+		commitments, _, hasRemaining := channelKeeper.GetTimedOutPacketCommitments(suite.chainA.GetContext(), portID, channelID, counterpartyHeight, counterpartyTime, 0, 0)
+		suite.Require().False(hasRemaining)
+		return commitments
+	}
+
+	// no timeout is reached
+	commitments := getTimedOutPacketCommitments(channelID, clienttypes.NewHeight(1, 49), timestampTimeout-1)
+	suite.Require().Empty(commitments)
+
+	// the timeout height is reached
+	commitments = getTimedOutPacketCommitments(channelID, heightTimeout, timestampTimeout-1)
+	suite.Require().Equal([]types.PacketState{commitment(heightPacket)}, commitments)
+
+	// the timeout timestamp is reached, the timeout heights of a previous revision are not
+	commitments = getTimedOutPacketCommitments(channelID, clienttypes.NewHeight(0, 1000), timestampTimeout)
+	suite.Require().Equal([]types.PacketState{commitment(timestampPacket)}, commitments)
+
+	commitments = getTimedOutPacketCommitments(channelID, defaultTimeoutHeight, timestampTimeout)
+	suite.Require().Equal([]types.PacketState{commitment(heightPacket), commitment(timestampPacket), commitment(packet)}, commitments)
+
+	// the lookup is continued from the last checked commitment once the limit is reached
+	commitments, lastSequence, hasRemaining := channelKeeper.GetTimedOutPacketCommitments(suite.chainA.GetContext(), portID, channelID, defaultTimeoutHeight, timestampTimeout, 0, 2)
+	suite.Require().Equal([]types.PacketState{commitment(heightPacket), commitment(timestampPacket)}, commitments)
+	suite.Require().Equal(timestampPacket.GetSequence(), lastSequence)
+	suite.Require().True(hasRemaining)
+
+	commitments, lastSequence, hasRemaining = channelKeeper.GetTimedOutPacketCommitments(suite.chainA.GetContext(), portID, channelID, defaultTimeoutHeight, timestampTimeout, lastSequence, 2)
+	suite.Require().Equal([]types.PacketState{commitment(packet)}, commitments)
+	suite.Require().Equal(packet.GetSequence(), lastSequence)
+	suite.Require().False(hasRemaining)
+
+	// the sequences between the stored commitments are not checked
+	channelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), portID, channelID, 1_000_000)
+	commitments, lastSequence, hasRemaining = channelKeeper.GetTimedOutPacketCommitments(suite.chainA.GetContext(), portID, channelID, defaultTimeoutHeight, timestampTimeout, lastSequence, 2)
+	suite.Require().Empty(commitments)
+	suite.Require().Equal(packet.GetSequence(), lastSequence)
+	suite.Require().False(hasRemaining)
+
+	// the other channels on chainA are not affected
+	suite.Require().Empty(getTimedOutPacketCommitments(ibctesting.InvalidID, defaultTimeoutHeight, timestampTimeout))
+
+	// commitments without stored timeouts are not returned
+	channelKeeper.SetPacketCommitment(suite.chainA.GetContext(), portID, channelID, 100, []byte("commitment"))
+	commitments = getTimedOutPacketCommitments(channelID, defaultTimeoutHeight, timestampTimeout)
+	suite.Require().Len(commitments, 3)
+
+	// the stored timeouts are removed with the packet commitments
+	suite.Require().NoError(path.RelayPacket(packet))
+	_, found = channelKeeper.GetPacketTimeout(suite.chainA.GetContext(), portID, channelID, packet.GetSequence())
+	suite.Require().False(found)
+
+	commitments = getTimedOutPacketCommitments(channelID, defaultTimeoutHeight, timestampTimeout)
+	suite.Require().Equal([]types.PacketState{commitment(heightPacket), commitment(timestampPacket)}, commitments)
+	suite.Require().Len(channelKeeper.GetAllPacketTimeouts(suite.chainA.GetContext()), 2)
+}
+
+// TestExportImportChannelPacketState tests that the packet state of a single channel is exported and imported.
+func (suite *KeeperTestSuite) TestExportImportChannelPacketState() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// the packet sent by chainA is received and acknowledged on chainB
+	sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	// the packet sent by chainB is in flight
+	_, err = path.EndpointB.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	portID, channelID := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+
+	packetState, err := channelKeeper.ExportChannelPacketState(suite.chainB.GetContext(), portID, channelID)
+	suite.Require().NoError(err)
+	suite.Require().Len(packetState.Commitments, 1)
+	suite.Require().Len(packetState.PacketTimeouts, 1)
+	suite.Require().Len(packetState.Receipts, 1)
+	suite.Require().Len(packetState.Acknowledgements, 1)
+	suite.Require().Equal(uint64(2), packetState.NextSequenceSend)
+
+	_, err = channelKeeper.ExportChannelPacketState(suite.chainB.GetContext(), portID, ibctesting.InvalidID)
+	suite.Require().ErrorIs(err, types.ErrChannelNotFound)
+
+	// import the packet state into a channel without packet state
+	suite.SetupTest()
+	path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	channelKeeper = suite.chainB.App.GetIBCKeeper().ChannelKeeper
+
+	err = channelKeeper.ImportChannelPacketState(suite.chainB.GetContext(), packetState)
+	suite.Require().NoError(err)
+
+	importedPacketState, err := channelKeeper.ExportChannelPacketState(suite.chainB.GetContext(), portID, channelID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(packetState, importedPacketState)
+	suite.Require().Equal(uint64(1), channelKeeper.GetInFlightPackets(suite.chainB.GetContext(), portID, channelID))
+
+	// the packet state cannot be imported into a channel which already stores packet state
+	err = channelKeeper.ImportChannelPacketState(suite.chainB.GetContext(), packetState)
+	suite.Require().ErrorIs(err, types.ErrInvalidPacketState)
+	suite.Require().Equal(uint64(1), channelKeeper.GetInFlightPackets(suite.chainB.GetContext(), portID, channelID))
+
+	// a single packet receipt is sufficient to reject the import
+	suite.SetupTest()
+	path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	channelKeeper = suite.chainB.App.GetIBCKeeper().ChannelKeeper
+
+	channelKeeper.SetPacketReceipt(suite.chainB.GetContext(), portID, channelID, 5)
+	err = channelKeeper.ImportChannelPacketState(suite.chainB.GetContext(), packetState)
+	suite.Require().ErrorIs(err, types.ErrInvalidPacketState)
+	suite.Require().Zero(channelKeeper.GetInFlightPackets(suite.chainB.GetContext(), portID, channelID))
+
+	// the channel must exist
+	invalidPacketState := packetState
+	invalidPacketState.ChannelId = ibctesting.InvalidID
+	err = channelKeeper.ImportChannelPacketState(suite.chainB.GetContext(), invalidPacketState)
+	suite.Require().ErrorIs(err, types.ErrChannelNotFound)
+
+	// an acknowledgement without receipt is rejected on an UNORDERED channel
+	invalidPacketState = packetState
+	invalidPacketState.Receipts = nil
+	err = channelKeeper.ImportChannelPacketState(suite.chainB.GetContext(), invalidPacketState)
+	suite.Require().ErrorIs(err, types.ErrInvalidPacketState)
+}
+
 // TestSetPacketAcknowledgement verifies that packet acknowledgements are correctly
 // set in the keeper.
 func (suite *KeeperTestSuite) TestSetPacketAcknowledgement() {
@@ -465,3 +711,26 @@ func (suite *KeeperTestSuite) TestSetPacketAcknowledgement() {
 	suite.Require().Equal(ackHash, storedAckHash)
 	suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq))
 }
+
+// TestHasChannelCapability verifies that the existence of the channel capabilities owned by the IBC
+// module is correctly reported during and after the channel opening handshake.
+func (suite *KeeperTestSuite) TestHasChannelCapability() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	channelKeeperA := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	channelKeeperB := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+
+	suite.Require().False(channelKeeperA.HasChannelCapability(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.FirstChannelID))
+
+	suite.Require().NoError(path.EndpointA.ChanOpenInit())
+	suite.Require().True(channelKeeperA.HasChannelCapability(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().False(channelKeeperB.HasChannelCapability(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, ibctesting.FirstChannelID))
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().True(channelKeeperB.HasChannelCapability(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+	// capabilities are scoped to the port and channel identifiers
+	suite.Require().False(channelKeeperA.HasChannelCapability(suite.chainA.GetContext(), ibctesting.TransferPort, path.EndpointA.ChannelID))
+	suite.Require().False(channelKeeperA.HasChannelCapability(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID))
+}
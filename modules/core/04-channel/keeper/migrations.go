@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateInFlightPackets sets the number of packets in flight of every channel to the number of
+// packet commitments stored for the channel. Commitments stored before the number of packets in
+// flight was tracked are otherwise not counted towards the MaxInFlightPackets parameter.
+func (m Migrator) MigrateInFlightPackets(ctx sdk.Context) error {
+	inFlightPackets := make(map[string]uint64)
+	m.keeper.IteratePacketCommitment(ctx, func(portID, channelID string, _ uint64, _ []byte) bool {
+		inFlightPackets[host.ChannelPath(portID, channelID)]++
+		return false
+	})
+
+	// the channels are collected before the store is written to
+	for _, channel := range m.keeper.GetAllChannels(ctx) {
+		count := inFlightPackets[host.ChannelPath(channel.PortId, channel.ChannelId)]
+		m.keeper.setInFlightPackets(ctx, channel.PortId, channel.ChannelId, count)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully migrated the number of packets in flight", "channels-with-packets-in-flight", len(inFlightPackets))
+
+	return nil
+}
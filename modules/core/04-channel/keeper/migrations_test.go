@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+// TestMigrateInFlightPackets tests that the number of packets in flight of every channel is set to
+// the number of packet commitments stored for the channel, including commitments stored before the
+// number of packets in flight was tracked.
+func (suite *KeeperTestSuite) TestMigrateInFlightPackets() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	idlePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(idlePath)
+
+	for i := 0; i < 3; i++ {
+		_, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+	}
+
+	ctx := suite.chainA.GetContext()
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(host.StoreKey))
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	idlePortID, idleChannelID := idlePath.EndpointA.ChannelConfig.PortID, idlePath.EndpointA.ChannelID
+
+	// the commitments were stored before the number of packets in flight was tracked
+	store.Delete(types.InFlightPacketsKey(portID, channelID))
+	// a channel without commitments with an inconsistent number of packets in flight
+	store.Set(types.InFlightPacketsKey(idlePortID, idleChannelID), sdk.Uint64ToBigEndian(2))
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	migrator := keeper.NewMigrator(channelKeeper)
+	suite.Require().NoError(migrator.MigrateInFlightPackets(ctx))
+
+	suite.Require().Equal(uint64(3), channelKeeper.GetInFlightPackets(ctx, portID, channelID))
+	suite.Require().Zero(channelKeeper.GetInFlightPackets(ctx, idlePortID, idleChannelID))
+
+	// the migration is idempotent
+	suite.Require().NoError(migrator.MigrateInFlightPackets(ctx))
+	suite.Require().Equal(uint64(3), channelKeeper.GetInFlightPackets(ctx, portID, channelID))
+}
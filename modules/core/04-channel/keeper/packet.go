@@ -19,6 +19,10 @@ import (
 // SendPacket is called by a module in order to send an IBC packet on a channel.
 // The packet sequence generated for the packet to be sent is returned. An error
 // is returned if one occurs.
+//
+// Either timeout may be disabled by setting it to zero, such that a zero timeout height
+// with a non-zero timeout timestamp sends a packet which only times out by timestamp.
+// At least one of the timeouts must be set.
 func (k Keeper) SendPacket(
 	ctx sdk.Context,
 	channelCap *capabilitytypes.Capability,
@@ -28,6 +32,14 @@ func (k Keeper) SendPacket(
 	timeoutTimestamp uint64,
 	data []byte,
 ) (uint64, error) {
+	if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		return 0, sdkerrors.Wrap(types.ErrInvalidTimeout, "timeout height and timeout timestamp cannot both be 0")
+	}
+
+	if maxPacketDataBytes := k.GetMaxPacketDataBytes(ctx); uint64(len(data)) > maxPacketDataBytes {
+		return 0, sdkerrors.Wrapf(types.ErrPacketDataTooLarge, "packet data of %d bytes exceeds the maximum of %d bytes", len(data), maxPacketDataBytes)
+	}
+
 	channel, found := k.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
 		return 0, sdkerrors.Wrap(types.ErrChannelNotFound, sourceChannel)
@@ -40,6 +52,16 @@ func (k Keeper) SendPacket(
 		)
 	}
 
+	if k.IsChannelFrozen(ctx, sourcePort, sourceChannel) {
+		return 0, sdkerrors.Wrapf(types.ErrChannelFrozen, "cannot send packet on frozen channel, port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	if maxInFlightPackets := k.GetMaxInFlightPackets(ctx); maxInFlightPackets != 0 {
+		if inFlightPackets := k.GetInFlightPackets(ctx, sourcePort, sourceChannel); inFlightPackets >= maxInFlightPackets {
+			return 0, sdkerrors.Wrapf(types.ErrMaxInFlightPackets, "%d packets in flight on port ID (%s) channel ID (%s), maximum is %d", inFlightPackets, sourcePort, sourceChannel, maxInFlightPackets)
+		}
+	}
+
 	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(sourcePort, sourceChannel)) {
 		return 0, sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
@@ -76,9 +98,10 @@ func (k Keeper) SendPacket(
 		return 0, sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "cannot send packet using client (%s) with status %s", connectionEnd.GetClientID(), status)
 	}
 
-	// check if packet is timed out on the receiving chain
+	// check if packet is timed out on the receiving chain, the timeout height is checked before the
+	// timestamp of the receiving chain is retrieved
 	latestHeight := clientState.GetLatestHeight()
-	if !timeoutHeight.IsZero() && latestHeight.GTE(timeoutHeight) {
+	if types.TimeoutHeightReached(packet, types.NewClock(latestHeight, 0)) {
 		return 0, sdkerrors.Wrapf(
 			types.ErrPacketTimeout,
 			"receiving chain block height >= packet timeout height (%s >= %s)", latestHeight, timeoutHeight,
@@ -90,7 +113,7 @@ func (k Keeper) SendPacket(
 		return 0, err
 	}
 
-	if packet.GetTimeoutTimestamp() != 0 && latestTimestamp >= packet.GetTimeoutTimestamp() {
+	if types.TimeoutTimestampReached(packet, types.NewClock(latestHeight, latestTimestamp)) {
 		return 0, sdkerrors.Wrapf(
 			types.ErrPacketTimeout,
 			"receiving chain block timestamp >= packet timeout timestamp (%s >= %s)", time.Unix(0, int64(latestTimestamp)), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
@@ -101,6 +124,7 @@ func (k Keeper) SendPacket(
 
 	k.SetNextSequenceSend(ctx, sourcePort, sourceChannel, sequence+1)
 	k.SetPacketCommitment(ctx, sourcePort, sourceChannel, packet.GetSequence(), commitment)
+	k.SetPacketTimeout(ctx, types.NewPacketTimeout(sourcePort, sourceChannel, packet.GetSequence(), timeoutHeight, timeoutTimestamp))
 
 	EmitSendPacketEvent(ctx, packet, channel, timeoutHeight)
 
@@ -137,6 +161,17 @@ func (k Keeper) RecvPacket(
 		)
 	}
 
+	// the packet is left unreceived, such that it may be timed out on the sending chain
+	if k.IsChannelFrozen(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		return sdkerrors.Wrapf(types.ErrChannelFrozen, "cannot receive packet on frozen channel, port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	// oversized packet data is never passed to the application so that it is not unmarshaled, the packet
+	// is left unreceived, such that it may be timed out on the sending chain
+	if maxPacketDataBytes := k.GetMaxPacketDataBytes(ctx); uint64(len(packet.GetData())) > maxPacketDataBytes {
+		return sdkerrors.Wrapf(types.ErrPacketDataTooLarge, "packet data of %d bytes exceeds the maximum of %d bytes", len(packet.GetData()), maxPacketDataBytes)
+	}
+
 	// Authenticate capability to ensure caller has authority to receive packet on this channel
 	capName := host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel())
 	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, capName) {
@@ -176,18 +211,16 @@ func (k Keeper) RecvPacket(
 		)
 	}
 
-	// check if packet timeouted by comparing it with the latest height of the chain
-	selfHeight := clienttypes.GetSelfHeight(ctx)
-	timeoutHeight := packet.GetTimeoutHeight()
-	if !timeoutHeight.IsZero() && selfHeight.GTE(timeoutHeight) {
+	// check if packet timeouted by comparing it with the latest height and timestamp of the chain
+	selfClock := types.ContextClock(ctx)
+	if types.TimeoutHeightReached(packet, selfClock) {
 		return sdkerrors.Wrapf(
 			types.ErrPacketTimeout,
-			"block height >= packet timeout height (%s >= %s)", selfHeight, timeoutHeight,
+			"block height >= packet timeout height (%s >= %s)", selfClock.GetHeight(), packet.GetTimeoutHeight(),
 		)
 	}
 
-	// check if packet timeouted by comparing it with the latest timestamp of the chain
-	if packet.GetTimeoutTimestamp() != 0 && uint64(ctx.BlockTime().UnixNano()) >= packet.GetTimeoutTimestamp() {
+	if types.TimeoutTimestampReached(packet, selfClock) {
 		return sdkerrors.Wrapf(
 			types.ErrPacketTimeout,
 			"block timestamp >= packet timeout timestamp (%s >= %s)", ctx.BlockTime(), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
@@ -198,7 +231,7 @@ func (k Keeper) RecvPacket(
 
 	// verify that the counterparty did commit to sending this packet
 	if err := k.connectionKeeper.VerifyPacketCommitment(
-		ctx, connectionEnd, proofHeight, proof,
+		ctx, verificationConnection(connectionEnd, channel), proofHeight, proof,
 		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
 		commitment,
 	); err != nil {
@@ -312,6 +345,83 @@ func (k Keeper) WriteAcknowledgement(
 		)
 	}
 
+	return k.writeAcknowledgement(ctx, channel, packet, acknowledgement)
+}
+
+// WriteAcknowledgements writes the acknowledgements of a batch of packets received on the same
+// channel. The channel state and capability are validated once for the whole batch. The packets
+// and acknowledgements are provided as parallel slices. If the acknowledgement of any packet
+// cannot be written, no acknowledgement of the batch is written and an error is returned.
+func (k Keeper) WriteAcknowledgements(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []exported.PacketI,
+	acknowledgements []exported.Acknowledgement,
+) error {
+	if len(packets) != len(acknowledgements) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidAcknowledgement,
+			"number of packets (%d) does not match the number of acknowledgements (%d)", len(packets), len(acknowledgements),
+		)
+	}
+
+	if len(packets) == 0 {
+		return nil
+	}
+
+	portID, channelID := packets[0].GetDestPort(), packets[0].GetDestChannel()
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrChannelNotFound, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not OPEN (got %s)", channel.State.String(),
+		)
+	}
+
+	// Authenticate capability to ensure caller has authority to receive packet on this channel
+	capName := host.ChannelCapabilityPath(portID, channelID)
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, capName) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelCapability,
+			"channel capability failed authentication for capability name %s", capName,
+		)
+	}
+
+	// acknowledgements are written to a cached context so that no acknowledgement is written
+	// if the batch fails on any packet
+	cacheCtx, writeFn := ctx.CacheContext()
+	for i, packet := range packets {
+		if packet.GetDestPort() != portID || packet.GetDestChannel() != channelID {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidPacket,
+				"packet destination port ID (%s) and channel ID (%s) do not match the batch port ID (%s) and channel ID (%s)",
+				packet.GetDestPort(), packet.GetDestChannel(), portID, channelID,
+			)
+		}
+
+		if err := k.writeAcknowledgement(cacheCtx, channel, packet, acknowledgements[i]); err != nil {
+			return sdkerrors.Wrapf(err, "failed to write acknowledgement for packet with sequence %d", packet.GetSequence())
+		}
+	}
+
+	// the events emitted on the cached context are emitted on the original context
+	writeFn()
+
+	return nil
+}
+
+// writeAcknowledgement validates and writes the acknowledgement of a packet received on the
+// provided channel. The channel state and capability must be validated by the caller.
+func (k Keeper) writeAcknowledgement(
+	ctx sdk.Context,
+	channel types.Channel,
+	packet exported.PacketI,
+	acknowledgement exported.Acknowledgement,
+) error {
 	// NOTE: IBC app modules might have written the acknowledgement synchronously on
 	// the OnRecvPacket callback so we need to check if the acknowledgement is already
 	// set on the store and return an error if so.
@@ -323,7 +433,11 @@ func (k Keeper) WriteAcknowledgement(
 		return sdkerrors.Wrap(types.ErrInvalidAcknowledgement, "acknowledgement cannot be nil")
 	}
 
-	bz := acknowledgement.Acknowledgement()
+	bz, err := k.MarshalAcknowledgement(packet.GetDestPort(), acknowledgement)
+	if err != nil {
+		return err
+	}
+
 	if len(bz) == 0 {
 		return sdkerrors.Wrap(types.ErrInvalidAcknowledgement, "acknowledgement cannot be empty")
 	}
@@ -433,7 +547,7 @@ func (k Keeper) AcknowledgePacket(
 	}
 
 	if err := k.connectionKeeper.VerifyPacketAcknowledgement(
-		ctx, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
+		ctx, verificationConnection(connectionEnd, channel), proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
 		packet.GetSequence(), acknowledgement,
 	); err != nil {
 		return err
@@ -483,3 +597,14 @@ func (k Keeper) AcknowledgePacket(
 
 	return nil
 }
+
+// verificationConnection returns the connection end used to verify packet proofs for the
+// provided channel. The delay period of the connection is replaced by the delay period of the
+// channel if it is set.
+func verificationConnection(connectionEnd connectiontypes.ConnectionEnd, channel types.Channel) connectiontypes.ConnectionEnd {
+	if channel.DelayPeriod != 0 {
+		connectionEnd.DelayPeriod = channel.DelayPeriod
+	}
+
+	return connectionEnd
+}
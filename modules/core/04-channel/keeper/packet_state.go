@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+// ExportChannelPacketState returns the packet state of a single channel: its packet commitments with
+// their timeouts, packet receipts, packet acknowledgements and next sequences. An error is returned if
+// the channel does not exist.
+func (k Keeper) ExportChannelPacketState(ctx sdk.Context, portID, channelID string) (types.ChannelPacketState, error) {
+	if _, found := k.GetChannel(ctx, portID, channelID); !found {
+		return types.ChannelPacketState{}, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	var packetTimeouts []types.PacketTimeout
+	commitments := k.GetAllPacketCommitmentsAtChannel(ctx, portID, channelID)
+	for _, commitment := range commitments {
+		if packetTimeout, found := k.GetPacketTimeout(ctx, portID, channelID, commitment.Sequence); found {
+			packetTimeouts = append(packetTimeouts, packetTimeout)
+		}
+	}
+
+	var receipts []types.PacketState
+	k.IteratePacketReceiptAtChannel(ctx, portID, channelID, func(_, _ string, sequence uint64, receipt []byte) bool {
+		receipts = append(receipts, types.NewPacketState(portID, channelID, sequence, receipt))
+		return false
+	})
+
+	var acks []types.PacketState
+	k.IteratePacketAcknowledgementAtChannel(ctx, portID, channelID, func(_, _ string, sequence uint64, hash []byte) bool {
+		acks = append(acks, types.NewPacketState(portID, channelID, sequence, hash))
+		return false
+	})
+
+	// the next sequences are set when the channel handshake is initialized
+	nextSequenceSend, _ := k.GetNextSequenceSend(ctx, portID, channelID)
+	nextSequenceRecv, _ := k.GetNextSequenceRecv(ctx, portID, channelID)
+	nextSequenceAck, _ := k.GetNextSequenceAck(ctx, portID, channelID)
+
+	return types.NewChannelPacketState(
+		portID, channelID, commitments, receipts, acks, packetTimeouts,
+		nextSequenceSend, nextSequenceRecv, nextSequenceAck,
+	), nil
+}
+
+// ImportChannelPacketState stores the packet state of a single channel exported by ExportChannelPacketState.
+// The channel must exist and the packet state is validated against its ordering before it is stored. The channel
+// must not store any packet commitments, receipts or acknowledgements, as merging the imported packet state with
+// existing packet state could leave commitments or receipts which are inconsistent with the imported next
+// sequences. The next sequences of the channel are overwritten.
+func (k Keeper) ImportChannelPacketState(ctx sdk.Context, packetState types.ChannelPacketState) error {
+	channel, found := k.GetChannel(ctx, packetState.PortId, packetState.ChannelId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packetState.PortId, packetState.ChannelId)
+	}
+
+	if err := packetState.Validate(channel.Ordering); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidPacketState, err.Error())
+	}
+
+	if k.hasPacketState(ctx, packetState.PortId, packetState.ChannelId) {
+		return sdkerrors.Wrapf(types.ErrInvalidPacketState, "port ID (%s) channel ID (%s) already stores packet state", packetState.PortId, packetState.ChannelId)
+	}
+
+	for _, commitment := range packetState.Commitments {
+		k.SetPacketCommitment(ctx, commitment.PortId, commitment.ChannelId, commitment.Sequence, commitment.Data)
+	}
+	for _, pt := range packetState.PacketTimeouts {
+		k.SetPacketTimeout(ctx, pt)
+	}
+	for _, receipt := range packetState.Receipts {
+		k.SetPacketReceipt(ctx, receipt.PortId, receipt.ChannelId, receipt.Sequence)
+	}
+	for _, ack := range packetState.Acknowledgements {
+		k.SetPacketAcknowledgement(ctx, ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
+	}
+
+	k.SetNextSequenceSend(ctx, packetState.PortId, packetState.ChannelId, packetState.NextSequenceSend)
+	k.SetNextSequenceRecv(ctx, packetState.PortId, packetState.ChannelId, packetState.NextSequenceRecv)
+	k.SetNextSequenceAck(ctx, packetState.PortId, packetState.ChannelId, packetState.NextSequenceAck)
+
+	return nil
+}
+
+// hasPacketState returns true if the given channel stores any packet commitment, receipt or acknowledgement.
+// Packet timeouts are only stored alongside packet commitments and are therefore covered by the commitments.
+func (k Keeper) hasPacketState(ctx sdk.Context, portID, channelID string) bool {
+	var found bool
+	stop := func(_, _ string, _ uint64, _ []byte) bool {
+		found = true
+		return true
+	}
+
+	k.IteratePacketCommitmentAtChannel(ctx, portID, channelID, stop)
+	if !found {
+		k.IteratePacketReceiptAtChannel(ctx, portID, channelID, stop)
+	}
+	if !found {
+		k.IteratePacketAcknowledgementAtChannel(ctx, portID, channelID, stop)
+	}
+
+	return found
+}
@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"time"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -82,6 +84,28 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 
 			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 		}, true},
+		{"success: timeout timestamp only", func() {
+			suite.coordinator.Setup(path)
+			sourceChannel = path.EndpointA.ChannelID
+
+			// use a timestamp after the latest time on the client state
+			clientState := path.EndpointA.GetClientState()
+			connection := path.EndpointA.GetConnection()
+			timestamp, err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetTimestampAtHeight(suite.chainA.GetContext(), connection, clientState.GetLatestHeight())
+			suite.Require().NoError(err)
+
+			timeoutHeight = disabledTimeoutHeight
+			timeoutTimestamp = timestamp + 1
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
+		{"timeout height and timeout timestamp both disabled", func() {
+			suite.coordinator.Setup(path)
+			sourceChannel = path.EndpointA.ChannelID
+
+			timeoutHeight = disabledTimeoutHeight
+			timeoutTimestamp = disabledTimeoutTimestamp
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
 		{"packet basic validation failed, empty packet data", func() {
 			suite.coordinator.Setup(path)
 			sourceChannel = path.EndpointA.ChannelID
@@ -102,6 +126,51 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 			err := path.EndpointA.SetChannelClosed()
 			suite.Require().NoError(err)
 		}, false},
+		{"channel frozen", func() {
+			suite.coordinator.Setup(path)
+			sourceChannel = path.EndpointA.ChannelID
+
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelFrozen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
+		{"packet data exceeds the maximum size", func() {
+			suite.coordinator.Setup(path)
+			sourceChannel = path.EndpointA.ChannelID
+
+			params := types.NewParams(types.DefaultMaxConnectionHops, uint64(len(packetData)-1), types.DefaultMaxInFlightPackets)
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
+		{"success: packet data of the maximum size", func() {
+			suite.coordinator.Setup(path)
+			sourceChannel = path.EndpointA.ChannelID
+
+			params := types.NewParams(types.DefaultMaxConnectionHops, uint64(len(packetData)), types.DefaultMaxInFlightPackets)
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
+		{"maximum number of packets in flight reached", func() {
+			suite.coordinator.Setup(path)
+			sourceChannel = path.EndpointA.ChannelID
+
+			params := types.NewParams(types.DefaultMaxConnectionHops, types.DefaultMaxPacketDataBytes, 1)
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+			_, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
+		{"success: fewer packets in flight than the maximum", func() {
+			suite.coordinator.Setup(path)
+			sourceChannel = path.EndpointA.ChannelID
+
+			params := types.NewParams(types.DefaultMaxConnectionHops, types.DefaultMaxPacketDataBytes, 2)
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+			_, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
 		{"connection not found", func() {
 			// pass channel check
 			suite.coordinator.Setup(path)
@@ -239,6 +308,35 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 	}
 }
 
+// TestSendPacketTimeoutCheckOrder tests that the timeout height of a packet is checked before the timestamp
+// of the receiving chain is retrieved, so that a packet whose timeout height has passed is rejected without
+// reading the consensus state of the receiving chain.
+func (suite *KeeperTestSuite) TestSendPacketTimeoutCheckOrder() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	clientState := path.EndpointA.GetClientState()
+	timestamp, err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetTimestampAtHeight(suite.chainA.GetContext(), path.EndpointA.GetConnection(), clientState.GetLatestHeight())
+	suite.Require().NoError(err)
+
+	channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+	// sendPacket returns the gas consumed by a send of a packet with the provided timeouts, which must fail
+	sendPacket := func(timeoutHeight clienttypes.Height, timeoutTimestamp uint64) uint64 {
+		ctx := suite.chainA.GetContext()
+		_, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, channelCap,
+			path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().ErrorIs(err, types.ErrPacketTimeout)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	heightGas := sendPacket(clientState.GetLatestHeight().(clienttypes.Height), disabledTimeoutTimestamp)
+	timestampGas := sendPacket(disabledTimeoutHeight, timestamp)
+
+	suite.Require().Less(heightGas, timestampGas)
+}
+
 // TestRecvPacket test RecvPacket on chainB. Since packet commitment verification will always
 // occur last (resource instensive), only tests expected to succeed and packet commitment
 // verification tests need to simulate sending a packet from chainA to chainB.
@@ -341,6 +439,29 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			suite.Require().NoError(err)
 			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 		}, false},
+		{"channel frozen", func() {
+			expError = types.ErrChannelFrozen
+
+			suite.coordinator.Setup(path)
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetChannelFrozen(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
+		{"packet data exceeds the maximum size", func() {
+			expError = types.ErrPacketDataTooLarge
+
+			suite.coordinator.Setup(path)
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+			params := types.NewParams(types.DefaultMaxConnectionHops, uint64(len(ibctesting.MockPacketData)-1), types.DefaultMaxInFlightPackets)
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
 		{"capability cannot authenticate ORDERED", func() {
 			expError = types.ErrInvalidChannelCapability
 
@@ -457,6 +578,31 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
 			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 		}, false},
+		{"success: channel delay period has passed", func() {
+			path.EndpointA.ChannelConfig.DelayPeriod = uint64(time.Second.Nanoseconds())
+			path.EndpointB.ChannelConfig.DelayPeriod = uint64(time.Second.Nanoseconds())
+			suite.coordinator.Setup(path)
+
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			suite.coordinator.CommitNBlocks(suite.chainB, 2)
+		}, true},
+		{"channel delay period has not passed", func() {
+			// skip error code check, downstream error code is used from light-client implementations
+
+			// the connection delay period is zero, the channel delay period must be enforced
+			path.EndpointA.ChannelConfig.DelayPeriod = uint64(time.Hour.Nanoseconds())
+			path.EndpointB.ChannelConfig.DelayPeriod = uint64(time.Hour.Nanoseconds())
+			suite.coordinator.Setup(path)
+
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
 	}
 
 	for i, tc := range testCases {
@@ -598,6 +744,210 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 	}
 }
 
+// prefixAckCodec is an acknowledgement codec which prefixes the standard acknowledgement bytes.
+type prefixAckCodec struct {
+	err error
+}
+
+var ackCodecPrefix = []byte("custom:")
+
+func (c prefixAckCodec) MarshalAcknowledgement(ack exported.Acknowledgement) ([]byte, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return append(append([]byte{}, ackCodecPrefix...), ack.Acknowledgement()...), nil
+}
+
+func (c prefixAckCodec) UnmarshalAcknowledgement(bz []byte) (exported.Acknowledgement, error) {
+	if !bytes.HasPrefix(bz, ackCodecPrefix) {
+		return nil, errors.New("missing acknowledgement prefix")
+	}
+
+	var ack types.Acknowledgement
+	if err := types.SubModuleCdc.UnmarshalJSON(bz[len(ackCodecPrefix):], &ack); err != nil {
+		return nil, err
+	}
+
+	return ack, nil
+}
+
+// TestWriteAcknowledgementWithCodec tests that the acknowledgement bytes returned by the
+// acknowledgement codec registered for the destination port are committed to.
+func (suite *KeeperTestSuite) TestWriteAcknowledgementWithCodec() {
+	var codec *prefixAckCodec
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: default acknowledgement format", func() {
+			codec = nil
+		}, true},
+		{"success: custom acknowledgement format", func() {}, true},
+		{"acknowledgement codec fails", func() {
+			codec.err = errors.New("cannot marshal")
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			codec = &prefixAckCodec{}
+
+			tc.malleate()
+
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			if codec != nil {
+				channelKeeper.RegisterAcknowledgementCodec(path.EndpointB.ChannelConfig.PortID, *codec)
+			}
+
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			ack := types.NewResultAcknowledgement([]byte{byte(1)})
+			channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			err := channelKeeper.WriteAcknowledgement(suite.chainB.GetContext(), channelCap, packet, ack)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				expBz := ack.Acknowledgement()
+				if codec != nil {
+					expBz = append(append([]byte{}, ackCodecPrefix...), expBz...)
+				}
+
+				commitment, found := channelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().True(found)
+				suite.Require().Equal(types.CommitAcknowledgement(expBz), commitment)
+
+				decodedAck, err := channelKeeper.UnmarshalAcknowledgement(packet.GetDestPort(), expBz)
+				suite.Require().NoError(err)
+				suite.Require().Equal(ack, decodedAck)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(channelKeeper.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			}
+		})
+	}
+}
+
+// TestWriteAcknowledgements tests the batch writing of acknowledgements on chainB. No
+// acknowledgement of the batch may be written if the batch fails.
+func (suite *KeeperTestSuite) TestWriteAcknowledgements() {
+	var (
+		path       *ibctesting.Path
+		packets    []exported.PacketI
+		acks       []exported.Acknowledgement
+		channelCap *capabilitytypes.Capability
+		expError   *sdkerrors.Error
+	)
+
+	const numPackets = 5
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"success: empty batch", func() {
+			packets = nil
+			acks = nil
+		}, true},
+		{"number of packets and acknowledgements do not match", func() {
+			acks = acks[1:]
+			expError = types.ErrInvalidAcknowledgement
+		}, false},
+		{"channel not found", func() {
+			packets[0] = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.InvalidID, ibctesting.InvalidID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			expError = types.ErrChannelNotFound
+		}, false},
+		{"channel not open", func() {
+			err := path.EndpointB.SetChannelClosed()
+			suite.Require().NoError(err)
+			expError = types.ErrInvalidChannelState
+		}, false},
+		{"capability authentication failed", func() {
+			channelCap = capabilitytypes.NewCapability(3)
+			expError = types.ErrInvalidChannelCapability
+		}, false},
+		{"packet destination does not match the batch channel", func() {
+			packets[numPackets-1] = types.NewPacket(ibctesting.MockPacketData, numPackets, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, ibctesting.InvalidID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			expError = types.ErrInvalidPacket
+		}, false},
+		{"acknowledgement already written for a packet of the batch", func() {
+			packet := packets[numPackets-1]
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), ibcmock.MockAcknowledgement.Acknowledgement())
+			expError = types.ErrAcknowledgementExists
+		}, false},
+		{"packet is duplicated in the batch", func() {
+			packets[numPackets-1] = packets[0]
+			expError = types.ErrAcknowledgementExists
+		}, false},
+		{"empty acknowledgement", func() {
+			acks[numPackets-1] = ibcmock.NewEmptyAcknowledgement()
+			expError = types.ErrInvalidAcknowledgement
+		}, false},
+		{"acknowledgement is nil", func() {
+			acks[numPackets-1] = nil
+			expError = types.ErrInvalidAcknowledgement
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expError = nil
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			packets = make([]exported.PacketI, numPackets)
+			acks = make([]exported.Acknowledgement, numPackets)
+			for i := range packets {
+				packets[i] = types.NewPacket(ibctesting.MockPacketData, uint64(i+1), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				acks[i] = ibcmock.MockAcknowledgement
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.WriteAcknowledgements(ctx, channelCap, packets, acks)
+
+			var writeAckEvents int
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeWriteAck {
+					writeAckEvents++
+				}
+			}
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(len(packets), writeAckEvents)
+
+				for _, packet := range packets {
+					_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().True(found)
+				}
+			} else {
+				suite.Require().ErrorIs(err, expError)
+				suite.Require().Zero(writeAckEvents)
+
+				// none of the acknowledgements of the batch have been written, the last
+				// packet of the batch is skipped as it may be acknowledged by the test case
+				for seq := uint64(1); seq < numPackets; seq++ {
+					_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, seq)
+					suite.Require().False(found)
+				}
+			}
+		})
+	}
+}
+
 // TestAcknowledgePacket tests the call AcknowledgePacket on chainA.
 func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 	var (
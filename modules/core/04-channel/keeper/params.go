@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+// GetMaxConnectionHops retrieves the maximum number of connection hops of a channel from the
+// paramstore. DefaultMaxConnectionHops is returned if the parameter has not been set.
+func (k Keeper) GetMaxConnectionHops(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxConnectionHops, &res)
+	if res == 0 {
+		return types.DefaultMaxConnectionHops
+	}
+	return res
+}
+
+// GetMaxPacketDataBytes retrieves the maximum size in bytes of packet data from the paramstore.
+// DefaultMaxPacketDataBytes is returned if the parameter has not been set.
+func (k Keeper) GetMaxPacketDataBytes(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxPacketDataBytes, &res)
+	if res == 0 {
+		return types.DefaultMaxPacketDataBytes
+	}
+	return res
+}
+
+// GetMaxInFlightPackets retrieves the maximum number of packets in flight on a channel from the
+// paramstore. Zero, meaning unlimited, is returned if the parameter has not been set.
+func (k Keeper) GetMaxInFlightPackets(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxInFlightPackets, &res)
+	return res
+}
+
+// GetParams returns the total set of ibc-channel parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.GetMaxConnectionHops(ctx), k.GetMaxPacketDataBytes(ctx), k.GetMaxInFlightPackets(ctx))
+}
+
+// SetParams sets the total set of ibc-channel parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
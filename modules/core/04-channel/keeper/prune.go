@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+// PruneAcknowledgements deletes up to limit packet acknowledgements stored for the given channel. It
+// returns the number of acknowledgements pruned and whether acknowledgements remain to be pruned.
+//
+// Pruning is only permitted once the channel is CLOSED and every packet sent on the channel has been
+// resolved, i.e. no packet commitment remains. Packet receipts are never pruned: once the receipt of a
+// packet is deleted, the counterparty could prove its absence and time the packet out on close although
+// it was received, refunding it a second time.
+//
+// At most limit + 1 acknowledgements are read. Pruned acknowledgements are deleted, so every call resumes
+// from the first acknowledgement which remains stored without rescanning the pruned ones.
+func (k Keeper) PruneAcknowledgements(ctx sdk.Context, portID, channelID string, limit uint64) (uint64, bool, error) {
+	if limit == 0 {
+		return 0, false, sdkerrors.Wrap(types.ErrInvalidPruningLimit, "limit must be greater than 0")
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, false, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.CLOSED {
+		return 0, false, sdkerrors.Wrapf(types.ErrInvalidChannelState, "channel state is not CLOSED (got %s)", channel.State.String())
+	}
+
+	var hasCommitment bool
+	k.IteratePacketCommitmentAtChannel(ctx, portID, channelID, func(_, _ string, _ uint64, _ []byte) bool {
+		hasCommitment = true
+		return true
+	})
+
+	if hasCommitment {
+		return 0, false, sdkerrors.Wrapf(types.ErrInvalidPacketState, "packets sent on port ID (%s) channel ID (%s) are not resolved", portID, channelID)
+	}
+
+	var sequences []uint64
+	k.IteratePacketAcknowledgementAtChannel(ctx, portID, channelID, func(_, _ string, sequence uint64, _ []byte) bool {
+		sequences = append(sequences, sequence)
+		return uint64(len(sequences)) > limit
+	})
+
+	hasRemaining := uint64(len(sequences)) > limit
+	if hasRemaining {
+		sequences = sequences[:limit]
+	}
+
+	for _, sequence := range sequences {
+		k.deletePacketAcknowledgement(ctx, portID, channelID, sequence)
+	}
+
+	totalPruned := uint64(len(sequences))
+	k.Logger(ctx).Info("packet acknowledgements pruned", "port-id", portID, "channel-id", channelID, "total-pruned", totalPruned, "has-remaining", hasRemaining)
+
+	EmitPruneAcknowledgementsEvent(ctx, portID, channelID, totalPruned, hasRemaining)
+
+	return totalPruned, hasRemaining, nil
+}
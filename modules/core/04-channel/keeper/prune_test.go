@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+// TestPruneAcknowledgements tests the pruning of packet acknowledgements stored on chainA for a
+// closed channel.
+func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
+	var (
+		path         *ibctesting.Path
+		limit        uint64
+		expPruned    uint64
+		expRemaining bool
+		expError     *sdkerrors.Error
+	)
+
+	const (
+		firstSequence = 3
+		numSequences  = 10
+	)
+
+	testCases := []testCase{
+		{"success: prune all acknowledgements", func() {
+			expPruned = numSequences
+		}, true},
+		{"success: prune with limit exceeding stored acknowledgements", func() {
+			limit = numSequences + 5
+			expPruned = numSequences
+		}, true},
+		{"success: prune part of the acknowledgements", func() {
+			limit = 4
+			expPruned = 4
+			expRemaining = true
+		}, true},
+		{"success: continue from the remaining acknowledgements", func() {
+			_, _, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PruneAcknowledgements(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 4)
+			suite.Require().NoError(err)
+
+			limit = 4
+			expPruned = 4
+			expRemaining = true
+		}, true},
+		{"success: prune the last acknowledgements", func() {
+			_, _, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PruneAcknowledgements(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 8)
+			suite.Require().NoError(err)
+
+			limit = 4
+			expPruned = numSequences - 8
+		}, true},
+		{"success: no acknowledgements remain once all are pruned", func() {
+			_, _, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PruneAcknowledgements(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, numSequences)
+			suite.Require().NoError(err)
+
+			expPruned = 0
+		}, true},
+		{"limit is zero", func() {
+			limit = 0
+			expError = types.ErrInvalidPruningLimit
+		}, false},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = ibctesting.InvalidID
+			expError = types.ErrChannelNotFound
+		}, false},
+		{"channel is not closed", func() {
+			channel := path.EndpointA.GetChannel()
+			channel.State = types.OPEN
+			path.EndpointA.SetChannel(channel)
+			expError = types.ErrInvalidChannelState
+		}, false},
+		{"packet sent on the channel is not resolved", func() {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, []byte("commitment"))
+			expError = types.ErrInvalidPacketState
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expPruned = 0
+			expRemaining = false
+			expError = nil
+			limit = numSequences
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+
+			for seq := uint64(firstSequence); seq < firstSequence+numSequences; seq++ {
+				channelKeeper.SetPacketReceipt(suite.chainA.GetContext(), portID, channelID, seq)
+				channelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), portID, channelID, seq, ibctesting.MockAcknowledgement)
+			}
+
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+
+			tc.malleate()
+
+			acksBefore := len(channelKeeper.GetAllPacketAcks(suite.chainA.GetContext()))
+
+			totalPruned, hasRemaining, err := channelKeeper.PruneAcknowledgements(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, limit)
+
+			// packet receipts are never pruned
+			suite.Require().Len(channelKeeper.GetAllPacketReceipts(suite.chainA.GetContext()), numSequences)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPruned, totalPruned)
+				suite.Require().Equal(expRemaining, hasRemaining)
+				suite.Require().Len(channelKeeper.GetAllPacketAcks(suite.chainA.GetContext()), acksBefore-int(expPruned))
+			} else {
+				suite.Require().ErrorIs(err, expError)
+				suite.Require().Zero(totalPruned)
+				suite.Require().False(hasRemaining)
+				suite.Require().Len(channelKeeper.GetAllPacketAcks(suite.chainA.GetContext()), numSequences)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
@@ -61,17 +62,10 @@ func (k Keeper) TimeoutPacket(
 	}
 
 	// check that timeout height or timeout timestamp has passed on the other end
-	proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, proofHeight)
-	if err != nil {
+	if err := k.checkTimeoutReached(ctx, connectionEnd, packet, proofHeight); err != nil {
 		return err
 	}
 
-	timeoutHeight := packet.GetTimeoutHeight()
-	if (timeoutHeight.IsZero() || proofHeight.LT(timeoutHeight)) &&
-		(packet.GetTimeoutTimestamp() == 0 || proofTimestamp < packet.GetTimeoutTimestamp()) {
-		return sdkerrors.Wrap(types.ErrPacketTimeout, "packet timeout has not been reached for height or timestamp")
-	}
-
 	commitment := k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	if len(commitment) == 0 {
@@ -97,6 +91,7 @@ func (k Keeper) TimeoutPacket(
 		return sdkerrors.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
 	}
 
+	var err error
 	switch channel.Ordering {
 	case types.ORDERED:
 		// check that packet has not been received
@@ -109,12 +104,12 @@ func (k Keeper) TimeoutPacket(
 
 		// check that the recv sequence is as claimed
 		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
+			ctx, verificationConnection(connectionEnd, channel), proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
 	case types.UNORDERED:
 		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
+			ctx, verificationConnection(connectionEnd, channel), proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		)
 	default:
@@ -129,6 +124,108 @@ func (k Keeper) TimeoutPacket(
 	return nil
 }
 
+// IsPacketTimedOut returns true if the timeout height or timeout timestamp of a packet sent
+// on the packet's source channel has passed on the counterparty chain at the provided proof
+// height, according to the consensus state stored by the channel's client. Otherwise false is
+// returned together with an error describing why the packet is not timed out. It performs the
+// checks of TimeoutPacket which do not require a proof and does not modify any state.
+//
+// NOTE: the absence of a packet receipt on the counterparty chain can only be proven by relaying
+// the timeout with TimeoutPacket. A packet commitment which still exists on this chain only
+// guarantees that neither an acknowledgement nor a timeout has been processed yet.
+func (k Keeper) IsPacketTimedOut(
+	ctx sdk.Context,
+	packet exported.PacketI,
+	proofHeight exported.Height,
+) (bool, error) {
+	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return false, sdkerrors.Wrapf(
+			types.ErrChannelNotFound,
+			"port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+	}
+
+	if channel.State != types.OPEN {
+		return false, sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not OPEN (got %s)", channel.State.String(),
+		)
+	}
+
+	if packet.GetDestPort() != channel.Counterparty.PortId {
+		return false, sdkerrors.Wrapf(
+			types.ErrInvalidPacket,
+			"packet destination port doesn't match the counterparty's port (%s ≠ %s)", packet.GetDestPort(), channel.Counterparty.PortId,
+		)
+	}
+
+	if packet.GetDestChannel() != channel.Counterparty.ChannelId {
+		return false, sdkerrors.Wrapf(
+			types.ErrInvalidPacket,
+			"packet destination channel doesn't match the counterparty's channel (%s ≠ %s)", packet.GetDestChannel(), channel.Counterparty.ChannelId,
+		)
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return false, sdkerrors.Wrap(
+			connectiontypes.ErrConnectionNotFound,
+			channel.ConnectionHops[0],
+		)
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, connectionEnd.GetClientID())
+	if !found {
+		return false, sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "client ID (%s)", connectionEnd.GetClientID())
+	}
+
+	// the consensus states of a client which is not active cannot be trusted
+	clientStore := k.clientKeeper.ClientStore(ctx, connectionEnd.GetClientID())
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return false, sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", connectionEnd.GetClientID(), status)
+	}
+
+	commitment := k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if len(commitment) == 0 {
+		return false, sdkerrors.Wrapf(
+			types.ErrPacketCommitmentNotFound,
+			"packet has already been acknowledged or timed out, or was never sent (sequence %d)", packet.GetSequence(),
+		)
+	}
+
+	packetCommitment := types.CommitPacket(k.cdc, packet)
+	if !bytes.Equal(commitment, packetCommitment) {
+		return false, sdkerrors.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
+	}
+
+	if err := k.checkTimeoutReached(ctx, connectionEnd, packet, proofHeight); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// checkTimeoutReached returns an error if neither the timeout height nor the timeout timestamp
+// of the packet has passed at the proof height on the counterparty chain of the connection.
+func (k Keeper) checkTimeoutReached(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	packet exported.PacketI,
+	proofHeight exported.Height,
+) error {
+	proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	if !types.TimeoutReached(packet, types.NewClock(proofHeight, proofTimestamp)) {
+		return sdkerrors.Wrap(types.ErrPacketTimeout, "packet timeout has not been reached for height or timestamp")
+	}
+
+	return nil
+}
+
 // TimeoutExecuted deletes the commitment send from this chain after it verifies timeout.
 // If the timed-out packet came from an ORDERED channel then this channel will be closed.
 //
@@ -245,6 +342,7 @@ func (k Keeper) TimeoutOnClose(
 	expectedChannel := types.NewChannel(
 		types.CLOSED, channel.Ordering, counterparty, counterpartyHops, channel.Version,
 	)
+	expectedChannel.DelayPeriod = channel.DelayPeriod
 
 	// check that the opposing channel end has closed
 	if err := k.connectionKeeper.VerifyChannelState(
@@ -265,12 +363,12 @@ func (k Keeper) TimeoutOnClose(
 
 		// check that the recv sequence is as claimed
 		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
+			ctx, verificationConnection(connectionEnd, channel), proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
 	case types.UNORDERED:
 		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
+			ctx, verificationConnection(connectionEnd, channel), proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		)
 	default:
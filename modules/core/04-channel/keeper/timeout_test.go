@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 )
 
@@ -54,6 +56,31 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 			// need to update chainA's client representing chainB to prove missing ack
 			path.EndpointA.UpdateClient()
 		}, true},
+		{"success: timeout timestamp only", func() {
+			ordered = false
+			suite.coordinator.Setup(path)
+
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().UnixNano())
+
+			sequence, err := path.EndpointA.SendPacket(disabledTimeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, timeoutTimestamp)
+			// need to update chainA's client representing chainB to prove missing ack
+			path.EndpointA.UpdateClient()
+		}, true},
+		{"timeout timestamp only not reached", func() {
+			expError = types.ErrPacketTimeout
+			ordered = false
+			suite.coordinator.Setup(path)
+
+			// the timeout timestamp is after the timestamp of the consensus state at the proof height
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())
+
+			sequence, err := path.EndpointA.SendPacket(disabledTimeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, timeoutTimestamp)
+			path.EndpointA.UpdateClient()
+		}, false},
 		{"packet already timed out: ORDERED", func() {
 			expError = types.ErrNoOpMsg
 			ordered = true
@@ -236,6 +263,102 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 	}
 }
 
+// TestIsPacketTimedOut tests the IsPacketTimedOut call on chainA by checking whether the timeout
+// of a packet sent to chainB has passed at the latest height of chainA's client for chainB.
+func (suite *KeeperTestSuite) TestIsPacketTimedOut() {
+	var (
+		path        *ibctesting.Path
+		packet      types.Packet
+		proofHeight exported.Height
+		expError    *sdkerrors.Error
+	)
+
+	testCases := []testCase{
+		{"success: timeout height", func() {}, true},
+		{"success: timeout timestamp", func() {
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().UnixNano())
+
+			sequence, err := path.EndpointA.SendPacket(disabledTimeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, timeoutTimestamp)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			proofHeight = path.EndpointA.GetClientState().GetLatestHeight()
+		}, true},
+		{"timeout not reached", func() {
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			expError = types.ErrPacketTimeout
+		}, false},
+		{"channel not found", func() {
+			packet.SourceChannel = ibctesting.InvalidID
+			expError = types.ErrChannelNotFound
+		}, false},
+		{"channel is not open", func() {
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+			expError = types.ErrInvalidChannelState
+		}, false},
+		{"packet destination channel does not match the counterparty", func() {
+			packet.DestinationChannel = ibctesting.InvalidID
+			expError = types.ErrInvalidPacket
+		}, false},
+		{"client is not active", func() {
+			clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointA.SetClientState(clientState)
+			expError = clienttypes.ErrClientNotActive
+		}, false},
+		{"packet commitment not found", func() {
+			// no packet has been sent with this sequence
+			packet.Sequence++
+			expError = types.ErrPacketCommitmentNotFound
+		}, false},
+		{"packet commitment does not match", func() {
+			packet.Data = []byte("invalid packet data")
+			expError = types.ErrInvalidPacket
+		}, false},
+		{"consensus state not found at proof height", func() {
+			proofHeight = proofHeight.Increment()
+			expError = clienttypes.ErrConsensusStateNotFound
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expError = nil
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			proofHeight = path.EndpointA.GetClientState().GetLatestHeight()
+
+			tc.malleate()
+
+			timedOut, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsPacketTimedOut(suite.chainA.GetContext(), packet, proofHeight)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(timedOut)
+
+				// the timeout is not processed
+				suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			} else {
+				suite.Require().ErrorIs(err, expError)
+				suite.Require().False(timedOut)
+			}
+		})
+	}
+}
+
 // TestTimeoutExectued verifies that packet commitments are deleted on chainA after the
 // channel capabilities are verified.
 func (suite *KeeperTestSuite) TestTimeoutExecuted() {
@@ -344,6 +467,23 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
 			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 		}, true},
+		{"success: UNORDERED channel with delay period", func() {
+			ordered = false
+			path.EndpointA.ChannelConfig.DelayPeriod = 1
+			path.EndpointB.ChannelConfig.DelayPeriod = 1
+			suite.coordinator.Setup(path)
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			path.EndpointB.SetChannelClosed()
+			// need to update chainA's client representing chainB to prove missing ack
+			path.EndpointA.UpdateClient()
+
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
 		{"channel not found", func() {
 			// use wrong channel naming
 			suite.coordinator.Setup(path)
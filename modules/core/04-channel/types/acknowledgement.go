@@ -3,16 +3,35 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
+// AcknowledgementCodec defines the interface an application may register for its port to define a
+// custom format of the acknowledgements of the packets it receives. The core IBC handler only stores
+// and hashes the marshaled bytes, the interpretation of the acknowledgement stays with the application.
+type AcknowledgementCodec interface {
+	// MarshalAcknowledgement returns the bytes committed to for the provided acknowledgement
+	MarshalAcknowledgement(ack exported.Acknowledgement) ([]byte, error)
+	// UnmarshalAcknowledgement decodes the acknowledgement bytes relayed from the counterparty
+	UnmarshalAcknowledgement(bz []byte) (exported.Acknowledgement, error)
+}
+
 const (
 	// ackErrorString defines a string constant included in error acknowledgements
 	// NOTE: Changing this const is state machine breaking as acknowledgements are written into state.
 	ackErrorString = "error handling packet: see events for details"
+
+	// ackErrorCodePrefix and ackErrorCodespaceSeparator delimit the ABCI code and codespace included in
+	// error acknowledgements constructed with NewErrorAcknowledgementWithCode.
+	// NOTE: Changing these consts is state machine breaking as acknowledgements are written into state.
+	ackErrorCodePrefix         = "ABCI code: "
+	ackErrorCodespaceSeparator = ": codespace: "
 )
 
 // NewResultAcknowledgement returns a new instance of Acknowledgement using an Acknowledgement_Result
@@ -41,6 +60,50 @@ func NewErrorAcknowledgement(err error) Acknowledgement {
 	}
 }
 
+// NewErrorAcknowledgementWithCode returns a new instance of Acknowledgement using an Acknowledgement_Error
+// type in the Response field. In addition to the ABCI code included by NewErrorAcknowledgement, the error
+// string includes the codespace of the error, so that the sending chain can tell apart the errors of different
+// modules sharing an ABCI code. The codespace and code may be retrieved with the ErrorCode function. The error
+// message itself is not included as it is not guaranteed to be deterministic.
+// NOTE: the codespace and ABCI code of registered errors are written into state, changing them is state machine breaking.
+func NewErrorAcknowledgementWithCode(err error) Acknowledgement {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false) // discard non-determinstic log value
+
+	return Acknowledgement{
+		Response: &Acknowledgement_Error{
+			Error: fmt.Sprintf("%s%d%s%s: %s", ackErrorCodePrefix, code, ackErrorCodespaceSeparator, codespace, ackErrorString),
+		},
+	}
+}
+
+// ErrorCode returns the codespace and ABCI code of an error acknowledgement constructed with
+// NewErrorAcknowledgementWithCode. False is returned if the acknowledgement is not an error
+// acknowledgement or if its error string does not include a codespace.
+func (ack Acknowledgement) ErrorCode() (string, uint32, bool) {
+	resp, ok := ack.Response.(*Acknowledgement_Error)
+	if !ok {
+		return "", 0, false
+	}
+
+	suffix := ": " + ackErrorString
+	if !strings.HasPrefix(resp.Error, ackErrorCodePrefix) || !strings.HasSuffix(resp.Error, suffix) {
+		return "", 0, false
+	}
+
+	errString := strings.TrimSuffix(strings.TrimPrefix(resp.Error, ackErrorCodePrefix), suffix)
+	codeString, codespace, ok := strings.Cut(errString, ackErrorCodespaceSeparator)
+	if !ok || codespace == "" {
+		return "", 0, false
+	}
+
+	code, err := strconv.ParseUint(codeString, 10, 32)
+	if err != nil {
+		return "", 0, false
+	}
+
+	return codespace, uint32(code), true
+}
+
 // ValidateBasic performs a basic validation of the acknowledgement
 func (ack Acknowledgement) ValidateBasic() error {
 	switch resp := ack.Response.(type) {
@@ -143,3 +143,96 @@ func (suite *TypesTestSuite) TestAcknowledgementError() {
 	suite.Require().Equal(ack, ackSameABCICode)
 	suite.Require().NotEqual(ack, ackDifferentABCICode)
 }
+
+// TestErrorAcknowledgementWithCode verifies that only a constant string, the ABCI error code and
+// the codespace are used in constructing the acknowledgement error string and that they are parsed
+// back by ErrorCode.
+func (suite *TypesTestSuite) TestErrorAcknowledgementWithCode() {
+	// same ABCI error code and codespace used
+	err := sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "error string 1")
+	errSameABCICode := sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "error string 2")
+
+	// same ABCI error code used in a different codespace
+	errDifferentCodespace := sdkerrors.Register("codespace", sdkerrors.ErrOutOfGas.ABCICode(), "error")
+
+	ack := types.NewErrorAcknowledgementWithCode(err)
+	ackSameABCICode := types.NewErrorAcknowledgementWithCode(errSameABCICode)
+	ackDifferentCodespace := types.NewErrorAcknowledgementWithCode(errDifferentCodespace)
+
+	suite.Require().Equal(ack, ackSameABCICode)
+	suite.Require().NotEqual(ack, ackDifferentCodespace)
+	suite.Require().NoError(ack.ValidateBasic())
+	suite.Require().False(ack.Success())
+
+	// the error acknowledgement without codespace differs from the one with codespace
+	suite.Require().NotEqual(types.NewErrorAcknowledgement(err), ack)
+
+	codespace, code, ok := ack.ErrorCode()
+	suite.Require().True(ok)
+	suite.Require().Equal(sdkerrors.ErrOutOfGas.Codespace(), codespace)
+	suite.Require().Equal(sdkerrors.ErrOutOfGas.ABCICode(), code)
+
+	codespace, code, ok = ackDifferentCodespace.ErrorCode()
+	suite.Require().True(ok)
+	suite.Require().Equal("codespace", codespace)
+	suite.Require().Equal(sdkerrors.ErrOutOfGas.ABCICode(), code)
+
+	// unregistered errors are included with the internal ABCI code (1) and the undefined codespace
+	codespace, code, ok = types.NewErrorAcknowledgementWithCode(fmt.Errorf("error")).ErrorCode()
+	suite.Require().True(ok)
+	suite.Require().Equal(sdkerrors.UndefinedCodespace, codespace)
+	suite.Require().Equal(uint32(1), code)
+}
+
+func (suite *TypesTestSuite) TestAcknowledgementErrorCode() {
+	testCases := []struct {
+		name string
+		ack  types.Acknowledgement
+		ok   bool
+	}{
+		{
+			"error acknowledgement with codespace",
+			types.NewErrorAcknowledgementWithCode(types.ErrInvalidPacket),
+			true,
+		},
+		{
+			"error acknowledgement without codespace",
+			types.NewErrorAcknowledgement(types.ErrInvalidPacket),
+			false,
+		},
+		{
+			"result acknowledgement",
+			types.NewResultAcknowledgement([]byte("success")),
+			false,
+		},
+		{
+			"arbitrary error string",
+			types.Acknowledgement{Response: &types.Acknowledgement_Error{Error: "error"}},
+			false,
+		},
+		{
+			"invalid ABCI code",
+			types.Acknowledgement{Response: &types.Acknowledgement_Error{Error: "ABCI code: abc: codespace: channel: error handling packet: see events for details"}},
+			false,
+		},
+		{
+			"empty codespace",
+			types.Acknowledgement{Response: &types.Acknowledgement_Error{Error: "ABCI code: 1: codespace: : error handling packet: see events for details"}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			codespace, code, ok := tc.ack.ErrorCode()
+
+			suite.Require().Equal(tc.ok, ok)
+			if tc.ok {
+				suite.Require().Equal(types.ErrInvalidPacket.Codespace(), codespace)
+				suite.Require().Equal(types.ErrInvalidPacket.ABCICode(), code)
+			}
+		})
+	}
+}
@@ -59,14 +59,14 @@ func (ch Channel) ValidateBasic() error {
 	if !(ch.Ordering == ORDERED || ch.Ordering == UNORDERED) {
 		return sdkerrors.Wrap(ErrInvalidChannelOrdering, ch.Ordering.String())
 	}
-	if len(ch.ConnectionHops) != 1 {
-		return sdkerrors.Wrap(
-			ErrTooManyConnectionHops,
-			"current IBC version only supports one connection hop",
-		)
+	// the maximum number of connection hops is a channel parameter which is checked upon channel opening
+	if len(ch.ConnectionHops) == 0 {
+		return sdkerrors.Wrap(ErrInvalidChannel, "connection hops cannot be empty")
 	}
-	if err := host.ConnectionIdentifierValidator(ch.ConnectionHops[0]); err != nil {
-		return sdkerrors.Wrap(err, "invalid connection hop ID")
+	for _, connectionID := range ch.ConnectionHops {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return sdkerrors.Wrap(err, "invalid connection hop ID")
+		}
 	}
 	return ch.Counterparty.ValidateBasic()
 }
@@ -112,6 +112,7 @@ func NewIdentifiedChannel(portID, channelID string, ch Channel) IdentifiedChanne
 		Version:        ch.Version,
 		PortId:         portID,
 		ChannelId:      channelID,
+		DelayPeriod:    ch.DelayPeriod,
 	}
 }
 
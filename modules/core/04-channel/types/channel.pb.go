@@ -115,6 +115,9 @@ type Channel struct {
 	ConnectionHops []string `protobuf:"bytes,4,rep,name=connection_hops,json=connectionHops,proto3" json:"connection_hops,omitempty" yaml:"connection_hops"`
 	// opaque channel version, which is agreed upon during the handshake
 	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	// delay period (in nanoseconds) which takes precedence over the delay period
+	// of the connection when verifying packet proofs, unused if zero
+	DelayPeriod uint64 `protobuf:"varint,6,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty" yaml:"delay_period"`
 }
 
 func (m *Channel) Reset()         { *m = Channel{} }
@@ -168,6 +171,9 @@ type IdentifiedChannel struct {
 	PortId string `protobuf:"bytes,6,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 	// channel identifier
 	ChannelId string `protobuf:"bytes,7,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// delay period (in nanoseconds) which takes precedence over the delay period
+	// of the connection when verifying packet proofs, unused if zero
+	DelayPeriod uint64 `protobuf:"varint,8,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty" yaml:"delay_period"`
 }
 
 func (m *IdentifiedChannel) Reset()         { *m = IdentifiedChannel{} }
@@ -347,6 +353,55 @@ func (m *PacketState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketState proto.InternalMessageInfo
 
+// PacketTimeout defines the timeouts of a packet sent on a channel. It is stored
+// alongside the packet commitment, which only holds a hash of the timeouts, so that
+// the packets which can be timed out may be found without their packet data.
+type PacketTimeout struct {
+	// channel port identifier.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// channel unique identifier.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// packet sequence.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// block height of the counterparty chain after which the packet times out
+	TimeoutHeight types.Height `protobuf:"bytes,4,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
+	// block timestamp (in nanoseconds) of the counterparty chain after which the packet times out
+	TimeoutTimestamp uint64 `protobuf:"varint,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+}
+
+func (m *PacketTimeout) Reset()         { *m = PacketTimeout{} }
+func (m *PacketTimeout) String() string { return proto.CompactTextString(m) }
+func (*PacketTimeout) ProtoMessage()    {}
+func (*PacketTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{5}
+}
+func (m *PacketTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketTimeout.Merge(m, src)
+}
+func (m *PacketTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketTimeout proto.InternalMessageInfo
+
 // PacketId is an identifer for a unique Packet
 // Source chains refer to packets by source port/channel
 // Destination chains refer to packets by destination port/channel
@@ -363,7 +418,7 @@ func (m *PacketId) Reset()         { *m = PacketId{} }
 func (m *PacketId) String() string { return proto.CompactTextString(m) }
 func (*PacketId) ProtoMessage()    {}
 func (*PacketId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{5}
+	return fileDescriptor_c3a07336710636a0, []int{6}
 }
 func (m *PacketId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,7 +467,7 @@ func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()    {}
 func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{6}
+	return fileDescriptor_c3a07336710636a0, []int{7}
 }
 func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,6 +541,73 @@ func (*Acknowledgement) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// Params defines the set of Channel parameters.
+type Params struct {
+	// maximum number of connection hops a channel may be opened with. Only channels with a single
+	// connection hop are supported until multi-hop channels are implemented.
+	MaxConnectionHops uint64 `protobuf:"varint,1,opt,name=max_connection_hops,json=maxConnectionHops,proto3" json:"max_connection_hops,omitempty" yaml:"max_connection_hops"`
+	// maximum size in bytes of the data of a packet. Packets with larger data cannot be sent or
+	// received, they are left unreceived so that they time out on the sending chain.
+	MaxPacketDataBytes uint64 `protobuf:"varint,2,opt,name=max_packet_data_bytes,json=maxPacketDataBytes,proto3" json:"max_packet_data_bytes,omitempty" yaml:"max_packet_data_bytes"`
+	// maximum number of packets sent on a channel which have been neither acknowledged nor timed out.
+	// Packets cannot be sent on a channel with this many packets in flight. Zero means unlimited.
+	MaxInFlightPackets uint64 `protobuf:"varint,3,opt,name=max_in_flight_packets,json=maxInFlightPackets,proto3" json:"max_in_flight_packets,omitempty" yaml:"max_in_flight_packets"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{8}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxConnectionHops() uint64 {
+	if m != nil {
+		return m.MaxConnectionHops
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPacketDataBytes() uint64 {
+	if m != nil {
+		return m.MaxPacketDataBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxInFlightPackets() uint64 {
+	if m != nil {
+		return m.MaxInFlightPackets
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
@@ -494,72 +616,84 @@ func init() {
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
 	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v1.Packet")
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
+	proto.RegisterType((*PacketTimeout)(nil), "ibc.core.channel.v1.PacketTimeout")
 	proto.RegisterType((*PacketId)(nil), "ibc.core.channel.v1.PacketId")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
+	proto.RegisterType((*Params)(nil), "ibc.core.channel.v1.Params")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 925 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x8e, 0x1a, 0x47,
-	0x10, 0x66, 0x60, 0xf8, 0x2b, 0x16, 0x96, 0x6d, 0x67, 0xf1, 0x64, 0x62, 0x33, 0x78, 0x94, 0xc3,
-	0xca, 0x91, 0xc1, 0xeb, 0x58, 0x8e, 0xe2, 0x53, 0x96, 0x1f, 0x6b, 0x47, 0xb1, 0x60, 0x35, 0xb0,
-	0x87, 0xf8, 0x42, 0x60, 0xa6, 0x03, 0x23, 0xc3, 0x34, 0x99, 0x69, 0x58, 0xed, 0x1b, 0x58, 0x5c,
-	0x92, 0x17, 0x40, 0x8a, 0x14, 0x25, 0xaf, 0x90, 0x57, 0xf0, 0xd1, 0xc7, 0x9c, 0x50, 0xb4, 0x7b,
-	0xc8, 0x9d, 0x17, 0x48, 0x34, 0xdd, 0x3d, 0xfc, 0xac, 0xad, 0x3d, 0x26, 0x17, 0x9f, 0xe8, 0xaa,
-	0xef, 0xab, 0xaa, 0x6f, 0xaa, 0x8a, 0x56, 0xc3, 0x03, 0xa7, 0x6f, 0x55, 0x2c, 0xe2, 0xe1, 0x8a,
-	0x35, 0xec, 0xb9, 0x2e, 0x1e, 0x55, 0x66, 0xc7, 0xe1, 0xb1, 0x3c, 0xf1, 0x08, 0x25, 0xe8, 0x8e,
-	0xd3, 0xb7, 0xca, 0x01, 0xa5, 0x1c, 0xfa, 0x67, 0xc7, 0xea, 0x27, 0x03, 0x32, 0x20, 0x0c, 0xaf,
-	0x04, 0x27, 0x4e, 0x55, 0xb5, 0x4d, 0xb6, 0x91, 0x83, 0x5d, 0xca, 0x92, 0xb1, 0x13, 0x27, 0xe8,
-	0xbf, 0x45, 0x21, 0x59, 0xe3, 0x59, 0xd0, 0x63, 0x88, 0xfb, 0xb4, 0x47, 0xb1, 0x22, 0x95, 0xa4,
-	0xa3, 0xdc, 0x13, 0xb5, 0xfc, 0x81, 0x3a, 0xe5, 0x76, 0xc0, 0x30, 0x39, 0x11, 0x3d, 0x83, 0x14,
-	0xf1, 0x6c, 0xec, 0x39, 0xee, 0x40, 0x89, 0xde, 0x12, 0xd4, 0x0a, 0x48, 0xe6, 0x9a, 0x8b, 0xbe,
-	0x85, 0x3d, 0x8b, 0x4c, 0x5d, 0x8a, 0xbd, 0x49, 0xcf, 0xa3, 0x97, 0x4a, 0xac, 0x24, 0x1d, 0x65,
-	0x9e, 0x3c, 0xf8, 0x60, 0x6c, 0x6d, 0x8b, 0x58, 0x95, 0xdf, 0x2e, 0xb5, 0x88, 0xb9, 0x13, 0x8c,
-	0x6a, 0xb0, 0x6f, 0x11, 0xd7, 0xc5, 0x16, 0x75, 0x88, 0xdb, 0x1d, 0x92, 0x89, 0xaf, 0xc8, 0xa5,
-	0xd8, 0x51, 0xba, 0xaa, 0xae, 0x96, 0x5a, 0xe1, 0xb2, 0x37, 0x1e, 0x3d, 0xd7, 0x6f, 0x10, 0x74,
-	0x33, 0xb7, 0xf1, 0x9c, 0x92, 0x89, 0x8f, 0x14, 0x48, 0xce, 0xb0, 0xe7, 0x3b, 0xc4, 0x55, 0xe2,
-	0x25, 0xe9, 0x28, 0x6d, 0x86, 0xe6, 0x73, 0xf9, 0xcd, 0x2f, 0x5a, 0x44, 0xff, 0x3b, 0x0a, 0x07,
-	0x86, 0x8d, 0x5d, 0xea, 0xfc, 0xe0, 0x60, 0xfb, 0x63, 0xc7, 0x6e, 0xe9, 0x18, 0xba, 0x0b, 0xc9,
-	0x09, 0xf1, 0x68, 0xd7, 0xb1, 0x95, 0x04, 0x43, 0x12, 0x81, 0x69, 0xd8, 0xe8, 0x3e, 0x80, 0x90,
-	0x19, 0x60, 0x49, 0x86, 0xa5, 0x85, 0xc7, 0xb0, 0x45, 0xa7, 0x2f, 0x60, 0x6f, 0xfb, 0x03, 0xd0,
-	0x17, 0x9b, 0x6c, 0x41, 0x97, 0xd3, 0x55, 0xb4, 0x5a, 0x6a, 0x39, 0x2e, 0x52, 0x00, 0xfa, 0xba,
-	0xc2, 0xd3, 0x9d, 0x0a, 0x51, 0xc6, 0x3f, 0x5c, 0x2d, 0xb5, 0x03, 0xf1, 0x51, 0x6b, 0x4c, 0x7f,
-	0xbf, 0xf0, 0x3f, 0x31, 0x48, 0x9c, 0xf5, 0xac, 0xd7, 0x98, 0x22, 0x15, 0x52, 0x3e, 0xfe, 0x71,
-	0x8a, 0x5d, 0x8b, 0x8f, 0x56, 0x36, 0xd7, 0x36, 0xfa, 0x0a, 0x32, 0x3e, 0x99, 0x7a, 0x16, 0xee,
-	0x06, 0x35, 0x45, 0x8d, 0xc2, 0x6a, 0xa9, 0x21, 0x5e, 0x63, 0x0b, 0xd4, 0x4d, 0xe0, 0xd6, 0x19,
-	0xf1, 0x28, 0xfa, 0x06, 0x72, 0x02, 0x13, 0x95, 0xd9, 0x10, 0xd3, 0xd5, 0x4f, 0x57, 0x4b, 0xed,
-	0x70, 0x27, 0x56, 0xe0, 0xba, 0x99, 0xe5, 0x8e, 0x70, 0xdd, 0x5e, 0x40, 0xde, 0xc6, 0x3e, 0x75,
-	0xdc, 0x1e, 0x9b, 0x0b, 0xab, 0x2f, 0xb3, 0x1c, 0x9f, 0xad, 0x96, 0xda, 0x5d, 0x9e, 0xe3, 0x26,
-	0x43, 0x37, 0xf7, 0xb7, 0x5c, 0x4c, 0x49, 0x0b, 0xee, 0x6c, 0xb3, 0x42, 0x39, 0x6c, 0x8c, 0xd5,
-	0xe2, 0x6a, 0xa9, 0xa9, 0xef, 0xa7, 0x5a, 0x6b, 0x42, 0x5b, 0xde, 0x50, 0x18, 0x02, 0xd9, 0xee,
-	0xd1, 0x1e, 0x1b, 0xf7, 0x9e, 0xc9, 0xce, 0xe8, 0x7b, 0xc8, 0x51, 0x67, 0x8c, 0xc9, 0x94, 0x76,
-	0x87, 0xd8, 0x19, 0x0c, 0x29, 0x1b, 0x78, 0x66, 0x67, 0xdf, 0xf9, 0x4d, 0x34, 0x3b, 0x2e, 0x9f,
-	0x32, 0x46, 0xf5, 0x7e, 0xb0, 0xac, 0x9b, 0x76, 0xec, 0xc6, 0xeb, 0x66, 0x56, 0x38, 0x38, 0x1b,
-	0x19, 0x70, 0x10, 0x32, 0x82, 0x5f, 0x9f, 0xf6, 0xc6, 0x13, 0x25, 0x15, 0x8c, 0xab, 0x7a, 0x6f,
-	0xb5, 0xd4, 0x94, 0xdd, 0x24, 0x6b, 0x8a, 0x6e, 0xe6, 0x85, 0xaf, 0x13, 0xba, 0xc4, 0x06, 0xfc,
-	0x2e, 0x41, 0x86, 0x6f, 0x00, 0xfb, 0xcf, 0xfe, 0x07, 0xab, 0xb7, 0xb3, 0x69, 0xb1, 0x1b, 0x9b,
-	0x16, 0x76, 0x55, 0xde, 0x74, 0x55, 0x08, 0xfd, 0x49, 0x82, 0x14, 0x17, 0x6a, 0xd8, 0xff, 0xb3,
-	0x4a, 0xa1, 0xa8, 0x05, 0xfb, 0x27, 0xd6, 0x6b, 0x97, 0x5c, 0x8c, 0xb0, 0x3d, 0xc0, 0x63, 0xec,
-	0x52, 0xa4, 0x40, 0xc2, 0xc3, 0xfe, 0x74, 0x44, 0x95, 0xc3, 0xe0, 0x03, 0x4e, 0x23, 0xa6, 0xb0,
-	0x51, 0x01, 0xe2, 0xd8, 0xf3, 0x88, 0xa7, 0x14, 0x82, 0xfa, 0xa7, 0x11, 0x93, 0x9b, 0x55, 0x80,
-	0x94, 0x87, 0xfd, 0x09, 0x71, 0x7d, 0xfc, 0xf0, 0x0f, 0x09, 0xe2, 0x6d, 0x71, 0x65, 0x6a, 0xed,
-	0xce, 0x49, 0xa7, 0xd1, 0x3d, 0x6f, 0x1a, 0x4d, 0xa3, 0x63, 0x9c, 0xbc, 0x34, 0x5e, 0x35, 0xea,
-	0xdd, 0xf3, 0x66, 0xfb, 0xac, 0x51, 0x33, 0x5e, 0x18, 0x8d, 0x7a, 0x3e, 0xa2, 0x1e, 0xcc, 0x17,
-	0xa5, 0xec, 0x0e, 0x01, 0x29, 0x00, 0x3c, 0x2e, 0x70, 0xe6, 0x25, 0x35, 0x35, 0x5f, 0x94, 0xe4,
-	0xe0, 0x8c, 0x8a, 0x90, 0xe5, 0x48, 0xc7, 0xfc, 0xae, 0x75, 0xd6, 0x68, 0xe6, 0xa3, 0x6a, 0x66,
-	0xbe, 0x28, 0x25, 0x85, 0xb9, 0x89, 0x64, 0x60, 0x8c, 0x47, 0x32, 0xe4, 0x1e, 0xec, 0x71, 0xa4,
-	0xf6, 0xb2, 0xd5, 0x6e, 0xd4, 0xf3, 0xb2, 0x0a, 0xf3, 0x45, 0x29, 0xc1, 0x2d, 0x55, 0x7e, 0xf3,
-	0x6b, 0x31, 0xf2, 0xf0, 0x02, 0xe2, 0xec, 0xf6, 0x46, 0x9f, 0x43, 0xa1, 0x65, 0xd6, 0x1b, 0x66,
-	0xb7, 0xd9, 0x6a, 0x36, 0x6e, 0xe8, 0x65, 0x29, 0x03, 0x3f, 0xd2, 0x61, 0x9f, 0xb3, 0xce, 0x9b,
-	0xec, 0xb7, 0x51, 0xcf, 0x4b, 0x6a, 0x76, 0xbe, 0x28, 0xa5, 0xd7, 0x8e, 0x40, 0x30, 0xe7, 0x84,
-	0x0c, 0x21, 0x58, 0x98, 0xbc, 0x70, 0xb5, 0xfd, 0xf6, 0xaa, 0x28, 0xbd, 0xbb, 0x2a, 0x4a, 0x7f,
-	0x5d, 0x15, 0xa5, 0x9f, 0xaf, 0x8b, 0x91, 0x77, 0xd7, 0xc5, 0xc8, 0x9f, 0xd7, 0xc5, 0xc8, 0xab,
-	0xaf, 0x07, 0x0e, 0x1d, 0x4e, 0xfb, 0x65, 0x8b, 0x8c, 0x2b, 0x16, 0xf1, 0xc7, 0xc4, 0xaf, 0x38,
-	0x7d, 0xeb, 0xd1, 0x80, 0x54, 0x66, 0xcf, 0x2a, 0x63, 0x62, 0x4f, 0x47, 0xd8, 0xe7, 0xcf, 0x84,
-	0xc7, 0x4f, 0x1f, 0x85, 0xef, 0x0e, 0x7a, 0x39, 0xc1, 0x7e, 0x3f, 0xc1, 0xde, 0x09, 0x5f, 0xfe,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xd6, 0x5e, 0x09, 0x98, 0x08, 0x00, 0x00,
+	// 1083 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x25, 0x4a, 0x96, 0x56, 0x96, 0x2d, 0xaf, 0x6b, 0x87, 0x65, 0x1d, 0x51, 0x21, 0x7a,
+	0x30, 0x52, 0x44, 0x8a, 0xd3, 0x20, 0x45, 0x7d, 0xaa, 0x29, 0xc9, 0x30, 0xd1, 0x40, 0x32, 0x28,
+	0xf9, 0xd0, 0x5c, 0x58, 0x8a, 0xdc, 0xc8, 0x44, 0x44, 0xae, 0x4a, 0xae, 0xfc, 0x73, 0xed, 0x29,
+	0xf0, 0xa5, 0x7d, 0x01, 0x03, 0x05, 0x02, 0xf4, 0x15, 0x7a, 0xee, 0x2d, 0xc7, 0x1c, 0x7b, 0x22,
+	0x5a, 0xfb, 0x0d, 0xf4, 0x02, 0x2d, 0xb8, 0xbb, 0xd4, 0x8f, 0x6d, 0x04, 0x28, 0x0a, 0x24, 0x97,
+	0x9e, 0xb4, 0x33, 0xf3, 0xcd, 0xcc, 0xa7, 0x99, 0x8f, 0xe4, 0x82, 0x07, 0x6e, 0xdf, 0xae, 0xdb,
+	0x38, 0x40, 0x75, 0xfb, 0xd8, 0xf2, 0x7d, 0x34, 0xac, 0x9f, 0xec, 0x24, 0xc7, 0xda, 0x28, 0xc0,
+	0x04, 0xc3, 0x75, 0xb7, 0x6f, 0xd7, 0x62, 0x48, 0x2d, 0xf1, 0x9f, 0xec, 0xc8, 0x9f, 0x0c, 0xf0,
+	0x00, 0xd3, 0x78, 0x3d, 0x3e, 0x31, 0xa8, 0xac, 0xcc, 0xaa, 0x0d, 0x5d, 0xe4, 0x13, 0x5a, 0x8c,
+	0x9e, 0x18, 0x40, 0xfd, 0x2b, 0x0d, 0x96, 0x1a, 0xac, 0x0a, 0x7c, 0x0c, 0xb2, 0x21, 0xb1, 0x08,
+	0x92, 0x84, 0xaa, 0xb0, 0xbd, 0xf2, 0x44, 0xae, 0xdd, 0xd1, 0xa7, 0xd6, 0x8d, 0x11, 0x06, 0x03,
+	0xc2, 0x67, 0x20, 0x8f, 0x03, 0x07, 0x05, 0xae, 0x3f, 0x90, 0xd2, 0xef, 0x49, 0xea, 0xc4, 0x20,
+	0x63, 0x8a, 0x85, 0xdf, 0x82, 0x65, 0x1b, 0x8f, 0x7d, 0x82, 0x82, 0x91, 0x15, 0x90, 0x73, 0x29,
+	0x53, 0x15, 0xb6, 0x8b, 0x4f, 0x1e, 0xdc, 0x99, 0xdb, 0x98, 0x03, 0x6a, 0xe2, 0xdb, 0x48, 0x49,
+	0x19, 0x0b, 0xc9, 0xb0, 0x01, 0x56, 0x6d, 0xec, 0xfb, 0xc8, 0x26, 0x2e, 0xf6, 0xcd, 0x63, 0x3c,
+	0x0a, 0x25, 0xb1, 0x9a, 0xd9, 0x2e, 0x68, 0xf2, 0x24, 0x52, 0x36, 0xcf, 0x2d, 0x6f, 0xb8, 0xab,
+	0xde, 0x00, 0xa8, 0xc6, 0xca, 0xcc, 0x73, 0x80, 0x47, 0x21, 0x94, 0xc0, 0xd2, 0x09, 0x0a, 0x42,
+	0x17, 0xfb, 0x52, 0xb6, 0x2a, 0x6c, 0x17, 0x8c, 0xc4, 0x84, 0xbb, 0x60, 0xd9, 0x41, 0x43, 0xeb,
+	0xdc, 0x1c, 0xa1, 0xc0, 0xc5, 0x8e, 0x94, 0xab, 0x0a, 0xdb, 0xa2, 0x76, 0x6f, 0x12, 0x29, 0xeb,
+	0xac, 0xf6, 0x7c, 0x54, 0x35, 0x8a, 0xd4, 0x3c, 0xa4, 0xd6, 0xae, 0xf8, 0xfa, 0x17, 0x25, 0xa5,
+	0xbe, 0xc9, 0x80, 0x35, 0xdd, 0x41, 0x3e, 0x71, 0x5f, 0xba, 0xc8, 0xf9, 0x7f, 0xda, 0xef, 0x9b,
+	0xf6, 0x3d, 0xb0, 0x34, 0xc2, 0x01, 0x31, 0x5d, 0x36, 0xe8, 0x82, 0x91, 0x8b, 0x4d, 0xdd, 0x81,
+	0xf7, 0x01, 0xe0, 0x34, 0xe3, 0xd8, 0x12, 0x8d, 0x15, 0xb8, 0x47, 0x77, 0x6e, 0x6d, 0x29, 0xff,
+	0xaf, 0xb7, 0x74, 0x0a, 0x96, 0xe7, 0xff, 0x3c, 0xfc, 0x62, 0xc6, 0x24, 0xde, 0x50, 0x41, 0x83,
+	0x93, 0x48, 0x59, 0x61, 0xc5, 0x78, 0x40, 0x9d, 0xb2, 0x7b, 0xba, 0xc0, 0x2e, 0x4d, 0xf1, 0x1b,
+	0x93, 0x48, 0x59, 0xe3, 0x03, 0x99, 0xc6, 0xd4, 0x39, 0xd2, 0xbc, 0xf1, 0xdf, 0x19, 0x90, 0x3b,
+	0xb4, 0xec, 0x57, 0x88, 0x40, 0x19, 0xe4, 0x43, 0xf4, 0xc3, 0x18, 0xf9, 0x36, 0x93, 0x85, 0x68,
+	0x4c, 0x6d, 0xf8, 0x15, 0x28, 0x86, 0x78, 0x1c, 0xd8, 0xc8, 0x8c, 0x7b, 0xf2, 0x1e, 0x9b, 0x93,
+	0x48, 0x81, 0xac, 0xc7, 0x5c, 0x50, 0x35, 0x00, 0xb3, 0x0e, 0x71, 0x40, 0xe0, 0x37, 0x60, 0x85,
+	0xc7, 0x78, 0x67, 0x2a, 0x80, 0x82, 0xf6, 0xe9, 0x24, 0x52, 0x36, 0x16, 0x72, 0x79, 0x5c, 0x35,
+	0x4a, 0xcc, 0x91, 0x48, 0x75, 0x1f, 0x94, 0x1d, 0x14, 0x12, 0xd7, 0xb7, 0xe8, 0x4e, 0x69, 0x7f,
+	0x91, 0xd6, 0xf8, 0x6c, 0x12, 0x29, 0xf7, 0x92, 0x01, 0x2f, 0x22, 0x54, 0x63, 0x75, 0xce, 0x45,
+	0x99, 0x74, 0xc0, 0xfa, 0x3c, 0x2a, 0xa1, 0x43, 0x25, 0xa0, 0x55, 0x26, 0x91, 0x22, 0xdf, 0x2e,
+	0x35, 0xe5, 0x04, 0xe7, 0xbc, 0x09, 0x31, 0x08, 0x44, 0xc7, 0x22, 0x16, 0x95, 0xca, 0xb2, 0x41,
+	0xcf, 0xf0, 0x7b, 0xb0, 0x42, 0x5c, 0x0f, 0xe1, 0x31, 0x31, 0x8f, 0x91, 0x3b, 0x38, 0x26, 0x54,
+	0x2c, 0xc5, 0x85, 0x67, 0x85, 0xbd, 0x01, 0x4f, 0x76, 0x6a, 0x07, 0x14, 0xa1, 0xdd, 0x8f, 0x85,
+	0x3e, 0x1b, 0xc7, 0x62, 0xbe, 0x6a, 0x94, 0xb8, 0x83, 0xa1, 0xa1, 0x0e, 0xd6, 0x12, 0x44, 0xfc,
+	0x1b, 0x12, 0xcb, 0x1b, 0x71, 0xc1, 0x6d, 0x4d, 0x22, 0x45, 0x5a, 0x2c, 0x32, 0x85, 0xa8, 0x46,
+	0x99, 0xfb, 0x7a, 0x89, 0x8b, 0x2b, 0xe0, 0x57, 0x01, 0x14, 0x99, 0x02, 0xe8, 0xf3, 0xfe, 0x01,
+	0xa4, 0xb7, 0xa0, 0xb4, 0xcc, 0x0d, 0xa5, 0x25, 0x53, 0x15, 0x67, 0x53, 0xe5, 0x44, 0x7f, 0x4f,
+	0x83, 0x12, 0x23, 0xda, 0x63, 0xff, 0xe4, 0x63, 0x53, 0xbd, 0xbd, 0x6c, 0xf1, 0x43, 0x2c, 0x3b,
+	0xfb, 0x1f, 0x96, 0xfd, 0x93, 0x00, 0xf2, 0x6c, 0x86, 0xba, 0xf3, 0x91, 0xc7, 0xc7, 0x19, 0x75,
+	0xc0, 0xea, 0x9e, 0xfd, 0xca, 0xc7, 0xa7, 0x43, 0xe4, 0x0c, 0x90, 0x87, 0x7c, 0x02, 0x25, 0x90,
+	0x0b, 0x50, 0x38, 0x1e, 0x12, 0x69, 0x23, 0x16, 0xc1, 0x41, 0xca, 0xe0, 0x36, 0xdc, 0x04, 0x59,
+	0x14, 0x04, 0x38, 0x90, 0x36, 0xe3, 0xfe, 0x07, 0x29, 0x83, 0x99, 0x1a, 0x00, 0xf9, 0x00, 0x85,
+	0x23, 0xec, 0x87, 0x48, 0xfd, 0x31, 0x1d, 0xbf, 0xd1, 0x02, 0xcb, 0x0b, 0x61, 0x1b, 0xac, 0x7b,
+	0xd6, 0x99, 0x79, 0xf3, 0x93, 0x41, 0x5f, 0x6e, 0xf3, 0x8f, 0xfc, 0x1d, 0x20, 0xd5, 0x58, 0xf3,
+	0xac, 0xb3, 0xc6, 0xe2, 0x97, 0xa3, 0x0b, 0x36, 0x62, 0xe8, 0x88, 0x0e, 0xd0, 0x8c, 0xa5, 0x69,
+	0xf6, 0xcf, 0x09, 0x0a, 0xe9, 0x38, 0x44, 0xad, 0x3a, 0x89, 0x94, 0xad, 0x59, 0xc5, 0x5b, 0x30,
+	0xd5, 0x80, 0x9e, 0x75, 0xc6, 0xc6, 0xdf, 0xb4, 0x88, 0xa5, 0xc5, 0xce, 0xa4, 0xa8, 0xeb, 0x9b,
+	0x2f, 0x87, 0xf1, 0xd2, 0x79, 0x5e, 0x28, 0x65, 0xee, 0x2a, 0x7a, 0x0b, 0xc6, 0x8a, 0xea, 0xfe,
+	0x3e, 0xf5, 0xb2, 0xe2, 0xe1, 0xc3, 0xdf, 0x04, 0x90, 0xed, 0xf2, 0xef, 0xb6, 0xd2, 0xed, 0xed,
+	0xf5, 0x5a, 0xe6, 0x51, 0x5b, 0x6f, 0xeb, 0x3d, 0x7d, 0xef, 0xb9, 0xfe, 0xa2, 0xd5, 0x34, 0x8f,
+	0xda, 0xdd, 0xc3, 0x56, 0x43, 0xdf, 0xd7, 0x5b, 0xcd, 0x72, 0x4a, 0x5e, 0xbb, 0xb8, 0xac, 0x96,
+	0x16, 0x00, 0x50, 0x02, 0x80, 0xe5, 0xc5, 0xce, 0xb2, 0x20, 0xe7, 0x2f, 0x2e, 0xab, 0x62, 0x7c,
+	0x86, 0x15, 0x50, 0x62, 0x91, 0x9e, 0xf1, 0x5d, 0xe7, 0xb0, 0xd5, 0x2e, 0xa7, 0xe5, 0xe2, 0xc5,
+	0x65, 0x75, 0x89, 0x9b, 0xb3, 0x4c, 0x1a, 0xcc, 0xb0, 0x4c, 0x1a, 0xd9, 0x02, 0xcb, 0x2c, 0xd2,
+	0x78, 0xde, 0xe9, 0xb6, 0x9a, 0x65, 0x51, 0x06, 0x17, 0x97, 0xd5, 0x1c, 0xb3, 0x64, 0xf1, 0xf5,
+	0x9b, 0x4a, 0xea, 0xe1, 0x29, 0xc8, 0xd2, 0x2b, 0x04, 0xfc, 0x1c, 0x6c, 0x76, 0x8c, 0x66, 0xcb,
+	0x30, 0xdb, 0x9d, 0x76, 0xeb, 0x06, 0x5f, 0x5a, 0x32, 0xf6, 0x43, 0x15, 0xac, 0x32, 0xd4, 0x51,
+	0x9b, 0xfe, 0xb6, 0x9a, 0x65, 0x41, 0x2e, 0x5d, 0x5c, 0x56, 0x0b, 0x53, 0x47, 0x4c, 0x98, 0x61,
+	0x12, 0x04, 0x27, 0xcc, 0x4d, 0xd6, 0x58, 0xeb, 0xbe, 0xbd, 0xaa, 0x08, 0xef, 0xae, 0x2a, 0xc2,
+	0x9f, 0x57, 0x15, 0xe1, 0xe7, 0xeb, 0x4a, 0xea, 0xdd, 0x75, 0x25, 0xf5, 0xc7, 0x75, 0x25, 0xf5,
+	0xe2, 0xeb, 0x81, 0x4b, 0x8e, 0xc7, 0xfd, 0x9a, 0x8d, 0xbd, 0xba, 0x8d, 0x43, 0x0f, 0x87, 0x75,
+	0xb7, 0x6f, 0x3f, 0x1a, 0xe0, 0xfa, 0xc9, 0xb3, 0xba, 0x87, 0x9d, 0xf1, 0x10, 0x85, 0xec, 0x9e,
+	0xfb, 0xf8, 0xe9, 0xa3, 0xe4, 0xe2, 0x4c, 0xce, 0x47, 0x28, 0xec, 0xe7, 0xe8, 0x45, 0xf7, 0xcb,
+	0x7f, 0x06, 0x00, 0xd9, 0x61, 0x05, 0x23, 0x59, 0x0b, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -582,6 +716,11 @@ func (m *Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelayPeriod != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -641,6 +780,11 @@ func (m *IdentifiedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelayPeriod != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
 		copy(dAtA[i:], m.ChannelId)
@@ -858,6 +1002,63 @@ func (m *PacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PacketId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -966,6 +1167,44 @@ func (m *Acknowledgement_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	dAtA[i] = 0xb2
 	return len(dAtA) - i, nil
 }
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxInFlightPackets != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxInFlightPackets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPacketDataBytes != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxPacketDataBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxConnectionHops != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxConnectionHops))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
 	offset -= sovChannel(v)
 	base := offset
@@ -1001,6 +1240,9 @@ func (m *Channel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovChannel(uint64(l))
 	}
+	if m.DelayPeriod != 0 {
+		n += 1 + sovChannel(uint64(m.DelayPeriod))
+	}
 	return n
 }
 
@@ -1036,6 +1278,9 @@ func (m *IdentifiedChannel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovChannel(uint64(l))
 	}
+	if m.DelayPeriod != 0 {
+		n += 1 + sovChannel(uint64(m.DelayPeriod))
+	}
 	return n
 }
 
@@ -1117,6 +1362,31 @@ func (m *PacketState) Size() (n int) {
 	return n
 }
 
+func (m *PacketTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovChannel(uint64(m.Sequence))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovChannel(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
 func (m *PacketId) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1171,6 +1441,23 @@ func (m *Acknowledgement_Error) Size() (n int) {
 	n += 2 + l + sovChannel(uint64(l))
 	return n
 }
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxConnectionHops != 0 {
+		n += 1 + sovChannel(uint64(m.MaxConnectionHops))
+	}
+	if m.MaxPacketDataBytes != 0 {
+		n += 1 + sovChannel(uint64(m.MaxPacketDataBytes))
+	}
+	if m.MaxInFlightPackets != 0 {
+		n += 1 + sovChannel(uint64(m.MaxInFlightPackets))
+	}
+	return n
+}
 
 func sovChannel(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -1342,6 +1629,25 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
@@ -1591,6 +1897,25 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
@@ -2176,6 +2501,191 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PacketTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PacketId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2424,6 +2934,113 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConnectionHops", wireType)
+			}
+			m.MaxConnectionHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConnectionHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketDataBytes", wireType)
+			}
+			m.MaxPacketDataBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketDataBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInFlightPackets", wireType)
+			}
+			m.MaxInFlightPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInFlightPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipChannel(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
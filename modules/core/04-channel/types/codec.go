@@ -39,6 +39,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgAcknowledgement{},
 		&MsgTimeout{},
 		&MsgTimeoutOnClose{},
+		&MsgPruneAcknowledgements{},
+		&MsgUpdateChannelConnection{},
+		&MsgFreezeChannel{},
+		&MsgUnfreezeChannel{},
+		&MsgUpdateChannelParams{},
+		&MsgSetupLocalhostPath{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -37,7 +37,15 @@ var (
 	// Perform a no-op on the current Msg
 	ErrNoOpMsg = sdkerrors.Register(SubModuleName, 23, "message is redundant, no-op will be performed")
 
-	ErrInvalidChannelVersion = sdkerrors.Register(SubModuleName, 24, "invalid channel version")
-	ErrPacketNotSent         = sdkerrors.Register(SubModuleName, 25, "packet has not been sent")
-	ErrInvalidTimeout        = sdkerrors.Register(SubModuleName, 26, "invalid packet timeout")
+	ErrInvalidChannelVersion      = sdkerrors.Register(SubModuleName, 24, "invalid channel version")
+	ErrPacketNotSent              = sdkerrors.Register(SubModuleName, 25, "packet has not been sent")
+	ErrInvalidTimeout             = sdkerrors.Register(SubModuleName, 26, "invalid packet timeout")
+	ErrInvalidPruningLimit        = sdkerrors.Register(SubModuleName, 27, "invalid pruning limit")
+	ErrInvalidDelayPeriod         = sdkerrors.Register(SubModuleName, 29, "invalid channel delay period")
+	ErrConnectionUpdateNotAllowed = sdkerrors.Register(SubModuleName, 30, "channel connection update not allowed")
+	ErrChannelFrozen              = sdkerrors.Register(SubModuleName, 31, "channel is frozen")
+	ErrChannelNotFrozen           = sdkerrors.Register(SubModuleName, 32, "channel is not frozen")
+	ErrPacketDataTooLarge         = sdkerrors.Register(SubModuleName, 33, "packet data exceeds the maximum size")
+	ErrMaxInFlightPackets         = sdkerrors.Register(SubModuleName, 34, "maximum number of packets in flight reached")
+	ErrInvalidPacketState         = sdkerrors.Register(SubModuleName, 35, "invalid channel packet state")
 )
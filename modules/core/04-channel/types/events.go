@@ -8,12 +8,15 @@ import (
 
 // IBC channel events
 const (
-	AttributeKeyConnectionID       = "connection_id"
-	AttributeKeyPortID             = "port_id"
-	AttributeKeyChannelID          = "channel_id"
-	AttributeVersion               = "version"
-	AttributeCounterpartyPortID    = "counterparty_port_id"
-	AttributeCounterpartyChannelID = "counterparty_channel_id"
+	AttributeKeyConnectionID         = "connection_id"
+	AttributeKeyPortID               = "port_id"
+	AttributeKeyChannelID            = "channel_id"
+	AttributeVersion                 = "version"
+	AttributeCounterpartyPortID      = "counterparty_port_id"
+	AttributeCounterpartyChannelID   = "counterparty_channel_id"
+	AttributeKeyTotalPruned          = "total_pruned"
+	AttributeKeyHasRemaining         = "has_remaining"
+	AttributeKeyPreviousConnectionID = "previous_connection_id"
 
 	EventTypeSendPacket           = "send_packet"
 	EventTypeRecvPacket           = "recv_packet"
@@ -42,13 +45,18 @@ const (
 
 // IBC channel events vars
 var (
-	EventTypeChannelOpenInit     = "channel_open_init"
-	EventTypeChannelOpenTry      = "channel_open_try"
-	EventTypeChannelOpenAck      = "channel_open_ack"
-	EventTypeChannelOpenConfirm  = "channel_open_confirm"
-	EventTypeChannelCloseInit    = "channel_close_init"
-	EventTypeChannelCloseConfirm = "channel_close_confirm"
-	EventTypeChannelClosed       = "channel_close"
+	EventTypeChannelOpenInit         = "channel_open_init"
+	EventTypeChannelOpenTry          = "channel_open_try"
+	EventTypeChannelOpenAck          = "channel_open_ack"
+	EventTypeChannelOpenConfirm      = "channel_open_confirm"
+	EventTypeChannelCloseInit        = "channel_close_init"
+	EventTypeChannelCloseConfirm     = "channel_close_confirm"
+	EventTypeChannelClosed           = "channel_close"
+	EventTypeChannelUpdateConnection = "channel_update_connection"
+	EventTypeChannelFreeze           = "channel_freeze"
+	EventTypeChannelUnfreeze         = "channel_unfreeze"
+
+	EventTypePruneAcknowledgements = "prune_acknowledgements"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
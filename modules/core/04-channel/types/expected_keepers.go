@@ -13,6 +13,7 @@ type ClientKeeper interface {
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
 	GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
 	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	ValidateSameChain(clientState, otherClientState exported.ClientState) error
 }
 
 // ConnectionKeeper expected account IBC connection keeper
@@ -41,10 +41,140 @@ func (ps PacketSequence) Validate() error {
 	return validateGenFields(ps.PortId, ps.ChannelId, ps.Sequence)
 }
 
+// NewChannelPacketState creates a new ChannelPacketState instance.
+func NewChannelPacketState(
+	portID, channelID string, commitments, receipts, acks []PacketState, packetTimeouts []PacketTimeout,
+	nextSequenceSend, nextSequenceRecv, nextSequenceAck uint64,
+) ChannelPacketState {
+	return ChannelPacketState{
+		PortId:           portID,
+		ChannelId:        channelID,
+		Commitments:      commitments,
+		Receipts:         receipts,
+		Acknowledgements: acks,
+		PacketTimeouts:   packetTimeouts,
+		NextSequenceSend: nextSequenceSend,
+		NextSequenceRecv: nextSequenceRecv,
+		NextSequenceAck:  nextSequenceAck,
+	}
+}
+
+// Validate performs validation of the packet state of a channel with the provided ordering returning
+// an error upon any failure. The packet state must be consistent with its sequences: commitments must
+// have been sent, and on ORDERED channels acknowledgements must have been received and commitments must
+// not have been acknowledged. ORDERED channels do not store receipts, while every acknowledgement of an
+// UNORDERED channel must have a receipt.
+func (cps ChannelPacketState) Validate(ordering Order) error {
+	if err := host.PortIdentifierValidator(cps.PortId); err != nil {
+		return fmt.Errorf("invalid port Id: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(cps.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel Id: %w", err)
+	}
+	if cps.NextSequenceSend == 0 || cps.NextSequenceRecv == 0 || cps.NextSequenceAck == 0 {
+		return errors.New("next sequences cannot be 0")
+	}
+
+	commitments := make(map[uint64]bool, len(cps.Commitments))
+	for i, commitment := range cps.Commitments {
+		if err := cps.validatePacketState(commitment); err != nil {
+			return fmt.Errorf("invalid commitment %v index %d: %w", commitment, i, err)
+		}
+		if commitment.Sequence >= cps.NextSequenceSend {
+			return fmt.Errorf("invalid commitment %v index %d: sequence must be less than the next send sequence %d", commitment, i, cps.NextSequenceSend)
+		}
+		if ordering == ORDERED && commitment.Sequence < cps.NextSequenceAck {
+			return fmt.Errorf("invalid commitment %v index %d: sequence has been acknowledged on an ORDERED channel, next ack sequence is %d", commitment, i, cps.NextSequenceAck)
+		}
+
+		commitments[commitment.Sequence] = true
+	}
+
+	for i, pt := range cps.PacketTimeouts {
+		if err := pt.Validate(); err != nil {
+			return fmt.Errorf("invalid packet timeout %v index %d: %w", pt, i, err)
+		}
+		if pt.PortId != cps.PortId || pt.ChannelId != cps.ChannelId {
+			return fmt.Errorf("invalid packet timeout %v index %d: packet timeout does not belong to channel %s on port %s", pt, i, cps.ChannelId, cps.PortId)
+		}
+		if !commitments[pt.Sequence] {
+			return fmt.Errorf("invalid packet timeout %v index %d: no packet commitment for sequence %d", pt, i, pt.Sequence)
+		}
+	}
+
+	if ordering == ORDERED && len(cps.Receipts) != 0 {
+		return errors.New("packet receipts are not stored for ORDERED channels")
+	}
+
+	receipts := make(map[uint64]bool, len(cps.Receipts))
+	for i, receipt := range cps.Receipts {
+		if err := cps.validatePacketState(receipt); err != nil {
+			return fmt.Errorf("invalid receipt %v index %d: %w", receipt, i, err)
+		}
+
+		receipts[receipt.Sequence] = true
+	}
+
+	for i, ack := range cps.Acknowledgements {
+		if err := cps.validatePacketState(ack); err != nil {
+			return fmt.Errorf("invalid acknowledgement %v ack index %d: %w", ack, i, err)
+		}
+
+		switch ordering {
+		case ORDERED:
+			if ack.Sequence >= cps.NextSequenceRecv {
+				return fmt.Errorf("invalid acknowledgement %v ack index %d: sequence must be less than the next receive sequence %d", ack, i, cps.NextSequenceRecv)
+			}
+		case UNORDERED:
+			if !receipts[ack.Sequence] {
+				return fmt.Errorf("invalid acknowledgement %v ack index %d: no packet receipt for sequence %d", ack, i, ack.Sequence)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validatePacketState validates the packet state returning an error if it is invalid or does not belong
+// to the channel.
+func (cps ChannelPacketState) validatePacketState(ps PacketState) error {
+	if err := ps.Validate(); err != nil {
+		return err
+	}
+	if len(ps.Data) == 0 {
+		return errors.New("data bytes cannot be empty")
+	}
+	if ps.PortId != cps.PortId || ps.ChannelId != cps.ChannelId {
+		return fmt.Errorf("packet state does not belong to channel %s on port %s", cps.ChannelId, cps.PortId)
+	}
+	return nil
+}
+
+// NewFrozenChannel creates a new FrozenChannel instance.
+func NewFrozenChannel(portID, channelID string) FrozenChannel {
+	return FrozenChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (fc FrozenChannel) Validate() error {
+	if err := host.PortIdentifierValidator(fc.PortId); err != nil {
+		return fmt.Errorf("invalid port Id: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(fc.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel Id: %w", err)
+	}
+	return nil
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	channels []IdentifiedChannel, acks, receipts, commitments []PacketState,
 	sendSeqs, recvSeqs, ackSeqs []PacketSequence, nextChannelSequence uint64,
+	frozenChannels []FrozenChannel, packetTimeouts []PacketTimeout, params Params,
 ) GenesisState {
 	return GenesisState{
 		Channels:            channels,
@@ -54,6 +184,9 @@ func NewGenesisState(
 		RecvSequences:       recvSeqs,
 		AckSequences:        ackSeqs,
 		NextChannelSequence: nextChannelSequence,
+		FrozenChannels:      frozenChannels,
+		Params:              params,
+		PacketTimeouts:      packetTimeouts,
 	}
 }
 
@@ -68,6 +201,9 @@ func DefaultGenesisState() GenesisState {
 		RecvSequences:       []PacketSequence{},
 		AckSequences:        []PacketSequence{},
 		NextChannelSequence: 0,
+		FrozenChannels:      []FrozenChannel{},
+		Params:              DefaultParams(),
+		PacketTimeouts:      []PacketTimeout{},
 	}
 }
 
@@ -93,7 +229,8 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
-	if maxSequence != 0 && maxSequence >= gs.NextChannelSequence {
+	// the next sequence must also be greater than zero if a channel with sequence zero is provided
+	if len(gs.Channels) != 0 && maxSequence >= gs.NextChannelSequence {
 		return fmt.Errorf("next channel sequence %d must be greater than maximum sequence used in channel identifier %d", gs.NextChannelSequence, maxSequence)
 	}
 
@@ -139,6 +276,30 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, fc := range gs.FrozenChannels {
+		if err := fc.Validate(); err != nil {
+			return fmt.Errorf("invalid frozen channel %v index %d: %w", fc, i, err)
+		}
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	commitments := make(map[string]bool, len(gs.Commitments))
+	for _, commitment := range gs.Commitments {
+		commitments[string(host.PacketCommitmentKey(commitment.PortId, commitment.ChannelId, commitment.Sequence))] = true
+	}
+
+	for i, pt := range gs.PacketTimeouts {
+		if err := pt.Validate(); err != nil {
+			return fmt.Errorf("invalid packet timeout %v index %d: %w", pt, i, err)
+		}
+		if !commitments[string(host.PacketCommitmentKey(pt.PortId, pt.ChannelId, pt.Sequence))] {
+			return fmt.Errorf("invalid packet timeout %v index %d: no packet commitment for sequence %d", pt, i, pt.Sequence)
+		}
+	}
+
 	return nil
 }
 
@@ -34,6 +34,11 @@ type GenesisState struct {
 	AckSequences     []PacketSequence    `protobuf:"bytes,7,rep,name=ack_sequences,json=ackSequences,proto3" json:"ack_sequences" yaml:"ack_sequences"`
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty" yaml:"next_channel_sequence"`
+	// the channels which are frozen by the governance authority
+	FrozenChannels []FrozenChannel `protobuf:"bytes,9,rep,name=frozen_channels,json=frozenChannels,proto3" json:"frozen_channels" yaml:"frozen_channels"`
+	Params         Params          `protobuf:"bytes,10,opt,name=params,proto3" json:"params"`
+	// the timeouts of the packets with a stored commitment
+	PacketTimeouts []PacketTimeout `protobuf:"bytes,11,rep,name=packet_timeouts,json=packetTimeouts,proto3" json:"packet_timeouts" yaml:"packet_timeouts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +130,27 @@ func (m *GenesisState) GetNextChannelSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetFrozenChannels() []FrozenChannel {
+	if m != nil {
+		return m.FrozenChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPacketTimeouts() []PacketTimeout {
+	if m != nil {
+		return m.PacketTimeouts
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -187,47 +213,227 @@ func (m *PacketSequence) GetSequence() uint64 {
 	return 0
 }
 
+// FrozenChannel defines the genesis type identifying a channel frozen by the governance authority.
+type FrozenChannel struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *FrozenChannel) Reset()         { *m = FrozenChannel{} }
+func (m *FrozenChannel) String() string { return proto.CompactTextString(m) }
+func (*FrozenChannel) ProtoMessage()    {}
+func (*FrozenChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb06ec201f452595, []int{2}
+}
+func (m *FrozenChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenChannel.Merge(m, src)
+}
+func (m *FrozenChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenChannel proto.InternalMessageInfo
+
+func (m *FrozenChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *FrozenChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// ChannelPacketState defines the packet state of a single channel. It is used to export and import the packet
+// state of a channel without the genesis state of all channels.
+type ChannelPacketState struct {
+	PortId           string        `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId        string        `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Commitments      []PacketState `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments"`
+	Receipts         []PacketState `protobuf:"bytes,4,rep,name=receipts,proto3" json:"receipts"`
+	Acknowledgements []PacketState `protobuf:"bytes,5,rep,name=acknowledgements,proto3" json:"acknowledgements"`
+	// the timeouts of the packets with a stored commitment
+	PacketTimeouts   []PacketTimeout `protobuf:"bytes,6,rep,name=packet_timeouts,json=packetTimeouts,proto3" json:"packet_timeouts" yaml:"packet_timeouts"`
+	NextSequenceSend uint64          `protobuf:"varint,7,opt,name=next_sequence_send,json=nextSequenceSend,proto3" json:"next_sequence_send,omitempty" yaml:"next_sequence_send"`
+	NextSequenceRecv uint64          `protobuf:"varint,8,opt,name=next_sequence_recv,json=nextSequenceRecv,proto3" json:"next_sequence_recv,omitempty" yaml:"next_sequence_recv"`
+	NextSequenceAck  uint64          `protobuf:"varint,9,opt,name=next_sequence_ack,json=nextSequenceAck,proto3" json:"next_sequence_ack,omitempty" yaml:"next_sequence_ack"`
+}
+
+func (m *ChannelPacketState) Reset()         { *m = ChannelPacketState{} }
+func (m *ChannelPacketState) String() string { return proto.CompactTextString(m) }
+func (*ChannelPacketState) ProtoMessage()    {}
+func (*ChannelPacketState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb06ec201f452595, []int{3}
+}
+func (m *ChannelPacketState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelPacketState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelPacketState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelPacketState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelPacketState.Merge(m, src)
+}
+func (m *ChannelPacketState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelPacketState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelPacketState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelPacketState proto.InternalMessageInfo
+
+func (m *ChannelPacketState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelPacketState) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelPacketState) GetCommitments() []PacketState {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *ChannelPacketState) GetReceipts() []PacketState {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *ChannelPacketState) GetAcknowledgements() []PacketState {
+	if m != nil {
+		return m.Acknowledgements
+	}
+	return nil
+}
+
+func (m *ChannelPacketState) GetPacketTimeouts() []PacketTimeout {
+	if m != nil {
+		return m.PacketTimeouts
+	}
+	return nil
+}
+
+func (m *ChannelPacketState) GetNextSequenceSend() uint64 {
+	if m != nil {
+		return m.NextSequenceSend
+	}
+	return 0
+}
+
+func (m *ChannelPacketState) GetNextSequenceRecv() uint64 {
+	if m != nil {
+		return m.NextSequenceRecv
+	}
+	return 0
+}
+
+func (m *ChannelPacketState) GetNextSequenceAck() uint64 {
+	if m != nil {
+		return m.NextSequenceAck
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.channel.v1.GenesisState")
 	proto.RegisterType((*PacketSequence)(nil), "ibc.core.channel.v1.PacketSequence")
+	proto.RegisterType((*FrozenChannel)(nil), "ibc.core.channel.v1.FrozenChannel")
+	proto.RegisterType((*ChannelPacketState)(nil), "ibc.core.channel.v1.ChannelPacketState")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0x87, 0xe3, 0x36, 0x4d, 0xd3, 0x6d, 0x13, 0xd1, 0x6d, 0x23, 0x99, 0xa8, 0xd8, 0xc6, 0x48,
-	0x28, 0x12, 0xaa, 0x4d, 0xa1, 0x42, 0x82, 0xa3, 0x39, 0x40, 0x6e, 0x68, 0xcb, 0x09, 0x09, 0x45,
-	0xf6, 0x7a, 0xea, 0xae, 0x12, 0x7b, 0x83, 0x77, 0x13, 0xe8, 0x53, 0xc0, 0x63, 0xf5, 0xd8, 0x23,
-	0x27, 0x0b, 0x25, 0x6f, 0x90, 0x23, 0x27, 0xe4, 0xbf, 0x49, 0xd4, 0x08, 0x51, 0x6e, 0xde, 0x99,
-	0xdf, 0x7c, 0xdf, 0x1c, 0x3c, 0xe8, 0x31, 0xf3, 0xa8, 0x4d, 0x79, 0x0c, 0x36, 0xbd, 0x72, 0xa3,
-	0x08, 0x46, 0xf6, 0xf4, 0xcc, 0x0e, 0x20, 0x02, 0xc1, 0x84, 0x35, 0x8e, 0xb9, 0xe4, 0xf8, 0x88,
-	0x79, 0xd4, 0x4a, 0x23, 0x56, 0x11, 0xb1, 0xa6, 0x67, 0xdd, 0xe3, 0x80, 0x07, 0x3c, 0xeb, 0xdb,
-	0xe9, 0x57, 0x1e, 0xed, 0x6e, 0xa4, 0x95, 0x53, 0x59, 0xc4, 0x9c, 0xef, 0xa0, 0x83, 0x77, 0x39,
-	0xff, 0x42, 0xba, 0x12, 0xf0, 0x67, 0xd4, 0x2c, 0x12, 0x42, 0x55, 0x8c, 0xed, 0xde, 0xfe, 0x8b,
-	0xa7, 0xd6, 0x06, 0xa3, 0xd5, 0xf7, 0x21, 0x92, 0xec, 0x92, 0x81, 0xff, 0x36, 0x2f, 0x3a, 0x0f,
-	0x6f, 0x12, 0xbd, 0xf6, 0x3b, 0xd1, 0x0f, 0xef, 0xb4, 0x48, 0x85, 0xc4, 0x04, 0x3d, 0x70, 0xe9,
-	0x30, 0xe2, 0x5f, 0x47, 0xe0, 0x07, 0x10, 0x42, 0x24, 0x85, 0xba, 0x95, 0x69, 0x8c, 0x8d, 0x9a,
-	0x0f, 0x2e, 0x1d, 0x82, 0xcc, 0x56, 0x73, 0xea, 0xa9, 0x80, 0xdc, 0x99, 0xc7, 0xef, 0xd1, 0x3e,
-	0xe5, 0x61, 0xc8, 0x64, 0x8e, 0xdb, 0xbe, 0x17, 0x6e, 0x75, 0x14, 0x3b, 0xa8, 0x19, 0x03, 0x05,
-	0x36, 0x96, 0x42, 0xad, 0xdf, 0x0b, 0x53, 0xcd, 0x61, 0x86, 0xda, 0x02, 0x22, 0x7f, 0x20, 0xe0,
-	0xcb, 0x04, 0x22, 0x0a, 0x42, 0xdd, 0xc9, 0x48, 0x4f, 0xfe, 0x46, 0x2a, 0xb2, 0xce, 0xa3, 0x14,
-	0xb6, 0x48, 0xf4, 0xce, 0xb5, 0x1b, 0x8e, 0xde, 0x98, 0xeb, 0x20, 0x93, 0xb4, 0xd2, 0x42, 0x19,
-	0xce, 0x54, 0x31, 0xd0, 0xe9, 0x8a, 0xaa, 0xf1, 0xdf, 0xaa, 0x75, 0x90, 0x49, 0x5a, 0x69, 0x61,
-	0xa9, 0xba, 0x44, 0x2d, 0x97, 0x0e, 0x57, 0x4c, 0xbb, 0xff, 0x6e, 0x3a, 0x29, 0x4c, 0xc7, 0xb9,
-	0x69, 0x8d, 0x63, 0x92, 0x03, 0x97, 0x0e, 0x97, 0x9e, 0x8f, 0xa8, 0x13, 0xc1, 0x37, 0x39, 0x28,
-	0x68, 0x55, 0x50, 0x6d, 0x1a, 0x4a, 0xaf, 0xee, 0x18, 0x8b, 0x44, 0x3f, 0xc9, 0x31, 0x1b, 0x63,
-	0x26, 0x39, 0x4a, 0xeb, 0xc5, 0x7f, 0x57, 0x62, 0xcd, 0xef, 0x0a, 0x6a, 0xaf, 0x2f, 0x85, 0x9f,
-	0xa1, 0xdd, 0x31, 0x8f, 0xe5, 0x80, 0xf9, 0xaa, 0x62, 0x28, 0xbd, 0x3d, 0x07, 0x2f, 0x12, 0xbd,
-	0x9d, 0xa3, 0x8b, 0x86, 0x49, 0x1a, 0xe9, 0x57, 0xdf, 0xc7, 0xe7, 0x08, 0x95, 0x26, 0xe6, 0xab,
-	0x5b, 0x59, 0xbe, 0xb3, 0x48, 0xf4, 0xc3, 0x3c, 0xbf, 0xec, 0x99, 0x64, 0xaf, 0x78, 0xf4, 0x7d,
-	0xdc, 0x45, 0xcd, 0x6a, 0xfd, 0xed, 0x74, 0x7d, 0x52, 0xbd, 0x9d, 0x8b, 0x9b, 0x99, 0xa6, 0xdc,
-	0xce, 0x34, 0xe5, 0xd7, 0x4c, 0x53, 0x7e, 0xcc, 0xb5, 0xda, 0xed, 0x5c, 0xab, 0xfd, 0x9c, 0x6b,
-	0xb5, 0x4f, 0xaf, 0x03, 0x26, 0xaf, 0x26, 0x9e, 0x45, 0x79, 0x68, 0x53, 0x2e, 0x42, 0x2e, 0x6c,
-	0xe6, 0xd1, 0xd3, 0x80, 0xdb, 0xd3, 0x57, 0x76, 0xc8, 0xfd, 0xc9, 0x08, 0x44, 0x7e, 0xd4, 0xcf,
-	0xcf, 0x4f, 0xcb, 0xbb, 0x96, 0xd7, 0x63, 0x10, 0x5e, 0x23, 0xbb, 0xe9, 0x97, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x65, 0x82, 0x18, 0x7d, 0x46, 0x04, 0x00, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc7, 0xe3, 0x7e, 0xe4, 0x63, 0xd2, 0xa4, 0xed, 0xb4, 0xbd, 0x72, 0x7b, 0xdb, 0x38, 0x77,
+	0xae, 0x84, 0x22, 0xa1, 0xc6, 0xb4, 0x54, 0x48, 0x65, 0x47, 0x90, 0xa0, 0x15, 0x1b, 0x34, 0xed,
+	0x0a, 0x09, 0x45, 0xce, 0x78, 0x92, 0x5a, 0x8e, 0x3d, 0xc1, 0xe3, 0x04, 0xca, 0x4b, 0xc0, 0x73,
+	0xf0, 0x24, 0x5d, 0x76, 0xc9, 0xca, 0x42, 0xed, 0x1b, 0x64, 0x85, 0x58, 0xa1, 0x19, 0xdb, 0xa9,
+	0x9d, 0x9a, 0x8a, 0x82, 0xa8, 0xd8, 0xd9, 0x67, 0xfe, 0xe7, 0xf7, 0x3f, 0x3a, 0x3e, 0x3e, 0x1a,
+	0xf0, 0x9f, 0xd5, 0x21, 0x3a, 0x61, 0x1e, 0xd5, 0xc9, 0x89, 0xe1, 0xba, 0xb4, 0xaf, 0x8f, 0x76,
+	0xf4, 0x1e, 0x75, 0x29, 0xb7, 0x78, 0x73, 0xe0, 0x31, 0x9f, 0xc1, 0x15, 0xab, 0x43, 0x9a, 0x42,
+	0xd2, 0x8c, 0x24, 0xcd, 0xd1, 0xce, 0xc6, 0x6a, 0x8f, 0xf5, 0x98, 0x3c, 0xd7, 0xc5, 0x53, 0x28,
+	0xdd, 0xc8, 0xa4, 0xc5, 0x59, 0x52, 0x82, 0xbe, 0x16, 0xc0, 0xc2, 0xf3, 0x90, 0x7f, 0xe4, 0x1b,
+	0x3e, 0x85, 0xaf, 0x41, 0x31, 0x52, 0x70, 0x55, 0xa9, 0xcf, 0x36, 0xca, 0xbb, 0xf7, 0x9a, 0x19,
+	0x8e, 0xcd, 0x43, 0x93, 0xba, 0xbe, 0xd5, 0xb5, 0xa8, 0xf9, 0x34, 0x0c, 0xb6, 0xd6, 0xcf, 0x02,
+	0x2d, 0xf7, 0x2d, 0xd0, 0x96, 0xaf, 0x1d, 0xe1, 0x09, 0x12, 0x62, 0xb0, 0x64, 0x10, 0xdb, 0x65,
+	0x6f, 0xfb, 0xd4, 0xec, 0x51, 0x87, 0xba, 0x3e, 0x57, 0x67, 0xa4, 0x4d, 0x3d, 0xd3, 0xe6, 0xa5,
+	0x41, 0x6c, 0xea, 0xcb, 0xd2, 0x5a, 0x73, 0xc2, 0x00, 0x5f, 0xcb, 0x87, 0x07, 0xa0, 0x4c, 0x98,
+	0xe3, 0x58, 0x7e, 0x88, 0x9b, 0xbd, 0x15, 0x2e, 0x99, 0x0a, 0x5b, 0xa0, 0xe8, 0x51, 0x42, 0xad,
+	0x81, 0xcf, 0xd5, 0xb9, 0x5b, 0x61, 0x26, 0x79, 0xd0, 0x02, 0x55, 0x4e, 0x5d, 0xb3, 0xcd, 0xe9,
+	0x9b, 0x21, 0x75, 0x09, 0xe5, 0xea, 0xbc, 0x24, 0xfd, 0x7f, 0x13, 0x29, 0xd2, 0xb6, 0xb6, 0x04,
+	0x6c, 0x1c, 0x68, 0x6b, 0xa7, 0x86, 0xd3, 0x7f, 0x8c, 0xd2, 0x20, 0x84, 0x2b, 0x22, 0x10, 0x8b,
+	0xa5, 0x95, 0x47, 0xc9, 0x28, 0x61, 0x95, 0xff, 0x65, 0xab, 0x34, 0x08, 0xe1, 0x8a, 0x08, 0x5c,
+	0x59, 0x75, 0x41, 0xc5, 0x20, 0x76, 0xc2, 0xa9, 0xf0, 0xf3, 0x4e, 0x9b, 0x91, 0xd3, 0x6a, 0xe8,
+	0x94, 0xe2, 0x20, 0xbc, 0x60, 0x10, 0xfb, 0xca, 0xe7, 0x18, 0xac, 0xb9, 0xf4, 0x9d, 0xdf, 0x8e,
+	0x68, 0x13, 0xa1, 0x5a, 0xac, 0x2b, 0x8d, 0xb9, 0x56, 0x7d, 0x1c, 0x68, 0x9b, 0x21, 0x26, 0x53,
+	0x86, 0xf0, 0x8a, 0x88, 0x47, 0x73, 0x17, 0x63, 0xa1, 0x0d, 0x16, 0xbb, 0x1e, 0x7b, 0x4f, 0xdd,
+	0xf6, 0x64, 0xb6, 0x4b, 0xb2, 0x7e, 0x94, 0x59, 0xff, 0x33, 0xa9, 0x8d, 0xe7, 0xba, 0x16, 0x95,
+	0xff, 0x4f, 0xe8, 0x3b, 0x05, 0x42, 0xb8, 0xda, 0x4d, 0xca, 0x39, 0xdc, 0x07, 0xf9, 0x81, 0xe1,
+	0x19, 0x0e, 0x57, 0x41, 0x5d, 0x69, 0x94, 0x77, 0xff, 0xfd, 0x41, 0x8f, 0x84, 0x24, 0x9a, 0x9e,
+	0x28, 0x41, 0xd4, 0x39, 0x90, 0xbd, 0x6b, 0xfb, 0x96, 0x43, 0xd9, 0xd0, 0xe7, 0x6a, 0xf9, 0x86,
+	0x3a, 0xc3, 0x3e, 0x1f, 0x87, 0xd2, 0xe9, 0x3a, 0xa7, 0x40, 0x08, 0x57, 0x07, 0x49, 0x39, 0x47,
+	0x1f, 0x14, 0x50, 0x4d, 0x7f, 0x29, 0x78, 0x1f, 0x14, 0x06, 0xcc, 0xf3, 0xdb, 0x96, 0xa9, 0x2a,
+	0x75, 0xa5, 0x51, 0x6a, 0xc1, 0x71, 0xa0, 0x55, 0x23, 0x5e, 0x78, 0x80, 0x70, 0x5e, 0x3c, 0x1d,
+	0x9a, 0x70, 0x0f, 0x80, 0xb8, 0xfd, 0x96, 0xa9, 0xce, 0x48, 0xfd, 0xda, 0x38, 0xd0, 0x96, 0x43,
+	0xfd, 0xd5, 0x19, 0xc2, 0xa5, 0xe8, 0xe5, 0xd0, 0x84, 0x1b, 0xa0, 0x38, 0xf9, 0xa6, 0xb3, 0xe2,
+	0x9b, 0xe2, 0xc9, 0x3b, 0xf2, 0x40, 0x25, 0xd5, 0xfa, 0x3b, 0xa8, 0x07, 0x7d, 0x9a, 0x07, 0x30,
+	0xb2, 0x4b, 0xfc, 0xd5, 0x77, 0xd1, 0x89, 0xbf, 0x6b, 0x6d, 0x65, 0x2d, 0xe6, 0xf9, 0xdf, 0x5c,
+	0xcc, 0x19, 0xe3, 0x9c, 0xff, 0x53, 0xe3, 0x0c, 0x5f, 0x00, 0x28, 0x57, 0x42, 0x3c, 0x4d, 0x6d,
+	0xb1, 0x2b, 0xd5, 0x82, 0x5c, 0x1b, 0x5b, 0xe3, 0x40, 0x5b, 0x4f, 0xac, 0x8d, 0x94, 0x06, 0xe1,
+	0x25, 0x11, 0x8c, 0x7f, 0x82, 0x23, 0xea, 0x9a, 0xd7, 0x61, 0x62, 0x1b, 0xaa, 0xc5, 0x9b, 0x61,
+	0x42, 0x33, 0x05, 0xc3, 0x94, 0x8c, 0xe0, 0x01, 0x58, 0x4e, 0x0b, 0x0d, 0x62, 0xab, 0x25, 0xc9,
+	0xda, 0x1c, 0x07, 0x9a, 0x9a, 0xc5, 0x32, 0x88, 0x8d, 0xf0, 0x62, 0x12, 0xf5, 0x84, 0xd8, 0xad,
+	0xa3, 0xb3, 0x8b, 0x9a, 0x72, 0x7e, 0x51, 0x53, 0xbe, 0x5c, 0xd4, 0x94, 0x8f, 0x97, 0xb5, 0xdc,
+	0xf9, 0x65, 0x2d, 0xf7, 0xf9, 0xb2, 0x96, 0x7b, 0xb5, 0xdf, 0xb3, 0xfc, 0x93, 0x61, 0xa7, 0x49,
+	0x98, 0xa3, 0x13, 0xc6, 0x1d, 0xc6, 0x75, 0xab, 0x43, 0xb6, 0x7b, 0x4c, 0x1f, 0x3d, 0xd2, 0x1d,
+	0x66, 0x0e, 0xfb, 0x94, 0x87, 0x57, 0x81, 0x07, 0x7b, 0xdb, 0xf1, 0x6d, 0xc0, 0x3f, 0x1d, 0x50,
+	0xde, 0xc9, 0xcb, 0x9b, 0xc0, 0xc3, 0xef, 0x03, 0x00, 0x0b, 0x50, 0x02, 0xcc, 0x7c, 0x08, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -250,6 +456,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PacketTimeouts) > 0 {
+		for iNdEx := len(m.PacketTimeouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketTimeouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.FrozenChannels) > 0 {
+		for iNdEx := len(m.FrozenChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.NextChannelSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextChannelSequence))
 		i--
@@ -398,98 +642,865 @@ func (m *PacketSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FrozenChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FrozenChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Channels) > 0 {
-		for _, e := range m.Channels {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Acknowledgements) > 0 {
-		for _, e := range m.Acknowledgements {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Commitments) > 0 {
-		for _, e := range m.Commitments {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Receipts) > 0 {
-		for _, e := range m.Receipts {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.SendSequences) > 0 {
-		for _, e := range m.SendSequences {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.RecvSequences) > 0 {
-		for _, e := range m.RecvSequences {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.AckSequences) > 0 {
-		for _, e := range m.AckSequences {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.NextChannelSequence != 0 {
-		n += 1 + sovGenesis(uint64(m.NextChannelSequence))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PacketSequence) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChannelPacketState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelPacketState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelPacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.NextSequenceAck != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSequenceAck))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.NextSequenceRecv != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSequenceRecv))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovGenesis(uint64(m.Sequence))
+	if m.NextSequenceSend != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSequenceSend))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PacketTimeouts) > 0 {
+		for iNdEx := len(m.PacketTimeouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketTimeouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Acknowledgements) > 0 {
+		for iNdEx := len(m.Acknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Acknowledgements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Acknowledgements) > 0 {
+		for _, e := range m.Acknowledgements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendSequences) > 0 {
+		for _, e := range m.SendSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecvSequences) > 0 {
+		for _, e := range m.RecvSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AckSequences) > 0 {
+		for _, e := range m.AckSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextChannelSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.NextChannelSequence))
+	}
+	if len(m.FrozenChannels) > 0 {
+		for _, e := range m.FrozenChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PacketTimeouts) > 0 {
+		for _, e := range m.PacketTimeouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *FrozenChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelPacketState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Acknowledgements) > 0 {
+		for _, e := range m.Acknowledgements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketTimeouts) > 0 {
+		for _, e := range m.PacketTimeouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextSequenceSend != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSequenceSend))
+	}
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSequenceRecv))
+	}
+	if m.NextSequenceAck != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSequenceAck))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, IdentifiedChannel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgements = append(m.Acknowledgements, PacketState{})
+			if err := m.Acknowledgements[len(m.Acknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, PacketState{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, PacketState{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendSequences = append(m.SendSequences, PacketSequence{})
+			if err := m.SendSequences[len(m.SendSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvSequences = append(m.RecvSequences, PacketSequence{})
+			if err := m.RecvSequences[len(m.RecvSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckSequences = append(m.AckSequences, PacketSequence{})
+			if err := m.AckSequences[len(m.AckSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextChannelSequence", wireType)
+			}
+			m.NextChannelSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextChannelSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenChannels = append(m.FrozenChannels, FrozenChannel{})
+			if err := m.FrozenChannels[len(m.FrozenChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketTimeouts = append(m.PacketTimeouts, PacketTimeout{})
+			if err := m.PacketTimeouts[len(m.PacketTimeouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *FrozenChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -512,17 +1523,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrozenChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrozenChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -532,31 +1543,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channels = append(m.Channels, IdentifiedChannel{})
-			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -566,31 +1575,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Acknowledgements = append(m.Acknowledgements, PacketState{})
-			if err := m.Acknowledgements[len(m.Acknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelPacketState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelPacketState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelPacketState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -600,31 +1657,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitments = append(m.Commitments, PacketState{})
-			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -634,29 +1689,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receipts = append(m.Receipts, PacketState{})
-			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendSequences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -683,14 +1736,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendSequences = append(m.SendSequences, PacketSequence{})
-			if err := m.SendSequences[len(m.SendSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commitments = append(m.Commitments, PacketState{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvSequences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -717,14 +1770,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecvSequences = append(m.RecvSequences, PacketSequence{})
-			if err := m.RecvSequences[len(m.RecvSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Receipts = append(m.Receipts, PacketState{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckSequences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -751,16 +1804,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AckSequences = append(m.AckSequences, PacketSequence{})
-			if err := m.AckSequences[len(m.AckSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Acknowledgements = append(m.Acknowledgements, PacketState{})
+			if err := m.Acknowledgements[len(m.Acknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextChannelSequence", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeouts", wireType)
 			}
-			m.NextChannelSequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -770,66 +1823,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextChannelSequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PacketSequence) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PacketTimeouts = append(m.PacketTimeouts, PacketTimeout{})
+			if err := m.PacketTimeouts[len(m.PacketTimeouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PacketSequence: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketSequence: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceSend", wireType)
 			}
-			var stringLen uint64
+			m.NextSequenceSend = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -839,29 +1857,16 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NextSequenceSend |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
 			}
-			var stringLen uint64
+			m.NextSequenceRecv = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -871,29 +1876,16 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceAck", wireType)
 			}
-			m.Sequence = 0
+			m.NextSequenceAck = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -903,7 +1895,7 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.NextSequenceAck |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
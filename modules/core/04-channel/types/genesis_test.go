@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 )
 
@@ -67,12 +68,20 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				2,
+				[]types.FrozenChannel{
+					types.NewFrozenChannel(testPort1, testChannel1),
+				},
+				[]types.PacketTimeout{
+					types.NewPacketTimeout(testPort1, testChannel1, 1, clienttypes.NewHeight(0, 100), 0),
+				},
+				types.DefaultParams(),
 			),
 			expPass: true,
 		},
 		{
 			name: "invalid channel",
 			genState: types.GenesisState{
+				Params: types.DefaultParams(),
 				Channels: []types.IdentifiedChannel{
 					types.NewIdentifiedChannel(
 						testPort1, "(testChannel1)", types.NewChannel(
@@ -86,6 +95,7 @@ func TestValidateGenesis(t *testing.T) {
 		{
 			name: "invalid ack",
 			genState: types.GenesisState{
+				Params: types.DefaultParams(),
 				Acknowledgements: []types.PacketState{
 					types.NewPacketState(testPort2, testChannel2, 1, nil),
 				},
@@ -95,6 +105,7 @@ func TestValidateGenesis(t *testing.T) {
 		{
 			name: "invalid commitment",
 			genState: types.GenesisState{
+				Params: types.DefaultParams(),
 				Commitments: []types.PacketState{
 					types.NewPacketState(testPort1, testChannel1, 1, nil),
 				},
@@ -104,6 +115,7 @@ func TestValidateGenesis(t *testing.T) {
 		{
 			name: "invalid send seq",
 			genState: types.GenesisState{
+				Params: types.DefaultParams(),
 				SendSequences: []types.PacketSequence{
 					types.NewPacketSequence(testPort1, testChannel1, 0),
 				},
@@ -113,6 +125,7 @@ func TestValidateGenesis(t *testing.T) {
 		{
 			name: "invalid recv seq",
 			genState: types.GenesisState{
+				Params: types.DefaultParams(),
 				RecvSequences: []types.PacketSequence{
 					types.NewPacketSequence(testPort1, "(testChannel1)", 1),
 				},
@@ -122,6 +135,7 @@ func TestValidateGenesis(t *testing.T) {
 		{
 			name: "invalid recv seq 2",
 			genState: types.GenesisState{
+				Params: types.DefaultParams(),
 				RecvSequences: []types.PacketSequence{
 					types.NewPacketSequence("(testPort1)", testChannel1, 1),
 				},
@@ -131,12 +145,56 @@ func TestValidateGenesis(t *testing.T) {
 		{
 			name: "invalid ack seq",
 			genState: types.GenesisState{
+				Params: types.DefaultParams(),
 				AckSequences: []types.PacketSequence{
 					types.NewPacketSequence(testPort1, "(testChannel1)", 1),
 				},
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid params",
+			genState: types.GenesisState{
+				Params: types.NewParams(0, types.DefaultMaxPacketDataBytes, types.DefaultMaxInFlightPackets),
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid frozen channel",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				FrozenChannels: []types.FrozenChannel{
+					types.NewFrozenChannel(testPort1, "(testChannel1)"),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid packet timeout",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Commitments: []types.PacketState{
+					types.NewPacketState(testPort1, testChannel1, 1, []byte("commit_hash")),
+				},
+				PacketTimeouts: []types.PacketTimeout{
+					types.NewPacketTimeout(testPort1, testChannel1, 1, clienttypes.ZeroHeight(), 0),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "packet timeout without packet commitment",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				Commitments: []types.PacketState{
+					types.NewPacketState(testPort1, testChannel1, 1, []byte("commit_hash")),
+				},
+				PacketTimeouts: []types.PacketTimeout{
+					types.NewPacketTimeout(testPort1, testChannel1, 2, clienttypes.NewHeight(0, 100), 0),
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid channel identifier",
 			genState: types.NewGenesisState(
@@ -171,6 +229,9 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				0,
+				nil,
+				nil,
+				types.DefaultParams(),
 			),
 			expPass: false,
 		},
@@ -208,6 +269,27 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				0,
+				nil,
+				nil,
+				types.DefaultParams(),
+			),
+			expPass: false,
+		},
+		{
+			name: "next channel sequence is equal to the sequence of the only channel",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedChannel{
+					types.NewIdentifiedChannel(
+						testPort1, testChannel1, types.NewChannel(
+							types.INIT, testChannelOrder, counterparty2, []string{testConnectionIDA}, testChannelVersion,
+						),
+					),
+				},
+				nil, nil, nil, nil, nil, nil,
+				0,
+				nil,
+				nil,
+				types.DefaultParams(),
 			),
 			expPass: false,
 		},
@@ -223,3 +305,93 @@ func TestValidateGenesis(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateChannelPacketState(t *testing.T) {
+	var packetState types.ChannelPacketState
+
+	testCases := []struct {
+		name     string
+		ordering types.Order
+		malleate func()
+		expPass  bool
+	}{
+		{"valid unordered packet state", types.UNORDERED, func() {}, true},
+		{
+			"valid ordered packet state", types.ORDERED, func() {
+				packetState.Receipts = nil
+			}, true,
+		},
+		{
+			"invalid channel identifier", types.UNORDERED, func() {
+				packetState.ChannelId = "(channelID)"
+			}, false,
+		},
+		{
+			"next sequence is zero", types.UNORDERED, func() {
+				packetState.NextSequenceAck = 0
+			}, false,
+		},
+		{
+			"commitment of another channel", types.UNORDERED, func() {
+				packetState.Commitments[0].ChannelId = testChannel2
+			}, false,
+		},
+		{
+			"commitment with empty data", types.UNORDERED, func() {
+				packetState.Commitments[0].Data = []byte{}
+			}, false,
+		},
+		{
+			"commitment has not been sent", types.UNORDERED, func() {
+				packetState.NextSequenceSend = 3
+			}, false,
+		},
+		{
+			"commitment has been acknowledged on an ordered channel", types.ORDERED, func() {
+				packetState.Receipts = nil
+				packetState.NextSequenceAck = 4
+			}, false,
+		},
+		{
+			"packet timeout without commitment", types.UNORDERED, func() {
+				packetState.PacketTimeouts[0].Sequence = 2
+			}, false,
+		},
+		{
+			"receipt on an ordered channel", types.ORDERED, func() {}, false,
+		},
+		{
+			"acknowledgement without receipt on an unordered channel", types.UNORDERED, func() {
+				packetState.Receipts = nil
+			}, false,
+		},
+		{
+			"acknowledgement has not been received on an ordered channel", types.ORDERED, func() {
+				packetState.Receipts = nil
+				packetState.NextSequenceRecv = 1
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		packetState = types.NewChannelPacketState(
+			testPort1, testChannel1,
+			[]types.PacketState{types.NewPacketState(testPort1, testChannel1, 3, []byte("commitment"))},
+			[]types.PacketState{types.NewPacketState(testPort1, testChannel1, 1, []byte{byte(1)})},
+			[]types.PacketState{types.NewPacketState(testPort1, testChannel1, 1, []byte("ack"))},
+			[]types.PacketTimeout{types.NewPacketTimeout(testPort1, testChannel1, 3, clienttypes.NewHeight(0, 100), 0)},
+			4, 2, 3,
+		)
+
+		tc.malleate()
+
+		err := packetState.Validate(tc.ordering)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
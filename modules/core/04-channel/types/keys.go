@@ -26,8 +26,24 @@ const (
 	// the keeper.
 	KeyNextChannelSequence = "nextChannelSequence"
 
+	// KeyFrozenChannelPrefix is the key prefix under which the channels frozen by the
+	// governance authority are stored in the keeper.
+	KeyFrozenChannelPrefix = "frozenChannels"
+
+	// KeyInFlightPacketsPrefix is the key prefix under which the number of packets in flight,
+	// i.e. the number of packet commitments, of a channel is stored in the keeper.
+	KeyInFlightPacketsPrefix = "inFlightPackets"
+
+	// KeyPacketTimeoutPrefix is the key prefix under which the timeouts of the packets with a
+	// stored commitment are stored in the keeper.
+	KeyPacketTimeoutPrefix = "packetTimeouts"
+
 	// ChannelPrefix is the prefix used when creating a channel identifier
 	ChannelPrefix = "channel-"
+
+	// MaxPacketSequenceGaps is the maximum number of sequences returned by the
+	// PacketSequenceGaps query
+	MaxPacketSequenceGaps = 1000
 )
 
 // FormatChannelIdentifier returns the channel identifier with the sequence appended.
@@ -65,3 +81,19 @@ func ParseChannelSequence(channelID string) (uint64, error) {
 func FilteredPortPrefix(portPrefix string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", host.KeyChannelEndPrefix, host.KeyPortPrefix, portPrefix))
 }
+
+// FrozenChannelKey returns the store key under which a channel is marked as frozen.
+func FrozenChannelKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%s", KeyFrozenChannelPrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID))
+}
+
+// InFlightPacketsKey returns the store key under which the number of packets in flight on a
+// channel is stored.
+func InFlightPacketsKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%s", KeyInFlightPacketsPrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID))
+}
+
+// PacketTimeoutKey returns the store key under which the timeouts of a sent packet are stored.
+func PacketTimeoutKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%s/%s/%d", KeyPacketTimeoutPrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID, host.KeySequencePrefix, sequence))
+}
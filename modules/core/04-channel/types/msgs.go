@@ -500,3 +500,234 @@ func (msg MsgAcknowledgement) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+var _ sdk.Msg = &MsgPruneAcknowledgements{}
+
+// NewMsgPruneAcknowledgements constructs a new MsgPruneAcknowledgements
+//
+//nolint:interfacer
+func NewMsgPruneAcknowledgements(authority, portID, channelID string, limit uint64) *MsgPruneAcknowledgements {
+	return &MsgPruneAcknowledgements{
+		PortId:    portID,
+		ChannelId: channelID,
+		Limit:     limit,
+		Authority: authority,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgPruneAcknowledgements) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if msg.Limit == 0 {
+		return sdkerrors.Wrap(ErrInvalidPruningLimit, "limit must be greater than 0")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPruneAcknowledgements) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgUpdateChannelConnection{}
+
+// NewMsgUpdateChannelConnection constructs a new MsgUpdateChannelConnection
+//
+//nolint:interfacer
+func NewMsgUpdateChannelConnection(authority, portID, channelID, connectionID string) *MsgUpdateChannelConnection {
+	return &MsgUpdateChannelConnection{
+		Authority:    authority,
+		PortId:       portID,
+		ChannelId:    channelID,
+		ConnectionId: connectionID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateChannelConnection) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateChannelConnection) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgFreezeChannel{}
+
+// NewMsgFreezeChannel constructs a new MsgFreezeChannel
+//
+//nolint:interfacer
+func NewMsgFreezeChannel(authority, portID, channelID string) *MsgFreezeChannel {
+	return &MsgFreezeChannel{
+		Authority: authority,
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgFreezeChannel) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgFreezeChannel) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgUnfreezeChannel{}
+
+// NewMsgUnfreezeChannel constructs a new MsgUnfreezeChannel
+//
+//nolint:interfacer
+func NewMsgUnfreezeChannel(authority, portID, channelID string) *MsgUnfreezeChannel {
+	return &MsgUnfreezeChannel{
+		Authority: authority,
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUnfreezeChannel) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUnfreezeChannel) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgUpdateChannelParams{}
+
+// NewMsgUpdateChannelParams constructs a new MsgUpdateChannelParams
+//
+//nolint:interfacer
+func NewMsgUpdateChannelParams(authority string, params Params) *MsgUpdateChannelParams {
+	return &MsgUpdateChannelParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateChannelParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateChannelParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgSetupLocalhostPath{}
+
+// NewMsgSetupLocalhostPath constructs a new MsgSetupLocalhostPath
+//
+//nolint:interfacer
+func NewMsgSetupLocalhostPath(portIDA, portIDB string, order Order, version, signer string) *MsgSetupLocalhostPath {
+	return &MsgSetupLocalhostPath{
+		PortIdA:  portIDA,
+		PortIdB:  portIDB,
+		Ordering: order,
+		Version:  version,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetupLocalhostPath) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortIdA); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID A")
+	}
+	if err := host.PortIdentifierValidator(msg.PortIdB); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID B")
+	}
+	if !(msg.Ordering == ORDERED || msg.Ordering == UNORDERED) {
+		return sdkerrors.Wrap(ErrInvalidChannelOrdering, msg.Ordering.String())
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSetupLocalhostPath) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
@@ -64,7 +64,7 @@ var (
 	emptyAddr string
 
 	connHops             = []string{"testconnection"}
-	invalidConnHops      = []string{"testconnection", "testconnection"}
+	multipleConnHops     = []string{"testconnection", "testconnection"}
 	invalidShortConnHops = []string{invalidShortConnection}
 	invalidLongConnHops  = []string{invalidLongConnection}
 )
@@ -121,7 +121,8 @@ func (suite *TypesTestSuite) TestMsgChannelOpenInitValidateBasic() {
 		{"too long port id", types.NewMsgChannelOpenInit(invalidLongPort, version, types.ORDERED, connHops, cpportid, addr), false},
 		{"port id contains non-alpha", types.NewMsgChannelOpenInit(invalidPort, version, types.ORDERED, connHops, cpportid, addr), false},
 		{"invalid channel order", types.NewMsgChannelOpenInit(portid, version, types.Order(3), connHops, cpportid, addr), false},
-		{"connection hops more than 1 ", types.NewMsgChannelOpenInit(portid, version, types.ORDERED, invalidConnHops, cpportid, addr), false},
+		{"multiple connection hops", types.NewMsgChannelOpenInit(portid, version, types.ORDERED, multipleConnHops, cpportid, addr), true},
+		{"empty connection hops", types.NewMsgChannelOpenInit(portid, version, types.ORDERED, []string{}, cpportid, addr), false},
 		{"too short connection id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, invalidShortConnHops, cpportid, addr), false},
 		{"too long connection id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, invalidLongConnHops, cpportid, addr), false},
 		{"connection id contains non-alpha", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, []string{invalidConnection}, cpportid, addr), false},
@@ -160,7 +161,8 @@ func (suite *TypesTestSuite) TestMsgChannelOpenTryValidateBasic() {
 		{"", types.NewMsgChannelOpenTry(portid, version, types.ORDERED, connHops, cpportid, cpchanid, "", suite.proof, height, addr), true},
 		{"proof height is zero", types.NewMsgChannelOpenTry(portid, version, types.ORDERED, connHops, cpportid, cpchanid, version, suite.proof, clienttypes.ZeroHeight(), addr), false},
 		{"invalid channel order", types.NewMsgChannelOpenTry(portid, version, types.Order(4), connHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
-		{"connection hops more than 1 ", types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, invalidConnHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
+		{"multiple connection hops", types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, multipleConnHops, cpportid, cpchanid, version, suite.proof, height, addr), true},
+		{"empty connection hops", types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, []string{}, cpportid, cpchanid, version, suite.proof, height, addr), false},
 		{"too short connection id", types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, invalidShortConnHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
 		{"too long connection id", types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, invalidLongConnHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
 		{"connection id contains non-alpha", types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, []string{invalidConnection}, cpportid, cpchanid, version, suite.proof, height, addr), false},
@@ -438,3 +440,173 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgUpdateChannelConnectionValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateChannelConnection
+		expPass bool
+	}{
+		{"success", types.NewMsgUpdateChannelConnection(addr, portid, chanid, connHops[0]), true},
+		{"missing authority address", types.NewMsgUpdateChannelConnection(emptyAddr, portid, chanid, connHops[0]), false},
+		{"too short port id", types.NewMsgUpdateChannelConnection(addr, invalidShortPort, chanid, connHops[0]), false},
+		{"port id contains non-alpha", types.NewMsgUpdateChannelConnection(addr, invalidPort, chanid, connHops[0]), false},
+		{"invalid channel ID", types.NewMsgUpdateChannelConnection(addr, portid, invalidChannel, connHops[0]), false},
+		{"invalid connection ID", types.NewMsgUpdateChannelConnection(addr, portid, chanid, invalidConnection), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgFreezeChannelValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgFreezeChannel
+		expPass bool
+	}{
+		{"success", types.NewMsgFreezeChannel(addr, portid, chanid), true},
+		{"missing authority address", types.NewMsgFreezeChannel(emptyAddr, portid, chanid), false},
+		{"too short port id", types.NewMsgFreezeChannel(addr, invalidShortPort, chanid), false},
+		{"port id contains non-alpha", types.NewMsgFreezeChannel(addr, invalidPort, chanid), false},
+		{"invalid channel ID", types.NewMsgFreezeChannel(addr, portid, invalidChannel), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgUnfreezeChannelValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUnfreezeChannel
+		expPass bool
+	}{
+		{"success", types.NewMsgUnfreezeChannel(addr, portid, chanid), true},
+		{"missing authority address", types.NewMsgUnfreezeChannel(emptyAddr, portid, chanid), false},
+		{"too short port id", types.NewMsgUnfreezeChannel(addr, invalidShortPort, chanid), false},
+		{"port id contains non-alpha", types.NewMsgUnfreezeChannel(addr, invalidPort, chanid), false},
+		{"invalid channel ID", types.NewMsgUnfreezeChannel(addr, portid, invalidChannel), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgPruneAcknowledgements
+		expPass bool
+	}{
+		{"success", types.NewMsgPruneAcknowledgements(addr, portid, chanid, 10), true},
+		{"too short port id", types.NewMsgPruneAcknowledgements(addr, invalidShortPort, chanid, 10), false},
+		{"port id contains non-alpha", types.NewMsgPruneAcknowledgements(addr, invalidPort, chanid, 10), false},
+		{"invalid channel ID", types.NewMsgPruneAcknowledgements(addr, portid, invalidChannel, 10), false},
+		{"limit is zero", types.NewMsgPruneAcknowledgements(addr, portid, chanid, 0), false},
+		{"missing authority address", types.NewMsgPruneAcknowledgements(emptyAddr, portid, chanid, 10), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgUpdateChannelParamsValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateChannelParams
+		expPass bool
+	}{
+		{"success", types.NewMsgUpdateChannelParams(addr, types.DefaultParams()), true},
+		{"multiple connection hops", types.NewMsgUpdateChannelParams(addr, types.NewParams(2, types.DefaultMaxPacketDataBytes, types.DefaultMaxInFlightPackets)), false},
+		{"missing authority address", types.NewMsgUpdateChannelParams(emptyAddr, types.DefaultParams()), false},
+		{"max connection hops is zero", types.NewMsgUpdateChannelParams(addr, types.NewParams(0, types.DefaultMaxPacketDataBytes, types.DefaultMaxInFlightPackets)), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgSetupLocalhostPathValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetupLocalhostPath
+		expPass bool
+	}{
+		{"success", types.NewMsgSetupLocalhostPath(portid, cpportid, types.UNORDERED, version, addr), true},
+		{"success: ordered channel with empty version", types.NewMsgSetupLocalhostPath(portid, cpportid, types.ORDERED, "", addr), true},
+		{"too short port id A", types.NewMsgSetupLocalhostPath(invalidShortPort, cpportid, types.UNORDERED, version, addr), false},
+		{"port id B contains non-alpha", types.NewMsgSetupLocalhostPath(portid, invalidPort, types.UNORDERED, version, addr), false},
+		{"invalid channel order", types.NewMsgSetupLocalhostPath(portid, cpportid, types.NONE, version, addr), false},
+		{"missing signer address", types.NewMsgSetupLocalhostPath(portid, cpportid, types.UNORDERED, version, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
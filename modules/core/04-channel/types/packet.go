@@ -133,3 +133,32 @@ func (p PacketId) Validate() error {
 func NewPacketID(portID, channelID string, seq uint64) PacketId {
 	return PacketId{PortId: portID, ChannelId: channelID, Sequence: seq}
 }
+
+// NewPacketTimeout creates a new PacketTimeout instance.
+func NewPacketTimeout(portID, channelID string, sequence uint64, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) PacketTimeout {
+	return PacketTimeout{
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// GetTimeoutHeight implements Timeouts interface
+func (pt PacketTimeout) GetTimeoutHeight() exported.Height { return pt.TimeoutHeight }
+
+// GetTimeoutTimestamp implements Timeouts interface
+func (pt PacketTimeout) GetTimeoutTimestamp() uint64 { return pt.TimeoutTimestamp }
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (pt PacketTimeout) Validate() error {
+	if err := validateGenFields(pt.PortId, pt.ChannelId, pt.Sequence); err != nil {
+		return err
+	}
+	if pt.TimeoutHeight.IsZero() && pt.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "packet timeout height and packet timeout timestamp cannot both be 0")
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// DefaultMaxConnectionHops is the default value for the maximum number of connection hops of a
+	// channel. Multi-hop channels are not supported, so only a single connection hop is allowed.
+	DefaultMaxConnectionHops = 1
+
+	// DefaultMaxPacketDataBytes is the default value for the maximum size in bytes of packet data (512 KiB)
+	DefaultMaxPacketDataBytes = 512 * 1024
+
+	// DefaultMaxInFlightPackets is the default value for the maximum number of packets in flight on a
+	// channel. The number of packets in flight is unlimited by default.
+	DefaultMaxInFlightPackets = 0
+)
+
+var (
+	// KeyMaxConnectionHops is store's key for MaxConnectionHops parameter
+	KeyMaxConnectionHops = []byte("MaxConnectionHops")
+	// KeyMaxPacketDataBytes is store's key for MaxPacketDataBytes parameter
+	KeyMaxPacketDataBytes = []byte("MaxPacketDataBytes")
+	// KeyMaxInFlightPackets is store's key for MaxInFlightPackets parameter
+	KeyMaxInFlightPackets = []byte("MaxInFlightPackets")
+)
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the ibc channel module
+func NewParams(maxConnectionHops, maxPacketDataBytes, maxInFlightPackets uint64) Params {
+	return Params{
+		MaxConnectionHops:  maxConnectionHops,
+		MaxPacketDataBytes: maxPacketDataBytes,
+		MaxInFlightPackets: maxInFlightPackets,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the ibc channel module
+func DefaultParams() Params {
+	return NewParams(DefaultMaxConnectionHops, DefaultMaxPacketDataBytes, DefaultMaxInFlightPackets)
+}
+
+// Validate ensures MaxConnectionHops and MaxPacketDataBytes are non-zero and MaxConnectionHops does not
+// exceed a single connection hop. MaxInFlightPackets may be zero to not limit the number of packets in flight.
+func (p Params) Validate() error {
+	if err := validateMaxConnectionHops(p.MaxConnectionHops); err != nil {
+		return err
+	}
+
+	if err := validateMaxPacketDataBytes(p.MaxPacketDataBytes); err != nil {
+		return err
+	}
+
+	return validateMaxInFlightPackets(p.MaxInFlightPackets)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxConnectionHops, &p.MaxConnectionHops, validateMaxConnectionHops),
+		paramtypes.NewParamSetPair(KeyMaxPacketDataBytes, &p.MaxPacketDataBytes, validateMaxPacketDataBytes),
+		paramtypes.NewParamSetPair(KeyMaxInFlightPackets, &p.MaxInFlightPackets, validateMaxInFlightPackets),
+	}
+}
+
+func validateMaxConnectionHops(i interface{}) error {
+	maxConnectionHops, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter. expected %T, got type: %T", uint64(1), i)
+	}
+
+	if maxConnectionHops == 0 {
+		return fmt.Errorf("MaxConnectionHops cannot be zero")
+	}
+
+	// multi-hop proofs cannot be verified, a channel with multiple connection hops could not be relayed
+	if maxConnectionHops > DefaultMaxConnectionHops {
+		return fmt.Errorf("MaxConnectionHops cannot be greater than %d, multi-hop channels are not supported", DefaultMaxConnectionHops)
+	}
+
+	return nil
+}
+
+func validateMaxPacketDataBytes(i interface{}) error {
+	maxPacketDataBytes, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter. expected %T, got type: %T", uint64(1), i)
+	}
+
+	if maxPacketDataBytes == 0 {
+		return fmt.Errorf("MaxPacketDataBytes cannot be zero")
+	}
+
+	return nil
+}
+
+func validateMaxInFlightPackets(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter. expected %T, got type: %T", uint64(1), i)
+	}
+
+	return nil
+}
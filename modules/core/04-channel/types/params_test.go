@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default params", types.DefaultParams(), true},
+		{"custom params", types.NewParams(types.DefaultMaxConnectionHops, 1024, types.DefaultMaxInFlightPackets), true},
+		{"multiple max connection hops", types.NewParams(2, types.DefaultMaxPacketDataBytes, types.DefaultMaxInFlightPackets), false},
+		{"zero max connection hops", types.NewParams(0, types.DefaultMaxPacketDataBytes, types.DefaultMaxInFlightPackets), false},
+		{"zero max packet data bytes", types.NewParams(types.DefaultMaxConnectionHops, 0, types.DefaultMaxInFlightPackets), false},
+		{"custom max in flight packets", types.NewParams(types.DefaultMaxConnectionHops, types.DefaultMaxPacketDataBytes, 100), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
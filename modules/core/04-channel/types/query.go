@@ -7,6 +7,20 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
+const (
+	// DefaultPacketCommitmentsMaxBytes is the size limit of the commitments returned by the
+	// PacketCommitmentsAfterSequence query if no limit is requested.
+	DefaultPacketCommitmentsMaxBytes = 1 << 20
+
+	// MaxPacketCommitmentsMaxBytes is the maximum size limit of the commitments returned by the
+	// PacketCommitmentsAfterSequence query. It is kept below the default maximum gRPC message size.
+	MaxPacketCommitmentsMaxBytes = 3 << 20
+
+	// MaxPacketSequenceLookups is the maximum number of sequences checked by a single
+	// PacketCommitmentsAfterSequence or PacketSequenceGaps query.
+	MaxPacketSequenceLookups = 10000
+)
+
 var (
 	_ codectypes.UnpackInterfacesMessage = QueryChannelClientStateResponse{}
 	_ codectypes.UnpackInterfacesMessage = QueryChannelConsensusStateResponse{}
@@ -93,3 +107,14 @@ func NewQueryNextSequenceReceiveResponse(
 		ProofHeight:         height,
 	}
 }
+
+// NewQueryNextSequenceSendResponse creates a new QueryNextSequenceSendResponse instance
+func NewQueryNextSequenceSendResponse(
+	sequence uint64, proof []byte, height clienttypes.Height,
+) *QueryNextSequenceSendResponse {
+	return &QueryNextSequenceSendResponse{
+		NextSequenceSend: sequence,
+		Proof:            proof,
+		ProofHeight:      height,
+	}
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+// Clock defines the source of the height and timestamp against which the timeouts of a packet are
+// checked. The timeouts of a received packet are checked against the clock of the running chain,
+// while the timeouts of a sent packet are checked against the height and timestamp of a consensus
+// state of the counterparty chain.
+type Clock interface {
+	// GetHeight returns the height of the clock.
+	GetHeight() exported.Height
+	// GetTimestamp returns the timestamp of the clock in nanoseconds since the unix epoch.
+	GetTimestamp() uint64
+}
+
+var (
+	_ Clock = clock{}
+
+	_ Timeouts = Packet{}
+	_ Timeouts = PacketTimeout{}
+)
+
+// Timeouts defines the timeouts of a packet. It is implemented by the packet itself and by the
+// PacketTimeout stored alongside the commitment of a sent packet.
+type Timeouts interface {
+	// GetTimeoutHeight returns the timeout height of the packet, zero if the packet has no timeout height.
+	GetTimeoutHeight() exported.Height
+	// GetTimeoutTimestamp returns the timeout timestamp of the packet, zero if the packet has no timeout timestamp.
+	GetTimeoutTimestamp() uint64
+}
+
+// clock is a Clock reporting a fixed height and timestamp.
+type clock struct {
+	height    exported.Height
+	timestamp uint64
+}
+
+// NewClock returns a Clock reporting the provided height and timestamp.
+func NewClock(height exported.Height, timestamp uint64) Clock {
+	return clock{
+		height:    height,
+		timestamp: timestamp,
+	}
+}
+
+// ContextClock returns the Clock of the running chain, reporting the height and the block time of
+// the provided context.
+func ContextClock(ctx sdk.Context) Clock {
+	return NewClock(clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano()))
+}
+
+// GetHeight implements Clock.
+func (c clock) GetHeight() exported.Height {
+	return c.height
+}
+
+// GetTimestamp implements Clock.
+func (c clock) GetTimestamp() uint64 {
+	return c.timestamp
+}
+
+// TimeoutHeightReached returns true if the timeout height of the packet is set and the height of the
+// clock is greater than or equal to it.
+func TimeoutHeightReached(packet Timeouts, clock Clock) bool {
+	timeoutHeight := packet.GetTimeoutHeight()
+	return !timeoutHeight.IsZero() && clock.GetHeight().GTE(timeoutHeight)
+}
+
+// TimeoutTimestampReached returns true if the timeout timestamp of the packet is set and the
+// timestamp of the clock is greater than or equal to it.
+func TimeoutTimestampReached(packet Timeouts, clock Clock) bool {
+	return packet.GetTimeoutTimestamp() != 0 && clock.GetTimestamp() >= packet.GetTimeoutTimestamp()
+}
+
+// TimeoutReached returns true if either timeout of the packet has been reached by the clock.
+func TimeoutReached(packet Timeouts, clock Clock) bool {
+	return TimeoutHeightReached(packet, clock) || TimeoutTimestampReached(packet, clock)
+}
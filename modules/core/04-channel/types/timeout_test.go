@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+func TestTimeoutReached(t *testing.T) {
+	packet := types.NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	heightOnlyPacket := types.NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, timeoutHeight, 0)
+	timestampOnlyPacket := types.NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, clienttypes.ZeroHeight(), timeoutTimestamp)
+
+	before := clienttypes.NewHeight(timeoutHeight.RevisionNumber, timeoutHeight.RevisionHeight-1)
+	after := timeoutHeight.Increment().(clienttypes.Height)
+	nextRevision := clienttypes.NewHeight(timeoutHeight.RevisionNumber+1, 1)
+
+	testCases := []struct {
+		name                string
+		packet              types.Packet
+		clock               types.Clock
+		expHeightReached    bool
+		expTimestampReached bool
+	}{
+		{"neither timeout reached", packet, types.NewClock(before, timeoutTimestamp-1), false, false},
+		{"exactly at timeout height", packet, types.NewClock(timeoutHeight, timeoutTimestamp-1), true, false},
+		{"past timeout height", packet, types.NewClock(after, timeoutTimestamp-1), true, false},
+		{"timeout height reached by later revision", packet, types.NewClock(nextRevision, timeoutTimestamp-1), true, false},
+		{"one nanosecond before timeout timestamp", packet, types.NewClock(before, timeoutTimestamp-1), false, false},
+		{"exactly at timeout timestamp", packet, types.NewClock(before, timeoutTimestamp), false, true},
+		{"one nanosecond after timeout timestamp", packet, types.NewClock(before, timeoutTimestamp+1), false, true},
+		{"both timeouts reached", packet, types.NewClock(timeoutHeight, timeoutTimestamp), true, true},
+		{"timeout timestamp disabled", heightOnlyPacket, types.NewClock(before, timeoutTimestamp+1), false, false},
+		{"timeout height disabled", timestampOnlyPacket, types.NewClock(after, timeoutTimestamp-1), false, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expHeightReached, types.TimeoutHeightReached(tc.packet, tc.clock), tc.name)
+		require.Equal(t, tc.expTimestampReached, types.TimeoutTimestampReached(tc.packet, tc.clock), tc.name)
+		require.Equal(t, tc.expHeightReached || tc.expTimestampReached, types.TimeoutReached(tc.packet, tc.clock), tc.name)
+	}
+}
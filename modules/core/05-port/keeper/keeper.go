@@ -1,9 +1,8 @@
 package keeper
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -38,25 +37,41 @@ func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 }
 
 // BindPort binds to a port and returns the associated capability.
-// Ports must be bound statically when the chain starts in `app.go`.
+// Ports are usually bound statically when the chain starts in `app.go`.
 // The capability must then be passed to a module which will need to pass
 // it as an extra parameter when calling functions on the IBC module.
+// BindPort panics if the port identifier is invalid or the port is already bound,
+// TryBindPort should be used to bind ports at runtime.
 func (k *Keeper) BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability {
-	if err := host.PortIdentifierValidator(portID); err != nil {
+	key, err := k.TryBindPort(ctx, portID)
+	if err != nil {
 		panic(err.Error())
 	}
 
+	return key
+}
+
+// TryBindPort binds to a port and returns the associated capability. It returns an error
+// instead of panicking if the port identifier is invalid or the port is already bound, so that
+// modules can bind ports at runtime, e.g. within a message handler. The calling module must
+// claim the returned capability with its scoped keeper under host.PortPath(portID) in order to
+// receive the callbacks of channels on the port.
+func (k *Keeper) TryBindPort(ctx sdk.Context, portID string) (*capabilitytypes.Capability, error) {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPort, err.Error())
+	}
+
 	if k.IsBound(ctx, portID) {
-		panic(fmt.Sprintf("port %s is already bound", portID))
+		return nil, sdkerrors.Wrapf(types.ErrPortExists, "port %s is already bound", portID)
 	}
 
 	key, err := k.scopedKeeper.NewCapability(ctx, host.PortPath(portID))
 	if err != nil {
-		panic(err.Error())
+		return nil, err
 	}
 
 	k.Logger(ctx).Info("port binded", "port", portID)
-	return key
+	return key, nil
 }
 
 // Authenticate authenticates a capability key against a port ID
@@ -9,6 +9,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/core/05-port/keeper"
+	"github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v6/testing/simapp"
 )
 
@@ -54,6 +55,25 @@ func (suite *KeeperTestSuite) TestBind() {
 	require.Panics(suite.T(), func() { suite.keeper.BindPort(suite.ctx, validPort) }, "did not panic on re-binding the same port")
 }
 
+func (suite *KeeperTestSuite) TestTryBindPort() {
+	// Test that invalid portID returns an error
+	capKey, err := suite.keeper.TryBindPort(suite.ctx, invalidPort)
+	require.ErrorIs(suite.T(), err, types.ErrInvalidPort)
+	require.Nil(suite.T(), capKey)
+
+	// Test that valid TryBindPort returns capability key
+	capKey, err = suite.keeper.TryBindPort(suite.ctx, validPort)
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), capKey, "capabilityKey is nil on valid TryBindPort")
+	require.True(suite.T(), suite.keeper.IsBound(suite.ctx, validPort), "port is bound successfully")
+	require.True(suite.T(), suite.keeper.Authenticate(suite.ctx, capKey, validPort))
+
+	// Test that rebinding the same portid returns an error
+	capKey, err = suite.keeper.TryBindPort(suite.ctx, validPort)
+	require.ErrorIs(suite.T(), err, types.ErrPortExists)
+	require.Nil(suite.T(), capKey)
+}
+
 func (suite *KeeperTestSuite) TestAuthenticate() {
 	capKey := suite.keeper.BindPort(suite.ctx, validPort)
 
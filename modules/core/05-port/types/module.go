@@ -132,6 +132,14 @@ type ICS4Wrapper interface {
 	) (string, bool)
 }
 
+// PacketDataUnmarshaler defines an optional interface which allows a middleware to
+// request the packet data to be unmarshaled by the base application.
+type PacketDataUnmarshaler interface {
+	// UnmarshalPacketData unmarshals the packet data sent or received over the provided
+	// channel into its concrete type.
+	UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (interface{}, error)
+}
+
 // Middleware must implement IBCModule to wrap communication from core IBC to underlying application
 // and ICS4Wrapper to wrap communication from underlying application to core IBC.
 type Middleware interface {
@@ -43,6 +43,7 @@ func GetQueryCmd() *cobra.Command {
 		ibcclient.GetQueryCmd(),
 		connection.GetQueryCmd(),
 		channel.GetQueryCmd(),
+		GetCmdParams(),
 	)
 
 	return ibcQueryCmd
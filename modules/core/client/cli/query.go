@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v6/modules/core/types"
+)
+
+// GetCmdParams returns the command handler for the aggregate ibc params query.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current parameters of all ibc submodules",
+		Long:    "Query the current parameters of the core ibc submodules and of the ibc applications wired into the core ibc keeper",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s params", version.AppName, host.ModuleName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewIBCQueryClient(clientCtx)
+
+			res, err := queryClient.IBCParams(cmd.Context(), &types.QueryIBCParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
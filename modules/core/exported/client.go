@@ -19,6 +19,12 @@ const (
 	// Tendermint is used to indicate that the client uses the Tendermint Consensus Algorithm.
 	Tendermint string = "07-tendermint"
 
+	// Localhost is the client type for the localhost client.
+	Localhost string = "09-localhost"
+
+	// LocalhostClientID is the sentinel client ID for the localhost client.
+	LocalhostClientID string = Localhost
+
 	// Active is a status type of a client. An active client is allowed to be used.
 	Active Status = "Active"
 
@@ -106,7 +112,8 @@ type ClientState interface {
 
 	// UpdateState updates and stores as necessary any associated information for an IBC client, such as the ClientState and corresponding ConsensusState.
 	// Upon successful update, a list of consensus heights is returned. It assumes the ClientMessage has already been verified.
-	UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg ClientMessage) []Height
+	// An error is returned if the ClientMessage cannot be applied to the client state.
+	UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg ClientMessage) ([]Height, error)
 
 	// CheckSubstituteAndUpdateState must verify that the provided substitute may be used to update the subject client.
 	// The light client must set the updated client and consensus states within the clientStore for the subject client.
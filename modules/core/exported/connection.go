@@ -1,5 +1,8 @@
 package exported
 
+// LocalhostConnectionID is the sentinel connection ID for the localhost connection.
+const LocalhostConnectionID string = "connection-localhost"
+
 // ConnectionI describes the required methods for a connection.
 type ConnectionI interface {
 	GetClientID() string
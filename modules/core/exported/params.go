@@ -0,0 +1,12 @@
+package exported
+
+import (
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// AppParams defines the params of an IBC application returned by the IBCParams query of core IBC.
+// Applications must register their params as an implementation of AppParams with the interface
+// registry.
+type AppParams interface {
+	proto.Message
+}
@@ -1,11 +1,14 @@
 package ibc
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	client "github.com/cosmos/ibc-go/v6/modules/core/02-client"
 	connection "github.com/cosmos/ibc-go/v6/modules/core/03-connection"
 	channel "github.com/cosmos/ibc-go/v6/modules/core/04-channel"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 	"github.com/cosmos/ibc-go/v6/modules/core/keeper"
 	"github.com/cosmos/ibc-go/v6/modules/core/types"
 )
@@ -13,8 +16,18 @@ import (
 // InitGenesis initializes the ibc state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
+	if err := gs.ValidateReferences(); err != nil {
+		panic(fmt.Sprintf("failed to initialize ibc genesis: %s", err.Error()))
+	}
+
 	client.InitGenesis(ctx, k.ClientKeeper, gs.ClientGenesis)
 	connection.InitGenesis(ctx, k.ConnectionKeeper, gs.ConnectionGenesis)
+
+	// the sentinel localhost connection is created if the 09-localhost client has been initialized
+	if _, found := k.ClientKeeper.GetClientState(ctx, exported.LocalhostClientID); found {
+		k.ConnectionKeeper.CreateSentinelLocalhostConnection(ctx)
+	}
+
 	channel.InitGenesis(ctx, k.ChannelKeeper, gs.ChannelGenesis)
 }
 
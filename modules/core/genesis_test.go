@@ -111,7 +111,7 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 					[]connectiontypes.ConnectionPaths{
 						connectiontypes.NewConnectionPaths(clientID, []string{connectionID}),
 					},
-					0,
+					1,
 					connectiontypes.NewParams(10),
 				),
 				ChannelGenesis: channeltypes.NewGenesisState(
@@ -141,7 +141,14 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 					[]channeltypes.PacketSequence{
 						channeltypes.NewPacketSequence(port2, channel2, 1),
 					},
-					0,
+					1,
+					[]channeltypes.FrozenChannel{
+						channeltypes.NewFrozenChannel(port1, channel1),
+					},
+					[]channeltypes.PacketTimeout{
+						channeltypes.NewPacketTimeout(port1, channel1, 1, clienttypes.NewHeight(0, 100), 0),
+					},
+					channeltypes.DefaultParams(),
 				),
 			},
 			expPass: true,
@@ -196,6 +203,7 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 				ClientGenesis:     clienttypes.DefaultGenesisState(),
 				ConnectionGenesis: connectiontypes.DefaultGenesisState(),
 				ChannelGenesis: channeltypes.GenesisState{
+					Params: channeltypes.DefaultParams(),
 					Acknowledgements: []channeltypes.PacketState{
 						channeltypes.NewPacketState("(portID)", channel1, 1, []byte("ack")),
 					},
@@ -203,6 +211,90 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "connection references nonexistent client",
+			genState: &types.GenesisState{
+				ClientGenesis: clienttypes.DefaultGenesisState(),
+				ConnectionGenesis: connectiontypes.NewGenesisState(
+					[]connectiontypes.IdentifiedConnection{
+						connectiontypes.NewIdentifiedConnection(connectionID, connectiontypes.NewConnectionEnd(connectiontypes.INIT, clientID, connectiontypes.NewCounterparty(clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))), []*connectiontypes.Version{ibctesting.ConnectionVersion}, 0)),
+					},
+					nil,
+					1,
+					connectiontypes.NewParams(10),
+				),
+				ChannelGenesis: channeltypes.DefaultGenesisState(),
+			},
+			expPass: false,
+		},
+		{
+			name: "channel references nonexistent connection",
+			genState: &types.GenesisState{
+				ClientGenesis:     clienttypes.DefaultGenesisState(),
+				ConnectionGenesis: connectiontypes.DefaultGenesisState(),
+				ChannelGenesis: channeltypes.GenesisState{
+					Params: channeltypes.DefaultParams(),
+					Channels: []channeltypes.IdentifiedChannel{
+						channeltypes.NewIdentifiedChannel(
+							port1, channel1, channeltypes.NewChannel(
+								channeltypes.INIT, channeltypes.ORDERED,
+								channeltypes.NewCounterparty(port2, channel2), []string{connectionID}, ibctesting.DefaultChannelVersion,
+							),
+						),
+					},
+					NextChannelSequence: 1,
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "channel references sentinel localhost connection",
+			genState: &types.GenesisState{
+				ClientGenesis:     clienttypes.DefaultGenesisState(),
+				ConnectionGenesis: connectiontypes.DefaultGenesisState(),
+				ChannelGenesis: channeltypes.GenesisState{
+					Params: channeltypes.DefaultParams(),
+					Channels: []channeltypes.IdentifiedChannel{
+						channeltypes.NewIdentifiedChannel(
+							port1, channel1, channeltypes.NewChannel(
+								channeltypes.INIT, channeltypes.ORDERED,
+								channeltypes.NewCounterparty(port2, channel2), []string{exported.LocalhostConnectionID}, ibctesting.DefaultChannelVersion,
+							),
+						),
+					},
+					NextChannelSequence: 1,
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "packet commitment references nonexistent channel",
+			genState: &types.GenesisState{
+				ClientGenesis:     clienttypes.DefaultGenesisState(),
+				ConnectionGenesis: connectiontypes.DefaultGenesisState(),
+				ChannelGenesis: channeltypes.GenesisState{
+					Params: channeltypes.DefaultParams(),
+					Commitments: []channeltypes.PacketState{
+						channeltypes.NewPacketState(port1, channel1, 1, []byte("commit_hash")),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "frozen channel references nonexistent channel",
+			genState: &types.GenesisState{
+				ClientGenesis:     clienttypes.DefaultGenesisState(),
+				ConnectionGenesis: connectiontypes.DefaultGenesisState(),
+				ChannelGenesis: channeltypes.GenesisState{
+					Params: channeltypes.DefaultParams(),
+					FrozenChannels: []channeltypes.FrozenChannel{
+						channeltypes.NewFrozenChannel(port1, channel1),
+					},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -300,6 +392,13 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 						channeltypes.NewPacketSequence(port2, channel2, 1),
 					},
 					0,
+					[]channeltypes.FrozenChannel{
+						channeltypes.NewFrozenChannel(port1, channel1),
+					},
+					[]channeltypes.PacketTimeout{
+						channeltypes.NewPacketTimeout(port1, channel1, 1, clienttypes.NewHeight(0, 100), 0),
+					},
+					channeltypes.DefaultParams(),
 				),
 			},
 		},
@@ -314,6 +413,28 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 	}
 }
 
+// TestInitGenesisInvalidReferences tests that InitGenesis reports every broken reference
+// of the genesis state before any state is initialized.
+func (suite *IBCTestSuite) TestInitGenesisInvalidReferences() {
+	genState := types.DefaultGenesisState()
+	genState.ConnectionGenesis.Connections = []connectiontypes.IdentifiedConnection{
+		connectiontypes.NewIdentifiedConnection(connectionID, connectiontypes.NewConnectionEnd(connectiontypes.INIT, clientID, connectiontypes.NewCounterparty(clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))), []*connectiontypes.Version{ibctesting.ConnectionVersion}, 0)),
+	}
+	genState.ChannelGenesis.Commitments = []channeltypes.PacketState{
+		channeltypes.NewPacketState(port1, channel1, 1, []byte("commit_hash")),
+	}
+
+	err := genState.ValidateReferences()
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), fmt.Sprintf("connection %s references nonexistent client %s", connectionID, clientID))
+	suite.Require().Contains(err.Error(), fmt.Sprintf("packet commitment with sequence 1 references nonexistent channel %s on port %s", channel1, port1))
+
+	app := simapp.Setup(false)
+	suite.Require().Panics(func() {
+		ibc.InitGenesis(app.BaseApp.NewContext(false, tmproto.Header{Height: 1}), *app.IBCKeeper, genState)
+	})
+}
+
 func (suite *IBCTestSuite) TestExportGenesis() {
 	testCases := []struct {
 		msg      string
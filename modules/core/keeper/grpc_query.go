@@ -3,9 +3,15 @@ package keeper
 import (
 	"context"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/types"
 )
 
 // ClientState implements the IBC QueryServer interface
@@ -38,6 +44,21 @@ func (q Keeper) ClientStatus(c context.Context, req *clienttypes.QueryClientStat
 	return q.ClientKeeper.ClientStatus(c, req)
 }
 
+// ClientStatusDetailed implements the IBC QueryServer interface
+func (q Keeper) ClientStatusDetailed(c context.Context, req *clienttypes.QueryClientStatusDetailedRequest) (*clienttypes.QueryClientStatusDetailedResponse, error) {
+	return q.ClientKeeper.ClientStatusDetailed(c, req)
+}
+
+// ConsensusStateProcessedTime implements the IBC QueryServer interface
+func (q Keeper) ConsensusStateProcessedTime(c context.Context, req *clienttypes.QueryConsensusStateProcessedTimeRequest) (*clienttypes.QueryConsensusStateProcessedTimeResponse, error) {
+	return q.ClientKeeper.ConsensusStateProcessedTime(c, req)
+}
+
+// ConsensusStateProcessedHeight implements the IBC QueryServer interface
+func (q Keeper) ConsensusStateProcessedHeight(c context.Context, req *clienttypes.QueryConsensusStateProcessedHeightRequest) (*clienttypes.QueryConsensusStateProcessedHeightResponse, error) {
+	return q.ClientKeeper.ConsensusStateProcessedHeight(c, req)
+}
+
 // Params implements the IBC QueryServer interface
 func (q Keeper) Params(c context.Context, req *clienttypes.QueryParamsRequest) (*clienttypes.QueryParamsResponse, error) {
 	return q.ClientKeeper.Params(c, req)
@@ -88,6 +109,11 @@ func (q Keeper) ConnectionChannels(c context.Context, req *channeltypes.QueryCon
 	return q.ChannelKeeper.ConnectionChannels(c, req)
 }
 
+// ClientChannels implements the IBC QueryServer interface
+func (q Keeper) ClientChannels(c context.Context, req *channeltypes.QueryClientChannelsRequest) (*channeltypes.QueryClientChannelsResponse, error) {
+	return q.ChannelKeeper.ClientChannels(c, req)
+}
+
 // ChannelClientState implements the IBC QueryServer interface
 func (q Keeper) ChannelClientState(c context.Context, req *channeltypes.QueryChannelClientStateRequest) (*channeltypes.QueryChannelClientStateResponse, error) {
 	return q.ChannelKeeper.ChannelClientState(c, req)
@@ -108,6 +134,11 @@ func (q Keeper) PacketCommitments(c context.Context, req *channeltypes.QueryPack
 	return q.ChannelKeeper.PacketCommitments(c, req)
 }
 
+// PacketCommitmentsAfterSequence implements the IBC QueryServer interface
+func (q Keeper) PacketCommitmentsAfterSequence(c context.Context, req *channeltypes.QueryPacketCommitmentsAfterSequenceRequest) (*channeltypes.QueryPacketCommitmentsAfterSequenceResponse, error) {
+	return q.ChannelKeeper.PacketCommitmentsAfterSequence(c, req)
+}
+
 // PacketReceipt implements the IBC QueryServer interface
 func (q Keeper) PacketReceipt(c context.Context, req *channeltypes.QueryPacketReceiptRequest) (*channeltypes.QueryPacketReceiptResponse, error) {
 	return q.ChannelKeeper.PacketReceipt(c, req)
@@ -137,3 +168,40 @@ func (q Keeper) UnreceivedAcks(c context.Context, req *channeltypes.QueryUnrecei
 func (q Keeper) NextSequenceReceive(c context.Context, req *channeltypes.QueryNextSequenceReceiveRequest) (*channeltypes.QueryNextSequenceReceiveResponse, error) {
 	return q.ChannelKeeper.NextSequenceReceive(c, req)
 }
+
+// NextSequenceSend implements the IBC QueryServer interface
+func (q Keeper) NextSequenceSend(c context.Context, req *channeltypes.QueryNextSequenceSendRequest) (*channeltypes.QueryNextSequenceSendResponse, error) {
+	return q.ChannelKeeper.NextSequenceSend(c, req)
+}
+
+// PacketSequenceGaps implements the IBC QueryServer interface
+func (q Keeper) PacketSequenceGaps(c context.Context, req *channeltypes.QueryPacketSequenceGapsRequest) (*channeltypes.QueryPacketSequenceGapsResponse, error) {
+	return q.ChannelKeeper.PacketSequenceGaps(c, req)
+}
+
+// PacketTimeoutStatus implements the IBC QueryServer interface
+func (q Keeper) PacketTimeoutStatus(c context.Context, req *channeltypes.QueryPacketTimeoutStatusRequest) (*channeltypes.QueryPacketTimeoutStatusResponse, error) {
+	return q.ChannelKeeper.PacketTimeoutStatus(c, req)
+}
+
+// IBCParams implements the IBC QueryServer interface
+func (q Keeper) IBCParams(c context.Context, _ *types.QueryIBCParamsRequest) (*types.QueryIBCParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryIBCParamsResponse{
+		ClientParams:     q.ClientKeeper.GetParams(ctx),
+		ConnectionParams: q.ConnectionKeeper.GetParams(ctx),
+		ChannelParams:    q.ChannelKeeper.GetParams(ctx),
+	}
+
+	for _, appParamsKeeper := range q.appParamsKeepers {
+		appParams, err := codectypes.NewAnyWithValue(appParamsKeeper.GetAppParams(ctx))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		res.AppParams = append(res.AppParams, appParams)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	"github.com/cosmos/ibc-go/v6/modules/core/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryIBCParams() {
+	var (
+		transferParams   exported.AppParams
+		controllerParams exported.AppParams
+		hostParams       exported.AppParams
+		expAppParams     func() []exported.AppParams
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: default params",
+			func() {},
+		},
+		{
+			"success: updated params",
+			func() {
+				params := transfertypes.NewParams(false, true, transfertypes.DefaultMaxMemoLength, []string{sdk.DefaultBondDenom})
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+				transferParams = &params
+
+				updatedHostParams := icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(&transfertypes.MsgTransfer{})})
+				suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), updatedHostParams)
+				hostParams = &updatedHostParams
+			},
+		},
+		{
+			"success: params returned in the order the application keepers are set",
+			func() {
+				suite.chainA.App.GetIBCKeeper().SetAppParamsKeepers(suite.chainA.GetSimApp().ICAHostKeeper, suite.chainA.GetSimApp().TransferKeeper)
+				expAppParams = func() []exported.AppParams { return []exported.AppParams{hostParams, transferParams} }
+			},
+		},
+		{
+			"success: application keepers not set",
+			func() {
+				suite.chainA.App.GetIBCKeeper().SetAppParamsKeepers()
+				expAppParams = func() []exported.AppParams { return nil }
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			defaultTransferParams := transfertypes.DefaultParams()
+			defaultControllerParams := icacontrollertypes.DefaultParams()
+			defaultHostParams := icahosttypes.DefaultParams()
+			transferParams, controllerParams, hostParams = &defaultTransferParams, &defaultControllerParams, &defaultHostParams
+			expAppParams = func() []exported.AppParams { return []exported.AppParams{transferParams, controllerParams, hostParams} }
+
+			tc.malleate()
+
+			expResponse := &types.QueryIBCParamsResponse{
+				ClientParams:     suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext()),
+				ConnectionParams: suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetParams(suite.chainA.GetContext()),
+				ChannelParams:    suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext()),
+			}
+			for _, appParams := range expAppParams() {
+				anyAppParams, err := codectypes.NewAnyWithValue(appParams)
+				suite.Require().NoError(err)
+				expResponse.AppParams = append(expResponse.AppParams, anyAppParams)
+			}
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.App.GetIBCKeeper().IBCParams(ctx, &types.QueryIBCParamsRequest{})
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(expResponse, res)
+
+			// the application params are resolved by the interface registry of the app
+			suite.Require().NoError(res.UnpackInterfaces(suite.chainA.GetSimApp().InterfaceRegistry()))
+			for i, appParams := range res.AppParams {
+				suite.Require().Equal(expAppParams()[i], appParams.GetCachedValue())
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -14,6 +15,7 @@ import (
 	connectionkeeper "github.com/cosmos/ibc-go/v6/modules/core/03-connection/keeper"
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channelkeeper "github.com/cosmos/ibc-go/v6/modules/core/04-channel/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	portkeeper "github.com/cosmos/ibc-go/v6/modules/core/05-port/keeper"
 	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/types"
@@ -33,19 +35,51 @@ type Keeper struct {
 	ChannelKeeper    channelkeeper.Keeper
 	PortKeeper       portkeeper.Keeper
 	Router           *porttypes.Router
+
+	// optional IBC application keepers whose params are returned by the IBCParams query
+	appParamsKeepers []types.AppParamsKeeper
+
+	// the address capable of executing governance gated messages, usually the gov module account
+	authority string
+}
+
+// Option configures the submodule keepers constructed by the IBC Keeper
+type Option func(*keeperOptions)
+
+// keeperOptions contains the options of the submodule keepers constructed by the IBC Keeper
+type keeperOptions struct {
+	clientOpts     []clientkeeper.Option
+	connectionOpts []connectionkeeper.Option
 }
 
-// NewKeeper creates a new ibc Keeper
+// WithClientKeeperOptions sets the options of the client keeper, e.g. the proof specs of the
+// commitment store of the running chain.
+func WithClientKeeperOptions(opts ...clientkeeper.Option) Option {
+	return func(o *keeperOptions) {
+		o.clientOpts = append(o.clientOpts, opts...)
+	}
+}
+
+// WithConnectionKeeperOptions sets the options of the connection keeper, e.g. the hook used by the
+// connection handshake to negotiate connection versions.
+func WithConnectionKeeperOptions(opts ...connectionkeeper.Option) Option {
+	return func(o *keeperOptions) {
+		o.connectionOpts = append(o.connectionOpts, opts...)
+	}
+}
+
+// NewKeeper creates a new ibc Keeper. The options configure the client and connection keepers.
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	stakingKeeper clienttypes.StakingKeeper, upgradeKeeper clienttypes.UpgradeKeeper,
-	scopedKeeper capabilitykeeper.ScopedKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper, authority string, opts ...Option,
 ) *Keeper {
 	// register paramSpace at top level keeper
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		keyTable := clienttypes.ParamKeyTable()
 		keyTable.RegisterParamSet(&connectiontypes.Params{})
+		keyTable.RegisterParamSet(&channeltypes.Params{})
 		paramSpace = paramSpace.WithKeyTable(keyTable)
 	}
 
@@ -61,10 +95,19 @@ func NewKeeper(
 		panic(fmt.Errorf("cannot initialize IBC keeper: empty scoped keeper"))
 	}
 
-	clientKeeper := clientkeeper.NewKeeper(cdc, key, paramSpace, stakingKeeper, upgradeKeeper)
-	connectionKeeper := connectionkeeper.NewKeeper(cdc, key, paramSpace, clientKeeper)
+	if strings.TrimSpace(authority) == "" {
+		panic(fmt.Errorf("cannot initialize IBC keeper: authority must be non-empty"))
+	}
+
+	var options keeperOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	clientKeeper := clientkeeper.NewKeeper(cdc, key, paramSpace, stakingKeeper, upgradeKeeper, options.clientOpts...)
+	connectionKeeper := connectionkeeper.NewKeeper(cdc, key, paramSpace, clientKeeper, options.connectionOpts...)
 	portKeeper := portkeeper.NewKeeper(scopedKeeper)
-	channelKeeper := channelkeeper.NewKeeper(cdc, key, clientKeeper, connectionKeeper, portKeeper, scopedKeeper)
+	channelKeeper := channelkeeper.NewKeeper(cdc, key, paramSpace, clientKeeper, connectionKeeper, portKeeper, scopedKeeper)
 
 	return &Keeper{
 		cdc:              cdc,
@@ -72,6 +115,7 @@ func NewKeeper(
 		ConnectionKeeper: connectionKeeper,
 		ChannelKeeper:    channelKeeper,
 		PortKeeper:       portKeeper,
+		authority:        authority,
 	}
 }
 
@@ -80,6 +124,11 @@ func (k Keeper) Codec() codec.BinaryCodec {
 	return k.cdc
 }
 
+// GetAuthority returns the address capable of executing governance gated messages.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetRouter sets the Router in IBC Keeper and seals it. The method panics if
 // there is an existing router that's already sealed.
 func (k *Keeper) SetRouter(rtr *porttypes.Router) {
@@ -92,6 +141,13 @@ func (k *Keeper) SetRouter(rtr *porttypes.Router) {
 	k.Router.Seal()
 }
 
+// SetAppParamsKeepers sets the IBC application keepers whose params are returned by the
+// IBCParams query, in the provided order. Applications which are not set are omitted from
+// the query response.
+func (k *Keeper) SetAppParamsKeepers(appParamsKeepers ...types.AppParamsKeeper) {
+	k.appParamsKeepers = appParamsKeepers
+}
+
 // isEmpty checks if the interface is an empty struct or a pointer pointing
 // to an empty struct
 func isEmpty(keeper interface{}) bool {
@@ -12,6 +12,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectionkeeper "github.com/cosmos/ibc-go/v6/modules/core/03-connection/keeper"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	ibchost "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	ibckeeper "github.com/cosmos/ibc-go/v6/modules/core/keeper"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
@@ -62,6 +64,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 		stakingKeeper clienttypes.StakingKeeper
 		upgradeKeeper clienttypes.UpgradeKeeper
 		scopedKeeper  capabilitykeeper.ScopedKeeper
+		authority     string
 		newIBCKeeper  = func() {
 			ibckeeper.NewKeeper(
 				suite.chainA.GetSimApp().AppCodec(),
@@ -70,6 +73,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 				stakingKeeper,
 				upgradeKeeper,
 				scopedKeeper,
+				authority,
 			)
 		}
 	)
@@ -110,6 +114,9 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 
 			scopedKeeper = emptyScopedKeeper
 		}, false},
+		{"failure: empty authority", func() {
+			authority = ""
+		}, false},
 		{"success: replace stakingKeeper with non-empty MockStakingKeeper", func() {
 			// use a different implementation of clienttypes.StakingKeeper
 			mockStakingKeeper := MockStakingKeeper{"not empty"}
@@ -126,6 +133,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 			stakingKeeper = suite.chainA.GetSimApp().StakingKeeper
 			upgradeKeeper = suite.chainA.GetSimApp().UpgradeKeeper
 			scopedKeeper = suite.chainA.GetSimApp().ScopedIBCKeeper
+			authority = suite.chainA.GetSimApp().IBCKeeper.GetAuthority()
 
 			tc.malleate()
 
@@ -141,3 +149,24 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 		})
 	}
 }
+
+// MockVersionNegotiator implements connectiontypes.VersionNegotiator used in TestNewKeeperOptions
+type MockVersionNegotiator struct {
+	connectiontypes.DefaultVersionNegotiator
+}
+
+// TestNewKeeperOptions tests that the options passed to ibckeeper.NewKeeper are applied to the
+// client and connection keepers.
+func (suite *KeeperTestSuite) TestNewKeeperOptions() {
+	app := suite.chainA.GetSimApp()
+
+	ibcKeeper := ibckeeper.NewKeeper(
+		app.AppCodec(), app.GetKey(ibchost.StoreKey), app.GetSubspace(ibchost.ModuleName),
+		app.StakingKeeper, app.UpgradeKeeper, app.ScopedIBCKeeper, app.IBCKeeper.GetAuthority(),
+		ibckeeper.WithConnectionKeeperOptions(connectionkeeper.WithVersionNegotiator(MockVersionNegotiator{})),
+	)
+	suite.Require().Equal(MockVersionNegotiator{}, ibcKeeper.ConnectionKeeper.GetVersionNegotiator())
+
+	// the default version negotiator is used if none is provided
+	suite.Require().Equal(connectiontypes.DefaultVersionNegotiator{}, app.IBCKeeper.ConnectionKeeper.GetVersionNegotiator())
+}
@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clientkeeper "github.com/cosmos/ibc-go/v6/modules/core/02-client/keeper"
+	connectionkeeper "github.com/cosmos/ibc-go/v6/modules/core/03-connection/keeper"
+	channelkeeper "github.com/cosmos/ibc-go/v6/modules/core/04-channel/keeper"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -30,3 +32,17 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration initializes the connection parameters if they have not been set.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	connectionMigrator := connectionkeeper.NewMigrator(m.keeper.ConnectionKeeper)
+	return connectionMigrator.MigrateParams(ctx)
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// This migration sets the number of packets in flight of every channel from its stored packet commitments.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	channelMigrator := channelkeeper.NewMigrator(m.keeper.ChannelKeeper)
+	return channelMigrator.MigrateInFlightPackets(ctx)
+}
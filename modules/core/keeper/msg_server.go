@@ -7,12 +7,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 	coretypes "github.com/cosmos/ibc-go/v6/modules/core/types"
+	localhost "github.com/cosmos/ibc-go/v6/modules/light-clients/09-localhost"
 )
 
 var (
@@ -97,6 +100,35 @@ func (k Keeper) SubmitMisbehaviour(goCtx context.Context, msg *clienttypes.MsgSu
 	return &clienttypes.MsgSubmitMisbehaviourResponse{}, nil
 }
 
+// PruneExpiredConsensusStates defines a rpc handler method for MsgPruneExpiredConsensusStates.
+func (k Keeper) PruneExpiredConsensusStates(goCtx context.Context, msg *clienttypes.MsgPruneExpiredConsensusStates) (*clienttypes.MsgPruneExpiredConsensusStatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	totalPruned, err := k.ClientKeeper.PruneExpiredConsensusStates(ctx, msg.ClientId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "pruning expired consensus states failed")
+	}
+
+	return &clienttypes.MsgPruneExpiredConsensusStatesResponse{TotalPruned: uint64(totalPruned)}, nil
+}
+
+// UpdateConnectionParams defines a rpc handler method for MsgUpdateConnectionParams.
+func (k Keeper) UpdateConnectionParams(goCtx context.Context, msg *connectiontypes.MsgUpdateConnectionParams) (*connectiontypes.MsgUpdateConnectionParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	k.ConnectionKeeper.SetParams(ctx, msg.Params)
+
+	return &connectiontypes.MsgUpdateConnectionParamsResponse{}, nil
+}
+
 // ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
 func (k Keeper) ConnectionOpenInit(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenInit) (*connectiontypes.MsgConnectionOpenInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -181,7 +213,7 @@ func (k Keeper) ChannelOpenInit(goCtx context.Context, msg *channeltypes.MsgChan
 	// Perform 04-channel verification
 	channelID, cap, err := k.ChannelKeeper.ChanOpenInit(
 		ctx, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.PortId,
-		portCap, msg.Channel.Counterparty, msg.Channel.Version,
+		portCap, msg.Channel.Counterparty, msg.Channel.Version, msg.Channel.DelayPeriod,
 	)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "channel handshake open init failed")
@@ -194,7 +226,7 @@ func (k Keeper) ChannelOpenInit(goCtx context.Context, msg *channeltypes.MsgChan
 	}
 
 	// Write channel into state
-	k.ChannelKeeper.WriteOpenInitChannel(ctx, msg.PortId, channelID, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.Channel.Counterparty, version)
+	k.ChannelKeeper.WriteOpenInitChannel(ctx, msg.PortId, channelID, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.Channel.Counterparty, version, msg.Channel.DelayPeriod)
 
 	return &channeltypes.MsgChannelOpenInitResponse{
 		ChannelId: channelID,
@@ -222,7 +254,7 @@ func (k Keeper) ChannelOpenTry(goCtx context.Context, msg *channeltypes.MsgChann
 
 	// Perform 04-channel verification
 	channelID, cap, err := k.ChannelKeeper.ChanOpenTry(ctx, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.PortId,
-		portCap, msg.Channel.Counterparty, msg.CounterpartyVersion, msg.ProofInit, msg.ProofHeight,
+		portCap, msg.Channel.Counterparty, msg.CounterpartyVersion, msg.Channel.DelayPeriod, msg.ProofInit, msg.ProofHeight,
 	)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "channel handshake open try failed")
@@ -235,10 +267,11 @@ func (k Keeper) ChannelOpenTry(goCtx context.Context, msg *channeltypes.MsgChann
 	}
 
 	// Write channel into state
-	k.ChannelKeeper.WriteOpenTryChannel(ctx, msg.PortId, channelID, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.Channel.Counterparty, version)
+	k.ChannelKeeper.WriteOpenTryChannel(ctx, msg.PortId, channelID, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.Channel.Counterparty, version, msg.Channel.DelayPeriod)
 
 	return &channeltypes.MsgChannelOpenTryResponse{
-		Version: version,
+		Version:   version,
+		ChannelId: channelID,
 	}, nil
 }
 
@@ -638,3 +671,127 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 
 	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
 }
+
+// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements. A closed channel
+// does not imply the counterparty has processed every acknowledgement, so pruning is gated behind
+// the governance authority.
+func (k Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.MsgPruneAcknowledgements) (*channeltypes.MsgPruneAcknowledgementsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	totalPruned, hasRemaining, err := k.ChannelKeeper.PruneAcknowledgements(ctx, msg.PortId, msg.ChannelId, msg.Limit)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "prune acknowledgements failed")
+	}
+
+	return &channeltypes.MsgPruneAcknowledgementsResponse{
+		TotalPruned:  totalPruned,
+		HasRemaining: hasRemaining,
+	}, nil
+}
+
+// UpdateChannelConnection defines a rpc handler method for MsgUpdateChannelConnection.
+func (k Keeper) UpdateChannelConnection(goCtx context.Context, msg *channeltypes.MsgUpdateChannelConnection) (*channeltypes.MsgUpdateChannelConnectionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.ChannelKeeper.ChanUpdateConnection(ctx, msg.PortId, msg.ChannelId, msg.ConnectionId); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel connection update failed")
+	}
+
+	return &channeltypes.MsgUpdateChannelConnectionResponse{}, nil
+}
+
+// FreezeChannel defines a rpc handler method for MsgFreezeChannel.
+func (k Keeper) FreezeChannel(goCtx context.Context, msg *channeltypes.MsgFreezeChannel) (*channeltypes.MsgFreezeChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.ChannelKeeper.ChanFreeze(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel freeze failed")
+	}
+
+	return &channeltypes.MsgFreezeChannelResponse{}, nil
+}
+
+// UnfreezeChannel defines a rpc handler method for MsgUnfreezeChannel.
+func (k Keeper) UnfreezeChannel(goCtx context.Context, msg *channeltypes.MsgUnfreezeChannel) (*channeltypes.MsgUnfreezeChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.ChannelKeeper.ChanUnfreeze(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel unfreeze failed")
+	}
+
+	return &channeltypes.MsgUnfreezeChannelResponse{}, nil
+}
+
+// UpdateChannelParams defines a rpc handler method for MsgUpdateChannelParams.
+func (k Keeper) UpdateChannelParams(goCtx context.Context, msg *channeltypes.MsgUpdateChannelParams) (*channeltypes.MsgUpdateChannelParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	k.ChannelKeeper.SetParams(ctx, msg.Params)
+
+	return &channeltypes.MsgUpdateChannelParamsResponse{}, nil
+}
+
+// SetupLocalhostPath defines a rpc handler method for MsgSetupLocalhostPath. It opens a channel between
+// two ports of the executing chain over the sentinel localhost connection by calling the ChannelOpenInit,
+// ChannelOpenTry, ChannelOpenAck and ChannelOpenConfirm handlers in turn. Each handshake step is verified
+// by the 09-localhost client against the channel end written by the previous step.
+func (k Keeper) SetupLocalhostPath(goCtx context.Context, msg *channeltypes.MsgSetupLocalhostPath) (*channeltypes.MsgSetupLocalhostPathResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clientState, found := k.ClientKeeper.GetClientState(ctx, exported.LocalhostClientID)
+	if !found {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "the %s client is not initialized", exported.LocalhostClientID)
+	}
+
+	// the localhost client reads the current IBC store, so proofs are provided at its latest height
+	proofHeight, ok := clientState.GetLatestHeight().(clienttypes.Height)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", clienttypes.Height{}, clientState.GetLatestHeight())
+	}
+
+	connectionHops := []string{exported.LocalhostConnectionID}
+
+	initRes, err := k.ChannelOpenInit(goCtx, channeltypes.NewMsgChannelOpenInit(msg.PortIdA, msg.Version, msg.Ordering, connectionHops, msg.PortIdB, msg.Signer))
+	if err != nil {
+		return nil, err
+	}
+
+	tryRes, err := k.ChannelOpenTry(goCtx, channeltypes.NewMsgChannelOpenTry(msg.PortIdB, initRes.Version, msg.Ordering, connectionHops, msg.PortIdA, initRes.ChannelId, initRes.Version, localhost.SentinelProof, proofHeight, msg.Signer))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.ChannelOpenAck(goCtx, channeltypes.NewMsgChannelOpenAck(msg.PortIdA, initRes.ChannelId, tryRes.ChannelId, tryRes.Version, localhost.SentinelProof, proofHeight, msg.Signer)); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.ChannelOpenConfirm(goCtx, channeltypes.NewMsgChannelOpenConfirm(msg.PortIdB, tryRes.ChannelId, localhost.SentinelProof, proofHeight, msg.Signer)); err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgSetupLocalhostPathResponse{
+		ChannelIdA: initRes.ChannelId,
+		ChannelIdB: tryRes.ChannelId,
+		Version:    tryRes.Version,
+	}, nil
+}
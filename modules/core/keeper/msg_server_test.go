@@ -5,6 +5,7 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
@@ -114,6 +115,17 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 			suite.coordinator.Setup(path)
 			packet = channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
 		}, false, false},
+		{"packet data exceeds the maximum size", func() {
+			suite.coordinator.Setup(path)
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			params := channeltypes.NewParams(channeltypes.DefaultMaxConnectionHops, uint64(len(ibctesting.MockPacketData)-1), channeltypes.DefaultMaxInFlightPackets)
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+		}, false, false},
 		{"successful no-op: ORDERED - packet already received (replay)", func() {
 			// mock will panic if application callback is called twice on the same packet
 			path.SetChannelOrdered()
@@ -753,3 +765,445 @@ func (suite *KeeperTestSuite) TestUpgradeClient() {
 		}
 	}
 }
+
+// TestPruneAcknowledgements tests the pruning of the acknowledgements of a closed channel on
+// chainA by the governance authority.
+func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgPruneAcknowledgements
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"channel is not closed",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.State = channeltypes.OPEN
+				path.EndpointA.SetChannel(channel)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, ibctesting.MockAcknowledgement)
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+
+			msg = channeltypes.NewMsgPruneAcknowledgements(suite.chainA.App.GetIBCKeeper().GetAuthority(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 10)
+
+			tc.malleate()
+
+			res, err := keeper.Keeper.PruneAcknowledgements(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			hasAck := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), res.TotalPruned)
+				suite.Require().False(res.HasRemaining)
+				suite.Require().False(hasAck)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(hasAck)
+			}
+		})
+	}
+}
+
+// TestUpdateChannelConnection tests moving the channel on chainA to a new connection by the
+// governance authority. A packet sent before the update is acknowledged using a proof verified
+// by the client of the new connection.
+func (suite *KeeperTestSuite) TestUpdateChannelConnection() {
+	var (
+		path    *ibctesting.Path
+		newPath *ibctesting.Path
+		msg     *channeltypes.MsgUpdateChannelConnection
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"client of the previous connection is active",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				clientState.FrozenHeight = clienttypes.ZeroHeight()
+				path.EndpointA.SetClientState(clientState)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// establish a new client and connection to chainB
+			newPath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(newPath)
+
+			// send and receive a packet which is acknowledged after the update
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			res, err := path.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			// the client of the channel's connection can no longer be used
+			clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointA.SetClientState(clientState)
+
+			msg = channeltypes.NewMsgUpdateChannelConnection(suite.chainA.App.GetIBCKeeper().GetAuthority(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, newPath.EndpointA.ConnectionID)
+
+			tc.malleate()
+
+			_, err = keeper.Keeper.UpdateChannelConnection(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]string{newPath.EndpointA.ConnectionID}, path.EndpointA.GetChannel().ConnectionHops)
+
+				// the acknowledgement proof is verified by the client of the new connection
+				suite.Require().NoError(newPath.EndpointA.UpdateClient())
+				suite.Require().NoError(newPath.EndpointA.AcknowledgePacket(packet, ack))
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().Nil(commitment)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal([]string{path.EndpointA.ConnectionID}, path.EndpointA.GetChannel().ConnectionHops)
+			}
+		})
+	}
+}
+
+// TestUpdateConnectionParams tests that the connection parameters may only be updated by the
+// governance authority.
+func (suite *KeeperTestSuite) TestUpdateConnectionParams() {
+	var msg *connectiontypes.MsgUpdateConnectionParams
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			params := connectiontypes.NewParams(uint64(connectiontypes.DefaultTimePerBlock) * 2)
+			msg = connectiontypes.NewMsgUpdateConnectionParams(suite.chainA.App.GetIBCKeeper().GetAuthority(), params)
+
+			tc.malleate()
+
+			_, err := keeper.Keeper.UpdateConnectionParams(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			connectionParams := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetParams(suite.chainA.GetContext())
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, connectionParams)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(connectiontypes.DefaultParams(), connectionParams)
+			}
+		})
+	}
+}
+
+// TestUpdateChannelParams tests that the channel parameters may only be updated by the governance authority.
+func (suite *KeeperTestSuite) TestUpdateChannelParams() {
+	var msg *channeltypes.MsgUpdateChannelParams
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			params := channeltypes.NewParams(channeltypes.DefaultMaxConnectionHops, channeltypes.DefaultMaxPacketDataBytes/2, channeltypes.DefaultMaxInFlightPackets)
+			msg = channeltypes.NewMsgUpdateChannelParams(suite.chainA.App.GetIBCKeeper().GetAuthority(), params)
+
+			tc.malleate()
+
+			_, err := keeper.Keeper.UpdateChannelParams(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			channelParams := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, channelParams)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(channeltypes.DefaultParams(), channelParams)
+			}
+		})
+	}
+}
+
+// TestPruneExpiredConsensusStates tests that the expired consensus states of a client may only be
+// pruned by the governance authority.
+func (suite *KeeperTestSuite) TestPruneExpiredConsensusStates() {
+	var (
+		path *ibctesting.Path
+		msg  *clienttypes.MsgPruneExpiredConsensusStates
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				msg.ClientId = ibctesting.InvalidID
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			height := path.EndpointA.GetClientState().GetLatestHeight()
+
+			suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+			msg = clienttypes.NewMsgPruneExpiredConsensusStates(suite.chainA.App.GetIBCKeeper().GetAuthority(), path.EndpointA.ClientID)
+
+			tc.malleate()
+
+			res, err := keeper.Keeper.PruneExpiredConsensusStates(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, height)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), res.TotalPruned)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(found)
+			}
+		})
+	}
+}
+
+// TestFreezeChannel tests the freezing of a channel on chainA by the governance authority.
+func (suite *KeeperTestSuite) TestFreezeChannel() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgFreezeChannel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			msg = channeltypes.NewMsgFreezeChannel(suite.chainA.App.GetIBCKeeper().GetAuthority(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			_, err := keeper.Keeper.FreezeChannel(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			frozen := suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelFrozen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(frozen)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(frozen)
+			}
+		})
+	}
+}
+
+// TestUnfreezeChannel tests the unfreezing of a frozen channel on chainA by the governance authority.
+func (suite *KeeperTestSuite) TestUnfreezeChannel() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgUnfreezeChannel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelFrozen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			msg = channeltypes.NewMsgUnfreezeChannel(suite.chainA.App.GetIBCKeeper().GetAuthority(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			_, err := keeper.Keeper.UnfreezeChannel(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			frozen := suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelFrozen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(frozen)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(frozen)
+			}
+		})
+	}
+}
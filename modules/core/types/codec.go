@@ -7,8 +7,10 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 	solomachine "github.com/cosmos/ibc-go/v6/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint"
+	localhost "github.com/cosmos/ibc-go/v6/modules/light-clients/09-localhost"
 )
 
 // RegisterInterfaces registers x/ibc interfaces into protobuf Any.
@@ -18,5 +20,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	channeltypes.RegisterInterfaces(registry)
 	solomachine.RegisterInterfaces(registry)
 	ibctm.RegisterInterfaces(registry)
+	localhost.RegisterInterfaces(registry)
 	commitmenttypes.RegisterInterfaces(registry)
+
+	registry.RegisterInterface(
+		"ibc.core.types.v1.AppParams",
+		(*exported.AppParams)(nil),
+	)
 }
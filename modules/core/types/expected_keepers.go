@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+// AppParamsKeeper defines the expected keeper of an IBC application whose params are returned
+// by the IBCParams query
+type AppParamsKeeper interface {
+	GetAppParams(ctx sdk.Context) exported.AppParams
+}
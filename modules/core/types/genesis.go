@@ -1,11 +1,15 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
 var _ codectypes.UnpackInterfacesMessage = GenesisState{}
@@ -35,5 +39,78 @@ func (gs *GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.ChannelGenesis.Validate()
+	if err := gs.ChannelGenesis.Validate(); err != nil {
+		return err
+	}
+
+	return gs.ValidateReferences()
+}
+
+// ValidateReferences ensures the identifiers referenced across the client, connection and channel
+// genesis states resolve. Every channel connection hop must reference an existing connection,
+// every connection must reference an existing client and every packet commitment and frozen channel
+// must reference an existing channel. All broken references are returned in a single error.
+func (gs *GenesisState) ValidateReferences() error {
+	clients := make(map[string]bool)
+	for _, client := range gs.ClientGenesis.Clients {
+		clients[client.ClientId] = true
+	}
+
+	// the 09-localhost client is created on genesis initialization if it is allowed
+	if gs.ClientGenesis.Params.IsAllowedClient(exported.Localhost) {
+		clients[exported.LocalhostClientID] = true
+	}
+
+	connections := make(map[string]bool)
+	for _, connection := range gs.ConnectionGenesis.Connections {
+		connections[connection.Id] = true
+	}
+
+	// the sentinel localhost connection is created on genesis initialization if the 09-localhost client exists
+	if clients[exported.LocalhostClientID] {
+		connections[exported.LocalhostConnectionID] = true
+	}
+
+	channels := make(map[string]bool)
+	for _, channel := range gs.ChannelGenesis.Channels {
+		channels[channelKey(channel.PortId, channel.ChannelId)] = true
+	}
+
+	var broken []string
+	for _, connection := range gs.ConnectionGenesis.Connections {
+		if !clients[connection.ClientId] {
+			broken = append(broken, fmt.Sprintf("connection %s references nonexistent client %s", connection.Id, connection.ClientId))
+		}
+	}
+
+	for _, channel := range gs.ChannelGenesis.Channels {
+		for _, connectionID := range channel.ConnectionHops {
+			if !connections[connectionID] {
+				broken = append(broken, fmt.Sprintf("channel %s on port %s references nonexistent connection %s", channel.ChannelId, channel.PortId, connectionID))
+			}
+		}
+	}
+
+	for _, commitment := range gs.ChannelGenesis.Commitments {
+		if !channels[channelKey(commitment.PortId, commitment.ChannelId)] {
+			broken = append(broken, fmt.Sprintf("packet commitment with sequence %d references nonexistent channel %s on port %s", commitment.Sequence, commitment.ChannelId, commitment.PortId))
+		}
+	}
+
+	for _, frozenChannel := range gs.ChannelGenesis.FrozenChannels {
+		if !channels[channelKey(frozenChannel.PortId, frozenChannel.ChannelId)] {
+			broken = append(broken, fmt.Sprintf("frozen channel %s on port %s does not exist", frozenChannel.ChannelId, frozenChannel.PortId))
+		}
+	}
+
+	if len(broken) != 0 {
+		return fmt.Errorf("invalid genesis references: %s", strings.Join(broken, "; "))
+	}
+
+	return nil
+}
+
+// channelKey returns a key uniquely identifying a channel by its port and channel identifiers.
+func channelKey(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", portID, channelID)
 }
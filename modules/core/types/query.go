@@ -1,6 +1,7 @@
 package types
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/gogo/protobuf/grpc"
 
 	client "github.com/cosmos/ibc-go/v6/modules/core/02-client"
@@ -9,13 +10,17 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channel "github.com/cosmos/ibc-go/v6/modules/core/04-channel"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
+var _ codectypes.UnpackInterfacesMessage = QueryIBCParamsResponse{}
+
 // QueryServer defines the IBC interfaces that the gRPC query server must implement
 type QueryServer interface {
 	clienttypes.QueryServer
 	connectiontypes.QueryServer
 	channeltypes.QueryServer
+	IBCQueryServer
 }
 
 // RegisterQueryService registers each individual IBC submodule query service
@@ -23,4 +28,17 @@ func RegisterQueryService(server grpc.Server, queryService QueryServer) {
 	client.RegisterQueryService(server, queryService)
 	connection.RegisterQueryService(server, queryService)
 	channel.RegisterQueryService(server, queryService)
+	RegisterIBCQueryServer(server, queryService)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
+func (qipr QueryIBCParamsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, appParams := range qipr.AppParams {
+		var params exported.AppParams
+		if err := unpacker.UnpackAny(appParams, &params); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,725 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/types/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types3 "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	types1 "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	types2 "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryIBCParamsRequest is the request type for the IBCQuery/IBCParams RPC method.
+type QueryIBCParamsRequest struct {
+}
+
+func (m *QueryIBCParamsRequest) Reset()         { *m = QueryIBCParamsRequest{} }
+func (m *QueryIBCParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCParamsRequest) ProtoMessage()    {}
+func (*QueryIBCParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc0ad6869acad8f, []int{0}
+}
+func (m *QueryIBCParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCParamsRequest.Merge(m, src)
+}
+func (m *QueryIBCParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCParamsRequest proto.InternalMessageInfo
+
+// QueryIBCParamsResponse is the response type for the IBCQuery/IBCParams RPC method.
+// The params of an IBC application are only returned if the application is wired into
+// the core IBC keeper.
+type QueryIBCParamsResponse struct {
+	// ICS002 - Client params
+	ClientParams types.Params `protobuf:"bytes,1,opt,name=client_params,json=clientParams,proto3" json:"client_params"`
+	// ICS003 - Connection params
+	ConnectionParams types1.Params `protobuf:"bytes,2,opt,name=connection_params,json=connectionParams,proto3" json:"connection_params"`
+	// ICS004 - Channel params
+	ChannelParams types2.Params `protobuf:"bytes,3,opt,name=channel_params,json=channelParams,proto3" json:"channel_params"`
+	// params of the IBC applications wired into the core IBC keeper, e.g.
+	// ibc.applications.transfer.v1.Params, in the order the applications were set
+	AppParams []*types3.Any `protobuf:"bytes,4,rep,name=app_params,json=appParams,proto3" json:"app_params,omitempty"`
+}
+
+func (m *QueryIBCParamsResponse) Reset()         { *m = QueryIBCParamsResponse{} }
+func (m *QueryIBCParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCParamsResponse) ProtoMessage()    {}
+func (*QueryIBCParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc0ad6869acad8f, []int{1}
+}
+func (m *QueryIBCParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCParamsResponse.Merge(m, src)
+}
+func (m *QueryIBCParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCParamsResponse proto.InternalMessageInfo
+
+func (m *QueryIBCParamsResponse) GetClientParams() types.Params {
+	if m != nil {
+		return m.ClientParams
+	}
+	return types.Params{}
+}
+
+func (m *QueryIBCParamsResponse) GetConnectionParams() types1.Params {
+	if m != nil {
+		return m.ConnectionParams
+	}
+	return types1.Params{}
+}
+
+func (m *QueryIBCParamsResponse) GetChannelParams() types2.Params {
+	if m != nil {
+		return m.ChannelParams
+	}
+	return types2.Params{}
+}
+
+func (m *QueryIBCParamsResponse) GetAppParams() []*types3.Any {
+	if m != nil {
+		return m.AppParams
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryIBCParamsRequest)(nil), "ibc.core.types.v1.QueryIBCParamsRequest")
+	proto.RegisterType((*QueryIBCParamsResponse)(nil), "ibc.core.types.v1.QueryIBCParamsResponse")
+}
+
+func init() { proto.RegisterFile("ibc/core/types/v1/query.proto", fileDescriptor_8cc0ad6869acad8f) }
+
+var fileDescriptor_8cc0ad6869acad8f = []byte{
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0x93, 0xde, 0x09, 0x71, 0x3e, 0x0e, 0x71, 0xe1, 0x8e, 0x3f, 0x39, 0xc8, 0x1d, 0x5d,
+	0x28, 0x03, 0xb6, 0xd2, 0x93, 0xd8, 0x49, 0x85, 0xd4, 0x6e, 0xb4, 0x23, 0x0b, 0x72, 0x82, 0x49,
+	0x23, 0x25, 0x7e, 0xdd, 0x38, 0x89, 0xd4, 0x81, 0x85, 0x8d, 0x0d, 0x89, 0x4f, 0xc2, 0xb7, 0xe8,
+	0x58, 0x89, 0x85, 0x09, 0xa1, 0x96, 0x0f, 0x82, 0x62, 0x3b, 0x49, 0x55, 0x18, 0x6e, 0xb3, 0xfd,
+	0x3c, 0xef, 0xaf, 0xcf, 0xfb, 0xbe, 0x0d, 0x7a, 0x9a, 0x84, 0x11, 0x89, 0x20, 0x67, 0xa4, 0x58,
+	0x0a, 0x26, 0x49, 0xe5, 0x93, 0x45, 0xc9, 0xf2, 0x25, 0x16, 0x39, 0x14, 0xe0, 0x9c, 0x26, 0x61,
+	0x84, 0x6b, 0x19, 0x2b, 0x19, 0x57, 0xbe, 0x7b, 0x16, 0x43, 0x0c, 0x4a, 0x25, 0xf5, 0x49, 0x1b,
+	0xdd, 0x27, 0x31, 0x40, 0x9c, 0x32, 0x42, 0x45, 0x42, 0x28, 0xe7, 0x50, 0xd0, 0x22, 0x01, 0x2e,
+	0x8d, 0xfa, 0xd8, 0xa8, 0xea, 0x16, 0x96, 0x1f, 0x09, 0xe5, 0xe6, 0x17, 0xdc, 0xcb, 0x36, 0x40,
+	0x94, 0x26, 0x8c, 0x17, 0x75, 0x02, 0x7d, 0x32, 0x86, 0xe7, 0x9d, 0x01, 0x38, 0x67, 0x51, 0xcd,
+	0x55, 0xa6, 0xf6, 0x66, 0x8c, 0xcf, 0x3a, 0xe3, 0x9c, 0x72, 0xce, 0x52, 0xe5, 0xd2, 0x47, 0x6d,
+	0xe9, 0x3f, 0x44, 0xe7, 0xd3, 0xba, 0xbb, 0x49, 0x30, 0x7a, 0x4b, 0x73, 0x9a, 0xc9, 0x19, 0x5b,
+	0x94, 0x4c, 0x16, 0xfd, 0xef, 0x3d, 0xf4, 0x60, 0x5f, 0x91, 0x02, 0xb8, 0x64, 0xce, 0x1b, 0x74,
+	0xa2, 0xf3, 0xbc, 0x17, 0x4a, 0x78, 0x64, 0x5f, 0xd9, 0x83, 0xe3, 0xa1, 0x8b, 0xdb, 0xd1, 0x98,
+	0xb8, 0x95, 0x8f, 0x75, 0x69, 0x70, 0xb8, 0xfa, 0x75, 0x69, 0xcd, 0xee, 0xe8, 0x77, 0xfd, 0xe6,
+	0x4c, 0xd1, 0x69, 0x97, 0xb8, 0x41, 0xf5, 0x14, 0xca, 0xdb, 0x41, 0x75, 0x4d, 0xed, 0xe3, 0xee,
+	0x75, 0x9a, 0x41, 0x8e, 0xd1, 0x5d, 0xd3, 0x5e, 0xc3, 0x3b, 0x50, 0xbc, 0x8b, 0x1d, 0x9e, 0x69,
+	0x7f, 0x1f, 0x76, 0x62, 0x04, 0x43, 0xba, 0x46, 0x88, 0x0a, 0xd1, 0x50, 0x0e, 0xaf, 0x0e, 0x06,
+	0xc7, 0xc3, 0x33, 0xac, 0x97, 0x86, 0x9b, 0xa5, 0xe1, 0xd7, 0x7c, 0x39, 0x3b, 0xa2, 0x42, 0xe8,
+	0xa2, 0xe1, 0x17, 0x1b, 0xdd, 0x9e, 0x04, 0x23, 0x35, 0x36, 0xe7, 0x13, 0x3a, 0x6a, 0x47, 0xe7,
+	0x0c, 0xf0, 0x3f, 0x7f, 0x1b, 0xfc, 0xdf, 0xb9, 0xbb, 0x2f, 0x6e, 0xe0, 0xd4, 0x7b, 0xe8, 0x5f,
+	0x7c, 0xfe, 0xf1, 0xe7, 0x5b, 0xef, 0xdc, 0xb9, 0x4f, 0xda, 0x3d, 0x57, 0x3e, 0xd1, 0x91, 0x83,
+	0xf1, 0x6a, 0xe3, 0xd9, 0xeb, 0x8d, 0x67, 0xff, 0xde, 0x78, 0xf6, 0xd7, 0xad, 0x67, 0xad, 0xb7,
+	0x9e, 0xf5, 0x73, 0xeb, 0x59, 0xef, 0x70, 0x9c, 0x14, 0xf3, 0x32, 0xc4, 0x11, 0x64, 0x24, 0x02,
+	0x99, 0x81, 0xac, 0xeb, 0x5f, 0xc6, 0x40, 0xaa, 0x57, 0x24, 0x83, 0x0f, 0x65, 0xca, 0xe4, 0xce,
+	0x07, 0x10, 0xde, 0x52, 0xed, 0x5e, 0xff, 0x1d, 0x00, 0x00, 0x09, 0x5e, 0x43, 0x19, 0x03, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IBCQueryClient is the client API for IBCQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IBCQueryClient interface {
+	// IBCParams queries the parameters of the core IBC submodules and of the IBC
+	// applications wired into the core IBC keeper.
+	IBCParams(ctx context.Context, in *QueryIBCParamsRequest, opts ...grpc.CallOption) (*QueryIBCParamsResponse, error)
+}
+
+type iBCQueryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewIBCQueryClient(cc grpc1.ClientConn) IBCQueryClient {
+	return &iBCQueryClient{cc}
+}
+
+func (c *iBCQueryClient) IBCParams(ctx context.Context, in *QueryIBCParamsRequest, opts ...grpc.CallOption) (*QueryIBCParamsResponse, error) {
+	out := new(QueryIBCParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.types.v1.IBCQuery/IBCParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IBCQueryServer is the server API for IBCQuery service.
+type IBCQueryServer interface {
+	// IBCParams queries the parameters of the core IBC submodules and of the IBC
+	// applications wired into the core IBC keeper.
+	IBCParams(context.Context, *QueryIBCParamsRequest) (*QueryIBCParamsResponse, error)
+}
+
+// UnimplementedIBCQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedIBCQueryServer struct {
+}
+
+func (*UnimplementedIBCQueryServer) IBCParams(ctx context.Context, req *QueryIBCParamsRequest) (*QueryIBCParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCParams not implemented")
+}
+
+func RegisterIBCQueryServer(s grpc1.Server, srv IBCQueryServer) {
+	s.RegisterService(&_IBCQuery_serviceDesc, srv)
+}
+
+func _IBCQuery_IBCParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IBCQueryServer).IBCParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.types.v1.IBCQuery/IBCParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IBCQueryServer).IBCParams(ctx, req.(*QueryIBCParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _IBCQuery_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.core.types.v1.IBCQuery",
+	HandlerType: (*IBCQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IBCParams",
+			Handler:    _IBCQuery_IBCParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/core/types/v1/query.proto",
+}
+
+func (m *QueryIBCParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppParams) > 0 {
+		for iNdEx := len(m.AppParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.ChannelParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.ConnectionParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ClientParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIBCParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIBCParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClientParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ConnectionParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ChannelParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.AppParams) > 0 {
+		for _, e := range m.AppParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryIBCParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClientParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConnectionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChannelParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppParams = append(m.AppParams, &types3.Any{})
+			if err := m.AppParams[len(m.AppParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
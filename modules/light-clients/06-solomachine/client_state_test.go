@@ -141,8 +141,8 @@ func (suite *SoloMachineTestSuite) TestInitialize() {
 }
 
 func (suite *SoloMachineTestSuite) TestVerifyMembership() {
-	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig and multisig public keys as well as singlesig public keys of other key types
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineEd25519, suite.solomachineSecp256r1} {
 
 		var (
 			clientState *solomachine.ClientState
@@ -605,8 +605,8 @@ func (suite *SoloMachineTestSuite) TestSignBytesMarshalling() {
 }
 
 func (suite *SoloMachineTestSuite) TestVerifyNonMembership() {
-	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	// test singlesig and multisig public keys as well as singlesig public keys of other key types
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineEd25519, suite.solomachineSecp256r1} {
 
 		var (
 			clientState *solomachine.ClientState
@@ -3,6 +3,7 @@ package solomachine
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
@@ -10,8 +11,12 @@ import (
 )
 
 // RegisterInterfaces register the ibc channel submodule interfaces to protobuf
-// Any.
+// Any. The public key implementations provided by the SDK (secp256k1, ed25519,
+// secp256r1 and multisig) are registered as well, such that any of them may be
+// used as the solo machine public key.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	cryptocodec.RegisterInterfaces(registry)
+
 	registry.RegisterImplementations(
 		(*exported.ClientState)(nil),
 		&ClientState{},
@@ -9,6 +9,8 @@ import (
 
 // VerifySignature verifies if the the provided public key generated the signature
 // over the given data. Single and Multi signature public keys are supported.
+// Single signature public keys may be of any type registered in the codec, such
+// as secp256k1, ed25519 or secp256r1.
 // The signature data type must correspond to the public key type. An error is
 // returned if signature verification fails or an invalid SignatureData type is
 // provided.
@@ -20,11 +22,7 @@ func VerifySignature(pubKey cryptotypes.PubKey, signBytes []byte, sigData signin
 			return sdkerrors.Wrapf(ErrSignatureVerificationFailed, "invalid signature data type, expected %T, got %T", (*signing.MultiSignatureData)(nil), data)
 		}
 
-		// The function supplied fulfills the VerifyMultisignature interface. No special
-		// adjustments need to be made to the sign bytes based on the sign mode.
-		if err := pubKey.VerifyMultisignature(func(signing.SignMode) ([]byte, error) {
-			return signBytes, nil
-		}, data); err != nil {
+		if err := verifyMultisignature(pubKey, signBytes, data); err != nil {
 			return err
 		}
 
@@ -41,3 +39,47 @@ func VerifySignature(pubKey cryptotypes.PubKey, signBytes []byte, sigData signin
 
 	return nil
 }
+
+// verifyMultisignature verifies that the threshold number of public keys nested within the multisig
+// public key generated a signature over the given data. The number of signatures is checked against
+// the threshold before any signature is verified and verification stops once the threshold is met,
+// such that signatures exceeding the threshold are not verified. Nested multisig public keys are
+// verified recursively. The sign mode of the signatures is ignored, no special adjustments need to
+// be made to the sign bytes based on the sign mode.
+func verifyMultisignature(pubKey multisig.PubKey, signBytes []byte, sigData *signing.MultiSignatureData) error {
+	pubKeys := pubKey.GetPubKeys()
+	threshold := int(pubKey.GetThreshold())
+	if threshold == 0 {
+		return sdkerrors.Wrap(ErrSignatureVerificationFailed, "multisig threshold cannot be zero")
+	}
+
+	size := sigData.BitArray.Count()
+	if len(pubKeys) != size {
+		return sdkerrors.Wrapf(ErrSignatureVerificationFailed, "bit array size is incorrect, expected %d, got %d", len(pubKeys), size)
+	}
+
+	numSigners := sigData.BitArray.NumTrueBitsBefore(size)
+	if len(sigData.Signatures) != numSigners {
+		return sdkerrors.Wrapf(ErrSignatureVerificationFailed, "number of signatures does not match the bit array, expected %d, got %d", numSigners, len(sigData.Signatures))
+	}
+
+	if numSigners < threshold {
+		return sdkerrors.Wrapf(ErrSignatureVerificationFailed, "not enough signatures, expected at least %d, got %d", threshold, numSigners)
+	}
+
+	// sigIndex is the index of the signature of the public key at index i
+	sigIndex := 0
+	for i := 0; i < size && sigIndex < threshold; i++ {
+		if !sigData.BitArray.GetIndex(i) {
+			continue
+		}
+
+		if err := VerifySignature(pubKeys[i], signBytes, sigData.Signatures[sigIndex]); err != nil {
+			return sdkerrors.Wrapf(err, "unable to verify signature of public key at index %d", i)
+		}
+
+		sigIndex++
+	}
+
+	return nil
+}
@@ -1,7 +1,10 @@
 package solomachine_test
 
 import (
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	solomachine "github.com/cosmos/ibc-go/v6/modules/light-clients/06-solomachine"
@@ -15,10 +18,50 @@ func (suite *SoloMachineTestSuite) TestVerifySignature() {
 	singleSigData, err := solomachine.UnmarshalSignatureData(cdc, singleSignature)
 	suite.Require().NoError(err)
 
+	ed25519Signature := suite.solomachineEd25519.GenerateSignature(signBytes)
+	ed25519SigData, err := solomachine.UnmarshalSignatureData(cdc, ed25519Signature)
+	suite.Require().NoError(err)
+
+	secp256r1Signature := suite.solomachineSecp256r1.GenerateSignature(signBytes)
+	secp256r1SigData, err := solomachine.UnmarshalSignatureData(cdc, secp256r1Signature)
+	suite.Require().NoError(err)
+
 	multiSignature := suite.solomachineMulti.GenerateSignature(signBytes)
 	multiSigData, err := solomachine.UnmarshalSignatureData(cdc, multiSignature)
 	suite.Require().NoError(err)
 
+	// 2 of 3 threshold multisig public key
+	privKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := make([]cryptotypes.PubKey, len(privKeys))
+	for i, privKey := range privKeys {
+		pubKeys[i] = privKey.PubKey()
+	}
+	thresholdPubKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+
+	// thresholdSigData returns the multi signature data of the provided signers of the threshold multisig
+	// public key. The signature of a signer in invalidSigners is generated over invalid sign bytes.
+	thresholdSigData := func(signers []int, invalidSigners ...int) *signing.MultiSignatureData {
+		sigData := multisig.NewMultisig(len(privKeys))
+		for _, signer := range signers {
+			signerBytes := signBytes
+			for _, invalidSigner := range invalidSigners {
+				if signer == invalidSigner {
+					signerBytes = []byte("invalid sign bytes")
+				}
+			}
+
+			signature, err := privKeys[signer].Sign(signerBytes)
+			suite.Require().NoError(err)
+
+			multisig.AddSignature(sigData, &signing.SingleSignatureData{Signature: signature}, signer)
+		}
+
+		return sigData
+	}
+
+	missingSignatureSigData := thresholdSigData([]int{0, 1, 2})
+	missingSignatureSigData.Signatures = missingSignatureSigData.Signatures[:2]
+
 	testCases := []struct {
 		name      string
 		publicKey cryptotypes.PubKey
@@ -31,6 +74,30 @@ func (suite *SoloMachineTestSuite) TestVerifySignature() {
 			singleSigData,
 			true,
 		},
+		{
+			"single signature with ed25519 public key",
+			suite.solomachineEd25519.PublicKey,
+			ed25519SigData,
+			true,
+		},
+		{
+			"single signature with secp256r1 public key",
+			suite.solomachineSecp256r1.PublicKey,
+			secp256r1SigData,
+			true,
+		},
+		{
+			"ed25519 signature with secp256r1 public key",
+			suite.solomachineSecp256r1.PublicKey,
+			ed25519SigData,
+			false,
+		},
+		{
+			"secp256r1 signature with regular public key",
+			suite.solomachine.PublicKey,
+			secp256r1SigData,
+			false,
+		},
 		{
 			"multi signature with multisig public key",
 			suite.solomachineMulti.PublicKey,
@@ -49,6 +116,58 @@ func (suite *SoloMachineTestSuite) TestVerifySignature() {
 			multiSigData,
 			false,
 		},
+		{
+			"threshold multi signature with threshold number of signatures",
+			thresholdPubKey,
+			thresholdSigData([]int{0, 2}),
+			true,
+		},
+		{
+			"threshold multi signature with all signatures",
+			thresholdPubKey,
+			thresholdSigData([]int{0, 1, 2}),
+			true,
+		},
+		{
+			"threshold multi signature with invalid signature exceeding the threshold",
+			thresholdPubKey,
+			thresholdSigData([]int{0, 1, 2}, 2),
+			true,
+		},
+		{
+			"threshold multi signature with invalid signature within the threshold",
+			thresholdPubKey,
+			thresholdSigData([]int{0, 1, 2}, 1),
+			false,
+		},
+		{
+			"threshold multi signature with less signatures than the threshold",
+			thresholdPubKey,
+			thresholdSigData([]int{1}),
+			false,
+		},
+		{
+			"threshold multi signature with signature missing for a set bit",
+			thresholdPubKey,
+			missingSignatureSigData,
+			false,
+		},
+		{
+			"threshold multi signature with incorrect bit array size",
+			thresholdPubKey,
+			multiSigData,
+			false,
+		},
+		{
+			"nested threshold multi signature",
+			kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{suite.solomachine.PublicKey, thresholdPubKey}),
+			func() *signing.MultiSignatureData {
+				sigData := multisig.NewMultisig(2)
+				multisig.AddSignature(sigData, thresholdSigData([]int{1, 2}), 1)
+				return sigData
+			}(),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
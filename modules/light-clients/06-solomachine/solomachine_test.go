@@ -5,7 +5,10 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -20,9 +23,11 @@ import (
 type SoloMachineTestSuite struct {
 	suite.Suite
 
-	solomachine      *ibctesting.Solomachine // singlesig public key
-	solomachineMulti *ibctesting.Solomachine // multisig public key
-	coordinator      *ibctesting.Coordinator
+	solomachine          *ibctesting.Solomachine // singlesig public key
+	solomachineMulti     *ibctesting.Solomachine // multisig public key
+	solomachineEd25519   *ibctesting.Solomachine // singlesig ed25519 public key
+	solomachineSecp256r1 *ibctesting.Solomachine // singlesig secp256r1 public key
+	coordinator          *ibctesting.Coordinator
 
 	// testing chain used for convenience and readability
 	chainA *ibctesting.TestChain
@@ -38,6 +43,14 @@ func (suite *SoloMachineTestSuite) SetupTest() {
 
 	suite.solomachine = ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachinesingle", "testing", 1)
 	suite.solomachineMulti = ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachinemulti", "testing", 4)
+	suite.solomachineEd25519 = ibctesting.NewSolomachineWithKeyGenerator(suite.T(), suite.chainA.Codec, "solomachineed25519", "testing", 1, func() cryptotypes.PrivKey {
+		return ed25519.GenPrivKey()
+	})
+	suite.solomachineSecp256r1 = ibctesting.NewSolomachineWithKeyGenerator(suite.T(), suite.chainA.Codec, "solomachinesecp256r1", "testing", 1, func() cryptotypes.PrivKey {
+		privKey, err := secp256r1.GenPrivKey()
+		suite.Require().NoError(err)
+		return privKey
+	})
 
 	suite.store = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), exported.Solomachine)
 }
@@ -110,3 +123,39 @@ func TestUnpackInterfaces_HeaderData(t *testing.T) {
 
 	require.Equal(t, pk, hd2.NewPubKey.GetCachedValue())
 }
+
+func TestRegisterInterfaces_PubKeys(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	solomachine.RegisterInterfaces(registry)
+
+	secp256r1PrivKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+
+	for _, pk := range []cryptotypes.PubKey{
+		secp256k1.GenPrivKey().PubKey(),
+		ed25519.GenPrivKey().PubKey(),
+		secp256r1PrivKey.PubKey(),
+	} {
+		any, err := codectypes.NewAnyWithValue(pk)
+		require.NoError(t, err)
+
+		consensusState := solomachine.ConsensusState{
+			PublicKey:   any,
+			Diversifier: "testing",
+			Timestamp:   10,
+		}
+		bz, err := consensusState.Marshal()
+		require.NoError(t, err)
+
+		var consensusState2 solomachine.ConsensusState
+		err = consensusState2.Unmarshal(bz)
+		require.NoError(t, err)
+
+		err = codectypes.UnpackInterfaces(consensusState2, registry)
+		require.NoError(t, err)
+
+		publicKey, err := consensusState2.GetPubKey()
+		require.NoError(t, err)
+		require.Equal(t, pk, publicKey)
+	}
+}
@@ -85,7 +85,7 @@ func (cs ClientState) verifyHeader(ctx sdk.Context, cdc codec.BinaryCodec, clien
 
 // UpdateState updates the consensus state to the new public key and an incremented sequence.
 // A list containing the updated consensus height is returned.
-func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) []exported.Height {
+func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) ([]exported.Height, error) {
 	smHeader, ok := clientMsg.(*Header)
 	if !ok {
 		panic(fmt.Errorf("unsupported ClientMessage: %T", clientMsg))
@@ -103,7 +103,7 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 
 	setClientState(clientStore, cdc, &cs)
 
-	return []exported.Height{clienttypes.NewHeight(0, cs.Sequence)}
+	return []exported.Height{clienttypes.NewHeight(0, cs.Sequence)}, nil
 }
 
 // CheckForMisbehaviour returns true for type Misbehaviour (passed VerifyClientMessage check), otherwise returns false
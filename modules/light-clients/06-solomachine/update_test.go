@@ -446,7 +446,8 @@ func (suite *SoloMachineTestSuite) TestUpdateState() {
 				tc.setup() // setup test
 
 				if tc.expPass {
-					consensusHeights := clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, clientMsg)
+					consensusHeights, err := clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, clientMsg)
+					suite.Require().NoError(err)
 
 					clientStateBz := suite.store.Get(host.ClientStateKey())
 					suite.Require().NotEmpty(clientStateBz)
@@ -464,7 +465,7 @@ func (suite *SoloMachineTestSuite) TestUpdateState() {
 					suite.Require().Equal(clientMsg.(*solomachine.Header).Timestamp, newClientState.(*solomachine.ClientState).ConsensusState.Timestamp)
 				} else {
 					suite.Require().Panics(func() {
-						clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, clientMsg)
+						_, _ = clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, clientMsg)
 					})
 				}
 			})
@@ -134,6 +134,10 @@ func (cs ClientState) Validate() error {
 	if cs.MaxClockDrift <= 0 {
 		return sdkerrors.Wrap(ErrInvalidMaxClockDrift, "max clock drift must be greater than zero")
 	}
+	// the latest consensus state and the consensus state created by an update are never pruned
+	if cs.MaxConsensusStates == 1 {
+		return sdkerrors.Wrap(ErrInvalidMaxConsensusStates, "max consensus states must be zero or greater than one")
+	}
 
 	// the latest height revision number must match the chain id revision number
 	if cs.LatestHeight.RevisionNumber != clienttypes.ParseChainID(cs.ChainId) {
@@ -150,14 +154,10 @@ func (cs ClientState) Validate() error {
 		)
 	}
 
-	if cs.ProofSpecs == nil {
-		return sdkerrors.Wrap(ErrInvalidProofSpecs, "proof specs cannot be nil for tm client")
-	}
-	for i, spec := range cs.ProofSpecs {
-		if spec == nil {
-			return sdkerrors.Wrapf(ErrInvalidProofSpecs, "proof spec cannot be nil at index: %d", i)
-		}
+	if err := validateProofSpecs(cs.ProofSpecs); err != nil {
+		return err
 	}
+
 	// UpgradePath may be empty, but if it isn't, each key must be non-empty
 	for i, k := range cs.UpgradePath {
 		if strings.TrimSpace(k) == "" {
@@ -168,6 +168,43 @@ func (cs ClientState) Validate() error {
 	return nil
 }
 
+// ValidateTrustingPeriodFraction returns an error if the trusting period of the client exceeds
+// the provided fraction of the unbonding period. No restriction is applied if the fraction is zero.
+func (cs ClientState) ValidateTrustingPeriodFraction(maxFraction sdk.Dec) error {
+	if maxFraction.IsNil() || maxFraction.IsZero() {
+		return nil
+	}
+
+	maxTrustingPeriod := time.Duration(sdk.NewDec(int64(cs.UnbondingPeriod)).Mul(maxFraction).TruncateInt64())
+	if cs.TrustingPeriod > maxTrustingPeriod {
+		return sdkerrors.Wrapf(
+			ErrInvalidTrustingPeriod,
+			"trusting period (%s) exceeds the maximum trusting period (%s) of %s times the unbonding period (%s)",
+			cs.TrustingPeriod, maxTrustingPeriod, maxFraction, cs.UnbondingPeriod,
+		)
+	}
+
+	return nil
+}
+
+// ValidateMaxClockDrift returns an error if the max clock drift of the client exceeds the provided
+// maximum allowed clock drift. No restriction is applied if the maximum allowed clock drift is zero.
+func (cs ClientState) ValidateMaxClockDrift(maxAllowedClockDrift time.Duration) error {
+	if maxAllowedClockDrift == 0 {
+		return nil
+	}
+
+	if cs.MaxClockDrift > maxAllowedClockDrift {
+		return sdkerrors.Wrapf(
+			ErrInvalidMaxClockDrift,
+			"max clock drift (%s) exceeds the maximum allowed clock drift (%s)",
+			cs.MaxClockDrift, maxAllowedClockDrift,
+		)
+	}
+
+	return nil
+}
+
 // GetProofSpecs returns the format the client expects for proof verification
 // as a string array specifying the proof type for each position in chained proof
 func (cs ClientState) GetProofSpecs() []*ics23.ProofSpec {
@@ -195,6 +232,12 @@ func (cs ClientState) Initialize(ctx sdk.Context, _ codec.BinaryCodec, clientSto
 		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "invalid initial consensus state. expected type: %T, got: %T",
 			&ConsensusState{}, consState)
 	}
+
+	// the proof specs are used to verify all proofs of the counterparty chain and may differ from the SDK defaults
+	if err := validateProofSpecs(cs.ProofSpecs); err != nil {
+		return err
+	}
+
 	// set metadata for initial consensus state.
 	setConsensusMetadata(ctx, clientStore, cs.GetLatestHeight())
 	return nil
@@ -224,26 +267,12 @@ func (cs ClientState) VerifyMembership(
 		return err
 	}
 
-	var merkleProof commitmenttypes.MerkleProof
-	if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
-		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
-	}
-
-	merklePath, ok := path.(commitmenttypes.MerklePath)
-	if !ok {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
-	}
-
 	consensusState, found := GetConsensusState(clientStore, cdc, height)
 	if !found {
 		return sdkerrors.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
 	}
 
-	if err := merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath, value); err != nil {
-		return err
-	}
-
-	return nil
+	return VerifyMembershipProof(cdc, consensusState.GetRoot(), cs.ProofSpecs, proof, path, value)
 }
 
 // VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a specified height.
@@ -269,26 +298,66 @@ func (cs ClientState) VerifyNonMembership(
 		return err
 	}
 
-	var merkleProof commitmenttypes.MerkleProof
-	if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
-		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
-	}
-
-	merklePath, ok := path.(commitmenttypes.MerklePath)
-	if !ok {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
-	}
-
 	consensusState, found := GetConsensusState(clientStore, cdc, height)
 	if !found {
 		return sdkerrors.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
 	}
 
-	if err := merkleProof.VerifyNonMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath); err != nil {
+	return VerifyNonMembershipProof(cdc, consensusState.GetRoot(), cs.ProofSpecs, proof, path)
+}
+
+// VerifyMembershipProof verifies a proof of the existence of a value at the given CommitmentPath against the provided
+// commitment root using the provided proof specs. It performs the same proof verification as VerifyMembership without
+// requiring an sdk.Context or client store, allowing IBC proofs to be verified outside of a chain. The caller is
+// responsible for obtaining a trusted commitment root and for enforcing any delay period, since the delay period
+// verification depends on the time and height at which the consensus state was stored in the client store.
+func VerifyMembershipProof(
+	cdc codec.BinaryCodec,
+	root exported.Root,
+	proofSpecs []*ics23.ProofSpec,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	merkleProof, merklePath, err := decodeMerkleProofAndPath(cdc, proof, path)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	return merkleProof.VerifyMembership(proofSpecs, root, merklePath, value)
+}
+
+// VerifyNonMembershipProof verifies a proof of the absence of the given CommitmentPath against the provided commitment
+// root using the provided proof specs. It is the non-membership counterpart of VerifyMembershipProof.
+func VerifyNonMembershipProof(
+	cdc codec.BinaryCodec,
+	root exported.Root,
+	proofSpecs []*ics23.ProofSpec,
+	proof []byte,
+	path exported.Path,
+) error {
+	merkleProof, merklePath, err := decodeMerkleProofAndPath(cdc, proof, path)
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.VerifyNonMembership(proofSpecs, root, merklePath)
+}
+
+// decodeMerkleProofAndPath unmarshals the provided proof into an ICS 23 commitment merkle proof and
+// asserts the provided path is a merkle path.
+func decodeMerkleProofAndPath(cdc codec.BinaryCodec, proof []byte, path exported.Path) (commitmenttypes.MerkleProof, commitmenttypes.MerklePath, error) {
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+	}
+
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	return merkleProof, merklePath, nil
 }
 
 // verifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
@@ -331,3 +400,31 @@ func verifyDelayPeriodPassed(ctx sdk.Context, store sdk.KVStore, proofHeight exp
 
 	return nil
 }
+
+// validateProofSpecs returns an error if the proof specs cannot be used to verify proofs. The proof
+// specs are not required to equal the SDK proof specs, but each spec must define the leaf and inner
+// node formats, otherwise proof verification would fail on every proof or panic.
+func validateProofSpecs(proofSpecs []*ics23.ProofSpec) error {
+	if proofSpecs == nil {
+		return sdkerrors.Wrap(ErrInvalidProofSpecs, "proof specs cannot be nil for tm client")
+	}
+	for i, spec := range proofSpecs {
+		if spec == nil {
+			return sdkerrors.Wrapf(ErrInvalidProofSpecs, "proof spec cannot be nil at index: %d", i)
+		}
+		if spec.LeafSpec == nil {
+			return sdkerrors.Wrapf(ErrInvalidProofSpecs, "leaf spec cannot be nil at index: %d", i)
+		}
+		if spec.InnerSpec == nil {
+			return sdkerrors.Wrapf(ErrInvalidProofSpecs, "inner spec cannot be nil at index: %d", i)
+		}
+		if len(spec.InnerSpec.ChildOrder) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidProofSpecs, "inner spec child order cannot be empty at index: %d", i)
+		}
+		if spec.InnerSpec.ChildSize <= 0 {
+			return sdkerrors.Wrapf(ErrInvalidProofSpecs, "inner spec child size must be positive at index: %d", i)
+		}
+	}
+
+	return nil
+}
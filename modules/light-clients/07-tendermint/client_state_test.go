@@ -65,6 +65,21 @@ func (suite *TendermintTestSuite) TestStatus() {
 	}
 }
 
+// customProofSpecs returns proof specs which differ from the SDK proof specs in their maximum depth,
+// but which still verify the proofs of the stores of the testing chains.
+func customProofSpecs() []*ics23.ProofSpec {
+	iavlSpec, tmSpec := *ics23.IavlSpec, *ics23.TendermintSpec
+	iavlSpec.MaxDepth, tmSpec.MaxDepth = 64, 16
+	return []*ics23.ProofSpec{&iavlSpec, &tmSpec}
+}
+
+// setProofSpecs sets the proof specs of the client state of the endpoint.
+func setProofSpecs(endpoint *ibctesting.Endpoint, proofSpecs []*ics23.ProofSpec) {
+	clientState := endpoint.GetClientState().(*ibctm.ClientState)
+	clientState.ProofSpecs = proofSpecs
+	endpoint.SetClientState(clientState)
+}
+
 func (suite *TendermintTestSuite) TestValidate() {
 	testCases := []struct {
 		name        string
@@ -137,6 +152,24 @@ func (suite *TendermintTestSuite) TestValidate() {
 			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, -1, height, commitmenttypes.GetSDKSpecs(), upgradePath),
 			expPass:     false,
 		},
+		{
+			name: "valid max consensus states",
+			clientState: func() *ibctm.ClientState {
+				clientState := ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath)
+				clientState.MaxConsensusStates = 2
+				return clientState
+			}(),
+			expPass: true,
+		},
+		{
+			name: "invalid max consensus states of one",
+			clientState: func() *ibctm.ClientState {
+				clientState := ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath)
+				clientState.MaxConsensusStates = 1
+				return clientState
+			}(),
+			expPass: false,
+		},
 		{
 			name:        "invalid revision number",
 			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, clienttypes.NewHeight(1, 1), commitmenttypes.GetSDKSpecs(), upgradePath),
@@ -162,6 +195,35 @@ func (suite *TendermintTestSuite) TestValidate() {
 			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, ubdPeriod, ubdPeriod, maxClockDrift, height, []*ics23.ProofSpec{ics23.TendermintSpec, nil}, upgradePath),
 			expPass:     false,
 		},
+		{
+			name:        "valid client with custom proof specs",
+			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, customProofSpecs(), upgradePath),
+			expPass:     true,
+		},
+		{
+			name:        "proof spec without leaf spec",
+			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, []*ics23.ProofSpec{{InnerSpec: ics23.IavlSpec.InnerSpec}}, upgradePath),
+			expPass:     false,
+		},
+		{
+			name:        "proof spec without inner spec",
+			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, []*ics23.ProofSpec{{LeafSpec: ics23.IavlSpec.LeafSpec}}, upgradePath),
+			expPass:     false,
+		},
+		{
+			name: "inner spec without child order",
+			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, []*ics23.ProofSpec{
+				{LeafSpec: ics23.IavlSpec.LeafSpec, InnerSpec: &ics23.InnerSpec{ChildSize: 33, Hash: ics23.HashOp_SHA256}},
+			}, upgradePath),
+			expPass: false,
+		},
+		{
+			name: "inner spec with zero child size",
+			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, []*ics23.ProofSpec{
+				{LeafSpec: ics23.IavlSpec.LeafSpec, InnerSpec: &ics23.InnerSpec{ChildOrder: []int32{0, 1}, Hash: ics23.HashOp_SHA256}},
+			}, upgradePath),
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -174,6 +236,56 @@ func (suite *TendermintTestSuite) TestValidate() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestValidateTrustingPeriodFraction() {
+	// the trusting period is two thirds of the unbonding period
+	clientState := ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath)
+
+	testCases := []struct {
+		name     string
+		fraction sdk.Dec
+		expPass  bool
+	}{
+		{"zero fraction applies no restriction", sdk.ZeroDec(), true},
+		{"nil fraction applies no restriction", sdk.Dec{}, true},
+		{"trusting period is within the fraction", sdk.NewDecWithPrec(7, 1), true},
+		{"trusting period is equal to the fraction", sdk.NewDec(2).Quo(sdk.NewDec(3)).Add(sdk.SmallestDec()), true},
+		{"trusting period exceeds the fraction", sdk.NewDecWithPrec(6, 1), false},
+	}
+
+	for _, tc := range testCases {
+		err := clientState.ValidateTrustingPeriodFraction(tc.fraction)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().ErrorIs(err, ibctm.ErrInvalidTrustingPeriod, tc.name)
+		}
+	}
+}
+
+func (suite *TendermintTestSuite) TestValidateMaxClockDrift() {
+	clientState := ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath)
+
+	testCases := []struct {
+		name                 string
+		maxAllowedClockDrift time.Duration
+		expPass              bool
+	}{
+		{"zero maximum applies no restriction", 0, true},
+		{"max clock drift is within the maximum", maxClockDrift + time.Second, true},
+		{"max clock drift is equal to the maximum", maxClockDrift, true},
+		{"max clock drift exceeds the maximum", maxClockDrift - time.Second, false},
+	}
+
+	for _, tc := range testCases {
+		err := clientState.ValidateMaxClockDrift(tc.maxAllowedClockDrift)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().ErrorIs(err, ibctm.ErrInvalidMaxClockDrift, tc.name)
+		}
+	}
+}
+
 func (suite *TendermintTestSuite) TestInitialize() {
 	testCases := []struct {
 		name           string
@@ -207,6 +319,15 @@ func (suite *TendermintTestSuite) TestInitialize() {
 			suite.Require().Error(err, "invalid case didn't return an error")
 		}
 	}
+
+	// custom proof specs are accepted, proof specs which cannot verify proofs are rejected
+	tmClientState := clientState.(*ibctm.ClientState)
+	tmClientState.ProofSpecs = customProofSpecs()
+	suite.Require().NoError(tmClientState.Initialize(suite.chainA.GetContext(), suite.chainA.Codec, store, &ibctm.ConsensusState{}))
+
+	tmClientState.ProofSpecs = []*ics23.ProofSpec{{LeafSpec: ics23.IavlSpec.LeafSpec}}
+	err = tmClientState.Initialize(suite.chainA.GetContext(), suite.chainA.Codec, store, &ibctm.ConsensusState{})
+	suite.Require().ErrorIs(err, ibctm.ErrInvalidProofSpecs)
 }
 
 func (suite *TendermintTestSuite) TestVerifyMembership() {
@@ -409,6 +530,18 @@ func (suite *TendermintTestSuite) TestVerifyMembership() {
 				value = []byte("invalid value")
 			}, false,
 		},
+		{
+			"successful verification with custom proof specs", func() {
+				setProofSpecs(testingpath.EndpointA, customProofSpecs())
+			}, true,
+		},
+		{
+			"proof verification failed: proof specs do not match the counterparty store", func() {
+				proofSpecs := customProofSpecs()
+				proofSpecs[0].LeafSpec = &ics23.LeafOp{Hash: ics23.HashOp_SHA512, PrehashValue: ics23.HashOp_SHA256, Length: ics23.LengthOp_VAR_PROTO, Prefix: []byte{0}}
+				setProofSpecs(testingpath.EndpointA, proofSpecs)
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -458,6 +591,100 @@ func (suite *TendermintTestSuite) TestVerifyMembership() {
 	}
 }
 
+// TestVerifyMembershipProof tests the verification of membership proofs against a commitment
+// root without access to the client store.
+func (suite *TendermintTestSuite) TestVerifyMembershipProof() {
+	var (
+		root       exported.Root
+		proofSpecs []*ics23.ProofSpec
+		proof      []byte
+		path       exported.Path
+		value      []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid path type",
+			func() {
+				path = ibcmock.KeyPath{}
+			},
+			false,
+		},
+		{
+			"failed to unmarshal merkle proof",
+			func() {
+				proof = invalidProof
+			},
+			false,
+		},
+		{
+			"invalid commitment root",
+			func() {
+				root = commitmenttypes.NewMerkleRoot([]byte("invalid root"))
+			},
+			false,
+		},
+		{
+			"proof verification failed",
+			func() {
+				// change the value being proved
+				value = []byte("invalid value")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			testingpath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(testingpath)
+
+			key := host.FullClientStateKey(testingpath.EndpointB.ClientID)
+			merklePath := commitmenttypes.NewMerklePath(string(key))
+			var err error
+			path, err = commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), merklePath)
+			suite.Require().NoError(err)
+
+			var proofHeight exported.Height
+			proof, proofHeight = suite.chainB.QueryProof(key)
+
+			value, err = suite.chainB.Codec.MarshalInterface(testingpath.EndpointB.GetClientState())
+			suite.Require().NoError(err)
+
+			clientState := testingpath.EndpointA.GetClientState().(*ibctm.ClientState)
+			proofSpecs = clientState.GetProofSpecs()
+			root = testingpath.EndpointA.GetConsensusState(proofHeight).(*ibctm.ConsensusState).GetRoot()
+
+			tc.malleate()
+
+			err = ibctm.VerifyMembershipProof(suite.chainA.Codec, root, proofSpecs, proof, path, value)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				// the result matches the client state verification with no delay period
+				ctx := suite.chainA.GetContext()
+				store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, testingpath.EndpointA.ClientID)
+				suite.Require().NoError(clientState.VerifyMembership(ctx, store, suite.chainA.Codec, proofHeight, 0, 0, proof, path, value))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *TendermintTestSuite) TestVerifyNonMembership() {
 	var (
 		testingpath         *ibctesting.Path
@@ -620,6 +847,18 @@ func (suite *TendermintTestSuite) TestVerifyNonMembership() {
 				proof, proofHeight = suite.chainB.QueryProof(key)
 			}, false,
 		},
+		{
+			"successful verification with custom proof specs", func() {
+				setProofSpecs(testingpath.EndpointA, customProofSpecs())
+			}, true,
+		},
+		{
+			"verify non membership fails as proof specs do not match the counterparty store", func() {
+				proofSpecs := customProofSpecs()
+				proofSpecs[0].LeafSpec = &ics23.LeafOp{Hash: ics23.HashOp_SHA512, PrehashValue: ics23.HashOp_SHA256, Length: ics23.LengthOp_VAR_PROTO, Prefix: []byte{0}}
+				setProofSpecs(testingpath.EndpointA, proofSpecs)
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
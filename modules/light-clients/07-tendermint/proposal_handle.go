@@ -21,12 +21,36 @@ import (
 // The following must always be true:
 //   - The substitute client is the same type as the subject client
 //   - The subject and substitute client states match in all parameters (expect frozen height, latest height, and chain-id)
+//   - The substitute client latest height is greater than the subject client latest height
 //
 // In case 1) before updating the client, the client will be unfrozen by resetting
 // the FrozenHeight to the zero Height.
 func (cs ClientState) CheckSubstituteAndUpdateState(
 	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
 	substituteClientStore sdk.KVStore, substituteClient exported.ClientState,
+) error {
+	return cs.checkSubstituteAndUpdateState(ctx, cdc, subjectClientStore, substituteClientStore, substituteClient, false)
+}
+
+// CheckSubstituteAndUpdateStateWithMetadata performs the same checks and updates as
+// CheckSubstituteAndUpdateState. In addition, the processed time and processed height
+// of every consensus state stored by both the subject and the substitute client are
+// carried over from the substitute to the subject, so that delay period checks made
+// against those consensus states remain valid once the subject client is recovered.
+func (cs ClientState) CheckSubstituteAndUpdateStateWithMetadata(
+	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
+	substituteClientStore sdk.KVStore, substituteClient exported.ClientState,
+) error {
+	return cs.checkSubstituteAndUpdateState(ctx, cdc, subjectClientStore, substituteClientStore, substituteClient, true)
+}
+
+// checkSubstituteAndUpdateState updates the subject client with the state of the substitute.
+// The consensus metadata of consensus states stored by both clients is only carried over
+// if carryOverMetadata is true.
+func (cs ClientState) checkSubstituteAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
+	substituteClientStore sdk.KVStore, substituteClient exported.ClientState,
+	carryOverMetadata bool,
 ) error {
 	substituteClientState, ok := substituteClient.(*ClientState)
 	if !ok {
@@ -37,6 +61,10 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 		return sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "subject client state does not match substitute client state")
 	}
 
+	if cs.GetLatestHeight().GTE(substituteClientState.GetLatestHeight()) {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", cs.GetLatestHeight(), substituteClientState.GetLatestHeight())
+	}
+
 	if cs.Status(ctx, subjectClientStore, cdc) == exported.Frozen {
 		// unfreeze the client
 		cs.FrozenHeight = clienttypes.ZeroHeight()
@@ -66,6 +94,12 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 
 	setConsensusMetadataWithValues(subjectClientStore, height, processedHeight, processedTime)
 
+	if carryOverMetadata {
+		if err := carryOverConsensusMetadata(cdc, subjectClientStore, substituteClientStore); err != nil {
+			return err
+		}
+	}
+
 	cs.LatestHeight = substituteClientState.LatestHeight
 	cs.ChainId = substituteClientState.ChainId
 
@@ -79,6 +113,38 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 	return nil
 }
 
+// carryOverConsensusMetadata sets the processed time and processed height of every consensus
+// state stored by the substitute client on the subject client, if the subject client stores a
+// consensus state for the same height. Consensus states which are only stored by the substitute
+// client are not copied.
+func carryOverConsensusMetadata(cdc codec.BinaryCodec, subjectClientStore, substituteClientStore sdk.KVStore) error {
+	var err error
+	IterateConsensusStateAscending(substituteClientStore, func(height exported.Height) bool {
+		if _, found := GetConsensusState(subjectClientStore, cdc, height); !found {
+			return false
+		}
+
+		processedHeight, found := GetProcessedHeight(substituteClientStore, height)
+		if !found {
+			err = sdkerrors.Wrapf(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed height for substitute client height %s", height)
+			return true
+		}
+
+		processedTime, found := GetProcessedTime(substituteClientStore, height)
+		if !found {
+			err = sdkerrors.Wrapf(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed time for substitute client height %s", height)
+			return true
+		}
+
+		SetProcessedHeight(subjectClientStore, height, processedHeight)
+		SetProcessedTime(subjectClientStore, height, processedTime)
+
+		return false
+	})
+
+	return err
+}
+
 // IsMatchingClientState returns true if all the client state parameters match
 // except for frozen height, latest height, trusting period, chain-id.
 func IsMatchingClientState(subject, substitute ClientState) bool {
@@ -37,6 +37,16 @@ func (suite *TendermintTestSuite) TestCheckSubstituteUpdateStateBasic() {
 				tmClientState.ChainId = tmClientState.ChainId + "different chain"
 			},
 		},
+		{
+			"substitute latest height is lower than subject latest height", func() {
+				suite.coordinator.SetupClients(substitutePath)
+				tmClientState, ok := suite.chainA.GetClientState(substitutePath.EndpointA.ClientID).(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				tmClientState.LatestHeight = clienttypes.NewHeight(0, 1)
+				substituteClientState = tmClientState
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -165,6 +175,86 @@ func (suite *TendermintTestSuite) TestCheckSubstituteAndUpdateState() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestCheckSubstituteAndUpdateStateWithMetadata() {
+	const (
+		sharedProcessedTime = uint64(100)
+		sharedHeight        = 5
+	)
+
+	testCases := []struct {
+		name              string
+		carryOverMetadata bool
+	}{
+		{"consensus metadata is carried over", true},
+		{"consensus metadata is not carried over", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(subjectPath)
+			subjectClientState := suite.chainA.GetClientState(subjectPath.EndpointA.ClientID).(*ibctm.ClientState)
+			subjectHeight := subjectClientState.GetLatestHeight()
+			subjectClientState.FrozenHeight = frozenHeight
+
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(substitutePath)
+			substituteInitialHeight := substitutePath.EndpointA.GetClientState().GetLatestHeight()
+
+			err := substitutePath.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+			substituteClientState := suite.chainA.GetClientState(substitutePath.EndpointA.ClientID).(*ibctm.ClientState)
+
+			subjectClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), subjectPath.EndpointA.ClientID)
+			substituteClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), substitutePath.EndpointA.ClientID)
+
+			// store the subject consensus state on the substitute with different consensus metadata
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), substitutePath.EndpointA.ClientID, subjectHeight, subjectPath.EndpointA.GetConsensusState(subjectHeight))
+			ibctm.SetIterationKey(substituteClientStore, subjectHeight)
+			ibctm.SetProcessedTime(substituteClientStore, subjectHeight, sharedProcessedTime)
+			ibctm.SetProcessedHeight(substituteClientStore, subjectHeight, clienttypes.NewHeight(0, sharedHeight))
+
+			expProcessedTime, found := ibctm.GetProcessedTime(subjectClientStore, subjectHeight)
+			suite.Require().True(found)
+			expProcessedHeight, found := ibctm.GetProcessedHeight(subjectClientStore, subjectHeight)
+			suite.Require().True(found)
+
+			if tc.carryOverMetadata {
+				expProcessedTime = sharedProcessedTime
+				expProcessedHeight = clienttypes.NewHeight(0, sharedHeight)
+
+				err = subjectClientState.CheckSubstituteAndUpdateStateWithMetadata(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), subjectClientStore, substituteClientStore, substituteClientState)
+			} else {
+				err = subjectClientState.CheckSubstituteAndUpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), subjectClientStore, substituteClientStore, substituteClientState)
+			}
+			suite.Require().NoError(err)
+
+			updatedClient := subjectPath.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().Equal(clienttypes.ZeroHeight(), updatedClient.FrozenHeight)
+			suite.Require().Equal(substituteClientState.GetLatestHeight(), updatedClient.GetLatestHeight())
+			suite.Require().Equal(substitutePath.EndpointA.GetConsensusState(substituteClientState.GetLatestHeight()), subjectPath.EndpointA.GetConsensusState(updatedClient.GetLatestHeight()))
+
+			processedTime, found := ibctm.GetProcessedTime(subjectClientStore, subjectHeight)
+			suite.Require().True(found)
+			suite.Require().Equal(expProcessedTime, processedTime)
+
+			processedHeight, found := ibctm.GetProcessedHeight(subjectClientStore, subjectHeight)
+			suite.Require().True(found)
+			suite.Require().Equal(expProcessedHeight, processedHeight)
+
+			// consensus states only stored by the substitute are not copied
+			_, found = ibctm.GetConsensusState(subjectClientStore, suite.chainA.App.AppCodec(), substituteInitialHeight)
+			suite.Require().False(found)
+			_, found = ibctm.GetProcessedTime(subjectClientStore, substituteInitialHeight)
+			suite.Require().False(found)
+		})
+	}
+}
+
 func (suite *TendermintTestSuite) TestIsMatchingClientState() {
 	var (
 		subjectPath, substitutePath               *ibctesting.Path
@@ -63,6 +63,10 @@ type ClientState struct {
 	AllowUpdateAfterExpiry bool `protobuf:"varint,10,opt,name=allow_update_after_expiry,json=allowUpdateAfterExpiry,proto3" json:"allow_update_after_expiry,omitempty" yaml:"allow_update_after_expiry"` // Deprecated: Do not use.
 	// allow_update_after_misbehaviour is deprecated
 	AllowUpdateAfterMisbehaviour bool `protobuf:"varint,11,opt,name=allow_update_after_misbehaviour,json=allowUpdateAfterMisbehaviour,proto3" json:"allow_update_after_misbehaviour,omitempty" yaml:"allow_update_after_misbehaviour"` // Deprecated: Do not use.
+	// max_consensus_states is the maximum number of consensus states stored for the client. Once exceeded,
+	// the oldest consensus states are pruned on each client update. A value of zero disables the limit,
+	// in which case consensus states are only pruned once they expire.
+	MaxConsensusStates uint64 `protobuf:"varint,12,opt,name=max_consensus_states,json=maxConsensusStates,proto3" json:"max_consensus_states,omitempty" yaml:"max_consensus_states"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -323,75 +327,77 @@ func init() {
 }
 
 var fileDescriptor_c6d6cf2b288949be = []byte{
-	// 1080 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x6e, 0xda, 0xb2, 0x4d, 0x26, 0xe9, 0xb6, 0x98, 0xd2, 0x4d, 0x4b, 0x37, 0x8e, 0x8c, 0x54,
-	0x72, 0xa0, 0x36, 0x49, 0x11, 0x48, 0x85, 0x0b, 0xee, 0x82, 0xda, 0x15, 0x2b, 0x55, 0x2e, 0x3f,
-	0x24, 0x24, 0x64, 0x26, 0xf6, 0x24, 0x19, 0xad, 0xed, 0xb1, 0x3c, 0x93, 0xd0, 0xf2, 0x17, 0xc0,
-	0x89, 0x3d, 0x22, 0x4e, 0x1c, 0xf8, 0x63, 0xf6, 0xd8, 0x23, 0x27, 0x83, 0xda, 0x2b, 0xa7, 0x1c,
-	0x39, 0xa1, 0xf9, 0x61, 0x7b, 0xda, 0xed, 0x52, 0x2d, 0x97, 0x68, 0xde, 0x7b, 0xdf, 0xfb, 0xbe,
-	0xcc, 0xbc, 0x37, 0x6f, 0x0c, 0x1c, 0x3c, 0x0c, 0x9c, 0x08, 0x8f, 0x27, 0x2c, 0x88, 0x30, 0x4a,
-	0x18, 0x75, 0x18, 0x4a, 0x42, 0x94, 0xc5, 0x38, 0x61, 0xce, 0xac, 0xaf, 0x59, 0x76, 0x9a, 0x11,
-	0x46, 0x8c, 0x0e, 0x1e, 0x06, 0xb6, 0x9e, 0x60, 0x6b, 0x90, 0x59, 0x7f, 0xbb, 0xab, 0xe5, 0xb3,
-	0xf3, 0x14, 0x51, 0x67, 0x06, 0x23, 0x1c, 0x42, 0x46, 0x32, 0xc9, 0xb0, 0xbd, 0xf3, 0x02, 0x42,
-	0xfc, 0xaa, 0x68, 0x2b, 0xcd, 0x08, 0x19, 0x15, 0x56, 0x67, 0x4c, 0xc8, 0x38, 0x42, 0x8e, 0xb0,
-	0x86, 0xd3, 0x91, 0x13, 0x4e, 0x33, 0xc8, 0x30, 0x49, 0x54, 0xdc, 0xbc, 0x19, 0x67, 0x38, 0x46,
-	0x94, 0xc1, 0x38, 0x2d, 0x00, 0x7c, 0x7f, 0x01, 0xc9, 0x90, 0x23, 0xff, 0x2e, 0xdf, 0x93, 0x5c,
-	0x29, 0xc0, 0x3b, 0x15, 0x80, 0xc4, 0x31, 0x66, 0x71, 0x01, 0x2a, 0x2d, 0x05, 0xdc, 0x18, 0x93,
-	0x31, 0x11, 0x4b, 0x87, 0xaf, 0xa4, 0xd7, 0xfa, 0x7b, 0x05, 0x34, 0x0f, 0x05, 0xdf, 0x29, 0x83,
-	0x0c, 0x19, 0x5b, 0xa0, 0x1e, 0x4c, 0x20, 0x4e, 0x7c, 0x1c, 0xb6, 0x6b, 0xdd, 0x5a, 0xaf, 0xe1,
-	0xad, 0x08, 0xfb, 0x38, 0x34, 0x10, 0x68, 0xb2, 0x6c, 0x4a, 0x99, 0x1f, 0xa1, 0x19, 0x8a, 0xda,
-	0x8b, 0xdd, 0x5a, 0xaf, 0x39, 0xe8, 0xd9, 0xff, 0x7d, 0x9e, 0xf6, 0x67, 0x19, 0x0c, 0xf8, 0x86,
-	0xdd, 0xed, 0xe7, 0xb9, 0xb9, 0x30, 0xcf, 0x4d, 0xe3, 0x1c, 0xc6, 0xd1, 0x81, 0xa5, 0x51, 0x59,
-	0x1e, 0x10, 0xd6, 0xe7, 0xdc, 0x30, 0x46, 0x60, 0x4d, 0x58, 0x38, 0x19, 0xfb, 0x29, 0xca, 0x30,
-	0x09, 0xdb, 0x4b, 0x42, 0x6a, 0xcb, 0x96, 0x87, 0x65, 0x17, 0x87, 0x65, 0x3f, 0x52, 0x87, 0xe9,
-	0x5a, 0x8a, 0x7b, 0x53, 0xe3, 0xae, 0xf2, 0xad, 0x5f, 0xfe, 0x34, 0x6b, 0xde, 0xfd, 0xc2, 0x7b,
-	0x22, 0x9c, 0x06, 0x06, 0xeb, 0xd3, 0x64, 0x48, 0x92, 0x50, 0x13, 0x5a, 0xbe, 0x4b, 0xe8, 0x6d,
-	0x25, 0xf4, 0x40, 0x0a, 0xdd, 0x24, 0x90, 0x4a, 0x6b, 0xa5, 0x5b, 0x49, 0x21, 0xb0, 0x16, 0xc3,
-	0x33, 0x3f, 0x88, 0x48, 0xf0, 0xd4, 0x0f, 0x33, 0x3c, 0x62, 0xed, 0xd7, 0x5e, 0x71, 0x4b, 0x37,
-	0xf2, 0xa5, 0xd0, 0x6a, 0x0c, 0xcf, 0x0e, 0xb9, 0xf3, 0x11, 0xf7, 0x19, 0xdf, 0x82, 0xd5, 0x51,
-	0x46, 0x7e, 0x40, 0x89, 0x3f, 0x41, 0xbc, 0x20, 0xed, 0x7b, 0x42, 0x64, 0x5b, 0x94, 0x88, 0xb7,
-	0x88, 0xad, 0x3a, 0x67, 0xd6, 0xb7, 0x8f, 0x04, 0xc2, 0xdd, 0x51, 0x2a, 0x1b, 0x52, 0xe5, 0x5a,
-	0xba, 0xe5, 0xb5, 0xa4, 0x2d, 0xb1, 0x9c, 0x3e, 0x82, 0x0c, 0x51, 0x56, 0xd0, 0xaf, 0xbc, 0x2a,
-	0xfd, 0xb5, 0x74, 0xcb, 0x6b, 0x49, 0x5b, 0xd1, 0x1f, 0x83, 0xa6, 0xb8, 0x3a, 0x3e, 0x4d, 0x51,
-	0x40, 0xdb, 0xf5, 0xee, 0x52, 0xaf, 0x39, 0x58, 0xb7, 0x71, 0x40, 0x07, 0xfb, 0xf6, 0x09, 0x8f,
-	0x9c, 0xa6, 0x28, 0x70, 0x37, 0xab, 0x16, 0xd2, 0xe0, 0x96, 0x07, 0xd2, 0x02, 0x42, 0x8d, 0x03,
-	0xd0, 0x9a, 0xa6, 0xe3, 0x0c, 0x86, 0xc8, 0x4f, 0x21, 0x9b, 0xb4, 0x1b, 0xdd, 0xa5, 0x5e, 0xc3,
-	0x7d, 0x30, 0xcf, 0xcd, 0x37, 0x54, 0xdd, 0xb4, 0xa8, 0xe5, 0x35, 0x95, 0x79, 0x02, 0xd9, 0xc4,
-	0x80, 0x60, 0x0b, 0x46, 0x11, 0xf9, 0xde, 0x9f, 0xa6, 0x21, 0x64, 0xc8, 0x87, 0x23, 0x86, 0x32,
-	0x1f, 0x9d, 0xa5, 0x38, 0x3b, 0x6f, 0x83, 0x6e, 0xad, 0x57, 0x77, 0x77, 0xe7, 0xb9, 0xd9, 0x95,
-	0x44, 0x2f, 0x85, 0x5a, 0xed, 0x9a, 0xb7, 0x29, 0xa2, 0x5f, 0x8a, 0xe0, 0x27, 0x3c, 0xf6, 0xa9,
-	0x08, 0x19, 0x14, 0x98, 0xb7, 0xe4, 0xc5, 0x98, 0x0e, 0xd1, 0x04, 0xce, 0x30, 0x99, 0x66, 0xed,
-	0xa6, 0x10, 0x7a, 0x77, 0x9e, 0x9b, 0xbb, 0x2f, 0x15, 0xd2, 0x13, 0xb8, 0xdc, 0xce, 0x4d, 0xb9,
-	0x27, 0x1a, 0xe0, 0x60, 0xf9, 0xc7, 0xdf, 0xcc, 0x05, 0xeb, 0xf7, 0x45, 0x70, 0xff, 0x90, 0x24,
-	0x14, 0x25, 0x74, 0x4a, 0xe5, 0x8d, 0x77, 0x41, 0xa3, 0x1c, 0x3a, 0xe2, 0xca, 0xf3, 0x92, 0xde,
-	0x6c, 0xcb, 0x2f, 0x0a, 0x84, 0x5b, 0xe7, 0x25, 0x7d, 0xc6, 0xbb, 0xaf, 0x4a, 0x33, 0x3e, 0x06,
-	0xcb, 0x19, 0x21, 0x4c, 0xcd, 0x04, 0x4b, 0xeb, 0x88, 0x6a, 0x0a, 0xcd, 0xfa, 0xf6, 0x13, 0x94,
-	0x3d, 0x8d, 0x90, 0x47, 0x08, 0x73, 0x97, 0x39, 0x8d, 0x27, 0xb2, 0x8c, 0x9f, 0x6a, 0x60, 0x23,
-	0x41, 0x67, 0xcc, 0x2f, 0x27, 0x2d, 0xf5, 0x27, 0x90, 0x4e, 0xc4, 0xbd, 0x6f, 0xb9, 0x5f, 0xcf,
-	0x73, 0xf3, 0x2d, 0x79, 0x0a, 0xb7, 0xa1, 0xac, 0x7f, 0x72, 0xf3, 0xfd, 0x31, 0x66, 0x93, 0xe9,
-	0x90, 0xcb, 0xe9, 0xf3, 0x5f, 0x5b, 0x46, 0x78, 0x48, 0x9d, 0xe1, 0x39, 0x43, 0xd4, 0x3e, 0x42,
-	0x67, 0x2e, 0x5f, 0x78, 0x06, 0xa7, 0xfb, 0xaa, 0x64, 0x3b, 0x82, 0x74, 0xa2, 0x8e, 0xe9, 0xe7,
-	0x45, 0xd0, 0xd2, 0x4f, 0xcf, 0xd8, 0x07, 0x0d, 0xd9, 0xdc, 0xe5, 0x5c, 0x14, 0x8d, 0xb8, 0x2e,
-	0xff, 0x56, 0x19, 0xe2, 0x65, 0xa8, 0x4b, 0xeb, 0x38, 0x34, 0x20, 0xa8, 0x4f, 0x10, 0x0c, 0x51,
-	0xe6, 0xf7, 0xd5, 0xc9, 0xec, 0xde, 0x35, 0x2d, 0x8f, 0x04, 0xde, 0xed, 0x5c, 0xe6, 0xe6, 0x8a,
-	0x5c, 0xf7, 0xe7, 0xb9, 0xb9, 0x26, 0x65, 0x0a, 0x32, 0xcb, 0x5b, 0x91, 0xcb, 0xbe, 0x26, 0x31,
-	0x50, 0x53, 0xf2, 0x7f, 0x48, 0x0c, 0x5e, 0x90, 0x18, 0x94, 0x12, 0x03, 0x75, 0x22, 0xbf, 0x2e,
-	0x81, 0x7b, 0x12, 0x6d, 0x40, 0xb0, 0x4a, 0xf1, 0x38, 0x41, 0xa1, 0x2f, 0x21, 0xaa, 0x69, 0x3a,
-	0xba, 0x8e, 0x7c, 0x11, 0x4f, 0x05, 0x4c, 0x09, 0xee, 0x5c, 0xe4, 0x66, 0xad, 0x9a, 0x05, 0xd7,
-	0x28, 0x2c, 0xaf, 0x45, 0x35, 0x2c, 0x1f, 0x35, 0x65, 0x95, 0x7d, 0x8a, 0x8a, 0xc6, 0xba, 0x45,
-	0xa2, 0x2c, 0xdf, 0x29, 0x62, 0x6e, 0xbb, 0xa2, 0xbf, 0x96, 0x6e, 0x79, 0xad, 0x99, 0x86, 0x33,
-	0xbe, 0x03, 0xf2, 0x31, 0x10, 0xfa, 0x62, 0x94, 0x2d, 0xdd, 0x39, 0xca, 0x1e, 0xaa, 0x51, 0xf6,
-	0xa6, 0xf6, 0xc4, 0x94, 0xf9, 0x96, 0xb7, 0xaa, 0x1c, 0x6a, 0x98, 0x45, 0xc0, 0x28, 0x10, 0x55,
-	0xbb, 0xaa, 0xe7, 0xe5, 0xae, 0x5d, 0x3c, 0x9c, 0xe7, 0xe6, 0xd6, 0x75, 0x95, 0x8a, 0xc3, 0xf2,
-	0x5e, 0x57, 0xce, 0xaa, 0x71, 0xad, 0xc7, 0xa0, 0x5e, 0x3c, 0xb3, 0xc6, 0x0e, 0x68, 0x24, 0xd3,
-	0x18, 0x65, 0x3c, 0x22, 0x2a, 0xb3, 0xec, 0x55, 0x0e, 0xa3, 0x0b, 0x9a, 0x21, 0x4a, 0x48, 0x8c,
-	0x13, 0x11, 0x5f, 0x14, 0x71, 0xdd, 0xe5, 0x86, 0xcf, 0x2f, 0x3b, 0xb5, 0x8b, 0xcb, 0x4e, 0xed,
-	0xaf, 0xcb, 0x4e, 0xed, 0xd9, 0x55, 0x67, 0xe1, 0xe2, 0xaa, 0xb3, 0xf0, 0xc7, 0x55, 0x67, 0xe1,
-	0x9b, 0xc7, 0xda, 0x25, 0x0b, 0x08, 0x8d, 0x09, 0xe5, 0x1f, 0x5f, 0x7b, 0x63, 0xe2, 0xcc, 0x3e,
-	0x70, 0x62, 0x12, 0x4e, 0x23, 0x44, 0xe5, 0xa7, 0xd8, 0x5e, 0xf1, 0x2d, 0xf6, 0xde, 0x87, 0x7b,
-	0xd5, 0x5e, 0x3f, 0xaa, 0x96, 0xc3, 0x7b, 0x62, 0xb2, 0xec, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0xb5, 0x6c, 0x98, 0x6a, 0xbf, 0x09, 0x00, 0x00,
+	// 1105 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x9b, 0xb0, 0x4d, 0x26, 0xe9, 0xb6, 0x98, 0xd2, 0x75, 0x4b, 0x37, 0x8e, 0x8c, 0x54,
+	0x72, 0xa0, 0x36, 0x49, 0x11, 0x48, 0x85, 0x0b, 0xde, 0x05, 0xb5, 0x2b, 0x56, 0x2a, 0x2e, 0x7f,
+	0x24, 0x24, 0x64, 0x26, 0xf6, 0x24, 0x19, 0xad, 0xed, 0xb1, 0x3c, 0x93, 0xd0, 0xf2, 0x09, 0xe0,
+	0xc4, 0x1e, 0x11, 0x27, 0x0e, 0x7c, 0x0f, 0xae, 0x7b, 0xec, 0x91, 0x53, 0x40, 0xed, 0x37, 0xc8,
+	0x91, 0x13, 0x9a, 0x3f, 0x8e, 0x9d, 0x6c, 0x97, 0xb2, 0x5c, 0xa2, 0x79, 0xef, 0xfd, 0xde, 0xef,
+	0x97, 0x99, 0xf7, 0xe6, 0x8d, 0x81, 0x83, 0xfb, 0x81, 0x13, 0xe1, 0xe1, 0x88, 0x05, 0x11, 0x46,
+	0x09, 0xa3, 0x0e, 0x43, 0x49, 0x88, 0xb2, 0x18, 0x27, 0xcc, 0x99, 0x74, 0x4b, 0x96, 0x9d, 0x66,
+	0x84, 0x11, 0xbd, 0x85, 0xfb, 0x81, 0x5d, 0x4e, 0xb0, 0x4b, 0x90, 0x49, 0x77, 0xb7, 0x5d, 0xca,
+	0x67, 0x17, 0x29, 0xa2, 0xce, 0x04, 0x46, 0x38, 0x84, 0x8c, 0x64, 0x92, 0x61, 0x77, 0xef, 0x39,
+	0x84, 0xf8, 0x55, 0xd1, 0x66, 0x9a, 0x11, 0x32, 0xc8, 0xad, 0xd6, 0x90, 0x90, 0x61, 0x84, 0x1c,
+	0x61, 0xf5, 0xc7, 0x03, 0x27, 0x1c, 0x67, 0x90, 0x61, 0x92, 0xa8, 0xb8, 0xb9, 0x1c, 0x67, 0x38,
+	0x46, 0x94, 0xc1, 0x38, 0xcd, 0x01, 0x7c, 0x7f, 0x01, 0xc9, 0x90, 0x23, 0xff, 0x2e, 0xdf, 0x93,
+	0x5c, 0x29, 0xc0, 0x5b, 0x05, 0x80, 0xc4, 0x31, 0x66, 0x71, 0x0e, 0x9a, 0x5b, 0x0a, 0xb8, 0x35,
+	0x24, 0x43, 0x22, 0x96, 0x0e, 0x5f, 0x49, 0xaf, 0xf5, 0x7b, 0x0d, 0x34, 0x1e, 0x08, 0xbe, 0x33,
+	0x06, 0x19, 0xd2, 0x77, 0x40, 0x2d, 0x18, 0x41, 0x9c, 0xf8, 0x38, 0x34, 0xb4, 0xb6, 0xd6, 0xa9,
+	0x7b, 0x6b, 0xc2, 0x3e, 0x09, 0x75, 0x04, 0x1a, 0x2c, 0x1b, 0x53, 0xe6, 0x47, 0x68, 0x82, 0x22,
+	0x63, 0xb5, 0xad, 0x75, 0x1a, 0xbd, 0x8e, 0xfd, 0xef, 0xe7, 0x69, 0x7f, 0x92, 0xc1, 0x80, 0x6f,
+	0xd8, 0xdd, 0x7d, 0x36, 0x35, 0x57, 0x66, 0x53, 0x53, 0xbf, 0x80, 0x71, 0x74, 0x64, 0x95, 0xa8,
+	0x2c, 0x0f, 0x08, 0xeb, 0x53, 0x6e, 0xe8, 0x03, 0xb0, 0x21, 0x2c, 0x9c, 0x0c, 0xfd, 0x14, 0x65,
+	0x98, 0x84, 0x46, 0x45, 0x48, 0xed, 0xd8, 0xf2, 0xb0, 0xec, 0xfc, 0xb0, 0xec, 0x87, 0xea, 0x30,
+	0x5d, 0x4b, 0x71, 0x6f, 0x97, 0xb8, 0x8b, 0x7c, 0xeb, 0xe7, 0x3f, 0x4d, 0xcd, 0xbb, 0x9b, 0x7b,
+	0x4f, 0x85, 0x53, 0xc7, 0x60, 0x73, 0x9c, 0xf4, 0x49, 0x12, 0x96, 0x84, 0xaa, 0xb7, 0x09, 0xbd,
+	0xa9, 0x84, 0xee, 0x49, 0xa1, 0x65, 0x02, 0xa9, 0xb4, 0x31, 0x77, 0x2b, 0x29, 0x04, 0x36, 0x62,
+	0x78, 0xee, 0x07, 0x11, 0x09, 0x9e, 0xf8, 0x61, 0x86, 0x07, 0xcc, 0x78, 0xe5, 0x25, 0xb7, 0xb4,
+	0x94, 0x2f, 0x85, 0xd6, 0x63, 0x78, 0xfe, 0x80, 0x3b, 0x1f, 0x72, 0x9f, 0xfe, 0x0d, 0x58, 0x1f,
+	0x64, 0xe4, 0x7b, 0x94, 0xf8, 0x23, 0xc4, 0x0b, 0x62, 0xdc, 0x11, 0x22, 0xbb, 0xa2, 0x44, 0xbc,
+	0x45, 0x6c, 0xd5, 0x39, 0x93, 0xae, 0x7d, 0x2c, 0x10, 0xee, 0x9e, 0x52, 0xd9, 0x92, 0x2a, 0x0b,
+	0xe9, 0x96, 0xd7, 0x94, 0xb6, 0xc4, 0x72, 0xfa, 0x08, 0x32, 0x44, 0x59, 0x4e, 0xbf, 0xf6, 0xb2,
+	0xf4, 0x0b, 0xe9, 0x96, 0xd7, 0x94, 0xb6, 0xa2, 0x3f, 0x01, 0x0d, 0x71, 0x75, 0x7c, 0x9a, 0xa2,
+	0x80, 0x1a, 0xb5, 0x76, 0xa5, 0xd3, 0xe8, 0x6d, 0xda, 0x38, 0xa0, 0xbd, 0x43, 0xfb, 0x94, 0x47,
+	0xce, 0x52, 0x14, 0xb8, 0xdb, 0x45, 0x0b, 0x95, 0xe0, 0x96, 0x07, 0xd2, 0x1c, 0x42, 0xf5, 0x23,
+	0xd0, 0x1c, 0xa7, 0xc3, 0x0c, 0x86, 0xc8, 0x4f, 0x21, 0x1b, 0x19, 0xf5, 0x76, 0xa5, 0x53, 0x77,
+	0xef, 0xcd, 0xa6, 0xe6, 0x6b, 0xaa, 0x6e, 0xa5, 0xa8, 0xe5, 0x35, 0x94, 0x79, 0x0a, 0xd9, 0x48,
+	0x87, 0x60, 0x07, 0x46, 0x11, 0xf9, 0xce, 0x1f, 0xa7, 0x21, 0x64, 0xc8, 0x87, 0x03, 0x86, 0x32,
+	0x1f, 0x9d, 0xa7, 0x38, 0xbb, 0x30, 0x40, 0x5b, 0xeb, 0xd4, 0xdc, 0xfd, 0xd9, 0xd4, 0x6c, 0x4b,
+	0xa2, 0x17, 0x42, 0x2d, 0x43, 0xf3, 0xb6, 0x45, 0xf4, 0x0b, 0x11, 0xfc, 0x88, 0xc7, 0x3e, 0x16,
+	0x21, 0x9d, 0x02, 0xf3, 0x86, 0xbc, 0x18, 0xd3, 0x3e, 0x1a, 0xc1, 0x09, 0x26, 0xe3, 0xcc, 0x68,
+	0x08, 0xa1, 0xb7, 0x67, 0x53, 0x73, 0xff, 0x85, 0x42, 0xe5, 0x04, 0x2e, 0xb7, 0xb7, 0x2c, 0xf7,
+	0xb8, 0x04, 0xd0, 0x3f, 0x03, 0x5b, 0xa2, 0x87, 0x48, 0x42, 0x51, 0x42, 0xc7, 0xd4, 0xa7, 0xfc,
+	0xbe, 0x53, 0xa3, 0xd9, 0xd6, 0x3a, 0x55, 0xd7, 0x9c, 0x4d, 0xcd, 0x37, 0x4a, 0x9d, 0xb6, 0x84,
+	0xb2, 0x3c, 0x9d, 0xb7, 0x5a, 0xee, 0x15, 0xa3, 0x82, 0x1e, 0x55, 0x7f, 0xf8, 0xd5, 0x5c, 0xb1,
+	0x7e, 0x5b, 0x05, 0x77, 0x17, 0x23, 0xba, 0x0b, 0xea, 0xf3, 0x39, 0x66, 0x68, 0xaa, 0x4b, 0x96,
+	0x3b, 0xfd, 0xf3, 0x1c, 0xe1, 0xd6, 0x78, 0x97, 0x3c, 0xe5, 0x0d, 0x5d, 0xa4, 0xe9, 0x1f, 0x82,
+	0x6a, 0x46, 0x08, 0x53, 0x63, 0xc6, 0x2a, 0x35, 0x59, 0x31, 0xd8, 0x26, 0x5d, 0xfb, 0x31, 0xca,
+	0x9e, 0x44, 0xc8, 0x23, 0x84, 0xb9, 0x55, 0x4e, 0xe3, 0x89, 0x2c, 0xfd, 0x47, 0x0d, 0x6c, 0x25,
+	0xe8, 0x9c, 0xf9, 0xf3, 0xe1, 0x4d, 0xfd, 0x11, 0xa4, 0x23, 0x31, 0x4a, 0x9a, 0xee, 0x57, 0xc5,
+	0x76, 0x6f, 0x42, 0x59, 0x7f, 0x4f, 0xcd, 0x77, 0x87, 0x98, 0x8d, 0xc6, 0x7d, 0x2e, 0x57, 0x7e,
+	0x52, 0x4a, 0xcb, 0x08, 0xf7, 0xa9, 0xd3, 0xbf, 0x60, 0x88, 0xda, 0xc7, 0xe8, 0xdc, 0xe5, 0x0b,
+	0x4f, 0xe7, 0x74, 0x5f, 0xce, 0xd9, 0x8e, 0x21, 0x1d, 0xa9, 0x63, 0xfa, 0x69, 0x15, 0x34, 0x17,
+	0x0a, 0x72, 0x08, 0xea, 0xf2, 0xbe, 0xcc, 0x47, 0xad, 0xe8, 0xed, 0x4d, 0xf9, 0xb7, 0xe6, 0x21,
+	0x5e, 0xd9, 0x9a, 0xb4, 0x4e, 0x42, 0x1d, 0x82, 0xda, 0x08, 0xc1, 0x10, 0x65, 0x7e, 0x57, 0x9d,
+	0xcc, 0xfe, 0x6d, 0x03, 0xf8, 0x58, 0xe0, 0xdd, 0xd6, 0xd5, 0xd4, 0x5c, 0x93, 0xeb, 0xee, 0x6c,
+	0x6a, 0x6e, 0x48, 0x99, 0x9c, 0xcc, 0xf2, 0xd6, 0xe4, 0xb2, 0x5b, 0x92, 0xe8, 0x19, 0x95, 0xff,
+	0x2b, 0xd1, 0x7b, 0x4e, 0xa2, 0x37, 0x97, 0xe8, 0xa9, 0x13, 0xf9, 0xa5, 0x02, 0xee, 0x48, 0xb4,
+	0x0e, 0xc1, 0x3a, 0xc5, 0xc3, 0x04, 0x85, 0xbe, 0x84, 0xa8, 0xa6, 0x69, 0x95, 0x75, 0xe4, 0x23,
+	0x7b, 0x26, 0x60, 0x4a, 0x70, 0xef, 0x72, 0x6a, 0x6a, 0xc5, 0x78, 0x59, 0xa0, 0xb0, 0xbc, 0x26,
+	0x2d, 0x61, 0xf9, 0xf4, 0x9a, 0x57, 0xd9, 0xa7, 0x28, 0x6f, 0xac, 0x1b, 0x24, 0xe6, 0xe5, 0x3b,
+	0x43, 0xcc, 0x35, 0x0a, 0xfa, 0x85, 0x74, 0xcb, 0x6b, 0x4e, 0x4a, 0x38, 0xfd, 0x5b, 0x20, 0xdf,
+	0x17, 0xa1, 0x2f, 0xa6, 0x63, 0xe5, 0xd6, 0xe9, 0x78, 0x5f, 0x4d, 0xc7, 0xd7, 0x4b, 0xaf, 0xd6,
+	0x3c, 0xdf, 0xf2, 0xd6, 0x95, 0x43, 0xcd, 0xc7, 0x08, 0xe8, 0x39, 0xa2, 0x68, 0x57, 0xa3, 0xfa,
+	0x9f, 0x76, 0x71, 0x7f, 0x36, 0x35, 0x77, 0x16, 0x55, 0x0a, 0x0e, 0xcb, 0x7b, 0x55, 0x39, 0x8b,
+	0xc6, 0xb5, 0x1e, 0x81, 0x5a, 0xfe, 0x72, 0xeb, 0x7b, 0xa0, 0x9e, 0x8c, 0x63, 0x94, 0xf1, 0x88,
+	0xa8, 0x4c, 0xd5, 0x2b, 0x1c, 0x7a, 0x1b, 0x34, 0x42, 0x94, 0x90, 0x18, 0x27, 0x22, 0xbe, 0x2a,
+	0xe2, 0x65, 0x97, 0x1b, 0x3e, 0xbb, 0x6a, 0x69, 0x97, 0x57, 0x2d, 0xed, 0xaf, 0xab, 0x96, 0xf6,
+	0xf4, 0xba, 0xb5, 0x72, 0x79, 0xdd, 0x5a, 0xf9, 0xe3, 0xba, 0xb5, 0xf2, 0xf5, 0xa3, 0xd2, 0x25,
+	0x0b, 0x08, 0x8d, 0x09, 0xe5, 0xdf, 0x73, 0x07, 0x43, 0xe2, 0x4c, 0xde, 0x73, 0x62, 0x12, 0x8e,
+	0x23, 0x44, 0xe5, 0xd7, 0xdd, 0x41, 0xfe, 0x79, 0xf7, 0xce, 0xfb, 0x07, 0xc5, 0x5e, 0x3f, 0x28,
+	0x96, 0xfd, 0x3b, 0x62, 0xb2, 0x1c, 0xfe, 0x33, 0x00, 0x5a, 0xfe, 0xb8, 0x05, 0x12, 0x0a, 0x00,
+	0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -414,6 +420,11 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxConsensusStates != 0 {
+		i = encodeVarintTendermint(dAtA, i, uint64(m.MaxConsensusStates))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.AllowUpdateAfterMisbehaviour {
 		i--
 		if m.AllowUpdateAfterMisbehaviour {
@@ -776,6 +787,9 @@ func (m *ClientState) Size() (n int) {
 	if m.AllowUpdateAfterMisbehaviour {
 		n += 2
 	}
+	if m.MaxConsensusStates != 0 {
+		n += 1 + sovTendermint(uint64(m.MaxConsensusStates))
+	}
 	return n
 }
 
@@ -1226,6 +1240,25 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUpdateAfterMisbehaviour = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsensusStates", wireType)
+			}
+			m.MaxConsensusStates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsensusStates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTendermint(dAtA[iNdEx:])
@@ -3,7 +3,6 @@ package tendermint
 import (
 	"bytes"
 	"fmt"
-	"reflect"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -125,11 +124,13 @@ func (cs *ClientState) verifyHeader(
 // If we are updating to a past height, a consensus state is created for that height to be persisted in client store
 // If we are updating to a future height, the consensus state is created and the client state is updated to reflect
 // the new latest height
-// A list containing the updated consensus height is returned.
+// A list containing the updated consensus height is returned. An error is returned if a consensus state
+// conflicting with the header is already stored at the header height.
 // UpdateState must only be used to update within a single revision, thus header revision number and trusted height's revision
 // number must be the same. To update to a new revision, use a separate upgrade path
-// UpdateState will prune the oldest consensus state if it is expired.
-func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) []exported.Height {
+// UpdateState will prune the oldest consensus state if it is expired. If MaxConsensusStates is set, the oldest
+// consensus states are additionally pruned once the number of stored consensus states exceeds it.
+func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) ([]exported.Height, error) {
 	header, ok := clientMsg.(*Header)
 	if !ok {
 		panic(fmt.Errorf("expected type %T, got %T", &Header{}, clientMsg))
@@ -137,8 +138,13 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 
 	// check for duplicate update
 	if consensusState, _ := GetConsensusState(clientStore, cdc, header.GetHeight()); consensusState != nil {
+		// a conflicting header must be handled by CheckForMisbehaviour prior to calling UpdateState
+		if conflictsWithConsensusState(header, consensusState) {
+			return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "header conflicts with the consensus state stored at height %s", header.GetHeight())
+		}
+
 		// perform no-op
-		return []exported.Height{header.GetHeight()}
+		return []exported.Height{header.GetHeight()}, nil
 	}
 
 	cs.pruneOldestConsensusState(ctx, cdc, clientStore)
@@ -159,7 +165,9 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 	setConsensusState(clientStore, cdc, consensusState, header.GetHeight())
 	setConsensusMetadata(ctx, clientStore, header.GetHeight())
 
-	return []exported.Height{height}
+	cs.pruneExcessConsensusStates(clientStore, height)
+
+	return []exported.Height{height}, nil
 }
 
 // pruneOldestConsensusState will retrieve the earliest consensus state for this clientID and check if it is expired. If it is,
@@ -195,6 +203,40 @@ func (cs ClientState) pruneOldestConsensusState(ctx sdk.Context, cdc codec.Binar
 	}
 }
 
+// pruneExcessConsensusStates prunes the oldest consensus states, along with all associated metadata, until no more than
+// MaxConsensusStates consensus states are stored for the client. The latest consensus state and the consensus state
+// created at the provided update height are never pruned. No consensus states are pruned if MaxConsensusStates is zero.
+func (cs ClientState) pruneExcessConsensusStates(clientStore sdk.KVStore, updateHeight exported.Height) {
+	if cs.MaxConsensusStates == 0 {
+		return
+	}
+
+	var heights []exported.Height
+	IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+		heights = append(heights, height)
+		return false
+	})
+
+	if uint64(len(heights)) <= cs.MaxConsensusStates {
+		return
+	}
+
+	excess := uint64(len(heights)) - cs.MaxConsensusStates
+	for _, height := range heights {
+		if excess == 0 {
+			break
+		}
+
+		if height.EQ(updateHeight) || height.EQ(cs.LatestHeight) {
+			continue
+		}
+
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+		excess--
+	}
+}
+
 // CheckForMisbehaviour detects duplicate height misbehaviour and BFT time violation misbehaviour
 func (cs ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, msg exported.ClientMessage) bool {
 	switch msg := msg.(type) {
@@ -207,15 +249,16 @@ func (cs ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCode
 		// since header has already been submitted in a previous UpdateClient.
 		existingConsState, _ := GetConsensusState(clientStore, cdc, tmHeader.GetHeight())
 		if existingConsState != nil {
-			// This header has already been submitted and the necessary state is already stored
-			// in client store, thus we can return early without further validation.
-			if reflect.DeepEqual(existingConsState, tmHeader.ConsensusState()) { //nolint:gosimple
-				return false
+			// A consensus state already exists for this height, but it does not match the provided header,
+			// e.g. the counterparty chain forked. The assumption is that Header has already been validated.
+			// Thus we can return true as misbehaviour is present
+			if conflictsWithConsensusState(tmHeader, existingConsState) {
+				return true
 			}
 
-			// A consensus state already exists for this height, but it does not match the provided header.
-			// The assumption is that Header has already been validated. Thus we can return true as misbehaviour is present
-			return true
+			// This header has already been submitted and the necessary state is already stored
+			// in client store, thus we can return early without further validation.
+			return false
 		}
 
 		// Check that consensus state timestamps are monotonic
@@ -240,6 +283,14 @@ func (cs ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCode
 	return false
 }
 
+// conflictsWithConsensusState returns true if the header commits to a different app hash, next validators hash
+// or timestamp than the consensus state stored at the header height.
+func conflictsWithConsensusState(header *Header, consState *ConsensusState) bool {
+	return !bytes.Equal(consState.Root.GetHash(), header.Header.GetAppHash()) ||
+		!bytes.Equal(consState.NextValidatorsHash, header.Header.NextValidatorsHash) ||
+		!consState.Timestamp.Equal(header.GetTime())
+}
+
 // UpdateStateOnMisbehaviour updates state upon misbehaviour, freezing the ClientState. This method should only be called when misbehaviour is detected
 // as it does not perform any misbehaviour checks.
 func (cs ClientState) UpdateStateOnMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, _ exported.ClientMessage) {
@@ -449,7 +449,8 @@ func (suite *TendermintTestSuite) TestUpdateState() {
 			clientStore = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
 
 			if tc.expPass {
-				consensusHeights = clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, clientMessage)
+				consensusHeights, err = clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, clientMessage)
+				suite.Require().NoError(err)
 
 				header := clientMessage.(*ibctm.Header)
 				expConsensusState := &ibctm.ConsensusState{
@@ -465,7 +466,7 @@ func (suite *TendermintTestSuite) TestUpdateState() {
 
 			} else {
 				suite.Require().Panics(func() {
-					clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, clientMessage)
+					_, _ = clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, clientMessage)
 				})
 			}
 
@@ -475,6 +476,38 @@ func (suite *TendermintTestSuite) TestUpdateState() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestUpdateStateConflictingHeader() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	err := path.EndpointA.CreateClient()
+	suite.Require().NoError(err)
+
+	// ensure counterparty state is committed
+	suite.coordinator.CommitBlock(suite.chainB)
+	tmHeader, err := path.EndpointA.Chain.ConstructUpdateTMClientHeader(path.EndpointA.Counterparty.Chain, path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	// store a consensus state at the header height which conflicts with the header
+	consensusState := &ibctm.ConsensusState{
+		Timestamp:          tmHeader.GetTime(),
+		Root:               commitmenttypes.NewMerkleRoot([]byte("app hash")),
+		NextValidatorsHash: tmHeader.Header.NextValidatorsHash,
+	}
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, tmHeader.GetHeight(), consensusState)
+
+	clientState := path.EndpointA.GetClientState()
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+	consensusHeights, err := clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, tmHeader)
+	suite.Require().ErrorIs(err, clienttypes.ErrInvalidHeader)
+	suite.Require().Nil(consensusHeights)
+
+	// the client state and the stored consensus state are left unchanged
+	suite.Require().Equal(clientState, path.EndpointA.GetClientState())
+	storedConsensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, tmHeader.GetHeight())
+	suite.Require().True(found)
+	suite.Require().Equal(consensusState, storedConsensusState)
+}
+
 func (suite *TendermintTestSuite) TestPruneConsensusState() {
 	// create path and setup clients
 	path := ibctesting.NewPath(suite.chainA, suite.chainB)
@@ -556,6 +589,58 @@ func (suite *TendermintTestSuite) TestPruneConsensusState() {
 	suite.Require().Equal(expectedConsKey, consKey, "iteration key incorrectly pruned")
 }
 
+// TestPruneExcessConsensusStates tests that the oldest consensus states and their metadata are pruned
+// once the number of stored consensus states exceeds the max consensus states of the client.
+func (suite *TendermintTestSuite) TestPruneExcessConsensusStates() {
+	// create path and setup clients
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	clientState.MaxConsensusStates = 3
+	path.EndpointA.SetClientState(clientState)
+
+	heights := []exported.Height{clientState.GetLatestHeight()}
+	for i := 0; i < 4; i++ {
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		heights = append(heights, path.EndpointA.GetClientState().GetLatestHeight())
+	}
+
+	ctx := path.EndpointA.Chain.GetContext()
+	clientStore := path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+	var storedHeights []exported.Height
+	ibctm.IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+		storedHeights = append(storedHeights, height)
+		return false
+	})
+	suite.Require().Equal(heights[2:], storedHeights)
+
+	// check that the oldest consensus states got deleted along with all associated metadata
+	for _, pruneHeight := range heights[:2] {
+		consState, ok := path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, pruneHeight)
+		suite.Require().Nil(consState, "excess consensus state not pruned")
+		suite.Require().False(ok)
+
+		_, ok = ibctm.GetProcessedTime(clientStore, pruneHeight)
+		suite.Require().False(ok, "processed time metadata not pruned")
+
+		_, ok = ibctm.GetProcessedHeight(clientStore, pruneHeight)
+		suite.Require().False(ok, "processed height metadata not pruned")
+
+		suite.Require().Nil(ibctm.GetIterationKey(clientStore, pruneHeight), "iteration key not pruned")
+	}
+
+	// check that the remaining consensus states and their metadata are not pruned
+	for _, height := range heights[2:] {
+		_, ok := path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, height)
+		suite.Require().True(ok)
+
+		_, ok = ibctm.GetProcessedTime(clientStore, height)
+		suite.Require().True(ok)
+	}
+}
+
 func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 	var (
 		path          *ibctesting.Path
@@ -608,6 +693,38 @@ func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 			},
 			true,
 		},
+		{
+			"consensus state already exists, next validators hash mismatch",
+			func() {
+				header, ok := clientMessage.(*ibctm.Header)
+				suite.Require().True(ok)
+
+				consensusState := &ibctm.ConsensusState{
+					Timestamp:          header.GetTime(),
+					Root:               commitmenttypes.NewMerkleRoot(header.Header.GetAppHash()),
+					NextValidatorsHash: []byte("next validators hash"),
+				}
+
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, header.GetHeight(), consensusState)
+			},
+			true,
+		},
+		{
+			"consensus state already exists, timestamp mismatch",
+			func() {
+				header, ok := clientMessage.(*ibctm.Header)
+				suite.Require().True(ok)
+
+				consensusState := &ibctm.ConsensusState{
+					Timestamp:          header.GetTime().Add(time.Second),
+					Root:               commitmenttypes.NewMerkleRoot(header.Header.GetAppHash()),
+					NextValidatorsHash: header.Header.NextValidatorsHash,
+				}
+
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, header.GetHeight(), consensusState)
+			},
+			true,
+		},
 		{
 			"previous consensus state exists and header time is before previous consensus state time",
 			func() {
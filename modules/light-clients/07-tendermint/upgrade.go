@@ -102,6 +102,7 @@ func (cs ClientState) VerifyUpgradeAndUpdateState(
 		tmUpgradeClient.ChainId, cs.TrustLevel, cs.TrustingPeriod, tmUpgradeClient.UnbondingPeriod,
 		cs.MaxClockDrift, tmUpgradeClient.LatestHeight, tmUpgradeClient.ProofSpecs, tmUpgradeClient.UpgradePath,
 	)
+	newClientState.MaxConsensusStates = cs.MaxConsensusStates
 
 	if err := newClientState.Validate(); err != nil {
 		return sdkerrors.Wrap(err, "updated client state failed basic validation")
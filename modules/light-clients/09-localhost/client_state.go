@@ -0,0 +1,224 @@
+package localhost
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+// NewClientState creates a new 09-localhost ClientState instance.
+func NewClientState(height clienttypes.Height) exported.ClientState {
+	return &ClientState{
+		LatestHeight: height,
+	}
+}
+
+// ClientType returns the 09-localhost client type.
+func (cs ClientState) ClientType() string {
+	return exported.Localhost
+}
+
+// GetLatestHeight returns the 09-localhost client state latest height.
+func (cs ClientState) GetLatestHeight() exported.Height {
+	return cs.LatestHeight
+}
+
+// Status always returns Active. The 09-localhost status cannot be changed.
+func (cs ClientState) Status(_ sdk.Context, _ sdk.KVStore, _ codec.BinaryCodec) exported.Status {
+	return exported.Active
+}
+
+// Validate performs a basic validation of the client state fields.
+func (cs ClientState) Validate() error {
+	if cs.LatestHeight.RevisionHeight == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidHeight, "local revision height cannot be zero")
+	}
+
+	return nil
+}
+
+// ZeroCustomFields returns the same client state since there are no custom fields in the 09-localhost client state.
+func (cs ClientState) ZeroCustomFields() exported.ClientState {
+	return &cs
+}
+
+// ExportMetadata is a no-op for the 09-localhost client.
+func (cs ClientState) ExportMetadata(_ sdk.KVStore) []exported.GenesisMetadata {
+	return nil
+}
+
+// GetTimestampAtHeight returns the current block time retrieved from the application context. The 09-localhost client
+// does not store consensus states and thus cannot provide a timestamp for the provided height.
+func (cs ClientState) GetTimestampAtHeight(ctx sdk.Context, _ sdk.KVStore, _ codec.BinaryCodec, _ exported.Height) (uint64, error) {
+	return uint64(ctx.BlockTime().UnixNano()), nil
+}
+
+// Initialize ensures that initial consensus state for localhost is nil and sets the client state in the client store.
+func (cs ClientState) Initialize(_ sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, consState exported.ConsensusState) error {
+	if consState != nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "initial consensus state for localhost must be nil")
+	}
+
+	clientStore.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(cdc, &cs))
+
+	return nil
+}
+
+// VerifyMembership is a generic proof verification method which verifies the existence of a given key and value within the IBC store.
+// The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+// The caller must provide the full IBC store and the localhost SentinelProof as proof. The store is read in its current state, the
+// provided height may not exceed the latest height of the client.
+func (cs ClientState) VerifyMembership(
+	_ sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	key, err := cs.verifyPathAndProof(height, proof, path)
+	if err != nil {
+		return err
+	}
+
+	bz := store.Get(key)
+	if bz == nil {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedMembershipVerification, "value not found for path %s", path)
+	}
+
+	if !bytes.Equal(bz, value) {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedMembershipVerification, "value provided does not equal value stored at path: %s", path)
+	}
+
+	return nil
+}
+
+// VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath within the IBC store.
+// The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+// The caller must provide the full IBC store and the localhost SentinelProof as proof. The store is read in its current state, the
+// provided height may not exceed the latest height of the client.
+func (cs ClientState) VerifyNonMembership(
+	_ sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	key, err := cs.verifyPathAndProof(height, proof, path)
+	if err != nil {
+		return err
+	}
+
+	if store.Has(key) {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedNonMembershipVerification, "value found for path %s", path)
+	}
+
+	return nil
+}
+
+// VerifyClientMessage checks that the client message is a 09-localhost Header which only moves the client
+// forward to the latest block height of the executing chain. The header height may not be lower than the
+// latest height of the client nor exceed the latest block height of the executing chain.
+func (cs ClientState) VerifyClientMessage(ctx sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore, clientMsg exported.ClientMessage) error {
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "expected type %T, got %T", &Header{}, clientMsg)
+	}
+
+	if header.Height.LT(cs.LatestHeight) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader,
+			"header height < client state height (%s < %s)", header.Height, cs.LatestHeight,
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	if header.Height.GT(selfHeight) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader,
+			"header height > latest block height (%s > %s)", header.Height, selfHeight,
+		)
+	}
+
+	return nil
+}
+
+// CheckForMisbehaviour returns false. The 09-localhost client cannot misbehave.
+func (cs ClientState) CheckForMisbehaviour(_ sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore, _ exported.ClientMessage) bool {
+	return false
+}
+
+// UpdateStateOnMisbehaviour is a no-op for the 09-localhost client.
+func (cs ClientState) UpdateStateOnMisbehaviour(_ sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore, _ exported.ClientMessage) {
+}
+
+// UpdateState updates the 09-localhost client to the latest block height of the executing chain. The provided
+// client message is ignored, the client is updated on every BeginBlock without a client message.
+func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, _ exported.ClientMessage) ([]exported.Height, error) {
+	height := clienttypes.GetSelfHeight(ctx)
+	cs.LatestHeight = height
+
+	clientStore.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(cdc, &cs))
+
+	return []exported.Height{height}, nil
+}
+
+// CheckSubstituteAndUpdateState returns an error. The 09-localhost client cannot be modified by
+// proposals.
+func (cs ClientState) CheckSubstituteAndUpdateState(_ sdk.Context, _ codec.BinaryCodec, _, _ sdk.KVStore, _ exported.ClientState) error {
+	return sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "cannot update localhost client with a proposal")
+}
+
+// VerifyUpgradeAndUpdateState returns an error since the 09-localhost client does not support upgrades.
+func (cs ClientState) VerifyUpgradeAndUpdateState(
+	_ sdk.Context,
+	_ codec.BinaryCodec,
+	_ sdk.KVStore,
+	_ exported.ClientState,
+	_ exported.ConsensusState,
+	_, _ []byte,
+) error {
+	return sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade localhost client")
+}
+
+// verifyPathAndProof ensures the proof is the localhost SentinelProof and the height does not exceed the
+// latest height of the client. It returns the key of the path within the IBC store, the commitment prefix
+// (e.g. "ibc") is omitted when operating on the IBC store.
+func (cs ClientState) verifyPathAndProof(height exported.Height, proof []byte, path exported.Path) ([]byte, error) {
+	if cs.GetLatestHeight().LT(height) {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.GetLatestHeight(), height,
+		)
+	}
+
+	if !bytes.Equal(proof, SentinelProof) {
+		return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected %s, got %s", string(SentinelProof), string(proof))
+	}
+
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	if len(merklePath.GetKeyPath()) != 2 {
+		return nil, sdkerrors.Wrapf(host.ErrInvalidPath, "path must be of length 2: %s", merklePath.GetKeyPath())
+	}
+
+	return []byte(merklePath.KeyPath[1]), nil
+}
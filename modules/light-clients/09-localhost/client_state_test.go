@@ -0,0 +1,375 @@
+package localhost_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint"
+	localhost "github.com/cosmos/ibc-go/v6/modules/light-clients/09-localhost"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+func (suite *LocalhostTestSuite) TestStatus() {
+	clientState := localhost.NewClientState(clienttypes.NewHeight(3, 10))
+	suite.Require().Equal(exported.Active, clientState.Status(suite.chain.GetContext(), nil, nil))
+}
+
+func (suite *LocalhostTestSuite) TestClientType() {
+	clientState := localhost.NewClientState(clienttypes.NewHeight(3, 10))
+	suite.Require().Equal(exported.Localhost, clientState.ClientType())
+}
+
+func (suite *LocalhostTestSuite) TestGetLatestHeight() {
+	expectedHeight := clienttypes.NewHeight(3, 10)
+	clientState := localhost.NewClientState(expectedHeight)
+	suite.Require().Equal(expectedHeight, clientState.GetLatestHeight())
+}
+
+func (suite *LocalhostTestSuite) TestGetTimestampAtHeight() {
+	ctx := suite.chain.GetContext()
+	clientState := localhost.NewClientState(clienttypes.NewHeight(1, 10))
+
+	timestamp, err := clientState.GetTimestampAtHeight(ctx, nil, nil, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(ctx.BlockTime().UnixNano()), timestamp)
+}
+
+func (suite *LocalhostTestSuite) TestValidate() {
+	testCases := []struct {
+		name        string
+		clientState exported.ClientState
+		expPass     bool
+	}{
+		{
+			name:        "valid client",
+			clientState: localhost.NewClientState(clienttypes.NewHeight(3, 10)),
+			expPass:     true,
+		},
+		{
+			name:        "invalid height",
+			clientState: localhost.NewClientState(clienttypes.ZeroHeight()),
+			expPass:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.clientState.Validate()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *LocalhostTestSuite) TestInitialize() {
+	testCases := []struct {
+		name      string
+		consState exported.ConsensusState
+		expPass   bool
+	}{
+		{
+			"valid initialization",
+			nil,
+			true,
+		},
+		{
+			"invalid consensus state",
+			&ibctm.ConsensusState{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientState := localhost.NewClientState(clienttypes.NewHeight(3, 10))
+			clientStore := suite.chain.GetSimApp().GetIBCKeeper().ClientKeeper.ClientStore(suite.chain.GetContext(), exported.LocalhostClientID)
+
+			err := clientState.Initialize(suite.chain.GetContext(), suite.chain.Codec, clientStore, tc.consState)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(clienttypes.MustMarshalClientState(suite.chain.Codec, clientState), clientStore.Get(host.ClientStateKey()))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *LocalhostTestSuite) TestVerifyMembership() {
+	var (
+		path   exported.Path
+		value  []byte
+		height exported.Height
+		proof  []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: connection state verification",
+			func() {},
+			true,
+		},
+		{
+			"invalid proof",
+			func() {
+				proof = []byte("proof")
+			},
+			false,
+		},
+		{
+			"proof height exceeds latest height",
+			func() {
+				height = height.Increment()
+			},
+			false,
+		},
+		{
+			"invalid path length",
+			func() {
+				path = commitmenttypes.NewMerklePath(host.ConnectionPath(exported.LocalhostConnectionID))
+			},
+			false,
+		},
+		{
+			"value not found",
+			func() {
+				merklePath := commitmenttypes.NewMerklePath(host.ConnectionPath(ibctesting.InvalidID))
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chain.GetPrefix(), merklePath)
+				suite.Require().NoError(err)
+
+				path = merklePath
+			},
+			false,
+		},
+		{
+			"value does not match stored value",
+			func() {
+				value = []byte("value")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chain.GetContext()
+			clientState := suite.chain.GetClientState(exported.LocalhostClientID)
+			height = clientState.GetLatestHeight()
+			proof = localhost.SentinelProof
+
+			connectionEnd, found := suite.chain.App.GetIBCKeeper().ConnectionKeeper.GetConnection(ctx, exported.LocalhostConnectionID)
+			suite.Require().True(found)
+
+			var err error
+			value, err = suite.chain.Codec.Marshal(&connectionEnd)
+			suite.Require().NoError(err)
+
+			merklePath := commitmenttypes.NewMerklePath(host.ConnectionPath(exported.LocalhostConnectionID))
+			path, err = commitmenttypes.ApplyPrefix(suite.chain.GetPrefix(), merklePath)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = clientState.VerifyMembership(ctx, ctx.KVStore(suite.chain.GetSimApp().GetKey(host.StoreKey)), suite.chain.Codec, height, 0, 0, proof, path, value)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *LocalhostTestSuite) TestVerifyNonMembership() {
+	var (
+		path  exported.Path
+		proof []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: connection does not exist",
+			func() {},
+			true,
+		},
+		{
+			"invalid proof",
+			func() {
+				proof = []byte("proof")
+			},
+			false,
+		},
+		{
+			"value found",
+			func() {
+				merklePath := commitmenttypes.NewMerklePath(host.ConnectionPath(exported.LocalhostConnectionID))
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chain.GetPrefix(), merklePath)
+				suite.Require().NoError(err)
+
+				path = merklePath
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chain.GetContext()
+			clientState := suite.chain.GetClientState(exported.LocalhostClientID)
+			proof = localhost.SentinelProof
+
+			merklePath := commitmenttypes.NewMerklePath(host.ConnectionPath(ibctesting.InvalidID))
+			merklePath, err := commitmenttypes.ApplyPrefix(suite.chain.GetPrefix(), merklePath)
+			suite.Require().NoError(err)
+			path = merklePath
+
+			tc.malleate()
+
+			err = clientState.VerifyNonMembership(ctx, ctx.KVStore(suite.chain.GetSimApp().GetKey(host.StoreKey)), suite.chain.Codec, clientState.GetLatestHeight(), 0, 0, proof, path)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *LocalhostTestSuite) TestVerifyClientMessage() {
+	var (
+		clientState exported.ClientState
+		clientMsg   exported.ClientMessage
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: latest block height",
+			func() {},
+			true,
+		},
+		{
+			"success: latest height of the client",
+			func() {
+				suite.coordinator.CommitBlock(suite.chain)
+			},
+			true,
+		},
+		{
+			"invalid client message type",
+			func() {
+				clientMsg = &ibctm.Header{}
+			},
+			false,
+		},
+		{
+			"header height is lower than the latest height of the client",
+			func() {
+				height := clientState.GetLatestHeight().(clienttypes.Height)
+				clientMsg = localhost.NewHeader(clienttypes.NewHeight(height.RevisionNumber, height.RevisionHeight-1))
+			},
+			false,
+		},
+		{
+			"header height exceeds the latest block height",
+			func() {
+				clientMsg = localhost.NewHeader(clienttypes.GetSelfHeight(suite.chain.GetContext()).Increment().(clienttypes.Height))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			height := clienttypes.GetSelfHeight(suite.chain.GetContext())
+			clientState = localhost.NewClientState(height)
+			clientMsg = localhost.NewHeader(height)
+
+			tc.malleate()
+
+			err := clientState.VerifyClientMessage(suite.chain.GetContext(), suite.chain.Codec, nil, clientMsg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *LocalhostTestSuite) TestUpdateState() {
+	clientState := localhost.NewClientState(clienttypes.NewHeight(1, uint64(suite.chain.GetContext().BlockHeight())))
+	store := suite.chain.GetSimApp().GetIBCKeeper().ClientKeeper.ClientStore(suite.chain.GetContext(), exported.LocalhostClientID)
+
+	suite.coordinator.CommitBlock(suite.chain)
+
+	// the client message is ignored
+	heights, err := clientState.UpdateState(suite.chain.GetContext(), suite.chain.Codec, store, &ibctm.Header{})
+	suite.Require().NoError(err)
+
+	expHeight := clienttypes.NewHeight(1, uint64(suite.chain.GetContext().BlockHeight()))
+	suite.Require().True(heights[0].EQ(expHeight))
+
+	clientState = suite.chain.GetClientState(exported.LocalhostClientID)
+	suite.Require().True(heights[0].EQ(clientState.GetLatestHeight()))
+}
+
+func (suite *LocalhostTestSuite) TestCheckSubstituteAndUpdateState() {
+	clientState := localhost.NewClientState(clienttypes.NewHeight(1, 10))
+	err := clientState.CheckSubstituteAndUpdateState(suite.chain.GetContext(), suite.chain.Codec, nil, nil, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *LocalhostTestSuite) TestVerifyUpgradeAndUpdateState() {
+	clientState := localhost.NewClientState(clienttypes.NewHeight(1, 10))
+	err := clientState.VerifyUpgradeAndUpdateState(suite.chain.GetContext(), suite.chain.Codec, nil, nil, nil, nil, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *LocalhostTestSuite) TestSentinelConnection() {
+	connectionEnd, found := suite.chain.App.GetIBCKeeper().ConnectionKeeper.GetConnection(suite.chain.GetContext(), exported.LocalhostConnectionID)
+	suite.Require().True(found)
+
+	suite.Require().Equal(connectiontypes.OPEN, connectionEnd.State)
+	suite.Require().Equal(exported.LocalhostClientID, connectionEnd.ClientId)
+	suite.Require().Equal(exported.LocalhostClientID, connectionEnd.Counterparty.ClientId)
+	suite.Require().Equal(exported.LocalhostConnectionID, connectionEnd.Counterparty.ConnectionId)
+}
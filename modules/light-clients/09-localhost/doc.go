@@ -0,0 +1,7 @@
+/*
+Package localhost implements a concrete `ClientState` type for the localhost light client.
+The localhost client allows IBC applications on the same chain to communicate without a
+relayer having to submit proofs: membership and non-membership are verified by reading
+directly from the IBC store of the executing chain.
+*/
+package localhost
@@ -0,0 +1,36 @@
+package localhost
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*Header)(nil)
+
+// NewHeader creates a new 09-localhost Header instance.
+func NewHeader(height clienttypes.Height) *Header {
+	return &Header{
+		Height: height,
+	}
+}
+
+// ClientType returns the 09-localhost client type.
+func (h Header) ClientType() string {
+	return exported.Localhost
+}
+
+// GetHeight returns the height the client is updated to.
+func (h Header) GetHeight() exported.Height {
+	return h.Height
+}
+
+// ValidateBasic ensures the header height is not zero.
+func (h Header) ValidateBasic() error {
+	if h.Height.RevisionHeight == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "localhost header revision height cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package localhost_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	localhost "github.com/cosmos/ibc-go/v6/modules/light-clients/09-localhost"
+)
+
+func (suite *LocalhostTestSuite) TestHeaderValidateBasic() {
+	header := localhost.NewHeader(clienttypes.NewHeight(1, 10))
+	suite.Require().NoError(header.ValidateBasic())
+	suite.Require().Equal(exported.Localhost, header.ClientType())
+	suite.Require().Equal(clienttypes.NewHeight(1, 10), header.GetHeight())
+
+	header = localhost.NewHeader(clienttypes.ZeroHeight())
+	suite.Require().Error(header.ValidateBasic())
+}
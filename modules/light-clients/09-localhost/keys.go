@@ -0,0 +1,10 @@
+package localhost
+
+const (
+	// SubModuleName for the localhost (loopback) client
+	SubModuleName = "localhost-client"
+)
+
+// SentinelProof defines the localhost proof sentinel value. Proof verification is performed
+// by reading directly from the IBC store, callers provide the sentinel value as proof.
+var SentinelProof = []byte{0x01}
@@ -0,0 +1,148 @@
+package localhost_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	localhost "github.com/cosmos/ibc-go/v6/modules/light-clients/09-localhost"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+)
+
+type LocalhostTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chain       *ibctesting.TestChain
+}
+
+func (suite *LocalhostTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chain = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+func TestLocalhostTestSuite(t *testing.T) {
+	suite.Run(t, new(LocalhostTestSuite))
+}
+
+// TestLocalhostChannelFlow tests that a channel handshake and an ICS20 token transfer between
+// two channel ends on the same chain are relayed over the sentinel localhost connection without
+// external proofs.
+func (suite *LocalhostTestSuite) TestLocalhostChannelFlow() {
+	chain := suite.chain
+	signer := chain.SenderAccount.GetAddress().String()
+	connectionHops := []string{exported.LocalhostConnectionID}
+
+	// proofs are verified against the latest height of the localhost client
+	proofHeight := func() clienttypes.Height {
+		return chain.GetClientState(exported.LocalhostClientID).GetLatestHeight().(clienttypes.Height)
+	}
+
+	res, err := chain.SendMsgs(channeltypes.NewMsgChannelOpenInit(transfertypes.PortID, transfertypes.Version, channeltypes.UNORDERED, connectionHops, transfertypes.PortID, signer))
+	suite.Require().NoError(err)
+	channelIDA, err := ibctesting.ParseChannelIDFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res, err = chain.SendMsgs(channeltypes.NewMsgChannelOpenTry(transfertypes.PortID, transfertypes.Version, channeltypes.UNORDERED, connectionHops, transfertypes.PortID, channelIDA, transfertypes.Version, localhost.SentinelProof, proofHeight(), signer))
+	suite.Require().NoError(err)
+	channelIDB, err := ibctesting.ParseChannelIDFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	_, err = chain.SendMsgs(channeltypes.NewMsgChannelOpenAck(transfertypes.PortID, channelIDA, channelIDB, transfertypes.Version, localhost.SentinelProof, proofHeight(), signer))
+	suite.Require().NoError(err)
+
+	_, err = chain.SendMsgs(channeltypes.NewMsgChannelOpenConfirm(transfertypes.PortID, channelIDB, localhost.SentinelProof, proofHeight(), signer))
+	suite.Require().NoError(err)
+
+	for _, channelID := range []string{channelIDA, channelIDB} {
+		channel, found := chain.App.GetIBCKeeper().ChannelKeeper.GetChannel(chain.GetContext(), transfertypes.PortID, channelID)
+		suite.Require().True(found)
+		suite.Require().Equal(channeltypes.OPEN, channel.State)
+	}
+
+	// transfer tokens from channelIDA to channelIDB
+	receiver := chain.SenderAccounts[1].SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	res, err = chain.SendMsgs(transfertypes.NewMsgTransfer(transfertypes.PortID, channelIDA, coin, signer, receiver.String(), clienttypes.NewHeight(1, 1000), 0, ""))
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res, err = chain.SendMsgs(channeltypes.NewMsgRecvPacket(packet, localhost.SentinelProof, proofHeight(), signer))
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	_, err = chain.SendMsgs(channeltypes.NewMsgAcknowledgement(packet, ack, localhost.SentinelProof, proofHeight(), signer))
+	suite.Require().NoError(err)
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, channelIDB, sdk.DefaultBondDenom))
+	voucher := chain.GetSimApp().BankKeeper.GetBalance(chain.GetContext(), receiver, voucherDenom.IBCDenom())
+	suite.Require().Equal(coin.Amount, voucher.Amount)
+
+	// the packet commitment is deleted once the acknowledgement is processed
+	commitment := chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(chain.GetContext(), transfertypes.PortID, channelIDA, packet.GetSequence())
+	suite.Require().Nil(commitment)
+}
+
+// TestSetupLocalhostPath tests that MsgSetupLocalhostPath opens a channel between two ports of the
+// chain over the sentinel localhost connection in a single transaction.
+func (suite *LocalhostTestSuite) TestSetupLocalhostPath() {
+	chain := suite.chain
+	signer := chain.SenderAccount.GetAddress().String()
+
+	res, err := chain.SendMsgs(channeltypes.NewMsgSetupLocalhostPath(transfertypes.PortID, transfertypes.PortID, channeltypes.UNORDERED, transfertypes.Version, signer))
+	suite.Require().NoError(err)
+
+	var txMsgData sdk.TxMsgData
+	suite.Require().NoError(proto.Unmarshal(res.Data, &txMsgData))
+	suite.Require().Len(txMsgData.MsgResponses, 1)
+
+	var setupRes channeltypes.MsgSetupLocalhostPathResponse
+	suite.Require().NoError(proto.Unmarshal(txMsgData.MsgResponses[0].Value, &setupRes))
+	suite.Require().Equal(transfertypes.Version, setupRes.Version)
+
+	channelA, found := chain.App.GetIBCKeeper().ChannelKeeper.GetChannel(chain.GetContext(), transfertypes.PortID, setupRes.ChannelIdA)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.OPEN, channelA.State)
+	suite.Require().Equal(channeltypes.NewCounterparty(transfertypes.PortID, setupRes.ChannelIdB), channelA.Counterparty)
+	suite.Require().Equal([]string{exported.LocalhostConnectionID}, channelA.ConnectionHops)
+
+	channelB, found := chain.App.GetIBCKeeper().ChannelKeeper.GetChannel(chain.GetContext(), transfertypes.PortID, setupRes.ChannelIdB)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.OPEN, channelB.State)
+	suite.Require().Equal(channeltypes.NewCounterparty(transfertypes.PortID, setupRes.ChannelIdA), channelB.Counterparty)
+
+	// the channel handshake fails if any of the application callbacks fails
+	_, err = chain.App.GetIBCKeeper().SetupLocalhostPath(sdk.WrapSDKContext(chain.GetContext()), channeltypes.NewMsgSetupLocalhostPath(transfertypes.PortID, transfertypes.PortID, channeltypes.UNORDERED, "invalid-version", signer))
+	suite.Require().Error(err)
+}
+
+// TestUpdateClient tests that anyone can update the localhost client through MsgUpdateClient with a
+// header at the latest block height, while headers exceeding it are rejected.
+func (suite *LocalhostTestSuite) TestUpdateClient() {
+	chain := suite.chain
+	signer := chain.SenderAccount.GetAddress().String()
+
+	// the localhost client is updated on BeginBlock, so a header at the latest block height is accepted
+	header := localhost.NewHeader(clienttypes.GetSelfHeight(chain.GetContext()))
+	msg, err := clienttypes.NewMsgUpdateClient(exported.LocalhostClientID, header, signer)
+	suite.Require().NoError(err)
+
+	_, err = chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(clienttypes.GetSelfHeight(chain.GetContext()), chain.GetClientState(exported.LocalhostClientID).GetLatestHeight())
+
+	header = localhost.NewHeader(clienttypes.GetSelfHeight(chain.GetContext()).Increment().(clienttypes.Height))
+	err = chain.App.GetIBCKeeper().ClientKeeper.UpdateClient(chain.GetContext(), exported.LocalhostClientID, header)
+	suite.Require().ErrorIs(err, clienttypes.ErrInvalidHeader)
+}
@@ -0,0 +1,6 @@
+package localhost
+
+// Name returns the IBC client name
+func Name() string {
+	return SubModuleName
+}
@@ -47,9 +47,10 @@ func NewConnectionConfig() *ConnectionConfig {
 }
 
 type ChannelConfig struct {
-	PortID  string
-	Version string
-	Order   channeltypes.Order
+	PortID      string
+	Version     string
+	Order       channeltypes.Order
+	DelayPeriod uint64 // optional, overrides the connection delay period if set
 }
 
 func NewChannelConfig() *ChannelConfig {
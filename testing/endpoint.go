@@ -260,11 +260,17 @@ func (endpoint *Endpoint) ConnOpenAck() error {
 
 	counterpartyClient, proofClient, proofConsensus, consensusHeight, proofTry, proofHeight := endpoint.QueryConnectionHandshakeProof()
 
+	// acknowledge the version picked by the counterparty, which may use a custom version negotiator
+	version := ConnectionVersion
+	if connection, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ConnectionKeeper.GetConnection(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ConnectionID); found && len(connection.Versions) == 1 {
+		version = connection.Versions[0]
+	}
+
 	msg := connectiontypes.NewMsgConnectionOpenAck(
-		endpoint.ConnectionID, endpoint.Counterparty.ConnectionID, counterpartyClient, // testing doesn't use flexible selection
+		endpoint.ConnectionID, endpoint.Counterparty.ConnectionID, counterpartyClient,
 		proofTry, proofClient, proofConsensus,
 		proofHeight, consensusHeight,
-		ConnectionVersion,
+		version,
 		endpoint.Chain.SenderAccount.GetAddress().String(),
 	)
 	return endpoint.Chain.sendMsgs(msg)
@@ -323,6 +329,8 @@ func (endpoint *Endpoint) ChanOpenInit() error {
 		endpoint.Counterparty.ChannelConfig.PortID,
 		endpoint.Chain.SenderAccount.GetAddress().String(),
 	)
+	msg.Channel.DelayPeriod = endpoint.ChannelConfig.DelayPeriod
+
 	res, err := endpoint.Chain.SendMsgs(msg)
 	if err != nil {
 		return err
@@ -353,6 +361,8 @@ func (endpoint *Endpoint) ChanOpenTry() error {
 		proof, height,
 		endpoint.Chain.SenderAccount.GetAddress().String(),
 	)
+	msg.Channel.DelayPeriod = endpoint.ChannelConfig.DelayPeriod
+
 	res, err := endpoint.Chain.SendMsgs(msg)
 	if err != nil {
 		return err
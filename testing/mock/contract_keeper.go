@@ -0,0 +1,95 @@
+package mock
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	callbacktypes "github.com/cosmos/ibc-go/v6/modules/apps/callbacks/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+)
+
+var _ callbacktypes.ContractKeeper = &ContractKeeper{}
+
+// ContractKeeper implements the callbacks middleware ContractKeeper interface for testing.
+// No address is recognized as a callback contract unless IsCallbackContractFn is set, and a
+// callback whose function is not set succeeds without any side effects.
+type ContractKeeper struct {
+	IsCallbackContractFn func(ctx sdk.Context, contractAddress string) bool
+
+	IBCOnAcknowledgementPacketFn func(
+		ctx sdk.Context,
+		packet exported.PacketI,
+		acknowledgement []byte,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+
+	IBCOnTimeoutPacketFn func(
+		ctx sdk.Context,
+		packet exported.PacketI,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+
+	IBCReceivePacketFn func(
+		ctx sdk.Context,
+		packet exported.PacketI,
+		ack exported.Acknowledgement,
+		contractAddress string,
+	) error
+}
+
+// IsCallbackContract implements the ContractKeeper interface.
+func (k *ContractKeeper) IsCallbackContract(ctx sdk.Context, contractAddress string) bool {
+	if k.IsCallbackContractFn != nil {
+		return k.IsCallbackContractFn(ctx, contractAddress)
+	}
+
+	return false
+}
+
+// IBCOnAcknowledgementPacket implements the ContractKeeper interface.
+func (k *ContractKeeper) IBCOnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet exported.PacketI,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	if k.IBCOnAcknowledgementPacketFn != nil {
+		return k.IBCOnAcknowledgementPacketFn(ctx, packet, acknowledgement, relayer, contractAddress, packetSenderAddress)
+	}
+
+	return nil
+}
+
+// IBCOnTimeoutPacket implements the ContractKeeper interface.
+func (k *ContractKeeper) IBCOnTimeoutPacket(
+	ctx sdk.Context,
+	packet exported.PacketI,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	if k.IBCOnTimeoutPacketFn != nil {
+		return k.IBCOnTimeoutPacketFn(ctx, packet, relayer, contractAddress, packetSenderAddress)
+	}
+
+	return nil
+}
+
+// IBCReceivePacket implements the ContractKeeper interface.
+func (k *ContractKeeper) IBCReceivePacket(
+	ctx sdk.Context,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+	contractAddress string,
+) error {
+	if k.IBCReceivePacketFn != nil {
+		return k.IBCReceivePacketFn(ctx, packet, ack, contractAddress)
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ type Solomachine struct {
 	t *testing.T
 
 	cdc         codec.BinaryCodec
+	genPrivKey  func() cryptotypes.PrivKey
 	ClientID    string
 	PrivateKeys []cryptotypes.PrivKey // keys used for signing
 	PublicKeys  []cryptotypes.PubKey  // keys used for generating solo machine pub key
@@ -38,11 +39,22 @@ type Solomachine struct {
 // generated private/public key pairs and a sequence starting at 1. If nKeys
 // is greater than 1 then a multisig public key is used.
 func NewSolomachine(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier string, nKeys uint64) *Solomachine {
-	privKeys, pubKeys, pk := GenerateKeys(t, nKeys)
+	return NewSolomachineWithKeyGenerator(t, cdc, clientID, diversifier, nKeys, func() cryptotypes.PrivKey {
+		return secp256k1.GenPrivKey()
+	})
+}
+
+// NewSolomachineWithKeyGenerator returns a new solomachine instance with an `nKeys`
+// amount of private/public key pairs generated by genPrivKey and a sequence starting
+// at 1. It allows for testing the solo machine with key types other than secp256k1.
+// The same generator is used for the keys of subsequent header updates.
+func NewSolomachineWithKeyGenerator(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier string, nKeys uint64, genPrivKey func() cryptotypes.PrivKey) *Solomachine {
+	privKeys, pubKeys, pk := GenerateKeysWithKeyGenerator(t, nKeys, genPrivKey)
 
 	return &Solomachine{
 		t:           t,
 		cdc:         cdc,
+		genPrivKey:  genPrivKey,
 		ClientID:    clientID,
 		PrivateKeys: privKeys,
 		PublicKeys:  pubKeys,
@@ -62,12 +74,21 @@ func NewSolomachine(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier s
 // interface, if needed. The same is true for the amino based Multisignature
 // public key.
 func GenerateKeys(t *testing.T, n uint64) ([]cryptotypes.PrivKey, []cryptotypes.PubKey, cryptotypes.PubKey) {
+	return GenerateKeysWithKeyGenerator(t, n, func() cryptotypes.PrivKey {
+		return secp256k1.GenPrivKey()
+	})
+}
+
+// GenerateKeysWithKeyGenerator generates a new set of private keys and public keys
+// using the provided private key generator. See GenerateKeys for the usage of the
+// returned keys.
+func GenerateKeysWithKeyGenerator(t *testing.T, n uint64, genPrivKey func() cryptotypes.PrivKey) ([]cryptotypes.PrivKey, []cryptotypes.PubKey, cryptotypes.PubKey) {
 	require.NotEqual(t, uint64(0), n, "generation of zero keys is not allowed")
 
 	privKeys := make([]cryptotypes.PrivKey, n)
 	pubKeys := make([]cryptotypes.PubKey, n)
 	for i := uint64(0); i < n; i++ {
-		privKeys[i] = secp256k1.GenPrivKey()
+		privKeys[i] = genPrivKey()
 		pubKeys[i] = privKeys[i].PubKey()
 	}
 
@@ -109,7 +130,7 @@ func (solo *Solomachine) GetHeight() exported.Height {
 // A new diversifier will be used as well
 func (solo *Solomachine) CreateHeader(newDiversifier string) *solomachine.Header {
 	// generate new private keys and signature for header
-	newPrivKeys, newPubKeys, newPubKey := GenerateKeys(solo.t, uint64(len(solo.PrivateKeys)))
+	newPrivKeys, newPubKeys, newPubKey := GenerateKeysWithKeyGenerator(solo.t, uint64(len(solo.PrivateKeys)), solo.genPrivKey)
 
 	publicKey, err := codectypes.NewAnyWithValue(newPubKey)
 	require.NoError(solo.t, err)